@@ -43,3 +43,7 @@ goimports -w ./sdk.pb.gw.go
 mv ./sdk.pb.go ./pkg/sdk
 mv ./sdk.pb.gw.go ./pkg/sdk
 
+protoc -I ${googleapis} -I ./cmd/allocator/v1alpha1 allocation.proto --go_out=plugins=grpc:pkg/allocation/go/v1alpha1
+cat ./build/boilerplate.go.txt ./pkg/allocation/go/v1alpha1/allocation.pb.go >> ./allocation.pb.go
+goimports -w ./allocation.pb.go
+mv ./allocation.pb.go ./pkg/allocation/go/v1alpha1
@@ -0,0 +1,125 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"compress/flate"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+const (
+	encodingGzip    = "gzip"
+	encodingDeflate = "deflate"
+)
+
+// compressionHandler compresses responses with gzip or deflate, when the client accepts either
+// of them, and decompresses request bodies that are sent with a gzip or deflate Content-Encoding
+func compressionHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch strings.ToLower(strings.TrimSpace(r.Header.Get("Content-Encoding"))) {
+		case "", "identity":
+		case encodingGzip:
+			body, err := gzip.NewReader(r.Body)
+			if err != nil {
+				http.Error(w, "invalid gzip request body", http.StatusBadRequest)
+				logger.WithError(err).Info("bad request")
+				return
+			}
+			defer body.Close() // nolint: errcheck
+			r.Body = body
+			r.Header.Del("Content-Encoding")
+		case encodingDeflate:
+			body := flate.NewReader(r.Body)
+			defer body.Close() // nolint: errcheck
+			r.Body = body
+			r.Header.Del("Content-Encoding")
+		default:
+			http.Error(w, "unsupported content encoding", http.StatusUnsupportedMediaType)
+			return
+		}
+
+		w.Header().Add("Vary", "Accept-Encoding")
+		encoding := negotiateEncoding(r.Header.Get("Accept-Encoding"))
+		if encoding == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		var cw io.WriteCloser
+		if encoding == encodingGzip {
+			cw = gzip.NewWriter(w)
+		} else {
+			// flate.NewWriter only returns an error for an invalid compression level
+			cw, _ = flate.NewWriter(w, flate.DefaultCompression)
+		}
+		defer cw.Close() // nolint: errcheck
+
+		w.Header().Set("Content-Encoding", encoding)
+		next.ServeHTTP(&compressedResponseWriter{ResponseWriter: w, writer: cw}, r)
+	})
+}
+
+// negotiateEncoding returns the encoding to compress the response with, gzip being preferred
+// over deflate, or an empty string if the response should not be compressed
+func negotiateEncoding(acceptEncoding string) string {
+	accepted := map[string]bool{}
+	for _, part := range strings.Split(acceptEncoding, ",") {
+		fields := strings.Split(part, ";")
+		name := strings.ToLower(strings.TrimSpace(fields[0]))
+		ok := true
+		for _, param := range fields[1:] {
+			param = strings.TrimSpace(param)
+			if strings.HasPrefix(param, "q=") {
+				q, err := strconv.ParseFloat(strings.TrimPrefix(param, "q="), 64)
+				ok = err == nil && q > 0
+			}
+		}
+		accepted[name] = ok
+	}
+
+	for _, encoding := range []string{encodingGzip, encodingDeflate} {
+		if ok, found := accepted[encoding]; found {
+			if ok {
+				return encoding
+			}
+			continue
+		}
+		if accepted["*"] {
+			return encoding
+		}
+	}
+	return ""
+}
+
+// compressedResponseWriter writes the response body through a compressing writer
+type compressedResponseWriter struct {
+	http.ResponseWriter
+	writer io.Writer
+}
+
+func (c *compressedResponseWriter) WriteHeader(code int) {
+	// the length of the compressed body is not known up front
+	c.ResponseWriter.Header().Del("Content-Length")
+	c.ResponseWriter.WriteHeader(code)
+}
+
+func (c *compressedResponseWriter) Write(b []byte) (int, error) {
+	c.ResponseWriter.Header().Del("Content-Length")
+	return c.writer.Write(b)
+}
@@ -0,0 +1,142 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNegotiateEncoding(t *testing.T) {
+	t.Parallel()
+
+	fixtures := map[string]string{
+		"":                          "",
+		"identity":                  "",
+		"gzip":                      "gzip",
+		"deflate":                   "deflate",
+		"deflate, gzip":             "gzip",
+		"GZIP;q=0.5":                "gzip",
+		"gzip;q=0, deflate":         "deflate",
+		"gzip;q=0, deflate;q=0":     "",
+		"*":                         "gzip",
+		"gzip;q=0, *":               "deflate",
+		"br, deflate;q=0.1, x-gzip": "deflate",
+	}
+
+	for acceptEncoding, expected := range fixtures {
+		assert.Equal(t, expected, negotiateEncoding(acceptEncoding), "Accept-Encoding: %q", acceptEncoding)
+	}
+}
+
+func TestCompressionHandler(t *testing.T) {
+	t.Parallel()
+
+	body := strings.Repeat(`{"status":{"state":"Allocated"}}`, 100)
+	echo := compressionHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(b)
+	}))
+
+	compress := func(encoding, s string) io.Reader {
+		buf := &bytes.Buffer{}
+		var w io.WriteCloser
+		if encoding == encodingGzip {
+			w = gzip.NewWriter(buf)
+		} else {
+			w, _ = flate.NewWriter(buf, flate.DefaultCompression)
+		}
+		_, err := w.Write([]byte(s))
+		assert.NoError(t, err)
+		assert.NoError(t, w.Close())
+		return buf
+	}
+
+	decompress := func(encoding string, r io.Reader) string {
+		var rc io.ReadCloser
+		var err error
+		if encoding == encodingGzip {
+			rc, err = gzip.NewReader(r)
+			assert.NoError(t, err)
+		} else {
+			rc = flate.NewReader(r)
+		}
+		b, err := ioutil.ReadAll(rc)
+		assert.NoError(t, err)
+		return string(b)
+	}
+
+	t.Run("uncompressed", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		echo.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body)))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Empty(t, rec.Header().Get("Content-Encoding"))
+		assert.Equal(t, "Accept-Encoding", rec.Header().Get("Vary"))
+		assert.Equal(t, body, rec.Body.String())
+	})
+
+	for _, encoding := range []string{encodingGzip, encodingDeflate} {
+		t.Run(encoding+" response", func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+			r.Header.Set("Accept-Encoding", encoding)
+			echo.ServeHTTP(rec, r)
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.Equal(t, encoding, rec.Header().Get("Content-Encoding"))
+			assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+			assert.True(t, rec.Body.Len() < len(body), "response should be compressed")
+			assert.Equal(t, body, decompress(encoding, rec.Body))
+		})
+
+		t.Run(encoding+" request", func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			r := httptest.NewRequest(http.MethodPost, "/", compress(encoding, body))
+			r.Header.Set("Content-Encoding", encoding)
+			echo.ServeHTTP(rec, r)
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.Equal(t, body, rec.Body.String())
+		})
+	}
+
+	t.Run("invalid gzip request", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		r.Header.Set("Content-Encoding", encodingGzip)
+		echo.ServeHTTP(rec, r)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("unsupported request encoding", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		r.Header.Set("Content-Encoding", "br")
+		echo.ServeHTTP(rec, r)
+		assert.Equal(t, http.StatusUnsupportedMediaType, rec.Code)
+	})
+}
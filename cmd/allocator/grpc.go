@@ -0,0 +1,185 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	pb "agones.dev/agones/pkg/allocation/go/v1alpha1"
+	"agones.dev/agones/pkg/apis"
+	allocationv1 "agones.dev/agones/pkg/apis/allocation/v1"
+	"agones.dev/agones/pkg/client/clientset/versioned"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// grpcHandler serves the gRPC AllocationService, by creating GameServerAllocations
+type grpcHandler struct {
+	agonesClient versioned.Interface
+}
+
+var _ pb.AllocationServiceServer = &grpcHandler{}
+
+// Allocate allocates a GameServer that matches the selectors of the request
+func (h *grpcHandler) Allocate(ctx context.Context, in *pb.AllocationRequest) (*pb.AllocationResponse, error) {
+	logger.WithField("request", in).Infof("allocation request received")
+
+	gsa := convertAllocationRequestToGSA(in)
+	allocatedGsa, err := h.createGameServerAllocation(ctx, gsa)
+	if err != nil && ctx.Err() == context.DeadlineExceeded {
+		return nil, status.Error(codes.DeadlineExceeded, err.Error())
+	}
+	if err != nil {
+		logger.WithField("gsa", gsa).WithError(err).Info("calling allocation extension API failed")
+		return nil, status.Error(grpcCode(err), err.Error())
+	}
+
+	return convertGSAToAllocationResponse(allocatedGsa), nil
+}
+
+// createGameServerAllocation creates the GameServerAllocation. If the gRPC call has a deadline,
+// the time left before it is the timeout of the allocation, so that it is abandoned once the client has given up.
+func (h *grpcHandler) createGameServerAllocation(ctx context.Context, gsa *allocationv1.GameServerAllocation) (*allocationv1.GameServerAllocation, error) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return h.agonesClient.AllocationV1().GameServerAllocations(gsa.ObjectMeta.Namespace).Create(gsa)
+	}
+	timeout := time.Until(deadline)
+	if timeout <= 0 {
+		return nil, context.DeadlineExceeded
+	}
+
+	// the typed client can't set a timeout, so use its REST client, which sends it as the timeout query parameter
+	result := &allocationv1.GameServerAllocation{}
+	err := h.agonesClient.AllocationV1().RESTClient().Post().
+		Namespace(gsa.ObjectMeta.Namespace).
+		Resource("gameserverallocations").
+		Timeout(timeout).
+		Context(ctx).
+		Body(gsa).
+		Do().
+		Into(result)
+	return result, err
+}
+
+// grpcCode returns the gRPC status code matching the http status code of an error
+func grpcCode(err error) codes.Code {
+	switch httpCode(err) {
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		return codes.InvalidArgument
+	case http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		return codes.NotFound
+	case http.StatusConflict:
+		return codes.Aborted
+	case http.StatusTooManyRequests:
+		return codes.ResourceExhausted
+	case http.StatusServiceUnavailable:
+		return codes.Unavailable
+	case http.StatusGatewayTimeout:
+		return codes.DeadlineExceeded
+	}
+	return codes.Internal
+}
+
+// grpcHandlerFunc routes gRPC requests to grpcServer, and all other requests to httpHandler,
+// so that both are served on the same port, secured by the same mTLS configuration
+func grpcHandlerFunc(grpcServer http.Handler, httpHandler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.ProtoMajor == 2 && strings.HasPrefix(r.Header.Get("Content-Type"), "application/grpc") {
+			grpcServer.ServeHTTP(w, r)
+			return
+		}
+		httpHandler.ServeHTTP(w, r)
+	})
+}
+
+// convertAllocationRequestToGSA converts an AllocationRequest to a GameServerAllocation
+func convertAllocationRequestToGSA(in *pb.AllocationRequest) *allocationv1.GameServerAllocation {
+	gsa := &allocationv1.GameServerAllocation{}
+	gsa.ObjectMeta.Namespace = in.GetNamespace()
+
+	gsa.Spec.Required = convertLabelSelector(in.GetRequiredGameServerSelector())
+	for _, selector := range in.GetPreferredGameServerSelectors() {
+		if selector != nil {
+			gsa.Spec.Preferred = append(gsa.Spec.Preferred, convertLabelSelector(selector))
+		}
+	}
+
+	if in.GetScheduling() == pb.AllocationRequest_Distributed {
+		gsa.Spec.Scheduling = apis.Distributed
+	} else {
+		gsa.Spec.Scheduling = apis.Packed
+	}
+
+	if mc := in.GetMultiClusterSetting(); mc != nil {
+		gsa.Spec.MultiClusterSetting.Enabled = mc.GetEnabled()
+		gsa.Spec.MultiClusterSetting.PolicySelector = convertLabelSelector(mc.GetPolicySelector())
+	}
+
+	if mp := in.GetMetaPatch(); mp != nil {
+		gsa.Spec.MetaPatch.Labels = mp.GetLabels()
+		gsa.Spec.MetaPatch.Annotations = mp.GetAnnotations()
+	}
+
+	return gsa
+}
+
+// convertLabelSelector converts a LabelSelector of an AllocationRequest to a Kubernetes LabelSelector
+func convertLabelSelector(in *pb.LabelSelector) metav1.LabelSelector {
+	out := metav1.LabelSelector{MatchLabels: in.GetMatchLabels()}
+	for _, e := range in.GetMatchExpressions() {
+		out.MatchExpressions = append(out.MatchExpressions, metav1.LabelSelectorRequirement{
+			Key:      e.GetKey(),
+			Operator: metav1.LabelSelectorOperator(e.GetOperator()),
+			Values:   e.GetValues(),
+		})
+	}
+	return out
+}
+
+// convertGSAToAllocationResponse converts the status of a GameServerAllocation to an AllocationResponse
+func convertGSAToAllocationResponse(gsa *allocationv1.GameServerAllocation) *pb.AllocationResponse {
+	out := &pb.AllocationResponse{
+		GameServerName: gsa.Status.GameServerName,
+		Address:        gsa.Status.Address,
+		NodeName:       gsa.Status.NodeName,
+		Annotations:    gsa.Status.Annotations,
+	}
+
+	switch gsa.Status.State {
+	case allocationv1.GameServerAllocationAllocated:
+		out.State = pb.AllocationResponse_Allocated
+	case allocationv1.GameServerAllocationUnAllocated:
+		out.State = pb.AllocationResponse_UnAllocated
+	case allocationv1.GameServerAllocationContention:
+		out.State = pb.AllocationResponse_Contention
+	default:
+		out.State = pb.AllocationResponse_Unknown
+	}
+
+	for _, port := range gsa.Status.Ports {
+		out.Ports = append(out.Ports, &pb.AllocationResponse_GameServerStatusPort{Name: port.Name, Port: port.Port})
+	}
+
+	return out
+}
@@ -0,0 +1,232 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	pb "agones.dev/agones/pkg/allocation/go/v1alpha1"
+	"agones.dev/agones/pkg/apis"
+	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
+	allocationv1 "agones.dev/agones/pkg/apis/allocation/v1"
+	"agones.dev/agones/pkg/client/clientset/versioned"
+	agonesfake "agones.dev/agones/pkg/client/clientset/versioned/fake"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	k8serror "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/rest"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestGRPCAllocate(t *testing.T) {
+	t.Parallel()
+
+	fakeAgones := &agonesfake.Clientset{}
+	h := grpcHandler{agonesClient: fakeAgones}
+
+	fakeAgones.AddReactor("create", "gameserverallocations", func(action k8stesting.Action) (bool, k8sruntime.Object, error) {
+		gsa := action.(k8stesting.CreateAction).GetObject().(*allocationv1.GameServerAllocation)
+		assert.Equal(t, "default", gsa.ObjectMeta.Namespace)
+		assert.Equal(t, map[string]string{"mode": "ranked"}, gsa.Spec.Required.MatchLabels)
+		assert.Equal(t, []metav1.LabelSelectorRequirement{{Key: "version", Operator: metav1.LabelSelectorOpIn, Values: []string{"v12", "v13"}}},
+			gsa.Spec.Required.MatchExpressions)
+		if assert.Len(t, gsa.Spec.Preferred, 1) {
+			assert.Equal(t, map[string]string{"map": "dust"}, gsa.Spec.Preferred[0].MatchLabels)
+		}
+		assert.Equal(t, apis.Distributed, gsa.Spec.Scheduling)
+		assert.True(t, gsa.Spec.MultiClusterSetting.Enabled)
+		assert.Equal(t, map[string]string{"cluster": "eu"}, gsa.Spec.MultiClusterSetting.PolicySelector.MatchLabels)
+		assert.Equal(t, map[string]string{"session": "1234"}, gsa.Spec.MetaPatch.Labels)
+		assert.Equal(t, map[string]string{"players": "10"}, gsa.Spec.MetaPatch.Annotations)
+
+		gsa.Status = allocationv1.GameServerAllocationStatus{
+			State:          allocationv1.GameServerAllocationAllocated,
+			GameServerName: "gs1",
+			Ports:          []agonesv1.GameServerStatusPort{{Name: "default", Port: 7777}},
+			Address:        "10.0.0.1",
+			NodeName:       "node1",
+			Annotations:    map[string]string{"version": "1.2"},
+		}
+		return true, gsa, nil
+	})
+
+	required := &pb.LabelSelector{
+		MatchLabels:      map[string]string{"mode": "ranked"},
+		MatchExpressions: []*pb.LabelSelectorRequirement{{Key: "version", Operator: "In", Values: []string{"v12", "v13"}}},
+	}
+	resp, err := h.Allocate(context.Background(), &pb.AllocationRequest{
+		Namespace:                    "default",
+		RequiredGameServerSelector:   required,
+		PreferredGameServerSelectors: []*pb.LabelSelector{{MatchLabels: map[string]string{"map": "dust"}}},
+		Scheduling:                   pb.AllocationRequest_Distributed,
+		MultiClusterSetting: &pb.MultiClusterSetting{
+			Enabled:        true,
+			PolicySelector: &pb.LabelSelector{MatchLabels: map[string]string{"cluster": "eu"}},
+		},
+		MetaPatch: &pb.MetaPatch{
+			Labels:      map[string]string{"session": "1234"},
+			Annotations: map[string]string{"players": "10"},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &pb.AllocationResponse{
+		State:          pb.AllocationResponse_Allocated,
+		GameServerName: "gs1",
+		Ports:          []*pb.AllocationResponse_GameServerStatusPort{{Name: "default", Port: 7777}},
+		Address:        "10.0.0.1",
+		NodeName:       "node1",
+		Annotations:    map[string]string{"version": "1.2"},
+	}, resp)
+}
+
+func TestGRPCAllocateDefaults(t *testing.T) {
+	t.Parallel()
+
+	fakeAgones := &agonesfake.Clientset{}
+	h := grpcHandler{agonesClient: fakeAgones}
+
+	fakeAgones.AddReactor("create", "gameserverallocations", func(action k8stesting.Action) (bool, k8sruntime.Object, error) {
+		gsa := action.(k8stesting.CreateAction).GetObject().(*allocationv1.GameServerAllocation)
+		assert.Equal(t, apis.Packed, gsa.Spec.Scheduling)
+		assert.Empty(t, gsa.Spec.Required.MatchLabels)
+		assert.Empty(t, gsa.Spec.Preferred)
+		assert.False(t, gsa.Spec.MultiClusterSetting.Enabled)
+
+		gsa.Status.State = allocationv1.GameServerAllocationUnAllocated
+		return true, gsa, nil
+	})
+
+	resp, err := h.Allocate(context.Background(), &pb.AllocationRequest{Namespace: "default"})
+	assert.NoError(t, err)
+	assert.Equal(t, pb.AllocationResponse_UnAllocated, resp.State)
+}
+
+func TestGRPCAllocateReturnsError(t *testing.T) {
+	t.Parallel()
+
+	fakeAgones := &agonesfake.Clientset{}
+	h := grpcHandler{agonesClient: fakeAgones}
+
+	fakeAgones.AddReactor("create", "gameserverallocations", func(action k8stesting.Action) (bool, k8sruntime.Object, error) {
+		return true, nil, k8serror.NewBadRequest("error")
+	})
+
+	_, err := h.Allocate(context.Background(), &pb.AllocationRequest{Namespace: "default"})
+	assert.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGRPCCode(t *testing.T) {
+	t.Parallel()
+
+	gr := schema.GroupResource{Group: "allocation.agones.dev", Resource: "gameserverallocations"}
+	assert.Equal(t, codes.InvalidArgument, grpcCode(k8serror.NewBadRequest("error")))
+	assert.Equal(t, codes.NotFound, grpcCode(k8serror.NewNotFound(gr, "gsa")))
+	assert.Equal(t, codes.Aborted, grpcCode(k8serror.NewConflict(gr, "gsa", nil)))
+	assert.Equal(t, codes.Unavailable, grpcCode(k8serror.NewServiceUnavailable("error")))
+	assert.Equal(t, codes.Internal, grpcCode(errors.New("error")))
+}
+
+func TestGRPCHandlerFunc(t *testing.T) {
+	t.Parallel()
+
+	var served string
+	handler := func(name string) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			served = name
+		})
+	}
+	h := grpcHandlerFunc(handler("grpc"), handler("http"))
+
+	r := httptest.NewRequest(http.MethodPost, "/v1alpha1.AllocationService/Allocate", nil)
+	r.ProtoMajor = 2
+	r.Header.Set("Content-Type", "application/grpc+proto")
+	h.ServeHTTP(httptest.NewRecorder(), r)
+	assert.Equal(t, "grpc", served)
+
+	r = httptest.NewRequest(http.MethodPost, "/v1alpha1/gameserverallocation", nil)
+	r.ProtoMajor = 2
+	r.Header.Set("Content-Type", "application/json")
+	h.ServeHTTP(httptest.NewRecorder(), r)
+	assert.Equal(t, "http", served)
+
+	r = httptest.NewRequest(http.MethodPost, "/v1alpha1/gameserverallocation", nil)
+	r.Header.Set("Content-Type", "application/grpc")
+	h.ServeHTTP(httptest.NewRecorder(), r)
+	assert.Equal(t, "http", served)
+}
+
+func TestAllocationRequestProtoRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	required := &pb.LabelSelector{
+		MatchLabels:      map[string]string{"mode": "ranked", "map": "dust"},
+		MatchExpressions: []*pb.LabelSelectorRequirement{{Key: "version", Operator: "NotIn", Values: []string{"v11"}}},
+	}
+	in := &pb.AllocationRequest{
+		Namespace:                  "default",
+		RequiredGameServerSelector: required,
+		MetaPatch:                  &pb.MetaPatch{Labels: map[string]string{"session": "1234"}},
+		Scheduling:                 pb.AllocationRequest_Distributed,
+	}
+	b, err := proto.Marshal(in)
+	assert.NoError(t, err)
+
+	out := &pb.AllocationRequest{}
+	assert.NoError(t, proto.Unmarshal(b, out))
+	assert.True(t, proto.Equal(in, out))
+}
+
+func TestGRPCAllocateDeadline(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/apis/allocation.agones.dev/v1/namespaces/default/gameserverallocations", r.URL.Path)
+		timeout, err := time.ParseDuration(r.URL.Query().Get("timeout"))
+		assert.NoError(t, err)
+		assert.True(t, timeout > 0 && timeout <= time.Minute, "timeout %s should be the time left before the deadline", timeout)
+
+		gsa := allocationv1.GameServerAllocation{Status: allocationv1.GameServerAllocationStatus{State: allocationv1.GameServerAllocationAllocated}}
+		w.Header().Set("Content-Type", "application/json")
+		assert.NoError(t, json.NewEncoder(w).Encode(gsa))
+	}))
+	defer server.Close()
+
+	agonesClient, err := versioned.NewForConfig(&rest.Config{Host: server.URL})
+	assert.NoError(t, err)
+	h := grpcHandler{agonesClient: agonesClient}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	resp, err := h.Allocate(ctx, &pb.AllocationRequest{Namespace: "default"})
+	assert.NoError(t, err)
+	assert.Equal(t, pb.AllocationResponse_Allocated, resp.State)
+
+	ctx, cancel = context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancel()
+	_, err = h.Allocate(ctx, &pb.AllocationRequest{Namespace: "default"})
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+}
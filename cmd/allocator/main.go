@@ -24,8 +24,10 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"agones.dev/agones/pkg"
+	pb "agones.dev/agones/pkg/allocation/go/v1alpha1"
 	allocationv1 "agones.dev/agones/pkg/apis/allocation/v1"
 	"agones.dev/agones/pkg/client/clientset/versioned"
 	"agones.dev/agones/pkg/metrics"
@@ -36,6 +38,8 @@ import (
 	"github.com/spf13/viper"
 	"go.opencensus.io/plugin/ochttp"
 	"go.opencensus.io/stats/view"
+	"golang.org/x/net/http2"
+	"google.golang.org/grpc"
 	k8serror "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/client-go/rest"
 )
@@ -52,6 +56,12 @@ const (
 	enableStackdriverMetricsFlag = "stackdriver-exporter"
 	enablePrometheusMetricsFlag  = "prometheus-exporter"
 	projectIDFlag                = "gcp-project-id"
+	maxConcurrentStreamsFlag     = "http2-max-concurrent-streams"
+	idleTimeoutFlag              = "http-idle-timeout"
+	featureGatesFlag             = "feature-gates"
+	apiServerSustainedQPSFlag    = "api-server-qps"
+	apiServerBurstQPSFlag        = "api-server-qps-burst"
+	apiServerTimeoutFlag         = "api-server-timeout"
 )
 
 func init() {
@@ -63,6 +73,9 @@ type handler func(w http.ResponseWriter, r *http.Request)
 
 func main() {
 	conf := parseEnvFlags()
+	if err := conf.validate(); err != nil {
+		logger.WithError(err).Fatal("Could not create allocator from environment or flags")
+	}
 
 	logger.WithField("version", pkg.Version).
 		WithField("sslPort", sslPort).
@@ -74,7 +87,7 @@ func main() {
 	// http.DefaultServerMux is used for http connection, not for https
 	http.Handle("/", health)
 
-	agonesClient, err := getAgonesClient()
+	agonesClient, err := getAgonesClient(conf)
 	if err != nil {
 		logger.WithError(err).Fatal("could not create agones client")
 	}
@@ -90,6 +103,10 @@ func main() {
 		agonesClient: agonesClient,
 	}
 
+	// gRPC server to serve gameserver allocations, on the same port as the https server
+	grpcServer := grpc.NewServer()
+	pb.RegisterAllocationServiceServer(grpcServer, &grpcHandler{agonesClient: agonesClient})
+
 	// mux for https server to serve gameserver allocations
 	httpsMux := http.NewServeMux()
 	httpsMux.HandleFunc("/v1alpha1/gameserverallocation", h.postOnly(h.allocateHandler))
@@ -104,12 +121,20 @@ func main() {
 		ClientCAs:  caCertPool,
 	}
 	srv := &http.Server{
-		Addr:      ":" + sslPort,
-		TLSConfig: cfg,
+		Addr:        ":" + sslPort,
+		TLSConfig:   cfg,
+		IdleTimeout: conf.IdleTimeout,
 		// add http OC metrics (opencensus.io/http/server/*)
-		Handler: &ochttp.Handler{
-			Handler: httpsMux,
-		},
+		Handler: grpcHandlerFunc(grpcServer, &ochttp.Handler{
+			Handler: compressionHandler(httpsMux),
+		}),
+	}
+	err = http2.ConfigureServer(srv, &http2.Server{
+		MaxConcurrentStreams: conf.MaxConcurrentStreams,
+		IdleTimeout:          conf.IdleTimeout,
+	})
+	if err != nil {
+		logger.WithError(err).Fatal("could not configure http2")
 	}
 
 	// listen on https to serve allocations
@@ -126,12 +151,15 @@ func main() {
 }
 
 // Set up our client which we will use to call the API
-func getAgonesClient() (*versioned.Clientset, error) {
+func getAgonesClient(conf config) (*versioned.Clientset, error) {
 	// Create the in-cluster config
 	config, err := rest.InClusterConfig()
 	if err != nil {
 		return nil, errors.New("Could not create in cluster config")
 	}
+	config.QPS = float32(conf.APIServerSustainedQPS)
+	config.Burst = conf.APIServerBurstQPS
+	config.Timeout = conf.APIServerTimeout
 
 	// Access to the Agones resources through the Agones Clientset
 	agonesClient, err := versioned.NewForConfig(config)
@@ -217,9 +245,14 @@ func httpCode(err error) int {
 }
 
 type config struct {
-	PrometheusMetrics bool
-	Stackdriver       bool
-	GCPProjectID      string
+	PrometheusMetrics     bool
+	Stackdriver           bool
+	GCPProjectID          string
+	MaxConcurrentStreams  uint32
+	IdleTimeout           time.Duration
+	APIServerSustainedQPS int
+	APIServerBurstQPS     int
+	APIServerTimeout      time.Duration
 }
 
 func parseEnvFlags() config {
@@ -227,23 +260,61 @@ func parseEnvFlags() config {
 	viper.SetDefault(enablePrometheusMetricsFlag, true)
 	viper.SetDefault(enableStackdriverMetricsFlag, false)
 	viper.SetDefault(projectIDFlag, "")
+	viper.SetDefault(maxConcurrentStreamsFlag, 250)
+	viper.SetDefault(idleTimeoutFlag, 90*time.Second)
+	viper.SetDefault(featureGatesFlag, "")
+	viper.SetDefault(apiServerSustainedQPSFlag, 5)
+	viper.SetDefault(apiServerBurstQPSFlag, 10)
+	viper.SetDefault(apiServerTimeoutFlag, time.Duration(0))
 
 	pflag.Bool(enablePrometheusMetricsFlag, viper.GetBool(enablePrometheusMetricsFlag), "Flag to activate metrics of Agones. Can also use PROMETHEUS_EXPORTER env variable.")
 	pflag.Bool(enableStackdriverMetricsFlag, viper.GetBool(enableStackdriverMetricsFlag), "Flag to activate stackdriver monitoring metrics for Agones. Can also use STACKDRIVER_EXPORTER env variable.")
 	pflag.String(projectIDFlag, viper.GetString(projectIDFlag), "GCP ProjectID used for Stackdriver, if not specified ProjectID from Application Default Credentials would be used. Can also use GCP_PROJECT_ID env variable.")
+	pflag.Int(maxConcurrentStreamsFlag, viper.GetInt(maxConcurrentStreamsFlag), "Maximum number of concurrent HTTP/2 streams per client connection. Can also use HTTP2_MAX_CONCURRENT_STREAMS env variable.")
+	pflag.Duration(idleTimeoutFlag, viper.GetDuration(idleTimeoutFlag), "How long an idle client connection is kept open for. Can also use HTTP_IDLE_TIMEOUT env variable.")
+	pflag.Int32(apiServerSustainedQPSFlag, viper.GetInt32(apiServerSustainedQPSFlag), "Maximum sustained queries per second to send to the API server. Can also use API_SERVER_QPS env variable.")
+	pflag.Int32(apiServerBurstQPSFlag, viper.GetInt32(apiServerBurstQPSFlag), "Maximum burst queries per second to send to the API server. Can also use API_SERVER_QPS_BURST env variable.")
+	pflag.Duration(apiServerTimeoutFlag, viper.GetDuration(apiServerTimeoutFlag), "Timeout of each request to the API server. 0 disables. Can also use API_SERVER_TIMEOUT env variable.")
+	pflag.String(featureGatesFlag, viper.GetString(featureGatesFlag), "Optional. Comma separated Feature=true|false pairs to switch features on or off, e.g. Chaos=true. Can also use FEATURE_GATES env variable.")
 	pflag.Parse()
 
 	viper.SetEnvKeyReplacer(strings.NewReplacer("-", "_"))
 	runtime.Must(viper.BindEnv(enablePrometheusMetricsFlag))
 	runtime.Must(viper.BindEnv(enableStackdriverMetricsFlag))
 	runtime.Must(viper.BindEnv(projectIDFlag))
+	runtime.Must(viper.BindEnv(maxConcurrentStreamsFlag))
+	runtime.Must(viper.BindEnv(idleTimeoutFlag))
+	runtime.Must(viper.BindEnv(featureGatesFlag))
+	runtime.Must(viper.BindEnv(apiServerSustainedQPSFlag))
+	runtime.Must(viper.BindEnv(apiServerBurstQPSFlag))
+	runtime.Must(viper.BindEnv(apiServerTimeoutFlag))
 	runtime.Must(viper.BindPFlags(pflag.CommandLine))
 
+	if err := runtime.ParseFeatures(viper.GetString(featureGatesFlag)); err != nil {
+		logger.WithError(err).Fatalf("could not parse %s", featureGatesFlag)
+	}
+
 	return config{
-		PrometheusMetrics: viper.GetBool(enablePrometheusMetricsFlag),
-		Stackdriver:       viper.GetBool(enableStackdriverMetricsFlag),
-		GCPProjectID:      viper.GetString(projectIDFlag),
+		PrometheusMetrics:     viper.GetBool(enablePrometheusMetricsFlag),
+		Stackdriver:           viper.GetBool(enableStackdriverMetricsFlag),
+		GCPProjectID:          viper.GetString(projectIDFlag),
+		MaxConcurrentStreams:  uint32(viper.GetInt(maxConcurrentStreamsFlag)),
+		IdleTimeout:           viper.GetDuration(idleTimeoutFlag),
+		APIServerSustainedQPS: int(viper.GetInt32(apiServerSustainedQPSFlag)),
+		APIServerBurstQPS:     int(viper.GetInt32(apiServerBurstQPSFlag)),
+		APIServerTimeout:      viper.GetDuration(apiServerTimeoutFlag),
+	}
+}
+
+// validate ensures the config is valid.
+func (c config) validate() error {
+	if c.APIServerSustainedQPS <= 0 || c.APIServerBurstQPS <= 0 {
+		return errors.New("api server qps and qps burst must be positive")
+	}
+	if c.APIServerTimeout < 0 {
+		return errors.New("api server timeout cannot be negative")
 	}
+	return nil
 }
 
 func registerMetricViews() {
@@ -21,6 +21,7 @@ import (
 	"net/http/httptest"
 	"os"
 	"testing"
+	"time"
 
 	allocationv1 "agones.dev/agones/pkg/apis/allocation/v1"
 	agonesfake "agones.dev/agones/pkg/client/clientset/versioned/fake"
@@ -134,3 +135,22 @@ x+3avw9KSlXqR/mCAkePyoKvprxeb6RVDdq92Ug0qzoAHLpvIkuHdlF0dNp6/kO0
 pwlCqZx4M8FpdfCbOZeRLzClUBdD5qzev0L3RNUx7UJzEIN+4LCBv37DIojNOyA=
 -----END CERTIFICATE-----
 `
+
+func TestConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	valid := config{APIServerSustainedQPS: 5, APIServerBurstQPS: 10, APIServerTimeout: time.Second}
+	assert.NoError(t, valid.validate())
+
+	fixtures := map[string]func(c *config){
+		"zero qps":         func(c *config) { c.APIServerSustainedQPS = 0 },
+		"negative burst":   func(c *config) { c.APIServerBurstQPS = -1 },
+		"negative timeout": func(c *config) { c.APIServerTimeout = -time.Second },
+	}
+
+	for k, v := range fixtures {
+		c := valid
+		v(&c)
+		assert.Error(t, c.validate(), k)
+	}
+}
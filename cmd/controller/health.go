@@ -0,0 +1,101 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"github.com/heptiolabs/healthcheck"
+)
+
+// healthHandler is a healthcheck.Handler that adds the last sync time of
+// each registered runner to the full (?full=1) output of the /live and /ready
+// endpoints, so that a wedged controller can be identified when the pod
+// reports as unhealthy
+type healthHandler struct {
+	healthcheck.Handler
+	mutex    sync.RWMutex
+	lastSync map[string]func() time.Time
+}
+
+// healthCheckResult is the full output of a single health check
+type healthCheckResult struct {
+	Status   string     `json:"status"`
+	LastSync *time.Time `json:"lastSync,omitempty"`
+}
+
+func newHealthHandler(handler healthcheck.Handler) *healthHandler {
+	return &healthHandler{Handler: handler, lastSync: map[string]func() time.Time{}}
+}
+
+// AddLastSync registers a function that returns the last time the
+// named check successfully synced
+func (h *healthHandler) AddLastSync(name string, lastSync func() time.Time) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	h.lastSync[name] = lastSync
+}
+
+// ServeHTTP serves the wrapped handler, adding last sync times to the
+// full results of health checks
+func (h *healthHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if (r.URL.Path != "/live" && r.URL.Path != "/ready") || r.URL.Query().Get("full") != "1" {
+		h.Handler.ServeHTTP(w, r)
+		return
+	}
+
+	rec := httptest.NewRecorder()
+	h.Handler.ServeHTTP(rec, r)
+
+	checks := map[string]string{}
+	if err := json.Unmarshal(rec.Body.Bytes(), &checks); err != nil {
+		// not a set of check results, so pass it through as is
+		copyHeader(w.Header(), rec.Header())
+		w.WriteHeader(rec.Code)
+		_, _ = w.Write(rec.Body.Bytes())
+		return
+	}
+
+	results := make(map[string]healthCheckResult, len(checks))
+	h.mutex.RLock()
+	for name, status := range checks {
+		result := healthCheckResult{Status: status}
+		if f, ok := h.lastSync[name]; ok {
+			if t := f(); !t.IsZero() {
+				result.LastSync = &t
+			}
+		}
+		results[name] = result
+	}
+	h.mutex.RUnlock()
+
+	copyHeader(w.Header(), rec.Header())
+	w.WriteHeader(rec.Code)
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "    ")
+	if err := encoder.Encode(results); err != nil {
+		logger.WithError(err).Error("could not write health check results")
+	}
+}
+
+func copyHeader(dst, src http.Header) {
+	for k, v := range src {
+		dst[k] = v
+	}
+}
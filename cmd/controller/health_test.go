@@ -0,0 +1,68 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/heptiolabs/healthcheck"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHealthHandler(t *testing.T) {
+	t.Parallel()
+
+	lastSync := time.Date(2019, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	h := newHealthHandler(healthcheck.NewHandler())
+	h.AddLivenessCheck("synced", func() error { return nil })
+	h.AddLastSync("synced", func() time.Time { return lastSync })
+	h.AddLivenessCheck("never-synced", func() error { return nil })
+	h.AddLastSync("never-synced", func() time.Time { return time.Time{} })
+	h.AddReadinessCheck("not-ready", func() error { return errors.New("not started") })
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/live?full=1", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	results := map[string]healthCheckResult{}
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &results))
+	assert.Len(t, results, 2)
+	assert.Equal(t, "OK", results["synced"].Status)
+	if assert.NotNil(t, results["synced"].LastSync) {
+		assert.True(t, lastSync.Equal(*results["synced"].LastSync))
+	}
+	assert.Equal(t, "OK", results["never-synced"].Status)
+	assert.Nil(t, results["never-synced"].LastSync)
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/ready?full=1", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	results = map[string]healthCheckResult{}
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &results))
+	assert.Len(t, results, 3)
+	assert.Equal(t, "not started", results["not-ready"].Status)
+
+	// without full, the body is left as is
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/live", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "{}\n", rec.Body.String())
+}
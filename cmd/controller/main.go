@@ -24,19 +24,25 @@ import (
 	"time"
 
 	"agones.dev/agones/pkg"
+	"agones.dev/agones/pkg/apis"
 	"agones.dev/agones/pkg/client/clientset/versioned"
 	"agones.dev/agones/pkg/client/informers/externalversions"
 	"agones.dev/agones/pkg/fleetautoscalers"
 	"agones.dev/agones/pkg/fleets"
 	"agones.dev/agones/pkg/gameserverallocations"
+	"agones.dev/agones/pkg/gameserverdeletions"
 	"agones.dev/agones/pkg/gameservers"
 	"agones.dev/agones/pkg/gameserversets"
 	"agones.dev/agones/pkg/metrics"
+	"agones.dev/agones/pkg/sizelimits"
 	"agones.dev/agones/pkg/util/apiserver"
+	"agones.dev/agones/pkg/util/chaos"
 	"agones.dev/agones/pkg/util/https"
 	"agones.dev/agones/pkg/util/runtime"
 	"agones.dev/agones/pkg/util/signals"
 	"agones.dev/agones/pkg/util/webhooks"
+	"agones.dev/agones/pkg/util/workerqueue"
+	"agones.dev/agones/pkg/webhookcerts"
 	"github.com/heptiolabs/healthcheck"
 	"github.com/pkg/errors"
 	prom "github.com/prometheus/client_golang/prometheus"
@@ -44,10 +50,17 @@ import (
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
 	"gopkg.in/natefinch/lumberjack.v2"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	extclientset "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 )
 
@@ -55,22 +68,65 @@ const (
 	enableStackdriverMetricsFlag = "stackdriver-exporter"
 	enablePrometheusMetricsFlag  = "prometheus-exporter"
 	projectIDFlag                = "gcp-project-id"
+	nodeHourlyCostFlag           = "node-hourly-cost"
+	metricsLabelsFlag            = "metrics-labels"
+	metricsLabelMaxValuesFlag    = "metrics-label-max-values"
 	sidecarImageFlag             = "sidecar-image"
 	sidecarCPURequestFlag        = "sidecar-cpu-request"
 	sidecarCPULimitFlag          = "sidecar-cpu-limit"
+	sidecarMemoryRequestFlag     = "sidecar-memory-request"
+	sidecarMemoryLimitFlag       = "sidecar-memory-limit"
+	sidecarPodSnippetFlag        = "sidecar-pod-snippet"
 	sdkServerAccountFlag         = "sdk-service-account"
+	finalizerTimeoutFlag         = "finalizer-timeout"
+	clockSkewToleranceFlag       = "clock-skew-tolerance"
+	fleetEventSummaryPeriodFlag  = "fleet-event-summary-period"
+	maxReplacementRateFlag       = "max-unhealthy-replacement-rate"
+	gameServerDeletionRateFlag   = "gameserver-deletion-rate"
+	maxAnnotationBytesFlag       = "max-annotation-bytes"
+	maxTemplateBytesFlag         = "max-template-bytes"
+	fleetDefaultReplicasFlag     = "fleet-default-replicas"
+	fleetDefaultSchedulingFlag   = "fleet-default-scheduling"
+	fleetDefaultStrategyFlag     = "fleet-default-strategy"
+	fleetDefaultMaxSurgeFlag     = "fleet-default-max-surge"
+	fleetDefaultMaxUnavailFlag   = "fleet-default-max-unavailable"
+	fleetNetworkPoliciesFlag     = "fleet-network-policies"
+	gameServerNodeLabelsFlag     = "gameserver-node-labels"
+	sdkServiceAccountsFlag       = "sdk-server-service-accounts"
+	gameServerEnvFlag            = "gameserver-env"
+	safeToEvictFlag              = "safe-to-evict-annotation"
+	gameServerFastRetryDelayFlag = "gameserver-fast-retry-delay"
+	gameServerSlowRetryDelayFlag = "gameserver-slow-retry-delay"
+	gameServerFastRetriesFlag    = "gameserver-fast-retries"
 	pullSidecarFlag              = "always-pull-sidecar"
 	minPortFlag                  = "min-port"
 	maxPortFlag                  = "max-port"
+	portRangeConfigMapFlag       = "port-range-configmap"
 	certFileFlag                 = "cert-file"
 	keyFileFlag                  = "key-file"
+	certReloadIntervalFlag       = "cert-reload-interval"
+	selfManagedTLSFlag           = "self-managed-tls"
+	podNamespaceEnv              = "POD_NAMESPACE"
 	numWorkersFlag               = "num-workers"
+	gameServerWorkersFlag        = "gameserver-workers"
+	gameServerSetWorkersFlag     = "gameserverset-workers"
+	fleetWorkersFlag             = "fleet-workers"
+	fleetAutoscalerWorkersFlag   = "fleetautoscaler-workers"
+	resyncPeriodFlag             = "resync-period"
 	apiServerSustainedQPSFlag    = "api-server-qps"
 	apiServerBurstQPSFlag        = "api-server-qps-burst"
+	apiServerTimeoutFlag         = "api-server-timeout"
 	logDirFlag                   = "log-dir"
 	logSizeLimitMBFlag           = "log-size-limit-mb"
 	kubeconfigFlag               = "kubeconfig"
-	defaultResync                = 30 * time.Second
+	featureGatesFlag             = "feature-gates"
+	chaosPodCreationDelayFlag    = "chaos-pod-creation-delay"
+	chaosUpdateFailuresFlag      = "chaos-update-failure-percentage"
+
+	// the Service of the webhooks and APIService of the controller, and the Secret
+	// its certificates are kept in, when it manages them itself
+	controllerService      = "agones-controller-service"
+	selfManagedCertsSecret = "agones-controller-self-managed-cert"
 )
 
 var (
@@ -119,6 +175,22 @@ func main() {
 	clientConf.QPS = float32(ctlConf.APIServerSustainedQPS)
 	clientConf.Burst = ctlConf.APIServerBurstQPS
 
+	if ctlConf.Chaos.Enabled() {
+		logger.WithField("chaos", ctlConf.Chaos).Warn("Injecting failures into API server requests. Never do this in production!")
+		wrap := clientConf.WrapTransport
+		clientConf.WrapTransport = func(rt http.RoundTripper) http.RoundTripper {
+			if wrap != nil {
+				rt = wrap(rt)
+			}
+			return ctlConf.Chaos.WrapTransport(rt)
+		}
+	}
+
+	// The informers' watches are long lived, so they get their own clients without the request timeout,
+	// which becomes the timeout of the whole http.Client, and would otherwise cut every watch short.
+	informerConf := rest.CopyConfig(clientConf)
+	clientConf.Timeout = ctlConf.APIServerTimeout
+
 	kubeClient, err := kubernetes.NewForConfig(clientConf)
 	if err != nil {
 		logger.WithError(err).Fatal("Could not create the kubernetes clientset")
@@ -134,17 +206,44 @@ func main() {
 		logger.WithError(err).Fatal("Could not create the agones api clientset")
 	}
 
+	kubeInformerClient, err := kubernetes.NewForConfig(informerConf)
+	if err != nil {
+		logger.WithError(err).Fatal("Could not create the kubernetes clientset for the informers")
+	}
+
+	agonesInformerClient, err := versioned.NewForConfig(informerConf)
+	if err != nil {
+		logger.WithError(err).Fatal("Could not create the agones api clientset for the informers")
+	}
+
+	if ctlConf.PortRangeConfigMap != "" {
+		if err := ctlConf.loadPortRange(kubeClient); err != nil {
+			logger.WithError(err).Fatal("Could not read the port range ConfigMap")
+		}
+	}
+
 	// https server and the items that share the Mux for routing
-	httpsServer := https.NewServer(ctlConf.CertFile, ctlConf.KeyFile)
+	certFile, keyFile := ctlConf.CertFile, ctlConf.KeyFile
+	var certController *webhookcerts.Controller
+	if ctlConf.SelfManagedTLS {
+		certController = webhookcerts.NewController(controllerService, ctlConf.Namespace, selfManagedCertsSecret,
+			filepath.Join(os.TempDir(), "agones-certs"), kubeClient)
+		if err := certController.Sync(); err != nil {
+			logger.WithError(err).Fatal("Could not set up the self managed webhook certificates")
+		}
+		certFile, keyFile = certController.CertFile(), certController.KeyFile()
+	}
+
+	httpsServer := https.NewServer(certFile, keyFile, ctlConf.CertReloadInterval)
 	wh := webhooks.NewWebHook(httpsServer.Mux)
 	api := apiserver.NewAPIServer(httpsServer.Mux)
 
-	agonesInformerFactory := externalversions.NewSharedInformerFactory(agonesClient, defaultResync)
-	kubeInformerFactory := informers.NewSharedInformerFactory(kubeClient, defaultResync)
+	agonesInformerFactory := externalversions.NewSharedInformerFactory(agonesInformerClient, ctlConf.ResyncPeriod)
+	kubeInformerFactory := informers.NewSharedInformerFactory(kubeInformerClient, ctlConf.ResyncPeriod)
 
 	server := &httpServer{}
 	var rs []runner
-	var health healthcheck.Handler
+	var health *healthHandler
 
 	// Stackdriver metrics
 	if ctlConf.Stackdriver {
@@ -164,19 +263,26 @@ func main() {
 			logger.WithError(err).Fatal("Could not register prometheus exporter")
 		}
 		server.Handle("/metrics", metricHandler)
-		health = healthcheck.NewMetricsHandler(registry, "agones")
+		health = newHealthHandler(healthcheck.NewMetricsHandler(registry, "agones"))
 	} else {
-		health = healthcheck.NewHandler()
+		health = newHealthHandler(healthcheck.NewHandler())
 	}
 
 	// If we are using Prometheus only exporter we can make reporting more often,
 	// every 1 seconds, if we are using Stackdriver we would use 60 seconds reporting period,
 	// which is a requirements of Stackdriver, otherwise most of time series would be invalid for Stackdriver
 	metrics.SetReportingPeriod(ctlConf.PrometheusMetrics, ctlConf.Stackdriver)
+	exportedLabels, err := metrics.NewExportedLabels(ctlConf.MetricsLabels, ctlConf.MetricsLabelMaxValues)
+	if err != nil {
+		logger.WithError(err).Fatalf("could not export %s as metric tags", metricsLabelsFlag)
+	}
+	metrics.SetExportedLabels(exportedLabels)
 
 	// Add metrics controller only if we configure one of metrics exporters
 	if ctlConf.PrometheusMetrics || ctlConf.Stackdriver {
-		rs = append(rs, metrics.NewController(kubeClient, agonesClient, kubeInformerFactory, agonesInformerFactory))
+		metricsController := metrics.NewController(ctlConf.NodeCostModel, exportedLabels, kubeClient, agonesClient, kubeInformerFactory, agonesInformerFactory)
+		server.Handle("/fleets", metricsController.FleetsOverviewHandler())
+		rs = append(rs, metricsController)
 	}
 
 	server.Handle("/", health)
@@ -185,17 +291,34 @@ func main() {
 
 	gsController := gameservers.NewController(wh, health,
 		ctlConf.MinPort, ctlConf.MaxPort, ctlConf.SidecarImage, ctlConf.AlwaysPullSidecar,
-		ctlConf.SidecarCPURequest, ctlConf.SidecarCPULimit, ctlConf.SdkServiceAccount,
+		ctlConf.SidecarCPURequest, ctlConf.SidecarCPULimit, ctlConf.SidecarMemoryRequest, ctlConf.SidecarMemoryLimit, ctlConf.SdkServiceAccount, ctlConf.SdkServiceAccounts, ctlConf.SidecarPodSnippet, ctlConf.GameServerEnv,
+		ctlConf.FinalizerTimeout, ctlConf.ClockSkewTolerance, ctlConf.GameServerNodeLabels, ctlConf.SafeToEvictAnnotation, ctlConf.GameServerRateLimiter, kubeClient, kubeInformerFactory, extClient, agonesClient, agonesInformerFactory)
+	gsSetController := gameserversets.NewController(wh, health, gsCounter, ctlConf.FleetEventSummaryPeriod > 0, ctlConf.MaxReplacementRate,
 		kubeClient, kubeInformerFactory, extClient, agonesClient, agonesInformerFactory)
-	gsSetController := gameserversets.NewController(wh, health, gsCounter,
-		kubeClient, extClient, agonesClient, agonesInformerFactory)
-	fleetController := fleets.NewController(wh, health, kubeClient, extClient, agonesClient, agonesInformerFactory)
-	gasController := gameserverallocations.NewController(api, health, gsCounter, kubeClient, kubeInformerFactory, agonesClient, agonesInformerFactory)
+	fleetController := fleets.NewController(wh, health, ctlConf.FleetDefaults, ctlConf.FleetNetworkPolicies,
+		gsController.PortRange, kubeClient, kubeInformerFactory, extClient, agonesClient, agonesInformerFactory)
+	gasController := gameserverallocations.NewController(api, health, gsCounter, gsController.PortAllocatorSynced, kubeClient, kubeInformerFactory, agonesClient, agonesInformerFactory)
+	sizelimits.NewValidator(wh, ctlConf.MaxAnnotationBytes, ctlConf.MaxTemplateBytes)
+	gsdController := gameserverdeletions.NewController(api, gsCounter, ctlConf.GameServerDeletionRate, kubeClient, agonesClient, agonesInformerFactory)
 	fasController := fleetautoscalers.NewController(wh, health,
 		kubeClient, extClient, agonesClient, agonesInformerFactory)
 
+	// Summarize the GameServer events of Fleets on the Fleet, instead of recording them one by one
+	if ctlConf.FleetEventSummaryPeriod > 0 {
+		rs = append(rs, fleets.NewEventSummaryController(ctlConf.FleetEventSummaryPeriod, kubeClient, agonesInformerFactory))
+	}
+
+	if certController != nil {
+		rs = append(rs, certController)
+	}
+
+	if ctlConf.PortRangeConfigMap != "" {
+		rs = append(rs, gameservers.NewPortRangeWatcher(ctlConf.PortRangeConfigMap, ctlConf.Namespace, gsController, kubeClient))
+	}
+
 	rs = append(rs,
-		httpsServer, gsCounter, gsController, gsSetController, fleetController, fasController, gasController, server)
+		httpsServer, gsCounter, withWorkers(gsController, ctlConf.GameServerWorkers), withWorkers(gsSetController, ctlConf.GameServerSetWorkers),
+		withWorkers(fleetController, ctlConf.FleetWorkers), withWorkers(fasController, ctlConf.FleetAutoscalerWorkers), gasController, gsdController, server)
 
 	stop := signals.NewStopChannel()
 
@@ -224,59 +347,173 @@ func parseEnvFlags() config {
 	viper.SetDefault(sidecarImageFlag, "gcr.io/agones-images/agones-sdk:"+pkg.Version)
 	viper.SetDefault(sidecarCPURequestFlag, "0")
 	viper.SetDefault(sidecarCPULimitFlag, "0")
+	viper.SetDefault(sidecarMemoryRequestFlag, "0")
+	viper.SetDefault(sidecarMemoryLimitFlag, "0")
 	viper.SetDefault(pullSidecarFlag, false)
+	viper.SetDefault(sidecarPodSnippetFlag, "")
 	viper.SetDefault(sdkServerAccountFlag, "agones-sdk")
+	viper.SetDefault(finalizerTimeoutFlag, time.Duration(0))
+	viper.SetDefault(clockSkewToleranceFlag, time.Duration(0))
+	viper.SetDefault(fleetEventSummaryPeriodFlag, time.Duration(0))
+	viper.SetDefault(maxReplacementRateFlag, 0.0)
+	viper.SetDefault(gameServerDeletionRateFlag, 10.0)
+	viper.SetDefault(maxAnnotationBytesFlag, 64*1024)
+	viper.SetDefault(maxTemplateBytesFlag, 256*1024)
+	viper.SetDefault(fleetDefaultReplicasFlag, 0)
+	viper.SetDefault(fleetDefaultSchedulingFlag, string(apis.Packed))
+	viper.SetDefault(fleetDefaultStrategyFlag, string(appsv1.RollingUpdateDeploymentStrategyType))
+	viper.SetDefault(fleetDefaultMaxSurgeFlag, "25%")
+	viper.SetDefault(fleetDefaultMaxUnavailFlag, "25%")
+	viper.SetDefault(fleetNetworkPoliciesFlag, false)
+	viper.SetDefault(gameServerNodeLabelsFlag, "")
+	viper.SetDefault(sdkServiceAccountsFlag, "")
+	viper.SetDefault(gameServerEnvFlag, "")
+	viper.SetDefault(safeToEvictFlag, true)
+	viper.SetDefault(gameServerFastRetryDelayFlag, 20*time.Millisecond)
+	viper.SetDefault(gameServerSlowRetryDelayFlag, 500*time.Millisecond)
+	viper.SetDefault(gameServerFastRetriesFlag, 5)
 	viper.SetDefault(certFileFlag, filepath.Join(base, "certs/server.crt"))
 	viper.SetDefault(keyFileFlag, filepath.Join(base, "certs/server.key"))
+	viper.SetDefault(certReloadIntervalFlag, time.Duration(0))
+	viper.SetDefault(selfManagedTLSFlag, false)
 	viper.SetDefault(enablePrometheusMetricsFlag, true)
 	viper.SetDefault(enableStackdriverMetricsFlag, false)
 	viper.SetDefault(projectIDFlag, "")
+	viper.SetDefault(nodeHourlyCostFlag, "")
+	viper.SetDefault(metricsLabelsFlag, "")
+	viper.SetDefault(metricsLabelMaxValuesFlag, 20)
 	viper.SetDefault(numWorkersFlag, 64)
+	viper.SetDefault(gameServerWorkersFlag, 0)
+	viper.SetDefault(gameServerSetWorkersFlag, 0)
+	viper.SetDefault(fleetWorkersFlag, 0)
+	viper.SetDefault(fleetAutoscalerWorkersFlag, 0)
+	viper.SetDefault(resyncPeriodFlag, 30*time.Second)
 	viper.SetDefault(apiServerSustainedQPSFlag, 100)
 	viper.SetDefault(apiServerBurstQPSFlag, 200)
+	viper.SetDefault(apiServerTimeoutFlag, time.Duration(0))
 	viper.SetDefault(logDirFlag, "")
 	viper.SetDefault(logSizeLimitMBFlag, 10000) // 10 GB, will be split into 100 MB chunks
+	viper.SetDefault(featureGatesFlag, "")
+	viper.SetDefault(chaosPodCreationDelayFlag, time.Duration(0))
+	viper.SetDefault(chaosUpdateFailuresFlag, 0.0)
 
 	pflag.String(sidecarImageFlag, viper.GetString(sidecarImageFlag), "Flag to overwrite the GameServer sidecar image that is used. Can also use SIDECAR env variable")
 	pflag.String(sidecarCPULimitFlag, viper.GetString(sidecarCPULimitFlag), "Flag to overwrite the GameServer sidecar container's cpu limit. Can also use SIDECAR_CPU_LIMIT env variable")
 	pflag.String(sidecarCPURequestFlag, viper.GetString(sidecarCPURequestFlag), "Flag to overwrite the GameServer sidecar container's cpu request. Can also use SIDECAR_CPU_REQUEST env variable")
+	pflag.String(sidecarMemoryLimitFlag, viper.GetString(sidecarMemoryLimitFlag), "Flag to overwrite the GameServer sidecar container's memory limit. Can also use SIDECAR_MEMORY_LIMIT env variable")
+	pflag.String(sidecarMemoryRequestFlag, viper.GetString(sidecarMemoryRequestFlag), "Flag to overwrite the GameServer sidecar container's memory request. Can also use SIDECAR_MEMORY_REQUEST env variable")
+	pflag.String(sidecarPodSnippetFlag, viper.GetString(sidecarPodSnippetFlag), "Optional. Path to a yaml file with containers, and their volumes, to add to every GameServer Pod, e.g. a log shipper. Can also use SIDECAR_POD_SNIPPET env variable")
 	pflag.Bool(pullSidecarFlag, viper.GetBool(pullSidecarFlag), "For development purposes, set the sidecar image to have a ImagePullPolicy of Always. Can also use ALWAYS_PULL_SIDECAR env variable")
 	pflag.String(sdkServerAccountFlag, viper.GetString(sdkServerAccountFlag), "Overwrite what service account default for GameServer Pods. Defaults to Can also use SDK_SERVICE_ACCOUNT")
+	pflag.Duration(finalizerTimeoutFlag, viper.GetDuration(finalizerTimeoutFlag), "Optional. How long a GameServer can be stuck in deletion before its finalizer is force removed. 0 disables. Can also use FINALIZER_TIMEOUT env variable")
+	pflag.Duration(clockSkewToleranceFlag, viper.GetDuration(clockSkewToleranceFlag), "Optional. Slack added to timeouts measured from timestamps set by the Kubernetes API server, to tolerate clock skew between it and the controller. Can also use CLOCK_SKEW_TOLERANCE env variable")
+	pflag.Duration(fleetEventSummaryPeriodFlag, viper.GetDuration(fleetEventSummaryPeriodFlag), "Optional. How often the GameServer events of each Fleet are summarized into a single Fleet event, instead of recording an event per GameServer. 0 disables. Can also use FLEET_EVENT_SUMMARY_PERIOD env variable")
+	pflag.Float64(maxReplacementRateFlag, viper.GetFloat64(maxReplacementRateFlag), "Optional. Maximum number of Unhealthy or Error GameServers replaced per second, across all GameServerSets. 0 is unlimited. Can also use MAX_UNHEALTHY_REPLACEMENT_RATE env variable")
+	pflag.Float64(gameServerDeletionRateFlag, viper.GetFloat64(gameServerDeletionRateFlag), "Optional. Maximum number of GameServers shut down per second by GameServerDeletions. 0 is unlimited. Can also use GAMESERVER_DELETION_RATE env variable")
+	pflag.Int(maxAnnotationBytesFlag, viper.GetInt(maxAnnotationBytesFlag), "Optional. Maximum total size of the annotations of a GameServer, GameServerSet or Fleet, and of its template, in bytes. 0 is unlimited. Can also use MAX_ANNOTATION_BYTES env variable")
+	pflag.Int(maxTemplateBytesFlag, viper.GetInt(maxTemplateBytesFlag), "Optional. Maximum size of the template of a GameServer, GameServerSet or Fleet, as JSON, in bytes. 0 is unlimited. Can also use MAX_TEMPLATE_BYTES env variable")
+	pflag.Int32(fleetDefaultReplicasFlag, 0, "Optional. Replicas of the Fleets that are created without setting them. Can also use FLEET_DEFAULT_REPLICAS env variable")
+	pflag.String(fleetDefaultSchedulingFlag, viper.GetString(fleetDefaultSchedulingFlag), "Optional. Scheduling strategy, Packed or Distributed, of the Fleets that are created without setting it. Can also use FLEET_DEFAULT_SCHEDULING env variable")
+	pflag.String(fleetDefaultStrategyFlag, viper.GetString(fleetDefaultStrategyFlag), "Optional. Update strategy, RollingUpdate or Recreate, of the Fleets that are created without setting it. Can also use FLEET_DEFAULT_STRATEGY env variable")
+	pflag.String(fleetDefaultMaxSurgeFlag, viper.GetString(fleetDefaultMaxSurgeFlag), "Optional. Rolling update max surge, as a number or percentage, of the Fleets that are created without setting it. Can also use FLEET_DEFAULT_MAX_SURGE env variable")
+	pflag.String(fleetDefaultMaxUnavailFlag, viper.GetString(fleetDefaultMaxUnavailFlag), "Optional. Rolling update max unavailable, as a number or percentage, of the Fleets that are created without setting it. Can also use FLEET_DEFAULT_MAX_UNAVAILABLE env variable")
+	pflag.Bool(fleetNetworkPoliciesFlag, viper.GetBool(fleetNetworkPoliciesFlag), "Create a NetworkPolicy for each Fleet, that only allows ingress traffic to the ports of its GameServers. Can also use FLEET_NETWORK_POLICIES env variable")
+	pflag.String(gameServerNodeLabelsFlag, viper.GetString(gameServerNodeLabelsFlag), "Optional. Comma separated Node labels to copy onto the GameServers scheduled on the Node, e.g. failure-domain.beta.kubernetes.io/zone. Can also use GAMESERVER_NODE_LABELS env variable.")
+	pflag.String(sdkServiceAccountsFlag, viper.GetString(sdkServiceAccountsFlag), "Optional. Comma separated namespace/name of the service accounts, of game servers that run the SDK server themselves, that are bound to the role of the SDK server. Can also use SDK_SERVER_SERVICE_ACCOUNTS env variable.")
+	pflag.String(gameServerEnvFlag, viper.GetString(gameServerEnvFlag), "Optional. Comma separated NAME=value environment variables to add to every game server container, unless it sets them, e.g. REGION=europe-west1. Can also use GAMESERVER_ENV env variable.")
+	pflag.Bool(safeToEvictFlag, viper.GetBool(safeToEvictFlag), "Set the cluster autoscaler safe-to-evict annotation on GameServer Pods, from their eviction setting, so that the nodes of Allocated GameServers are not scaled down. Can also use SAFE_TO_EVICT_ANNOTATION env variable.")
+	pflag.Duration(gameServerFastRetryDelayFlag, viper.GetDuration(gameServerFastRetryDelayFlag), "Optional. Delay before each of the first retries of a GameServer that failed to sync. Can also use GAMESERVER_FAST_RETRY_DELAY env variable.")
+	pflag.Duration(gameServerSlowRetryDelayFlag, viper.GetDuration(gameServerSlowRetryDelayFlag), "Optional. Delay before each retry of a GameServer that failed to sync, after the fast retries. Can also use GAMESERVER_SLOW_RETRY_DELAY env variable.")
+	pflag.Int32(gameServerFastRetriesFlag, viper.GetInt32(gameServerFastRetriesFlag), "Optional. Number of retries of a GameServer that failed to sync, that use the fast retry delay. Can also use GAMESERVER_FAST_RETRIES env variable.")
 	pflag.Int32(minPortFlag, 0, "Required. The minimum port that that a GameServer can be allocated to. Can also use MIN_PORT env variable.")
 	pflag.Int32(maxPortFlag, 0, "Required. The maximum port that that a GameServer can be allocated to. Can also use MAX_PORT env variable")
+	pflag.String(portRangeConfigMapFlag, viper.GetString(portRangeConfigMapFlag), "Optional. Name of a ConfigMap in the POD_NAMESPACE with the minPort and maxPort to allocate from, which overrides min-port and max-port, and is watched so the range can change without a restart. Can also use PORT_RANGE_CONFIGMAP env variable")
 	pflag.String(keyFileFlag, viper.GetString(keyFileFlag), "Optional. Path to the key file")
 	pflag.String(certFileFlag, viper.GetString(certFileFlag), "Optional. Path to the crt file")
+	pflag.Duration(certReloadIntervalFlag, viper.GetDuration(certReloadIntervalFlag), "Optional. How often the crt and key files are re-read, on top of when they change. 0 disables. Can also use CERT_RELOAD_INTERVAL env variable")
+	pflag.Bool(selfManagedTLSFlag, viper.GetBool(selfManagedTLSFlag), "Optional. Generate the CA and certificate of the webhooks and APIService at startup, keep them in the "+selfManagedCertsSecret+" Secret, and patch the caBundle of the webhook configurations and APIServices, instead of using the crt and key files. Requires the POD_NAMESPACE env variable. Can also use SELF_MANAGED_TLS env variable")
 	pflag.String(kubeconfigFlag, viper.GetString(kubeconfigFlag), "Optional. kubeconfig to run the controller out of the cluster. Only use it for debugging as webhook won't works.")
 	pflag.Bool(enablePrometheusMetricsFlag, viper.GetBool(enablePrometheusMetricsFlag), "Flag to activate metrics of Agones. Can also use PROMETHEUS_EXPORTER env variable.")
 	pflag.Bool(enableStackdriverMetricsFlag, viper.GetBool(enableStackdriverMetricsFlag), "Flag to activate stackdriver monitoring metrics for Agones. Can also use STACKDRIVER_EXPORTER env variable.")
 	pflag.String(projectIDFlag, viper.GetString(projectIDFlag), "GCP ProjectID used for Stackdriver, if not specified ProjectID from Application Default Credentials would be used. Can also use GCP_PROJECT_ID env variable.")
+	pflag.String(nodeHourlyCostFlag, viper.GetString(nodeHourlyCostFlag), "Optional. Hourly cost per node instance type used to estimate fleet costs, e.g. n1-standard-4=0.19,n1-standard-8=0.38. Can also use NODE_HOURLY_COST env variable.")
+	pflag.String(metricsLabelsFlag, viper.GetString(metricsLabelsFlag), "Optional. Comma separated GameServer and Fleet labels to export as metric tags, e.g. game_mode,region. Can also use METRICS_LABELS env variable.")
+	pflag.Int32(metricsLabelMaxValuesFlag, 20, "Maximum number of distinct values exported per metrics label, further values are exported as \"other\". Can also use METRICS_LABEL_MAX_VALUES env variable.")
 	pflag.Int32(numWorkersFlag, 64, "Number of controller workers per resource type")
+	pflag.Int32(gameServerWorkersFlag, viper.GetInt32(gameServerWorkersFlag), "Optional. Number of workers of each GameServer work queue. 0 uses num-workers. Can also use GAMESERVER_WORKERS env variable.")
+	pflag.Int32(gameServerSetWorkersFlag, viper.GetInt32(gameServerSetWorkersFlag), "Optional. Number of GameServerSet workers. 0 uses num-workers. Can also use GAMESERVERSET_WORKERS env variable.")
+	pflag.Int32(fleetWorkersFlag, viper.GetInt32(fleetWorkersFlag), "Optional. Number of Fleet workers. 0 uses num-workers. Can also use FLEET_WORKERS env variable.")
+	pflag.Int32(fleetAutoscalerWorkersFlag, viper.GetInt32(fleetAutoscalerWorkersFlag), "Optional. Number of FleetAutoscaler workers. 0 uses num-workers. Can also use FLEETAUTOSCALER_WORKERS env variable.")
+	pflag.Duration(resyncPeriodFlag, viper.GetDuration(resyncPeriodFlag), "Optional. How often all the resources in the informer caches are synced again, on top of their changes. 0 disables. Can also use RESYNC_PERIOD env variable.")
 	pflag.Int32(apiServerSustainedQPSFlag, 100, "Maximum sustained queries per second to send to the API server")
 	pflag.Int32(apiServerBurstQPSFlag, 200, "Maximum burst queries per second to send to the API server")
+	pflag.Duration(apiServerTimeoutFlag, viper.GetDuration(apiServerTimeoutFlag), "Optional. Timeout of each request to the API server. The informers' list and watch requests are not subject to it. 0 disables. Can also use API_SERVER_TIMEOUT env variable.")
 	pflag.String(logDirFlag, viper.GetString(logDirFlag), "If set, store logs in a given directory.")
 	pflag.Int32(logSizeLimitMBFlag, 1000, "Log file size limit in MB")
+	pflag.String(featureGatesFlag, viper.GetString(featureGatesFlag), "Optional. Comma separated Feature=true|false pairs to switch features on or off, e.g. Chaos=true. Can also use FEATURE_GATES env variable.")
+	pflag.Duration(chaosPodCreationDelayFlag, viper.GetDuration(chaosPodCreationDelayFlag), "Optional. For soak testing only, requires the Chaos feature gate. Delays the creation of each Pod. Can also use CHAOS_POD_CREATION_DELAY env variable.")
+	pflag.Float64(chaosUpdateFailuresFlag, viper.GetFloat64(chaosUpdateFailuresFlag), "Optional. For soak testing only, requires the Chaos feature gate. Percentage of API server updates that randomly fail. Can also use CHAOS_UPDATE_FAILURE_PERCENTAGE env variable.")
 	pflag.Parse()
 
 	viper.SetEnvKeyReplacer(strings.NewReplacer("-", "_"))
 	runtime.Must(viper.BindEnv(sidecarImageFlag))
 	runtime.Must(viper.BindEnv(sidecarCPULimitFlag))
 	runtime.Must(viper.BindEnv(sidecarCPURequestFlag))
+	runtime.Must(viper.BindEnv(sidecarMemoryLimitFlag))
+	runtime.Must(viper.BindEnv(sidecarMemoryRequestFlag))
 	runtime.Must(viper.BindEnv(pullSidecarFlag))
+	runtime.Must(viper.BindEnv(sidecarPodSnippetFlag))
 	runtime.Must(viper.BindEnv(sdkServerAccountFlag))
+	runtime.Must(viper.BindEnv(finalizerTimeoutFlag))
+	runtime.Must(viper.BindEnv(clockSkewToleranceFlag))
+	runtime.Must(viper.BindEnv(fleetEventSummaryPeriodFlag))
+	runtime.Must(viper.BindEnv(maxReplacementRateFlag))
+	runtime.Must(viper.BindEnv(gameServerDeletionRateFlag))
+	runtime.Must(viper.BindEnv(maxAnnotationBytesFlag))
+	runtime.Must(viper.BindEnv(maxTemplateBytesFlag))
+	runtime.Must(viper.BindEnv(fleetDefaultReplicasFlag))
+	runtime.Must(viper.BindEnv(fleetDefaultSchedulingFlag))
+	runtime.Must(viper.BindEnv(fleetDefaultStrategyFlag))
+	runtime.Must(viper.BindEnv(fleetDefaultMaxSurgeFlag))
+	runtime.Must(viper.BindEnv(fleetDefaultMaxUnavailFlag))
+	runtime.Must(viper.BindEnv(fleetNetworkPoliciesFlag))
+	runtime.Must(viper.BindEnv(gameServerNodeLabelsFlag))
+	runtime.Must(viper.BindEnv(sdkServiceAccountsFlag))
+	runtime.Must(viper.BindEnv(gameServerEnvFlag))
+	runtime.Must(viper.BindEnv(safeToEvictFlag))
+	runtime.Must(viper.BindEnv(gameServerFastRetryDelayFlag))
+	runtime.Must(viper.BindEnv(gameServerSlowRetryDelayFlag))
+	runtime.Must(viper.BindEnv(gameServerFastRetriesFlag))
 	runtime.Must(viper.BindEnv(minPortFlag))
 	runtime.Must(viper.BindEnv(maxPortFlag))
+	runtime.Must(viper.BindEnv(portRangeConfigMapFlag))
 	runtime.Must(viper.BindEnv(keyFileFlag))
 	runtime.Must(viper.BindEnv(certFileFlag))
+	runtime.Must(viper.BindEnv(certReloadIntervalFlag))
+	runtime.Must(viper.BindEnv(selfManagedTLSFlag))
+	runtime.Must(viper.BindEnv(podNamespaceEnv))
 	runtime.Must(viper.BindEnv(kubeconfigFlag))
 	runtime.Must(viper.BindEnv(enablePrometheusMetricsFlag))
 	runtime.Must(viper.BindEnv(enableStackdriverMetricsFlag))
 	runtime.Must(viper.BindEnv(projectIDFlag))
+	runtime.Must(viper.BindEnv(nodeHourlyCostFlag))
+	runtime.Must(viper.BindEnv(metricsLabelsFlag))
+	runtime.Must(viper.BindEnv(metricsLabelMaxValuesFlag))
 	runtime.Must(viper.BindPFlags(pflag.CommandLine))
 	runtime.Must(viper.BindEnv(numWorkersFlag))
+	runtime.Must(viper.BindEnv(gameServerWorkersFlag))
+	runtime.Must(viper.BindEnv(gameServerSetWorkersFlag))
+	runtime.Must(viper.BindEnv(fleetWorkersFlag))
+	runtime.Must(viper.BindEnv(fleetAutoscalerWorkersFlag))
+	runtime.Must(viper.BindEnv(resyncPeriodFlag))
 	runtime.Must(viper.BindEnv(apiServerSustainedQPSFlag))
 	runtime.Must(viper.BindEnv(apiServerBurstQPSFlag))
+	runtime.Must(viper.BindEnv(apiServerTimeoutFlag))
 	runtime.Must(viper.BindEnv(logDirFlag))
 	runtime.Must(viper.BindEnv(logSizeLimitMBFlag))
+	runtime.Must(viper.BindEnv(featureGatesFlag))
+	runtime.Must(viper.BindEnv(chaosPodCreationDelayFlag))
+	runtime.Must(viper.BindEnv(chaosUpdateFailuresFlag))
 
 	request, err := resource.ParseQuantity(viper.GetString(sidecarCPURequestFlag))
 	if err != nil {
@@ -288,65 +525,278 @@ func parseEnvFlags() config {
 		logger.WithError(err).Fatalf("could not parse %s", sidecarCPULimitFlag)
 	}
 
+	memoryRequest, err := resource.ParseQuantity(viper.GetString(sidecarMemoryRequestFlag))
+	if err != nil {
+		logger.WithError(err).Fatalf("could not parse %s", sidecarMemoryRequestFlag)
+	}
+
+	memoryLimit, err := resource.ParseQuantity(viper.GetString(sidecarMemoryLimitFlag))
+	if err != nil {
+		logger.WithError(err).Fatalf("could not parse %s", sidecarMemoryLimitFlag)
+	}
+
+	var podSnippet gameservers.PodSnippet
+	if path := viper.GetString(sidecarPodSnippetFlag); path != "" {
+		podSnippet, err = gameservers.LoadPodSnippet(path)
+		if err != nil {
+			logger.WithError(err).Fatalf("could not load %s", sidecarPodSnippetFlag)
+		}
+	}
+
+	gameServerEnv, err := gameservers.ParseEnvironmentVariables(viper.GetString(gameServerEnvFlag))
+	if err != nil {
+		logger.WithError(err).Fatalf("could not parse %s", gameServerEnvFlag)
+	}
+
+	costModel, err := metrics.ParseNodeCostModel(viper.GetString(nodeHourlyCostFlag))
+	if err != nil {
+		logger.WithError(err).Fatalf("could not parse %s", nodeHourlyCostFlag)
+	}
+
+	if err := runtime.ParseFeatures(viper.GetString(featureGatesFlag)); err != nil {
+		logger.WithError(err).Fatalf("could not parse %s", featureGatesFlag)
+	}
+
 	return config{
-		MinPort:               int32(viper.GetInt64(minPortFlag)),
-		MaxPort:               int32(viper.GetInt64(maxPortFlag)),
-		SidecarImage:          viper.GetString(sidecarImageFlag),
-		SidecarCPURequest:     request,
-		SidecarCPULimit:       limit,
-		SdkServiceAccount:     viper.GetString(sdkServerAccountFlag),
-		AlwaysPullSidecar:     viper.GetBool(pullSidecarFlag),
-		KeyFile:               viper.GetString(keyFileFlag),
-		CertFile:              viper.GetString(certFileFlag),
-		KubeConfig:            viper.GetString(kubeconfigFlag),
-		PrometheusMetrics:     viper.GetBool(enablePrometheusMetricsFlag),
-		Stackdriver:           viper.GetBool(enableStackdriverMetricsFlag),
-		GCPProjectID:          viper.GetString(projectIDFlag),
-		NumWorkers:            int(viper.GetInt32(numWorkersFlag)),
-		APIServerSustainedQPS: int(viper.GetInt32(apiServerSustainedQPSFlag)),
-		APIServerBurstQPS:     int(viper.GetInt32(apiServerBurstQPSFlag)),
-		LogDir:                viper.GetString(logDirFlag),
-		LogSizeLimitMB:        int(viper.GetInt32(logSizeLimitMBFlag)),
+		MinPort:                 int32(viper.GetInt64(minPortFlag)),
+		MaxPort:                 int32(viper.GetInt64(maxPortFlag)),
+		PortRangeConfigMap:      viper.GetString(portRangeConfigMapFlag),
+		SidecarImage:            viper.GetString(sidecarImageFlag),
+		SidecarCPURequest:       request,
+		SidecarCPULimit:         limit,
+		SidecarMemoryRequest:    memoryRequest,
+		SidecarMemoryLimit:      memoryLimit,
+		SidecarPodSnippet:       podSnippet,
+		SdkServiceAccount:       viper.GetString(sdkServerAccountFlag),
+		SdkServiceAccounts:      splitList(viper.GetString(sdkServiceAccountsFlag)),
+		FinalizerTimeout:        viper.GetDuration(finalizerTimeoutFlag),
+		ClockSkewTolerance:      viper.GetDuration(clockSkewToleranceFlag),
+		FleetEventSummaryPeriod: viper.GetDuration(fleetEventSummaryPeriodFlag),
+		MaxReplacementRate:      viper.GetFloat64(maxReplacementRateFlag),
+		GameServerDeletionRate:  viper.GetFloat64(gameServerDeletionRateFlag),
+		MaxAnnotationBytes:      viper.GetInt(maxAnnotationBytesFlag),
+		MaxTemplateBytes:        viper.GetInt(maxTemplateBytesFlag),
+		FleetNetworkPolicies:    viper.GetBool(fleetNetworkPoliciesFlag),
+		GameServerNodeLabels:    splitList(viper.GetString(gameServerNodeLabelsFlag)),
+		GameServerEnv:           gameServerEnv,
+		SafeToEvictAnnotation:   viper.GetBool(safeToEvictFlag),
+		AlwaysPullSidecar:       viper.GetBool(pullSidecarFlag),
+		KeyFile:                 viper.GetString(keyFileFlag),
+		CertFile:                viper.GetString(certFileFlag),
+		CertReloadInterval:      viper.GetDuration(certReloadIntervalFlag),
+		SelfManagedTLS:          viper.GetBool(selfManagedTLSFlag),
+		Namespace:               viper.GetString(podNamespaceEnv),
+		KubeConfig:              viper.GetString(kubeconfigFlag),
+		PrometheusMetrics:       viper.GetBool(enablePrometheusMetricsFlag),
+		Stackdriver:             viper.GetBool(enableStackdriverMetricsFlag),
+		GCPProjectID:            viper.GetString(projectIDFlag),
+		NodeCostModel:           costModel,
+		MetricsLabels:           strings.Split(viper.GetString(metricsLabelsFlag), ","),
+		MetricsLabelMaxValues:   int(viper.GetInt32(metricsLabelMaxValuesFlag)),
+		NumWorkers:              int(viper.GetInt32(numWorkersFlag)),
+		GameServerWorkers:       int(viper.GetInt32(gameServerWorkersFlag)),
+		GameServerSetWorkers:    int(viper.GetInt32(gameServerSetWorkersFlag)),
+		FleetWorkers:            int(viper.GetInt32(fleetWorkersFlag)),
+		FleetAutoscalerWorkers:  int(viper.GetInt32(fleetAutoscalerWorkersFlag)),
+		ResyncPeriod:            viper.GetDuration(resyncPeriodFlag),
+		APIServerSustainedQPS:   int(viper.GetInt32(apiServerSustainedQPSFlag)),
+		APIServerBurstQPS:       int(viper.GetInt32(apiServerBurstQPSFlag)),
+		APIServerTimeout:        viper.GetDuration(apiServerTimeoutFlag),
+		LogDir:                  viper.GetString(logDirFlag),
+		LogSizeLimitMB:          int(viper.GetInt32(logSizeLimitMBFlag)),
+		FleetDefaults: fleets.Defaults{
+			Replicas:       viper.GetInt32(fleetDefaultReplicasFlag),
+			Scheduling:     apis.SchedulingStrategy(viper.GetString(fleetDefaultSchedulingFlag)),
+			StrategyType:   appsv1.DeploymentStrategyType(viper.GetString(fleetDefaultStrategyFlag)),
+			MaxSurge:       intstr.Parse(viper.GetString(fleetDefaultMaxSurgeFlag)),
+			MaxUnavailable: intstr.Parse(viper.GetString(fleetDefaultMaxUnavailFlag)),
+		},
+		GameServerRateLimiter: workerqueue.FastSlowRateLimiter{
+			FastDelay:   viper.GetDuration(gameServerFastRetryDelayFlag),
+			SlowDelay:   viper.GetDuration(gameServerSlowRetryDelayFlag),
+			FastRetries: int(viper.GetInt32(gameServerFastRetriesFlag)),
+		},
+		Chaos: chaos.Config{
+			PodCreationDelay:        viper.GetDuration(chaosPodCreationDelayFlag),
+			UpdateFailurePercentage: viper.GetFloat64(chaosUpdateFailuresFlag),
+		},
 	}
 }
 
 // config stores all required configuration to create a game server controller.
 type config struct {
-	MinPort               int32
-	MaxPort               int32
-	SidecarImage          string
-	SidecarCPURequest     resource.Quantity
-	SidecarCPULimit       resource.Quantity
-	SdkServiceAccount     string
-	AlwaysPullSidecar     bool
-	PrometheusMetrics     bool
-	Stackdriver           bool
-	KeyFile               string
-	CertFile              string
-	KubeConfig            string
-	GCPProjectID          string
-	NumWorkers            int
-	APIServerSustainedQPS int
-	APIServerBurstQPS     int
-	LogDir                string
-	LogSizeLimitMB        int
+	MinPort                 int32
+	MaxPort                 int32
+	PortRangeConfigMap      string
+	SidecarImage            string
+	SidecarCPURequest       resource.Quantity
+	SidecarCPULimit         resource.Quantity
+	SidecarMemoryRequest    resource.Quantity
+	SidecarMemoryLimit      resource.Quantity
+	SidecarPodSnippet       gameservers.PodSnippet
+	SdkServiceAccount       string
+	SdkServiceAccounts      []string
+	FinalizerTimeout        time.Duration
+	ClockSkewTolerance      time.Duration
+	FleetEventSummaryPeriod time.Duration
+	MaxReplacementRate      float64
+	GameServerDeletionRate  float64
+	MaxAnnotationBytes      int
+	MaxTemplateBytes        int
+	FleetNetworkPolicies    bool
+	GameServerNodeLabels    []string
+	GameServerEnv           []corev1.EnvVar
+	SafeToEvictAnnotation   bool
+	AlwaysPullSidecar       bool
+	PrometheusMetrics       bool
+	Stackdriver             bool
+	KeyFile                 string
+	CertFile                string
+	CertReloadInterval      time.Duration
+	SelfManagedTLS          bool
+	Namespace               string
+	KubeConfig              string
+	GCPProjectID            string
+	NodeCostModel           metrics.NodeCostModel
+	MetricsLabels           []string
+	MetricsLabelMaxValues   int
+	NumWorkers              int
+	GameServerWorkers       int
+	GameServerSetWorkers    int
+	FleetWorkers            int
+	FleetAutoscalerWorkers  int
+	ResyncPeriod            time.Duration
+	APIServerSustainedQPS   int
+	APIServerBurstQPS       int
+	APIServerTimeout        time.Duration
+	LogDir                  string
+	LogSizeLimitMB          int
+	FleetDefaults           fleets.Defaults
+	GameServerRateLimiter   workerqueue.FastSlowRateLimiter
+	Chaos                   chaos.Config
+}
+
+// loadPortRange replaces the min and max port with the ones of the port range ConfigMap.
+// If it doesn't exist, the min and max port are kept, if they are set.
+func (c *config) loadPortRange(kubeClient kubernetes.Interface) error {
+	cm, err := kubeClient.CoreV1().ConfigMaps(c.Namespace).Get(c.PortRangeConfigMap, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) && c.MinPort > 0 {
+		logger.WithField("configMap", c.PortRangeConfigMap).Warn("Port range ConfigMap not found. Using the min and max port until it is created")
+		return nil
+	}
+	if err != nil {
+		return errors.Wrapf(err, "error getting ConfigMap %s", c.PortRangeConfigMap)
+	}
+
+	r, err := gameservers.ParsePortRange(cm)
+	if err != nil {
+		return err
+	}
+	c.MinPort, c.MaxPort = r.MinPort, r.MaxPort
+	return nil
 }
 
 // validate ensures the ctlConfig data is valid.
 func (c config) validate() error {
-	if c.MinPort <= 0 || c.MaxPort <= 0 {
-		return errors.New("min Port and Max Port values are required")
+	// the ports can be left out if they are read from the port range ConfigMap
+	if c.PortRangeConfigMap == "" || c.MinPort != 0 || c.MaxPort != 0 {
+		if c.MinPort <= 0 || c.MaxPort <= 0 {
+			return errors.New("min Port and Max Port values are required")
+		}
+		if c.MaxPort < c.MinPort {
+			return errors.New("max Port cannot be set less that the Min Port")
+		}
 	}
-	if c.MaxPort < c.MinPort {
-		return errors.New("max Port cannot be set less that the Min Port")
+	if c.PortRangeConfigMap != "" && c.Namespace == "" {
+		return errors.New("the POD_NAMESPACE env variable is required to watch the port range ConfigMap")
 	}
-	return nil
+	if c.FinalizerTimeout < 0 {
+		return errors.New("finalizer timeout cannot be negative")
+	}
+	if c.ClockSkewTolerance < 0 {
+		return errors.New("clock skew tolerance cannot be negative")
+	}
+	if c.MaxReplacementRate < 0 {
+		return errors.New("max unhealthy replacement rate cannot be negative")
+	}
+	if c.GameServerDeletionRate < 0 {
+		return errors.New("gameserver deletion rate cannot be negative")
+	}
+	if c.SelfManagedTLS && c.Namespace == "" {
+		return errors.New("the POD_NAMESPACE env variable is required to manage the webhook certificates")
+	}
+	if c.APIServerSustainedQPS <= 0 || c.APIServerBurstQPS <= 0 {
+		return errors.New("api server qps and qps burst must be positive")
+	}
+	if c.APIServerTimeout < 0 {
+		return errors.New("api server timeout cannot be negative")
+	}
+	if c.NumWorkers <= 0 {
+		return errors.New("num workers must be positive")
+	}
+	if c.GameServerWorkers < 0 || c.GameServerSetWorkers < 0 || c.FleetWorkers < 0 || c.FleetAutoscalerWorkers < 0 {
+		return errors.New("the workers of a controller cannot be negative")
+	}
+	if c.ResyncPeriod < 0 {
+		return errors.New("resync period cannot be negative")
+	}
+	if c.MaxAnnotationBytes < 0 || c.MaxTemplateBytes < 0 {
+		return errors.New("max annotation and template bytes cannot be negative")
+	}
+	if err := c.FleetDefaults.Validate(); err != nil {
+		return err
+	}
+	if err := c.GameServerRateLimiter.Validate(); err != nil {
+		return errors.Wrap(err, "invalid gameserver retries")
+	}
+	for _, sa := range c.SdkServiceAccounts {
+		if parts := strings.Split(sa, "/"); len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return errors.Errorf("invalid sdk server service account %q, expected namespace/name", sa)
+		}
+	}
+	for _, l := range c.GameServerNodeLabels {
+		if errs := validation.IsQualifiedName(l); len(errs) > 0 {
+			return errors.Errorf("invalid gameserver node label %q: %s", l, strings.Join(errs, ", "))
+		}
+	}
+	return c.Chaos.Validate()
+}
+
+// splitList splits a comma separated list, dropping empty values
+func splitList(s string) []string {
+	var result []string
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			result = append(result, v)
+		}
+	}
+	return result
 }
 
 type runner interface {
 	Run(workers int, stop <-chan struct{}) error
 }
 
+// workersRunner runs a runner with its own number of workers
+type workersRunner struct {
+	runner
+	workers int
+}
+
+// withWorkers returns a runner that runs r with the given number of workers,
+// or with the default number of workers if it is 0
+func withWorkers(r runner, workers int) runner {
+	if workers == 0 {
+		return r
+	}
+	return workersRunner{runner: r, workers: workers}
+}
+
+// Run runs the runner with its own number of workers, rather than the default one
+func (w workersRunner) Run(_ int, stop <-chan struct{}) error {
+	return w.runner.Run(w.workers, stop)
+}
+
 type httpServer struct {
 	http.ServeMux
 }
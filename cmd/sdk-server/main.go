@@ -49,14 +49,17 @@ const (
 	podNamespaceEnv   = "POD_NAMESPACE"
 
 	// Flags (that can also be env vars)
-	localFlag    = "local"
-	fileFlag     = "file"
-	testFlag     = "test"
-	addressFlag  = "address"
-	delayFlag    = "delay"
-	timeoutFlag  = "timeout"
-	grpcPortFlag = "grpc-port"
-	httpPortFlag = "http-port"
+	localFlag         = "local"
+	fileFlag          = "file"
+	testFlag          = "test"
+	addressFlag       = "address"
+	delayFlag         = "delay"
+	timeoutFlag       = "timeout"
+	grpcPortFlag      = "grpc-port"
+	httpPortFlag      = "http-port"
+	tokenFileFlag     = "token-file"
+	shutdownDelayFlag = "shutdown-delay"
+	featureGatesFlag  = "feature-gates"
 )
 
 var (
@@ -75,7 +78,17 @@ func main() {
 
 	stop := signals.NewStopChannel()
 	timedStop := make(chan struct{})
-	grpcServer := grpc.NewServer()
+	// closed once the game server no longer needs the SDK, which the local SDK servers don't track
+	var gameServerStopped <-chan struct{}
+	var opts []grpc.ServerOption
+	if ctlConf.TokenFile != "" {
+		auth, err := sdkserver.NewTokenAuth(ctlConf.TokenFile)
+		if err != nil {
+			logger.WithError(err).Fatal("Could not load the SDK server token")
+		}
+		opts = auth.ServerOptions()
+	}
+	grpcServer := grpc.NewServer(opts...)
 	// don't graceful stop, because if we get a kill signal
 	// then the gameserver is being shut down, and we no longer
 	// care about running RPC calls.
@@ -149,6 +162,7 @@ func main() {
 			}
 		}()
 		sdk.RegisterSDKServer(grpcServer, s)
+		gameServerStopped = s.GameServerStopped()
 	}
 
 	grpcEndpoint := fmt.Sprintf("%s:%d", ctlConf.Address, ctlConf.GRPCPort)
@@ -157,6 +171,16 @@ func main() {
 
 	select {
 	case <-stop:
+		if ctlConf.ShutdownDelay > 0 {
+			// keep serving the pre-delete hook of the game server, which runs once the Pod is deleted,
+			// until the game server has stopped, with the timeout of the hook as the upper bound
+			logger.Infof("Waiting up to %d seconds before shutting down", ctlConf.ShutdownDelay)
+			select {
+			case <-time.After(time.Duration(ctlConf.ShutdownDelay) * time.Second):
+			case <-gameServerStopped:
+			case <-timedStop:
+			}
+		}
 	case <-timedStop:
 	}
 
@@ -244,13 +268,19 @@ func parseEnvFlags() config {
 	viper.SetDefault(timeoutFlag, 0)
 	viper.SetDefault(grpcPortFlag, defaultGRPCPort)
 	viper.SetDefault(httpPortFlag, defaultHTTPPort)
+	viper.SetDefault(tokenFileFlag, "")
+	viper.SetDefault(shutdownDelayFlag, 0)
+	viper.SetDefault(featureGatesFlag, "")
 	pflag.Bool(localFlag, viper.GetBool(localFlag),
 		"Set this, or LOCAL env, to 'true' to run this binary in local development mode. Defaults to 'false'")
 	pflag.StringP(fileFlag, "f", viper.GetString(fileFlag), "Set this, or FILE env var to the path of a local yaml or json file that contains your GameServer resoure configuration")
 	pflag.String(addressFlag, viper.GetString(addressFlag), "The Address to bind the server grpcPort to. Defaults to 'localhost'")
 	pflag.Int(grpcPortFlag, viper.GetInt(grpcPortFlag), fmt.Sprintf("Port on which to bind the gRPC server. Defaults to %d", defaultGRPCPort))
 	pflag.Int(httpPortFlag, viper.GetInt(httpPortFlag), fmt.Sprintf("Port on which to bind the HTTP server. Defaults to %d", defaultHTTPPort))
+	pflag.String(tokenFileFlag, viper.GetString(tokenFileFlag), "Set this, or TOKEN_FILE env var, to the path of a file with a token that clients must send as a bearer token. Defaults to no token")
+	pflag.String(featureGatesFlag, viper.GetString(featureGatesFlag), "Set this, or FEATURE_GATES env var, to a comma separated list of Feature=true|false pairs to switch features on or off. Set by the controller to match its own feature gates")
 	pflag.Int(delayFlag, viper.GetInt(delayFlag), "Time to delay (in seconds) before starting to execute main. Useful for tests")
+	pflag.Int(shutdownDelayFlag, viper.GetInt(shutdownDelayFlag), "Set this, or SHUTDOWN_DELAY env var, to the longest time (in seconds) to keep serving after receiving SIGTERM, until the game server has stopped. Set by the controller to the timeout of the pre-delete hook of the GameServer")
 	pflag.Int(timeoutFlag, viper.GetInt(timeoutFlag), "Time of execution (in seconds) before close. Useful for tests")
 	pflag.String(testFlag, viper.GetString(testFlag), "List functions which shoud be called during the SDK Conformance test run.")
 	pflag.Parse()
@@ -265,28 +295,39 @@ func parseEnvFlags() config {
 	runtime.Must(viper.BindEnv(timeoutFlag))
 	runtime.Must(viper.BindEnv(grpcPortFlag))
 	runtime.Must(viper.BindEnv(httpPortFlag))
+	runtime.Must(viper.BindEnv(tokenFileFlag))
+	runtime.Must(viper.BindEnv(shutdownDelayFlag))
+	runtime.Must(viper.BindEnv(featureGatesFlag))
 	runtime.Must(viper.BindPFlags(pflag.CommandLine))
 
+	if err := runtime.ParseFeatures(viper.GetString(featureGatesFlag)); err != nil {
+		logger.WithError(err).Fatalf("could not parse %s", featureGatesFlag)
+	}
+
 	return config{
-		IsLocal:   viper.GetBool(localFlag),
-		Address:   viper.GetString(addressFlag),
-		LocalFile: viper.GetString(fileFlag),
-		Delay:     viper.GetInt(delayFlag),
-		Timeout:   viper.GetInt(timeoutFlag),
-		Test:      viper.GetString(testFlag),
-		GRPCPort:  viper.GetInt(grpcPortFlag),
-		HTTPPort:  viper.GetInt(httpPortFlag),
+		IsLocal:       viper.GetBool(localFlag),
+		Address:       viper.GetString(addressFlag),
+		LocalFile:     viper.GetString(fileFlag),
+		Delay:         viper.GetInt(delayFlag),
+		Timeout:       viper.GetInt(timeoutFlag),
+		Test:          viper.GetString(testFlag),
+		GRPCPort:      viper.GetInt(grpcPortFlag),
+		HTTPPort:      viper.GetInt(httpPortFlag),
+		TokenFile:     viper.GetString(tokenFileFlag),
+		ShutdownDelay: viper.GetInt(shutdownDelayFlag),
 	}
 }
 
 // config is all the configuration for this program
 type config struct {
-	Address   string
-	IsLocal   bool
-	LocalFile string
-	Delay     int
-	Timeout   int
-	Test      string
-	GRPCPort  int
-	HTTPPort  int
+	Address       string
+	IsLocal       bool
+	LocalFile     string
+	Delay         int
+	Timeout       int
+	Test          string
+	GRPCPort      int
+	HTTPPort      int
+	TokenFile     string
+	ShutdownDelay int
 }
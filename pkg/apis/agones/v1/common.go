@@ -16,6 +16,7 @@ package v1
 
 import (
 	"fmt"
+	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -29,8 +30,34 @@ const (
 	ErrPortPolicyStatic         = "PortPolicy must be Static"
 	ErrContainerPortRequired    = "ContainerPort must be defined for Dynamic and Static PortPolicies"
 	ErrContainerPortPassthrough = "ContainerPort cannot be specified with Passthrough PortPolicy"
+	ErrCounterInvalid           = "Counter count and capacity cannot be negative, and count cannot be greater than capacity"
+	ErrListInvalid              = "List capacity cannot be negative, and the number of values cannot be greater than capacity"
+	ErrPortProtocolInvalid      = "Protocol must be UDP, TCP or TCPUDP"
+	ErrPortRangeInvalid         = "PortRange minPort must be greater than 0, and maxPort must be between minPort and 65535"
+	ErrReservedContainerName    = "Container name is reserved for the Agones SDK sidecar"
+	ErrReservedVolumeName       = "Volume name is reserved for disabling the service account of the game server, unless a serviceAccountName is set"
+	ErrReservedLabel            = "Label is set by Agones on the Pods of GameServers"
+	ErrTemplateHostPort         = "HostPort cannot be set in the pod template, as host ports are allocated by Agones from the GameServer ports"
+	ErrEvictionSafeInvalid      = "Eviction safe must be Always or Never"
+	ErrTerminationGracePeriod   = "TerminationGracePeriodSeconds cannot be negative"
+	ErrPreDeleteHookAction      = "Pre-delete hook must set exactly one of exec or httpGet"
+	ErrPreDeleteHookTimeout     = "Pre-delete hook timeoutSeconds cannot be negative"
+	ErrPreDeleteHookPreStop     = "Pre-delete hook cannot be set when the game server container has a preStop lifecycle hook"
+	ErrHostPortDuplicate        = "HostPort is already used by another Static port with the same protocol"
+	ErrSdkServerPortConflict    = "SDK server port must be between 1 and 65535, and cannot be used by the other SDK server port or a game server container"
+	ErrSdkServerSidecar         = "SDK server sidecar annotation must be true or false"
+	ErrSdkServerTokenVolumeName = "Volume name is reserved for the SDK server token, when a tokenSecretName is set"
+	ErrSdkServerHealthPort      = "Health port 8080 of the SDK server cannot be used by a GameServer port or another container, when the game server runs the SDK server"
+	ErrHealthProbeName          = "Health probe name is required, and must be unique"
+	ErrHealthProbeType          = "Health probe type must be TCP or HTTP"
+	ErrHealthProbePort          = "Health probe port must be between 1 and 65535"
+	ErrHealthProbePath          = "Health probe path can only be set for HTTP probes, and must start with /"
 )
 
+// MinMaxLifetime is the shortest MaxLifetime of the GameServers of a Fleet or GameServerSet,
+// so that GameServers are not recycled as soon as they are Ready
+const MinMaxLifetime = time.Minute
+
 // crd is an interface to get Name and Kind of CRD
 type crd interface {
 	GetName() string
@@ -53,6 +80,18 @@ func validateName(c crd) []metav1.StatusCause {
 	return causes
 }
 
+// validateMaxLifetime checks that the MaxLifetime of a Fleet or GameServerSet, if set, is at least MinMaxLifetime
+func validateMaxLifetime(maxLifetime *metav1.Duration) []metav1.StatusCause {
+	if maxLifetime == nil || maxLifetime.Duration >= MinMaxLifetime {
+		return nil
+	}
+	return []metav1.StatusCause{{
+		Type:    metav1.CauseTypeFieldValueInvalid,
+		Field:   "maxLifetime",
+		Message: fmt.Sprintf("maxLifetime must be at least %s", MinMaxLifetime),
+	}}
+}
+
 // gsSpec is an interface which contains all necessary
 // functions to perform common validations against it
 type gsSpec interface {
@@ -15,20 +15,43 @@
 package v1
 
 import (
+	"fmt"
+	"time"
+
 	"agones.dev/agones/pkg"
 	"agones.dev/agones/pkg/apis"
 	"agones.dev/agones/pkg/apis/agones"
+	"github.com/pkg/errors"
 	appsv1 "k8s.io/api/apps/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation"
 )
 
 const (
 	// FleetNameLabel is the label that the name of the Fleet
 	// is set to on GameServerSet and GameServer  the Fleet controls
 	FleetNameLabel = agones.GroupName + "/fleet"
+	// FleetNodePoolLabel is the label that the name of the Fleet node pool
+	// is set to on the GameServerSets the Fleet controls for that pool
+	FleetNodePoolLabel = agones.GroupName + "/nodepool"
+	// FleetRestartAnnotation requests the restart of the Ready GameServers of a Fleet
+	// that were created before the time, in RFC3339 format, it is set to
+	FleetRestartAnnotation = agones.GroupName + "/restart"
+	// FleetRestartSelectorAnnotation is a label selector that limits the restart requested
+	// with the FleetRestartAnnotation to the GameServers that match it
+	FleetRestartSelectorAnnotation = agones.GroupName + "/restart-selector"
 )
 
+// FleetRestart is a restart of the GameServers of a Fleet
+type FleetRestart struct {
+	// Before is the time before which the GameServers to restart were created
+	Before time.Time
+	// Selector selects the GameServers to restart
+	Selector labels.Selector
+}
+
 // +genclient
 // +genclient:method=GetScale,verb=get,subresource=scale,result=k8s.io/api/extensions/v1beta1.Scale
 // +genclient:method=UpdateScale,verb=update,subresource=scale,input=k8s.io/api/extensions/v1beta1.Scale,result=k8s.io/api/extensions/v1beta1.Scale
@@ -61,8 +84,30 @@ type FleetSpec struct {
 	Strategy appsv1.DeploymentStrategy `json:"strategy"`
 	// Scheduling strategy. Defaults to "Packed".
 	Scheduling apis.SchedulingStrategy `json:"scheduling"`
+	// ScaleDownStrategy is the order in which GameServers are deleted on scale down.
+	// If not set, it depends on the Scheduling strategy.
+	ScaleDownStrategy ScaleDownStrategy `json:"scaleDownStrategy,omitempty"`
 	// Template the GameServer template to apply for this Fleet
 	Template GameServerTemplateSpec `json:"template"`
+	// NodePools distributes the Fleet's replicas across pools of nodes, with a
+	// GameServerSet for each pool. If empty, the Fleet has a single GameServerSet.
+	NodePools []FleetNodePool `json:"nodePools,omitempty"`
+	// Naming of the GameServers of this Fleet. If not set, GameServers are
+	// given a unique generated name, prefixed with the name of their GameServerSet.
+	Naming *GameServerNaming `json:"naming,omitempty"`
+	// MaxLifetime is how long a GameServer can be Ready for, since it was created, before it is
+	// replaced by a new one, e.g. to mitigate slow memory leaks. If not set, GameServers are not recycled.
+	MaxLifetime *metav1.Duration `json:"maxLifetime,omitempty"`
+}
+
+// FleetNodePool is a pool of nodes that a share of a Fleet's replicas are scheduled onto
+type FleetNodePool struct {
+	// Name of the node pool, unique within the Fleet
+	Name string `json:"name"`
+	// NodeSelector selects the nodes in the pool, and is added to the nodeSelector of the GameServer Pods
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	// Weight of the pool, relative to the other pools, which determines its share of the Fleet's replicas
+	Weight int32 `json:"weight"`
 }
 
 // FleetStatus is the status of a Fleet
@@ -83,8 +128,11 @@ func (f *Fleet) GameServerSet() *GameServerSet {
 	gsSet := &GameServerSet{
 		ObjectMeta: *f.Spec.Template.ObjectMeta.DeepCopy(),
 		Spec: GameServerSetSpec{
-			Template:   f.Spec.Template,
-			Scheduling: f.Spec.Scheduling,
+			Template:          f.Spec.Template,
+			Scheduling:        f.Spec.Scheduling,
+			ScaleDownStrategy: f.Spec.ScaleDownStrategy,
+			Naming:            f.Spec.Naming.DeepCopy(),
+			MaxLifetime:       f.Spec.MaxLifetime.DeepCopy(),
 		},
 	}
 
@@ -108,6 +156,63 @@ func (f *Fleet) GameServerSet() *GameServerSet {
 	return gsSet
 }
 
+// NodePoolFleet returns a copy of the Fleet for the node pool at index i of
+// Spec.NodePools, with the pool's share of the replicas and a template that
+// schedules GameServers onto the pool's nodes
+func (f *Fleet) NodePoolFleet(i int) *Fleet {
+	pool := f.Spec.NodePools[i]
+	poolFleet := f.DeepCopy()
+	poolFleet.Spec.NodePools = nil
+	poolFleet.Spec.Replicas = f.NodePoolReplicas()[i]
+
+	podSpec := &poolFleet.Spec.Template.Spec.Template.Spec
+	if len(pool.NodeSelector) > 0 && podSpec.NodeSelector == nil {
+		podSpec.NodeSelector = make(map[string]string, len(pool.NodeSelector))
+	}
+	for k, v := range pool.NodeSelector {
+		podSpec.NodeSelector[k] = v
+	}
+
+	return poolFleet
+}
+
+// NodePoolReplicas splits Spec.Replicas between the node pools in proportion to
+// their weights, returning the replicas of each pool in the order of Spec.NodePools.
+// Remainders are given to the pools with the largest fractional share, and then
+// to the pools that come first.
+func (f *Fleet) NodePoolReplicas() []int32 {
+	result := make([]int32, len(f.Spec.NodePools))
+	var totalWeight int64
+	for _, pool := range f.Spec.NodePools {
+		totalWeight += int64(pool.Weight)
+	}
+	if totalWeight == 0 {
+		return result
+	}
+
+	remainders := make([]int64, len(f.Spec.NodePools))
+	allocated := int32(0)
+	for i, pool := range f.Spec.NodePools {
+		share := int64(f.Spec.Replicas) * int64(pool.Weight)
+		result[i] = int32(share / totalWeight)
+		remainders[i] = share % totalWeight
+		allocated += result[i]
+	}
+
+	for ; allocated < f.Spec.Replicas; allocated++ {
+		max := 0
+		for i := range remainders {
+			if remainders[i] > remainders[max] {
+				max = i
+			}
+		}
+		result[max]++
+		remainders[max] = -1
+	}
+
+	return result
+}
+
 // ApplyDefaults applies default values to the Fleet
 func (f *Fleet) ApplyDefaults() {
 	if f.Spec.Strategy.Type == "" {
@@ -169,11 +274,34 @@ func (f *Fleet) validateRollingUpdate(value *intstr.IntOrString, causes *[]metav
 // the returned array
 func (f *Fleet) Validate() ([]metav1.StatusCause, bool) {
 	causes := validateName(f)
+	causes = append(causes, f.validateStrategies()...)
+	causes = append(causes, validateMaxLifetime(f.Spec.MaxLifetime)...)
 
 	if f.Spec.Strategy.Type == appsv1.RollingUpdateDeploymentStrategyType {
 		f.validateRollingUpdate(f.Spec.Strategy.RollingUpdate.MaxUnavailable, &causes, "MaxUnavailable")
 		f.validateRollingUpdate(f.Spec.Strategy.RollingUpdate.MaxSurge, &causes, "MaxSurge")
 	}
+	causes = append(causes, f.validateNodePools()...)
+	if f.Spec.Naming != nil {
+		causes = append(causes, f.Spec.Naming.Validate("naming")...)
+		if f.Spec.Naming.Ordinal {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Field:   "naming.ordinal",
+				Message: "ordinal naming is only supported by GameServerSets",
+			})
+		}
+	}
+	causes = append(causes, validateAllocationVisibleAnnotation(f.Spec.Template.ObjectMeta.Annotations)...)
+	causes = append(causes, validateDeletionCostAnnotation(f.Spec.Template.ObjectMeta.Annotations)...)
+	if _, err := f.Restart(); err != nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Field:   "annotations",
+			Message: err.Error(),
+		})
+	}
+
 	// check Gameserver specification in a Fleet
 	gsCauses := validateGSSpec(f)
 	if len(gsCauses) > 0 {
@@ -183,6 +311,137 @@ func (f *Fleet) Validate() ([]metav1.StatusCause, bool) {
 	return causes, len(causes) == 0
 }
 
+// Lint returns warnings for a Fleet that is valid, but whose template is likely to cause problems,
+// including the problems specific to the spot or preemptible node pools of the Fleet.
+// It should be called after ApplyDefaults.
+func (f *Fleet) Lint() []string {
+	warnings := f.Spec.Template.Spec.Lint()
+	if isSpotNodeSelector(f.Spec.Template.Spec.Template.Spec.NodeSelector) {
+		return warnings
+	}
+	for i, pool := range f.Spec.NodePools {
+		if !isSpotNodeSelector(pool.NodeSelector) {
+			continue
+		}
+		for _, w := range f.NodePoolFleet(i).Spec.Template.Spec.lintSpotImages() {
+			warnings = append(warnings, fmt.Sprintf("node pool %s: %s", pool.Name, w))
+		}
+	}
+	return warnings
+}
+
+// Restart returns the restart requested with the FleetRestartAnnotation and FleetRestartSelectorAnnotation
+// annotations, or nil if there is none. Returns an error if the annotations are invalid.
+func (f *Fleet) Restart() (*FleetRestart, error) {
+	before, ok := f.ObjectMeta.Annotations[FleetRestartAnnotation]
+	selector, hasSelector := f.ObjectMeta.Annotations[FleetRestartSelectorAnnotation]
+	if !ok {
+		if hasSelector {
+			return nil, errors.Errorf("annotation %s requires the %s annotation", FleetRestartSelectorAnnotation, FleetRestartAnnotation)
+		}
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, before)
+	if err != nil {
+		return nil, errors.Errorf("annotation %s must be a time in RFC3339 format, was %q", FleetRestartAnnotation, before)
+	}
+	s, err := labels.Parse(selector)
+	if err != nil {
+		return nil, errors.Wrapf(err, "annotation %s must be a label selector", FleetRestartSelectorAnnotation)
+	}
+	return &FleetRestart{Before: t, Selector: s}, nil
+}
+
+// validateStrategies checks the replicas, and the deployment, scheduling and scale down strategies,
+// which are otherwise only checked by the CRD schema. Empty strategies are allowed, as they are defaulted elsewhere.
+func (f *Fleet) validateStrategies() []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if f.Spec.Replicas < 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Field:   "replicas",
+			Message: "replicas must be 0 or greater",
+		})
+	}
+
+	switch f.Spec.Strategy.Type {
+	case "", appsv1.RecreateDeploymentStrategyType, appsv1.RollingUpdateDeploymentStrategyType:
+	default:
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Field:   "strategy.type",
+			Message: fmt.Sprintf("Invalid value: %s, value must be Recreate or RollingUpdate", f.Spec.Strategy.Type),
+		})
+	}
+
+	switch f.Spec.Scheduling {
+	case "", apis.Packed, apis.Distributed:
+	default:
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Field:   "scheduling",
+			Message: fmt.Sprintf("Invalid value: %s, value must be Packed or Distributed", f.Spec.Scheduling),
+		})
+	}
+
+	switch f.Spec.ScaleDownStrategy {
+	case "", PackedScaleDown, DistributedScaleDown, OldestFirstScaleDown, NewestFirstScaleDown:
+	default:
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Field:   "scaleDownStrategy",
+			Message: fmt.Sprintf("Invalid value: %s, value must be Packed, Distributed, OldestFirst or NewestFirst", f.Spec.ScaleDownStrategy),
+		})
+	}
+
+	return causes
+}
+
+// validateNodePools validates that node pools have unique names that can be used as label values,
+// positive weights, and node selectors that don't conflict with the template's nodeSelector
+func (f *Fleet) validateNodePools() []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	names := map[string]bool{}
+	for i, pool := range f.Spec.NodePools {
+		field := fmt.Sprintf("nodePools[%d]", i)
+		if errs := validation.IsValidLabelValue(pool.Name); pool.Name == "" || len(errs) > 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Field:   field + ".name",
+				Message: fmt.Sprintf("Node pool name %q must be a non-empty, valid label value", pool.Name),
+			})
+		}
+		if names[pool.Name] {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueDuplicate,
+				Field:   field + ".name",
+				Message: fmt.Sprintf("Node pool name %q is used more than once", pool.Name),
+			})
+		}
+		names[pool.Name] = true
+
+		if pool.Weight < 1 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Field:   field + ".weight",
+				Message: "Node pool weight must be 1 or greater",
+			})
+		}
+
+		for k, v := range pool.NodeSelector {
+			if existing, ok := f.Spec.Template.Spec.Template.Spec.NodeSelector[k]; ok && existing != v {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Field:   field + ".nodeSelector",
+					Message: fmt.Sprintf("Node pool selector %s=%s conflicts with the template nodeSelector %s=%s", k, v, k, existing),
+				})
+			}
+		}
+	}
+	return causes
+}
+
 // UpperBoundReplicas returns whichever is smaller,
 // the value i, or the f.Spec.Replicas.
 func (f *Fleet) UpperBoundReplicas(i int32) int32 {
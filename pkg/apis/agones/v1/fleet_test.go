@@ -15,12 +15,15 @@
 package v1
 
 import (
+	"fmt"
 	"testing"
+	"time"
 
 	"agones.dev/agones/pkg/apis"
 	"github.com/stretchr/testify/assert"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/validation"
@@ -56,8 +59,24 @@ func TestFleetGameServerSetGameServer(t *testing.T) {
 	assert.Equal(t, f.ObjectMeta.Name, gsSet.ObjectMeta.Labels[FleetNameLabel])
 	assert.Equal(t, int32(0), gsSet.Spec.Replicas)
 	assert.Equal(t, f.Spec.Scheduling, gsSet.Spec.Scheduling)
+	assert.Equal(t, ScaleDownStrategy(""), gsSet.Spec.ScaleDownStrategy)
 	assert.Equal(t, f.Spec.Template, gsSet.Spec.Template)
+	assert.Nil(t, gsSet.Spec.Naming)
 	assert.True(t, metav1.IsControlledBy(gsSet, &f))
+
+	f.Spec.Naming = &GameServerNaming{Prefix: "test-shard-", Digits: 4}
+	gsSet = f.GameServerSet()
+	assert.Equal(t, f.Spec.Naming, gsSet.Spec.Naming)
+	assert.False(t, f.Spec.Naming == gsSet.Spec.Naming, "naming should be copied")
+
+	f.Spec.ScaleDownStrategy = NewestFirstScaleDown
+	gsSet = f.GameServerSet()
+	assert.Equal(t, NewestFirstScaleDown, gsSet.Spec.ScaleDownStrategy)
+
+	f.Spec.MaxLifetime = &metav1.Duration{Duration: 24 * time.Hour}
+	gsSet = f.GameServerSet()
+	assert.Equal(t, f.Spec.MaxLifetime, gsSet.Spec.MaxLifetime)
+	assert.False(t, f.Spec.MaxLifetime == gsSet.Spec.MaxLifetime, "max lifetime should be copied")
 }
 
 func TestFleetApplyDefaults(t *testing.T) {
@@ -176,6 +195,20 @@ func TestFleetName(t *testing.T) {
 	assert.Len(t, causes, 0)
 }
 
+func TestFleetValidateNaming(t *testing.T) {
+	f := defaultFleet()
+	f.Spec.Naming = &GameServerNaming{Prefix: "shard-", Digits: 4}
+	causes, ok := f.Validate()
+	assert.True(t, ok)
+	assert.Len(t, causes, 0)
+
+	f.Spec.Naming.Ordinal = true
+	causes, ok = f.Validate()
+	assert.False(t, ok)
+	assert.Len(t, causes, 1)
+	assert.Equal(t, "naming.ordinal", causes[0].Field)
+}
+
 func TestSumStatusReplicas(t *testing.T) {
 	fixture := []*GameServerSet{
 		{Status: GameServerSetStatus{Replicas: 10}},
@@ -186,6 +219,210 @@ func TestSumStatusReplicas(t *testing.T) {
 	assert.Equal(t, int32(30), SumStatusReplicas(fixture))
 }
 
+func TestFleetNodePoolReplicas(t *testing.T) {
+	t.Parallel()
+
+	fixtures := map[string]struct {
+		replicas int32
+		weights  []int32
+		expected []int32
+	}{
+		"no pools":             {replicas: 10, weights: nil, expected: []int32{}},
+		"even split":           {replicas: 10, weights: []int32{1, 1}, expected: []int32{5, 5}},
+		"reserved and spot":    {replicas: 10, weights: []int32{70, 30}, expected: []int32{7, 3}},
+		"largest remainder":    {replicas: 10, weights: []int32{2, 1}, expected: []int32{7, 3}},
+		"tie goes to first":    {replicas: 1, weights: []int32{1, 1}, expected: []int32{1, 0}},
+		"three ways":           {replicas: 11, weights: []int32{1, 1, 1}, expected: []int32{4, 4, 3}},
+		"zero replicas":        {replicas: 0, weights: []int32{3, 1}, expected: []int32{0, 0}},
+		"more pools than reps": {replicas: 2, weights: []int32{1, 1, 1}, expected: []int32{1, 1, 0}},
+	}
+
+	for k, v := range fixtures {
+		t.Run(k, func(t *testing.T) {
+			f := defaultFleet()
+			f.Spec.Replicas = v.replicas
+			for i, w := range v.weights {
+				f.Spec.NodePools = append(f.Spec.NodePools, FleetNodePool{Name: fmt.Sprintf("pool-%d", i), Weight: w})
+			}
+			assert.Equal(t, v.expected, f.NodePoolReplicas())
+		})
+	}
+}
+
+func TestFleetNodePoolFleet(t *testing.T) {
+	t.Parallel()
+
+	f := defaultFleet()
+	f.Spec.Replicas = 10
+	f.Spec.Template.Spec.Template.Spec.NodeSelector = map[string]string{"zone": "a"}
+	f.Spec.NodePools = []FleetNodePool{
+		{Name: "reserved", Weight: 70, NodeSelector: map[string]string{"pool": "reserved"}},
+		{Name: "spot", Weight: 30, NodeSelector: map[string]string{"pool": "spot"}},
+	}
+
+	spot := f.NodePoolFleet(1)
+	assert.Equal(t, int32(3), spot.Spec.Replicas)
+	assert.Empty(t, spot.Spec.NodePools)
+	assert.Equal(t, map[string]string{"zone": "a", "pool": "spot"}, spot.Spec.Template.Spec.Template.Spec.NodeSelector)
+
+	// the original Fleet is not modified
+	assert.Equal(t, int32(10), f.Spec.Replicas)
+	assert.Equal(t, map[string]string{"zone": "a"}, f.Spec.Template.Spec.Template.Spec.NodeSelector)
+	assert.Len(t, f.Spec.NodePools, 2)
+}
+
+func TestFleetLint(t *testing.T) {
+	t.Parallel()
+
+	f := defaultFleet()
+	f.ApplyDefaults()
+	assert.Equal(t, []string{
+		"container testing has no cpu request, so its GameServers can be scheduled onto nodes without the cpu to run them",
+		"container testing has no memory request, so its GameServers can be scheduled onto nodes without the memory to run them",
+	}, f.Lint())
+
+	f.Spec.Template.Spec.Template.Spec.Containers[0].Resources.Requests = corev1.ResourceList{
+		corev1.ResourceCPU:    resource.MustParse("100m"),
+		corev1.ResourceMemory: resource.MustParse("64Mi"),
+	}
+	assert.Empty(t, f.Lint())
+
+	f.Spec.Template.Spec.Health.Disabled = true
+	warnings := f.Lint()
+	if assert.Len(t, warnings, 1) {
+		assert.Contains(t, warnings[0], "health checking is disabled")
+	}
+	f.Spec.Template.Spec.Health.Disabled = false
+
+	// the image has no tag, so it is pulled every time on the spot pool
+	f.Spec.NodePools = []FleetNodePool{
+		{Name: "reserved", Weight: 70, NodeSelector: map[string]string{"pool": "reserved"}},
+		{Name: "spot", Weight: 30, NodeSelector: map[string]string{"cloud.google.com/gke-spot": "true"}},
+	}
+	warnings = f.Lint()
+	if assert.Len(t, warnings, 1) {
+		assert.Contains(t, warnings[0], "node pool spot: container testing pulls its image every time")
+	}
+
+	f.Spec.NodePools = nil
+	f.Spec.Template.Spec.Template.Spec.NodeSelector = map[string]string{"eks.amazonaws.com/capacityType": "SPOT"}
+	warnings = f.Lint()
+	if assert.Len(t, warnings, 1) {
+		assert.Contains(t, warnings[0], "container testing pulls its image every time")
+	}
+
+	f.Spec.Template.Spec.Template.Spec.Containers[0].Image = "testing/image:1.0"
+	assert.Empty(t, f.Lint())
+}
+
+func TestFleetValidateNodePools(t *testing.T) {
+	t.Parallel()
+
+	f := defaultFleet()
+	f.ApplyDefaults()
+	f.Spec.NodePools = []FleetNodePool{
+		{Name: "reserved", Weight: 70, NodeSelector: map[string]string{"pool": "reserved"}},
+		{Name: "spot", Weight: 30, NodeSelector: map[string]string{"pool": "spot"}},
+	}
+	causes, ok := f.Validate()
+	assert.True(t, ok)
+	assert.Empty(t, causes)
+
+	f.Spec.Template.Spec.Template.Spec.NodeSelector = map[string]string{"pool": "reserved"}
+	f.Spec.NodePools = append(f.Spec.NodePools,
+		FleetNodePool{Name: "spot", Weight: 1},
+		FleetNodePool{Name: "", Weight: 0})
+	causes, ok = f.Validate()
+	assert.False(t, ok)
+	fields := []string{}
+	for _, c := range causes {
+		fields = append(fields, c.Field)
+	}
+	assert.ElementsMatch(t, []string{"nodePools[1].nodeSelector", "nodePools[2].name", "nodePools[3].name", "nodePools[3].weight"}, fields)
+}
+
+func TestFleetValidateStrategies(t *testing.T) {
+	t.Parallel()
+
+	f := defaultFleet()
+	f.Spec.Replicas = 0
+	f.Spec.Strategy.Type = appsv1.RecreateDeploymentStrategyType
+	f.Spec.Scheduling = apis.Distributed
+	f.Spec.ScaleDownStrategy = OldestFirstScaleDown
+	f.ApplyDefaults()
+	causes, ok := f.Validate()
+	assert.True(t, ok)
+	assert.Empty(t, causes)
+
+	f.Spec.Replicas = -1
+	f.Spec.Strategy.Type = "Nope"
+	f.Spec.Scheduling = "Nope"
+	f.Spec.ScaleDownStrategy = "Nope"
+	causes, ok = f.Validate()
+	assert.False(t, ok)
+	fields := []string{}
+	for _, c := range causes {
+		fields = append(fields, c.Field)
+	}
+	assert.Equal(t, []string{"replicas", "strategy.type", "scheduling", "scaleDownStrategy"}, fields)
+}
+
+func TestFleetValidateMaxLifetime(t *testing.T) {
+	t.Parallel()
+
+	f := defaultFleet()
+	f.ApplyDefaults()
+	f.Spec.MaxLifetime = &metav1.Duration{Duration: MinMaxLifetime}
+	causes, ok := f.Validate()
+	assert.True(t, ok)
+	assert.Empty(t, causes)
+
+	f.Spec.MaxLifetime.Duration = time.Second
+	causes, ok = f.Validate()
+	assert.False(t, ok)
+	if assert.Len(t, causes, 1) {
+		assert.Equal(t, "maxLifetime", causes[0].Field)
+		assert.Equal(t, "maxLifetime must be at least 1m0s", causes[0].Message)
+	}
+}
+
+func TestFleetRestart(t *testing.T) {
+	t.Parallel()
+
+	f := defaultFleet()
+	f.ApplyDefaults()
+	r, err := f.Restart()
+	assert.NoError(t, err)
+	assert.Nil(t, r)
+
+	f.ObjectMeta.Annotations[FleetRestartAnnotation] = "2019-11-05T20:00:00Z"
+	r, err = f.Restart()
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2019, 11, 5, 20, 0, 0, 0, time.UTC), r.Before.UTC())
+	assert.True(t, r.Selector.Empty())
+	_, ok := f.Validate()
+	assert.True(t, ok)
+
+	f.ObjectMeta.Annotations[FleetRestartSelectorAnnotation] = "mode in (ranked, casual)"
+	r, err = f.Restart()
+	assert.NoError(t, err)
+	assert.Equal(t, "mode in (casual,ranked)", r.Selector.String())
+
+	for _, annotations := range []map[string]string{
+		{FleetRestartAnnotation: "now"},
+		{FleetRestartAnnotation: "2019-11-05T20:00:00Z", FleetRestartSelectorAnnotation: "mode in ranked"},
+		{FleetRestartSelectorAnnotation: "mode=ranked"},
+	} {
+		f.ObjectMeta.Annotations = annotations
+		_, err = f.Restart()
+		assert.Error(t, err, "%v should be invalid", annotations)
+		causes, ok := f.Validate()
+		assert.False(t, ok)
+		assert.Len(t, causes, 1)
+		assert.Equal(t, "annotations", causes[0].Field)
+	}
+}
+
 func defaultFleet() *Fleet {
 	gs := GameServer{
 		Spec: GameServerSpec{
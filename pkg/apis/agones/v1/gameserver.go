@@ -18,6 +18,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"net"
+	"strconv"
+	"strings"
 
 	"github.com/mattbaird/jsonpatch"
 
@@ -28,6 +30,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/validation"
 )
 
 const (
@@ -70,6 +73,9 @@ const (
 	// This will mean that users will need to lookup what port has been opened through the server side SDK.
 	Passthrough PortPolicy = "Passthrough"
 
+	// ProtocolTCPUDP Protocol exposes the same port number for both TCP and UDP
+	ProtocolTCPUDP corev1.Protocol = "TCPUDP"
+
 	// SdkServerLogLevelInfo will cause the SDK server to output all messages except for debug messages.
 	SdkServerLogLevelInfo SdkServerLogLevel = "Info"
 	// SdkServerLogLevelDebug will cause the SDK server to output all messages including debug messages.
@@ -82,15 +88,96 @@ const (
 	RoleLabel = agones.GroupName + "/role"
 	// GameServerLabelRole is the GameServer label value for RoleLabel
 	GameServerLabelRole = "gameserver"
+	// SidecarContainerName is the name of the Agones SDK sidecar container in the Pods of GameServers
+	SidecarContainerName = "agones-gameserver-sidecar"
+	// ServiceAccountVolumeName is the name of the volume that replaces the service account token in the
+	// game server container, when the pod template doesn't set a service account
+	ServiceAccountVolumeName = "empty"
 	// GameServerPodLabel is the label that the name of the GameServer
 	// is set on the Pod the GameServer controls
 	GameServerPodLabel = agones.GroupName + "/gameserver"
+	// GameServerStateLabel is the label with the state of a GameServer, kept in sync with its status,
+	// so that GameServers can be listed and watched by state with a label selector
+	GameServerStateLabel = agones.GroupName + "/gameserver-state"
+	// GameServerNodeNameLabel is the label with the name of the node of a GameServer, kept in sync with its status,
+	// so that GameServers can be listed and watched by node with a label selector
+	GameServerNodeNameLabel = agones.GroupName + "/node-name"
 	// GameServerContainerAnnotation is the annotation that stores
 	// which container is the container that runs the dedicated game server
 	GameServerContainerAnnotation = agones.GroupName + "/container"
 	// DevAddressAnnotation is an annotation to indicate that a GameServer hosted outside of Agones.
 	// A locally hosted GameServer is not managed by Agones it is just simply registered.
 	DevAddressAnnotation = "agones.dev/dev-address"
+	// AllocationVisibleAnnotation is the annotation that lists, separated by commas, the keys of the
+	// GameServer annotations that are copied into the status of a GameServerAllocation of the GameServer
+	AllocationVisibleAnnotation = agones.GroupName + "/allocation-visible-annotations"
+	// GameServerShutdownReasonAnnotation is the annotation with the reason that Agones shut down a GameServer.
+	// It is not set on GameServers that shut down through the SDK, or that are deleted directly.
+	GameServerShutdownReasonAnnotation = agones.GroupName + "/shutdown-reason"
+	// GameServerTransferAnnotation is the annotation that requests the transfer of an Allocated GameServer
+	// from an inactive GameServerSet of its Fleet to the active one, when their templates are compatible
+	GameServerTransferAnnotation = agones.GroupName + "/transfer-to-active"
+	// GameServerRestartAnnotation is the annotation that the Fleet controller sets on a Ready GameServer
+	// to restart it, for its GameServerSet to replace it before shutting it down
+	GameServerRestartAnnotation = agones.GroupName + "/restart-pending"
+	// GameServerUnhealthyFromAnnotation is the annotation with the state a GameServer was in when it became Unhealthy
+	GameServerUnhealthyFromAnnotation = agones.GroupName + "/unhealthy-from"
+	// GameServerAllocatedAnnotation is the annotation that is set on a GameServer once it has been Allocated,
+	// and that it keeps if it moves back to Ready, so that it is not recycled once it is past the MaxLifetime of its GameServerSet
+	GameServerAllocatedAnnotation = agones.GroupName + "/allocated"
+	// GameServerDeletionCostAnnotation is the annotation with the cost of deleting a GameServer, as an integer.
+	// When a GameServerSet scales down, GameServers with a lower deletion cost are deleted first.
+	GameServerDeletionCostAnnotation = agones.GroupName + "/deletion-cost"
+	// GameServerSDKDeletionCostAnnotation is the deletion cost annotation as set by the game server
+	// through the SDK with SetAnnotation("deletion-cost", ...), used when GameServerDeletionCostAnnotation is not set
+	GameServerSDKDeletionCostAnnotation = agones.GroupName + "/sdk-deletion-cost"
+	// SafeToEvictAnnotation is the Pod annotation that tells the cluster autoscaler whether it can
+	// evict the Pod when it removes the Node the Pod is on
+	SafeToEvictAnnotation = "cluster-autoscaler.kubernetes.io/safe-to-evict"
+	// SdkServerSidecarAnnotation is the annotation that, when set to "false", tells the controller not to add the
+	// SDK server sidecar to the Pod of a GameServer, whose game server runs the SDK server itself
+	SdkServerSidecarAnnotation = agones.GroupName + "/sdk-server-sidecar"
+	// SdkServerHealthPort is the port on which the SDK server serves the health check of the game server
+	SdkServerHealthPort = 8080
+	// SdkServerTokenKey is the key of the token in the Secret of the SdkServer TokenSecretName
+	SdkServerTokenKey = "token"
+	// SdkServerTokenVolumeName is the name of the volume of the Secret of the SdkServer TokenSecretName
+	SdkServerTokenVolumeName = "agones-sdk-token"
+	// SdkServerTokenMountPath is the directory the Secret of the SdkServer TokenSecretName is mounted
+	// on in the containers of the Pod, so the token is in the SdkServerTokenKey file of this directory
+	SdkServerTokenMountPath = "/var/run/agones/sdk"
+	// PassthroughPortEnvVar is the environment variable of the game server container that is set to
+	// the port allocated to its first Passthrough port. The port allocated to each named Passthrough port
+	// is also set in this variable suffixed with the upper cased port name, e.g. AGONES_PASSTHROUGH_PORT_GAME
+	PassthroughPortEnvVar = "AGONES_PASSTHROUGH_PORT"
+)
+
+// ShutdownReason is the reason a GameServer was shut down and deleted
+type ShutdownReason string
+
+const (
+	// ShutdownReasonScaleDown is for GameServers deleted by their GameServerSet scaling down
+	ShutdownReasonScaleDown ShutdownReason = "ScaleDown"
+	// ShutdownReasonRollout is for GameServers deleted by scaling down a GameServerSet
+	// whose template is being replaced by a Fleet update
+	ShutdownReasonRollout ShutdownReason = "Rollout"
+	// ShutdownReasonRestart is for GameServers restarted by a Fleet restart
+	ShutdownReasonRestart ShutdownReason = "Restart"
+	// ShutdownReasonUnhealthy is for Unhealthy GameServers replaced by their GameServerSet
+	ShutdownReasonUnhealthy ShutdownReason = "Unhealthy"
+	// ShutdownReasonError is for GameServers in the Error state replaced by their GameServerSet
+	ShutdownReasonError ShutdownReason = "Error"
+	// ShutdownReasonNodeDrain is for Ready GameServers shut down because their node is being drained or removed
+	ShutdownReasonNodeDrain ShutdownReason = "NodeDrain"
+	// ShutdownReasonDeletion is for GameServers shut down by a GameServerDeletion request
+	ShutdownReasonDeletion ShutdownReason = "GameServerDeletion"
+	// ShutdownReasonSDK is for GameServers that shut down through the SDK
+	ShutdownReasonSDK ShutdownReason = "SDKShutdown"
+	// ShutdownReasonRecycle is for Ready GameServers replaced by their GameServerSet after their MaxLifetime
+	ShutdownReasonRecycle ShutdownReason = "Recycle"
+	// ShutdownReasonManual is for GameServers that were deleted directly, e.g. with kubectl,
+	// or along with their GameServerSet or Fleet
+	ShutdownReasonManual ShutdownReason = "Manual"
 )
 
 var (
@@ -144,16 +231,102 @@ type GameServerSpec struct {
 	Scheduling apis.SchedulingStrategy `json:"scheduling,omitempty"`
 	// SdkServer specifies parameters for the Agones SDK Server sidecar container
 	SdkServer SdkServer `json:"sdkServer,omitempty"`
+	// Counters are the initial counts and capacities of the GameServer's counters, by counter name
+	Counters map[string]CounterStatus `json:"counters,omitempty"`
+	// Lists are the initial values and capacities of the GameServer's lists, by list name
+	Lists map[string]ListStatus `json:"lists,omitempty"`
+	// PortRange restricts the host ports that are dynamically allocated to the GameServer.
+	// It must be within the port range of the controller. Defaults to the port range of the controller.
+	PortRange *PortRange `json:"portRange,omitempty"`
+	// Eviction is whether the cluster autoscaler can evict the Pod of the GameServer.
+	// If not set, a Packed GameServer is never evicted, and a Distributed one follows the cluster autoscaler rules.
+	Eviction *Eviction `json:"eviction,omitempty"`
+	// TerminationGracePeriodSeconds is how long the Pod of the GameServer keeps running once the GameServer is deleted,
+	// for the game server to finish its game session and call Shutdown through the SDK, which ends the window early.
+	// Defaults to 0, in which case the Pod is deleted right away.
+	TerminationGracePeriodSeconds int32 `json:"terminationGracePeriodSeconds,omitempty"`
+	// PreDelete is a hook that is run in the game server container when its Pod is deleted, before the container is
+	// stopped, for game servers that need to flush their state to external storage
+	PreDelete *PreDeleteHook `json:"preDelete,omitempty"`
 	// Template describes the Pod that will be created for the GameServer
 	Template corev1.PodTemplateSpec `json:"template"`
 }
 
+// PreDeleteHook is a command or HTTP GET request that is run in the game server container when its Pod is deleted.
+// Exactly one of Exec or HTTPGet must be set.
+type PreDeleteHook struct {
+	// Exec is the command that is run in the game server container
+	Exec *corev1.ExecAction `json:"exec,omitempty"`
+	// HTTPGet is the HTTP GET request that is made to the game server container
+	HTTPGet *corev1.HTTPGetAction `json:"httpGet,omitempty"`
+	// TimeoutSeconds is how long the hook is waited for, before the game server container is sent SIGTERM,
+	// on top of the termination grace period of the Pod. Defaults to 30
+	TimeoutSeconds int32 `json:"timeoutSeconds,omitempty"`
+}
+
 // GameServerState is the state for the GameServer
 type GameServerState string
 
+// PortRange is an inclusive range of host ports
+type PortRange struct {
+	// MinPort is the lowest port in the range
+	MinPort int32 `json:"minPort"`
+	// MaxPort is the highest port in the range
+	MaxPort int32 `json:"maxPort"`
+}
+
+// Contains returns true if the port is within the range
+func (pr PortRange) Contains(port int32) bool {
+	return port >= pr.MinPort && port <= pr.MaxPort
+}
+
+// ValidateStaticPorts validates that the host ports of the Static ports are outside of the range of
+// ports that the controller allocates to Dynamic and Passthrough ports, as those are allocated without
+// knowing which Static ports are bound on a node.
+func (gss *GameServerSpec) ValidateStaticPorts(r PortRange) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	for _, p := range gss.Ports {
+		if p.PortPolicy == Static && r.Contains(p.HostPort) {
+			causes = append(causes, metav1.StatusCause{
+				Type:  metav1.CauseTypeFieldValueInvalid,
+				Field: fmt.Sprintf("%s.hostPort", p.Name),
+				Message: fmt.Sprintf("HostPort %d of a Static port cannot be within the port range %d-%d of Dynamic and Passthrough ports",
+					p.HostPort, r.MinPort, r.MaxPort),
+			})
+		}
+	}
+	return causes
+}
+
 // PortPolicy is the port policy for the GameServer
 type PortPolicy string
 
+// EvictionSafe is whether the cluster autoscaler can evict the Pod of a GameServer
+type EvictionSafe string
+
+// HealthProbeType is the type of check that a HealthProbe makes
+type HealthProbeType string
+
+const (
+	// HealthProbeTCP checks that the game server accepts TCP connections on the port
+	HealthProbeTCP HealthProbeType = "TCP"
+	// HealthProbeHTTP checks that a HTTP GET request of the path, on the port, returns a 2xx or 3xx status code
+	HealthProbeHTTP HealthProbeType = "HTTP"
+)
+
+const (
+	// EvictionSafeAlways lets the cluster autoscaler evict the Pod of the GameServer when it removes its Node
+	EvictionSafeAlways EvictionSafe = "Always"
+	// EvictionSafeNever stops the cluster autoscaler from removing the Node of the GameServer while its Pod runs
+	EvictionSafeNever EvictionSafe = "Never"
+)
+
+// Eviction configures whether the cluster autoscaler can evict the Pod of a GameServer
+type Eviction struct {
+	// Safe is whether the Pod is safe to evict, Always or Never
+	Safe EvictionSafe `json:"safe,omitempty"`
+}
+
 // Health configures health checking on the GameServer
 type Health struct {
 	// Disabled is whether health checking is disabled or not
@@ -164,6 +337,22 @@ type Health struct {
 	FailureThreshold int32 `json:"failureThreshold,omitempty"`
 	// InitialDelaySeconds initial delay before checking health
 	InitialDelaySeconds int32 `json:"initialDelaySeconds,omitempty"`
+	// Probes are checks of the game server that the SDK server makes every PeriodSeconds, alongside the health pings.
+	// The GameServer is unhealthy when a probe fails FailureThreshold times in a row.
+	Probes []HealthProbe `json:"probes,omitempty"`
+}
+
+// HealthProbe is a check of the game server, e.g. that its netcode port answers,
+// for game servers where that is a better health signal than the health pings of the SDK
+type HealthProbe struct {
+	// Name identifies the probe in logs and events
+	Name string `json:"name"`
+	// Type is the type of the check, TCP or HTTP
+	Type HealthProbeType `json:"type"`
+	// Port is the container port that is checked
+	Port int32 `json:"port"`
+	// Path is the path of the HTTP GET request of a HTTP probe. Defaults to "/"
+	Path string `json:"path,omitempty"`
 }
 
 // GameServerPort defines a set of Ports that
@@ -181,7 +370,7 @@ type GameServerPort struct {
 	ContainerPort int32 `json:"containerPort,omitempty"`
 	// HostPort the port exposed on the host for clients to connect to
 	HostPort int32 `json:"hostPort,omitempty"`
-	// Protocol is the network protocol being used. Defaults to UDP. TCP is the only other option
+	// Protocol is the network protocol being used. Defaults to UDP. TCP and TCPUDP are the other options
 	Protocol corev1.Protocol `json:"protocol,omitempty"`
 }
 
@@ -196,6 +385,9 @@ type SdkServer struct {
 	GRPCPort int32 `json:"grpcPort,omitempty"`
 	// HTTPPort is the port on which the SDK Server binds the HTTP gRPC gateway server to accept incoming connections
 	HTTPPort int32 `json:"httpPort,omitempty"`
+	// TokenSecretName is the name of a Secret, in the namespace of the GameServer, whose token the SDK Server
+	// requires from its clients. If not set, any process in the Pod can call the SDK Server.
+	TokenSecretName string `json:"tokenSecretName,omitempty"`
 }
 
 // GameServerStatus is the status for a GameServer resource
@@ -206,6 +398,72 @@ type GameServerStatus struct {
 	Address       string                 `json:"address"`
 	NodeName      string                 `json:"nodeName"`
 	ReservedUntil *metav1.Time           `json:"reservedUntil"`
+	// ReadyTime is when the GameServer last became Ready after the game server process called SDK.Ready()
+	ReadyTime *metav1.Time `json:"readyTime,omitempty"`
+	// Counters are the current counts and capacities of the GameServer's counters, by counter name
+	Counters map[string]CounterStatus `json:"counters,omitempty"`
+	// Lists are the current values and capacities of the GameServer's lists, by list name
+	Lists map[string]ListStatus `json:"lists,omitempty"`
+	// Disruption is set when the GameServer is likely to be disrupted soon, because of the state of its node
+	Disruption *GameServerDisruption `json:"disruption,omitempty"`
+}
+
+// GameServerDisruptionReason is the reason a GameServer is likely to be disrupted
+type GameServerDisruptionReason string
+
+const (
+	// DisruptionNodeScaleDown is when the cluster autoscaler is removing the GameServer's node
+	DisruptionNodeScaleDown GameServerDisruptionReason = "NodeScaleDown"
+	// DisruptionNodeNotReady is when the GameServer's node is not ready
+	DisruptionNodeNotReady GameServerDisruptionReason = "NodeNotReady"
+	// DisruptionNodeDraining is when the GameServer's node has been cordoned, usually before it is drained
+	DisruptionNodeDraining GameServerDisruptionReason = "NodeDraining"
+	// DisruptionNodeScaleDownCandidate is when the cluster autoscaler considers the GameServer's node
+	// unneeded, and may remove it
+	DisruptionNodeScaleDownCandidate GameServerDisruptionReason = "NodeScaleDownCandidate"
+)
+
+// GameServerDisruption is a forecast of an imminent disruption of a GameServer,
+// so that the game can migrate its sessions before it happens
+type GameServerDisruption struct {
+	// Reason the GameServer is likely to be disrupted
+	Reason GameServerDisruptionReason `json:"reason"`
+	// Message is a human readable description of the disruption
+	Message string `json:"message,omitempty"`
+	// Since is when the disruption was first forecast
+	Since metav1.Time `json:"since"`
+}
+
+// CounterStatus is the current count and capacity of a GameServer counter,
+// e.g. the number of players connected to the GameServer, out of the maximum it can hold
+type CounterStatus struct {
+	Count    int64 `json:"count"`
+	Capacity int64 `json:"capacity"`
+}
+
+// Available returns the remaining capacity of the counter
+func (c CounterStatus) Available() int64 {
+	if c.Count >= c.Capacity {
+		return 0
+	}
+	return c.Capacity - c.Count
+}
+
+// ListStatus is the current values and capacity of a GameServer list,
+// e.g. the ids of the players connected to the GameServer
+type ListStatus struct {
+	Capacity int64    `json:"capacity"`
+	Values   []string `json:"values"`
+}
+
+// Contains returns true if the value is in the list
+func (l ListStatus) Contains(value string) bool {
+	for _, v := range l.Values {
+		if v == value {
+			return true
+		}
+	}
+	return false
 }
 
 // GameServerStatusPort shows the port that was allocated to a
@@ -215,6 +473,74 @@ type GameServerStatusPort struct {
 	Port int32  `json:"port"`
 }
 
+// Shutdown moves the GameServer to the Shutdown state, for the given reason,
+// which is recorded in the GameServerShutdownReasonAnnotation annotation.
+// The state label is updated with it.
+func (gs *GameServer) Shutdown(reason ShutdownReason) {
+	gs.Status.State = GameServerStateShutdown
+	if gs.ObjectMeta.Annotations == nil {
+		gs.ObjectMeta.Annotations = map[string]string{}
+	}
+	gs.ObjectMeta.Annotations[GameServerShutdownReasonAnnotation] = string(reason)
+	gs.ApplyStatusLabels()
+}
+
+// ShutdownReason returns the reason the GameServer was shut down: the GameServerShutdownReasonAnnotation annotation
+// if Agones shut it down, ShutdownReasonSDK if it shut down through the SDK, and ShutdownReasonManual otherwise
+func (gs *GameServer) ShutdownReason() ShutdownReason {
+	if reason := gs.ObjectMeta.Annotations[GameServerShutdownReasonAnnotation]; reason != "" {
+		return ShutdownReason(reason)
+	}
+	if gs.Status.State == GameServerStateShutdown {
+		return ShutdownReasonSDK
+	}
+	return ShutdownReasonManual
+}
+
+// DeletionCost returns the cost of deleting the GameServer from its GameServerDeletionCostAnnotation
+// annotation, or else its GameServerSDKDeletionCostAnnotation annotation. Returns 0 if neither is set
+// to a valid integer.
+func (gs *GameServer) DeletionCost() int32 {
+	for _, key := range []string{GameServerDeletionCostAnnotation, GameServerSDKDeletionCostAnnotation} {
+		if value, ok := gs.ObjectMeta.Annotations[key]; ok {
+			cost, err := strconv.ParseInt(value, 10, 32)
+			if err != nil {
+				return 0
+			}
+			return int32(cost)
+		}
+	}
+	return 0
+}
+
+// ApplyStatusLabels sets the GameServerStateLabel and GameServerNodeNameLabel labels to the state
+// and node name in the status of the GameServer, or removes them if those are empty, or are not
+// valid label values. Returns true if the labels have changed.
+func (gs *GameServer) ApplyStatusLabels() bool {
+	changed := false
+	apply := func(label, value string) {
+		current, ok := gs.ObjectMeta.Labels[label]
+		if value == "" || len(validation.IsValidLabelValue(value)) > 0 {
+			if ok {
+				delete(gs.ObjectMeta.Labels, label)
+				changed = true
+			}
+			return
+		}
+		if !ok || current != value {
+			if gs.ObjectMeta.Labels == nil {
+				gs.ObjectMeta.Labels = map[string]string{}
+			}
+			gs.ObjectMeta.Labels[label] = value
+			changed = true
+		}
+	}
+
+	apply(GameServerStateLabel, string(gs.Status.State))
+	apply(GameServerNodeNameLabel, gs.Status.NodeName)
+	return changed
+}
+
 // ApplyDefaults applies default values to the GameServer if they are not already populated
 func (gs *GameServer) ApplyDefaults() {
 	// VersionAnnotation is the annotation that stores
@@ -236,6 +562,78 @@ func (gss *GameServerSpec) ApplyDefaults() {
 	gss.applyHealthDefaults()
 	gss.applySchedulingDefaults()
 	gss.applySdkServerDefaults()
+	gss.applyPreDeleteDefaults()
+}
+
+// spotNodeLabels are the node labels, with their values, of the spot and preemptible node pools of the major cloud providers
+var spotNodeLabels = map[string]string{
+	"cloud.google.com/gke-preemptible":      "true",
+	"cloud.google.com/gke-spot":             "true",
+	"eks.amazonaws.com/capacityType":        "SPOT",
+	"kubernetes.azure.com/scalesetpriority": "spot",
+}
+
+// Lint returns warnings for a GameServerSpec that is valid, but is likely to cause problems,
+// such as GameServers that can't get the resources they need, or that are slow to start.
+// It should be called after ApplyDefaults.
+func (gss *GameServerSpec) Lint() []string {
+	var warnings []string
+	if gss.Health.Disabled {
+		warnings = append(warnings, "health checking is disabled, so GameServers that stop responding are not marked Unhealthy and replaced")
+	}
+	for _, c := range gss.Template.Spec.Containers {
+		for _, r := range []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory} {
+			if _, ok := c.Resources.Requests[r]; !ok {
+				warnings = append(warnings, fmt.Sprintf("container %s has no %s request, so its GameServers can be scheduled onto nodes without the %s to run them", c.Name, r, r))
+			}
+		}
+	}
+	if isSpotNodeSelector(gss.Template.Spec.NodeSelector) {
+		warnings = append(warnings, gss.lintSpotImages()...)
+	}
+	return warnings
+}
+
+// lintSpotImages returns warnings for the containers that pull their image every time they start,
+// which, on spot nodes that come and go, delays the start of many GameServers by the pull of the image
+func (gss *GameServerSpec) lintSpotImages() []string {
+	var warnings []string
+	for _, c := range gss.Template.Spec.Containers {
+		if pullsImageAlways(c) {
+			warnings = append(warnings, fmt.Sprintf("container %s pulls its image every time it starts on spot nodes, "+
+				"set a fixed image tag and an imagePullPolicy of IfNotPresent so that nodes pull large images only once", c.Name))
+		}
+	}
+	return warnings
+}
+
+// isSpotNodeSelector returns true if the node selector selects spot or preemptible nodes
+func isSpotNodeSelector(selector map[string]string) bool {
+	for k, v := range spotNodeLabels {
+		if selector[k] == v {
+			return true
+		}
+	}
+	return false
+}
+
+// pullsImageAlways returns true if the container pulls its image every time it starts, either
+// as its imagePullPolicy is Always, or as it is not set and the image has no tag or the latest tag
+func pullsImageAlways(c corev1.Container) bool {
+	switch c.ImagePullPolicy {
+	case corev1.PullAlways:
+		return true
+	case "":
+		if strings.Contains(c.Image, "@") {
+			return false
+		}
+		if i := strings.LastIndex(c.Image, ":"); i > strings.LastIndex(c.Image, "/") {
+			return c.Image[i+1:] == "latest"
+		}
+		return true
+	default:
+		return false
+	}
 }
 
 // applySdkServerDefaults applies the default log level ("Info") for the sidecar
@@ -272,6 +670,11 @@ func (gss *GameServerSpec) applyHealthDefaults() {
 		if gss.Health.InitialDelaySeconds <= 0 {
 			gss.Health.InitialDelaySeconds = 5
 		}
+		for i, p := range gss.Health.Probes {
+			if p.Type == HealthProbeHTTP && p.Path == "" {
+				gss.Health.Probes[i].Path = "/"
+			}
+		}
 	}
 }
 
@@ -284,6 +687,18 @@ func (gs *GameServer) applyStateDefaults() {
 			gs.Status.State = GameServerStatePortAllocation
 		}
 	}
+	if gs.Status.Counters == nil && len(gs.Spec.Counters) > 0 {
+		gs.Status.Counters = make(map[string]CounterStatus, len(gs.Spec.Counters))
+		for name, c := range gs.Spec.Counters {
+			gs.Status.Counters[name] = c
+		}
+	}
+	if gs.Status.Lists == nil && len(gs.Spec.Lists) > 0 {
+		gs.Status.Lists = make(map[string]ListStatus, len(gs.Spec.Lists))
+		for name, l := range gs.Spec.Lists {
+			gs.Status.Lists[name] = ListStatus{Capacity: l.Capacity, Values: append([]string(nil), l.Values...)}
+		}
+	}
 }
 
 // applyPortDefaults applies default values for all ports
@@ -300,6 +715,13 @@ func (gss *GameServerSpec) applyPortDefaults() {
 	}
 }
 
+// applyPreDeleteDefaults applies the default timeout of the pre-delete hook
+func (gss *GameServerSpec) applyPreDeleteDefaults() {
+	if gss.PreDelete != nil && gss.PreDelete.TimeoutSeconds == 0 {
+		gss.PreDelete.TimeoutSeconds = 30
+	}
+}
+
 func (gss *GameServerSpec) applySchedulingDefaults() {
 	if gss.Scheduling == "" {
 		gss.Scheduling = apis.Packed
@@ -348,6 +770,9 @@ func (gss GameServerSpec) Validate(devAddress string) ([]metav1.StatusCause, boo
 			})
 		}
 
+		// host ports of Static ports are all bound on the same node, so they can't be used twice for a protocol
+		staticPorts := map[string]bool{}
+
 		// no host port when using dynamic PortPolicy
 		for _, p := range gss.Ports {
 			if p.PortPolicy == Dynamic || p.PortPolicy == Static {
@@ -368,6 +793,14 @@ func (gss GameServerSpec) Validate(devAddress string) ([]metav1.StatusCause, boo
 				})
 			}
 
+			if p.Protocol != "" && p.Protocol != corev1.ProtocolUDP && p.Protocol != corev1.ProtocolTCP && p.Protocol != ProtocolTCPUDP {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Field:   fmt.Sprintf("%s.protocol", p.Name),
+					Message: ErrPortProtocolInvalid,
+				})
+			}
+
 			if p.HostPort > 0 && (p.PortPolicy == Dynamic || p.PortPolicy == Passthrough) {
 				causes = append(causes, metav1.StatusCause{
 					Type:    metav1.CauseTypeFieldValueInvalid,
@@ -375,6 +808,22 @@ func (gss GameServerSpec) Validate(devAddress string) ([]metav1.StatusCause, boo
 					Message: ErrHostPortDynamic,
 				})
 			}
+
+			if p.PortPolicy == Static && p.HostPort > 0 {
+				duplicate := false
+				for _, protocol := range p.protocols() {
+					key := fmt.Sprintf("%s/%d", protocol, p.HostPort)
+					duplicate = duplicate || staticPorts[key]
+					staticPorts[key] = true
+				}
+				if duplicate {
+					causes = append(causes, metav1.StatusCause{
+						Type:    metav1.CauseTypeFieldValueDuplicate,
+						Field:   fmt.Sprintf("%s.hostPort", p.Name),
+						Message: ErrHostPortDuplicate,
+					})
+				}
+			}
 		}
 
 		// make sure the container value points to a valid container
@@ -386,11 +835,234 @@ func (gss GameServerSpec) Validate(devAddress string) ([]metav1.StatusCause, boo
 				Message: err.Error(),
 			})
 		}
+
+		causes = append(causes, gss.validatePodTemplate()...)
+		causes = append(causes, gss.validateSdkServerPorts()...)
+		causes = append(causes, gss.validateSdkServerToken()...)
+	}
+
+	causes = append(causes, gss.Health.validateProbes()...)
+
+	for name, c := range gss.Counters {
+		if c.Count < 0 || c.Capacity < 0 || c.Count > c.Capacity {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Field:   fmt.Sprintf("counters.%s", name),
+				Message: ErrCounterInvalid,
+			})
+		}
 	}
+
+	for name, l := range gss.Lists {
+		if l.Capacity < 0 || int64(len(l.Values)) > l.Capacity {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Field:   fmt.Sprintf("lists.%s", name),
+				Message: ErrListInvalid,
+			})
+		}
+	}
+
+	if e := gss.Eviction; e != nil && e.Safe != "" && e.Safe != EvictionSafeAlways && e.Safe != EvictionSafeNever {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Field:   "eviction.safe",
+			Message: ErrEvictionSafeInvalid,
+		})
+	}
+
+	if gss.TerminationGracePeriodSeconds < 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Field:   "terminationGracePeriodSeconds",
+			Message: ErrTerminationGracePeriod,
+		})
+	}
+
+	causes = append(causes, gss.validatePreDelete()...)
+
+	if pr := gss.PortRange; pr != nil && (pr.MinPort <= 0 || pr.MaxPort < pr.MinPort || pr.MaxPort > 65535) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Field:   "portRange",
+			Message: ErrPortRangeInvalid,
+		})
+	}
+
 	return causes, len(causes) == 0
 
 }
 
+// validatePodTemplate validates that the pod template doesn't set any of the fields that Agones sets on the Pod
+// of the GameServer, as they would either be overwritten, or conflict with what Agones adds to the Pod
+func (gss GameServerSpec) validatePodTemplate() []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	for _, label := range []string{RoleLabel, GameServerPodLabel} {
+		if _, ok := gss.Template.ObjectMeta.Labels[label]; ok {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Field:   fmt.Sprintf("template.metadata.labels.%s", label),
+				Message: ErrReservedLabel,
+			})
+		}
+	}
+
+	for i, c := range gss.Template.Spec.Containers {
+		if c.Name == SidecarContainerName {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Field:   fmt.Sprintf("template.spec.containers[%d].name", i),
+				Message: ErrReservedContainerName,
+			})
+		}
+		for j, p := range c.Ports {
+			if p.HostPort != 0 {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Field:   fmt.Sprintf("template.spec.containers[%d].ports[%d].hostPort", i, j),
+					Message: ErrTemplateHostPort,
+				})
+			}
+		}
+	}
+
+	if gss.Template.Spec.ServiceAccountName == "" {
+		for i, v := range gss.Template.Spec.Volumes {
+			if v.Name == ServiceAccountVolumeName {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Field:   fmt.Sprintf("template.spec.volumes[%d].name", i),
+					Message: ErrReservedVolumeName,
+				})
+			}
+		}
+	}
+
+	return causes
+}
+
+// validateSdkServerPorts validates that the SDK server ports don't conflict with each other,
+// or with the container ports of the game server, since all the containers of the Pod share its network.
+// A port of 0 was not defaulted, for GameServers created before the ports could be set, and is ignored.
+func (gss GameServerSpec) validateSdkServerPorts() []metav1.StatusCause {
+	used := map[int32]bool{}
+	for _, p := range gss.Ports {
+		if p.ContainerPort > 0 {
+			used[p.ContainerPort] = true
+		}
+	}
+	for _, c := range gss.Template.Spec.Containers {
+		for _, p := range c.Ports {
+			used[p.ContainerPort] = true
+		}
+	}
+
+	var causes []metav1.StatusCause
+	check := func(field string, port, other int32) {
+		if port == 0 {
+			return
+		}
+		if port < 0 || port > 65535 || port == other || used[port] {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Field:   fmt.Sprintf("sdkServer.%s", field),
+				Message: ErrSdkServerPortConflict,
+			})
+		}
+	}
+	check("grpcPort", gss.SdkServer.GRPCPort, gss.SdkServer.HTTPPort)
+	check("httpPort", gss.SdkServer.HTTPPort, gss.SdkServer.GRPCPort)
+	return causes
+}
+
+// validateSdkServerToken validates that the name of the Secret of the SDK server token is valid,
+// and that the volume it is mounted from doesn't conflict with a volume of the pod template
+func (gss GameServerSpec) validateSdkServerToken() []metav1.StatusCause {
+	if gss.SdkServer.TokenSecretName == "" {
+		return nil
+	}
+
+	var causes []metav1.StatusCause
+	for _, msg := range validation.IsDNS1123Subdomain(gss.SdkServer.TokenSecretName) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Field:   "sdkServer.tokenSecretName",
+			Message: msg,
+		})
+	}
+	for i, v := range gss.Template.Spec.Volumes {
+		if v.Name == SdkServerTokenVolumeName {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Field:   fmt.Sprintf("template.spec.volumes[%d].name", i),
+				Message: ErrSdkServerTokenVolumeName,
+			})
+		}
+	}
+	return causes
+}
+
+// validateProbes validates that the health probes have a unique name, a type, and a valid port
+func (h Health) validateProbes() []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	names := map[string]bool{}
+	for i, p := range h.Probes {
+		invalid := func(field, message string) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Field:   fmt.Sprintf("health.probes[%d].%s", i, field),
+				Message: message,
+			})
+		}
+		if p.Name == "" || names[p.Name] {
+			invalid("name", ErrHealthProbeName)
+		}
+		names[p.Name] = true
+		if p.Type != HealthProbeTCP && p.Type != HealthProbeHTTP {
+			invalid("type", ErrHealthProbeType)
+		}
+		if p.Port < 1 || p.Port > 65535 {
+			invalid("port", ErrHealthProbePort)
+		}
+		if p.Path != "" && (p.Type != HealthProbeHTTP || !strings.HasPrefix(p.Path, "/")) {
+			invalid("path", ErrHealthProbePath)
+		}
+	}
+	return causes
+}
+
+// validatePreDelete validates that the pre-delete hook sets one action, a timeout that is not negative,
+// and that it doesn't replace the preStop hook of the game server container
+func (gss *GameServerSpec) validatePreDelete() []metav1.StatusCause {
+	h := gss.PreDelete
+	if h == nil {
+		return nil
+	}
+	var causes []metav1.StatusCause
+	if (h.Exec == nil) == (h.HTTPGet == nil) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Field:   "preDelete",
+			Message: ErrPreDeleteHookAction,
+		})
+	}
+	if h.TimeoutSeconds < 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Field:   "preDelete.timeoutSeconds",
+			Message: ErrPreDeleteHookTimeout,
+		})
+	}
+	if _, c, err := gss.FindGameServerContainer(); err == nil && c.Lifecycle != nil && c.Lifecycle.PreStop != nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Field:   "preDelete",
+			Message: ErrPreDeleteHookPreStop,
+		})
+	}
+	return causes
+}
+
 // Validate validates the GameServer configuration.
 // If a GameServer is invalid there will be > 0 values in
 // the returned array
@@ -401,9 +1073,114 @@ func (gs *GameServer) Validate() ([]metav1.StatusCause, bool) {
 	devAddress, _ := gs.GetDevAddress()
 	gssCauses, _ := gs.Spec.Validate(devAddress)
 	causes = append(causes, gssCauses...)
+	causes = append(causes, validateAllocationVisibleAnnotation(gs.ObjectMeta.Annotations)...)
+	causes = append(causes, validateDeletionCostAnnotation(gs.ObjectMeta.Annotations)...)
+	causes = append(causes, gs.validateSdkServerSidecar()...)
 	return causes, len(causes) == 0
 }
 
+// HasSdkServerSidecar returns false if the game server runs the SDK server itself,
+// so the SDK server sidecar is not added to the Pod of the GameServer
+func (gs *GameServer) HasSdkServerSidecar() bool {
+	return gs.ObjectMeta.Annotations[SdkServerSidecarAnnotation] != "false"
+}
+
+// validateSdkServerSidecar validates the SdkServerSidecarAnnotation annotation, and that when the game server
+// runs the SDK server, the health port of the SDK server is still free for the health check of the game server
+func (gs *GameServer) validateSdkServerSidecar() []metav1.StatusCause {
+	field := fmt.Sprintf("annotations.%s", SdkServerSidecarAnnotation)
+	value, ok := gs.ObjectMeta.Annotations[SdkServerSidecarAnnotation]
+	if !ok {
+		return nil
+	}
+	if value != "true" && value != "false" {
+		return []metav1.StatusCause{{Type: metav1.CauseTypeFieldValueInvalid, Field: field, Message: ErrSdkServerSidecar}}
+	}
+	if gs.HasSdkServerSidecar() || gs.Spec.Health.Disabled {
+		return nil
+	}
+
+	used := false
+	for _, p := range gs.Spec.Ports {
+		used = used || p.ContainerPort == SdkServerHealthPort
+	}
+	// the game server container can declare the health port, as it serves it
+	_, gsContainer, err := gs.FindGameServerContainer()
+	for _, c := range gs.Spec.Template.Spec.Containers {
+		if err == nil && c.Name == gsContainer.Name {
+			continue
+		}
+		for _, p := range c.Ports {
+			used = used || p.ContainerPort == SdkServerHealthPort
+		}
+	}
+	if used {
+		return []metav1.StatusCause{{Type: metav1.CauseTypeFieldValueInvalid, Field: field, Message: ErrSdkServerHealthPort}}
+	}
+	return nil
+}
+
+// AllocationVisibleAnnotations returns the annotations listed in the AllocationVisibleAnnotation
+// annotation that are set on the GameServer, or nil if there are none
+func (gs *GameServer) AllocationVisibleAnnotations() map[string]string {
+	var result map[string]string
+	for _, key := range allocationVisibleAnnotationKeys(gs.ObjectMeta.Annotations) {
+		value, ok := gs.ObjectMeta.Annotations[key]
+		if !ok {
+			continue
+		}
+		if result == nil {
+			result = map[string]string{}
+		}
+		result[key] = value
+	}
+	return result
+}
+
+// allocationVisibleAnnotationKeys returns the annotation keys listed in the AllocationVisibleAnnotation annotation
+func allocationVisibleAnnotationKeys(annotations map[string]string) []string {
+	var keys []string
+	for _, key := range strings.Split(annotations[AllocationVisibleAnnotation], ",") {
+		if key = strings.TrimSpace(key); key != "" {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// validateAllocationVisibleAnnotation validates that the AllocationVisibleAnnotation annotation
+// only lists valid annotation keys
+func validateAllocationVisibleAnnotation(annotations map[string]string) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	for _, key := range allocationVisibleAnnotationKeys(annotations) {
+		if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Field:   fmt.Sprintf("annotations.%s", AllocationVisibleAnnotation),
+				Message: fmt.Sprintf("'%s' is not a valid annotation key: %s", key, strings.Join(errs, ", ")),
+			})
+		}
+	}
+	return causes
+}
+
+// validateDeletionCostAnnotation validates that the GameServerDeletionCostAnnotation annotation
+// is a 32 bit integer, if it is set
+func validateDeletionCostAnnotation(annotations map[string]string) []metav1.StatusCause {
+	value, ok := annotations[GameServerDeletionCostAnnotation]
+	if !ok {
+		return nil
+	}
+	if _, err := strconv.ParseInt(value, 10, 32); err != nil {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Field:   fmt.Sprintf("annotations.%s", GameServerDeletionCostAnnotation),
+			Message: fmt.Sprintf("'%s' is not a valid deletion cost, it must be a 32 bit integer", value),
+		}}
+	}
+	return nil
+}
+
 // GetDevAddress returns the address for game server.
 func (gs *GameServer) GetDevAddress() (string, bool) {
 	devAddress, hasDevAddress := gs.ObjectMeta.Annotations[DevAddressAnnotation]
@@ -420,11 +1197,40 @@ func (gs *GameServer) IsDeletable() bool {
 	return true
 }
 
+// MarkUnhealthy moves the GameServer to the Unhealthy state, and records the state it was in
+// with the GameServerUnhealthyFromAnnotation. The state label is updated with it.
+func (gs *GameServer) MarkUnhealthy() {
+	if gs.ObjectMeta.Annotations == nil {
+		gs.ObjectMeta.Annotations = map[string]string{}
+	}
+	gs.ObjectMeta.Annotations[GameServerUnhealthyFromAnnotation] = string(gs.Status.State)
+	gs.Status.State = GameServerStateUnhealthy
+	gs.ApplyStatusLabels()
+}
+
+// MarkAllocated moves the GameServer to the Allocated state, and records that it has been allocated
+// with the GameServerAllocatedAnnotation. The state label is updated with it.
+func (gs *GameServer) MarkAllocated() {
+	if gs.ObjectMeta.Annotations == nil {
+		gs.ObjectMeta.Annotations = map[string]string{}
+	}
+	gs.ObjectMeta.Annotations[GameServerAllocatedAnnotation] = "true"
+	gs.Status.State = GameServerStateAllocated
+	gs.ApplyStatusLabels()
+}
+
 // IsBeingDeleted returns true if the server is in the process of being deleted.
 func (gs *GameServer) IsBeingDeleted() bool {
 	return !gs.ObjectMeta.DeletionTimestamp.IsZero() || gs.Status.State == GameServerStateShutdown
 }
 
+// IsRestartPending returns true if the GameServer is Ready, and is waiting for its GameServerSet
+// to replace it before it is restarted
+func (gs *GameServer) IsRestartPending() bool {
+	_, ok := gs.ObjectMeta.Annotations[GameServerRestartAnnotation]
+	return ok && gs.Status.State == GameServerStateReady && !gs.IsBeingDeleted()
+}
+
 // FindGameServerContainer returns the container that is specified in
 // gameServer.Spec.Container. Returns the index and the value.
 // Returns an error if not found
@@ -475,13 +1281,16 @@ func (gs *GameServer) Pod(sidecars ...corev1.Container) (*corev1.Pod, error) {
 	}
 
 	for _, p := range gs.Spec.Ports {
-		cp := corev1.ContainerPort{
-			ContainerPort: p.ContainerPort,
-			HostPort:      p.HostPort,
-			Protocol:      p.Protocol,
+		for _, protocol := range p.protocols() {
+			cp := corev1.ContainerPort{
+				ContainerPort: p.ContainerPort,
+				HostPort:      p.HostPort,
+				Protocol:      protocol,
+			}
+			gsContainer.Ports = append(gsContainer.Ports, cp)
 		}
-		gsContainer.Ports = append(gsContainer.Ports, cp)
 	}
+	gsContainer.Env = gs.passthroughPortEnv(gsContainer.Env)
 	pod.Spec.Containers[i] = gsContainer
 
 	pod.Spec.Containers = append(pod.Spec.Containers, sidecars...)
@@ -491,6 +1300,50 @@ func (gs *GameServer) Pod(sidecars ...corev1.Container) (*corev1.Pod, error) {
 	return pod, nil
 }
 
+// passthroughPortEnv returns the environment variables with the ports allocated to
+// Passthrough ports, which replace any environment variables of the same name
+func (gs *GameServer) passthroughPortEnv(env []corev1.EnvVar) []corev1.EnvVar {
+	var ports []corev1.EnvVar
+	for _, p := range gs.Spec.Ports {
+		if p.PortPolicy != Passthrough || p.HostPort == 0 {
+			continue
+		}
+		value := strconv.Itoa(int(p.HostPort))
+		if len(ports) == 0 {
+			ports = append(ports, corev1.EnvVar{Name: PassthroughPortEnvVar, Value: value})
+		}
+		if p.Name != "" {
+			ports = append(ports, corev1.EnvVar{Name: PassthroughPortEnvVar + "_" + EnvVarSuffix(p.Name), Value: value})
+		}
+	}
+	if len(ports) == 0 {
+		return env
+	}
+
+	var result []corev1.EnvVar
+	for _, e := range env {
+		if e.Name != PassthroughPortEnvVar && !strings.HasPrefix(e.Name, PassthroughPortEnvVar+"_") {
+			result = append(result, e)
+		}
+	}
+	return append(result, ports...)
+}
+
+// EnvVarSuffix converts a port name into an environment variable name suffix,
+// e.g. "game-port" becomes "GAME_PORT"
+func EnvVarSuffix(name string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z':
+			return r - 'a' + 'A'
+		case (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9'):
+			return r
+		default:
+			return '_'
+		}
+	}, name)
+}
+
 // podObjectMeta configures the pod ObjectMeta details
 func (gs *GameServer) podObjectMeta(pod *corev1.Pod) {
 	pod.ObjectMeta.GenerateName = ""
@@ -511,16 +1364,16 @@ func (gs *GameServer) podObjectMeta(pod *corev1.Pod) {
 	pod.ObjectMeta.Labels[RoleLabel] = GameServerLabelRole
 	// store the GameServer name as a label, for easy lookup later on
 	pod.ObjectMeta.Labels[GameServerPodLabel] = gs.ObjectMeta.Name
+	// store the Fleet name as a label, so that the Pods of a Fleet can be selected, e.g. by its NetworkPolicy
+	if fleet, ok := gs.ObjectMeta.Labels[FleetNameLabel]; ok {
+		pod.ObjectMeta.Labels[FleetNameLabel] = fleet
+	}
 	// store the GameServer container as an annotation, to make lookup at a Pod level easier
 	pod.ObjectMeta.Annotations[GameServerContainerAnnotation] = gs.Spec.Container
 	ref := metav1.NewControllerRef(gs, SchemeGroupVersion.WithKind("GameServer"))
 	pod.ObjectMeta.OwnerReferences = append(pod.ObjectMeta.OwnerReferences, *ref)
 
-	if gs.Spec.Scheduling == apis.Packed {
-		// This means that the autoscaler cannot remove the Node that this Pod is on.
-		// (and evict the Pod in the process)
-		pod.ObjectMeta.Annotations["cluster-autoscaler.kubernetes.io/safe-to-evict"] = "false"
-	}
+	gs.podEviction(pod)
 
 	// Add Agones version into Pod Annotations
 	pod.ObjectMeta.Annotations[VersionAnnotation] = pkg.Version
@@ -529,12 +1382,49 @@ func (gs *GameServer) podObjectMeta(pod *corev1.Pod) {
 	}
 }
 
+// EvictionSafe returns whether the Pod of the GameServer is safe to evict for the cluster autoscaler,
+// or an empty string if it is up to the cluster autoscaler
+func (gss *GameServerSpec) EvictionSafe() EvictionSafe {
+	if gss.Eviction != nil && gss.Eviction.Safe != "" {
+		return gss.Eviction.Safe
+	}
+	if gss.Scheduling == apis.Packed {
+		return EvictionSafeNever
+	}
+	return ""
+}
+
+// podEviction sets the cluster autoscaler safe-to-evict annotation on the Pod, following the
+// eviction of the GameServer, unless the Pod template already sets it
+func (gs *GameServer) podEviction(pod *corev1.Pod) {
+	if _, ok := gs.Spec.Template.ObjectMeta.Annotations[SafeToEvictAnnotation]; ok {
+		return
+	}
+	switch gs.Spec.EvictionSafe() {
+	case EvictionSafeNever:
+		// This means that the autoscaler cannot remove the Node that this Pod is on.
+		// (and evict the Pod in the process)
+		pod.ObjectMeta.Annotations[SafeToEvictAnnotation] = "false"
+	case EvictionSafeAlways:
+		pod.ObjectMeta.Annotations[SafeToEvictAnnotation] = "true"
+	}
+}
+
 // podScheduling applies the Fleet scheduling strategy to the passed in Pod
 // this sets the a PreferredDuringSchedulingIgnoredDuringExecution for GameServer
 // pods to a host topology. Basically doing a half decent job of packing GameServer
-// pods together.
+// pods together, or, with the Distributed strategy, of spreading them across the nodes.
 func (gs *GameServer) podScheduling(pod *corev1.Pod) {
-	if gs.Spec.Scheduling == apis.Packed {
+	wpat := corev1.WeightedPodAffinityTerm{
+		Weight: 100,
+		PodAffinityTerm: corev1.PodAffinityTerm{
+			TopologyKey:   "kubernetes.io/hostname",
+			LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{RoleLabel: GameServerLabelRole}},
+		},
+	}
+
+	switch gs.Spec.Scheduling {
+	case apis.Packed:
 		if pod.Spec.Affinity == nil {
 			pod.Spec.Affinity = &corev1.Affinity{}
 		}
@@ -542,22 +1432,23 @@ func (gs *GameServer) podScheduling(pod *corev1.Pod) {
 			pod.Spec.Affinity.PodAffinity = &corev1.PodAffinity{}
 		}
 
-		wpat := corev1.WeightedPodAffinityTerm{
-			Weight: 100,
-			PodAffinityTerm: corev1.PodAffinityTerm{
-				TopologyKey:   "kubernetes.io/hostname",
-				LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{RoleLabel: GameServerLabelRole}},
-			},
+		pod.Spec.Affinity.PodAffinity.PreferredDuringSchedulingIgnoredDuringExecution = append(pod.Spec.Affinity.PodAffinity.PreferredDuringSchedulingIgnoredDuringExecution, wpat)
+	case apis.Distributed:
+		if pod.Spec.Affinity == nil {
+			pod.Spec.Affinity = &corev1.Affinity{}
+		}
+		if pod.Spec.Affinity.PodAntiAffinity == nil {
+			pod.Spec.Affinity.PodAntiAffinity = &corev1.PodAntiAffinity{}
 		}
 
-		pod.Spec.Affinity.PodAffinity.PreferredDuringSchedulingIgnoredDuringExecution = append(pod.Spec.Affinity.PodAffinity.PreferredDuringSchedulingIgnoredDuringExecution, wpat)
+		pod.Spec.Affinity.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution = append(pod.Spec.Affinity.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution, wpat)
 	}
 }
 
 // DisableServiceAccount disables the service account for the gameserver container
 func (gs *GameServer) DisableServiceAccount(pod *corev1.Pod) {
 	// gameservers don't get access to the k8s api.
-	emptyVol := corev1.Volume{Name: "empty", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}}
+	emptyVol := corev1.Volume{Name: ServiceAccountVolumeName, VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}}
 	pod.Spec.Volumes = append(pod.Spec.Volumes, emptyVol)
 	mount := corev1.VolumeMount{MountPath: "/var/run/secrets/kubernetes.io/serviceaccount", Name: emptyVol.Name, ReadOnly: true}
 
@@ -568,6 +1459,26 @@ func (gs *GameServer) DisableServiceAccount(pod *corev1.Pod) {
 	})
 }
 
+// MountSdkServerToken mounts the Secret of the SDK server token, if one is set, into all the containers of the
+// Pod, so the SDK server can read the token it requires, and the game server the token it sends
+func (gs *GameServer) MountSdkServerToken(pod *corev1.Pod) {
+	if gs.Spec.SdkServer.TokenSecretName == "" {
+		return
+	}
+
+	pod.Spec.Volumes = append(pod.Spec.Volumes, corev1.Volume{
+		Name: SdkServerTokenVolumeName,
+		VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{
+			SecretName: gs.Spec.SdkServer.TokenSecretName,
+			Items:      []corev1.KeyToPath{{Key: SdkServerTokenKey, Path: SdkServerTokenKey}},
+		}},
+	})
+	mount := corev1.VolumeMount{Name: SdkServerTokenVolumeName, MountPath: SdkServerTokenMountPath, ReadOnly: true}
+	for i := range pod.Spec.Containers {
+		pod.Spec.Containers[i].VolumeMounts = append(pod.Spec.Containers[i].VolumeMounts, mount)
+	}
+}
+
 // HasPortPolicy checks if there is a port with a given
 // PortPolicy
 func (gs *GameServer) HasPortPolicy(policy PortPolicy) bool {
@@ -584,6 +1495,15 @@ func (p GameServerPort) Status() GameServerStatusPort {
 	return GameServerStatusPort{Name: p.Name, Port: p.HostPort}
 }
 
+// protocols returns the protocols the GameServerPort is exposed with. TCPUDP ports
+// are exposed as a pair of TCP and UDP ports with the same port numbers
+func (p GameServerPort) protocols() []corev1.Protocol {
+	if p.Protocol == ProtocolTCPUDP {
+		return []corev1.Protocol{corev1.ProtocolTCP, corev1.ProtocolUDP}
+	}
+	return []corev1.Protocol{p.Protocol}
+}
+
 // CountPorts returns the number of
 // ports that match condition function
 func (gs *GameServer) CountPorts(f func(policy PortPolicy) bool) int {
@@ -16,6 +16,7 @@ package v1
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"agones.dev/agones/pkg"
@@ -336,6 +337,92 @@ func TestGameServerApplyDefaults(t *testing.T) {
 	}
 }
 
+func TestGameServerApplyStatusLabels(t *testing.T) {
+	t.Parallel()
+
+	gs := &GameServer{Status: GameServerStatus{State: GameServerStateReady, NodeName: "node-1"}}
+	assert.True(t, gs.ApplyStatusLabels())
+	assert.Equal(t, map[string]string{GameServerStateLabel: "Ready", GameServerNodeNameLabel: "node-1"}, gs.ObjectMeta.Labels)
+	assert.False(t, gs.ApplyStatusLabels())
+
+	gs.Status.State = GameServerStateAllocated
+	assert.True(t, gs.ApplyStatusLabels())
+	assert.Equal(t, "Allocated", gs.ObjectMeta.Labels[GameServerStateLabel])
+
+	// node names can be longer than label values
+	gs.ObjectMeta.Labels["foo"] = "bar"
+	gs.Status.NodeName = strings.Repeat("node", 20)
+	assert.True(t, gs.ApplyStatusLabels())
+	assert.Equal(t, map[string]string{GameServerStateLabel: "Allocated", "foo": "bar"}, gs.ObjectMeta.Labels)
+
+	gs.Status = GameServerStatus{}
+	assert.True(t, gs.ApplyStatusLabels())
+	assert.Equal(t, map[string]string{"foo": "bar"}, gs.ObjectMeta.Labels)
+}
+
+func TestGameServerShutdownReason(t *testing.T) {
+	t.Parallel()
+
+	gs := &GameServer{Status: GameServerStatus{State: GameServerStateReady}}
+	assert.Equal(t, ShutdownReasonManual, gs.ShutdownReason())
+
+	gs.Status.State = GameServerStateShutdown
+	assert.Equal(t, ShutdownReasonSDK, gs.ShutdownReason())
+
+	gs.Status.State = GameServerStateUnhealthy
+	gs.Shutdown(ShutdownReasonUnhealthy)
+	assert.Equal(t, GameServerStateShutdown, gs.Status.State)
+	assert.Equal(t, "Unhealthy", gs.ObjectMeta.Annotations[GameServerShutdownReasonAnnotation])
+	assert.Equal(t, string(GameServerStateShutdown), gs.ObjectMeta.Labels[GameServerStateLabel])
+	assert.Equal(t, ShutdownReasonUnhealthy, gs.ShutdownReason())
+}
+
+func TestGameServerDeletionCost(t *testing.T) {
+	t.Parallel()
+
+	gs := &GameServer{}
+	assert.Equal(t, int32(0), gs.DeletionCost())
+
+	gs.ObjectMeta.Annotations = map[string]string{GameServerSDKDeletionCostAnnotation: "-10"}
+	assert.Equal(t, int32(-10), gs.DeletionCost())
+
+	gs.ObjectMeta.Annotations[GameServerDeletionCostAnnotation] = "100"
+	assert.Equal(t, int32(100), gs.DeletionCost())
+
+	gs.ObjectMeta.Annotations[GameServerDeletionCostAnnotation] = "not a number"
+	assert.Equal(t, int32(0), gs.DeletionCost())
+	causes := validateDeletionCostAnnotation(gs.ObjectMeta.Annotations)
+	if assert.Len(t, causes, 1) {
+		assert.Equal(t, "annotations."+GameServerDeletionCostAnnotation, causes[0].Field)
+	}
+}
+
+func TestPullsImageAlways(t *testing.T) {
+	t.Parallel()
+
+	fixtures := map[string]struct {
+		image    string
+		policy   corev1.PullPolicy
+		expected bool
+	}{
+		"no tag":             {image: "gcr.io/agones/game", expected: true},
+		"latest tag":         {image: "gcr.io/agones/game:latest", expected: true},
+		"fixed tag":          {image: "gcr.io/agones/game:1.0", expected: false},
+		"registry port":      {image: "localhost:5000/game", expected: true},
+		"digest":             {image: "gcr.io/agones/game@sha256:abc", expected: false},
+		"always":             {image: "gcr.io/agones/game:1.0", policy: corev1.PullAlways, expected: true},
+		"if not present":     {image: "gcr.io/agones/game:latest", policy: corev1.PullIfNotPresent, expected: false},
+		"never, no tag":      {image: "gcr.io/agones/game", policy: corev1.PullNever, expected: false},
+		"registry port, tag": {image: "localhost:5000/game:1.0", expected: false},
+	}
+
+	for k, v := range fixtures {
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, v.expected, pullsImageAlways(corev1.Container{Image: v.image, ImagePullPolicy: v.policy}))
+		})
+	}
+}
+
 func TestGameServerValidate(t *testing.T) {
 	gs := GameServer{
 		Spec: GameServerSpec{
@@ -439,6 +526,415 @@ func TestGameServerValidate(t *testing.T) {
 	assert.Len(t, causes, 2)
 	assert.Contains(t, fields, "one.containerPort")
 	assert.Contains(t, fields, "two.hostPort")
+
+	gs = GameServer{
+		Spec: GameServerSpec{
+			Counters: map[string]CounterStatus{"players": {Count: 0, Capacity: 10}, "rooms": {Count: 5, Capacity: 2}},
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "testing", Image: "testing/image"}}}},
+		},
+	}
+	gs.ApplyDefaults()
+	causes, ok = gs.Validate()
+	assert.False(t, ok)
+	assert.Len(t, causes, 1)
+	assert.Equal(t, "counters.rooms", causes[0].Field)
+
+	gs = GameServer{
+		Spec: GameServerSpec{
+			Lists: map[string]ListStatus{"players": {Capacity: 1, Values: []string{"a", "b"}}, "rooms": {Capacity: 2}},
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "testing", Image: "testing/image"}}}},
+		},
+	}
+	gs.ApplyDefaults()
+	causes, ok = gs.Validate()
+	assert.False(t, ok)
+	assert.Len(t, causes, 1)
+	assert.Equal(t, "lists.players", causes[0].Field)
+
+	for _, pr := range []PortRange{{MinPort: 0, MaxPort: 10}, {MinPort: 20, MaxPort: 10}, {MinPort: 10, MaxPort: 70000}} {
+		gs = GameServer{
+			Spec: GameServerSpec{
+				PortRange: pr.DeepCopy(),
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "testing", Image: "testing/image"}}}},
+			},
+		}
+		gs.ApplyDefaults()
+		causes, ok = gs.Validate()
+		assert.False(t, ok, "%v should be invalid", pr)
+		assert.Len(t, causes, 1)
+		assert.Equal(t, "portRange", causes[0].Field)
+	}
+
+	gs.Spec.PortRange = &PortRange{MinPort: 10, MaxPort: 10}
+	_, ok = gs.Validate()
+	assert.True(t, ok)
+
+	gs.Spec.Ports = []GameServerPort{
+		{Name: "tcp", ContainerPort: 7777, Protocol: corev1.ProtocolTCP},
+		{Name: "tcpudp", ContainerPort: 7778, Protocol: ProtocolTCPUDP},
+		{Name: "sctp", ContainerPort: 7779, Protocol: corev1.ProtocolSCTP},
+	}
+	causes, ok = gs.Validate()
+	assert.False(t, ok)
+	assert.Len(t, causes, 1)
+	assert.Equal(t, "sctp.protocol", causes[0].Field)
+
+	gs.Spec.Ports = []GameServerPort{
+		{Name: "game", PortPolicy: Static, ContainerPort: 7777, HostPort: 7777, Protocol: corev1.ProtocolUDP},
+		{Name: "control", PortPolicy: Static, ContainerPort: 7778, HostPort: 7777, Protocol: corev1.ProtocolTCP},
+	}
+	_, ok = gs.Validate()
+	assert.True(t, ok)
+
+	gs.Spec.Ports = append(gs.Spec.Ports, GameServerPort{Name: "both", PortPolicy: Static, ContainerPort: 7779, HostPort: 7777, Protocol: ProtocolTCPUDP})
+	causes, ok = gs.Validate()
+	assert.False(t, ok)
+	assert.Len(t, causes, 1)
+	assert.Equal(t, "both.hostPort", causes[0].Field)
+	assert.Equal(t, ErrHostPortDuplicate, causes[0].Message)
+
+	gs.Spec.Ports = nil
+	gs.Spec.Eviction = &Eviction{Safe: "Sometimes"}
+	causes, ok = gs.Validate()
+	assert.False(t, ok)
+	assert.Len(t, causes, 1)
+	assert.Equal(t, "eviction.safe", causes[0].Field)
+
+	gs.Spec.Eviction.Safe = EvictionSafeAlways
+	_, ok = gs.Validate()
+	assert.True(t, ok)
+
+	gs.Spec.TerminationGracePeriodSeconds = -1
+	causes, ok = gs.Validate()
+	assert.False(t, ok)
+	assert.Len(t, causes, 1)
+	assert.Equal(t, "terminationGracePeriodSeconds", causes[0].Field)
+
+	gs.Spec.TerminationGracePeriodSeconds = 60
+	_, ok = gs.Validate()
+	assert.True(t, ok)
+
+	gs.Spec.PreDelete = &PreDeleteHook{TimeoutSeconds: -1}
+	causes, ok = gs.Validate()
+	assert.False(t, ok)
+	assert.Len(t, causes, 2)
+	assert.Equal(t, ErrPreDeleteHookAction, causes[0].Message)
+	assert.Equal(t, "preDelete.timeoutSeconds", causes[1].Field)
+
+	gs.Spec.PreDelete = &PreDeleteHook{Exec: &corev1.ExecAction{Command: []string{"/flush"}}, HTTPGet: &corev1.HTTPGetAction{Path: "/flush"}}
+	causes, ok = gs.Validate()
+	assert.False(t, ok)
+	assert.Len(t, causes, 1)
+	assert.Equal(t, ErrPreDeleteHookAction, causes[0].Message)
+
+	gs.Spec.PreDelete = &PreDeleteHook{Exec: &corev1.ExecAction{Command: []string{"/flush"}}}
+	_, ok = gs.Validate()
+	assert.True(t, ok)
+
+	gs.Spec.Template.Spec.Containers[0].Lifecycle = &corev1.Lifecycle{PreStop: &corev1.Handler{Exec: &corev1.ExecAction{Command: []string{"/stop"}}}}
+	causes, ok = gs.Validate()
+	assert.False(t, ok)
+	assert.Len(t, causes, 1)
+	assert.Equal(t, ErrPreDeleteHookPreStop, causes[0].Message)
+}
+
+func TestGameServerApplyDefaultsPreDelete(t *testing.T) {
+	t.Parallel()
+
+	gs := &GameServer{Spec: GameServerSpec{
+		PreDelete: &PreDeleteHook{HTTPGet: &corev1.HTTPGetAction{Path: "/flush"}},
+		Template:  corev1.PodTemplateSpec{Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "testing", Image: "testing/image"}}}},
+	}}
+	gs.ApplyDefaults()
+	assert.Equal(t, int32(30), gs.Spec.PreDelete.TimeoutSeconds)
+
+	gs.Spec.PreDelete.TimeoutSeconds = 120
+	gs.ApplyDefaults()
+	assert.Equal(t, int32(120), gs.Spec.PreDelete.TimeoutSeconds)
+}
+
+func TestGameServerValidatePodTemplate(t *testing.T) {
+	t.Parallel()
+
+	spec := func() GameServerSpec {
+		gss := GameServerSpec{
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"mode": "ranked"}},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "testing", Image: "testing/image",
+						Ports: []corev1.ContainerPort{{ContainerPort: 8080}}}},
+					Tolerations:       []corev1.Toleration{{Key: "dedicated", Value: "gameservers", Effect: corev1.TaintEffectNoExecute}},
+					NodeSelector:      map[string]string{"pool": "gameservers"},
+					PriorityClassName: "game",
+					Volumes:           []corev1.Volume{{Name: "config"}},
+				}}}
+		gss.ApplyDefaults()
+		return gss
+	}
+	fields := func(causes []metav1.StatusCause) []string {
+		var result []string
+		for _, c := range causes {
+			result = append(result, c.Field)
+		}
+		return result
+	}
+
+	causes, ok := spec().Validate("")
+	assert.True(t, ok)
+	assert.Empty(t, causes)
+
+	gss := spec()
+	gss.Template.ObjectMeta.Labels[RoleLabel] = "other"
+	gss.Template.ObjectMeta.Labels[GameServerPodLabel] = "other"
+	gss.Template.Spec.Containers = append(gss.Template.Spec.Containers, corev1.Container{Name: SidecarContainerName, Image: "sidecar"})
+	gss.Template.Spec.Containers[0].Ports[0].HostPort = 7777
+	gss.Container = "testing"
+	gss.Template.Spec.Volumes = append(gss.Template.Spec.Volumes, corev1.Volume{Name: ServiceAccountVolumeName})
+	causes, ok = gss.Validate("")
+	assert.False(t, ok)
+	assert.Equal(t, []string{
+		"template.metadata.labels." + RoleLabel,
+		"template.metadata.labels." + GameServerPodLabel,
+		"template.spec.containers[0].ports[0].hostPort",
+		"template.spec.containers[1].name",
+		"template.spec.volumes[1].name",
+	}, fields(causes))
+
+	// the volume is only reserved when the service account is disabled
+	gss.Template.Spec.ServiceAccountName = "game"
+	causes, _ = gss.Validate("")
+	assert.NotContains(t, fields(causes), "template.spec.volumes[1].name")
+}
+
+func TestGameServerValidateSdkServerPorts(t *testing.T) {
+	t.Parallel()
+
+	spec := func() GameServerSpec {
+		gss := GameServerSpec{
+			Ports: []GameServerPort{{Name: "game", ContainerPort: 9357}},
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "testing", Image: "testing/image",
+						Ports: []corev1.ContainerPort{{ContainerPort: 8080}}}},
+				}}}
+		gss.ApplyDefaults()
+		return gss
+	}
+
+	// the default ports don't conflict
+	causes, ok := spec().Validate("")
+	assert.True(t, ok)
+	assert.Empty(t, causes)
+
+	gss := spec()
+	gss.SdkServer.GRPCPort = 9357
+	gss.SdkServer.HTTPPort = 8080
+	causes, ok = gss.Validate("")
+	assert.False(t, ok)
+	if assert.Len(t, causes, 2) {
+		assert.Equal(t, "sdkServer.grpcPort", causes[0].Field)
+		assert.Equal(t, "sdkServer.httpPort", causes[1].Field)
+		assert.Equal(t, ErrSdkServerPortConflict, causes[0].Message)
+	}
+
+	gss.SdkServer.GRPCPort = 9358
+	gss.SdkServer.HTTPPort = 9358
+	causes, _ = gss.Validate("")
+	assert.Len(t, causes, 2)
+
+	gss.SdkServer.GRPCPort = 70000
+	gss.SdkServer.HTTPPort = 9359
+	causes, _ = gss.Validate("")
+	if assert.Len(t, causes, 1) {
+		assert.Equal(t, "sdkServer.grpcPort", causes[0].Field)
+	}
+
+	// not defaulted
+	gss.SdkServer.GRPCPort = 0
+	gss.SdkServer.HTTPPort = 0
+	_, ok = gss.Validate("")
+	assert.True(t, ok)
+}
+
+func TestGameServerValidateSdkServerToken(t *testing.T) {
+	t.Parallel()
+
+	gss := GameServerSpec{
+		Ports: []GameServerPort{{Name: "game", ContainerPort: 7777}},
+		Template: corev1.PodTemplateSpec{
+			Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "testing", Image: "testing/image"}}}}}
+	gss.ApplyDefaults()
+
+	gss.SdkServer.TokenSecretName = "sdk-token"
+	causes, ok := gss.Validate("")
+	assert.True(t, ok)
+	assert.Empty(t, causes)
+
+	gss.SdkServer.TokenSecretName = "Not_Valid"
+	gss.Template.Spec.Volumes = []corev1.Volume{{Name: SdkServerTokenVolumeName}}
+	causes, ok = gss.Validate("")
+	assert.False(t, ok)
+	if assert.Len(t, causes, 2) {
+		assert.Equal(t, "sdkServer.tokenSecretName", causes[0].Field)
+		assert.Equal(t, "template.spec.volumes[0].name", causes[1].Field)
+		assert.Equal(t, ErrSdkServerTokenVolumeName, causes[1].Message)
+	}
+
+	// the volume is only reserved when a token is set
+	gss.SdkServer.TokenSecretName = ""
+	_, ok = gss.Validate("")
+	assert.True(t, ok)
+}
+
+func TestGameServerValidateHealthProbes(t *testing.T) {
+	t.Parallel()
+
+	spec := func(probes ...HealthProbe) GameServerSpec {
+		gss := GameServerSpec{
+			Ports:  []GameServerPort{{Name: "game", ContainerPort: 7777}},
+			Health: Health{Probes: probes},
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "testing", Image: "testing/image"}}}}}
+		gss.ApplyDefaults()
+		return gss
+	}
+
+	gss := spec(HealthProbe{Name: "netcode", Type: HealthProbeTCP, Port: 7777}, HealthProbe{Name: "status", Type: HealthProbeHTTP, Port: 8000})
+	assert.Equal(t, "", gss.Health.Probes[0].Path)
+	assert.Equal(t, "/", gss.Health.Probes[1].Path)
+	causes, ok := gss.Validate("")
+	assert.True(t, ok)
+	assert.Empty(t, causes)
+
+	gss = spec(HealthProbe{Name: "netcode", Type: HealthProbeTCP, Port: 7777, Path: "/"},
+		HealthProbe{Name: "netcode", Type: "UDP", Port: 0},
+		HealthProbe{Type: HealthProbeHTTP, Port: 70000, Path: "status"})
+	causes, ok = gss.Validate("")
+	assert.False(t, ok)
+	fields := []string{}
+	for _, c := range causes {
+		fields = append(fields, c.Field)
+	}
+	assert.Equal(t, []string{"health.probes[0].path", "health.probes[1].name", "health.probes[1].type", "health.probes[1].port",
+		"health.probes[2].name", "health.probes[2].port", "health.probes[2].path"}, fields)
+}
+
+func TestGameServerValidateSdkServerSidecar(t *testing.T) {
+	t.Parallel()
+
+	newGs := func(sidecar string) *GameServer {
+		gs := &GameServer{
+			ObjectMeta: metav1.ObjectMeta{Name: "test", Annotations: map[string]string{SdkServerSidecarAnnotation: sidecar}},
+			Spec: GameServerSpec{
+				Ports: []GameServerPort{{Name: "game", ContainerPort: 7777}},
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{{Name: "testing", Image: "testing/image",
+							Ports: []corev1.ContainerPort{{ContainerPort: SdkServerHealthPort}}}},
+					}}}}
+		gs.ApplyDefaults()
+		return gs
+	}
+
+	gs := newGs("false")
+	assert.False(t, gs.HasSdkServerSidecar())
+	causes, ok := gs.Validate()
+	assert.True(t, ok)
+	assert.Empty(t, causes)
+
+	gs = newGs("true")
+	assert.True(t, gs.HasSdkServerSidecar())
+	_, ok = gs.Validate()
+	assert.True(t, ok)
+
+	gs = newGs("nope")
+	causes, ok = gs.Validate()
+	assert.False(t, ok)
+	if assert.Len(t, causes, 1) {
+		assert.Equal(t, ErrSdkServerSidecar, causes[0].Message)
+	}
+
+	// the health port is used by a game server port
+	gs = newGs("false")
+	gs.Spec.Ports[0].ContainerPort = SdkServerHealthPort
+	causes, ok = gs.Validate()
+	assert.False(t, ok)
+	if assert.Len(t, causes, 1) {
+		assert.Equal(t, "annotations."+SdkServerSidecarAnnotation, causes[0].Field)
+		assert.Equal(t, ErrSdkServerHealthPort, causes[0].Message)
+	}
+
+	// ... unless health checking is disabled
+	gs.Spec.Health.Disabled = true
+	_, ok = gs.Validate()
+	assert.True(t, ok)
+
+	// the health port is used by another container
+	gs = newGs("false")
+	gs.Spec.Container = "testing"
+	gs.Spec.Template.Spec.Containers = append(gs.Spec.Template.Spec.Containers, corev1.Container{Name: "proxy", Image: "proxy/image",
+		Ports: []corev1.ContainerPort{{ContainerPort: SdkServerHealthPort}}})
+	causes, ok = gs.Validate()
+	assert.False(t, ok)
+	if assert.Len(t, causes, 1) {
+		assert.Equal(t, ErrSdkServerHealthPort, causes[0].Message)
+	}
+
+	// the SDK server sidecar serves the health port in its own container
+	gs.ObjectMeta.Annotations[SdkServerSidecarAnnotation] = "true"
+	_, ok = gs.Validate()
+	assert.True(t, ok)
+}
+
+func TestGameServerApplyDefaultsCounters(t *testing.T) {
+	t.Parallel()
+
+	gs := &GameServer{Spec: GameServerSpec{
+		Counters: map[string]CounterStatus{"players": {Count: 1, Capacity: 10}},
+		Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "testing", Image: "testing/image"}}}},
+	}}
+	gs.ApplyDefaults()
+	assert.Equal(t, gs.Spec.Counters, gs.Status.Counters)
+
+	// existing counter status is not overwritten
+	gs.Status.Counters["players"] = CounterStatus{Count: 5, Capacity: 10}
+	gs.ApplyDefaults()
+	assert.Equal(t, int64(5), gs.Status.Counters["players"].Count)
+	assert.Equal(t, int64(1), gs.Spec.Counters["players"].Count)
+}
+
+func TestGameServerApplyDefaultsLists(t *testing.T) {
+	t.Parallel()
+
+	gs := &GameServer{Spec: GameServerSpec{
+		Lists:    map[string]ListStatus{"players": {Capacity: 10, Values: []string{"a"}}},
+		Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "testing", Image: "testing/image"}}}},
+	}}
+	gs.ApplyDefaults()
+	assert.Equal(t, gs.Spec.Lists, gs.Status.Lists)
+
+	// the status values do not share the spec's backing array
+	gs.Status.Lists["players"].Values[0] = "b"
+	assert.Equal(t, "a", gs.Spec.Lists["players"].Values[0])
+}
+
+func TestListStatusContains(t *testing.T) {
+	t.Parallel()
+
+	l := ListStatus{Capacity: 2, Values: []string{"a", "b"}}
+	assert.True(t, l.Contains("a"))
+	assert.False(t, l.Contains("c"))
+}
+
+func TestCounterStatusAvailable(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, int64(6), CounterStatus{Count: 4, Capacity: 10}.Available())
+	assert.Equal(t, int64(0), CounterStatus{Count: 10, Capacity: 10}.Available())
+	assert.Equal(t, int64(0), CounterStatus{Count: 12, Capacity: 10}.Available())
 }
 
 func TestGameServerPod(t *testing.T) {
@@ -468,6 +964,39 @@ func TestGameServerPod(t *testing.T) {
 	assert.Equal(t, "container", pod.Spec.Containers[0].Name)
 	assert.Equal(t, "sidecar", pod.Spec.Containers[1].Name)
 	assert.True(t, metav1.IsControlledBy(pod, fixture))
+	assert.Empty(t, pod.Spec.Containers[0].Env)
+
+	fixture.Spec.Ports[0].Protocol = ProtocolTCPUDP
+	pod, err = fixture.Pod()
+	assert.Nil(t, err, "Pod should not return an error")
+	assert.Equal(t, []corev1.ContainerPort{
+		{ContainerPort: fixture.Spec.Ports[0].ContainerPort, HostPort: fixture.Spec.Ports[0].HostPort, Protocol: corev1.ProtocolTCP},
+		{ContainerPort: fixture.Spec.Ports[0].ContainerPort, HostPort: fixture.Spec.Ports[0].HostPort, Protocol: corev1.ProtocolUDP},
+	}, pod.Spec.Containers[0].Ports)
+}
+
+func TestGameServerPodPassthroughPortEnv(t *testing.T) {
+	fixture := defaultGameServer()
+	fixture.Spec.Ports = []GameServerPort{
+		{Name: "static", PortPolicy: Static, ContainerPort: 7777, HostPort: 7777},
+		{Name: "game-port", PortPolicy: Passthrough, ContainerPort: 7001, HostPort: 7001},
+		{PortPolicy: Passthrough, ContainerPort: 7002, HostPort: 7002},
+	}
+	fixture.Spec.Template.Spec.Containers[0].Env = []corev1.EnvVar{
+		{Name: "KEEP", Value: "me"},
+		{Name: PassthroughPortEnvVar, Value: "1234"},
+	}
+	fixture.ApplyDefaults()
+
+	pod, err := fixture.Pod()
+	assert.NoError(t, err)
+	assert.Equal(t, []corev1.EnvVar{
+		{Name: "KEEP", Value: "me"},
+		{Name: PassthroughPortEnvVar, Value: "7001"},
+		{Name: PassthroughPortEnvVar + "_GAME_PORT", Value: "7001"},
+	}, pod.Spec.Containers[0].Env)
+	// the template is not changed
+	assert.Len(t, fixture.Spec.Template.Spec.Containers[0].Env, 2)
 }
 
 func TestGameServerPodObjectMeta(t *testing.T) {
@@ -504,6 +1033,48 @@ func TestGameServerPodObjectMeta(t *testing.T) {
 		f(t, gs, pod)
 
 		assert.Equal(t, "", pod.ObjectMeta.Annotations["cluster-autoscaler.kubernetes.io/safe-to-evict"])
+		assert.NotContains(t, pod.ObjectMeta.Labels, FleetNameLabel)
+	})
+
+	t.Run("fleet", func(t *testing.T) {
+		gs := fixture.DeepCopy()
+		gs.ObjectMeta.Labels = map[string]string{FleetNameLabel: "fleet"}
+		pod := &corev1.Pod{}
+
+		gs.podObjectMeta(pod)
+		f(t, gs, pod)
+
+		assert.Equal(t, "fleet", pod.ObjectMeta.Labels[FleetNameLabel])
+	})
+
+	t.Run("eviction", func(t *testing.T) {
+		gs := fixture.DeepCopy()
+		gs.Spec.Scheduling = apis.Packed
+		gs.Spec.Eviction = &Eviction{Safe: EvictionSafeAlways}
+		pod := &corev1.Pod{}
+
+		gs.podObjectMeta(pod)
+		f(t, gs, pod)
+		assert.Equal(t, "true", pod.ObjectMeta.Annotations[SafeToEvictAnnotation])
+
+		gs.Spec.Scheduling = apis.Distributed
+		gs.Spec.Eviction.Safe = EvictionSafeNever
+		pod = &corev1.Pod{}
+
+		gs.podObjectMeta(pod)
+		f(t, gs, pod)
+		assert.Equal(t, "false", pod.ObjectMeta.Annotations[SafeToEvictAnnotation])
+	})
+
+	t.Run("template annotation", func(t *testing.T) {
+		gs := fixture.DeepCopy()
+		gs.Spec.Scheduling = apis.Packed
+		gs.Spec.Template.ObjectMeta.Annotations = map[string]string{SafeToEvictAnnotation: "true"}
+		gs.Spec.Template.Spec.Containers = []corev1.Container{{Name: "goat", Image: "goat/image"}}
+
+		pod, err := gs.Pod()
+		assert.Nil(t, err)
+		assert.Equal(t, "true", pod.ObjectMeta.Annotations[SafeToEvictAnnotation])
 	})
 }
 
@@ -526,7 +1097,14 @@ func TestGameServerPodScheduling(t *testing.T) {
 		gs := &GameServer{Spec: GameServerSpec{Scheduling: apis.Distributed}}
 		pod := fixture.DeepCopy()
 		gs.podScheduling(pod)
-		assert.Empty(t, pod.Spec.Affinity)
+
+		assert.Nil(t, pod.Spec.Affinity.PodAffinity)
+		assert.Len(t, pod.Spec.Affinity.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution, 1)
+		wpat := pod.Spec.Affinity.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution[0]
+		assert.Equal(t, int32(100), wpat.Weight)
+		assert.Equal(t, "kubernetes.io/hostname", wpat.PodAffinityTerm.TopologyKey)
+		assert.Contains(t, wpat.PodAffinityTerm.LabelSelector.String(), GameServerLabelRole)
+		assert.Contains(t, wpat.PodAffinityTerm.LabelSelector.String(), RoleLabel)
 	})
 }
 
@@ -581,6 +1159,36 @@ func TestGameServerPatch(t *testing.T) {
 	assert.Contains(t, string(patch), `{"op":"replace","path":"/spec/container","value":"bear"}`)
 }
 
+func TestGameServerAllocationVisibleAnnotations(t *testing.T) {
+	t.Parallel()
+
+	gs := &GameServer{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+		"map":        "dust2",
+		"build-hash": "a1b2c3",
+		"secret":     "hidden",
+	}}}
+	assert.Nil(t, gs.AllocationVisibleAnnotations())
+
+	gs.ObjectMeta.Annotations[AllocationVisibleAnnotation] = "map, build-hash,,unset"
+	assert.Equal(t, map[string]string{"map": "dust2", "build-hash": "a1b2c3"}, gs.AllocationVisibleAnnotations())
+
+	gs.ObjectMeta.Name = "test"
+	gs.Spec = GameServerSpec{Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+		Containers: []corev1.Container{{Name: "container", Image: "container/image"}}}}}
+	gs.ApplyDefaults()
+	causes, ok := gs.Validate()
+	assert.True(t, ok)
+	assert.Empty(t, causes)
+
+	gs.ObjectMeta.Annotations[AllocationVisibleAnnotation] = "map,not a key"
+	causes, ok = gs.Validate()
+	assert.False(t, ok)
+	if assert.Len(t, causes, 1) {
+		assert.Equal(t, "annotations."+AllocationVisibleAnnotation, causes[0].Field)
+		assert.Contains(t, causes[0].Message, "not a key")
+	}
+}
+
 func TestGameServerGetDevAddress(t *testing.T) {
 	devGs := &GameServer{
 		ObjectMeta: metav1.ObjectMeta{
@@ -629,6 +1237,42 @@ func TestGameServerIsDeletable(t *testing.T) {
 	assert.True(t, gs.IsDeletable())
 }
 
+func TestGameServerIsRestartPending(t *testing.T) {
+	t.Parallel()
+
+	gs := &GameServer{Status: GameServerStatus{State: GameServerStateReady}}
+	assert.False(t, gs.IsRestartPending())
+
+	gs.ObjectMeta.Annotations = map[string]string{GameServerRestartAnnotation: "true"}
+	assert.True(t, gs.IsRestartPending())
+
+	gs.Status.State = GameServerStateAllocated
+	assert.False(t, gs.IsRestartPending())
+
+	gs.Status.State = GameServerStateShutdown
+	assert.False(t, gs.IsRestartPending())
+}
+
+func TestGameServerMarkAllocated(t *testing.T) {
+	t.Parallel()
+
+	gs := &GameServer{Status: GameServerStatus{State: GameServerStateReady}}
+	gs.MarkAllocated()
+	assert.Equal(t, GameServerStateAllocated, gs.Status.State)
+	assert.Equal(t, "true", gs.ObjectMeta.Annotations[GameServerAllocatedAnnotation])
+	assert.Equal(t, string(GameServerStateAllocated), gs.ObjectMeta.Labels[GameServerStateLabel])
+}
+
+func TestGameServerMarkUnhealthy(t *testing.T) {
+	t.Parallel()
+
+	gs := &GameServer{Status: GameServerStatus{State: GameServerStateAllocated}}
+	gs.MarkUnhealthy()
+	assert.Equal(t, GameServerStateUnhealthy, gs.Status.State)
+	assert.Equal(t, string(GameServerStateAllocated), gs.ObjectMeta.Annotations[GameServerUnhealthyFromAnnotation])
+	assert.Equal(t, string(GameServerStateUnhealthy), gs.ObjectMeta.Labels[GameServerStateLabel])
+}
+
 func TestGameServerApplyToPodGameServerContainer(t *testing.T) {
 	t.Parallel()
 
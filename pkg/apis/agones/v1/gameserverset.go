@@ -15,19 +15,43 @@
 package v1
 
 import (
+	"fmt"
 	"reflect"
+	"strconv"
+	"strings"
 
 	"agones.dev/agones/pkg/apis"
 	"agones.dev/agones/pkg/apis/agones"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
 )
 
 const (
+	// maxGameServerNamingDigits is the maximum number of digits of a GameServerNaming index
+	maxGameServerNamingDigits = 9
+
 	// GameServerSetGameServerLabel is the label that the name of the GameServerSet
 	// is set on the GameServer the GameServerSet controls
 	GameServerSetGameServerLabel = agones.GroupName + "/gameserverset"
 )
 
+// ScaleDownStrategy is the order in which a GameServerSet deletes its Ready GameServers when it scales down
+type ScaleDownStrategy string
+
+const (
+	// PackedScaleDown deletes the GameServers on the Nodes with the fewest GameServers first,
+	// so that the cluster autoscaler can remove the Nodes that are emptied
+	PackedScaleDown ScaleDownStrategy = "Packed"
+	// DistributedScaleDown deletes the GameServers on the Nodes with the most GameServers first,
+	// to keep the remaining GameServers spread across the Nodes
+	DistributedScaleDown ScaleDownStrategy = "Distributed"
+	// OldestFirstScaleDown deletes the oldest GameServers first, e.g. to recycle the oldest game server binaries
+	OldestFirstScaleDown ScaleDownStrategy = "OldestFirst"
+	// NewestFirstScaleDown deletes the newest GameServers first, keeping the longest running ones
+	NewestFirstScaleDown ScaleDownStrategy = "NewestFirst"
+)
+
 // +genclient
 // +genclient:method=GetScale,verb=get,subresource=scale,result=k8s.io/api/extensions/v1beta1.Scale
 // +genclient:method=UpdateScale,verb=update,subresource=scale,input=k8s.io/api/extensions/v1beta1.Scale,result=k8s.io/api/extensions/v1beta1.Scale
@@ -60,8 +84,85 @@ type GameServerSetSpec struct {
 	Replicas int32 `json:"replicas"`
 	// Scheduling strategy. Defaults to "Packed".
 	Scheduling apis.SchedulingStrategy `json:"scheduling,omitempty"`
+	// ScaleDownStrategy is the order in which GameServers are deleted on scale down.
+	// If not set, it is the same as the Scheduling strategy.
+	ScaleDownStrategy ScaleDownStrategy `json:"scaleDownStrategy,omitempty"`
 	// Template the GameServer template to apply for this GameServerSet
 	Template GameServerTemplateSpec `json:"template"`
+	// Naming of the GameServers of this GameServerSet. If not set, GameServers are
+	// given a unique generated name, prefixed with the name of the GameServerSet.
+	Naming *GameServerNaming `json:"naming,omitempty"`
+	// MaxLifetime is how long a GameServer can be Ready for, since it was created, before it is
+	// replaced by a new one. If not set, GameServers are not recycled.
+	MaxLifetime *metav1.Duration `json:"maxLifetime,omitempty"`
+	// Paused stops the controller from creating and deleting the GameServers of the GameServerSet,
+	// e.g. to inspect a broken state. Its status is still kept up to date.
+	Paused bool `json:"paused,omitempty"`
+}
+
+// GetScaleDownStrategy returns the ScaleDownStrategy of the GameServerSet,
+// or the default of its Scheduling strategy if it is not set
+func (gsSetSpec *GameServerSetSpec) GetScaleDownStrategy() ScaleDownStrategy {
+	if gsSetSpec.ScaleDownStrategy != "" {
+		return gsSetSpec.ScaleDownStrategy
+	}
+	if gsSetSpec.Scheduling == apis.Distributed {
+		return DistributedScaleDown
+	}
+	return PackedScaleDown
+}
+
+// GameServerNaming names GameServers with a prefix, followed by an index, e.g. fleet-shard-0007.
+// The lowest index that is not used by a GameServer in the namespace is given to each new GameServer.
+type GameServerNaming struct {
+	// Prefix of the GameServer names
+	Prefix string `json:"prefix"`
+	// Digits is the minimum number of digits of the index, which is padded with zeros
+	Digits int32 `json:"digits,omitempty"`
+	// Ordinal makes a GameServerSet keep exactly one GameServer for each index from 0 to Replicas - 1,
+	// like a StatefulSet. Not supported by Fleets, as their updates run GameServerSets side by side.
+	Ordinal bool `json:"ordinal,omitempty"`
+}
+
+// Name returns the name of the GameServer with the given index
+func (n *GameServerNaming) Name(index int) string {
+	return fmt.Sprintf("%s%0*d", n.Prefix, n.Digits, index)
+}
+
+// Index returns the index of a GameServer named with this naming,
+// and false if the name was not given by it
+func (n *GameServerNaming) Index(name string) (int, bool) {
+	if !strings.HasPrefix(name, n.Prefix) {
+		return 0, false
+	}
+	index, err := strconv.Atoi(name[len(n.Prefix):])
+	if err != nil || index < 0 || n.Name(index) != name {
+		return 0, false
+	}
+	return index, true
+}
+
+// Validate validates the GameServerNaming, returning the causes of any failure
+func (n *GameServerNaming) Validate(field string) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if n.Digits < 0 || n.Digits > maxGameServerNamingDigits {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Field:   field + ".digits",
+			Message: fmt.Sprintf("digits must be between 0 and %d", maxGameServerNamingDigits),
+		})
+		return causes
+	}
+	// the GameServer name is also used as a label value, so check it with the longest index
+	name := n.Prefix + strings.Repeat("0", maxGameServerNamingDigits)
+	for _, msg := range validation.IsDNS1123Label(name) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Field:   field + ".prefix",
+			Message: fmt.Sprintf("prefix %q does not make valid GameServer names: %s", n.Prefix, msg),
+		})
+	}
+	return causes
 }
 
 // GameServerSetStatus is the status of a GameServerSet
@@ -76,6 +177,44 @@ type GameServerSetStatus struct {
 	AllocatedReplicas int32 `json:"allocatedReplicas"`
 	// ShutdownReplicas are the number of Shutdown GameServers replicas
 	ShutdownReplicas int32 `json:"shutdownReplicas"`
+	// Conditions are the latest observations of the GameServerSet's state
+	Conditions []GameServerSetCondition `json:"conditions,omitempty"`
+}
+
+// GameServerSetConditionType is the type of a GameServerSet condition
+type GameServerSetConditionType string
+
+const (
+	// GameServerSetBackoff is true when the GameServerSet has stopped creating and deleting
+	// GameServers for a cooldown period, because of too many consecutive Kubernetes API failures
+	GameServerSetBackoff GameServerSetConditionType = "Backoff"
+	// GameServerSetPaused is true when the GameServerSet is paused, so its GameServers
+	// are neither created nor deleted, whatever its replicas
+	GameServerSetPaused GameServerSetConditionType = "Paused"
+)
+
+// GameServerSetCondition describes the state of a GameServerSet at a certain point
+type GameServerSetCondition struct {
+	// Type of GameServerSet condition
+	Type GameServerSetConditionType `json:"type"`
+	// Status of the condition, one of True, False, Unknown
+	Status corev1.ConditionStatus `json:"status"`
+	// LastTransitionTime is the last time the condition transitioned from one status to another
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+	// Reason is a brief machine readable reason for the condition's last transition
+	Reason string `json:"reason,omitempty"`
+	// Message is a human readable description of the condition's last transition
+	Message string `json:"message,omitempty"`
+}
+
+// GetCondition returns the condition of the given type, or nil if it is not present
+func (gsSetStatus *GameServerSetStatus) GetCondition(conditionType GameServerSetConditionType) *GameServerSetCondition {
+	for i := range gsSetStatus.Conditions {
+		if gsSetStatus.Conditions[i].Type == conditionType {
+			return &gsSetStatus.Conditions[i]
+		}
+	}
+	return nil
 }
 
 // ValidateUpdate validates when updates occur. The argument
@@ -89,6 +228,7 @@ func (gsSet *GameServerSet) ValidateUpdate(new *GameServerSet) ([]metav1.StatusC
 			Message: "template values cannot be updated after creation",
 		})
 	}
+	causes = append(causes, validateMaxLifetime(new.Spec.MaxLifetime)...)
 
 	return causes, len(causes) == 0
 }
@@ -103,6 +243,11 @@ func (gsSet *GameServerSet) Validate() ([]metav1.StatusCause, bool) {
 		causes = append(causes, gsCauses...)
 	}
 
+	if gsSet.Spec.Naming != nil {
+		causes = append(causes, gsSet.Spec.Naming.Validate("naming")...)
+	}
+	causes = append(causes, validateMaxLifetime(gsSet.Spec.MaxLifetime)...)
+
 	return causes, len(causes) == 0
 }
 
@@ -111,6 +256,15 @@ func (gsSet *GameServerSet) GetGameServerSpec() *GameServerSpec {
 	return &gsSet.Spec.Template.Spec
 }
 
+// NamedGameServer returns a single GameServer derived from the GameServer template,
+// named with the given index of the GameServerSet Naming
+func (gsSet *GameServerSet) NamedGameServer(index int) *GameServer {
+	gs := gsSet.GameServer()
+	gs.ObjectMeta.GenerateName = ""
+	gs.ObjectMeta.Name = gsSet.Spec.Naming.Name(index)
+	return gs
+}
+
 // GameServer returns a single GameServer derived
 // from the GameSever template
 func (gsSet *GameServerSet) GameServer() *GameServer {
@@ -15,8 +15,11 @@
 package v1
 
 import (
+	"strings"
 	"testing"
+	"time"
 
+	"agones.dev/agones/pkg/apis"
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -57,6 +60,75 @@ func TestGameServerSetGameServer(t *testing.T) {
 
 	assert.Equal(t, gs.Spec, gsSet.Spec.Template.Spec)
 	assert.True(t, metav1.IsControlledBy(gs, &gsSet))
+
+	gsSet.Spec.Naming = &GameServerNaming{Prefix: "shard-", Digits: 4}
+	gs = gsSet.NamedGameServer(7)
+	assert.Equal(t, "shard-0007", gs.ObjectMeta.Name)
+	assert.Equal(t, "", gs.ObjectMeta.GenerateName)
+	assert.True(t, metav1.IsControlledBy(gs, &gsSet))
+}
+
+func TestGameServerSetSpecGetScaleDownStrategy(t *testing.T) {
+	t.Parallel()
+
+	spec := GameServerSetSpec{Scheduling: apis.Packed}
+	assert.Equal(t, PackedScaleDown, spec.GetScaleDownStrategy())
+	spec.Scheduling = apis.Distributed
+	assert.Equal(t, DistributedScaleDown, spec.GetScaleDownStrategy())
+	spec.ScaleDownStrategy = NewestFirstScaleDown
+	assert.Equal(t, NewestFirstScaleDown, spec.GetScaleDownStrategy())
+}
+
+func TestGameServerNaming(t *testing.T) {
+	t.Parallel()
+
+	naming := GameServerNaming{Prefix: "fleet-shard-", Digits: 4}
+	assert.Equal(t, "fleet-shard-0007", naming.Name(7))
+	assert.Equal(t, "fleet-shard-12345", naming.Name(12345))
+
+	fixtures := map[string]struct {
+		name  string
+		index int
+		ok    bool
+	}{
+		"padded":           {name: "fleet-shard-0007", index: 7, ok: true},
+		"longer":           {name: "fleet-shard-12345", index: 12345, ok: true},
+		"not padded":       {name: "fleet-shard-7", ok: false},
+		"extra zero":       {name: "fleet-shard-00007", ok: false},
+		"other prefix":     {name: "fleet-0007", ok: false},
+		"generated":        {name: "fleet-shard-x7k2p", ok: false},
+		"negative":         {name: "fleet-shard--007", ok: false},
+		"prefix only":      {name: "fleet-shard-", ok: false},
+		"unrelated suffix": {name: "fleet-shard-0007-a", ok: false},
+	}
+
+	for k, v := range fixtures {
+		t.Run(k, func(t *testing.T) {
+			index, ok := naming.Index(v.name)
+			assert.Equal(t, v.ok, ok)
+			assert.Equal(t, v.index, index)
+		})
+	}
+}
+
+func TestGameServerNamingValidate(t *testing.T) {
+	t.Parallel()
+
+	assert.Empty(t, (&GameServerNaming{Prefix: "fleet-shard-", Digits: 4}).Validate("naming"))
+	assert.Empty(t, (&GameServerNaming{Prefix: "shard"}).Validate("naming"))
+	assert.Empty(t, (&GameServerNaming{Prefix: "shard-", Ordinal: true}).Validate("naming"))
+
+	causes := (&GameServerNaming{Prefix: "shard-", Digits: 10}).Validate("naming")
+	assert.Len(t, causes, 1)
+	assert.Equal(t, "naming.digits", causes[0].Field)
+
+	causes = (&GameServerNaming{Prefix: "Shard_"}).Validate("naming")
+	assert.NotEmpty(t, causes)
+	assert.Equal(t, "naming.prefix", causes[0].Field)
+
+	causes = (&GameServerNaming{Prefix: strings.Repeat("a", validation.LabelValueMaxLength-5)}).Validate("naming")
+	assert.NotEmpty(t, causes)
+	assert.Equal(t, "naming.prefix", causes[0].Field)
 }
 
 // TestGameServerSetValidateUpdate test GameServerSet Validate() and ValidateUpdate()
@@ -88,6 +160,19 @@ func TestGameServerSetValidateUpdate(t *testing.T) {
 	assert.Len(t, causes, 1)
 	assert.Equal(t, "template", causes[0].Field)
 
+	newGSS = gsSet.DeepCopy()
+	newGSS.Spec.MaxLifetime = &metav1.Duration{Duration: time.Second}
+	causes, ok = gsSet.ValidateUpdate(newGSS)
+	assert.False(t, ok)
+	if assert.Len(t, causes, 1) {
+		assert.Equal(t, "maxLifetime", causes[0].Field)
+	}
+	causes, ok = newGSS.Validate()
+	assert.False(t, ok)
+	if assert.Len(t, causes, 1) {
+		assert.Equal(t, "maxLifetime", causes[0].Field)
+	}
+
 	newGSS = gsSet.DeepCopy()
 	nameLen := validation.LabelValueMaxLength + 1
 	bytes := make([]byte, nameLen)
@@ -21,9 +21,43 @@
 package v1
 
 import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CounterStatus) DeepCopyInto(out *CounterStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CounterStatus.
+func (in *CounterStatus) DeepCopy() *CounterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CounterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Eviction) DeepCopyInto(out *Eviction) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Eviction.
+func (in *Eviction) DeepCopy() *Eviction {
+	if in == nil {
+		return nil
+	}
+	out := new(Eviction)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Fleet) DeepCopyInto(out *Fleet) {
 	*out = *in
@@ -85,11 +119,51 @@ func (in *FleetList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FleetNodePool) DeepCopyInto(out *FleetNodePool) {
+	*out = *in
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FleetNodePool.
+func (in *FleetNodePool) DeepCopy() *FleetNodePool {
+	if in == nil {
+		return nil
+	}
+	out := new(FleetNodePool)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FleetSpec) DeepCopyInto(out *FleetSpec) {
 	*out = *in
 	in.Strategy.DeepCopyInto(&out.Strategy)
 	in.Template.DeepCopyInto(&out.Template)
+	if in.NodePools != nil {
+		in, out := &in.NodePools, &out.NodePools
+		*out = make([]FleetNodePool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Naming != nil {
+		in, out := &in.Naming, &out.Naming
+		*out = new(GameServerNaming)
+		**out = **in
+	}
+	if in.MaxLifetime != nil {
+		in, out := &in.MaxLifetime, &out.MaxLifetime
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -147,6 +221,23 @@ func (in *GameServer) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GameServerDisruption) DeepCopyInto(out *GameServerDisruption) {
+	*out = *in
+	in.Since.DeepCopyInto(&out.Since)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GameServerDisruption.
+func (in *GameServerDisruption) DeepCopy() *GameServerDisruption {
+	if in == nil {
+		return nil
+	}
+	out := new(GameServerDisruption)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GameServerList) DeepCopyInto(out *GameServerList) {
 	*out = *in
@@ -180,6 +271,22 @@ func (in *GameServerList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GameServerNaming) DeepCopyInto(out *GameServerNaming) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GameServerNaming.
+func (in *GameServerNaming) DeepCopy() *GameServerNaming {
+	if in == nil {
+		return nil
+	}
+	out := new(GameServerNaming)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GameServerPort) DeepCopyInto(out *GameServerPort) {
 	*out = *in
@@ -202,7 +309,7 @@ func (in *GameServerSet) DeepCopyInto(out *GameServerSet) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -224,6 +331,23 @@ func (in *GameServerSet) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GameServerSetCondition) DeepCopyInto(out *GameServerSetCondition) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GameServerSetCondition.
+func (in *GameServerSetCondition) DeepCopy() *GameServerSetCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(GameServerSetCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GameServerSetList) DeepCopyInto(out *GameServerSetList) {
 	*out = *in
@@ -261,6 +385,16 @@ func (in *GameServerSetList) DeepCopyObject() runtime.Object {
 func (in *GameServerSetSpec) DeepCopyInto(out *GameServerSetSpec) {
 	*out = *in
 	in.Template.DeepCopyInto(&out.Template)
+	if in.Naming != nil {
+		in, out := &in.Naming, &out.Naming
+		*out = new(GameServerNaming)
+		**out = **in
+	}
+	if in.MaxLifetime != nil {
+		in, out := &in.MaxLifetime, &out.MaxLifetime
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -277,6 +411,13 @@ func (in *GameServerSetSpec) DeepCopy() *GameServerSetSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GameServerSetStatus) DeepCopyInto(out *GameServerSetStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]GameServerSetCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -298,7 +439,36 @@ func (in *GameServerSpec) DeepCopyInto(out *GameServerSpec) {
 		*out = make([]GameServerPort, len(*in))
 		copy(*out, *in)
 	}
-	out.Health = in.Health
+	in.Health.DeepCopyInto(&out.Health)
+	if in.Counters != nil {
+		in, out := &in.Counters, &out.Counters
+		*out = make(map[string]CounterStatus, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Lists != nil {
+		in, out := &in.Lists, &out.Lists
+		*out = make(map[string]ListStatus, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.PortRange != nil {
+		in, out := &in.PortRange, &out.PortRange
+		*out = new(PortRange)
+		**out = **in
+	}
+	if in.Eviction != nil {
+		in, out := &in.Eviction, &out.Eviction
+		*out = new(Eviction)
+		**out = **in
+	}
+	if in.PreDelete != nil {
+		in, out := &in.PreDelete, &out.PreDelete
+		*out = new(PreDeleteHook)
+		(*in).DeepCopyInto(*out)
+	}
 	in.Template.DeepCopyInto(&out.Template)
 	return
 }
@@ -325,6 +495,29 @@ func (in *GameServerStatus) DeepCopyInto(out *GameServerStatus) {
 		in, out := &in.ReservedUntil, &out.ReservedUntil
 		*out = (*in).DeepCopy()
 	}
+	if in.ReadyTime != nil {
+		in, out := &in.ReadyTime, &out.ReadyTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Counters != nil {
+		in, out := &in.Counters, &out.Counters
+		*out = make(map[string]CounterStatus, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Lists != nil {
+		in, out := &in.Lists, &out.Lists
+		*out = make(map[string]ListStatus, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.Disruption != nil {
+		in, out := &in.Disruption, &out.Disruption
+		*out = new(GameServerDisruption)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -375,6 +568,11 @@ func (in *GameServerTemplateSpec) DeepCopy() *GameServerTemplateSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Health) DeepCopyInto(out *Health) {
 	*out = *in
+	if in.Probes != nil {
+		in, out := &in.Probes, &out.Probes
+		*out = make([]HealthProbe, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -387,3 +585,82 @@ func (in *Health) DeepCopy() *Health {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthProbe) DeepCopyInto(out *HealthProbe) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthProbe.
+func (in *HealthProbe) DeepCopy() *HealthProbe {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthProbe)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ListStatus) DeepCopyInto(out *ListStatus) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListStatus.
+func (in *ListStatus) DeepCopy() *ListStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ListStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PreDeleteHook) DeepCopyInto(out *PreDeleteHook) {
+	*out = *in
+	if in.Exec != nil {
+		in, out := &in.Exec, &out.Exec
+		*out = new(corev1.ExecAction)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HTTPGet != nil {
+		in, out := &in.HTTPGet, &out.HTTPGet
+		*out = new(corev1.HTTPGetAction)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PreDeleteHook.
+func (in *PreDeleteHook) DeepCopy() *PreDeleteHook {
+	if in == nil {
+		return nil
+	}
+	out := new(PreDeleteHook)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PortRange) DeepCopyInto(out *PortRange) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PortRange.
+func (in *PortRange) DeepCopy() *PortRange {
+	if in == nil {
+		return nil
+	}
+	out := new(PortRange)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -16,10 +16,12 @@ package v1
 
 import (
 	"fmt"
+	"time"
 
 	"agones.dev/agones/pkg/apis"
 	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 )
@@ -32,6 +34,9 @@ const (
 	// GameServerAllocationContention when the allocation is unsuccessful
 	// because of contention
 	GameServerAllocationContention GameServerAllocationState = "Contention"
+
+	// maxAffinityKeyLength is the maximum length of the affinity key of a GameServerAllocation
+	maxAffinityKeyLength = 253
 )
 
 // GameServerAllocationState is the Allocation state
@@ -74,9 +79,21 @@ type GameServerAllocationSpec struct {
 	// the selection attempts the second selector, and so on.
 	Preferred []metav1.LabelSelector `json:"preferred,omitempty"`
 
+	// Counters filters the `required` and `preferred` sets down to the GameServers whose
+	// counters match the given counts and available capacities, by counter name.
+	Counters map[string]CounterSelector `json:"counters,omitempty"`
+
+	// Age filters the `required` and `preferred` sets down to the GameServers that have been Ready
+	// for a given range of time, and optionally prefers the oldest or newest of them.
+	Age *AgeSelector `json:"age,omitempty"`
+
 	// Scheduling strategy. Defaults to "Packed".
 	Scheduling apis.SchedulingStrategy `json:"scheduling"`
 
+	// AffinityKey is an optional key, such as a party ID, for which the GameServers on the Node
+	// of the last allocation made with the same key are preferred, if it was made recently.
+	AffinityKey string `json:"affinityKey,omitempty"`
+
 	// MetaPatch is optional custom metadata that is added to the game server at allocation
 	// You can use this to tell the server necessary session data
 	MetaPatch MetaPatch `json:"metadata,omitempty"`
@@ -88,6 +105,77 @@ type MultiClusterSetting struct {
 	PolicySelector metav1.LabelSelector `json:"policySelector,omitempty"`
 }
 
+// CounterSelector filters GameServers on the count and available capacity of a counter.
+// A zero Max value means there is no upper bound.
+type CounterSelector struct {
+	MinCount     int64 `json:"minCount,omitempty"`
+	MaxCount     int64 `json:"maxCount,omitempty"`
+	MinAvailable int64 `json:"minAvailable,omitempty"`
+	MaxAvailable int64 `json:"maxAvailable,omitempty"`
+}
+
+// Matches returns true if the counter status is within the bounds of the CounterSelector
+func (cs CounterSelector) Matches(c agonesv1.CounterStatus) bool {
+	if c.Count < cs.MinCount || (cs.MaxCount > 0 && c.Count > cs.MaxCount) {
+		return false
+	}
+	available := c.Available()
+	return available >= cs.MinAvailable && (cs.MaxAvailable == 0 || available <= cs.MaxAvailable)
+}
+
+// AgePreference is which GameServers are preferred by age when allocating
+type AgePreference string
+
+const (
+	// OldestAgePreference prefers the GameServers that have been Ready the longest
+	OldestAgePreference AgePreference = "Oldest"
+	// NewestAgePreference prefers the GameServers that have been Ready the shortest
+	NewestAgePreference AgePreference = "Newest"
+)
+
+// AgeSelector filters GameServers on how long they have been Ready, e.g. to not allocate
+// game servers that are still warming up, and optionally prefers the oldest or newest of them.
+// A zero MaxReadySeconds value means there is no upper bound.
+type AgeSelector struct {
+	MinReadySeconds int64 `json:"minReadySeconds,omitempty"`
+	MaxReadySeconds int64 `json:"maxReadySeconds,omitempty"`
+	// Prefer is Oldest or Newest, to allocate the GameServer that has been Ready the longest or the shortest
+	// among those matching the same selector, instead of following the scheduling strategy
+	Prefer AgePreference `json:"prefer,omitempty"`
+}
+
+// readySince returns when the GameServer became Ready, or when it was created
+// if it became Ready before the time was recorded
+func readySince(gs *agonesv1.GameServer) metav1.Time {
+	if gs.Status.ReadyTime != nil {
+		return *gs.Status.ReadyTime
+	}
+	return gs.ObjectMeta.CreationTimestamp
+}
+
+// Matches returns true if the GameServer has been Ready for a duration within the bounds of the AgeSelector
+func (as *AgeSelector) Matches(gs *agonesv1.GameServer, now time.Time) bool {
+	age := now.Sub(readySince(gs).Time)
+	if age < time.Duration(as.MinReadySeconds)*time.Second {
+		return false
+	}
+	return as.MaxReadySeconds == 0 || age <= time.Duration(as.MaxReadySeconds)*time.Second
+}
+
+// Prefers returns true if the AgeSelector prefers the GameServer a over b
+func (as *AgeSelector) Prefers(a, b *agonesv1.GameServer) bool {
+	aSince := readySince(a)
+	bSince := readySince(b)
+	switch as.Prefer {
+	case OldestAgePreference:
+		return aSince.Before(&bSince)
+	case NewestAgePreference:
+		return bSince.Before(&aSince)
+	default:
+		return false
+	}
+}
+
 // MetaPatch is the metadata used to patch the GameServer metadata on allocation
 type MetaPatch struct {
 	Labels      map[string]string `json:"labels,omitempty"`
@@ -110,6 +198,18 @@ func (gsas *GameServerAllocationSpec) PreferredSelectors() ([]labels.Selector, e
 	return list, errors.WithStack(err)
 }
 
+// MatchesCounters returns true if the GameServer has all the counters
+// specified on the GameServerAllocationSpec, and they all match their CounterSelector
+func (gsas *GameServerAllocationSpec) MatchesCounters(gs *agonesv1.GameServer) bool {
+	for name, sel := range gsas.Counters {
+		c, ok := gs.Status.Counters[name]
+		if !ok || !sel.Matches(c) {
+			return false
+		}
+	}
+	return true
+}
+
 // GameServerAllocationStatus is the status for an GameServerAllocation resource
 type GameServerAllocationStatus struct {
 	// GameServerState is the current state of an GameServerAllocation, e.g. Allocated, or UnAllocated
@@ -118,6 +218,18 @@ type GameServerAllocationStatus struct {
 	Ports          []agonesv1.GameServerStatusPort `json:"ports,omitempty"`
 	Address        string                          `json:"address,omitempty"`
 	NodeName       string                          `json:"nodeName,omitempty"`
+	// Annotations are the annotations of the allocated GameServer that are listed
+	// in its agones.dev/allocation-visible-annotations annotation
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// GameServer references the allocated GameServer, including its uid and the resourceVersion it was
+	// allocated at, so it can be told apart from the other GameServers that are given the same name
+	GameServer *corev1.ObjectReference `json:"gameServer,omitempty"`
+	// GameServerSet references the GameServerSet of the allocated GameServer, if it has one
+	GameServerSet *corev1.ObjectReference `json:"gameServerSet,omitempty"`
+	// Fleet references the Fleet that controls the GameServerSet of the allocated GameServer, if it has one
+	Fleet *corev1.ObjectReference `json:"fleet,omitempty"`
+	// FleetName is the name of the Fleet of the allocated GameServer, if it has one
+	FleetName string `json:"fleetName,omitempty"`
 }
 
 // ApplyDefaults applies the default values to this GameServerAllocation
@@ -143,5 +255,60 @@ func (gsa *GameServerAllocation) Validate() ([]metav1.StatusCause, bool) {
 			Message: fmt.Sprintf("Invalid value: %s, value must be either Packed or Distributed", gsa.Spec.Scheduling)})
 	}
 
+	if _, err := metav1.LabelSelectorAsSelector(&gsa.Spec.Required); err != nil {
+		causes = append(causes, metav1.StatusCause{Type: metav1.CauseTypeFieldValueInvalid,
+			Field:   "spec.required",
+			Message: fmt.Sprintf("Invalid label selector: %v", err)})
+	}
+	for i := range gsa.Spec.Preferred {
+		if _, err := metav1.LabelSelectorAsSelector(&gsa.Spec.Preferred[i]); err != nil {
+			causes = append(causes, metav1.StatusCause{Type: metav1.CauseTypeFieldValueInvalid,
+				Field:   fmt.Sprintf("spec.preferred[%d]", i),
+				Message: fmt.Sprintf("Invalid label selector: %v", err)})
+		}
+	}
+	if _, err := metav1.LabelSelectorAsSelector(&gsa.Spec.MultiClusterSetting.PolicySelector); err != nil {
+		causes = append(causes, metav1.StatusCause{Type: metav1.CauseTypeFieldValueInvalid,
+			Field:   "spec.multiClusterSetting.policySelector",
+			Message: fmt.Sprintf("Invalid label selector: %v", err)})
+	}
+
+	for name, c := range gsa.Spec.Counters {
+		if c.MinCount < 0 || c.MaxCount < 0 || c.MinAvailable < 0 || c.MaxAvailable < 0 {
+			causes = append(causes, metav1.StatusCause{Type: metav1.CauseTypeFieldValueInvalid,
+				Field:   fmt.Sprintf("spec.counters.%s", name),
+				Message: "Counter selector values cannot be negative"})
+		}
+		if (c.MaxCount > 0 && c.MaxCount < c.MinCount) || (c.MaxAvailable > 0 && c.MaxAvailable < c.MinAvailable) {
+			causes = append(causes, metav1.StatusCause{Type: metav1.CauseTypeFieldValueInvalid,
+				Field:   fmt.Sprintf("spec.counters.%s", name),
+				Message: "Counter selector max values cannot be less than min values"})
+		}
+	}
+
+	if len(gsa.Spec.AffinityKey) > maxAffinityKeyLength {
+		causes = append(causes, metav1.StatusCause{Type: metav1.CauseTypeFieldValueInvalid,
+			Field:   "spec.affinityKey",
+			Message: fmt.Sprintf("Affinity key cannot be longer than %d characters", maxAffinityKeyLength)})
+	}
+
+	if a := gsa.Spec.Age; a != nil {
+		if a.MinReadySeconds < 0 || a.MaxReadySeconds < 0 {
+			causes = append(causes, metav1.StatusCause{Type: metav1.CauseTypeFieldValueInvalid,
+				Field:   "spec.age",
+				Message: "Age selector values cannot be negative"})
+		}
+		if a.MaxReadySeconds > 0 && a.MaxReadySeconds < a.MinReadySeconds {
+			causes = append(causes, metav1.StatusCause{Type: metav1.CauseTypeFieldValueInvalid,
+				Field:   "spec.age",
+				Message: "Age selector maxReadySeconds cannot be less than minReadySeconds"})
+		}
+		if a.Prefer != "" && a.Prefer != OldestAgePreference && a.Prefer != NewestAgePreference {
+			causes = append(causes, metav1.StatusCause{Type: metav1.CauseTypeFieldValueInvalid,
+				Field:   "spec.age.prefer",
+				Message: fmt.Sprintf("Invalid value: %s, value must be either Oldest or Newest", a.Prefer)})
+		}
+	}
+
 	return causes, len(causes) == 0
 }
@@ -15,7 +15,9 @@
 package v1
 
 import (
+	"strings"
 	"testing"
+	"time"
 
 	"agones.dev/agones/pkg/apis"
 	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
@@ -84,4 +86,123 @@ func TestGameServerAllocationValidate(t *testing.T) {
 
 	assert.Equal(t, metav1.CauseTypeFieldValueInvalid, causes[0].Type)
 	assert.Equal(t, "spec.scheduling", causes[0].Field)
+
+	gsa.Spec.Scheduling = apis.Packed
+	gsa.Spec.Counters = map[string]CounterSelector{
+		"players": {MinAvailable: 4},
+		"rooms":   {MinCount: 5, MaxCount: 2},
+		"slots":   {MinAvailable: -1},
+	}
+	causes, ok = gsa.Validate()
+	assert.False(t, ok)
+	assert.Len(t, causes, 2)
+
+	gsa.Spec.Counters = nil
+	gsa.Spec.Age = &AgeSelector{MinReadySeconds: 30, MaxReadySeconds: 10, Prefer: "Middle"}
+	causes, ok = gsa.Validate()
+	assert.False(t, ok)
+	if assert.Len(t, causes, 2) {
+		assert.Equal(t, "spec.age", causes[0].Field)
+		assert.Equal(t, "spec.age.prefer", causes[1].Field)
+	}
+
+	gsa.Spec.Age = &AgeSelector{MinReadySeconds: 30, Prefer: NewestAgePreference}
+	_, ok = gsa.Validate()
+	assert.True(t, ok)
+
+	gsa.Spec.AffinityKey = strings.Repeat("a", maxAffinityKeyLength+1)
+	causes, ok = gsa.Validate()
+	assert.False(t, ok)
+	if assert.Len(t, causes, 1) {
+		assert.Equal(t, "spec.affinityKey", causes[0].Field)
+	}
+
+	gsa.Spec.AffinityKey = "party-1234"
+	_, ok = gsa.Validate()
+	assert.True(t, ok)
+
+	gsa.Spec.Required = metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+		{Key: "version", Operator: metav1.LabelSelectorOpIn, Values: []string{"v12", "v13"}},
+		{Key: "beta", Operator: metav1.LabelSelectorOpDoesNotExist},
+	}}
+	gsa.Spec.Preferred = []metav1.LabelSelector{
+		{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "version", Operator: metav1.LabelSelectorOpNotIn, Values: []string{"v12"}}}},
+	}
+	_, ok = gsa.Validate()
+	assert.True(t, ok)
+
+	gsa.Spec.Required.MatchExpressions[0].Values = nil
+	gsa.Spec.Preferred = append(gsa.Spec.Preferred, metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "version", Operator: "Nope"}}})
+	gsa.Spec.MultiClusterSetting.PolicySelector = metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "cluster", Operator: metav1.LabelSelectorOpExists, Values: []string{"eu"}}}}
+	causes, ok = gsa.Validate()
+	assert.False(t, ok)
+	fields := []string{}
+	for _, c := range causes {
+		fields = append(fields, c.Field)
+	}
+	assert.Equal(t, []string{"spec.required", "spec.preferred[1]", "spec.multiClusterSetting.policySelector"}, fields)
+}
+
+func TestAgeSelector(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	readyAt := func(d time.Duration) *agonesv1.GameServer {
+		ready := metav1.NewTime(now.Add(-d))
+		return &agonesv1.GameServer{Status: agonesv1.GameServerStatus{ReadyTime: &ready}}
+	}
+	young := readyAt(10 * time.Second)
+	old := readyAt(time.Minute)
+	// the creation time is used when there is no ready time
+	older := &agonesv1.GameServer{ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(now.Add(-time.Hour))}}
+
+	as := &AgeSelector{MinReadySeconds: 30}
+	assert.False(t, as.Matches(young, now))
+	assert.True(t, as.Matches(old, now))
+	assert.True(t, as.Matches(older, now))
+
+	as = &AgeSelector{MaxReadySeconds: 300}
+	assert.True(t, as.Matches(young, now))
+	assert.True(t, as.Matches(old, now))
+	assert.False(t, as.Matches(older, now))
+
+	assert.False(t, as.Prefers(young, old))
+	assert.False(t, as.Prefers(old, young))
+
+	as.Prefer = OldestAgePreference
+	assert.True(t, as.Prefers(older, old))
+	assert.False(t, as.Prefers(young, old))
+
+	as.Prefer = NewestAgePreference
+	assert.True(t, as.Prefers(young, old))
+	assert.False(t, as.Prefers(older, old))
+}
+
+func TestGameServerAllocationSpecMatchesCounters(t *testing.T) {
+	t.Parallel()
+
+	gs := &agonesv1.GameServer{Status: agonesv1.GameServerStatus{
+		Counters: map[string]agonesv1.CounterStatus{"players": {Count: 6, Capacity: 10}}}}
+
+	fixtures := map[string]struct {
+		counters map[string]CounterSelector
+		expected bool
+	}{
+		"no selectors":          {counters: nil, expected: true},
+		"enough available":      {counters: map[string]CounterSelector{"players": {MinAvailable: 4}}, expected: true},
+		"not enough available":  {counters: map[string]CounterSelector{"players": {MinAvailable: 5}}, expected: false},
+		"too much available":    {counters: map[string]CounterSelector{"players": {MaxAvailable: 3}}, expected: false},
+		"count in range":        {counters: map[string]CounterSelector{"players": {MinCount: 2, MaxCount: 6}}, expected: true},
+		"count below range":     {counters: map[string]CounterSelector{"players": {MinCount: 7}}, expected: false},
+		"count above range":     {counters: map[string]CounterSelector{"players": {MaxCount: 5}}, expected: false},
+		"missing counter":       {counters: map[string]CounterSelector{"rooms": {}}, expected: false},
+		"one of many not match": {counters: map[string]CounterSelector{"players": {}, "rooms": {}}, expected: false},
+	}
+
+	for k, v := range fixtures {
+		t.Run(k, func(t *testing.T) {
+			gsas := &GameServerAllocationSpec{Counters: v.counters}
+			assert.Equal(t, v.expected, gsas.MatchesCounters(gs))
+		})
+	}
 }
@@ -0,0 +1,141 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"fmt"
+
+	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// GameServerDeletion is a request to delete the GameServers that match a selector, in the order
+// GameServerSets delete them when they scale down, e.g. to decommission the GameServers of a region
+type GameServerDeletion struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              GameServerDeletionSpec   `json:"spec"`
+	Status            GameServerDeletionStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// GameServerDeletionList is a list of GameServerDeletion resources
+type GameServerDeletionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []GameServerDeletion `json:"items"`
+}
+
+// GameServerDeletionSpec is the spec for a GameServerDeletion
+type GameServerDeletionSpec struct {
+	// Selector selects the GameServers to delete by label. It cannot be empty, so that
+	// all the GameServers of a namespace are never deleted by accident.
+	Selector metav1.LabelSelector `json:"selector"`
+
+	// States are the states of the GameServers to delete. Defaults to all the states of GameServers
+	// that are not in use, so that Allocated and Reserved GameServers are only deleted if they are listed.
+	States []agonesv1.GameServerState `json:"states,omitempty"`
+
+	// Limit is the maximum number of GameServers deleted by the request. Defaults to 0, which deletes all of them.
+	Limit int32 `json:"limit,omitempty"`
+
+	// Strategy is the order in which the GameServers are deleted, when there are more than the limit.
+	// Defaults to "Packed", which deletes the GameServers on the Nodes with the fewest GameServers first.
+	Strategy agonesv1.ScaleDownStrategy `json:"strategy,omitempty"`
+}
+
+// GameServerDeletionStatus is the status of a GameServerDeletion
+type GameServerDeletionStatus struct {
+	// GameServers are the names of the GameServers that were shut down,
+	// or that would have been on a dry run
+	GameServers []string `json:"gameServers,omitempty"`
+	// Remaining is the number of GameServers that match the request, but were not shut down because of the limit,
+	// or because the deletion rate was exceeded
+	Remaining int32 `json:"remaining"`
+}
+
+// ApplyDefaults applies the default values to this GameServerDeletion
+func (gsd *GameServerDeletion) ApplyDefaults() {
+	if gsd.Spec.Strategy == "" {
+		gsd.Spec.Strategy = agonesv1.PackedScaleDown
+	}
+}
+
+// Validate validation for the GameServerDeletion
+func (gsd *GameServerDeletion) Validate() ([]metav1.StatusCause, bool) {
+	var causes []metav1.StatusCause
+
+	if selector, err := metav1.LabelSelectorAsSelector(&gsd.Spec.Selector); err != nil {
+		causes = append(causes, metav1.StatusCause{Type: metav1.CauseTypeFieldValueInvalid,
+			Field:   "spec.selector",
+			Message: err.Error()})
+	} else if selector.Empty() {
+		causes = append(causes, metav1.StatusCause{Type: metav1.CauseTypeFieldValueRequired,
+			Field:   "spec.selector",
+			Message: "Selector cannot be empty"})
+	}
+
+	for _, s := range gsd.Spec.States {
+		switch s {
+		case agonesv1.GameServerStatePortAllocation, agonesv1.GameServerStateCreating, agonesv1.GameServerStateStarting,
+			agonesv1.GameServerStateScheduled, agonesv1.GameServerStateRequestReady, agonesv1.GameServerStateReady,
+			agonesv1.GameServerStateError, agonesv1.GameServerStateUnhealthy, agonesv1.GameServerStateReserved,
+			agonesv1.GameServerStateAllocated:
+		default:
+			causes = append(causes, metav1.StatusCause{Type: metav1.CauseTypeFieldValueInvalid,
+				Field:   "spec.states",
+				Message: fmt.Sprintf("Invalid value: %s, value must be a GameServer state other than Shutdown", s)})
+		}
+	}
+
+	if gsd.Spec.Limit < 0 {
+		causes = append(causes, metav1.StatusCause{Type: metav1.CauseTypeFieldValueInvalid,
+			Field:   "spec.limit",
+			Message: "Limit cannot be negative"})
+	}
+
+	switch gsd.Spec.Strategy {
+	case agonesv1.PackedScaleDown, agonesv1.DistributedScaleDown, agonesv1.OldestFirstScaleDown, agonesv1.NewestFirstScaleDown:
+	default:
+		causes = append(causes, metav1.StatusCause{Type: metav1.CauseTypeFieldValueInvalid,
+			Field:   "spec.strategy",
+			Message: fmt.Sprintf("Invalid value: %s, value must be Packed, Distributed, OldestFirst or NewestFirst", gsd.Spec.Strategy)})
+	}
+
+	return causes, len(causes) == 0
+}
+
+// Matches returns true if the GameServer is one of the GameServers to delete, before the limit is applied.
+// GameServers that are already shut down or being deleted never match.
+func (gsd *GameServerDeletion) Matches(selector labels.Selector, gs *agonesv1.GameServer) bool {
+	if gs.IsBeingDeleted() || gs.Status.State == agonesv1.GameServerStateShutdown || !selector.Matches(labels.Set(gs.ObjectMeta.Labels)) {
+		return false
+	}
+
+	if len(gsd.Spec.States) == 0 {
+		return gs.Status.State != agonesv1.GameServerStateAllocated && gs.Status.State != agonesv1.GameServerStateReserved
+	}
+	for _, s := range gsd.Spec.States {
+		if gs.Status.State == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,117 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"testing"
+
+	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+func TestGameServerDeletionApplyDefaults(t *testing.T) {
+	t.Parallel()
+
+	gsd := &GameServerDeletion{}
+	gsd.ApplyDefaults()
+	assert.Equal(t, agonesv1.PackedScaleDown, gsd.Spec.Strategy)
+
+	gsd = &GameServerDeletion{Spec: GameServerDeletionSpec{Strategy: agonesv1.OldestFirstScaleDown}}
+	gsd.ApplyDefaults()
+	assert.Equal(t, agonesv1.OldestFirstScaleDown, gsd.Spec.Strategy)
+}
+
+func TestGameServerDeletionValidate(t *testing.T) {
+	t.Parallel()
+
+	newGsd := func() *GameServerDeletion {
+		gsd := &GameServerDeletion{Spec: GameServerDeletionSpec{
+			Selector: metav1.LabelSelector{MatchLabels: map[string]string{"region": "eu"}},
+		}}
+		gsd.ApplyDefaults()
+		return gsd
+	}
+
+	causes, ok := newGsd().Validate()
+	assert.True(t, ok)
+	assert.Empty(t, causes)
+
+	gsd := newGsd()
+	gsd.Spec.States = []agonesv1.GameServerState{agonesv1.GameServerStateReady, agonesv1.GameServerStateAllocated}
+	gsd.Spec.Limit = 10
+	causes, ok = gsd.Validate()
+	assert.True(t, ok)
+	assert.Empty(t, causes)
+
+	gsd = newGsd()
+	gsd.Spec.Selector = metav1.LabelSelector{}
+	causes, ok = gsd.Validate()
+	assert.False(t, ok)
+	if assert.Len(t, causes, 1) {
+		assert.Equal(t, "spec.selector", causes[0].Field)
+		assert.Equal(t, metav1.CauseTypeFieldValueRequired, causes[0].Type)
+	}
+
+	gsd = newGsd()
+	gsd.Spec.Selector.MatchExpressions = []metav1.LabelSelectorRequirement{{Key: "region", Operator: "Nope"}}
+	causes, ok = gsd.Validate()
+	assert.False(t, ok)
+	if assert.Len(t, causes, 1) {
+		assert.Equal(t, "spec.selector", causes[0].Field)
+	}
+
+	gsd = newGsd()
+	gsd.Spec.States = []agonesv1.GameServerState{agonesv1.GameServerStateShutdown, "Nope"}
+	gsd.Spec.Limit = -1
+	gsd.Spec.Strategy = "Nope"
+	causes, ok = gsd.Validate()
+	assert.False(t, ok)
+	fields := []string{}
+	for _, c := range causes {
+		fields = append(fields, c.Field)
+	}
+	assert.Equal(t, []string{"spec.states", "spec.states", "spec.limit", "spec.strategy"}, fields)
+}
+
+func TestGameServerDeletionMatches(t *testing.T) {
+	t.Parallel()
+
+	gs := func(state agonesv1.GameServerState, region string) *agonesv1.GameServer {
+		return &agonesv1.GameServer{
+			ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"region": region}},
+			Status:     agonesv1.GameServerStatus{State: state},
+		}
+	}
+	selector := labels.SelectorFromSet(labels.Set{"region": "eu"})
+
+	gsd := &GameServerDeletion{}
+	assert.True(t, gsd.Matches(selector, gs(agonesv1.GameServerStateReady, "eu")))
+	assert.True(t, gsd.Matches(selector, gs(agonesv1.GameServerStateUnhealthy, "eu")))
+	assert.False(t, gsd.Matches(selector, gs(agonesv1.GameServerStateReady, "us")))
+	assert.False(t, gsd.Matches(selector, gs(agonesv1.GameServerStateAllocated, "eu")))
+	assert.False(t, gsd.Matches(selector, gs(agonesv1.GameServerStateReserved, "eu")))
+	assert.False(t, gsd.Matches(selector, gs(agonesv1.GameServerStateShutdown, "eu")))
+
+	deleted := gs(agonesv1.GameServerStateReady, "eu")
+	now := metav1.Now()
+	deleted.ObjectMeta.DeletionTimestamp = &now
+	assert.False(t, gsd.Matches(selector, deleted))
+
+	gsd.Spec.States = []agonesv1.GameServerState{agonesv1.GameServerStateAllocated}
+	assert.True(t, gsd.Matches(selector, gs(agonesv1.GameServerStateAllocated, "eu")))
+	assert.False(t, gsd.Matches(selector, gs(agonesv1.GameServerStateReady, "eu")))
+}
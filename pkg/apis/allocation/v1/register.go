@@ -53,6 +53,8 @@ func addKnownTypes(scheme *k8sruntime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
 		&GameServerAllocation{},
 		&GameServerAllocationList{},
+		&GameServerDeletion{},
+		&GameServerDeletionList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
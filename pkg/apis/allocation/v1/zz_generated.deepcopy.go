@@ -22,10 +22,43 @@ package v1
 
 import (
 	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgeSelector) DeepCopyInto(out *AgeSelector) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgeSelector.
+func (in *AgeSelector) DeepCopy() *AgeSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(AgeSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CounterSelector) DeepCopyInto(out *CounterSelector) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CounterSelector.
+func (in *CounterSelector) DeepCopy() *CounterSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(CounterSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GameServerAllocation) DeepCopyInto(out *GameServerAllocation) {
 	*out = *in
@@ -99,6 +132,18 @@ func (in *GameServerAllocationSpec) DeepCopyInto(out *GameServerAllocationSpec)
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Counters != nil {
+		in, out := &in.Counters, &out.Counters
+		*out = make(map[string]CounterSelector, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Age != nil {
+		in, out := &in.Age, &out.Age
+		*out = new(AgeSelector)
+		**out = **in
+	}
 	in.MetaPatch.DeepCopyInto(&out.MetaPatch)
 	return
 }
@@ -121,6 +166,28 @@ func (in *GameServerAllocationStatus) DeepCopyInto(out *GameServerAllocationStat
 		*out = make([]agonesv1.GameServerStatusPort, len(*in))
 		copy(*out, *in)
 	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.GameServer != nil {
+		in, out := &in.GameServer, &out.GameServer
+		*out = new(corev1.ObjectReference)
+		**out = **in
+	}
+	if in.GameServerSet != nil {
+		in, out := &in.GameServerSet, &out.GameServerSet
+		*out = new(corev1.ObjectReference)
+		**out = **in
+	}
+	if in.Fleet != nil {
+		in, out := &in.Fleet, &out.Fleet
+		*out = new(corev1.ObjectReference)
+		**out = **in
+	}
 	return
 }
 
@@ -134,6 +201,110 @@ func (in *GameServerAllocationStatus) DeepCopy() *GameServerAllocationStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GameServerDeletion) DeepCopyInto(out *GameServerDeletion) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GameServerDeletion.
+func (in *GameServerDeletion) DeepCopy() *GameServerDeletion {
+	if in == nil {
+		return nil
+	}
+	out := new(GameServerDeletion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GameServerDeletion) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GameServerDeletionList) DeepCopyInto(out *GameServerDeletionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GameServerDeletion, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GameServerDeletionList.
+func (in *GameServerDeletionList) DeepCopy() *GameServerDeletionList {
+	if in == nil {
+		return nil
+	}
+	out := new(GameServerDeletionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GameServerDeletionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GameServerDeletionSpec) DeepCopyInto(out *GameServerDeletionSpec) {
+	*out = *in
+	in.Selector.DeepCopyInto(&out.Selector)
+	if in.States != nil {
+		in, out := &in.States, &out.States
+		*out = make([]agonesv1.GameServerState, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GameServerDeletionSpec.
+func (in *GameServerDeletionSpec) DeepCopy() *GameServerDeletionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GameServerDeletionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GameServerDeletionStatus) DeepCopyInto(out *GameServerDeletionStatus) {
+	*out = *in
+	if in.GameServers != nil {
+		in, out := &in.GameServers, &out.GameServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GameServerDeletionStatus.
+func (in *GameServerDeletionStatus) DeepCopy() *GameServerDeletionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GameServerDeletionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MetaPatch) DeepCopyInto(out *MetaPatch) {
 	*out = *in
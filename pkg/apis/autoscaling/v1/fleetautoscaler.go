@@ -16,15 +16,38 @@ package v1
 
 import (
 	"crypto/x509"
+	"fmt"
 	"net/url"
+	"strconv"
+	"time"
 
 	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
+	"agones.dev/agones/pkg/apis/autoscaling"
+	"agones.dev/agones/pkg/util/cron"
+	"github.com/pkg/errors"
 	admregv1b "k8s.io/api/admissionregistration/v1beta1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
+const (
+	// OverrideReplicasAnnotation pins the replicas of the Fleet of a FleetAutoscaler,
+	// overriding its policy, until the time in the OverrideExpiryAnnotation
+	OverrideReplicasAnnotation = autoscaling.GroupName + "/override-replicas"
+	// OverrideExpiryAnnotation is the time, in RFC3339 format, at which the OverrideReplicasAnnotation expires
+	OverrideExpiryAnnotation = autoscaling.GroupName + "/override-expiry"
+	// MaxOverrideDuration is how far in the future the OverrideExpiryAnnotation can be,
+	// so that an override can never be left on forever
+	MaxOverrideDuration = 24 * time.Hour
+	// MaxScheduleWindowDuration is the maximum duration of a window of a Schedule policy
+	MaxScheduleWindowDuration = 7 * 24 * time.Hour
+	// MaxRatioWindowDuration is the maximum duration of the averaging and stabilization windows of a Ratio policy,
+	// as the FleetAutoscaler controller keeps the samples of these windows in memory
+	MaxRatioWindowDuration = time.Hour
+)
+
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
@@ -66,6 +89,12 @@ type FleetAutoscalerPolicy struct {
 	// Webhook policy config params. Present only if FleetAutoscalerPolicyType = Webhook.
 	// +optional
 	Webhook *WebhookPolicy `json:"webhook,omitempty"`
+	// Schedule policy config params. Present only if FleetAutoscalerPolicyType = Schedule.
+	// +optional
+	Schedule *SchedulePolicy `json:"schedule,omitempty"`
+	// Ratio policy config params. Present only if FleetAutoscalerPolicyType = Ratio.
+	// +optional
+	Ratio *RatioPolicy `json:"ratio,omitempty"`
 }
 
 // FleetAutoscalerPolicyType is the policy for autoscaling
@@ -79,6 +108,12 @@ const (
 	// WebhookPolicyType is a simple webhook strategy used for horizontal fleet scaling
 	// GameServers
 	WebhookPolicyType FleetAutoscalerPolicyType = "Webhook"
+	// SchedulePolicyType FleetAutoscalerPolicyType applies different buffering strategies
+	// during scheduled windows of time, e.g. to scale up before peak hours
+	SchedulePolicyType FleetAutoscalerPolicyType = "Schedule"
+	// RatioPolicyType FleetAutoscalerPolicyType scales a Fleet to a target ratio of Allocated to Ready
+	// GameServers, averaged over a window of time
+	RatioPolicyType FleetAutoscalerPolicyType = "Ratio"
 )
 
 // BufferPolicy controls the desired behavior of the buffer policy.
@@ -105,6 +140,66 @@ type BufferPolicy struct {
 	BufferSize intstr.IntOrString `json:"bufferSize"`
 }
 
+// SchedulePolicy controls the desired behavior of the schedule policy.
+// The Buffer policy of the first active window is applied, or the Default one
+// when no window is active
+type SchedulePolicy struct {
+	// Default is the Buffer policy applied outside of the windows
+	Default BufferPolicy `json:"default"`
+
+	// Windows are the windows of time in which a different Buffer policy is applied
+	Windows []ScheduleWindow `json:"windows"`
+}
+
+// ScheduleWindow is a recurring window of time of a Schedule policy
+type ScheduleWindow struct {
+	// Start is a cron expression, in UTC, of when the window starts.
+	// Example: "0 18 * * 5" starts the window at 6PM every Friday
+	Start string `json:"start"`
+
+	// Duration is how long the window is active after it starts, e.g. 4h.
+	// Must be bigger than 0, and at most 7 days
+	Duration metav1.Duration `json:"duration"`
+
+	// Buffer is the Buffer policy applied while the window is active
+	Buffer BufferPolicy `json:"buffer"`
+}
+
+// RatioPolicy controls the desired behavior of the ratio policy.
+// The Fleet is scaled so that the ratio of its Allocated to Ready replicas, averaged over the Window,
+// reaches the TargetRatio
+type RatioPolicy struct {
+	// TargetRatio is the desired ratio of Allocated to Ready replicas.
+	// Example: when this is set to 4, the autoscaler will make sure that there is 1 Ready
+	//   game server for every 4 Allocated ones. When this is set to 0.5, there are 2 Ready
+	//   game servers for every Allocated one.
+	// Must be bigger than 0
+	TargetRatio resource.Quantity `json:"targetRatio"`
+
+	// MaxReplicas is the maximum amount of replicas that the fleet may have.
+	// It must be bigger than MinReplicas
+	MaxReplicas int32 `json:"maxReplicas"`
+
+	// MinReplicas is the minimum amount of replicas that the fleet must have.
+	// Must be bigger than 0, so that the fleet can scale up from no Allocated replicas
+	MinReplicas int32 `json:"minReplicas"`
+
+	// Window is the period over which the Allocated and Ready replicas are averaged, e.g. 2m.
+	// If zero, only the current replicas are used
+	// +optional
+	Window metav1.Duration `json:"window,omitempty"`
+
+	// ScaleUpStabilization is the period over which the lowest desired size is used when scaling up,
+	// so that the fleet does not scale up on a short spike, e.g. 1m
+	// +optional
+	ScaleUpStabilization metav1.Duration `json:"scaleUpStabilization,omitempty"`
+
+	// ScaleDownStabilization is the period over which the highest desired size is used when scaling down,
+	// so that the fleet does not flap between scaling up and down, e.g. 5m
+	// +optional
+	ScaleDownStabilization metav1.Duration `json:"scaleDownStabilization,omitempty"`
+}
+
 // WebhookPolicy controls the desired behavior of the webhook policy.
 // It contains the description of the webhook autoscaler service
 // used to form url which is accessible inside the cluster
@@ -132,6 +227,19 @@ type FleetAutoscalerStatus struct {
 	ScalingLimited bool `json:"scalingLimited"`
 }
 
+// ManualOverride pins the replicas of a Fleet, overriding the policy of its FleetAutoscaler
+type ManualOverride struct {
+	// Replicas is the number of replicas the Fleet is pinned to
+	Replicas int32
+	// Expiry is the time at which the override expires
+	Expiry time.Time
+}
+
+// Active returns true if the override has not expired at the given time
+func (o *ManualOverride) Active(now time.Time) bool {
+	return o != nil && now.Before(o.Expiry)
+}
+
 // FleetAutoscaleRequest defines the request to webhook autoscaler endpoint
 type FleetAutoscaleRequest struct {
 	// UID is an identifier for the individual request/response. It allows us to distinguish instances of requests which are
@@ -167,12 +275,70 @@ type FleetAutoscaleReview struct {
 
 // Validate validates the FleetAutoscaler scaling settings
 func (fas *FleetAutoscaler) Validate(causes []metav1.StatusCause) []metav1.StatusCause {
+	if fas.Spec.FleetName == "" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Field:   "fleetName",
+			Message: "fleetName should be provided",
+		})
+	}
+
 	switch fas.Spec.Policy.Type {
 	case BufferPolicyType:
 		causes = fas.Spec.Policy.Buffer.ValidateBufferPolicy(causes)
 
 	case WebhookPolicyType:
 		causes = fas.Spec.Policy.Webhook.ValidateWebhookPolicy(causes)
+
+	case SchedulePolicyType:
+		causes = fas.Spec.Policy.Schedule.ValidateSchedulePolicy(causes)
+
+	case RatioPolicyType:
+		causes = fas.Spec.Policy.Ratio.ValidateRatioPolicy(causes)
+	}
+	return fas.validateManualOverride(causes, time.Now())
+}
+
+// ManualOverride returns the override of the FleetAutoscaler policy set with the OverrideReplicasAnnotation
+// and OverrideExpiryAnnotation annotations, or nil if there is none.
+// Returns an error if the annotations are invalid.
+func (fas *FleetAutoscaler) ManualOverride() (*ManualOverride, error) {
+	replicas, hasReplicas := fas.ObjectMeta.Annotations[OverrideReplicasAnnotation]
+	expiry, hasExpiry := fas.ObjectMeta.Annotations[OverrideExpiryAnnotation]
+	if !hasReplicas && !hasExpiry {
+		return nil, nil
+	}
+	if !hasReplicas || !hasExpiry {
+		return nil, errors.Errorf("annotations %s and %s must be set together", OverrideReplicasAnnotation, OverrideExpiryAnnotation)
+	}
+
+	r, err := strconv.ParseInt(replicas, 10, 32)
+	if err != nil || r < 0 {
+		return nil, errors.Errorf("annotation %s must be a number of replicas that is 0 or more, was %q", OverrideReplicasAnnotation, replicas)
+	}
+	e, err := time.Parse(time.RFC3339, expiry)
+	if err != nil {
+		return nil, errors.Errorf("annotation %s must be a time in RFC3339 format, was %q", OverrideExpiryAnnotation, expiry)
+	}
+	return &ManualOverride{Replicas: int32(r), Expiry: e}, nil
+}
+
+// validateManualOverride validates the override annotations, and that the override expires within MaxOverrideDuration
+func (fas *FleetAutoscaler) validateManualOverride(causes []metav1.StatusCause, now time.Time) []metav1.StatusCause {
+	o, err := fas.ManualOverride()
+	if err != nil {
+		return append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Field:   "annotations",
+			Message: err.Error(),
+		})
+	}
+	if o != nil && o.Expiry.After(now.Add(MaxOverrideDuration)) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Field:   fmt.Sprintf("annotations.%s", OverrideExpiryAnnotation),
+			Message: fmt.Sprintf("override cannot expire more than %s in the future", MaxOverrideDuration),
+		})
 	}
 	return causes
 }
@@ -200,6 +366,13 @@ func (w *WebhookPolicy) ValidateWebhookPolicy(causes []metav1.StatusCause) []met
 			Message: "service and url cannot be used simultaneously",
 		})
 	}
+	if w.Service != nil && w.Service.Name == "" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Field:   "service.name",
+			Message: "service name should be provided",
+		})
+	}
 	if w.CABundle != nil {
 		rootCAs := x509.NewCertPool()
 		//Check that CABundle provided is correctly encoded certificate
@@ -211,13 +384,13 @@ func (w *WebhookPolicy) ValidateWebhookPolicy(causes []metav1.StatusCause) []met
 			})
 		}
 	}
-	if w.URL != nil {
+	if w.URL != nil && w.Service == nil {
 		u, err := url.Parse(*w.URL)
-		if err != nil {
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
 			causes = append(causes, metav1.StatusCause{
 				Type:    metav1.CauseTypeFieldValueInvalid,
 				Field:   "url",
-				Message: "url is not valid",
+				Message: "url is not valid, it should be an absolute http or https url",
 			})
 		} else if u.Scheme == "https" {
 			if w.CABundle == nil {
@@ -233,6 +406,117 @@ func (w *WebhookPolicy) ValidateWebhookPolicy(causes []metav1.StatusCause) []met
 	return causes
 }
 
+// ActiveBuffer returns the Buffer policy of the first window that is active at the given time,
+// or the Default Buffer policy if none is
+func (s *SchedulePolicy) ActiveBuffer(now time.Time) *BufferPolicy {
+	now = now.UTC()
+	for i := range s.Windows {
+		w := &s.Windows[i]
+		// invalid windows are rejected on validation, so should never be skipped here
+		if start, err := cron.Parse(w.Start); err == nil && start.Active(now, w.Duration.Duration) {
+			return &w.Buffer
+		}
+	}
+	return &s.Default
+}
+
+// ValidateSchedulePolicy validates the FleetAutoscaler Schedule policy settings
+func (s *SchedulePolicy) ValidateSchedulePolicy(causes []metav1.StatusCause) []metav1.StatusCause {
+	if s == nil {
+		return append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Field:   "schedule",
+			Message: "Schedule policy config params are missing",
+		})
+	}
+	if len(s.Windows) == 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Field:   "schedule.windows",
+			Message: "at least one window is required",
+		})
+	}
+
+	causes = appendFieldCauses(causes, "schedule.default.", s.Default.ValidateBufferPolicy(nil))
+	for i, w := range s.Windows {
+		prefix := fmt.Sprintf("schedule.windows[%d].", i)
+		if _, err := cron.Parse(w.Start); err != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Field:   prefix + "start",
+				Message: err.Error(),
+			})
+		}
+		if w.Duration.Duration <= 0 || w.Duration.Duration > MaxScheduleWindowDuration {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Field:   prefix + "duration",
+				Message: fmt.Sprintf("duration must be bigger than 0, and at most %s", MaxScheduleWindowDuration),
+			})
+		}
+		causes = appendFieldCauses(causes, prefix+"buffer.", w.Buffer.ValidateBufferPolicy(nil))
+	}
+	return causes
+}
+
+// ValidateRatioPolicy validates the FleetAutoscaler Ratio policy settings
+func (r *RatioPolicy) ValidateRatioPolicy(causes []metav1.StatusCause) []metav1.StatusCause {
+	if r == nil {
+		return append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Field:   "ratio",
+			Message: "Ratio policy config params are missing",
+		})
+	}
+	if r.TargetRatio.Sign() <= 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Field:   "targetRatio",
+			Message: "targetRatio must be bigger than 0",
+		})
+	}
+	if r.MinReplicas < 1 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Field:   "minReplicas",
+			Message: "minReplicas should be above 0",
+		})
+	}
+	if r.MinReplicas > r.MaxReplicas {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Field:   "minReplicas",
+			Message: "minReplicas is bigger than maxReplicas",
+		})
+	}
+	for _, w := range []struct {
+		field    string
+		duration time.Duration
+	}{
+		{field: "window", duration: r.Window.Duration},
+		{field: "scaleUpStabilization", duration: r.ScaleUpStabilization.Duration},
+		{field: "scaleDownStabilization", duration: r.ScaleDownStabilization.Duration},
+	} {
+		if w.duration < 0 || w.duration > MaxRatioWindowDuration {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Field:   w.field,
+				Message: fmt.Sprintf("%s must be between 0 and %s", w.field, MaxRatioWindowDuration),
+			})
+		}
+	}
+	return causes
+}
+
+// appendFieldCauses appends the causes of a nested field to causes, with its path prefixed to their fields
+func appendFieldCauses(causes []metav1.StatusCause, prefix string, nested []metav1.StatusCause) []metav1.StatusCause {
+	for _, c := range nested {
+		c.Field = prefix + c.Field
+		causes = append(causes, c)
+	}
+	return causes
+}
+
 // ValidateBufferPolicy validates the FleetAutoscaler Buffer policy settings
 func (b *BufferPolicy) ValidateBufferPolicy(causes []metav1.StatusCause) []metav1.StatusCause {
 	if b == nil {
@@ -16,9 +16,11 @@ package v1
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	admregv1b "k8s.io/api/admissionregistration/v1beta1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
@@ -112,7 +114,62 @@ func TestFleetAutoscalerValidateUpdate(t *testing.T) {
 		assert.Len(t, causes, 1)
 		assert.Equal(t, "minReplicas", causes[0].Field)
 	})
+
+	t.Run("missing fleet name", func(t *testing.T) {
+		fas := defaultFixture()
+		fas.Spec.FleetName = ""
+
+		causes := fas.Validate(nil)
+
+		assert.Len(t, causes, 1)
+		assert.Equal(t, "fleetName", causes[0].Field)
+	})
 }
+func TestFleetAutoscalerManualOverride(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2019, 11, 5, 20, 0, 0, 0, time.UTC)
+	expiry := now.Add(time.Hour).Format(time.RFC3339)
+
+	fas := defaultFixture()
+	o, err := fas.ManualOverride()
+	assert.NoError(t, err)
+	assert.Nil(t, o)
+	assert.False(t, o.Active(now))
+	assert.Empty(t, fas.validateManualOverride(nil, now))
+
+	fas.ObjectMeta.Annotations = map[string]string{OverrideReplicasAnnotation: "20", OverrideExpiryAnnotation: expiry}
+	o, err = fas.ManualOverride()
+	assert.NoError(t, err)
+	assert.Equal(t, int32(20), o.Replicas)
+	assert.True(t, o.Active(now))
+	assert.False(t, o.Active(now.Add(time.Hour)))
+	assert.Empty(t, fas.validateManualOverride(nil, now))
+
+	// expired overrides are still valid
+	assert.Empty(t, fas.validateManualOverride(nil, now.Add(48*time.Hour)))
+
+	fas.ObjectMeta.Annotations[OverrideExpiryAnnotation] = now.Add(MaxOverrideDuration + time.Minute).Format(time.RFC3339)
+	causes := fas.validateManualOverride(nil, now)
+	assert.Len(t, causes, 1)
+	assert.Equal(t, "annotations."+OverrideExpiryAnnotation, causes[0].Field)
+
+	for _, annotations := range []map[string]string{
+		{OverrideReplicasAnnotation: "20"},
+		{OverrideExpiryAnnotation: expiry},
+		{OverrideReplicasAnnotation: "-1", OverrideExpiryAnnotation: expiry},
+		{OverrideReplicasAnnotation: "twenty", OverrideExpiryAnnotation: expiry},
+		{OverrideReplicasAnnotation: "20", OverrideExpiryAnnotation: "tomorrow"},
+	} {
+		fas.ObjectMeta.Annotations = annotations
+		_, err = fas.ManualOverride()
+		assert.Error(t, err, "%v should be invalid", annotations)
+		causes = fas.validateManualOverride(nil, now)
+		assert.Len(t, causes, 1)
+		assert.Equal(t, "annotations", causes[0].Field)
+	}
+}
+
 func TestFleetAutoscalerWebhookValidateUpdate(t *testing.T) {
 	t.Parallel()
 
@@ -203,6 +260,169 @@ func TestFleetAutoscalerWebhookValidateUpdate(t *testing.T) {
 		assert.Equal(t, "url", causes[0].Field)
 	})
 
+	t.Run("relative or non http url value", func(t *testing.T) {
+		for _, url := range []string{"/scale", "good.example.com/scale", "ftp://good.example.com"} {
+			fas := webhookFixture()
+			url := url
+			fas.Spec.Policy.Webhook.URL = &url
+			fas.Spec.Policy.Webhook.Service = nil
+
+			causes := fas.Validate(nil)
+			assert.Len(t, causes, 1, url)
+			assert.Equal(t, "url", causes[0].Field, url)
+		}
+	})
+
+	t.Run("service without a name", func(t *testing.T) {
+		fas := webhookFixture()
+		fas.Spec.Policy.Webhook.Service.Name = ""
+
+		causes := fas.Validate(nil)
+		assert.Len(t, causes, 1)
+		assert.Equal(t, "service.name", causes[0].Field)
+	})
+
+}
+
+func TestFleetAutoscalerScheduleValidateUpdate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("good schedule", func(t *testing.T) {
+		fas := scheduleFixture()
+		causes := fas.Validate(nil)
+
+		assert.Len(t, causes, 0)
+	})
+
+	t.Run("missing schedule", func(t *testing.T) {
+		fas := scheduleFixture()
+		fas.Spec.Policy.Schedule = nil
+		causes := fas.Validate(nil)
+
+		assert.Len(t, causes, 1)
+		assert.Equal(t, "schedule", causes[0].Field)
+	})
+
+	t.Run("no windows", func(t *testing.T) {
+		fas := scheduleFixture()
+		fas.Spec.Policy.Schedule.Windows = nil
+		causes := fas.Validate(nil)
+
+		assert.Len(t, causes, 1)
+		assert.Equal(t, "schedule.windows", causes[0].Field)
+	})
+
+	t.Run("bad start", func(t *testing.T) {
+		fas := scheduleFixture()
+		fas.Spec.Policy.Schedule.Windows[0].Start = "0 25 * * *"
+		causes := fas.Validate(nil)
+
+		assert.Len(t, causes, 1)
+		assert.Equal(t, "schedule.windows[0].start", causes[0].Field)
+	})
+
+	t.Run("bad duration", func(t *testing.T) {
+		fas := scheduleFixture()
+		fas.Spec.Policy.Schedule.Windows[0].Duration.Duration = 0
+		causes := fas.Validate(nil)
+		assert.Len(t, causes, 1)
+		assert.Equal(t, "schedule.windows[0].duration", causes[0].Field)
+
+		fas.Spec.Policy.Schedule.Windows[0].Duration.Duration = MaxScheduleWindowDuration + time.Minute
+		causes = fas.Validate(nil)
+		assert.Len(t, causes, 1)
+		assert.Equal(t, "schedule.windows[0].duration", causes[0].Field)
+	})
+
+	t.Run("bad buffers", func(t *testing.T) {
+		fas := scheduleFixture()
+		fas.Spec.Policy.Schedule.Default.MinReplicas = 20
+		fas.Spec.Policy.Schedule.Windows[0].Buffer.BufferSize = intstr.FromInt(0)
+		causes := fas.Validate(nil)
+
+		assert.Len(t, causes, 2)
+		assert.Equal(t, "schedule.default.minReplicas", causes[0].Field)
+		assert.Equal(t, "schedule.windows[0].buffer.bufferSize", causes[1].Field)
+	})
+}
+
+func TestFleetAutoscalerRatioValidateUpdate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("good ratio", func(t *testing.T) {
+		fas := ratioFixture()
+		causes := fas.Validate(nil)
+
+		assert.Len(t, causes, 0)
+	})
+
+	t.Run("missing ratio", func(t *testing.T) {
+		fas := ratioFixture()
+		fas.Spec.Policy.Ratio = nil
+		causes := fas.Validate(nil)
+
+		assert.Len(t, causes, 1)
+		assert.Equal(t, "ratio", causes[0].Field)
+	})
+
+	t.Run("bad target ratio", func(t *testing.T) {
+		fas := ratioFixture()
+		fas.Spec.Policy.Ratio.TargetRatio = resource.MustParse("0")
+		causes := fas.Validate(nil)
+		assert.Len(t, causes, 1)
+		assert.Equal(t, "targetRatio", causes[0].Field)
+
+		fas.Spec.Policy.Ratio.TargetRatio = resource.MustParse("-1")
+		causes = fas.Validate(nil)
+		assert.Len(t, causes, 1)
+		assert.Equal(t, "targetRatio", causes[0].Field)
+	})
+
+	t.Run("bad min replicas", func(t *testing.T) {
+		fas := ratioFixture()
+		fas.Spec.Policy.Ratio.MinReplicas = 0
+		causes := fas.Validate(nil)
+		assert.Len(t, causes, 1)
+		assert.Equal(t, "minReplicas", causes[0].Field)
+
+		fas.Spec.Policy.Ratio.MinReplicas = 200
+		causes = fas.Validate(nil)
+		assert.Len(t, causes, 1)
+		assert.Equal(t, "minReplicas", causes[0].Field)
+	})
+
+	t.Run("bad windows", func(t *testing.T) {
+		fas := ratioFixture()
+		fas.Spec.Policy.Ratio.Window.Duration = -time.Minute
+		fas.Spec.Policy.Ratio.ScaleUpStabilization.Duration = MaxRatioWindowDuration + time.Minute
+		fas.Spec.Policy.Ratio.ScaleDownStabilization.Duration = MaxRatioWindowDuration + time.Minute
+		causes := fas.Validate(nil)
+
+		assert.Len(t, causes, 3)
+		assert.Equal(t, "window", causes[0].Field)
+		assert.Equal(t, "scaleUpStabilization", causes[1].Field)
+		assert.Equal(t, "scaleDownStabilization", causes[2].Field)
+	})
+}
+
+func TestSchedulePolicyActiveBuffer(t *testing.T) {
+	t.Parallel()
+
+	s := scheduleFixture().Spec.Policy.Schedule
+	s.Windows = append(s.Windows, ScheduleWindow{
+		Start:    "0 17 * * *",
+		Duration: metav1.Duration{Duration: 6 * time.Hour},
+		Buffer:   BufferPolicy{BufferSize: intstr.FromInt(10), MaxReplicas: 50},
+	})
+
+	// Friday 5 PM UTC
+	friday := time.Date(2019, 11, 8, 17, 0, 0, 0, time.UTC)
+	assert.Equal(t, &s.Default, s.ActiveBuffer(friday.Add(-time.Minute)))
+	assert.Equal(t, &s.Windows[1].Buffer, s.ActiveBuffer(friday))
+	assert.Equal(t, &s.Windows[0].Buffer, s.ActiveBuffer(friday.Add(time.Hour)), "the first active window should apply")
+	assert.Equal(t, &s.Windows[0].Buffer, s.ActiveBuffer(friday.Add(4*time.Hour).In(time.FixedZone("PST", -8*60*60))))
+	assert.Equal(t, &s.Windows[1].Buffer, s.ActiveBuffer(friday.Add(5*time.Hour)))
+	assert.Equal(t, &s.Default, s.ActiveBuffer(friday.Add(6*time.Hour)))
 }
 
 func defaultFixture() *FleetAutoscaler {
@@ -213,6 +433,14 @@ func webhookFixture() *FleetAutoscaler {
 	return customFixture(WebhookPolicyType)
 }
 
+func scheduleFixture() *FleetAutoscaler {
+	return customFixture(SchedulePolicyType)
+}
+
+func ratioFixture() *FleetAutoscaler {
+	return customFixture(RatioPolicyType)
+}
+
 func customFixture(t FleetAutoscalerPolicyType) *FleetAutoscaler {
 	res := &FleetAutoscaler{
 		ObjectMeta: metav1.ObjectMeta{Name: "test"},
@@ -240,6 +468,29 @@ func customFixture(t FleetAutoscalerPolicyType) *FleetAutoscaler {
 				Path:      &url,
 			},
 		}
+	case SchedulePolicyType:
+		res.Spec.Policy.Type = SchedulePolicyType
+		res.Spec.Policy.Schedule = &SchedulePolicy{
+			Default: *res.Spec.Policy.Buffer,
+			Windows: []ScheduleWindow{{
+				// Fridays from 6PM to 10PM
+				Start:    "0 18 * * 5",
+				Duration: metav1.Duration{Duration: 4 * time.Hour},
+				Buffer:   BufferPolicy{BufferSize: intstr.FromInt(20), MaxReplicas: 100},
+			}},
+		}
+		res.Spec.Policy.Buffer = nil
+	case RatioPolicyType:
+		res.Spec.Policy.Type = RatioPolicyType
+		res.Spec.Policy.Buffer = nil
+		res.Spec.Policy.Ratio = &RatioPolicy{
+			TargetRatio:            resource.MustParse("4"),
+			MinReplicas:            5,
+			MaxReplicas:            100,
+			Window:                 metav1.Duration{Duration: 2 * time.Minute},
+			ScaleUpStabilization:   metav1.Duration{Duration: time.Minute},
+			ScaleDownStabilization: metav1.Duration{Duration: 5 * time.Minute},
+		}
 	}
 	return res
 }
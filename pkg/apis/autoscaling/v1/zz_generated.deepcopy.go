@@ -175,6 +175,16 @@ func (in *FleetAutoscalerPolicy) DeepCopyInto(out *FleetAutoscalerPolicy) {
 		*out = new(WebhookPolicy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(SchedulePolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Ratio != nil {
+		in, out := &in.Ratio, &out.Ratio
+		*out = new(RatioPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -225,6 +235,66 @@ func (in *FleetAutoscalerStatus) DeepCopy() *FleetAutoscalerStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RatioPolicy) DeepCopyInto(out *RatioPolicy) {
+	*out = *in
+	out.TargetRatio = in.TargetRatio.DeepCopy()
+	out.Window = in.Window
+	out.ScaleUpStabilization = in.ScaleUpStabilization
+	out.ScaleDownStabilization = in.ScaleDownStabilization
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RatioPolicy.
+func (in *RatioPolicy) DeepCopy() *RatioPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(RatioPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchedulePolicy) DeepCopyInto(out *SchedulePolicy) {
+	*out = *in
+	out.Default = in.Default
+	if in.Windows != nil {
+		in, out := &in.Windows, &out.Windows
+		*out = make([]ScheduleWindow, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulePolicy.
+func (in *SchedulePolicy) DeepCopy() *SchedulePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(SchedulePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduleWindow) DeepCopyInto(out *ScheduleWindow) {
+	*out = *in
+	out.Duration = in.Duration
+	out.Buffer = in.Buffer
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleWindow.
+func (in *ScheduleWindow) DeepCopy() *ScheduleWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduleWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WebhookPolicy) DeepCopyInto(out *WebhookPolicy) {
 	*out = *in
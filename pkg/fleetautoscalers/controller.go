@@ -62,6 +62,7 @@ type Controller struct {
 	fleetAutoscalerSynced cache.InformerSynced
 	workerqueue           *workerqueue.WorkerQueue
 	recorder              record.EventRecorder
+	ratios                *ratioHistory
 }
 
 // NewController returns a controller for a FleetAutoscaler
@@ -83,10 +84,11 @@ func NewController(
 		fleetAutoscalerGetter: agonesClient.AutoscalingV1(),
 		fleetAutoscalerLister: autoscaler.Lister(),
 		fleetAutoscalerSynced: autoscaler.Informer().HasSynced,
+		ratios:                newRatioHistory(),
 	}
 	c.baseLogger = runtime.NewLoggerWithType(c)
 	c.workerqueue = workerqueue.NewWorkerQueue(c.syncFleetAutoscaler, c.baseLogger, logfields.FleetAutoscalerKey, autoscaling.GroupName+".FleetAutoscalerController")
-	health.AddLivenessCheck("fleetautoscaler-workerqueue", healthcheck.Check(c.workerqueue.Healthy))
+	c.workerqueue.AddHealthChecks(health, "fleetautoscaler-workerqueue")
 
 	eventBroadcaster := record.NewBroadcaster()
 	eventBroadcaster.StartLogging(c.baseLogger.Infof)
@@ -96,6 +98,8 @@ func NewController(
 	kind := autoscalingv1.Kind("FleetAutoscaler")
 	wh.AddHandler("/validate", kind, admv1beta1.Create, c.validationHandler)
 	wh.AddHandler("/validate", kind, admv1beta1.Update, c.validationHandler)
+	wh.AddWarningHandler("/validate", kind, admv1beta1.Create, c.fleetWarningHandler)
+	wh.AddWarningHandler("/validate", kind, admv1beta1.Update, c.fleetWarningHandler)
 
 	autoscaler.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc: c.workerqueue.Enqueue,
@@ -168,6 +172,22 @@ func (c *Controller) validationHandler(review admv1beta1.AdmissionReview) (admv1
 	return review, nil
 }
 
+// fleetWarningHandler warns when the Fleet of a FleetAutoscaler does not exist, without denying
+// the request, as the Fleet can be created after its FleetAutoscaler
+func (c *Controller) fleetWarningHandler(review admv1beta1.AdmissionReview) []string {
+	fas := &autoscalingv1.FleetAutoscaler{}
+	if err := json.Unmarshal(review.Request.Object.Raw, fas); err != nil || fas.Spec.FleetName == "" {
+		// the validation handler denies the request
+		return nil
+	}
+
+	if _, err := c.fleetLister.Fleets(review.Request.Namespace).Get(fas.Spec.FleetName); k8serrors.IsNotFound(err) {
+		return []string{fmt.Sprintf("Fleet %s does not exist in namespace %s, the FleetAutoscaler will not scale until it is created",
+			fas.Spec.FleetName, review.Request.Namespace)}
+	}
+	return nil
+}
+
 // syncFleetAutoscaler scales the attached fleet and
 // synchronizes the FleetAutoscaler CRD
 func (c *Controller) syncFleetAutoscaler(key string) error {
@@ -185,6 +205,7 @@ func (c *Controller) syncFleetAutoscaler(key string) error {
 	if err != nil {
 		if k8serrors.IsNotFound(err) {
 			c.loggerForFleetAutoscalerKey(key).Info(fmt.Sprintf("FleetAutoscaler %s from namespace %s is no longer available for syncing", name, namespace))
+			c.ratios.forget(key)
 			return nil
 		}
 		return errors.Wrapf(err, "error retrieving FleetAutoscaler %s from namespace %s", name, namespace)
@@ -211,7 +232,26 @@ func (c *Controller) syncFleetAutoscaler(key string) error {
 	}
 
 	currentReplicas := fleet.Status.Replicas
-	desiredReplicas, scalingLimited, err := computeDesiredFleetSize(fas, fleet)
+
+	override, err := fas.ManualOverride()
+	if err != nil {
+		// should not happen, as the annotations are validated, so fall back to the policy
+		c.loggerForFleetAutoscaler(fas).WithError(err).Warn("Ignoring invalid manual override")
+	}
+	if now := time.Now(); override.Active(now) {
+		if override.Replicas != fleet.Spec.Replicas {
+			c.recorder.Eventf(fas, corev1.EventTypeNormal, "ManualOverride",
+				"Fleet %s replicas are pinned to %d until %s", fleet.ObjectMeta.Name, override.Replicas, override.Expiry.Format(time.RFC3339))
+		}
+		if err = c.scaleFleet(fas, fleet, override.Replicas); err != nil {
+			return errors.Wrapf(err, "error scaling fleet %s to %d overridden replicas", fas.Spec.FleetName, override.Replicas)
+		}
+		// go back to the policy as soon as the override expires
+		c.workerqueue.EnqueueAfter(fas, override.Expiry.Sub(now))
+		return c.updateStatus(fas, currentReplicas, override.Replicas, override.Replicas != fleet.Spec.Replicas, false)
+	}
+
+	desiredReplicas, scalingLimited, err := computeDesiredFleetSize(fas, fleet, c.ratios)
 	if err != nil {
 		c.recorder.Eventf(fas, corev1.EventTypeWarning, "FleetAutoscaler",
 			"Error calculating desired fleet size on FleetAutoscaler %s. Error: %s", fas.ObjectMeta.Name, err.Error())
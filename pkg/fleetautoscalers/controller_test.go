@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
 	autoscalingv1 "agones.dev/agones/pkg/apis/autoscaling/v1"
@@ -115,6 +116,33 @@ func TestWebhookControllerCreationValidationHandler(t *testing.T) {
 	})
 }
 
+func TestControllerFleetWarningHandler(t *testing.T) {
+	t.Parallel()
+
+	c, m := newFakeController()
+	fas, f := defaultWebhookFixtures()
+	m.AgonesClient.AddReactor("list", "fleets", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, &agonesv1.FleetList{Items: []agonesv1.Fleet{*f}}, nil
+	})
+	_, cancel := agtesting.StartInformers(m, c.fleetSynced)
+	defer cancel()
+
+	review, err := newAdmissionReview(*fas)
+	assert.Nil(t, err)
+	assert.Empty(t, c.fleetWarningHandler(review))
+
+	fas.Spec.FleetName = "missing"
+	review, err = newAdmissionReview(*fas)
+	assert.Nil(t, err)
+	warnings := c.fleetWarningHandler(review)
+	if assert.Len(t, warnings, 1) {
+		assert.Equal(t, "Fleet missing does not exist in namespace default, the FleetAutoscaler will not scale until it is created", warnings[0])
+	}
+
+	review.Request.Object.Raw = []byte("{")
+	assert.Empty(t, c.fleetWarningHandler(review))
+}
+
 // nolint:dupl
 func TestControllerSyncFleetAutoscaler(t *testing.T) {
 	t.Parallel()
@@ -260,6 +288,98 @@ func TestControllerSyncFleetAutoscaler(t *testing.T) {
 		agtesting.AssertNoEvent(t, m.FakeRecorder.Events)
 	})
 
+	t.Run("manual override", func(t *testing.T) {
+		t.Parallel()
+		c, m := newFakeController()
+		fas, f := defaultFixtures()
+		fas.ObjectMeta.Annotations = map[string]string{
+			autoscalingv1.OverrideReplicasAnnotation: "50",
+			autoscalingv1.OverrideExpiryAnnotation:   time.Now().Add(time.Hour).Format(time.RFC3339),
+		}
+
+		f.Spec.Replicas = 10
+		f.Status.Replicas = 10
+		f.Status.ReadyReplicas = 5
+		fas.Spec.Policy.Buffer.BufferSize = intstr.FromInt(5)
+
+		fUpdated := false
+		fasUpdated := false
+
+		m.AgonesClient.AddReactor("list", "fleetautoscalers", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			return true, &autoscalingv1.FleetAutoscalerList{Items: []autoscalingv1.FleetAutoscaler{*fas}}, nil
+		})
+
+		m.AgonesClient.AddReactor("update", "fleetautoscalers", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			fasUpdated = true
+			ca := action.(k8stesting.UpdateAction)
+			fas := ca.GetObject().(*autoscalingv1.FleetAutoscaler)
+			assert.Equal(t, int32(10), fas.Status.CurrentReplicas)
+			assert.Equal(t, int32(50), fas.Status.DesiredReplicas)
+			return true, fas, nil
+		})
+
+		m.AgonesClient.AddReactor("list", "fleets", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			return true, &agonesv1.FleetList{Items: []agonesv1.Fleet{*f}}, nil
+		})
+
+		m.AgonesClient.AddReactor("update", "fleets", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			fUpdated = true
+			ca := action.(k8stesting.UpdateAction)
+			f := ca.GetObject().(*agonesv1.Fleet)
+			assert.Equal(t, int32(50), f.Spec.Replicas)
+			return true, f, nil
+		})
+
+		_, cancel := agtesting.StartInformers(m, c.fleetSynced, c.fleetAutoscalerSynced)
+		defer cancel()
+
+		err := c.syncFleetAutoscaler("default/fas-1")
+		assert.Nil(t, err)
+		assert.True(t, fUpdated, "fleet should have been updated")
+		assert.True(t, fasUpdated, "fleetautoscaler should have been updated")
+		agtesting.AssertEventContains(t, m.FakeRecorder.Events, "ManualOverride")
+		agtesting.AssertEventContains(t, m.FakeRecorder.Events, "AutoScalingFleet")
+		agtesting.AssertNoEvent(t, m.FakeRecorder.Events)
+	})
+
+	t.Run("expired manual override", func(t *testing.T) {
+		t.Parallel()
+		c, m := newFakeController()
+		fas, f := defaultFixtures()
+		fas.ObjectMeta.Annotations = map[string]string{
+			autoscalingv1.OverrideReplicasAnnotation: "50",
+			autoscalingv1.OverrideExpiryAnnotation:   time.Now().Add(-time.Minute).Format(time.RFC3339),
+		}
+
+		f.Spec.Replicas = 10
+		f.Status.Replicas = 10
+		f.Status.ReadyReplicas = 5
+		f.Status.AllocatedReplicas = 5
+		fas.Spec.Policy.Buffer.BufferSize = intstr.FromInt(5)
+		fas.Status.CurrentReplicas = 10
+		fas.Status.DesiredReplicas = 10
+
+		m.AgonesClient.AddReactor("list", "fleetautoscalers", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			return true, &autoscalingv1.FleetAutoscalerList{Items: []autoscalingv1.FleetAutoscaler{*fas}}, nil
+		})
+
+		m.AgonesClient.AddReactor("list", "fleets", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			return true, &agonesv1.FleetList{Items: []agonesv1.Fleet{*f}}, nil
+		})
+
+		m.AgonesClient.AddReactor("update", "fleets", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			assert.FailNow(t, "fleet should not update")
+			return false, nil, nil
+		})
+
+		_, cancel := agtesting.StartInformers(m, c.fleetSynced, c.fleetAutoscalerSynced)
+		defer cancel()
+
+		err := c.syncFleetAutoscaler("default/fas-1")
+		assert.Nil(t, err)
+		agtesting.AssertNoEvent(t, m.FakeRecorder.Events)
+	})
+
 	t.Run("fleet not available", func(t *testing.T) {
 		t.Parallel()
 		c, m := newFakeController()
@@ -40,16 +40,20 @@ var client = http.Client{
 }
 
 // computeDesiredFleetSize computes the new desired size of the given fleet
-func computeDesiredFleetSize(fas *autoscalingv1.FleetAutoscaler, f *agonesv1.Fleet) (int32, bool, error) {
+func computeDesiredFleetSize(fas *autoscalingv1.FleetAutoscaler, f *agonesv1.Fleet, ratios *ratioHistory) (int32, bool, error) {
 
 	switch fas.Spec.Policy.Type {
 	case autoscalingv1.BufferPolicyType:
 		return applyBufferPolicy(fas.Spec.Policy.Buffer, f)
 	case autoscalingv1.WebhookPolicyType:
 		return applyWebhookPolicy(fas.Spec.Policy.Webhook, f)
+	case autoscalingv1.SchedulePolicyType:
+		return applySchedulePolicy(fas.Spec.Policy.Schedule, f, time.Now())
+	case autoscalingv1.RatioPolicyType:
+		return applyRatioPolicy(fas.Spec.Policy.Ratio, f, ratios, fas.ObjectMeta.Namespace+"/"+fas.ObjectMeta.Name, time.Now())
 	}
 
-	return f.Status.Replicas, false, errors.New("wrong policy type, should be one of: Buffer, Webhook")
+	return f.Status.Replicas, false, errors.New("wrong policy type, should be one of: Buffer, Webhook, Schedule, Ratio")
 }
 
 func applyWebhookPolicy(w *autoscalingv1.WebhookPolicy, f *agonesv1.Fleet) (int32, bool, error) {
@@ -135,6 +139,14 @@ func applyWebhookPolicy(w *autoscalingv1.WebhookPolicy, f *agonesv1.Fleet) (int3
 	return f.Status.Replicas, false, nil
 }
 
+// applySchedulePolicy applies the Buffer policy of the window of the Schedule policy that is active at the given time
+func applySchedulePolicy(s *autoscalingv1.SchedulePolicy, f *agonesv1.Fleet, now time.Time) (int32, bool, error) {
+	if s == nil {
+		return f.Status.Replicas, false, errors.New("schedule policy config params are missing")
+	}
+	return applyBufferPolicy(s.ActiveBuffer(now), f)
+}
+
 func applyBufferPolicy(b *autoscalingv1.BufferPolicy, f *agonesv1.Fleet) (int32, bool, error) {
 	var replicas int32
 
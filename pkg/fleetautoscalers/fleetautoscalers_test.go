@@ -23,9 +23,11 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	autoscalingv1 "agones.dev/agones/pkg/apis/autoscaling/v1"
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
@@ -45,7 +47,7 @@ func TestComputeDesiredFleetSize(t *testing.T) {
 	f.Status.AllocatedReplicas = 40
 	f.Status.ReadyReplicas = 10
 
-	replicas, limited, err := computeDesiredFleetSize(fas, f)
+	replicas, limited, err := computeDesiredFleetSize(fas, f, newRatioHistory())
 	assert.Nil(t, err)
 	assert.Equal(t, replicas, int32(60))
 	assert.Equal(t, limited, false)
@@ -53,7 +55,7 @@ func TestComputeDesiredFleetSize(t *testing.T) {
 	// test empty Policy Type
 	f.Status.Replicas = 61
 	fas.Spec.Policy.Type = ""
-	replicas, limited, err = computeDesiredFleetSize(fas, f)
+	replicas, limited, err = computeDesiredFleetSize(fas, f, newRatioHistory())
 	assert.NotNil(t, err)
 	assert.Equal(t, replicas, int32(61))
 	assert.Equal(t, limited, false)
@@ -125,6 +127,41 @@ func TestApplyBufferPolicy(t *testing.T) {
 
 type testServer struct{}
 
+func TestApplySchedulePolicy(t *testing.T) {
+	t.Parallel()
+
+	_, f := defaultFixtures()
+	f.Status.AllocatedReplicas = 40
+	s := &autoscalingv1.SchedulePolicy{
+		Default: autoscalingv1.BufferPolicy{BufferSize: intstr.FromInt(5), MinReplicas: 10, MaxReplicas: 100},
+		Windows: []autoscalingv1.ScheduleWindow{{
+			// weekdays from 6PM to 10PM
+			Start:    "0 18 * * 1-5",
+			Duration: metav1.Duration{Duration: 4 * time.Hour},
+			Buffer:   autoscalingv1.BufferPolicy{BufferSize: intstr.FromInt(50), MinReplicas: 50, MaxReplicas: 80},
+		}},
+	}
+
+	// Friday 8PM
+	now := time.Date(2019, 11, 8, 20, 0, 0, 0, time.UTC)
+	replicas, limited, err := applySchedulePolicy(s, f, now)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(80), replicas)
+	assert.True(t, limited)
+
+	// Saturday 8PM
+	replicas, limited, err = applySchedulePolicy(s, f, now.Add(24*time.Hour))
+	assert.Nil(t, err)
+	assert.Equal(t, int32(45), replicas)
+	assert.False(t, limited)
+
+	f.Status.Replicas = 12
+	replicas, limited, err = applySchedulePolicy(nil, f, now)
+	assert.NotNil(t, err)
+	assert.Equal(t, int32(12), replicas)
+	assert.False(t, limited)
+}
+
 func (t testServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	if r == nil {
 		http.Error(w, "Empty request", http.StatusInternalServerError)
@@ -0,0 +1,137 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fleetautoscalers
+
+import (
+	"sync"
+	"time"
+
+	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
+	autoscalingv1 "agones.dev/agones/pkg/apis/autoscaling/v1"
+	"github.com/pkg/errors"
+)
+
+// ratioSample is the number of Allocated replicas of a Fleet at a point in time,
+// and the size that the Ratio policy recommended for the Fleet from it
+type ratioSample struct {
+	time           time.Time
+	allocated      int32
+	recommendation int32
+}
+
+// ratioHistory keeps the recent samples of the Fleets of the FleetAutoscalers with a Ratio policy,
+// so that the policy can average them, and stabilize scaling, over windows of time
+type ratioHistory struct {
+	mu      sync.Mutex
+	samples map[string][]ratioSample
+}
+
+// newRatioHistory returns an empty ratioHistory
+func newRatioHistory() *ratioHistory {
+	return &ratioHistory{samples: map[string][]ratioSample{}}
+}
+
+// forget removes the samples of the FleetAutoscaler with the given key
+func (h *ratioHistory) forget(key string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.samples, key)
+}
+
+// recent removes the samples of the FleetAutoscaler with the given key that are maxAge or older
+// at the given time, and returns the samples that are left
+func (h *ratioHistory) recent(key string, now time.Time, maxAge time.Duration) []ratioSample {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	var samples []ratioSample
+	for _, s := range h.samples[key] {
+		if now.Sub(s.time) < maxAge {
+			samples = append(samples, s)
+		}
+	}
+	h.samples[key] = samples
+	return samples
+}
+
+// add adds a sample for the FleetAutoscaler with the given key
+func (h *ratioHistory) add(key string, sample ratioSample) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.samples[key] = append(h.samples[key], sample)
+}
+
+// applyRatioPolicy computes the size of the fleet that reaches the target ratio of Allocated to Ready replicas,
+// from the Allocated replicas averaged over the window, and stabilizes it over the scale up and scale down windows
+func applyRatioPolicy(r *autoscalingv1.RatioPolicy, f *agonesv1.Fleet, h *ratioHistory, key string, now time.Time) (int32, bool, error) {
+	if r == nil {
+		return f.Status.Replicas, false, errors.New("ratio policy config params are missing")
+	}
+	target := r.TargetRatio.MilliValue()
+	if target <= 0 {
+		return f.Status.Replicas, false, errors.Errorf("targetRatio must be bigger than 0, was %s", r.TargetRatio.String())
+	}
+
+	maxAge := r.Window.Duration
+	for _, d := range []time.Duration{r.ScaleUpStabilization.Duration, r.ScaleDownStabilization.Duration} {
+		if d > maxAge {
+			maxAge = d
+		}
+	}
+	samples := h.recent(key, now, maxAge)
+	current := ratioSample{time: now, allocated: f.Status.AllocatedReplicas}
+
+	// average the Allocated replicas of the window, with the current sample
+	sum, count := int64(current.allocated), int64(1)
+	for _, s := range samples {
+		if now.Sub(s.time) < r.Window.Duration {
+			sum += int64(s.allocated)
+			count++
+		}
+	}
+	// allocated + allocated / targetRatio, rounded up, as integers to avoid rounding errors
+	replicas := (sum*(target+1000) + count*target - 1) / (count * target)
+
+	limited := false
+	if replicas < int64(r.MinReplicas) {
+		replicas = int64(r.MinReplicas)
+		limited = true
+	}
+	if replicas > int64(r.MaxReplicas) {
+		replicas = int64(r.MaxReplicas)
+		limited = true
+	}
+	current.recommendation = int32(replicas)
+	h.add(key, current)
+
+	// only scale up to the lowest, and down to the highest, recommendation of the stabilization windows
+	up, down := current.recommendation, current.recommendation
+	for _, s := range samples {
+		age := now.Sub(s.time)
+		if age < r.ScaleUpStabilization.Duration && s.recommendation < up {
+			up = s.recommendation
+		}
+		if age < r.ScaleDownStabilization.Duration && s.recommendation > down {
+			down = s.recommendation
+		}
+	}
+	switch {
+	case f.Spec.Replicas < up:
+		return up, limited, nil
+	case f.Spec.Replicas > down:
+		return down, limited, nil
+	}
+	return f.Spec.Replicas, limited, nil
+}
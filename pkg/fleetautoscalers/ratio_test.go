@@ -0,0 +1,159 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fleetautoscalers
+
+import (
+	"testing"
+	"time"
+
+	autoscalingv1 "agones.dev/agones/pkg/apis/autoscaling/v1"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestApplyRatioPolicy(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2019, 11, 8, 18, 0, 0, 0, time.UTC)
+	ratio := func() *autoscalingv1.RatioPolicy {
+		return &autoscalingv1.RatioPolicy{
+			TargetRatio: resource.MustParse("4"),
+			MinReplicas: 5,
+			MaxReplicas: 100,
+		}
+	}
+
+	t.Run("target ratio", func(t *testing.T) {
+		_, f := defaultFixtures()
+		r := ratio()
+		h := newRatioHistory()
+
+		f.Status.AllocatedReplicas = 40
+		replicas, limited, err := applyRatioPolicy(r, f, h, "default/fas", now)
+		assert.Nil(t, err)
+		assert.Equal(t, int32(50), replicas)
+		assert.False(t, limited)
+
+		// 1 Ready replica for 3 Allocated ones, rounded up
+		r.TargetRatio = resource.MustParse("3")
+		f.Status.AllocatedReplicas = 7
+		replicas, limited, err = applyRatioPolicy(r, f, h, "default/fas", now)
+		assert.Nil(t, err)
+		assert.Equal(t, int32(10), replicas)
+		assert.False(t, limited)
+
+		// 2 Ready replicas for each Allocated one
+		r.TargetRatio = resource.MustParse("0.5")
+		f.Status.AllocatedReplicas = 20
+		replicas, limited, err = applyRatioPolicy(r, f, h, "default/fas", now)
+		assert.Nil(t, err)
+		assert.Equal(t, int32(60), replicas)
+		assert.False(t, limited)
+	})
+
+	t.Run("limited", func(t *testing.T) {
+		_, f := defaultFixtures()
+		r := ratio()
+		h := newRatioHistory()
+
+		f.Status.AllocatedReplicas = 0
+		replicas, limited, err := applyRatioPolicy(r, f, h, "default/fas", now)
+		assert.Nil(t, err)
+		assert.Equal(t, int32(5), replicas)
+		assert.True(t, limited)
+
+		f.Status.AllocatedReplicas = 90
+		replicas, limited, err = applyRatioPolicy(r, f, h, "default/fas", now)
+		assert.Nil(t, err)
+		assert.Equal(t, int32(100), replicas)
+		assert.True(t, limited)
+	})
+
+	t.Run("averaged over the window", func(t *testing.T) {
+		_, f := defaultFixtures()
+		r := ratio()
+		r.Window = metav1.Duration{Duration: time.Minute}
+		h := newRatioHistory()
+
+		f.Status.AllocatedReplicas = 20
+		replicas, _, err := applyRatioPolicy(r, f, h, "default/fas", now)
+		assert.Nil(t, err)
+		assert.Equal(t, int32(25), replicas)
+
+		f.Status.AllocatedReplicas = 60
+		replicas, _, err = applyRatioPolicy(r, f, h, "default/fas", now.Add(30*time.Second))
+		assert.Nil(t, err)
+		assert.Equal(t, int32(50), replicas)
+
+		// the first sample is out of the window
+		f.Status.AllocatedReplicas = 60
+		replicas, _, err = applyRatioPolicy(r, f, h, "default/fas", now.Add(61*time.Second))
+		assert.Nil(t, err)
+		assert.Equal(t, int32(75), replicas)
+
+		// other FleetAutoscalers have their own samples
+		replicas, _, err = applyRatioPolicy(r, f, h, "default/other", now.Add(61*time.Second))
+		assert.Nil(t, err)
+		assert.Equal(t, int32(75), replicas)
+	})
+
+	t.Run("stabilization", func(t *testing.T) {
+		_, f := defaultFixtures()
+		r := ratio()
+		r.ScaleUpStabilization = metav1.Duration{Duration: time.Minute}
+		r.ScaleDownStabilization = metav1.Duration{Duration: 5 * time.Minute}
+		h := newRatioHistory()
+
+		f.Spec.Replicas = 50
+		f.Status.AllocatedReplicas = 40
+		replicas, _, err := applyRatioPolicy(r, f, h, "default/fas", now)
+		assert.Nil(t, err)
+		assert.Equal(t, int32(50), replicas)
+
+		// a spike does not scale up until it lasts for the scale up window
+		f.Status.AllocatedReplicas = 60
+		replicas, _, err = applyRatioPolicy(r, f, h, "default/fas", now.Add(30*time.Second))
+		assert.Nil(t, err)
+		assert.Equal(t, int32(50), replicas)
+		replicas, _, err = applyRatioPolicy(r, f, h, "default/fas", now.Add(61*time.Second))
+		assert.Nil(t, err)
+		assert.Equal(t, int32(75), replicas)
+		f.Spec.Replicas = 75
+
+		// does not scale down until the scale down window has passed
+		f.Status.AllocatedReplicas = 20
+		replicas, _, err = applyRatioPolicy(r, f, h, "default/fas", now.Add(2*time.Minute))
+		assert.Nil(t, err)
+		assert.Equal(t, int32(75), replicas)
+		replicas, _, err = applyRatioPolicy(r, f, h, "default/fas", now.Add(6*time.Minute))
+		assert.Nil(t, err)
+		assert.Equal(t, int32(75), replicas)
+		replicas, _, err = applyRatioPolicy(r, f, h, "default/fas", now.Add(7*time.Minute))
+		assert.Nil(t, err)
+		assert.Equal(t, int32(25), replicas)
+
+		h.forget("default/fas")
+		assert.Empty(t, h.samples)
+	})
+
+	t.Run("missing policy", func(t *testing.T) {
+		_, f := defaultFixtures()
+		replicas, limited, err := applyRatioPolicy(nil, f, newRatioHistory(), "default/fas", now)
+		assert.NotNil(t, err)
+		assert.Equal(t, f.Status.Replicas, replicas)
+		assert.False(t, limited)
+	})
+}
@@ -25,6 +25,7 @@ import (
 	getterv1 "agones.dev/agones/pkg/client/clientset/versioned/typed/agones/v1"
 	"agones.dev/agones/pkg/client/informers/externalversions"
 	listerv1 "agones.dev/agones/pkg/client/listers/agones/v1"
+	"agones.dev/agones/pkg/gameservers"
 	"agones.dev/agones/pkg/util/crd"
 	"agones.dev/agones/pkg/util/logfields"
 	"agones.dev/agones/pkg/util/runtime"
@@ -37,14 +38,19 @@ import (
 	admv1beta1 "k8s.io/api/admission/v1beta1"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	extclientset "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
 	"k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/typed/apiextensions/v1beta1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/scheme"
 	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	typednetworkingv1 "k8s.io/client-go/kubernetes/typed/networking/v1"
+	corelisterv1 "k8s.io/client-go/listers/core/v1"
+	networkinglisterv1 "k8s.io/client-go/listers/networking/v1"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
 )
@@ -53,21 +59,38 @@ import (
 type Controller struct {
 	baseLogger          *logrus.Entry
 	crdGetter           v1beta1.CustomResourceDefinitionInterface
+	gameServerGetter    getterv1.GameServersGetter
+	gameServerLister    listerv1.GameServerLister
+	gameServerSynced    cache.InformerSynced
 	gameServerSetGetter getterv1.GameServerSetsGetter
 	gameServerSetLister listerv1.GameServerSetLister
 	gameServerSetSynced cache.InformerSynced
 	fleetGetter         getterv1.FleetsGetter
 	fleetLister         listerv1.FleetLister
 	fleetSynced         cache.InformerSynced
+	namespaceLister     corelisterv1.NamespaceLister
+	namespaceSynced     cache.InformerSynced
+	networkPolicyGetter typednetworkingv1.NetworkPoliciesGetter
+	networkPolicyLister networkinglisterv1.NetworkPolicyLister
+	networkPolicySynced cache.InformerSynced
 	workerqueue         *workerqueue.WorkerQueue
 	recorder            record.EventRecorder
+	defaults            Defaults
+	networkPolicies     bool
+	portRange           func() agonesv1.PortRange
 }
 
-// NewController returns a new fleets crd controller
+// NewController returns a new fleets crd controller.
+// If networkPolicies is true, a NetworkPolicy is created for each Fleet.
+// portRange returns the range of ports allocated to Dynamic and Passthrough ports, that Static ports can't use.
 func NewController(
 	wh *webhooks.WebHook,
 	health healthcheck.Handler,
+	defaults Defaults,
+	networkPolicies bool,
+	portRange func() agonesv1.PortRange,
 	kubeClient kubernetes.Interface,
+	kubeInformerFactory informers.SharedInformerFactory,
 	extClient extclientset.Interface,
 	agonesClient versioned.Interface,
 	agonesInformerFactory externalversions.SharedInformerFactory) *Controller {
@@ -78,19 +101,47 @@ func NewController(
 	fleets := agonesInformerFactory.Agones().V1().Fleets()
 	fInformer := fleets.Informer()
 
+	gameServers := agonesInformerFactory.Agones().V1().GameServers()
+
 	c := &Controller{
 		crdGetter:           extClient.ApiextensionsV1beta1().CustomResourceDefinitions(),
+		gameServerGetter:    agonesClient.AgonesV1(),
+		gameServerLister:    gameServers.Lister(),
+		gameServerSynced:    gameServers.Informer().HasSynced,
 		gameServerSetGetter: agonesClient.AgonesV1(),
 		gameServerSetLister: gameServerSets.Lister(),
 		gameServerSetSynced: gsSetInformer.HasSynced,
 		fleetGetter:         agonesClient.AgonesV1(),
 		fleetLister:         fleets.Lister(),
 		fleetSynced:         fInformer.HasSynced,
+		namespaceLister:     kubeInformerFactory.Core().V1().Namespaces().Lister(),
+		namespaceSynced:     kubeInformerFactory.Core().V1().Namespaces().Informer().HasSynced,
+		defaults:            defaults,
+		networkPolicies:     networkPolicies,
+		portRange:           portRange,
+	}
+
+	// the NetworkPolicy informer is only started if it is used, so that it doesn't require permissions otherwise
+	if networkPolicies {
+		np := kubeInformerFactory.Networking().V1().NetworkPolicies()
+		c.networkPolicyGetter = kubeClient.NetworkingV1()
+		c.networkPolicyLister = np.Lister()
+		c.networkPolicySynced = np.Informer().HasSynced
+		np.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+			UpdateFunc: func(_, newObj interface{}) {
+				c.ownerEventHandler(newObj.(*networkingv1.NetworkPolicy))
+			},
+			DeleteFunc: func(obj interface{}) {
+				if np, ok := obj.(*networkingv1.NetworkPolicy); ok {
+					c.ownerEventHandler(np)
+				}
+			},
+		})
 	}
 
 	c.baseLogger = runtime.NewLoggerWithType(c)
 	c.workerqueue = workerqueue.NewWorkerQueue(c.syncFleet, c.baseLogger, logfields.FleetKey, agones.GroupName+".FleetController")
-	health.AddLivenessCheck("fleet-workerqueue", healthcheck.Check(c.workerqueue.Healthy))
+	c.workerqueue.AddHealthChecks(health, "fleet-workerqueue")
 
 	eventBroadcaster := record.NewBroadcaster()
 	eventBroadcaster.StartLogging(c.baseLogger.Infof)
@@ -100,6 +151,8 @@ func NewController(
 	wh.AddHandler("/mutate", agonesv1.Kind("Fleet"), admv1beta1.Create, c.creationMutationHandler)
 	wh.AddHandler("/validate", agonesv1.Kind("Fleet"), admv1beta1.Create, c.creationValidationHandler)
 	wh.AddHandler("/validate", agonesv1.Kind("Fleet"), admv1beta1.Update, c.creationValidationHandler)
+	wh.AddWarningHandler("/validate", agonesv1.Kind("Fleet"), admv1beta1.Create, c.lintHandler)
+	wh.AddWarningHandler("/validate", agonesv1.Kind("Fleet"), admv1beta1.Update, c.lintHandler)
 
 	fInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc: c.workerqueue.Enqueue,
@@ -119,11 +172,15 @@ func NewController(
 		},
 	})
 
+	gameServers.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: c.gameServerEventHandler,
+	})
+
 	return c
 }
 
 // creationMutationHandler is the handler for the mutating webhook that sets the
-// the default values on the Fleet
+// the default values on the Fleet, the overrides of its namespace first, then the configured Defaults
 // Should only be called on fleet create operations.
 // nolint:dupl
 func (c *Controller) creationMutationHandler(review admv1beta1.AdmissionReview) (admv1beta1.AdmissionReview, error) {
@@ -138,6 +195,8 @@ func (c *Controller) creationMutationHandler(review admv1beta1.AdmissionReview)
 
 	// This is the main logic of this function
 	// the rest is really just json plumbing
+	gameservers.GetNamespaceDefaults(c.namespaceLister, review.Request.Namespace, c.baseLogger).ApplyToFleet(fleet)
+	c.defaults.apply(fleet, obj.Raw)
 	fleet.ApplyDefaults()
 
 	newFleet, err := json.Marshal(fleet)
@@ -176,8 +235,11 @@ func (c *Controller) creationValidationHandler(review admv1beta1.AdmissionReview
 		return review, errors.Wrapf(err, "error unmarshalling original Fleet json: %s", obj.Raw)
 	}
 
-	causes, ok := fleet.Validate()
-	if !ok {
+	causes, _ := fleet.Validate()
+	if portsChanged(review, fleet) {
+		causes = append(causes, fleet.Spec.Template.Spec.ValidateStaticPorts(c.portRange())...)
+	}
+	if len(causes) > 0 {
 		review.Response.Allowed = false
 		details := metav1.StatusDetails{
 			Name:   review.Request.Name,
@@ -199,6 +261,31 @@ func (c *Controller) creationValidationHandler(review admv1beta1.AdmissionReview
 	return review, nil
 }
 
+// portsChanged returns whether the review creates the Fleet, or updates the ports of its GameServer template,
+// so that the static ports of existing Fleets are not checked again against the current port range,
+// and they can still be scaled
+func portsChanged(review admv1beta1.AdmissionReview, fleet *agonesv1.Fleet) bool {
+	if review.Request.Operation != admv1beta1.Update {
+		return true
+	}
+	old := &agonesv1.Fleet{}
+	if err := json.Unmarshal(review.Request.OldObject.Raw, old); err != nil {
+		return true
+	}
+	return !reflect.DeepEqual(old.Spec.Template.Spec.Ports, fleet.Spec.Template.Spec.Ports)
+}
+
+// lintHandler returns warnings for Fleets with a template that is likely to cause problems,
+// without denying them, so that existing Fleets keep working
+func (c *Controller) lintHandler(review admv1beta1.AdmissionReview) []string {
+	fleet := &agonesv1.Fleet{}
+	if err := json.Unmarshal(review.Request.Object.Raw, fleet); err != nil {
+		// the validation handler denies the request
+		return nil
+	}
+	return fleet.Lint()
+}
+
 // Run the Fleet controller. Will block until stop is closed.
 // Runs threadiness number workers to process the rate limited queue
 func (c *Controller) Run(workers int, stop <-chan struct{}) error {
@@ -208,7 +295,11 @@ func (c *Controller) Run(workers int, stop <-chan struct{}) error {
 	}
 
 	c.baseLogger.Info("Wait for cache sync")
-	if !cache.WaitForCacheSync(stop, c.gameServerSetSynced, c.fleetSynced) {
+	synced := []cache.InformerSynced{c.gameServerSynced, c.gameServerSetSynced, c.fleetSynced, c.namespaceSynced}
+	if c.networkPolicies {
+		synced = append(synced, c.networkPolicySynced)
+	}
+	if !cache.WaitForCacheSync(stop, synced...) {
 		return errors.New("failed to wait for caches to sync")
 	}
 
@@ -231,19 +322,53 @@ func (c *Controller) loggerForFleet(f *agonesv1.Fleet) *logrus.Entry {
 // gameServerSetEventHandler enqueues the owning Fleet for this GameServerSet,
 // assuming that it has one
 func (c *Controller) gameServerSetEventHandler(obj interface{}) {
-	gsSet := obj.(*agonesv1.GameServerSet)
-	ref := metav1.GetControllerOf(gsSet)
-	if ref == nil {
+	c.ownerEventHandler(obj.(*agonesv1.GameServerSet))
+}
+
+// gameServerEventHandler enqueues the Fleet that owns the GameServerSet of a GameServer
+// when the GameServerTransferAnnotation is added to it, so that it is transferred straight away
+func (c *Controller) gameServerEventHandler(oldObj, newObj interface{}) {
+	oldGs := oldObj.(*agonesv1.GameServer)
+	newGs := newObj.(*agonesv1.GameServer)
+	if _, ok := oldGs.ObjectMeta.Annotations[agonesv1.GameServerTransferAnnotation]; ok {
+		return
+	}
+	if _, ok := newGs.ObjectMeta.Annotations[agonesv1.GameServerTransferAnnotation]; !ok {
 		return
 	}
 
-	fleet, err := c.fleetLister.Fleets(gsSet.ObjectMeta.Namespace).Get(ref.Name)
+	ref := metav1.GetControllerOf(newGs)
+	if ref == nil || ref.Kind != "GameServerSet" {
+		return
+	}
+
+	gsSet, err := c.gameServerSetLister.GameServerSets(newGs.ObjectMeta.Namespace).Get(ref.Name)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			c.baseLogger.WithField("ref", ref).Info("Owner GameServerSet no longer available for syncing")
+		} else {
+			runtime.HandleError(c.baseLogger.WithField("ref", ref), errors.Wrap(err, "error retrieving owner GameServerSet"))
+		}
+		return
+	}
+	c.ownerEventHandler(gsSet)
+}
+
+// ownerEventHandler enqueues the owning Fleet of a GameServerSet or NetworkPolicy,
+// assuming that it has one
+func (c *Controller) ownerEventHandler(obj metav1.Object) {
+	ref := metav1.GetControllerOf(obj)
+	if ref == nil || ref.Kind != "Fleet" {
+		return
+	}
+
+	fleet, err := c.fleetLister.Fleets(obj.GetNamespace()).Get(ref.Name)
 	if err != nil {
 		if k8serrors.IsNotFound(err) {
 			c.baseLogger.WithField("ref", ref).Info("Owner Fleet no longer available for syncing")
 		} else {
 			runtime.HandleError(c.loggerForFleet(fleet).WithField("ref", ref),
-				errors.Wrap(err, "error retrieving GameServerSet owner"))
+				errors.Wrap(err, "error retrieving owner Fleet"))
 		}
 		return
 	}
@@ -272,31 +397,99 @@ func (c *Controller) syncFleet(key string) error {
 		return errors.Wrapf(err, "error retrieving fleet %s from namespace %s", name, namespace)
 	}
 
+	if gameservers.NamespaceTerminating(c.namespaceLister, namespace) {
+		// the API server would reject any GameServerSet or NetworkPolicy that is created,
+		// and the namespace controller deletes the existing ones
+		c.loggerForFleetKey(key).Info("Namespace is terminating, not syncing Fleet")
+		return nil
+	}
+
 	list, err := ListGameServerSetsByFleetOwner(c.gameServerSetLister, fleet)
 	if err != nil {
 		return err
 	}
 
+	if err := c.syncNetworkPolicy(fleet, list); err != nil {
+		return err
+	}
+
+	if len(fleet.Spec.NodePools) > 0 {
+		err = c.syncNodePools(fleet, list)
+	} else {
+		err = c.syncGameServerSets(fleet, list, "")
+	}
+	if err != nil {
+		return err
+	}
+	if err := c.restartGameServers(fleet); err != nil {
+		return err
+	}
+
+	return c.updateFleetStatus(fleet)
+}
+
+// syncGameServerSets applies the fleet's deployment strategy to the list of GameServerSets,
+// creating the active GameServerSet if needed. If nodePool is not empty, the fleet is the
+// Fleet for that node pool, and the list is the node pool's GameServerSets
+func (c *Controller) syncGameServerSets(fleet *agonesv1.Fleet, list []*agonesv1.GameServerSet, nodePool string) error {
 	active, rest := c.filterGameServerSetByActive(fleet, list)
 
 	// if there isn't an active gameServerSet, create one (but don't persist yet)
 	if active == nil {
-		c.loggerForFleet(fleet).Info("could not find active GameServerSet, creating")
+		c.loggerForFleet(fleet).WithField("nodePool", nodePool).Info("could not find active GameServerSet, creating")
 		active = fleet.GameServerSet()
+		if nodePool != "" {
+			active.ObjectMeta.GenerateName = fleet.ObjectMeta.Name + "-" + nodePool + "-"
+			active.ObjectMeta.Labels[agonesv1.FleetNodePoolLabel] = nodePool
+		}
 	}
 
 	replicas, err := c.applyDeploymentStrategy(fleet, active, rest)
 	if err != nil {
 		return err
 	}
+	if active.ObjectMeta.UID != "" {
+		if err := c.transferGameServers(fleet, active, rest); err != nil {
+			return err
+		}
+	}
 	if err := c.deleteEmptyGameServerSets(fleet, rest); err != nil {
 		return err
 	}
 
-	if err := c.upsertGameServerSet(fleet, active, replicas); err != nil {
-		return err
+	return c.upsertGameServerSet(fleet, active, replicas)
+}
+
+// syncNodePools syncs the GameServerSets of each of the fleet's node pools, with the pool's
+// share of the fleet replicas. GameServerSets that don't belong to any of the current node pools
+// (e.g. from before the fleet had node pools, or from a pool that has been removed) are scaled
+// down as inactive GameServerSets of the first node pool.
+func (c *Controller) syncNodePools(fleet *agonesv1.Fleet, list []*agonesv1.GameServerSet) error {
+	pools := make(map[string][]*agonesv1.GameServerSet, len(fleet.Spec.NodePools))
+	for _, pool := range fleet.Spec.NodePools {
+		pools[pool.Name] = nil
 	}
-	return c.updateFleetStatus(fleet)
+
+	var orphans []*agonesv1.GameServerSet
+	for _, gsSet := range list {
+		name := gsSet.ObjectMeta.Labels[agonesv1.FleetNodePoolLabel]
+		if _, ok := pools[name]; ok && name != "" {
+			pools[name] = append(pools[name], gsSet)
+		} else {
+			orphans = append(orphans, gsSet)
+		}
+	}
+
+	first := fleet.Spec.NodePools[0].Name
+	pools[first] = append(pools[first], orphans...)
+
+	for i, pool := range fleet.Spec.NodePools {
+		if err := c.syncGameServerSets(fleet.NodePoolFleet(i), pools[pool.Name], pool.Name); err != nil {
+			return errors.Wrapf(err, "error syncing node pool %s", pool.Name)
+		}
+	}
+
+	return nil
 }
 
 // upsertGameServerSet if the GameServerSet is new, insert it
@@ -328,10 +521,15 @@ func (c *Controller) upsertGameServerSet(fleet *agonesv1.Fleet, active *agonesv1
 		return nil
 	}
 
-	if replicas != active.Spec.Replicas || active.Spec.Scheduling != fleet.Spec.Scheduling {
+	if replicas != active.Spec.Replicas || active.Spec.Scheduling != fleet.Spec.Scheduling ||
+		active.Spec.ScaleDownStrategy != fleet.Spec.ScaleDownStrategy || !reflect.DeepEqual(active.Spec.Naming, fleet.Spec.Naming) ||
+		!reflect.DeepEqual(active.Spec.MaxLifetime, fleet.Spec.MaxLifetime) {
 		gsSetCopy := active.DeepCopy()
 		gsSetCopy.Spec.Replicas = replicas
 		gsSetCopy.Spec.Scheduling = fleet.Spec.Scheduling
+		gsSetCopy.Spec.ScaleDownStrategy = fleet.Spec.ScaleDownStrategy
+		gsSetCopy.Spec.Naming = fleet.Spec.Naming.DeepCopy()
+		gsSetCopy.Spec.MaxLifetime = fleet.Spec.MaxLifetime.DeepCopy()
 		gsSetCopy, err := c.gameServerSetGetter.GameServerSets(fleet.ObjectMeta.Namespace).Update(gsSetCopy)
 		if err != nil {
 			return errors.Wrapf(err, "error updating replicas for gameserverset for fleet %s", fleet.ObjectMeta.Name)
@@ -23,6 +23,7 @@ import (
 
 	"agones.dev/agones/pkg/apis"
 	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
+	"agones.dev/agones/pkg/gameservers"
 	agtesting "agones.dev/agones/pkg/testing"
 	"agones.dev/agones/pkg/util/webhooks"
 	"github.com/heptiolabs/healthcheck"
@@ -31,6 +32,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	admv1beta1 "k8s.io/api/admission/v1beta1"
 	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -71,6 +73,31 @@ func TestControllerSyncFleet(t *testing.T) {
 		agtesting.AssertEventContains(t, m.FakeRecorder.Events, "CreatingGameServerSet")
 	})
 
+	t.Run("terminating namespace, don't create gameserverset", func(t *testing.T) {
+		f := defaultFixture()
+		c, m := newFakeController()
+
+		m.KubeClient.AddReactor("list", "namespaces", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			ns := corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: f.ObjectMeta.Namespace},
+				Status: corev1.NamespaceStatus{Phase: corev1.NamespaceTerminating}}
+			return true, &corev1.NamespaceList{Items: []corev1.Namespace{ns}}, nil
+		})
+		m.AgonesClient.AddReactor("list", "fleets", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			return true, &agonesv1.FleetList{Items: []agonesv1.Fleet{*f}}, nil
+		})
+		m.AgonesClient.AddReactor("create", "gameserversets", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			assert.FailNow(t, "gameserverset should not be created")
+			return true, nil, nil
+		})
+
+		_, cancel := agtesting.StartInformers(m, c.fleetSynced, c.namespaceSynced)
+		defer cancel()
+
+		err := c.syncFleet("default/fleet-1")
+		assert.Nil(t, err)
+		agtesting.AssertNoEvent(t, m.FakeRecorder.Events)
+	})
+
 	t.Run("gamserverset with the same number of replicas", func(t *testing.T) {
 		t.Parallel()
 		f := defaultFixture()
@@ -244,6 +271,102 @@ func TestControllerSyncFleet(t *testing.T) {
 	})
 }
 
+func TestControllerSyncFleetNodePools(t *testing.T) {
+	t.Parallel()
+
+	nodePools := []agonesv1.FleetNodePool{
+		{Name: "reserved", Weight: 70, NodeSelector: map[string]string{"pool": "reserved"}},
+		{Name: "spot", Weight: 30, NodeSelector: map[string]string{"pool": "spot"}},
+	}
+
+	t.Run("no gameserversets, create one per pool", func(t *testing.T) {
+		f := defaultFixture()
+		f.Spec.Replicas = 10
+		f.Spec.NodePools = nodePools
+		c, m := newFakeController()
+
+		m.AgonesClient.AddReactor("list", "fleets", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			return true, &agonesv1.FleetList{Items: []agonesv1.Fleet{*f}}, nil
+		})
+
+		created := map[string]*agonesv1.GameServerSet{}
+		m.AgonesClient.AddReactor("create", "gameserversets", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			ca := action.(k8stesting.CreateAction)
+			gsSet := ca.GetObject().(*agonesv1.GameServerSet)
+
+			assert.True(t, metav1.IsControlledBy(gsSet, f))
+			created[gsSet.ObjectMeta.Labels[agonesv1.FleetNodePoolLabel]] = gsSet
+
+			return true, gsSet, nil
+		})
+
+		_, cancel := agtesting.StartInformers(m, c.fleetSynced)
+		defer cancel()
+
+		err := c.syncFleet("default/fleet-1")
+		assert.Nil(t, err)
+		if assert.Len(t, created, 2) {
+			assert.Equal(t, int32(7), created["reserved"].Spec.Replicas)
+			assert.Equal(t, "fleet-1-reserved-", created["reserved"].ObjectMeta.GenerateName)
+			assert.Equal(t, map[string]string{"pool": "reserved"}, created["reserved"].Spec.Template.Spec.Template.Spec.NodeSelector)
+			assert.Equal(t, int32(3), created["spot"].Spec.Replicas)
+			assert.Equal(t, "fleet-1-spot-", created["spot"].ObjectMeta.GenerateName)
+			assert.Equal(t, map[string]string{"pool": "spot"}, created["spot"].Spec.Template.Spec.Template.Spec.NodeSelector)
+		}
+		assert.Nil(t, f.Spec.Template.Spec.Template.Spec.NodeSelector)
+	})
+
+	t.Run("gameserverset from before node pools is scaled down", func(t *testing.T) {
+		f := defaultFixture()
+		f.Spec.Replicas = 10
+		f.Spec.Strategy.Type = appsv1.RecreateDeploymentStrategyType
+		c, m := newFakeController()
+
+		gsSet := f.GameServerSet()
+		gsSet.ObjectMeta.Name = "gsSet1"
+		gsSet.ObjectMeta.UID = "4321"
+		gsSet.Spec.Replicas = 10
+		gsSet.Status.Replicas = 10
+		f.Spec.NodePools = nodePools
+
+		m.AgonesClient.AddReactor("list", "fleets", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			return true, &agonesv1.FleetList{Items: []agonesv1.Fleet{*f}}, nil
+		})
+
+		m.AgonesClient.AddReactor("list", "gameserversets", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			return true, &agonesv1.GameServerSetList{Items: []agonesv1.GameServerSet{*gsSet}}, nil
+		})
+
+		created := 0
+		m.AgonesClient.AddReactor("create", "gameserversets", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			created++
+			return true, action.(k8stesting.CreateAction).GetObject(), nil
+		})
+
+		scaledDown := false
+		m.AgonesClient.AddReactor("update", "gameserversets", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			ua := action.(k8stesting.UpdateAction)
+			if ua.GetSubresource() != "" {
+				return true, nil, nil
+			}
+			updated := ua.GetObject().(*agonesv1.GameServerSet)
+			if updated.ObjectMeta.Name == "gsSet1" {
+				scaledDown = true
+				assert.Equal(t, int32(0), updated.Spec.Replicas)
+			}
+			return true, updated, nil
+		})
+
+		_, cancel := agtesting.StartInformers(m, c.fleetSynced, c.gameServerSetSynced)
+		defer cancel()
+
+		err := c.syncFleet("default/fleet-1")
+		assert.Nil(t, err)
+		assert.True(t, scaledDown, "gameserverset without a node pool should be scaled down")
+		assert.Equal(t, 2, created)
+	})
+}
+
 func TestControllerCreationMutationHandler(t *testing.T) {
 	t.Parallel()
 
@@ -288,6 +411,193 @@ func TestControllerCreationMutationHandler(t *testing.T) {
 	assertContains(patch, jsonpatch.JsonPatchOperation{Operation: "add", Path: "/spec/strategy/type", Value: "RollingUpdate"})
 }
 
+func TestControllerCreationMutationHandlerDefaults(t *testing.T) {
+	t.Parallel()
+
+	c, _ := newFakeController()
+	c.defaults = Defaults{
+		Replicas:       3,
+		Scheduling:     apis.Distributed,
+		StrategyType:   appsv1.RollingUpdateDeploymentStrategyType,
+		MaxSurge:       intstr.FromInt(5),
+		MaxUnavailable: intstr.FromString("10%"),
+	}
+	gvk := metav1.GroupVersionKind(agonesv1.SchemeGroupVersion.WithKind("Fleet"))
+
+	review := admv1beta1.AdmissionReview{
+		Request: &admv1beta1.AdmissionRequest{
+			Kind:      gvk,
+			Operation: admv1beta1.Create,
+			Object: runtime.RawExtension{
+				Raw: []byte(`{"metadata":{"name":"fleet"},"spec":{}}`),
+			},
+		},
+		Response: &admv1beta1.AdmissionResponse{Allowed: true},
+	}
+
+	result, err := c.creationMutationHandler(review)
+	assert.Nil(t, err)
+	assert.True(t, result.Response.Allowed)
+
+	patch := jsonpatch.ByPath{}
+	err = json.Unmarshal(result.Response.Patch, &patch)
+	assert.Nil(t, err)
+
+	ops := map[string]interface{}{}
+	for _, p := range patch {
+		ops[p.Path] = p.Value
+	}
+	assert.Equal(t, float64(3), ops["/spec/replicas"])
+	assert.Equal(t, "Distributed", ops["/spec/scheduling"])
+	assert.Equal(t, map[string]interface{}{
+		"type":          "RollingUpdate",
+		"rollingUpdate": map[string]interface{}{"maxSurge": float64(5), "maxUnavailable": "10%"},
+	}, ops["/spec/strategy"])
+}
+
+func TestControllerCreationMutationHandlerNamespaceDefaults(t *testing.T) {
+	t.Parallel()
+
+	c, m := newFakeController()
+	c.defaults = Defaults{
+		Scheduling:     apis.Packed,
+		StrategyType:   appsv1.RollingUpdateDeploymentStrategyType,
+		MaxSurge:       intstr.FromString("25%"),
+		MaxUnavailable: intstr.FromString("25%"),
+	}
+	m.KubeClient.AddReactor("list", "namespaces", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		ns := corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "dev",
+			Annotations: map[string]string{gameservers.NamespaceSchedulingAnnotation: "Distributed"}}}
+		return true, &corev1.NamespaceList{Items: []corev1.Namespace{ns}}, nil
+	})
+	_, cancel := agtesting.StartInformers(m, c.namespaceSynced)
+	defer cancel()
+
+	gvk := metav1.GroupVersionKind(agonesv1.SchemeGroupVersion.WithKind("Fleet"))
+	review := func(namespace string) admv1beta1.AdmissionReview {
+		return admv1beta1.AdmissionReview{
+			Request: &admv1beta1.AdmissionRequest{
+				Kind:      gvk,
+				Namespace: namespace,
+				Operation: admv1beta1.Create,
+				Object: runtime.RawExtension{
+					Raw: []byte(`{"metadata":{"name":"fleet","namespace":"` + namespace + `"},"spec":{}}`),
+				},
+			},
+			Response: &admv1beta1.AdmissionResponse{Allowed: true},
+		}
+	}
+
+	scheduling := func(namespace string) interface{} {
+		result, err := c.creationMutationHandler(review(namespace))
+		assert.Nil(t, err)
+		patch := jsonpatch.ByPath{}
+		assert.Nil(t, json.Unmarshal(result.Response.Patch, &patch))
+		for _, p := range patch {
+			if p.Path == "/spec/scheduling" {
+				return p.Value
+			}
+		}
+		return nil
+	}
+
+	// the namespace overrides the defaults of the controller
+	assert.Equal(t, "Distributed", scheduling("dev"))
+	assert.Equal(t, "Packed", scheduling("default"))
+}
+
+func TestControllerCreationValidationHandler(t *testing.T) {
+	t.Parallel()
+
+	c, _ := newFakeController()
+	gvk := metav1.GroupVersionKind(agonesv1.SchemeGroupVersion.WithKind("Fleet"))
+
+	review := func(hostPort int32) admv1beta1.AdmissionReview {
+		fixture := defaultFixture()
+		fixture.Spec.Template.Spec.Ports = []agonesv1.GameServerPort{
+			{Name: "game", PortPolicy: agonesv1.Static, ContainerPort: 7777, HostPort: hostPort, Protocol: corev1.ProtocolUDP},
+		}
+		fixture.Spec.Template.Spec.Template.Spec.Containers = []corev1.Container{{Name: "container", Image: "container/image"}}
+
+		raw, err := json.Marshal(fixture)
+		assert.Nil(t, err)
+		return admv1beta1.AdmissionReview{
+			Request: &admv1beta1.AdmissionRequest{
+				Kind:      gvk,
+				Operation: admv1beta1.Create,
+				Object: runtime.RawExtension{
+					Raw: raw,
+				},
+			},
+			Response: &admv1beta1.AdmissionResponse{Allowed: true},
+		}
+	}
+
+	t.Run("static port outside of the allocatable ports", func(t *testing.T) {
+		result, err := c.creationValidationHandler(review(7777))
+		assert.Nil(t, err)
+		assert.True(t, result.Response.Allowed)
+	})
+
+	t.Run("static port within the allocatable ports", func(t *testing.T) {
+		result, err := c.creationValidationHandler(review(15))
+		assert.Nil(t, err)
+		assert.False(t, result.Response.Allowed)
+		if assert.Len(t, result.Response.Result.Details.Causes, 1) {
+			assert.Equal(t, "game.hostPort", result.Response.Result.Details.Causes[0].Field)
+		}
+	})
+
+	t.Run("update of a fleet with a static port within the allocatable ports", func(t *testing.T) {
+		r := review(15)
+		r.Request.Operation = admv1beta1.Update
+		r.Request.OldObject = r.Request.Object
+		result, err := c.creationValidationHandler(r)
+		assert.Nil(t, err)
+		assert.True(t, result.Response.Allowed)
+	})
+
+	t.Run("update of the static port within the allocatable ports", func(t *testing.T) {
+		r := review(15)
+		r.Request.Operation = admv1beta1.Update
+		r.Request.OldObject = review(7777).Request.Object
+		result, err := c.creationValidationHandler(r)
+		assert.Nil(t, err)
+		assert.False(t, result.Response.Allowed)
+	})
+}
+
+func TestControllerLintHandler(t *testing.T) {
+	t.Parallel()
+
+	c, _ := newFakeController()
+	gvk := metav1.GroupVersionKind(agonesv1.SchemeGroupVersion.WithKind("Fleet"))
+
+	fixture := defaultFixture()
+	fixture.Spec.Template.Spec.Health.Disabled = true
+
+	raw, err := json.Marshal(fixture)
+	assert.Nil(t, err)
+	review := admv1beta1.AdmissionReview{
+		Request: &admv1beta1.AdmissionRequest{
+			Kind:      gvk,
+			Operation: admv1beta1.Create,
+			Object: runtime.RawExtension{
+				Raw: raw,
+			},
+		},
+		Response: &admv1beta1.AdmissionResponse{Allowed: true},
+	}
+
+	warnings := c.lintHandler(review)
+	if assert.Len(t, warnings, 1) {
+		assert.Contains(t, warnings[0], "health checking is disabled")
+	}
+
+	review.Request.Object.Raw = []byte("{")
+	assert.Empty(t, c.lintHandler(review))
+}
+
 func TestControllerRun(t *testing.T) {
 	t.Parallel()
 
@@ -306,6 +616,9 @@ func TestControllerRun(t *testing.T) {
 	gsSetWatch := watch.NewFake()
 	m.AgonesClient.AddWatchReactor("gameserversets", k8stesting.DefaultWatchReactor(gsSetWatch, nil))
 
+	gsWatch := watch.NewFake()
+	m.AgonesClient.AddWatchReactor("gameservers", k8stesting.DefaultWatchReactor(gsWatch, nil))
+
 	c.workerqueue.SyncHandler = func(name string) error {
 		received <- name
 		return nil
@@ -352,6 +665,23 @@ func TestControllerRun(t *testing.T) {
 	gsSet.Spec.Replicas += 10
 	gsSetWatch.Modify(gsSet)
 	assert.Equal(t, expected, f())
+
+	// test adding the transfer annotation to a gameserver
+	gs := gsSet.GameServer()
+	gs.ObjectMeta.Name = "gs1-1"
+	gsWatch.Add(gs.DeepCopy())
+	gs.ObjectMeta.Annotations = map[string]string{agonesv1.GameServerTransferAnnotation: "true"}
+	gsWatch.Modify(gs.DeepCopy())
+	assert.Equal(t, expected, f())
+
+	// further updates of an annotated gameserver are ignored
+	gs.Status.State = agonesv1.GameServerStateAllocated
+	gsWatch.Modify(gs.DeepCopy())
+	select {
+	case result := <-received:
+		assert.FailNow(t, "unexpected sync", result)
+	case <-time.After(time.Second):
+	}
 }
 
 func TestControllerUpdateFleetStatus(t *testing.T) {
@@ -615,6 +945,29 @@ func TestControllerUpsertGameServerSet(t *testing.T) {
 		assert.Nil(t, err)
 		agtesting.AssertNoEvent(t, m.FakeRecorder.Events)
 	})
+
+	t.Run("update max lifetime", func(t *testing.T) {
+		t.Parallel()
+
+		c, m := newFakeController()
+		gsSet := f.GameServerSet()
+		gsSet.ObjectMeta.UID = "1234"
+		gsSet.Spec.Replicas = replicas
+		fleet := f.DeepCopy()
+		fleet.Spec.MaxLifetime = &metav1.Duration{Duration: time.Hour}
+		update := false
+
+		m.AgonesClient.AddReactor("update", "gameserversets", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			update = true
+			gsSet := action.(k8stesting.UpdateAction).GetObject().(*agonesv1.GameServerSet)
+			assert.Equal(t, fleet.Spec.MaxLifetime, gsSet.Spec.MaxLifetime)
+			return true, gsSet, nil
+		})
+
+		err := c.upsertGameServerSet(fleet, gsSet, replicas)
+		assert.Nil(t, err)
+		assert.True(t, update, "Should be update")
+	})
 }
 
 func TestControllerDeleteEmptyGameServerSets(t *testing.T) {
@@ -784,7 +1137,7 @@ func TestControllerRollingUpdateDeployment(t *testing.T) {
 func newFakeController() (*Controller, agtesting.Mocks) {
 	m := agtesting.NewMocks()
 	wh := webhooks.NewWebHook(http.NewServeMux())
-	c := NewController(wh, healthcheck.NewHandler(), m.KubeClient, m.ExtClient, m.AgonesClient, m.AgonesInformerFactory)
+	c := NewController(wh, healthcheck.NewHandler(), Defaults{}, false, func() agonesv1.PortRange { return agonesv1.PortRange{MinPort: 10, MaxPort: 20} }, m.KubeClient, m.KubeInformerFactory, m.ExtClient, m.AgonesClient, m.AgonesInformerFactory)
 	c.recorder = m.FakeRecorder
 	return c, m
 }
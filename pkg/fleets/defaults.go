@@ -0,0 +1,116 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fleets
+
+import (
+	"encoding/json"
+
+	"agones.dev/agones/pkg/apis"
+	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// Defaults are the values, configured on the controller, that are set on the
+// Fleets that do not specify them when they are created
+type Defaults struct {
+	// Replicas is the number of replicas of a Fleet that does not set them
+	Replicas int32
+	// Scheduling is the scheduling strategy of a Fleet
+	Scheduling apis.SchedulingStrategy
+	// StrategyType is the type of the update strategy of a Fleet
+	StrategyType appsv1.DeploymentStrategyType
+	// MaxSurge is the rolling update max surge of a Fleet
+	MaxSurge intstr.IntOrString
+	// MaxUnavailable is the rolling update max unavailable of a Fleet
+	MaxUnavailable intstr.IntOrString
+}
+
+// Validate returns an error if the Defaults would make the Fleets they are applied to invalid
+func (d Defaults) Validate() error {
+	if d.Replicas < 0 {
+		return errors.New("fleet default replicas cannot be negative")
+	}
+	if d.Scheduling != apis.Packed && d.Scheduling != apis.Distributed {
+		return errors.Errorf("fleet default scheduling must be %s or %s, was %q", apis.Packed, apis.Distributed, d.Scheduling)
+	}
+	if d.StrategyType != appsv1.RollingUpdateDeploymentStrategyType && d.StrategyType != appsv1.RecreateDeploymentStrategyType {
+		return errors.Errorf("fleet default strategy must be %s or %s, was %q",
+			appsv1.RollingUpdateDeploymentStrategyType, appsv1.RecreateDeploymentStrategyType, d.StrategyType)
+	}
+
+	if err := validateRollingUpdateDefault("max surge", d.MaxSurge); err != nil {
+		return err
+	}
+	return validateRollingUpdateDefault("max unavailable", d.MaxUnavailable)
+}
+
+// validateRollingUpdateDefault returns an error if the rolling update value would not pass
+// the validation of a Fleet, which is a percentage between 1% and 99%, or an integer over 0
+func validateRollingUpdateDefault(name string, value intstr.IntOrString) error {
+	r, err := intstr.GetValueFromIntOrPercent(&value, 100, true)
+	if value.Type == intstr.String {
+		if err != nil || r < 1 || r > 99 {
+			return errors.Errorf("fleet default %s must be a percentage between 1%% and 99%%, was %q", name, value.String())
+		}
+		return nil
+	}
+	if r < 1 {
+		return errors.Errorf("fleet default %s must be greater than 0, was %d", name, r)
+	}
+	return nil
+}
+
+// apply sets the Defaults on the fields the Fleet does not set, before Fleet.ApplyDefaults
+// sets any that are left. raw is the json the Fleet was created with, so that replicas that
+// are not set can be told apart from replicas set to 0.
+func (d Defaults) apply(fleet *agonesv1.Fleet, raw []byte) {
+	if d.Replicas != 0 && !hasReplicas(raw) {
+		fleet.Spec.Replicas = d.Replicas
+	}
+	if fleet.Spec.Scheduling == "" {
+		fleet.Spec.Scheduling = d.Scheduling
+	}
+	if fleet.Spec.Strategy.Type == "" {
+		fleet.Spec.Strategy.Type = d.StrategyType
+	}
+	if fleet.Spec.Strategy.Type == appsv1.RollingUpdateDeploymentStrategyType {
+		if fleet.Spec.Strategy.RollingUpdate == nil {
+			fleet.Spec.Strategy.RollingUpdate = &appsv1.RollingUpdateDeployment{}
+		}
+		if fleet.Spec.Strategy.RollingUpdate.MaxSurge == nil {
+			maxSurge := d.MaxSurge
+			fleet.Spec.Strategy.RollingUpdate.MaxSurge = &maxSurge
+		}
+		if fleet.Spec.Strategy.RollingUpdate.MaxUnavailable == nil {
+			maxUnavailable := d.MaxUnavailable
+			fleet.Spec.Strategy.RollingUpdate.MaxUnavailable = &maxUnavailable
+		}
+	}
+}
+
+// hasReplicas returns true if the Fleet json sets its replicas
+func hasReplicas(raw []byte) bool {
+	var fleet struct {
+		Spec struct {
+			Replicas *int32 `json:"replicas"`
+		} `json:"spec"`
+	}
+	if err := json.Unmarshal(raw, &fleet); err != nil {
+		return true
+	}
+	return fleet.Spec.Replicas != nil
+}
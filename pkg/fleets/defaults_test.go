@@ -0,0 +1,115 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fleets
+
+import (
+	"testing"
+
+	"agones.dev/agones/pkg/apis"
+	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestDefaultsValidate(t *testing.T) {
+	t.Parallel()
+
+	valid := Defaults{
+		Scheduling:     apis.Packed,
+		StrategyType:   appsv1.RollingUpdateDeploymentStrategyType,
+		MaxSurge:       intstr.FromString("25%"),
+		MaxUnavailable: intstr.FromInt(1),
+	}
+	assert.Nil(t, valid.Validate())
+
+	fixtures := map[string]struct {
+		update func(d *Defaults)
+		err    string
+	}{
+		"negative replicas": {
+			update: func(d *Defaults) { d.Replicas = -1 },
+			err:    "fleet default replicas cannot be negative",
+		},
+		"unknown scheduling": {
+			update: func(d *Defaults) { d.Scheduling = "Random" },
+			err:    `fleet default scheduling must be Packed or Distributed, was "Random"`,
+		},
+		"unknown strategy": {
+			update: func(d *Defaults) { d.StrategyType = "" },
+			err:    `fleet default strategy must be RollingUpdate or Recreate, was ""`,
+		},
+		"percentage out of range": {
+			update: func(d *Defaults) { d.MaxSurge = intstr.FromString("100%") },
+			err:    `fleet default max surge must be a percentage between 1% and 99%, was "100%"`,
+		},
+		"zero integer": {
+			update: func(d *Defaults) { d.MaxUnavailable = intstr.FromInt(0) },
+			err:    "fleet default max unavailable must be greater than 0, was 0",
+		},
+	}
+
+	for k, v := range fixtures {
+		t.Run(k, func(t *testing.T) {
+			d := valid
+			v.update(&d)
+			assert.EqualError(t, d.Validate(), v.err)
+		})
+	}
+}
+
+func TestDefaultsApply(t *testing.T) {
+	t.Parallel()
+
+	d := Defaults{
+		Replicas:       3,
+		Scheduling:     apis.Distributed,
+		StrategyType:   appsv1.RollingUpdateDeploymentStrategyType,
+		MaxSurge:       intstr.FromInt(5),
+		MaxUnavailable: intstr.FromString("10%"),
+	}
+
+	t.Run("nothing set", func(t *testing.T) {
+		f := &agonesv1.Fleet{}
+		d.apply(f, []byte(`{"spec":{}}`))
+		assert.Equal(t, int32(3), f.Spec.Replicas)
+		assert.Equal(t, apis.Distributed, f.Spec.Scheduling)
+		assert.Equal(t, appsv1.RollingUpdateDeploymentStrategyType, f.Spec.Strategy.Type)
+		assert.Equal(t, intstr.FromInt(5), *f.Spec.Strategy.RollingUpdate.MaxSurge)
+		assert.Equal(t, intstr.FromString("10%"), *f.Spec.Strategy.RollingUpdate.MaxUnavailable)
+	})
+
+	t.Run("values set on the fleet are kept", func(t *testing.T) {
+		maxSurge := intstr.FromString("50%")
+		f := &agonesv1.Fleet{}
+		f.Spec.Scheduling = apis.Packed
+		f.Spec.Strategy.Type = appsv1.RollingUpdateDeploymentStrategyType
+		f.Spec.Strategy.RollingUpdate = &appsv1.RollingUpdateDeployment{MaxSurge: &maxSurge}
+		d.apply(f, []byte(`{"spec":{"replicas":0}}`))
+		assert.Equal(t, int32(0), f.Spec.Replicas)
+		assert.Equal(t, apis.Packed, f.Spec.Scheduling)
+		assert.Equal(t, intstr.FromString("50%"), *f.Spec.Strategy.RollingUpdate.MaxSurge)
+		assert.Equal(t, intstr.FromString("10%"), *f.Spec.Strategy.RollingUpdate.MaxUnavailable)
+	})
+
+	t.Run("recreate", func(t *testing.T) {
+		d := d
+		d.StrategyType = appsv1.RecreateDeploymentStrategyType
+		f := &agonesv1.Fleet{}
+		d.apply(f, []byte(`{"spec":{}}`))
+		assert.Equal(t, appsv1.RecreateDeploymentStrategyType, f.Spec.Strategy.Type)
+		assert.Nil(t, f.Spec.Strategy.RollingUpdate)
+	})
+}
@@ -0,0 +1,191 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fleets
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
+	"agones.dev/agones/pkg/client/informers/externalversions"
+	listerv1 "agones.dev/agones/pkg/client/listers/agones/v1"
+	"agones.dev/agones/pkg/util/runtime"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+)
+
+// fleetEventCounts are the counts of the GameServer events of a Fleet
+type fleetEventCounts struct {
+	created int
+	ready   int
+	deleted int
+	failed  int
+}
+
+// EventSummaryController counts the creation, readiness, deletion and failures of
+// the GameServers of each Fleet, and periodically records a single summary event
+// on the Fleet, rather than one event per GameServer.
+type EventSummaryController struct {
+	baseLogger       *logrus.Entry
+	period           time.Duration
+	started          time.Time
+	fleetLister      listerv1.FleetLister
+	fleetSynced      cache.InformerSynced
+	gameServerSynced cache.InformerSynced
+	recorder         record.EventRecorder
+	lock             sync.Mutex
+	// counts of GameServer events, by Fleet key
+	counts map[string]*fleetEventCounts
+}
+
+// NewEventSummaryController returns an EventSummaryController that records a summary event
+// on each Fleet with GameServer activity every period
+func NewEventSummaryController(
+	period time.Duration,
+	kubeClient kubernetes.Interface,
+	agonesInformerFactory externalversions.SharedInformerFactory) *EventSummaryController {
+
+	fleets := agonesInformerFactory.Agones().V1().Fleets()
+	gsInformer := agonesInformerFactory.Agones().V1().GameServers().Informer()
+
+	c := &EventSummaryController{
+		period:           period,
+		started:          time.Now(),
+		fleetLister:      fleets.Lister(),
+		fleetSynced:      fleets.Informer().HasSynced,
+		gameServerSynced: gsInformer.HasSynced,
+		counts:           map[string]*fleetEventCounts{},
+	}
+	c.baseLogger = runtime.NewLoggerWithType(c)
+
+	eventBroadcaster := record.NewBroadcaster()
+	eventBroadcaster.StartLogging(c.baseLogger.Infof)
+	eventBroadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{Interface: kubeClient.CoreV1().Events("")})
+	c.recorder = eventBroadcaster.NewRecorder(scheme.Scheme, corev1.EventSource{Component: "fleet-controller"})
+
+	gsInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			gs := obj.(*agonesv1.GameServer)
+			// the informer adds all existing GameServers when it starts, which were not just created
+			if gs.ObjectMeta.CreationTimestamp.Time.Before(c.started) {
+				return
+			}
+			c.count(gs, func(counts *fleetEventCounts) { counts.created++ })
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			oldGs := oldObj.(*agonesv1.GameServer)
+			newGs := newObj.(*agonesv1.GameServer)
+			if oldGs.Status.State == newGs.Status.State {
+				return
+			}
+			switch newGs.Status.State {
+			case agonesv1.GameServerStateReady:
+				c.count(newGs, func(counts *fleetEventCounts) { counts.ready++ })
+			case agonesv1.GameServerStateUnhealthy, agonesv1.GameServerStateError:
+				c.count(newGs, func(counts *fleetEventCounts) { counts.failed++ })
+			}
+		},
+		DeleteFunc: func(obj interface{}) {
+			gs, ok := obj.(*agonesv1.GameServer)
+			if !ok {
+				tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+				if !ok {
+					return
+				}
+				if gs, ok = tombstone.Obj.(*agonesv1.GameServer); !ok {
+					return
+				}
+			}
+			c.count(gs, func(counts *fleetEventCounts) { counts.deleted++ })
+		},
+	})
+
+	return c
+}
+
+// Run records the summary events every period.
+// Will block until stop is closed
+func (c *EventSummaryController) Run(_ int, stop <-chan struct{}) error {
+	c.baseLogger.Info("Wait for cache sync")
+	if !cache.WaitForCacheSync(stop, c.fleetSynced, c.gameServerSynced) {
+		return errors.New("failed to wait for caches to sync")
+	}
+
+	wait.Until(c.summarize, c.period, stop)
+	return nil
+}
+
+// count applies f to the event counts of the GameServer's Fleet, if it has one
+func (c *EventSummaryController) count(gs *agonesv1.GameServer, f func(counts *fleetEventCounts)) {
+	fleetName := gs.ObjectMeta.Labels[agonesv1.FleetNameLabel]
+	if fleetName == "" {
+		return
+	}
+	key := gs.ObjectMeta.Namespace + "/" + fleetName
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	counts, ok := c.counts[key]
+	if !ok {
+		counts = &fleetEventCounts{}
+		c.counts[key] = counts
+	}
+	f(counts)
+}
+
+// summarize records a summary event on each Fleet that had GameServer events
+// since the last summary, and resets the counts
+func (c *EventSummaryController) summarize() {
+	c.lock.Lock()
+	counts := c.counts
+	c.counts = map[string]*fleetEventCounts{}
+	c.lock.Unlock()
+
+	for key, fc := range counts {
+		namespace, name, err := cache.SplitMetaNamespaceKey(key)
+		if err != nil {
+			runtime.HandleError(c.baseLogger.WithField("key", key), errors.Wrap(err, "invalid resource key"))
+			continue
+		}
+		fleet, err := c.fleetLister.Fleets(namespace).Get(name)
+		if err != nil {
+			if !k8serrors.IsNotFound(err) {
+				runtime.HandleError(c.baseLogger.WithField("key", key), errors.Wrapf(err, "error retrieving Fleet %s from namespace %s", name, namespace))
+			}
+			continue
+		}
+
+		eventType := corev1.EventTypeNormal
+		if fc.failed > 0 {
+			eventType = corev1.EventTypeWarning
+		}
+		c.recorder.Event(fleet, eventType, "GameServerSummary", summaryMessage(fc, c.period))
+	}
+}
+
+// summaryMessage returns the message of the summary event for the given counts
+func summaryMessage(fc *fleetEventCounts, period time.Duration) string {
+	return fmt.Sprintf("Created %d GameServers, %d became Ready and %d were deleted in last %s, %d failures",
+		fc.created, fc.ready, fc.deleted, period, fc.failed)
+}
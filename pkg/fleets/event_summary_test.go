@@ -0,0 +1,98 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fleets
+
+import (
+	"testing"
+	"time"
+
+	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
+	agtesting "agones.dev/agones/pkg/testing"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apimachinery/pkg/watch"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestEventSummaryControllerSummarize(t *testing.T) {
+	t.Parallel()
+
+	m := agtesting.NewMocks()
+	c := NewEventSummaryController(time.Minute, m.KubeClient, m.AgonesInformerFactory)
+	c.recorder = m.FakeRecorder
+
+	f := defaultFixture()
+	m.AgonesClient.AddReactor("list", "fleets", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, &agonesv1.FleetList{Items: []agonesv1.Fleet{*f}}, nil
+	})
+	m.AgonesClient.AddWatchReactor("fleets", k8stesting.DefaultWatchReactor(watch.NewFake(), nil))
+	gsWatch := watch.NewFake()
+	m.AgonesClient.AddWatchReactor("gameservers", k8stesting.DefaultWatchReactor(gsWatch, nil))
+
+	_, cancel := agtesting.StartInformers(m, c.fleetSynced, c.gameServerSynced)
+	defer cancel()
+
+	newGameServer := func(name, fleetName string, created time.Time) *agonesv1.GameServer {
+		return &agonesv1.GameServer{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: f.ObjectMeta.Namespace,
+			CreationTimestamp: metav1.NewTime(created), Labels: map[string]string{agonesv1.FleetNameLabel: fleetName}}}
+	}
+
+	// existing before the controller started, should not be counted as created
+	existing := newGameServer("existing", f.ObjectMeta.Name, c.started.Add(-time.Hour))
+	gsWatch.Add(existing)
+	for _, name := range []string{"gs1", "gs2", "gs3"} {
+		gs := newGameServer(name, f.ObjectMeta.Name, time.Now())
+		gsWatch.Add(gs)
+		gs = gs.DeepCopy()
+		gs.Status.State = agonesv1.GameServerStateReady
+		gsWatch.Modify(gs)
+	}
+	unhealthy := existing.DeepCopy()
+	unhealthy.Status.State = agonesv1.GameServerStateUnhealthy
+	gsWatch.Modify(unhealthy)
+	gsWatch.Delete(unhealthy)
+	// not part of a fleet
+	gsWatch.Add(newGameServer("standalone", "", time.Now()))
+
+	expected := fleetEventCounts{created: 3, ready: 3, deleted: 1, failed: 1}
+	err := wait.PollImmediate(100*time.Millisecond, 10*time.Second, func() (bool, error) {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+		counts, ok := c.counts[f.ObjectMeta.Namespace+"/"+f.ObjectMeta.Name]
+		return ok && *counts == expected, nil
+	})
+	assert.NoError(t, err)
+	c.lock.Lock()
+	assert.Len(t, c.counts, 1)
+	c.lock.Unlock()
+
+	c.summarize()
+	assert.Len(t, m.FakeRecorder.Events, 1)
+	agtesting.AssertEventContains(t, m.FakeRecorder.Events,
+		"Warning GameServerSummary Created 3 GameServers, 3 became Ready and 1 were deleted in last 1m0s, 1 failures")
+
+	// nothing happened since the last summary
+	c.summarize()
+	assert.Empty(t, m.FakeRecorder.Events)
+}
+
+func TestSummaryMessage(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "Created 500 GameServers, 480 became Ready and 20 were deleted in last 1m0s, 3 failures",
+		summaryMessage(&fleetEventCounts{created: 500, ready: 480, deleted: 20, failed: 3}, time.Minute))
+}
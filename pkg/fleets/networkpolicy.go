@@ -0,0 +1,144 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fleets
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// syncNetworkPolicy creates or updates the NetworkPolicy of the Fleet, if network policies are enabled.
+// The NetworkPolicy has the name of the Fleet, and denies all the ingress traffic to the GameServer Pods
+// of the Fleet, except to the ports of the GameServers of the Fleet and of its GameServerSets,
+// so that GameServers that are left over from a rolling update keep their ports.
+func (c *Controller) syncNetworkPolicy(fleet *agonesv1.Fleet, list []*agonesv1.GameServerSet) error {
+	if !c.networkPolicies {
+		return nil
+	}
+
+	np := networkPolicy(fleet, list)
+	existing, err := c.networkPolicyLister.NetworkPolicies(fleet.ObjectMeta.Namespace).Get(np.ObjectMeta.Name)
+	if k8serrors.IsNotFound(err) {
+		if _, err := c.networkPolicyGetter.NetworkPolicies(np.ObjectMeta.Namespace).Create(np); err != nil {
+			return errors.Wrapf(err, "error creating network policy for fleet %s", fleet.ObjectMeta.Name)
+		}
+		c.recorder.Eventf(fleet, corev1.EventTypeNormal, "CreatingNetworkPolicy", "Created NetworkPolicy %s", np.ObjectMeta.Name)
+		return nil
+	}
+	if err != nil {
+		return errors.Wrapf(err, "error retrieving network policy for fleet %s", fleet.ObjectMeta.Name)
+	}
+
+	if !metav1.IsControlledBy(existing, fleet) {
+		// don't replace a NetworkPolicy the user manages
+		c.loggerForFleet(fleet).WithField("networkPolicy", existing.ObjectMeta.Name).
+			Warn("NetworkPolicy with the name of the Fleet is not owned by the Fleet, not updating it")
+		return nil
+	}
+	if reflect.DeepEqual(existing.Spec, np.Spec) {
+		return nil
+	}
+
+	npCopy := existing.DeepCopy()
+	npCopy.Spec = np.Spec
+	if _, err := c.networkPolicyGetter.NetworkPolicies(npCopy.ObjectMeta.Namespace).Update(npCopy); err != nil {
+		return errors.Wrapf(err, "error updating network policy for fleet %s", fleet.ObjectMeta.Name)
+	}
+	c.recorder.Eventf(fleet, corev1.EventTypeNormal, "UpdatingNetworkPolicy", "Updated NetworkPolicy %s", npCopy.ObjectMeta.Name)
+	return nil
+}
+
+// networkPolicy returns the NetworkPolicy of the Fleet, which only allows ingress traffic to the
+// ports of the GameServers of the Fleet and of the passed in GameServerSets. As the container port of a
+// Passthrough port is only known once its host port is allocated, all the ports of its protocol are allowed.
+// Traffic between the containers of a GameServer Pod, such as to the SDK server, is not affected.
+func networkPolicy(fleet *agonesv1.Fleet, list []*agonesv1.GameServerSet) *networkingv1.NetworkPolicy {
+	ports := map[string]networkingv1.NetworkPolicyPort{}
+	addPorts := func(gsPorts []agonesv1.GameServerPort) {
+		for _, p := range gsPorts {
+			protocols := []corev1.Protocol{p.Protocol}
+			switch p.Protocol {
+			case "":
+				protocols = []corev1.Protocol{corev1.ProtocolUDP}
+			case agonesv1.ProtocolTCPUDP:
+				protocols = []corev1.Protocol{corev1.ProtocolTCP, corev1.ProtocolUDP}
+			}
+			for _, protocol := range protocols {
+				protocol := protocol
+				npp := networkingv1.NetworkPolicyPort{Protocol: &protocol}
+				if p.PortPolicy != agonesv1.Passthrough {
+					port := intstr.FromInt(int(p.ContainerPort))
+					npp.Port = &port
+				}
+				ports[portKey(npp)] = npp
+			}
+		}
+	}
+
+	addPorts(fleet.Spec.Template.Spec.Ports)
+	for _, gsSet := range list {
+		addPorts(gsSet.Spec.Template.Spec.Ports)
+	}
+
+	keys := make([]string, 0, len(ports))
+	for k := range ports {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	np := &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fleet.ObjectMeta.Name,
+			Namespace: fleet.ObjectMeta.Namespace,
+			Labels:    map[string]string{agonesv1.FleetNameLabel: fleet.ObjectMeta.Name},
+		},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{
+				agonesv1.RoleLabel:      agonesv1.GameServerLabelRole,
+				agonesv1.FleetNameLabel: fleet.ObjectMeta.Name,
+			}},
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+		},
+	}
+	if len(keys) > 0 {
+		rule := networkingv1.NetworkPolicyIngressRule{}
+		for _, k := range keys {
+			rule.Ports = append(rule.Ports, ports[k])
+		}
+		np.Spec.Ingress = []networkingv1.NetworkPolicyIngressRule{rule}
+	}
+
+	ref := metav1.NewControllerRef(fleet, agonesv1.SchemeGroupVersion.WithKind("Fleet"))
+	np.ObjectMeta.OwnerReferences = append(np.ObjectMeta.OwnerReferences, *ref)
+
+	return np
+}
+
+// portKey returns the key of a NetworkPolicyPort, that sorts ports by protocol, then by number
+func portKey(npp networkingv1.NetworkPolicyPort) string {
+	if npp.Port == nil {
+		return string(*npp.Protocol)
+	}
+	return fmt.Sprintf("%s/%05d", *npp.Protocol, npp.Port.IntValue())
+}
@@ -0,0 +1,195 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fleets
+
+import (
+	"net/http"
+	"testing"
+
+	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
+	agtesting "agones.dev/agones/pkg/testing"
+	"agones.dev/agones/pkg/util/webhooks"
+	"github.com/heptiolabs/healthcheck"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestNetworkPolicy(t *testing.T) {
+	t.Parallel()
+
+	port := func(protocol corev1.Protocol, port int) networkingv1.NetworkPolicyPort {
+		npp := networkingv1.NetworkPolicyPort{Protocol: &protocol}
+		if port > 0 {
+			p := intstr.FromInt(port)
+			npp.Port = &p
+		}
+		return npp
+	}
+
+	t.Run("ports", func(t *testing.T) {
+		f := defaultFixture()
+		f.Spec.Template.Spec.Ports = []agonesv1.GameServerPort{
+			{Name: "game", PortPolicy: agonesv1.Dynamic, ContainerPort: 7777, Protocol: corev1.ProtocolUDP},
+			{Name: "control", PortPolicy: agonesv1.Static, ContainerPort: 80, Protocol: agonesv1.ProtocolTCPUDP},
+			{Name: "passthrough", PortPolicy: agonesv1.Passthrough, Protocol: corev1.ProtocolTCP},
+		}
+		gsSet := f.GameServerSet()
+		gsSet.Spec.Template.Spec.Ports = []agonesv1.GameServerPort{
+			{Name: "game", PortPolicy: agonesv1.Dynamic, ContainerPort: 7654, Protocol: corev1.ProtocolUDP},
+		}
+
+		np := networkPolicy(f, []*agonesv1.GameServerSet{gsSet})
+
+		assert.Equal(t, f.ObjectMeta.Name, np.ObjectMeta.Name)
+		assert.Equal(t, f.ObjectMeta.Namespace, np.ObjectMeta.Namespace)
+		assert.Equal(t, f.ObjectMeta.Name, np.ObjectMeta.Labels[agonesv1.FleetNameLabel])
+		assert.True(t, metav1.IsControlledBy(np, f))
+		assert.Equal(t, map[string]string{agonesv1.RoleLabel: agonesv1.GameServerLabelRole, agonesv1.FleetNameLabel: f.ObjectMeta.Name},
+			np.Spec.PodSelector.MatchLabels)
+		assert.Equal(t, []networkingv1.PolicyType{networkingv1.PolicyTypeIngress}, np.Spec.PolicyTypes)
+		if assert.Len(t, np.Spec.Ingress, 1) {
+			assert.Empty(t, np.Spec.Ingress[0].From)
+			assert.Equal(t, []networkingv1.NetworkPolicyPort{
+				port(corev1.ProtocolTCP, 0), port(corev1.ProtocolTCP, 80),
+				port(corev1.ProtocolUDP, 80), port(corev1.ProtocolUDP, 7654), port(corev1.ProtocolUDP, 7777),
+			}, np.Spec.Ingress[0].Ports)
+		}
+	})
+
+	t.Run("no ports", func(t *testing.T) {
+		f := defaultFixture()
+		np := networkPolicy(f, nil)
+		assert.Equal(t, []networkingv1.PolicyType{networkingv1.PolicyTypeIngress}, np.Spec.PolicyTypes)
+		assert.Empty(t, np.Spec.Ingress)
+	})
+}
+
+func TestControllerSyncNetworkPolicy(t *testing.T) {
+	t.Parallel()
+
+	newFixture := func() *agonesv1.Fleet {
+		f := defaultFixture()
+		f.Spec.Template.Spec.Ports = []agonesv1.GameServerPort{
+			{Name: "game", PortPolicy: agonesv1.Dynamic, ContainerPort: 7777, Protocol: corev1.ProtocolUDP},
+		}
+		return f
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		c, m := newFakeController()
+		m.KubeClient.AddReactor("create", "networkpolicies", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			assert.FailNow(t, "network policy should not be created")
+			return true, nil, nil
+		})
+
+		assert.NoError(t, c.syncNetworkPolicy(newFixture(), nil))
+	})
+
+	t.Run("create", func(t *testing.T) {
+		c, m := newFakeNetworkPolicyController()
+		f := newFixture()
+		created := false
+		m.KubeClient.AddReactor("create", "networkpolicies", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			created = true
+			np := action.(k8stesting.CreateAction).GetObject().(*networkingv1.NetworkPolicy)
+			assert.Equal(t, networkPolicy(f, nil), np)
+			return true, np, nil
+		})
+
+		_, cancel := agtesting.StartInformers(m, c.networkPolicySynced)
+		defer cancel()
+
+		assert.NoError(t, c.syncNetworkPolicy(f, nil))
+		assert.True(t, created)
+		agtesting.AssertEventContains(t, m.FakeRecorder.Events, "CreatingNetworkPolicy")
+	})
+
+	t.Run("update", func(t *testing.T) {
+		c, m := newFakeNetworkPolicyController()
+		f := newFixture()
+		existing := networkPolicy(f, nil)
+		existing.Spec.Ingress = nil
+		updated := false
+		m.KubeClient.AddReactor("list", "networkpolicies", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			return true, &networkingv1.NetworkPolicyList{Items: []networkingv1.NetworkPolicy{*existing}}, nil
+		})
+		m.KubeClient.AddReactor("update", "networkpolicies", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			updated = true
+			np := action.(k8stesting.UpdateAction).GetObject().(*networkingv1.NetworkPolicy)
+			assert.Equal(t, networkPolicy(f, nil).Spec, np.Spec)
+			return true, np, nil
+		})
+
+		_, cancel := agtesting.StartInformers(m, c.networkPolicySynced)
+		defer cancel()
+
+		assert.NoError(t, c.syncNetworkPolicy(f, nil))
+		assert.True(t, updated)
+		agtesting.AssertEventContains(t, m.FakeRecorder.Events, "UpdatingNetworkPolicy")
+	})
+
+	t.Run("up to date", func(t *testing.T) {
+		c, m := newFakeNetworkPolicyController()
+		f := newFixture()
+		existing := networkPolicy(f, nil)
+		m.KubeClient.AddReactor("list", "networkpolicies", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			return true, &networkingv1.NetworkPolicyList{Items: []networkingv1.NetworkPolicy{*existing}}, nil
+		})
+		m.KubeClient.AddReactor("update", "networkpolicies", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			assert.FailNow(t, "network policy should not be updated")
+			return true, nil, nil
+		})
+
+		_, cancel := agtesting.StartInformers(m, c.networkPolicySynced)
+		defer cancel()
+
+		assert.NoError(t, c.syncNetworkPolicy(f, nil))
+		agtesting.AssertNoEvent(t, m.FakeRecorder.Events)
+	})
+
+	t.Run("not owned by the fleet", func(t *testing.T) {
+		c, m := newFakeNetworkPolicyController()
+		f := newFixture()
+		existing := &networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: f.ObjectMeta.Name, Namespace: f.ObjectMeta.Namespace}}
+		m.KubeClient.AddReactor("list", "networkpolicies", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			return true, &networkingv1.NetworkPolicyList{Items: []networkingv1.NetworkPolicy{*existing}}, nil
+		})
+		m.KubeClient.AddReactor("update", "networkpolicies", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			assert.FailNow(t, "network policy should not be updated")
+			return true, nil, nil
+		})
+
+		_, cancel := agtesting.StartInformers(m, c.networkPolicySynced)
+		defer cancel()
+
+		assert.NoError(t, c.syncNetworkPolicy(f, nil))
+		agtesting.AssertNoEvent(t, m.FakeRecorder.Events)
+	})
+}
+
+// newFakeNetworkPolicyController returns a controller that creates the NetworkPolicies of Fleets,
+// backed by the fake Clientset
+func newFakeNetworkPolicyController() (*Controller, agtesting.Mocks) {
+	m := agtesting.NewMocks()
+	wh := webhooks.NewWebHook(http.NewServeMux())
+	c := NewController(wh, healthcheck.NewHandler(), Defaults{}, true, func() agonesv1.PortRange { return agonesv1.PortRange{MinPort: 10, MaxPort: 20} }, m.KubeClient, m.KubeInformerFactory, m.ExtClient, m.AgonesClient, m.AgonesInformerFactory)
+	c.recorder = m.FakeRecorder
+	return c, m
+}
@@ -0,0 +1,145 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fleets
+
+import (
+	"time"
+
+	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// restartGameServers restarts the Ready GameServers of the Fleet that were created before the
+// restart requested with the FleetRestartAnnotation, and that match its FleetRestartSelectorAnnotation.
+// With a MaxSurge, no more than MaxSurge GameServers at a time are marked with the GameServerRestartAnnotation,
+// for their GameServerSet to create their replacements before shutting them down, as when it recycles them.
+// Otherwise the GameServers are shut down, and replaced by their GameServerSet, no more than the MaxUnavailable
+// of a rolling update at a time.
+func (c *Controller) restartGameServers(fleet *agonesv1.Fleet) error {
+	restart, err := fleet.Restart()
+	if err != nil {
+		// should not happen, as the annotations are validated
+		c.loggerForFleet(fleet).WithError(err).Warn("Ignoring invalid restart")
+		return nil
+	}
+	if restart == nil {
+		return nil
+	}
+
+	list, err := ListGameServersByFleetOwner(c.gameServerLister, fleet)
+	if err != nil {
+		return err
+	}
+
+	maxSurge, maxUnavailable, err := restartBudget(fleet)
+	if err != nil {
+		return err
+	}
+
+	var toRestart []*agonesv1.GameServer
+	unavailable, pending := int32(0), int32(0)
+	for _, gs := range list {
+		if gs.ObjectMeta.Namespace != fleet.ObjectMeta.Namespace {
+			continue
+		}
+		if restarting(gs) {
+			unavailable++
+			continue
+		}
+		if gs.IsRestartPending() {
+			pending++
+			continue
+		}
+		if gs.Status.State == agonesv1.GameServerStateReady &&
+			gs.ObjectMeta.CreationTimestamp.Time.Before(restart.Before) &&
+			restart.Selector.Matches(labels.Set(gs.ObjectMeta.Labels)) {
+			toRestart = append(toRestart, gs)
+		}
+	}
+
+	remaining := len(toRestart)
+	budget := int(maxUnavailable - unavailable)
+	if maxSurge > 0 {
+		budget = int(maxSurge - pending)
+	}
+	if remaining > budget {
+		if budget <= 0 {
+			return nil
+		}
+		toRestart = toRestart[:budget]
+	}
+	if len(toRestart) == 0 {
+		return nil
+	}
+
+	c.loggerForFleet(fleet).WithField("count", len(toRestart)).WithField("remaining", remaining).Info("Restarting GameServers")
+	for _, gs := range toRestart {
+		gsCopy := gs.DeepCopy()
+		if maxSurge > 0 {
+			if gsCopy.ObjectMeta.Annotations == nil {
+				gsCopy.ObjectMeta.Annotations = map[string]string{}
+			}
+			gsCopy.ObjectMeta.Annotations[agonesv1.GameServerRestartAnnotation] = "true"
+		} else {
+			// shut the GameServer down rather than deleting it, so that it can't be restarted
+			// if it has been allocated since it was listed
+			gsCopy.Shutdown(agonesv1.ShutdownReasonRestart)
+		}
+		if _, err := c.gameServerGetter.GameServers(gs.ObjectMeta.Namespace).Update(gsCopy); err != nil {
+			return errors.Wrapf(err, "error restarting gameserver %s", gs.ObjectMeta.Name)
+		}
+	}
+	c.recorder.Eventf(fleet, corev1.EventTypeNormal, "RestartingGameServers",
+		"Restarting %d of %d GameServers created before %s", len(toRestart), remaining, restart.Before.Format(time.RFC3339))
+
+	return nil
+}
+
+// restartBudget returns how many GameServers of the Fleet can be created in surplus, and how many can be
+// unavailable at a time while restarting. The Recreate strategy has no surge, and unlimited unavailability.
+func restartBudget(fleet *agonesv1.Fleet) (int32, int32, error) {
+	if fleet.Spec.Strategy.Type != appsv1.RollingUpdateDeploymentStrategyType || fleet.Spec.Strategy.RollingUpdate == nil {
+		return 0, fleet.Spec.Replicas, nil
+	}
+	surge, err := intstr.GetValueFromIntOrPercent(fleet.Spec.Strategy.RollingUpdate.MaxSurge, int(fleet.Spec.Replicas), true)
+	if err != nil {
+		return 0, 0, errors.Wrapf(err, "error calculating max surge gameservers for restarting fleet %s", fleet.ObjectMeta.Name)
+	}
+	unavailable, err := intstr.GetValueFromIntOrPercent(fleet.Spec.Strategy.RollingUpdate.MaxUnavailable, int(fleet.Spec.Replicas), true)
+	if err != nil {
+		return 0, 0, errors.Wrapf(err, "error calculating max unavailable gameservers for restarting fleet %s", fleet.ObjectMeta.Name)
+	}
+	if unavailable < 1 {
+		unavailable = 1
+	}
+	return int32(surge), int32(unavailable), nil
+}
+
+// restarting returns true if the GameServer is being shut down, or is starting up
+func restarting(gs *agonesv1.GameServer) bool {
+	if !gs.ObjectMeta.DeletionTimestamp.IsZero() {
+		return true
+	}
+	switch gs.Status.State {
+	case agonesv1.GameServerStateShutdown, agonesv1.GameServerStatePortAllocation, agonesv1.GameServerStateCreating,
+		agonesv1.GameServerStateStarting, agonesv1.GameServerStateScheduled, agonesv1.GameServerStateRequestReady:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,152 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fleets
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
+	agtesting "agones.dev/agones/pkg/testing"
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestControllerRestartGameServers(t *testing.T) {
+	t.Parallel()
+
+	restartTime := time.Date(2019, 11, 5, 20, 0, 0, 0, time.UTC)
+	old := metav1.NewTime(restartTime.Add(-time.Hour))
+	recent := metav1.NewTime(restartTime.Add(time.Minute))
+
+	gameServer := func(name string, state agonesv1.GameServerState, created metav1.Time, mode string) agonesv1.GameServer {
+		return agonesv1.GameServer{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", CreationTimestamp: created,
+				Labels: map[string]string{agonesv1.FleetNameLabel: "fleet-1", "mode": mode}},
+			Status: agonesv1.GameServerStatus{State: state},
+		}
+	}
+
+	// run returns the names of the GameServers that were shut down, and of the ones that were marked for restart
+	run := func(t *testing.T, f *agonesv1.Fleet, list []agonesv1.GameServer) ([]string, []string) {
+		c, m := newFakeController()
+		var restarted, marked []string
+
+		m.AgonesClient.AddReactor("list", "gameservers", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			return true, &agonesv1.GameServerList{Items: list}, nil
+		})
+		m.AgonesClient.AddReactor("update", "gameservers", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			gs := action.(k8stesting.UpdateAction).GetObject().(*agonesv1.GameServer)
+			if gs.IsRestartPending() {
+				marked = append(marked, gs.ObjectMeta.Name)
+			} else {
+				assert.Equal(t, agonesv1.GameServerStateShutdown, gs.Status.State)
+				restarted = append(restarted, gs.ObjectMeta.Name)
+			}
+			return true, gs, nil
+		})
+
+		_, cancel := agtesting.StartInformers(m, c.gameServerSynced)
+		defer cancel()
+
+		assert.NoError(t, c.restartGameServers(f))
+		sort.Strings(restarted)
+		sort.Strings(marked)
+		if len(restarted) > 0 || len(marked) > 0 {
+			agtesting.AssertEventContains(t, m.FakeRecorder.Events, "RestartingGameServers")
+		}
+		agtesting.AssertNoEvent(t, m.FakeRecorder.Events)
+		return restarted, marked
+	}
+
+	// fleet returns a Fleet restarted at restartTime, that restarts GameServers without a surge
+	fleet := func() *agonesv1.Fleet {
+		f := defaultFixture()
+		f.ObjectMeta.Annotations = map[string]string{agonesv1.FleetRestartAnnotation: restartTime.Format(time.RFC3339)}
+		f.Spec.Strategy.RollingUpdate.MaxSurge = &intstr.IntOrString{Type: intstr.Int, IntVal: 0}
+		return f
+	}
+
+	list := []agonesv1.GameServer{
+		gameServer("ready-old-ranked", agonesv1.GameServerStateReady, old, "ranked"),
+		gameServer("ready-old-casual", agonesv1.GameServerStateReady, old, "casual"),
+		gameServer("ready-recent-ranked", agonesv1.GameServerStateReady, recent, "ranked"),
+		gameServer("allocated-old-ranked", agonesv1.GameServerStateAllocated, old, "ranked"),
+	}
+
+	t.Run("no restart", func(t *testing.T) {
+		restarted, marked := run(t, defaultFixture(), list)
+		assert.Empty(t, restarted)
+		assert.Empty(t, marked)
+	})
+
+	t.Run("restart all", func(t *testing.T) {
+		f := fleet()
+		f.Spec.Strategy.RollingUpdate.MaxUnavailable = &intstr.IntOrString{Type: intstr.Int, IntVal: 5}
+		restarted, marked := run(t, f, list)
+		assert.Equal(t, []string{"ready-old-casual", "ready-old-ranked"}, restarted)
+		assert.Empty(t, marked)
+	})
+
+	t.Run("restart selected", func(t *testing.T) {
+		f := fleet()
+		f.ObjectMeta.Annotations[agonesv1.FleetRestartSelectorAnnotation] = "mode=ranked"
+		restarted, _ := run(t, f, list)
+		assert.Equal(t, []string{"ready-old-ranked"}, restarted)
+	})
+
+	t.Run("max unavailable", func(t *testing.T) {
+		f := fleet()
+		f.Spec.Strategy.RollingUpdate.MaxUnavailable = &intstr.IntOrString{Type: intstr.Int, IntVal: 2}
+
+		starting := append([]agonesv1.GameServer{gameServer("starting", agonesv1.GameServerStateScheduled, recent, "ranked")}, list...)
+		restarted, _ := run(t, f, starting)
+		assert.Len(t, restarted, 1)
+
+		starting = append([]agonesv1.GameServer{gameServer("shutdown", agonesv1.GameServerStateShutdown, old, "ranked")}, starting...)
+		restarted, _ = run(t, f, starting)
+		assert.Empty(t, restarted)
+	})
+
+	t.Run("max surge", func(t *testing.T) {
+		f := fleet()
+		f.Spec.Strategy.RollingUpdate.MaxSurge = &intstr.IntOrString{Type: intstr.Int, IntVal: 1}
+		restarted, marked := run(t, f, list)
+		assert.Empty(t, restarted)
+		assert.Len(t, marked, 1)
+
+		f.Spec.Strategy.RollingUpdate.MaxSurge = &intstr.IntOrString{Type: intstr.Int, IntVal: 2}
+		pending := gameServer("pending", agonesv1.GameServerStateReady, old, "ranked")
+		pending.ObjectMeta.Annotations = map[string]string{agonesv1.GameServerRestartAnnotation: "true"}
+		restarted, marked = run(t, f, append([]agonesv1.GameServer{pending}, list...))
+		assert.Empty(t, restarted)
+		assert.Len(t, marked, 1)
+		assert.NotContains(t, marked, "pending")
+	})
+
+	t.Run("recreate", func(t *testing.T) {
+		f := fleet()
+		f.Spec.Strategy.Type = appsv1.RecreateDeploymentStrategyType
+		starting := append([]agonesv1.GameServer{gameServer("starting", agonesv1.GameServerStateScheduled, recent, "ranked")}, list...)
+		restarted, marked := run(t, f, starting)
+		assert.Equal(t, []string{"ready-old-casual", "ready-old-ranked"}, restarted)
+		assert.Empty(t, marked)
+	})
+}
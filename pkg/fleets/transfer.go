@@ -0,0 +1,93 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fleets
+
+import (
+	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// transferGameServers moves the Allocated GameServers of the inactive GameServerSets that have the
+// GameServerTransferAnnotation to the active GameServerSet, so that long lived GameServers don't keep
+// obsolete GameServerSets alive after a rollout. A GameServer is only transferred if the template of its
+// GameServerSet is compatible with the active one, otherwise the transfer is rejected.
+// Either way, the annotation is removed.
+func (c *Controller) transferGameServers(fleet *agonesv1.Fleet, active *agonesv1.GameServerSet, rest []*agonesv1.GameServerSet) error {
+	for _, gsSet := range rest {
+		list, err := c.gameServerLister.GameServers(gsSet.ObjectMeta.Namespace).List(
+			labels.SelectorFromSet(labels.Set{agonesv1.GameServerSetGameServerLabel: gsSet.ObjectMeta.Name}))
+		if err != nil {
+			return errors.Wrapf(err, "error listing gameservers for gameserverset %s", gsSet.ObjectMeta.Name)
+		}
+
+		for _, gs := range list {
+			if _, ok := gs.ObjectMeta.Annotations[agonesv1.GameServerTransferAnnotation]; !ok ||
+				!metav1.IsControlledBy(gs, gsSet) || !gs.ObjectMeta.DeletionTimestamp.IsZero() {
+				continue
+			}
+
+			gsCopy := gs.DeepCopy()
+			delete(gsCopy.ObjectMeta.Annotations, agonesv1.GameServerTransferAnnotation)
+
+			compatible := compatibleTemplates(gsSet.Spec.Template, active.Spec.Template)
+			transfer := gs.Status.State == agonesv1.GameServerStateAllocated && compatible
+			if transfer {
+				gsCopy.ObjectMeta.OwnerReferences = transferOwnerReferences(gsCopy.ObjectMeta.OwnerReferences, active)
+				gsCopy.ObjectMeta.Labels[agonesv1.GameServerSetGameServerLabel] = active.ObjectMeta.Name
+			}
+
+			if _, err := c.gameServerGetter.GameServers(gs.ObjectMeta.Namespace).Update(gsCopy); err != nil {
+				return errors.Wrapf(err, "error transferring gameserver %s", gs.ObjectMeta.Name)
+			}
+
+			switch {
+			case transfer:
+				c.recorder.Eventf(fleet, corev1.EventTypeNormal, "TransferredGameServer",
+					"Transferred GameServer %s from GameServerSet %s to %s", gs.ObjectMeta.Name, gsSet.ObjectMeta.Name, active.ObjectMeta.Name)
+			case !compatible:
+				c.recorder.Eventf(fleet, corev1.EventTypeWarning, "TransferRejected",
+					"GameServer %s can't be transferred, as the template of GameServerSet %s is not compatible with %s",
+					gs.ObjectMeta.Name, gsSet.ObjectMeta.Name, active.ObjectMeta.Name)
+			default:
+				c.recorder.Eventf(fleet, corev1.EventTypeWarning, "TransferRejected",
+					"GameServer %s can't be transferred, as it is %s rather than Allocated", gs.ObjectMeta.Name, gs.Status.State)
+			}
+		}
+	}
+
+	return nil
+}
+
+// compatibleTemplates returns true if GameServers of the old template can be owned by a GameServerSet
+// with the new template, which is when the templates only differ by their labels and annotations
+func compatibleTemplates(old, new agonesv1.GameServerTemplateSpec) bool {
+	return equality.Semantic.DeepEqual(old.Spec, new.Spec)
+}
+
+// transferOwnerReferences returns the owner references with the controller reference replaced
+// by a controller reference to the given GameServerSet
+func transferOwnerReferences(refs []metav1.OwnerReference, gsSet *agonesv1.GameServerSet) []metav1.OwnerReference {
+	result := []metav1.OwnerReference{*metav1.NewControllerRef(gsSet, agonesv1.SchemeGroupVersion.WithKind("GameServerSet"))}
+	for _, ref := range refs {
+		if ref.Controller == nil || !*ref.Controller {
+			result = append(result, ref)
+		}
+	}
+	return result
+}
@@ -0,0 +1,130 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fleets
+
+import (
+	"testing"
+
+	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
+	agtesting "agones.dev/agones/pkg/testing"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestControllerTransferGameServers(t *testing.T) {
+	t.Parallel()
+
+	gameServerSet := func(f *agonesv1.Fleet, name string) *agonesv1.GameServerSet {
+		gsSet := f.GameServerSet()
+		gsSet.ObjectMeta.Name = name
+		gsSet.ObjectMeta.UID = types.UID(name)
+		return gsSet
+	}
+
+	gameServer := func(gsSet *agonesv1.GameServerSet, name string, state agonesv1.GameServerState, transfer bool) agonesv1.GameServer {
+		gs := gsSet.GameServer()
+		gs.ObjectMeta.Name = name
+		gs.Status.State = state
+		if transfer {
+			gs.ObjectMeta.Annotations = map[string]string{agonesv1.GameServerTransferAnnotation: "true"}
+		}
+		return *gs
+	}
+
+	run := func(t *testing.T, f *agonesv1.Fleet, active *agonesv1.GameServerSet, rest []*agonesv1.GameServerSet, list []agonesv1.GameServer) map[string]*agonesv1.GameServer {
+		c, m := newFakeController()
+		updated := map[string]*agonesv1.GameServer{}
+
+		m.AgonesClient.AddReactor("list", "gameservers", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			return true, &agonesv1.GameServerList{Items: list}, nil
+		})
+		m.AgonesClient.AddReactor("update", "gameservers", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			gs := action.(k8stesting.UpdateAction).GetObject().(*agonesv1.GameServer)
+			assert.NotContains(t, gs.ObjectMeta.Annotations, agonesv1.GameServerTransferAnnotation)
+			updated[gs.ObjectMeta.Name] = gs
+			return true, gs, nil
+		})
+
+		_, cancel := agtesting.StartInformers(m, c.gameServerSynced)
+		defer cancel()
+
+		assert.NoError(t, c.transferGameServers(f, active, rest))
+		return updated
+	}
+
+	t.Run("compatible templates", func(t *testing.T) {
+		f := defaultFixture()
+		old := gameServerSet(f, "old")
+		f.Spec.Template.ObjectMeta.Labels = map[string]string{"version": "2"}
+		active := gameServerSet(f, "active")
+
+		list := []agonesv1.GameServer{
+			gameServer(old, "allocated", agonesv1.GameServerStateAllocated, true),
+			gameServer(old, "allocated-no-transfer", agonesv1.GameServerStateAllocated, false),
+		}
+		updated := run(t, f, active, []*agonesv1.GameServerSet{old}, list)
+
+		assert.Len(t, updated, 1)
+		gs := updated["allocated"]
+		if assert.NotNil(t, gs) {
+			assert.True(t, metav1.IsControlledBy(gs, active))
+			assert.Len(t, gs.ObjectMeta.OwnerReferences, 1)
+			assert.Equal(t, "active", gs.ObjectMeta.Labels[agonesv1.GameServerSetGameServerLabel])
+		}
+	})
+
+	t.Run("incompatible templates", func(t *testing.T) {
+		f := defaultFixture()
+		old := gameServerSet(f, "old")
+		f.Spec.Template.Spec.Health.Disabled = true
+		active := gameServerSet(f, "active")
+
+		list := []agonesv1.GameServer{gameServer(old, "allocated", agonesv1.GameServerStateAllocated, true)}
+		c, m := newFakeController()
+		m.AgonesClient.AddReactor("list", "gameservers", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			return true, &agonesv1.GameServerList{Items: list}, nil
+		})
+		m.AgonesClient.AddReactor("update", "gameservers", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			gs := action.(k8stesting.UpdateAction).GetObject().(*agonesv1.GameServer)
+			assert.NotContains(t, gs.ObjectMeta.Annotations, agonesv1.GameServerTransferAnnotation)
+			assert.True(t, metav1.IsControlledBy(gs, old))
+			return true, gs, nil
+		})
+
+		_, cancel := agtesting.StartInformers(m, c.gameServerSynced)
+		defer cancel()
+
+		assert.NoError(t, c.transferGameServers(f, active, []*agonesv1.GameServerSet{old}))
+		agtesting.AssertEventContains(t, m.FakeRecorder.Events, "TransferRejected")
+	})
+
+	t.Run("not allocated", func(t *testing.T) {
+		f := defaultFixture()
+		old := gameServerSet(f, "old")
+		active := gameServerSet(f, "active")
+
+		list := []agonesv1.GameServer{gameServer(old, "ready", agonesv1.GameServerStateReady, true)}
+		updated := run(t, f, active, []*agonesv1.GameServerSet{old}, list)
+
+		gs := updated["ready"]
+		if assert.NotNil(t, gs) {
+			assert.True(t, metav1.IsControlledBy(gs, old))
+			assert.Equal(t, "old", gs.ObjectMeta.Labels[agonesv1.GameServerSetGameServerLabel])
+		}
+	})
+}
@@ -0,0 +1,85 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gameserverallocations
+
+import (
+	"sync"
+	"time"
+
+	allocationv1 "agones.dev/agones/pkg/apis/allocation/v1"
+)
+
+// allocationAffinityTTL is how long the node of the last allocation with an affinity key
+// is preferred for the next allocations with the same key
+const allocationAffinityTTL = 5 * time.Minute
+
+// affinityNode is the node that was last allocated from for an affinity key
+type affinityNode struct {
+	name    string
+	expires time.Time
+}
+
+// allocationAffinity keeps track of the nodes that recent allocations with an affinity key,
+// such as a party ID, were made on, so that back to back allocations for the same group
+// prefer the node whose caches are already warm
+type allocationAffinity struct {
+	mu        sync.Mutex
+	nodes     map[string]affinityNode
+	nextPurge time.Time
+}
+
+// newAllocationAffinity returns an allocationAffinity with no nodes
+func newAllocationAffinity() *allocationAffinity {
+	return &allocationAffinity{nodes: map[string]affinityNode{}}
+}
+
+// affinityKey returns the key the node of the GameServerAllocation is stored under,
+// or an empty string if it has no affinity key
+func affinityKey(gsa *allocationv1.GameServerAllocation) string {
+	if gsa.Spec.AffinityKey == "" {
+		return ""
+	}
+	return gsa.ObjectMeta.Namespace + "/" + gsa.Spec.AffinityKey
+}
+
+// node returns the node that was last allocated from for the key, or an empty string if there
+// was no allocation for it within the allocationAffinityTTL
+func (a *allocationAffinity) node(key string, now time.Time) string {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	n, ok := a.nodes[key]
+	if !ok || now.After(n.expires) {
+		return ""
+	}
+	return n.name
+}
+
+// record stores the node that was allocated from for the key at the given time,
+// and removes the expired nodes every allocationAffinityTTL
+func (a *allocationAffinity) record(key, node string, now time.Time) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if now.After(a.nextPurge) {
+		for k, n := range a.nodes {
+			if now.After(n.expires) {
+				delete(a.nodes, k)
+			}
+		}
+		a.nextPurge = now.Add(allocationAffinityTTL)
+	}
+	a.nodes[key] = affinityNode{name: node, expires: now.Add(allocationAffinityTTL)}
+}
@@ -0,0 +1,70 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gameserverallocations
+
+import (
+	"testing"
+	"time"
+
+	allocationv1 "agones.dev/agones/pkg/apis/allocation/v1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAllocationAffinity(t *testing.T) {
+	t.Parallel()
+
+	t.Run("remembers the last node", func(t *testing.T) {
+		a := newAllocationAffinity()
+		now := time.Now()
+
+		assert.Equal(t, "", a.node("default/party", now))
+		a.record("default/party", "node1", now)
+		assert.Equal(t, "node1", a.node("default/party", now))
+		a.record("default/party", "node2", now.Add(time.Minute))
+		assert.Equal(t, "node2", a.node("default/party", now.Add(time.Minute)))
+		// other keys are not affected
+		assert.Equal(t, "", a.node("default/other", now))
+	})
+
+	t.Run("expires", func(t *testing.T) {
+		a := newAllocationAffinity()
+		now := time.Now()
+
+		a.record("default/party", "node1", now)
+		assert.Equal(t, "node1", a.node("default/party", now.Add(allocationAffinityTTL)))
+		assert.Equal(t, "", a.node("default/party", now.Add(allocationAffinityTTL+time.Second)))
+	})
+
+	t.Run("purges expired keys", func(t *testing.T) {
+		a := newAllocationAffinity()
+		now := time.Now()
+
+		a.record("default/a", "node1", now)
+		a.record("default/b", "node1", now.Add(allocationAffinityTTL/2))
+		assert.Len(t, a.nodes, 2)
+
+		a.record("default/c", "node1", now.Add(allocationAffinityTTL+time.Second))
+		assert.Len(t, a.nodes, 2)
+		assert.NotContains(t, a.nodes, "default/a")
+	})
+
+	t.Run("key", func(t *testing.T) {
+		gsa := &allocationv1.GameServerAllocation{ObjectMeta: metav1.ObjectMeta{Namespace: "default"}}
+		assert.Equal(t, "", affinityKey(gsa))
+		gsa.Spec.AffinityKey = "party"
+		assert.Equal(t, "default/party", affinityKey(gsa))
+	})
+}
@@ -16,6 +16,7 @@ package gameserverallocations
 
 import (
 	"bytes"
+	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/json"
@@ -29,8 +30,11 @@ import (
 	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
 	allocationv1 "agones.dev/agones/pkg/apis/allocation/v1"
 	multiclusterv1alpha1 "agones.dev/agones/pkg/apis/multicluster/v1alpha1"
+	informerv1 "agones.dev/agones/pkg/client/informers/externalversions/agones/v1"
 	multiclusterinformerv1alpha1 "agones.dev/agones/pkg/client/informers/externalversions/multicluster/v1alpha1"
+	listerv1 "agones.dev/agones/pkg/client/listers/agones/v1"
 	multiclusterlisterv1alpha1 "agones.dev/agones/pkg/client/listers/multicluster/v1alpha1"
+	"agones.dev/agones/pkg/gameservers"
 	"agones.dev/agones/pkg/util/apiserver"
 	"agones.dev/agones/pkg/util/logfields"
 	"agones.dev/agones/pkg/util/runtime"
@@ -57,6 +61,9 @@ var (
 	ErrNoGameServerReady = errors.New("Could not find a Ready GameServer")
 	// ErrConflictInGameServerSelection is returned when the candidate gameserver already allocated
 	ErrConflictInGameServerSelection = errors.New("The Gameserver was already allocated")
+	// ErrAllocatorNotReady is returned when allocation requests are received before the
+	// Ready GameServer cache and port allocator have synced after startup
+	ErrAllocatorNotReady = errors.New("The allocator has not finished syncing GameServers")
 )
 
 const (
@@ -69,6 +76,14 @@ const (
 	// to reduce the contention while allocating gameservers.
 	topNGameServerDefaultCount = 100
 	allocatorRequestURLFmt     = "https://%s/v1alpha1/gameserverallocation"
+
+	// notReadyRetryAfterSeconds is how long clients are asked to wait before retrying
+	// an allocation that was rejected because the allocator has not synced yet
+	notReadyRetryAfterSeconds = 5
+
+	// remoteAllocationTimeout is how long to wait for the allocation endpoint of a remote cluster
+	// to respond, before counting the request as failed and trying the next endpoint
+	remoteAllocationTimeout = 10 * time.Second
 )
 
 const (
@@ -91,36 +106,49 @@ type Allocator struct {
 	allocationPolicySynced cache.InformerSynced
 	secretLister           corev1lister.SecretLister
 	secretSynced           cache.InformerSynced
+	gameServerSetLister    listerv1.GameServerSetLister
+	gameServerSetSynced    cache.InformerSynced
 	recorder               record.EventRecorder
 	pendingRequests        chan request
 	readyGameServerCache   *ReadyGameServerCache
+	portAllocatorSynced    cache.InformerSynced
 	topNGameServerCount    int
+	remoteClusters         *remoteClusterHealth
+	affinity               *allocationAffinity
 }
 
 // request is an async request for allocation
 type request struct {
+	ctx      context.Context
 	gsa      *allocationv1.GameServerAllocation
 	response chan response
 }
 
 // response is an async response for a matching request
 type response struct {
-	request request
-	gs      *agonesv1.GameServer
-	err     error
+	request  request
+	gs       *agonesv1.GameServer
+	selector string
+	err      error
 }
 
 // NewAllocator creates an instance off Allocator
 func NewAllocator(policyInformer multiclusterinformerv1alpha1.GameServerAllocationPolicyInformer, secretInformer informercorev1.SecretInformer,
-	kubeClient kubernetes.Interface, readyGameServerCache *ReadyGameServerCache) *Allocator {
+	gameServerSetInformer informerv1.GameServerSetInformer, kubeClient kubernetes.Interface, readyGameServerCache *ReadyGameServerCache,
+	portAllocatorSynced cache.InformerSynced) *Allocator {
 	ah := &Allocator{
 		pendingRequests:        make(chan request, maxBatchQueue),
 		allocationPolicyLister: policyInformer.Lister(),
 		allocationPolicySynced: policyInformer.Informer().HasSynced,
 		secretLister:           secretInformer.Lister(),
 		secretSynced:           secretInformer.Informer().HasSynced,
+		gameServerSetLister:    gameServerSetInformer.Lister(),
+		gameServerSetSynced:    gameServerSetInformer.Informer().HasSynced,
 		readyGameServerCache:   readyGameServerCache,
+		portAllocatorSynced:    portAllocatorSynced,
 		topNGameServerCount:    topNGameServerDefaultCount,
+		remoteClusters:         newRemoteClusterHealth(),
+		affinity:               newAllocationAffinity(),
 	}
 
 	ah.baseLogger = runtime.NewLoggerWithType(ah)
@@ -151,14 +179,24 @@ func (c *Allocator) Start(stop <-chan struct{}) error {
 // Sync waits for cache to sync
 func (c *Allocator) Sync(stop <-chan struct{}) error {
 	c.baseLogger.Info("Wait for Allocator cache sync")
-	if !cache.WaitForCacheSync(stop, c.secretSynced, c.allocationPolicySynced) {
+	if !cache.WaitForCacheSync(stop, c.secretSynced, c.allocationPolicySynced, c.gameServerSetSynced) {
 		return errors.New("failed to wait for caches to sync")
 	}
 	return nil
 }
 
+// Ready returns ErrAllocatorNotReady until the Ready GameServer cache and
+// the port allocator have synced after startup
+func (c *Allocator) Ready() error {
+	if !c.readyGameServerCache.HasSynced() || !c.portAllocatorSynced() {
+		return ErrAllocatorNotReady
+	}
+	return nil
+}
+
 // Allocate CRDHandler for allocating a gameserver.
-func (c *Allocator) Allocate(gsa *allocationv1.GameServerAllocation, stop <-chan struct{}) (k8sruntime.Object, error) {
+// The allocation is abandoned once ctx is done, and a Timeout status is returned if its deadline is exceeded.
+func (c *Allocator) Allocate(ctx context.Context, gsa *allocationv1.GameServerAllocation, stop <-chan struct{}) (k8sruntime.Object, error) {
 	// server side validation
 	if causes, ok := gsa.Validate(); !ok {
 		status := &metav1.Status{
@@ -184,13 +222,59 @@ func (c *Allocator) Allocate(gsa *allocationv1.GameServerAllocation, stop <-chan
 		return status, nil
 	}
 
+	// don't allocate from a partial view of the cluster, as it would look like there is no capacity
+	if err := c.Ready(); err != nil {
+		status := &metav1.Status{
+			Status:  metav1.StatusFailure,
+			Message: err.Error(),
+			Reason:  metav1.StatusReasonServiceUnavailable,
+			Details: &metav1.StatusDetails{
+				Kind:              "GameServerAllocation",
+				Group:             allocationv1.SchemeGroupVersion.Group,
+				RetryAfterSeconds: notReadyRetryAfterSeconds,
+			},
+			Code: http.StatusServiceUnavailable,
+		}
+
+		gvks, _, err := apiserver.Scheme.ObjectKinds(status)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not find objectkinds for status")
+		}
+		c.loggerForGameServerAllocation(gsa).Info("GameServerAllocation received before the allocator is ready")
+
+		status.TypeMeta = metav1.TypeMeta{Kind: gvks[0].Kind, APIVersion: gvks[0].Version}
+		return status, nil
+	}
+
 	// If multi-cluster setting is enabled, allocate base on the multicluster allocation policy.
 	var out *allocationv1.GameServerAllocation
 	var err error
 	if gsa.Spec.MultiClusterSetting.Enabled {
-		out, err = c.applyMultiClusterAllocation(gsa, stop)
+		out, err = c.applyMultiClusterAllocation(ctx, gsa, stop)
 	} else {
-		out, err = c.allocateFromLocalCluster(gsa, stop)
+		out, err = c.allocateFromLocalCluster(ctx, gsa, stop)
+	}
+
+	if errors.Cause(err) == context.DeadlineExceeded {
+		status := &metav1.Status{
+			Status:  metav1.StatusFailure,
+			Message: "GameServerAllocation did not complete before its timeout",
+			Reason:  metav1.StatusReasonTimeout,
+			Details: &metav1.StatusDetails{
+				Kind:  "GameServerAllocation",
+				Group: allocationv1.SchemeGroupVersion.Group,
+			},
+			Code: http.StatusGatewayTimeout,
+		}
+
+		gvks, _, err := apiserver.Scheme.ObjectKinds(status)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not find objectkinds for status")
+		}
+		c.loggerForGameServerAllocation(gsa).Info("GameServerAllocation timed out")
+
+		status.TypeMeta = metav1.TypeMeta{Kind: gvks[0].Kind, APIVersion: gvks[0].Version}
+		return status, nil
 	}
 
 	if err != nil {
@@ -214,17 +298,21 @@ func (c *Allocator) loggerForGameServerAllocation(gsa *allocationv1.GameServerAl
 }
 
 // allocateFromLocalCluster allocates gameservers from the local cluster.
-func (c *Allocator) allocateFromLocalCluster(gsa *allocationv1.GameServerAllocation, stop <-chan struct{}) (*allocationv1.GameServerAllocation, error) {
+func (c *Allocator) allocateFromLocalCluster(ctx context.Context, gsa *allocationv1.GameServerAllocation, stop <-chan struct{}) (*allocationv1.GameServerAllocation, error) {
 	var gs *agonesv1.GameServer
 	err := Retry(allocationRetry, func() error {
 		var err error
-		gs, err = c.allocate(gsa, stop)
-		if err != nil {
+		gs, err = c.allocate(ctx, gsa, stop)
+		if err != nil && ctx.Err() == nil {
 			c.loggerForGameServerAllocation(gsa).WithError(err).Warn("failed to allocate. Retrying... ")
 		}
 		return err
 	})
 
+	if err != nil && ctx.Err() != nil {
+		// the request was abandoned, so there is nothing wrong with the cache
+		return nil, ctx.Err()
+	}
 	if err != nil && err != ErrNoGameServerReady && err != ErrConflictInGameServerSelection {
 		c.readyGameServerCache.Resync()
 		return nil, err
@@ -241,6 +329,10 @@ func (c *Allocator) allocateFromLocalCluster(gsa *allocationv1.GameServerAllocat
 		gsa.Status.Ports = gs.Status.Ports
 		gsa.Status.Address = gs.Status.Address
 		gsa.Status.NodeName = gs.Status.NodeName
+		gsa.Status.Annotations = gs.AllocationVisibleAnnotations()
+		gsa.Status.GameServer, gsa.Status.GameServerSet = gameServerReferences(gs)
+		gsa.Status.Fleet = c.fleetReference(gsa.Status.GameServerSet)
+		gsa.Status.FleetName = gs.ObjectMeta.Labels[agonesv1.FleetNameLabel]
 	}
 
 	c.loggerForGameServerAllocation(gsa).Info("game server allocation")
@@ -249,7 +341,9 @@ func (c *Allocator) allocateFromLocalCluster(gsa *allocationv1.GameServerAllocat
 
 // applyMultiClusterAllocation retrieves allocation policies and iterate on policies.
 // Then allocate gameservers from local or remote cluster accordingly.
-func (c *Allocator) applyMultiClusterAllocation(gsa *allocationv1.GameServerAllocation, stop <-chan struct{}) (result *allocationv1.GameServerAllocation, err error) {
+// If a cluster has no Ready GameServers, the next cluster is tried, and the last result
+// is returned if none of the clusters could allocate a GameServer.
+func (c *Allocator) applyMultiClusterAllocation(ctx context.Context, gsa *allocationv1.GameServerAllocation, stop <-chan struct{}) (result *allocationv1.GameServerAllocation, err error) {
 	selector := labels.Everything()
 	if len(gsa.Spec.MultiClusterSetting.PolicySelector.MatchLabels)+len(gsa.Spec.MultiClusterSetting.PolicySelector.MatchExpressions) != 0 {
 		selector, err = metav1.LabelSelectorAsSelector(&gsa.Spec.MultiClusterSetting.PolicySelector)
@@ -265,39 +359,48 @@ func (c *Allocator) applyMultiClusterAllocation(gsa *allocationv1.GameServerAllo
 		return nil, errors.New("no multi-cluster allocation policy is specified")
 	}
 
+	var unallocated *allocationv1.GameServerAllocation
 	it := multiclusterv1alpha1.NewConnectionInfoIterator(policies)
 	for {
 		connectionInfo := it.Next()
 		if connectionInfo == nil {
 			break
 		}
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
 		if len(connectionInfo.AllocationEndpoints) == 0 {
-			// Change the naemspace to the policy namespace and allocate locally
-			gsaCopy := gsa
-			if gsa.Namespace != connectionInfo.Namespace {
-				gsaCopy = gsa.DeepCopy()
-				gsaCopy.Namespace = connectionInfo.Namespace
-			}
-			result, err = c.allocateFromLocalCluster(gsaCopy, stop)
+			// Change the namespace to the policy namespace and allocate locally.
+			// Allocate from a copy, so the request is left as is for the next cluster.
+			gsaCopy := gsa.DeepCopy()
+			gsaCopy.Namespace = connectionInfo.Namespace
+			result, err = c.allocateFromLocalCluster(ctx, gsaCopy, stop)
 			if err != nil {
 				c.loggerForGameServerAllocation(gsaCopy).WithError(err).Error("self-allocation failed")
 			}
 		} else {
-			result, err = c.allocateFromRemoteCluster(*gsa, connectionInfo, gsa.ObjectMeta.Namespace)
+			result, err = c.allocateFromRemoteCluster(ctx, *gsa, connectionInfo, gsa.ObjectMeta.Namespace)
 			if err != nil {
 				c.loggerForGameServerAllocation(gsa).WithField("allocConnInfo", connectionInfo).WithError(err).Error("remote-allocation failed")
 			}
 		}
 		if result != nil {
-			return result, nil
+			if result.Status.State == allocationv1.GameServerAllocationAllocated {
+				return result, nil
+			}
+			unallocated = result
 		}
 	}
+	if unallocated != nil {
+		return unallocated, nil
+	}
 	return nil, err
 }
 
 // allocateFromRemoteCluster allocates gameservers from a remote cluster by making
 // an http call to allocation service in that cluster.
-func (c *Allocator) allocateFromRemoteCluster(gsa allocationv1.GameServerAllocation, connectionInfo *multiclusterv1alpha1.ClusterConnectionInfo, namespace string) (*allocationv1.GameServerAllocation, error) {
+// Endpoints that keep failing are skipped, until their circuit breaker lets a request probe them again.
+func (c *Allocator) allocateFromRemoteCluster(ctx context.Context, gsa allocationv1.GameServerAllocation, connectionInfo *multiclusterv1alpha1.ClusterConnectionInfo, namespace string) (*allocationv1.GameServerAllocation, error) {
 	var gsaResult allocationv1.GameServerAllocation
 
 	// TODO: handle converting error to apiserver error
@@ -317,27 +420,50 @@ func (c *Allocator) allocateFromRemoteCluster(gsa allocationv1.GameServerAllocat
 		return nil, err
 	}
 
-	// TODO: Retry on transient error --> response.StatusCode >= 500
-	for i, endpoint := range connectionInfo.AllocationEndpoints {
-		c.loggerForGameServerAllocation(&gsa).WithField("endpoint", endpoint).Info("forwarding allocation request")
+	// the circuit breaker is only asked just before each endpoint is tried, so that the endpoints
+	// that are never reached don't use up the single request that probes them once their circuit expires
+	var lastErr error
+	for _, endpoint := range connectionInfo.AllocationEndpoints {
+		logger := c.loggerForGameServerAllocation(&gsa).WithField("endpoint", endpoint)
+		if !c.remoteClusters.allow(endpoint, time.Now()) {
+			logger.Debug("skipping unhealthy allocation endpoint")
+			continue
+		}
+		logger.WithField("latency", c.remoteClusters.latency(endpoint)).Info("forwarding allocation request")
 		requestURL := fmt.Sprintf(allocatorRequestURLFmt, endpoint)
-		response, err := client.Post(requestURL, "application/json", bytes.NewBuffer(body))
+		request, err := http.NewRequest(http.MethodPost, requestURL, bytes.NewBuffer(body))
 		if err != nil {
 			return nil, err
 		}
+		request.Header.Set("Content-Type", "application/json")
+		start := time.Now()
+		response, err := client.Do(request.WithContext(ctx))
+		if err != nil && ctx.Err() != nil {
+			// the request was abandoned, which says nothing about the health of the endpoint
+			return nil, ctx.Err()
+		}
+		if err != nil {
+			c.remoteClusters.failure(endpoint, time.Now())
+			logger.WithError(err).Warn("The request failed. Trying next endpoint")
+			lastErr = err
+			continue
+		}
 		defer response.Body.Close() // nolint: errcheck
 
 		data, err := ioutil.ReadAll(response.Body)
 		if err != nil {
+			c.remoteClusters.failure(endpoint, time.Now())
 			return nil, err
 		}
 		// If there are multiple enpoints for the allocator connection and the current one is
 		// failing with 5xx http status, try the next endpoint. Otherwise, return the error response.
-		if response.StatusCode >= 500 && (i+1) < len(connectionInfo.AllocationEndpoints) {
-			// If there is a server error try a different endpoint
-			c.loggerForGameServerAllocation(&gsa).WithError(err).WithField("endpoint", endpoint).Warn("The request failed. Trying next endpoint")
+		if response.StatusCode >= 500 {
+			c.remoteClusters.failure(endpoint, time.Now())
+			logger.WithField("status", response.StatusCode).Warn("The request failed. Trying next endpoint")
+			lastErr = errors.New(string(data))
 			continue
 		}
+		c.remoteClusters.success(endpoint, time.Since(start))
 		if response.StatusCode >= 400 {
 			// For error responses return the body without deserializing to an object.
 			return nil, errors.New(string(data))
@@ -347,9 +473,12 @@ func (c *Allocator) allocateFromRemoteCluster(gsa allocationv1.GameServerAllocat
 		if err != nil {
 			return nil, err
 		}
-		break
+		return &gsaResult, nil
 	}
-	return &gsaResult, nil
+	if lastErr == nil {
+		return nil, errors.Errorf("all allocation endpoints of cluster %s are unhealthy", connectionInfo.ClusterName)
+	}
+	return nil, lastErr
 }
 
 // createRemoteClusterRestClient creates a rest client with proper certs to make a remote call.
@@ -380,6 +509,7 @@ func (c *Allocator) createRemoteClusterRestClient(namespace, secretName string)
 
 	// Setup HTTPS client
 	return &http.Client{
+		Timeout: remoteAllocationTimeout,
 		Transport: &http.Transport{
 			TLSClientConfig: tlsConfig,
 		},
@@ -405,17 +535,26 @@ func (c *Allocator) getClientCertificates(namespace, secretName string) (clientC
 
 // allocate allocated a GameServer from a given GameServerAllocation
 // this sets up allocation through a batch process.
-func (c *Allocator) allocate(gsa *allocationv1.GameServerAllocation, stop <-chan struct{}) (*agonesv1.GameServer, error) {
+func (c *Allocator) allocate(ctx context.Context, gsa *allocationv1.GameServerAllocation, stop <-chan struct{}) (*agonesv1.GameServer, error) {
 	// creates an allocation request. This contains the requested GameServerAllocation, as well as the
-	// channel we expect the return values to come back for this GameServerAllocation
-	req := request{gsa: gsa, response: make(chan response)}
+	// channel we expect the return values to come back for this GameServerAllocation.
+	// The channel is buffered, so the batch process doesn't block on requests that have been abandoned.
+	req := request{ctx: ctx, gsa: gsa, response: make(chan response, 1)}
 
 	// this pushes the request into the batching process
-	c.pendingRequests <- req
+	select {
+	case c.pendingRequests <- req:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-stop:
+		return nil, errors.New("shutting down")
+	}
 
 	select {
 	case res := <-req.response: // wait for the batch to be completed
 		return res.gs, res.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
 	case <-stop:
 		return nil, errors.New("shutting down")
 	}
@@ -460,11 +599,18 @@ func (c *Allocator) ListenAndAllocate(updateWorkerCount int, stop <-chan struct{
 	// continued.
 
 	var list []*agonesv1.GameServer
+	var counts map[string]gameservers.NodeCount
 	requestCount := 0
 
 	for {
 		select {
 		case req := <-c.pendingRequests:
+			// don't take a game server for a request that has been abandoned
+			if err := req.ctx.Err(); err != nil {
+				req.response <- response{request: req, gs: nil, err: err}
+				continue
+			}
+
 			// refresh the list after every 100 allocations made in a single batch
 			requestCount++
 			if requestCount >= maxBatchBeforeRefresh {
@@ -474,15 +620,31 @@ func (c *Allocator) ListenAndAllocate(updateWorkerCount int, stop <-chan struct{
 
 			if list == nil {
 				list = c.readyGameServerCache.ListSortedReadyGameServers()
+				counts = c.readyGameServerCache.NodeCounts()
 			}
 
-			gs, index, err := findGameServerForAllocation(req.gsa, list)
+			key := affinityKey(req.gsa)
+			node := ""
+			if key != "" {
+				node = c.affinity.node(key, time.Now())
+			}
+
+			gs, index, selector, err := findGameServerForAllocation(req.gsa, list, counts, node)
 			if err != nil {
+				if err == ErrNoGameServerReady {
+					recordSelectorOutcome(c.loggerForGameServerAllocation(req.gsa), req.gsa, "none", "none")
+				}
 				req.response <- response{request: req, gs: nil, err: err}
 				continue
 			}
-			// remove the game server that has been allocated
+			// remove the game server that has been allocated, and count it against its node,
+			// so that Distributed allocations in the same batch spread across the nodes
 			list = append(list[:index], list[index+1:]...)
+			if count, ok := counts[gs.Status.NodeName]; ok {
+				count.Ready--
+				count.Allocated++
+				counts[gs.Status.NodeName] = count
+			}
 
 			if err := c.readyGameServerCache.RemoveFromReadyGameServer(gs); err != nil {
 				// this seems unlikely, but lets handle it just in case
@@ -490,7 +652,7 @@ func (c *Allocator) ListenAndAllocate(updateWorkerCount int, stop <-chan struct{
 				continue
 			}
 
-			updateQueue <- response{request: req, gs: gs.DeepCopy(), err: nil}
+			updateQueue <- response{request: req, gs: gs.DeepCopy(), selector: selector, err: nil}
 
 		case <-stop:
 			return
@@ -516,14 +678,30 @@ func (c *Allocator) allocationUpdateWorkers(workerCount int, stop <-chan struct{
 			for {
 				select {
 				case res := <-updateQueue:
+					// the request may have been abandoned while waiting for a worker, so give the game server back.
+					// Once the update is sent, it completes, as it can't be cancelled.
+					if err := res.request.ctx.Err(); err != nil {
+						c.readyGameServerCache.AddToReadyGameServer(res.gs)
+						res.gs = nil
+						res.err = err
+						res.request.response <- res
+						continue
+					}
+
 					gs, err := c.readyGameServerCache.PatchGameServerMetadata(res.request.gsa.Spec.MetaPatch, *res.gs)
 					if err != nil {
 						// since we could not allocate, we should put it back
-						c.readyGameServerCache.AddToReadyGameServer(gs)
+						c.readyGameServerCache.AddToReadyGameServer(res.gs)
 						res.err = errors.Wrap(err, "error updating allocated gameserver")
 					} else {
 						res.gs = gs
-						c.recorder.Event(res.gs, corev1.EventTypeNormal, string(res.gs.Status.State), "Allocated")
+						_, gsSetRef := gameServerReferences(gs)
+						c.recorder.Event(res.gs, corev1.EventTypeNormal, string(res.gs.Status.State), allocatedEventMessage(res.gs, c.fleetReference(gsSetRef)))
+						recordSelectorOutcome(c.loggerForGameServerAllocation(res.request.gsa), res.request.gsa, res.selector, gs.ObjectMeta.Labels[agonesv1.FleetNameLabel])
+						// the node is only recorded once the game server is allocated from it
+						if key := affinityKey(res.request.gsa); key != "" {
+							c.affinity.record(key, gs.Status.NodeName, time.Now())
+						}
 					}
 
 					res.request.response <- res
@@ -537,6 +715,70 @@ func (c *Allocator) allocationUpdateWorkers(workerCount int, stop <-chan struct{
 	return updateQueue
 }
 
+// gameServerReferences returns the references to the GameServer, at its current resourceVersion,
+// and to its GameServerSet, which is nil if it doesn't have one
+func gameServerReferences(gs *agonesv1.GameServer) (*corev1.ObjectReference, *corev1.ObjectReference) {
+	gsRef := &corev1.ObjectReference{
+		Kind:            "GameServer",
+		APIVersion:      agonesv1.SchemeGroupVersion.String(),
+		Namespace:       gs.ObjectMeta.Namespace,
+		Name:            gs.ObjectMeta.Name,
+		UID:             gs.ObjectMeta.UID,
+		ResourceVersion: gs.ObjectMeta.ResourceVersion,
+	}
+
+	owner := metav1.GetControllerOf(gs)
+	if owner == nil || owner.Kind != "GameServerSet" {
+		return gsRef, nil
+	}
+	return gsRef, &corev1.ObjectReference{
+		Kind:       owner.Kind,
+		APIVersion: owner.APIVersion,
+		Namespace:  gs.ObjectMeta.Namespace,
+		Name:       owner.Name,
+		UID:        owner.UID,
+	}
+}
+
+// fleetReference returns the reference to the Fleet that controls the GameServerSet, which is nil
+// if there is no GameServerSet, it isn't controlled by a Fleet, or it is no longer in the cache
+func (c *Allocator) fleetReference(gsSetRef *corev1.ObjectReference) *corev1.ObjectReference {
+	if gsSetRef == nil {
+		return nil
+	}
+	gsSet, err := c.gameServerSetLister.GameServerSets(gsSetRef.Namespace).Get(gsSetRef.Name)
+	if err != nil || gsSet.ObjectMeta.UID != gsSetRef.UID {
+		c.baseLogger.WithField("gsSet", gsSetRef.Name).WithError(err).Debug("Could not find the GameServerSet of the allocated GameServer")
+		return nil
+	}
+	owner := metav1.GetControllerOf(gsSet)
+	if owner == nil || owner.Kind != "Fleet" {
+		return nil
+	}
+	return &corev1.ObjectReference{
+		Kind:       owner.Kind,
+		APIVersion: owner.APIVersion,
+		Namespace:  gsSet.ObjectMeta.Namespace,
+		Name:       owner.Name,
+		UID:        owner.UID,
+	}
+}
+
+// allocatedEventMessage returns the message of the event of an allocated GameServer, which identifies
+// the generation of the GameServer and its owners, so the event can be matched with its allocation
+func allocatedEventMessage(gs *agonesv1.GameServer, fleetRef *corev1.ObjectReference) string {
+	msg := fmt.Sprintf("Allocated, uid: %s, resourceVersion: %s", gs.ObjectMeta.UID, gs.ObjectMeta.ResourceVersion)
+	if _, gsSetRef := gameServerReferences(gs); gsSetRef != nil {
+		msg += fmt.Sprintf(", gameServerSet: %s (uid: %s)", gsSetRef.Name, gsSetRef.UID)
+	}
+	if fleetRef != nil {
+		msg += fmt.Sprintf(", fleet: %s (uid: %s)", fleetRef.Name, fleetRef.UID)
+	} else if fleet := gs.ObjectMeta.Labels[agonesv1.FleetNameLabel]; fleet != "" {
+		msg += ", fleet: " + fleet
+	}
+	return msg
+}
+
 // Retry retries fn based on backoff provided.
 func Retry(backoff wait.Backoff, fn func() error) error {
 	var lastConflictErr error
@@ -545,7 +787,7 @@ func Retry(backoff wait.Backoff, fn func() error) error {
 		switch {
 		case err == nil:
 			return true, nil
-		case err == ErrNoGameServerReady:
+		case err == ErrNoGameServerReady, err == context.DeadlineExceeded, err == context.Canceled:
 			return true, err
 		default:
 			lastConflictErr = err
@@ -0,0 +1,131 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gameserverallocations
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// circuitBreakerFailureThreshold is the number of consecutive failed requests
+	// to a remote allocation endpoint after which it is skipped
+	circuitBreakerFailureThreshold = 3
+	// circuitBreakerMinOpenDuration is how long an endpoint is first skipped for,
+	// before a single request is let through to probe whether it has recovered
+	circuitBreakerMinOpenDuration = 10 * time.Second
+	// circuitBreakerMaxOpenDuration is the limit of how long an endpoint is skipped for,
+	// as the duration doubles every time a probe fails
+	circuitBreakerMaxOpenDuration = 5 * time.Minute
+)
+
+// endpointHealth is the recent health of the allocation endpoint of a remote cluster
+type endpointHealth struct {
+	// failures is the number of consecutive failed requests
+	failures int
+	// openUntil is when the next request can be sent, once the circuit is open
+	openUntil time.Time
+	// openDuration is how long the circuit stays open after the next failure
+	openDuration time.Duration
+	// latency is the moving average of the latency of successful requests
+	latency time.Duration
+}
+
+// remoteClusterHealth keeps track of the health and latency of the allocation endpoints of remote clusters,
+// and acts as a circuit breaker, so that allocations skip the endpoints that keep failing right away,
+// instead of waiting for a timeout on every request while their cluster is down
+type remoteClusterHealth struct {
+	mu        sync.Mutex
+	endpoints map[string]*endpointHealth
+}
+
+// newRemoteClusterHealth returns a remoteClusterHealth with every endpoint healthy
+func newRemoteClusterHealth() *remoteClusterHealth {
+	return &remoteClusterHealth{endpoints: map[string]*endpointHealth{}}
+}
+
+// endpoint returns the health of the given endpoint. Must be called with the lock held.
+func (h *remoteClusterHealth) endpoint(endpoint string) *endpointHealth {
+	e, ok := h.endpoints[endpoint]
+	if !ok {
+		e = &endpointHealth{}
+		h.endpoints[endpoint] = e
+	}
+	return e
+}
+
+// allow returns true if a request can be sent to the endpoint at the given time.
+// Once the circuit of an open endpoint expires, a single request is allowed to probe it,
+// and the others are skipped until the probe either succeeds, or fails and opens the circuit again.
+func (h *remoteClusterHealth) allow(endpoint string, now time.Time) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	e := h.endpoint(endpoint)
+	if e.failures < circuitBreakerFailureThreshold {
+		return true
+	}
+	if now.Before(e.openUntil) {
+		return false
+	}
+	e.openUntil = now.Add(e.openDuration)
+	return true
+}
+
+// success records a successful request to the endpoint, which closes its circuit
+func (h *remoteClusterHealth) success(endpoint string, latency time.Duration) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	e := h.endpoint(endpoint)
+	e.failures = 0
+	e.openUntil = time.Time{}
+	e.openDuration = 0
+	if e.latency == 0 {
+		e.latency = latency
+	} else {
+		e.latency = (7*e.latency + latency) / 8
+	}
+}
+
+// failure records a failed request to the endpoint at the given time, and opens its circuit
+// once there are enough consecutive failures, for twice as long as before if a probe failed
+func (h *remoteClusterHealth) failure(endpoint string, now time.Time) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	e := h.endpoint(endpoint)
+	e.failures++
+	if e.failures < circuitBreakerFailureThreshold {
+		return
+	}
+	switch {
+	case e.openDuration == 0:
+		e.openDuration = circuitBreakerMinOpenDuration
+	case e.failures > circuitBreakerFailureThreshold:
+		e.openDuration *= 2
+		if e.openDuration > circuitBreakerMaxOpenDuration {
+			e.openDuration = circuitBreakerMaxOpenDuration
+		}
+	}
+	e.openUntil = now.Add(e.openDuration)
+}
+
+// latency returns the moving average of the latency of the successful requests to the endpoint
+func (h *remoteClusterHealth) latency(endpoint string) time.Duration {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.endpoint(endpoint).latency
+}
@@ -0,0 +1,100 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gameserverallocations
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRemoteClusterHealth(t *testing.T) {
+	t.Parallel()
+
+	t.Run("opens after consecutive failures", func(t *testing.T) {
+		h := newRemoteClusterHealth()
+		now := time.Now()
+
+		for i := 0; i < circuitBreakerFailureThreshold-1; i++ {
+			assert.True(t, h.allow("a", now))
+			h.failure("a", now)
+		}
+		assert.True(t, h.allow("a", now))
+		h.failure("a", now)
+		assert.False(t, h.allow("a", now))
+		assert.False(t, h.allow("a", now.Add(circuitBreakerMinOpenDuration-time.Second)))
+		// other endpoints are not affected
+		assert.True(t, h.allow("b", now))
+	})
+
+	t.Run("success resets the failures", func(t *testing.T) {
+		h := newRemoteClusterHealth()
+		now := time.Now()
+
+		for i := 0; i < circuitBreakerFailureThreshold-1; i++ {
+			h.failure("a", now)
+		}
+		h.success("a", time.Millisecond)
+		h.failure("a", now)
+		assert.True(t, h.allow("a", now))
+	})
+
+	t.Run("half open probe", func(t *testing.T) {
+		h := newRemoteClusterHealth()
+		now := time.Now()
+
+		for i := 0; i < circuitBreakerFailureThreshold; i++ {
+			h.failure("a", now)
+		}
+
+		// a single probe is allowed once the circuit expires
+		now = now.Add(circuitBreakerMinOpenDuration)
+		assert.True(t, h.allow("a", now))
+		assert.False(t, h.allow("a", now))
+
+		// a failed probe opens the circuit for twice as long
+		h.failure("a", now)
+		assert.False(t, h.allow("a", now.Add(2*circuitBreakerMinOpenDuration-time.Second)))
+		now = now.Add(2 * circuitBreakerMinOpenDuration)
+		assert.True(t, h.allow("a", now))
+
+		// a successful probe closes the circuit
+		h.success("a", time.Millisecond)
+		assert.True(t, h.allow("a", now))
+		assert.True(t, h.allow("a", now))
+	})
+
+	t.Run("open duration is limited", func(t *testing.T) {
+		h := newRemoteClusterHealth()
+		now := time.Now()
+
+		for i := 0; i < circuitBreakerFailureThreshold+20; i++ {
+			h.failure("a", now)
+		}
+		assert.False(t, h.allow("a", now.Add(circuitBreakerMaxOpenDuration-time.Second)))
+		assert.True(t, h.allow("a", now.Add(circuitBreakerMaxOpenDuration)))
+	})
+
+	t.Run("latency", func(t *testing.T) {
+		h := newRemoteClusterHealth()
+		assert.Equal(t, time.Duration(0), h.latency("a"))
+
+		h.success("a", 80*time.Millisecond)
+		assert.Equal(t, 80*time.Millisecond, h.latency("a"))
+		h.success("a", 160*time.Millisecond)
+		assert.Equal(t, 90*time.Millisecond, h.latency("a"))
+	})
+}
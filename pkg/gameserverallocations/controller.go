@@ -16,8 +16,8 @@ package gameserverallocations
 
 import (
 	"context"
-	"io/ioutil"
 	"net/http"
+	"strconv"
 	"time"
 
 	allocationv1 "agones.dev/agones/pkg/apis/allocation/v1"
@@ -39,6 +39,7 @@ import (
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/scheme"
 	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
 )
 
@@ -54,6 +55,7 @@ type Controller struct {
 func NewController(apiServer *apiserver.APIServer,
 	health healthcheck.Handler,
 	counter *gameservers.PerNodeCounter,
+	portAllocatorSynced cache.InformerSynced,
 	kubeClient kubernetes.Interface,
 	kubeInformerFactory informers.SharedInformerFactory,
 	agonesClient versioned.Interface,
@@ -64,10 +66,13 @@ func NewController(apiServer *apiserver.APIServer,
 		allocator: NewAllocator(
 			agonesInformerFactory.Multicluster().V1alpha1().GameServerAllocationPolicies(),
 			kubeInformerFactory.Core().V1().Secrets(),
+			agonesInformerFactory.Agones().V1().GameServerSets(),
 			kubeClient,
-			NewReadyGameServerCache(agonesInformerFactory.Agones().V1().GameServers(), agonesClient.AgonesV1(), counter, health)),
+			NewReadyGameServerCache(agonesInformerFactory.Agones().V1().GameServers(), agonesClient.AgonesV1(), counter, health),
+			portAllocatorSynced),
 	}
 	c.baseLogger = runtime.NewLoggerWithType(c)
+	health.AddReadinessCheck("gameserverallocation-allocator", c.allocator.Ready)
 
 	eventBroadcaster := record.NewBroadcaster()
 	eventBroadcaster.StartLogging(c.baseLogger.Infof)
@@ -95,15 +100,13 @@ func (c *Controller) registerAPIResource(stop <-chan struct{}) {
 }
 
 // Run runs this controller. Will block until stop is closed.
-// Ignores threadiness, as we only needs 1 worker for cache sync
+// Ignores threadiness, as we only needs 1 worker for cache sync.
+// The api resource is registered before the caches are synced, so that
+// early requests are rejected with a Retry-After rather than not being found
 func (c *Controller) Run(_ int, stop <-chan struct{}) error {
-	if err := c.allocator.Start(stop); err != nil {
-		return err
-	}
-
 	c.registerAPIResource(stop)
 
-	return nil
+	return c.allocator.Start(stop)
 }
 
 func (c *Controller) processAllocationRequest(w http.ResponseWriter, r *http.Request, namespace string, stop <-chan struct{}) (err error) {
@@ -128,6 +131,21 @@ func (c *Controller) processAllocationRequest(w http.ResponseWriter, r *http.Req
 		return
 	}
 
+	// like the Kubernetes API, a timeout for the request can be set with the timeout query parameter, e.g. ?timeout=500ms
+	ctx := r.Context()
+	if timeout := r.URL.Query().Get("timeout"); timeout != "" {
+		d, err := time.ParseDuration(timeout)
+		if err != nil || d <= 0 {
+			log.WithField("timeout", timeout).Warn("invalid allocation timeout")
+			http.Error(w, "timeout must be a positive duration, e.g. 500ms", http.StatusBadRequest)
+			latency.setError()
+			return nil
+		}
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, d)
+		defer cancel()
+	}
+
 	gsa, err := c.allocationDeserialization(r, namespace)
 	if err != nil {
 		return err
@@ -135,11 +153,14 @@ func (c *Controller) processAllocationRequest(w http.ResponseWriter, r *http.Req
 
 	latency.setRequest(gsa)
 
-	result, err := c.allocator.Allocate(gsa, stop)
+	result, err := c.allocator.Allocate(ctx, gsa, stop)
 	if err != nil {
 		return err
 	}
 	if status, ok := result.(*metav1.Status); ok {
+		if status.Details != nil && status.Details.RetryAfterSeconds > 0 {
+			w.Header().Set("Retry-After", strconv.Itoa(int(status.Details.RetryAfterSeconds)))
+		}
 		w.WriteHeader(int(status.Code))
 	}
 
@@ -174,22 +195,9 @@ func (c *Controller) allocationDeserialization(r *http.Request, namespace string
 
 	gsa.TypeMeta = metav1.TypeMeta{Kind: gvks[0].Kind, APIVersion: gvks[0].Version}
 
-	mediaTypes := scheme.Codecs.SupportedMediaTypes()
-	info, ok := k8sruntime.SerializerInfoForMediaType(mediaTypes, r.Header.Get("Content-Type"))
-	if !ok {
-		return gsa, errors.New("Could not find deserializer")
-	}
-
-	b, err := ioutil.ReadAll(r.Body)
-	if err != nil {
-		return gsa, errors.Wrap(err, "could not read body")
-	}
-
 	gvk := allocationv1.SchemeGroupVersion.WithKind("GameServerAllocation")
-	_, _, err = info.Serializer.Decode(b, &gvk, gsa)
-	if err != nil {
-		c.baseLogger.WithField("body", string(b)).Error("error decoding body")
-		return gsa, errors.Wrap(err, "error decoding body")
+	if err := apiserver.DecodeRequest(r, scheme.Codecs, gvk, gsa, c.baseLogger); err != nil {
+		return gsa, err
 	}
 
 	gsa.ObjectMeta.Namespace = namespace
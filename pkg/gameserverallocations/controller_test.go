@@ -16,6 +16,7 @@ package gameserverallocations
 
 import (
 	"bytes"
+	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/json"
@@ -41,6 +42,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8sruntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -116,6 +118,177 @@ func TestControllerAllocator(t *testing.T) {
 		test(gsa.DeepCopy(), allocationv1.GameServerAllocationUnAllocated)
 	})
 
+	t.Run("allocation visible annotations", func(t *testing.T) {
+		f, _, gsList := defaultFixtures(1)
+		gsList[0].ObjectMeta.Annotations = map[string]string{
+			agonesv1.AllocationVisibleAnnotation: "map, build-hash",
+			"map":                                "dust2",
+			"build-hash":                         "a1b2c3",
+			"secret":                             "hidden",
+		}
+
+		c, m := newFakeController()
+		gsWatch := watch.NewFake()
+		m.AgonesClient.AddWatchReactor("gameservers", k8stesting.DefaultWatchReactor(gsWatch, nil))
+		m.AgonesClient.AddReactor("list", "gameservers", func(action k8stesting.Action) (bool, k8sruntime.Object, error) {
+			return true, &agonesv1.GameServerList{Items: gsList}, nil
+		})
+		m.AgonesClient.AddReactor("update", "gameservers", func(action k8stesting.Action) (bool, k8sruntime.Object, error) {
+			gs := action.(k8stesting.UpdateAction).GetObject().(*agonesv1.GameServer)
+			gsWatch.Modify(gs)
+			return true, gs, nil
+		})
+
+		stop, cancel := agtesting.StartInformers(m)
+		defer cancel()
+
+		if err := c.Run(1, stop); err != nil {
+			assert.FailNow(t, err.Error())
+		}
+		err := wait.PollImmediate(time.Second, 10*time.Second, func() (done bool, err error) {
+			return c.allocator.readyGameServerCache.workerqueue.RunCount() == 1, nil
+		})
+		assert.NoError(t, err)
+
+		gsa := &allocationv1.GameServerAllocation{
+			ObjectMeta: metav1.ObjectMeta{Namespace: defaultNs},
+			Spec: allocationv1.GameServerAllocationSpec{
+				Required: metav1.LabelSelector{MatchLabels: map[string]string{agonesv1.FleetNameLabel: f.ObjectMeta.Name}},
+			}}
+		ret, err := executeAllocation(gsa, c)
+		assert.NoError(t, err)
+		assert.Equal(t, allocationv1.GameServerAllocationAllocated, ret.Status.State)
+		assert.Equal(t, map[string]string{"map": "dust2", "build-hash": "a1b2c3"}, ret.Status.Annotations)
+	})
+
+	t.Run("object references", func(t *testing.T) {
+		f, gsSet, gsList := defaultFixtures(1)
+		gsList[0].ObjectMeta.Namespace = defaultNs
+		gsList[0].ObjectMeta.UID = "gs-uid"
+		gsList[0].ObjectMeta.ResourceVersion = "1"
+		gsList[0].ObjectMeta.OwnerReferences[0].UID = "gsset-uid"
+		gsSet.ObjectMeta.UID = "gsset-uid"
+
+		c, m := newFakeController()
+		m.AgonesClient.AddReactor("list", "gameserversets", func(action k8stesting.Action) (bool, k8sruntime.Object, error) {
+			return true, &agonesv1.GameServerSetList{Items: []agonesv1.GameServerSet{*gsSet}}, nil
+		})
+		gsWatch := watch.NewFake()
+		m.AgonesClient.AddWatchReactor("gameservers", k8stesting.DefaultWatchReactor(gsWatch, nil))
+		m.AgonesClient.AddReactor("list", "gameservers", func(action k8stesting.Action) (bool, k8sruntime.Object, error) {
+			return true, &agonesv1.GameServerList{Items: gsList}, nil
+		})
+		m.AgonesClient.AddReactor("update", "gameservers", func(action k8stesting.Action) (bool, k8sruntime.Object, error) {
+			gs := action.(k8stesting.UpdateAction).GetObject().(*agonesv1.GameServer)
+			gs.ObjectMeta.ResourceVersion = "2"
+			gsWatch.Modify(gs)
+			return true, gs, nil
+		})
+
+		stop, cancel := agtesting.StartInformers(m)
+		defer cancel()
+
+		if err := c.Run(1, stop); err != nil {
+			assert.FailNow(t, err.Error())
+		}
+		err := wait.PollImmediate(time.Second, 10*time.Second, func() (done bool, err error) {
+			return c.allocator.readyGameServerCache.workerqueue.RunCount() == 1, nil
+		})
+		assert.NoError(t, err)
+
+		gsa := &allocationv1.GameServerAllocation{
+			ObjectMeta: metav1.ObjectMeta{Namespace: defaultNs},
+			Spec: allocationv1.GameServerAllocationSpec{
+				Required: metav1.LabelSelector{MatchLabels: map[string]string{agonesv1.FleetNameLabel: f.ObjectMeta.Name}},
+			}}
+		ret, err := executeAllocation(gsa, c)
+		assert.NoError(t, err)
+		assert.Equal(t, allocationv1.GameServerAllocationAllocated, ret.Status.State)
+		assert.Equal(t, &corev1.ObjectReference{Kind: "GameServer", APIVersion: "agones.dev/v1", Namespace: defaultNs,
+			Name: gsList[0].ObjectMeta.Name, UID: "gs-uid", ResourceVersion: "2"}, ret.Status.GameServer)
+		assert.Equal(t, &corev1.ObjectReference{Kind: "GameServerSet", APIVersion: "agones.dev/v1", Namespace: defaultNs,
+			Name: gsSet.ObjectMeta.Name, UID: "gsset-uid"}, ret.Status.GameServerSet)
+		assert.Equal(t, &corev1.ObjectReference{Kind: "Fleet", APIVersion: "agones.dev/v1", Namespace: defaultNs,
+			Name: f.ObjectMeta.Name, UID: f.ObjectMeta.UID}, ret.Status.Fleet)
+		assert.Equal(t, f.ObjectMeta.Name, ret.Status.FleetName)
+		agtesting.AssertEventContains(t, m.FakeRecorder.Events,
+			"Allocated, uid: gs-uid, resourceVersion: 2, gameServerSet: gsSet1 (uid: gsset-uid), fleet: fleet-1 (uid: 1234)")
+	})
+
+	t.Run("standalone game server references", func(t *testing.T) {
+		gs := &agonesv1.GameServer{ObjectMeta: metav1.ObjectMeta{Name: "gs", Namespace: defaultNs, UID: "uid", ResourceVersion: "3"}}
+		gsRef, gsSetRef := gameServerReferences(gs)
+		assert.Equal(t, &corev1.ObjectReference{Kind: "GameServer", APIVersion: "agones.dev/v1", Namespace: defaultNs,
+			Name: "gs", UID: "uid", ResourceVersion: "3"}, gsRef)
+		assert.Nil(t, gsSetRef)
+		c, _ := newFakeController()
+		assert.Nil(t, c.allocator.fleetReference(gsSetRef))
+		assert.Equal(t, "Allocated, uid: uid, resourceVersion: 3", allocatedEventMessage(gs, nil))
+	})
+
+	t.Run("allocation timeout", func(t *testing.T) {
+		f, _, gsList := defaultFixtures(1)
+
+		c, m := newFakeController()
+		gsWatch := watch.NewFake()
+		m.AgonesClient.AddWatchReactor("gameservers", k8stesting.DefaultWatchReactor(gsWatch, nil))
+		m.AgonesClient.AddReactor("list", "gameservers", func(action k8stesting.Action) (bool, k8sruntime.Object, error) {
+			return true, &agonesv1.GameServerList{Items: gsList}, nil
+		})
+		updated := false
+		m.AgonesClient.AddReactor("update", "gameservers", func(action k8stesting.Action) (bool, k8sruntime.Object, error) {
+			updated = true
+			gs := action.(k8stesting.UpdateAction).GetObject().(*agonesv1.GameServer)
+			gsWatch.Modify(gs)
+			return true, gs, nil
+		})
+
+		stop, cancel := agtesting.StartInformers(m)
+		defer cancel()
+
+		if err := c.Run(1, stop); err != nil {
+			assert.FailNow(t, err.Error())
+		}
+		err := wait.PollImmediate(time.Second, 10*time.Second, func() (done bool, err error) {
+			return c.allocator.readyGameServerCache.workerqueue.RunCount() == 1, nil
+		})
+		assert.NoError(t, err)
+
+		gsa := &allocationv1.GameServerAllocation{
+			ObjectMeta: metav1.ObjectMeta{Namespace: defaultNs},
+			Spec: allocationv1.GameServerAllocationSpec{
+				Required: metav1.LabelSelector{MatchLabels: map[string]string{agonesv1.FleetNameLabel: f.ObjectMeta.Name}},
+			}}
+
+		request := func(timeout string) *httptest.ResponseRecorder {
+			r, err := createRequest(gsa.DeepCopy())
+			assert.NoError(t, err)
+			r.URL.RawQuery = "timeout=" + timeout
+			rec := httptest.NewRecorder()
+			err = c.processAllocationRequest(rec, r, defaultNs, stop)
+			assert.NoError(t, err)
+			return rec
+		}
+
+		rec := request("invalid")
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+		rec = request("1ns")
+		assert.Equal(t, http.StatusGatewayTimeout, rec.Code)
+		s := &metav1.Status{}
+		err = json.NewDecoder(rec.Body).Decode(s)
+		assert.NoError(t, err)
+		assert.Equal(t, metav1.StatusReasonTimeout, s.Reason)
+		assert.False(t, updated, "a timed out allocation should not allocate a game server")
+
+		rec = request("10s")
+		assert.Equal(t, http.StatusOK, rec.Code)
+		ret := &allocationv1.GameServerAllocation{}
+		err = json.Unmarshal(rec.Body.Bytes(), ret)
+		assert.NoError(t, err)
+		assert.Equal(t, allocationv1.GameServerAllocationAllocated, ret.Status.State)
+	})
+
 	t.Run("method not allowed", func(t *testing.T) {
 		c, _ := newFakeController()
 		r, err := http.NewRequest(http.MethodGet, "/", nil)
@@ -152,6 +325,89 @@ func TestControllerAllocator(t *testing.T) {
 
 		assert.Equal(t, metav1.StatusReasonInvalid, s.Reason)
 	})
+
+	t.Run("allocator not ready", func(t *testing.T) {
+		c, _ := newFakeController()
+		gsa := &allocationv1.GameServerAllocation{
+			Spec: allocationv1.GameServerAllocationSpec{
+				Required: metav1.LabelSelector{MatchLabels: map[string]string{agonesv1.FleetNameLabel: "fleet"}},
+			}}
+		buf := bytes.NewBuffer(nil)
+		err := json.NewEncoder(buf).Encode(gsa)
+		assert.NoError(t, err)
+		r, err := http.NewRequest(http.MethodPost, "/", buf)
+		r.Header.Set("Content-Type", k8sruntime.ContentTypeJSON)
+		assert.NoError(t, err)
+		rec := httptest.NewRecorder()
+		err = c.processAllocationRequest(rec, r, "default", stop)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+		assert.Equal(t, "5", rec.Header().Get("Retry-After"))
+
+		s := &metav1.Status{}
+		err = json.NewDecoder(rec.Body).Decode(s)
+		assert.NoError(t, err)
+
+		assert.Equal(t, metav1.StatusReasonServiceUnavailable, s.Reason)
+		assert.Equal(t, ErrAllocatorNotReady.Error(), s.Message)
+	})
+}
+
+func TestControllerAllocateAffinity(t *testing.T) {
+	t.Parallel()
+
+	f, _, gsList := defaultFixtures(2)
+	for i := range gsList {
+		gsList[i].Status.NodeName = "node1"
+	}
+	c, m := newFakeController()
+
+	m.AgonesClient.AddReactor("list", "gameservers", func(action k8stesting.Action) (bool, k8sruntime.Object, error) {
+		return true, &agonesv1.GameServerList{Items: gsList}, nil
+	})
+
+	failUpdate := true
+	gsWatch := watch.NewFake()
+	m.AgonesClient.AddWatchReactor("gameservers", k8stesting.DefaultWatchReactor(gsWatch, nil))
+	m.AgonesClient.AddReactor("update", "gameservers", func(action k8stesting.Action) (bool, k8sruntime.Object, error) {
+		if failUpdate {
+			return true, nil, k8serrors.NewConflict(agonesv1.Resource("gameservers"), "gs", errors.New("conflict"))
+		}
+		gs := action.(k8stesting.UpdateAction).GetObject().(*agonesv1.GameServer)
+		gsWatch.Modify(gs)
+		return true, gs, nil
+	})
+
+	stop, cancel := agtesting.StartInformers(m)
+	defer cancel()
+
+	if err := c.Run(1, stop); err != nil {
+		assert.FailNow(t, err.Error())
+	}
+	err := wait.PollImmediate(time.Second, 10*time.Second, func() (done bool, err error) {
+		return c.allocator.readyGameServerCache.workerqueue.RunCount() == 1, nil
+	})
+	assert.NoError(t, err)
+
+	gsa := allocationv1.GameServerAllocation{ObjectMeta: metav1.ObjectMeta{Name: "gsa-1", Namespace: defaultNs},
+		Spec: allocationv1.GameServerAllocationSpec{
+			Required:    metav1.LabelSelector{MatchLabels: map[string]string{agonesv1.FleetNameLabel: f.ObjectMeta.Name}},
+			AffinityKey: "party",
+		}}
+	gsa.ApplyDefaults()
+
+	// the node is not recorded when the game server could not be allocated
+	_, err = c.allocator.allocate(context.Background(), &gsa, stop)
+	assert.Error(t, err)
+	assert.Equal(t, "", c.allocator.affinity.node(affinityKey(&gsa), time.Now()))
+
+	failUpdate = false
+	gs, err := c.allocator.allocate(context.Background(), &gsa, stop)
+	if assert.NoError(t, err) {
+		assert.Equal(t, agonesv1.GameServerStateAllocated, gs.Status.State)
+	}
+	assert.Equal(t, "node1", c.allocator.affinity.node(affinityKey(&gsa), time.Now()))
 }
 
 func TestControllerAllocate(t *testing.T) {
@@ -179,6 +435,8 @@ func TestControllerAllocate(t *testing.T) {
 
 		updated = true
 		assert.Equal(t, agonesv1.GameServerStateAllocated, gs.Status.State)
+		assert.Equal(t, string(agonesv1.GameServerStateAllocated), gs.ObjectMeta.Labels[agonesv1.GameServerStateLabel])
+		assert.Equal(t, "true", gs.ObjectMeta.Annotations[agonesv1.GameServerAllocatedAnnotation])
 		gsWatch.Modify(gs)
 
 		return true, gs, nil
@@ -203,7 +461,7 @@ func TestControllerAllocate(t *testing.T) {
 		}}
 	gsa.ApplyDefaults()
 
-	gs, err := c.allocator.allocate(&gsa, stop)
+	gs, err := c.allocator.allocate(context.Background(), &gsa, stop)
 	assert.Nil(t, err)
 	assert.Equal(t, agonesv1.GameServerStateAllocated, gs.Status.State)
 	assert.True(t, updated)
@@ -219,19 +477,19 @@ func TestControllerAllocate(t *testing.T) {
 	}
 
 	updated = false
-	gs, err = c.allocator.allocate(&gsa, stop)
+	gs, err = c.allocator.allocate(context.Background(), &gsa, stop)
 	assert.Nil(t, err)
 	assert.Equal(t, agonesv1.GameServerStateAllocated, gs.Status.State)
 	assert.True(t, updated)
 
 	updated = false
-	gs, err = c.allocator.allocate(&gsa, stop)
+	gs, err = c.allocator.allocate(context.Background(), &gsa, stop)
 	assert.Nil(t, err)
 	assert.Equal(t, agonesv1.GameServerStateAllocated, gs.Status.State)
 	assert.True(t, updated)
 
 	updated = false
-	_, err = c.allocator.allocate(&gsa, stop)
+	_, err = c.allocator.allocate(context.Background(), &gsa, stop)
 	assert.NotNil(t, err)
 	assert.Equal(t, ErrNoGameServerReady, err)
 	assert.False(t, updated)
@@ -289,27 +547,27 @@ func TestControllerAllocatePriority(t *testing.T) {
 
 	run(t, "packed", func(t *testing.T, c *Controller, gas *allocationv1.GameServerAllocation) {
 		// priority should be node1, then node2
-		gs1, err := c.allocator.allocate(gas, stop)
+		gs1, err := c.allocator.allocate(context.Background(), gas, stop)
 		assert.NoError(t, err)
 		assert.Equal(t, n1, gs1.Status.NodeName)
 
-		gs2, err := c.allocator.allocate(gas, stop)
+		gs2, err := c.allocator.allocate(context.Background(), gas, stop)
 		assert.NoError(t, err)
 		assert.Equal(t, n1, gs2.Status.NodeName)
 		assert.NotEqual(t, gs1.ObjectMeta.Name, gs2.ObjectMeta.Name)
 
-		gs3, err := c.allocator.allocate(gas, stop)
+		gs3, err := c.allocator.allocate(context.Background(), gas, stop)
 		assert.NoError(t, err)
 		assert.Equal(t, n1, gs3.Status.NodeName)
 		assert.NotContains(t, []string{gs1.ObjectMeta.Name, gs2.ObjectMeta.Name}, gs3.ObjectMeta.Name)
 
-		gs4, err := c.allocator.allocate(gas, stop)
+		gs4, err := c.allocator.allocate(context.Background(), gas, stop)
 		assert.NoError(t, err)
 		assert.Equal(t, n2, gs4.Status.NodeName)
 		assert.NotContains(t, []string{gs1.ObjectMeta.Name, gs2.ObjectMeta.Name, gs3.ObjectMeta.Name}, gs4.ObjectMeta.Name)
 
 		// should have none left
-		_, err = c.allocator.allocate(gas, stop)
+		_, err = c.allocator.allocate(context.Background(), gas, stop)
 		assert.Equal(t, err, ErrNoGameServerReady)
 	})
 
@@ -320,23 +578,23 @@ func TestControllerAllocatePriority(t *testing.T) {
 
 		// distributed is randomised, so no set pattern
 
-		gs1, err := c.allocator.allocate(gas, stop)
+		gs1, err := c.allocator.allocate(context.Background(), gas, stop)
 		assert.NoError(t, err)
 
-		gs2, err := c.allocator.allocate(gas, stop)
+		gs2, err := c.allocator.allocate(context.Background(), gas, stop)
 		assert.NoError(t, err)
 		assert.NotEqual(t, gs1.ObjectMeta.Name, gs2.ObjectMeta.Name)
 
-		gs3, err := c.allocator.allocate(gas, stop)
+		gs3, err := c.allocator.allocate(context.Background(), gas, stop)
 		assert.NoError(t, err)
 		assert.NotContains(t, []string{gs1.ObjectMeta.Name, gs2.ObjectMeta.Name}, gs3.ObjectMeta.Name)
 
-		gs4, err := c.allocator.allocate(gas, stop)
+		gs4, err := c.allocator.allocate(context.Background(), gas, stop)
 		assert.NoError(t, err)
 		assert.NotContains(t, []string{gs1.ObjectMeta.Name, gs2.ObjectMeta.Name, gs3.ObjectMeta.Name}, gs4.ObjectMeta.Name)
 
 		// should have none left
-		_, err = c.allocator.allocate(gas, stop)
+		_, err = c.allocator.allocate(context.Background(), gas, stop)
 		assert.Equal(t, err, ErrNoGameServerReady)
 	})
 }
@@ -382,11 +640,11 @@ func TestControllerRunLocalAllocations(t *testing.T) {
 		gsa.ApplyDefaults()
 
 		// line up 3 in a batch
-		j1 := request{gsa: gsa.DeepCopy(), response: make(chan response)}
+		j1 := request{ctx: context.Background(), gsa: gsa.DeepCopy(), response: make(chan response)}
 		c.allocator.pendingRequests <- j1
-		j2 := request{gsa: gsa.DeepCopy(), response: make(chan response)}
+		j2 := request{ctx: context.Background(), gsa: gsa.DeepCopy(), response: make(chan response)}
 		c.allocator.pendingRequests <- j2
-		j3 := request{gsa: gsa.DeepCopy(), response: make(chan response)}
+		j3 := request{ctx: context.Background(), gsa: gsa.DeepCopy(), response: make(chan response)}
 		c.allocator.pendingRequests <- j3
 
 		go c.allocator.ListenAndAllocate(3, stop)
@@ -436,7 +694,7 @@ func TestControllerRunLocalAllocations(t *testing.T) {
 			}}
 		gsa.ApplyDefaults()
 
-		j1 := request{gsa: gsa.DeepCopy(), response: make(chan response)}
+		j1 := request{ctx: context.Background(), gsa: gsa.DeepCopy(), response: make(chan response)}
 		c.allocator.pendingRequests <- j1
 
 		go c.allocator.ListenAndAllocate(3, stop)
@@ -604,6 +862,7 @@ func TestControllerAllocationUpdateWorkers(t *testing.T) {
 		}
 		r := response{
 			request: request{
+				ctx:      context.Background(),
 				gsa:      &allocationv1.GameServerAllocation{},
 				response: make(chan response),
 			},
@@ -643,6 +902,7 @@ func TestControllerAllocationUpdateWorkers(t *testing.T) {
 		}
 		r = response{
 			request: request{
+				ctx:      context.Background(),
 				gsa:      &allocationv1.GameServerAllocation{},
 				response: make(chan response),
 			},
@@ -678,6 +938,7 @@ func TestControllerAllocationUpdateWorkers(t *testing.T) {
 
 		r := response{
 			request: request{
+				ctx:      context.Background(),
 				gsa:      &allocationv1.GameServerAllocation{},
 				response: make(chan response),
 			},
@@ -1062,6 +1323,89 @@ func TestMultiClusterAllocationFromRemote(t *testing.T) {
 		assert.Contains(t, err.Error(), "test error message")
 	})
 
+	t.Run("Unhealthy remote server is skipped", func(t *testing.T) {
+		c, m := newFakeController()
+		fleetName := addReactorForGameServer(&m)
+
+		// Mock server to return error
+		requests := 0
+		server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			http.Error(w, "test error message", 500)
+		}))
+		defer server.Close()
+		serverURL := parseURL(t, server.URL)
+
+		// Set client CA for server
+		certpool := x509.NewCertPool()
+		certpool.AppendCertsFromPEM(clientCert)
+		server.TLS.ClientCAs = certpool
+		server.TLS.ClientAuth = tls.RequireAndVerifyClientCert
+
+		// Allocation policy reactor
+		secretName := clusterName + "secret"
+		m.AgonesClient.AddReactor("list", "gameserverallocationpolicies", func(action k8stesting.Action) (bool, k8sruntime.Object, error) {
+			return true, &multiclusterv1alpha1.GameServerAllocationPolicyList{
+				Items: []multiclusterv1alpha1.GameServerAllocationPolicy{
+					{
+						Spec: multiclusterv1alpha1.GameServerAllocationPolicySpec{
+							Priority: 1,
+							Weight:   200,
+							ConnectionInfo: multiclusterv1alpha1.ClusterConnectionInfo{
+								AllocationEndpoints: []string{serverURL.Host},
+								ClusterName:         clusterName,
+								SecretName:          secretName,
+							},
+						},
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: defaultNs,
+						},
+					},
+				},
+			}, nil
+		})
+
+		m.KubeClient.AddReactor("list", "secrets",
+			func(action k8stesting.Action) (bool, k8sruntime.Object, error) {
+				return true, getTestSecret(secretName, getPEMFromDER(server.TLS.Certificates[0].Certificate[0])), nil
+			})
+
+		stop, cancel := agtesting.StartInformers(m, c.allocator.allocationPolicySynced, c.allocator.secretSynced, c.allocator.readyGameServerCache.gameServerSynced)
+		defer cancel()
+
+		// This call initializes the cache
+		err := c.allocator.readyGameServerCache.syncReadyGSServerCache()
+		assert.Nil(t, err)
+
+		err = c.allocator.readyGameServerCache.counter.Run(0, stop)
+		assert.Nil(t, err)
+
+		gsa := &allocationv1.GameServerAllocation{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   defaultNs,
+				Name:        "alloc1",
+				ClusterName: "localcluster",
+			},
+			Spec: allocationv1.GameServerAllocationSpec{
+				MultiClusterSetting: allocationv1.MultiClusterSetting{
+					Enabled: true,
+				},
+				Required: metav1.LabelSelector{MatchLabels: map[string]string{agonesv1.FleetNameLabel: fleetName}},
+			},
+		}
+
+		for i := 0; i < circuitBreakerFailureThreshold; i++ {
+			_, err = executeAllocation(gsa, c)
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), "test error message")
+		}
+
+		_, err = executeAllocation(gsa, c)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "unhealthy")
+		assert.Equal(t, circuitBreakerFailureThreshold, requests)
+	})
+
 	t.Run("First server fails and second server succeeds", func(t *testing.T) {
 		c, m := newFakeController()
 		fleetName := addReactorForGameServer(&m)
@@ -1094,6 +1438,12 @@ func TestMultiClusterAllocationFromRemote(t *testing.T) {
 		healthyServerURL := parseURL(t, healthyServer.URL)
 		healthyServer.TLS = unhealthyServer.TLS
 
+		// an endpoint whose circuit has expired, and should keep its probe as it is never reached
+		unreachedEndpoint := "unreached.invalid:443"
+		for i := 0; i < circuitBreakerFailureThreshold; i++ {
+			c.allocator.remoteClusters.failure(unreachedEndpoint, time.Now().Add(-circuitBreakerMinOpenDuration))
+		}
+
 		// Allocation policy reactor
 		secretName := clusterName + "secret"
 		m.AgonesClient.AddReactor("list", "gameserverallocationpolicies", func(action k8stesting.Action) (bool, k8sruntime.Object, error) {
@@ -1104,7 +1454,7 @@ func TestMultiClusterAllocationFromRemote(t *testing.T) {
 							Priority: 1,
 							Weight:   200,
 							ConnectionInfo: multiclusterv1alpha1.ClusterConnectionInfo{
-								AllocationEndpoints: []string{unhealthyServerURL.Host, healthyServerURL.Host},
+								AllocationEndpoints: []string{unhealthyServerURL.Host, healthyServerURL.Host, unreachedEndpoint},
 								ClusterName:         clusterName,
 								SecretName:          secretName,
 							},
@@ -1150,6 +1500,116 @@ func TestMultiClusterAllocationFromRemote(t *testing.T) {
 		if assert.NoError(t, err) {
 			assert.Equal(t, expectedGSAName, result.ObjectMeta.Name)
 		}
+		assert.True(t, c.allocator.remoteClusters.allow(unreachedEndpoint, time.Now()))
+	})
+
+	t.Run("No ready gameservers locally, so forward to remote cluster", func(t *testing.T) {
+		c, m := newFakeController()
+		fleetName := addReactorForGameServer(&m)
+
+		// Mock server
+		expectedGSAName := "mocked"
+		server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var request allocationv1.GameServerAllocation
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+			assert.False(t, request.Spec.MultiClusterSetting.Enabled)
+
+			serverResponse := allocationv1.GameServerAllocation{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: expectedGSAName,
+				},
+				Status: allocationv1.GameServerAllocationStatus{
+					State:          allocationv1.GameServerAllocationAllocated,
+					GameServerName: expectedGSAName,
+				},
+			}
+			response, _ := json.Marshal(serverResponse)
+			_, _ = w.Write(response)
+		}))
+		defer server.Close()
+		serverURL := parseURL(t, server.URL)
+
+		// Set client CA for server
+		certpool := x509.NewCertPool()
+		certpool.AppendCertsFromPEM(clientCert)
+		server.TLS.ClientCAs = certpool
+		server.TLS.ClientAuth = tls.RequireAndVerifyClientCert
+
+		// Allocation policy reactor, with the local cluster, that has no gameservers in
+		// the targeted namespace, preferred over the remote cluster
+		secretName := clusterName + "secret"
+		m.AgonesClient.AddReactor("list", "gameserverallocationpolicies", func(action k8stesting.Action) (bool, k8sruntime.Object, error) {
+			return true, &multiclusterv1alpha1.GameServerAllocationPolicyList{
+				Items: []multiclusterv1alpha1.GameServerAllocationPolicy{
+					{
+						Spec: multiclusterv1alpha1.GameServerAllocationPolicySpec{
+							Priority: 1,
+							Weight:   100,
+							ConnectionInfo: multiclusterv1alpha1.ClusterConnectionInfo{
+								ClusterName: "localcluster",
+								Namespace:   "empty",
+							},
+						},
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "local",
+							Namespace: defaultNs,
+						},
+					},
+					{
+						Spec: multiclusterv1alpha1.GameServerAllocationPolicySpec{
+							Priority: 2,
+							Weight:   100,
+							ConnectionInfo: multiclusterv1alpha1.ClusterConnectionInfo{
+								AllocationEndpoints: []string{serverURL.Host},
+								ClusterName:         clusterName,
+								SecretName:          secretName,
+								Namespace:           defaultNs,
+							},
+						},
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "remote",
+							Namespace: defaultNs,
+						},
+					},
+				},
+			}, nil
+		})
+
+		m.KubeClient.AddReactor("list", "secrets",
+			func(action k8stesting.Action) (bool, k8sruntime.Object, error) {
+				return true, getTestSecret(secretName, getPEMFromDER(server.TLS.Certificates[0].Certificate[0])), nil
+			})
+
+		stop, cancel := agtesting.StartInformers(m)
+		defer cancel()
+
+		if err := c.Run(1, stop); err != nil {
+			assert.FailNow(t, err.Error())
+		}
+		// wait for it to be up and running
+		err := wait.PollImmediate(time.Second, 10*time.Second, func() (done bool, err error) {
+			return c.allocator.readyGameServerCache.workerqueue.RunCount() == 1, nil
+		})
+		assert.NoError(t, err)
+
+		gsa := &allocationv1.GameServerAllocation{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: defaultNs,
+				Name:      "alloc1",
+			},
+			Spec: allocationv1.GameServerAllocationSpec{
+				MultiClusterSetting: allocationv1.MultiClusterSetting{
+					Enabled: true,
+				},
+				Required: metav1.LabelSelector{MatchLabels: map[string]string{agonesv1.FleetNameLabel: fleetName}},
+			},
+		}
+
+		result, err := executeAllocation(gsa, c)
+		if assert.NoError(t, err) {
+			assert.Equal(t, allocationv1.GameServerAllocationAllocated, result.Status.State)
+			assert.Equal(t, expectedGSAName, result.Status.GameServerName)
+		}
 	})
 }
 
@@ -1305,7 +1765,7 @@ func newFakeController() (*Controller, agtesting.Mocks) {
 	m.Mux = http.NewServeMux()
 	counter := gameservers.NewPerNodeCounter(m.KubeInformerFactory, m.AgonesInformerFactory)
 	api := apiserver.NewAPIServer(m.Mux)
-	c := NewController(api, healthcheck.NewHandler(), counter, m.KubeClient, m.KubeInformerFactory, m.AgonesClient, m.AgonesInformerFactory)
+	c := NewController(api, healthcheck.NewHandler(), counter, func() bool { return true }, m.KubeClient, m.KubeInformerFactory, m.AgonesClient, m.AgonesInformerFactory)
 	c.allocator.topNGameServerCount = 1
 	c.recorder = m.FakeRecorder
 	c.allocator.recorder = m.FakeRecorder
@@ -16,10 +16,14 @@ package gameserverallocations
 
 import (
 	"math/rand"
+	"sort"
+	"strconv"
+	"time"
 
 	"agones.dev/agones/pkg/apis"
 	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
 	allocationv1 "agones.dev/agones/pkg/apis/allocation/v1"
+	"agones.dev/agones/pkg/gameservers"
 	"github.com/pkg/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
@@ -27,11 +31,15 @@ import (
 
 // findGameServerForAllocation finds an optimal gameserver, given the
 // set of preferred and required selectors on the GameServerAllocation. This also returns the index
-// that the gameserver was found at in `list`, in case you want to remove it from the list
+// that the gameserver was found at in `list`, in case you want to remove it from the list,
+// and which of the selectors it was found with (see selectorOutcome)
 // Packed: will search list from start to finish
-// Distributed: will search in a random order through the list
+// Distributed: will search the gameservers on the nodes with the fewest Allocated gameservers in counts first,
+// in a random order on nodes with as many
+// If the age selector has a preference, the oldest or newest gameserver matching each selector is found instead
+// If a node is given, the gameservers on it are searched first, in the same order
 // It is assumed that all gameservers passed in, are Ready and not being deleted, and are sorted in Packed priority order
-func findGameServerForAllocation(gsa *allocationv1.GameServerAllocation, list []*agonesv1.GameServer) (*agonesv1.GameServer, int, error) {
+func findGameServerForAllocation(gsa *allocationv1.GameServerAllocation, list []*agonesv1.GameServer, counts map[string]gameservers.NodeCount, node string) (*agonesv1.GameServer, int, string, error) {
 	type result struct {
 		gs    *agonesv1.GameServer
 		index int
@@ -39,20 +47,26 @@ func findGameServerForAllocation(gsa *allocationv1.GameServerAllocation, list []
 
 	requiredSelector, err := metav1.LabelSelectorAsSelector(&gsa.Spec.Required)
 	if err != nil {
-		return nil, -1, errors.Wrap(err, "could not convert GameServerAllocation selector")
+		return nil, -1, "", errors.Wrap(err, "could not convert GameServerAllocation selector")
 	}
 
 	preferredSelector, err := gsa.Spec.PreferredSelectors()
 	if err != nil {
-		return nil, -1, errors.Wrap(err, "could not convert preferred selectors for GameServerAllocation")
+		return nil, -1, "", errors.Wrap(err, "could not convert preferred selectors for GameServerAllocation")
 	}
 
 	var required *result
 	preferred := make([]*result, len(preferredSelector))
+	now := time.Now()
+	age := gsa.Spec.Age
+	// better returns true if the gameserver should be selected over the current result
+	better := func(current *result, gs *agonesv1.GameServer) bool {
+		return current == nil || (age != nil && age.Prefers(gs, current.gs))
+	}
 
 	var loop func(list []*agonesv1.GameServer, f func(i int, gs *agonesv1.GameServer))
 
-	// packed is forward looping, distributed is random looping
+	// packed is forward looping, distributed is looping from the least loaded nodes
 	switch gsa.Spec.Scheduling {
 	case apis.Packed:
 		loop = func(list []*agonesv1.GameServer, f func(i int, gs *agonesv1.GameServer)) {
@@ -71,6 +85,9 @@ func findGameServerForAllocation(gsa *allocationv1.GameServerAllocation, list []
 		rand.Shuffle(l, func(i, j int) {
 			indices[i], indices[j] = indices[j], indices[i]
 		})
+		sort.SliceStable(indices, func(i, j int) bool {
+			return counts[list[indices[i]].Status.NodeName].Allocated < counts[list[indices[j]].Status.NodeName].Allocated
+		})
 
 		loop = func(list []*agonesv1.GameServer, f func(i int, gs *agonesv1.GameServer)) {
 			for _, i := range indices {
@@ -78,7 +95,24 @@ func findGameServerForAllocation(gsa *allocationv1.GameServerAllocation, list []
 			}
 		}
 	default:
-		return nil, -1, errors.Errorf("scheduling strategy of '%s' is not supported", gsa.Spec.Scheduling)
+		return nil, -1, "", errors.Errorf("scheduling strategy of '%s' is not supported", gsa.Spec.Scheduling)
+	}
+
+	// with a preferred node, loop over its gameservers first, and then the rest
+	if node != "" {
+		strategyLoop := loop
+		loop = func(list []*agonesv1.GameServer, f func(i int, gs *agonesv1.GameServer)) {
+			strategyLoop(list, func(i int, gs *agonesv1.GameServer) {
+				if gs.Status.NodeName == node {
+					f(i, gs)
+				}
+			})
+			strategyLoop(list, func(i int, gs *agonesv1.GameServer) {
+				if gs.Status.NodeName != node {
+					f(i, gs)
+				}
+			})
+		}
 	}
 
 	loop(list, func(i int, gs *agonesv1.GameServer) {
@@ -87,30 +121,53 @@ func findGameServerForAllocation(gsa *allocationv1.GameServerAllocation, list []
 			return
 		}
 
+		// only match gameservers with the requested counter capacity
+		if !gsa.Spec.MatchesCounters(gs) {
+			return
+		}
+
+		// only match gameservers that have been ready for the requested time
+		if age != nil && !age.Matches(gs, now) {
+			return
+		}
+
 		set := labels.Set(gs.ObjectMeta.Labels)
 
-		// first look at preferred
+		// preferred selectors only choose out of the required set, which matters
+		// when they are not narrower than the required selector, e.g. with NotIn expressions
+		if !requiredSelector.Matches(set) {
+			return
+		}
+
 		for j, sel := range preferredSelector {
-			if preferred[j] == nil && sel.Matches(set) {
+			if better(preferred[j], gs) && sel.Matches(set) {
 				preferred[j] = &result{gs: gs, index: i}
 			}
 		}
 
-		// then look at required
-		if required == nil && requiredSelector.Matches(set) {
+		if better(required, gs) {
 			required = &result{gs: gs, index: i}
 		}
 	})
 
-	for _, r := range preferred {
+	for j, r := range preferred {
 		if r != nil {
-			return r.gs, r.index, nil
+			return r.gs, r.index, selectorOutcome(j), nil
 		}
 	}
 
 	if required == nil {
-		return nil, 0, ErrNoGameServerReady
+		return nil, 0, "", ErrNoGameServerReady
 	}
 
-	return required.gs, required.index, nil
+	return required.gs, required.index, selectorOutcome(-1), nil
+}
+
+// selectorOutcome returns the name of the selector a GameServer was found with,
+// "preferred_<index>" for a preferred selector, or "required" when index is negative
+func selectorOutcome(index int) string {
+	if index < 0 {
+		return "required"
+	}
+	return "preferred_" + strconv.Itoa(index)
 }
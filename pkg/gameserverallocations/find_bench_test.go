@@ -0,0 +1,164 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gameserverallocations
+
+import (
+	"fmt"
+	"testing"
+
+	"agones.dev/agones/pkg/apis"
+	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
+	allocationv1 "agones.dev/agones/pkg/apis/allocation/v1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// allocsDelta is the tolerated difference in memory allocations between selecting from a small,
+// and a large list of GameServers, to allow for background goroutines left behind by other tests
+const allocsDelta = 5
+
+// benchmarkSizes are the number of cached Ready GameServers that allocation is benchmarked against
+var benchmarkSizes = []int{1000, 5000, 10000}
+
+// Benchmarks can be run with:
+//
+//	go test -run=^$ -bench=. -benchmem ./pkg/gameserverallocations
+//
+// Allocations per second, for a single allocation worker, is 1e9 / ns/op.
+
+func BenchmarkFindGameServerForAllocation(b *testing.B) {
+	for _, strategy := range []apis.SchedulingStrategy{apis.Packed, apis.Distributed} {
+		for _, size := range benchmarkSizes {
+			gsa := benchmarkGameServerAllocation(strategy)
+			list := benchmarkGameServers(size)
+
+			b.Run(fmt.Sprintf("%s/%d", strategy, size), func(b *testing.B) {
+				b.ReportAllocs()
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					if _, _, _, err := findGameServerForAllocation(gsa, list, nil, ""); err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+
+			// the list of Ready GameServers is shared by concurrent selections
+			b.Run(fmt.Sprintf("%s/%d/parallel", strategy, size), func(b *testing.B) {
+				b.ReportAllocs()
+				b.ResetTimer()
+				b.RunParallel(func(pb *testing.PB) {
+					for pb.Next() {
+						if _, _, _, err := findGameServerForAllocation(gsa, list, nil, ""); err != nil {
+							b.Fatal(err)
+						}
+					}
+				})
+			})
+		}
+	}
+}
+
+// BenchmarkAllocationSelection mirrors the batch loop of Allocator.ListenAndAllocate, selecting
+// GameServers from the sorted Ready GameServer cache, and refreshing the list every maxBatchBeforeRefresh selections
+func BenchmarkAllocationSelection(b *testing.B) {
+	for _, strategy := range []apis.SchedulingStrategy{apis.Packed, apis.Distributed} {
+		for _, size := range benchmarkSizes {
+			gsa := benchmarkGameServerAllocation(strategy)
+			gameServers := benchmarkGameServers(size)
+
+			b.Run(fmt.Sprintf("%s/%d", strategy, size), func(b *testing.B) {
+				c, _ := newFakeController()
+				cache := c.allocator.readyGameServerCache
+				for _, gs := range gameServers {
+					cache.AddToReadyGameServer(gs)
+				}
+
+				var list []*agonesv1.GameServer
+				b.ReportAllocs()
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					if i%maxBatchBeforeRefresh == 0 {
+						list = cache.ListSortedReadyGameServers()
+					}
+					_, index, _, err := findGameServerForAllocation(gsa, list, nil, "")
+					if err != nil {
+						b.Fatal(err)
+					}
+					list = append(list[:index], list[index+1:]...)
+				}
+			})
+		}
+	}
+}
+
+// TestFindGameServerForAllocationAllocs makes sure the memory allocated to select a GameServer
+// does not grow with the number of Ready GameServers, which would slow allocation down at scale.
+// Not run in parallel, as testing.AllocsPerRun counts all allocations made by the process.
+func TestFindGameServerForAllocationAllocs(t *testing.T) {
+	small := benchmarkGameServers(100)
+	large := benchmarkGameServers(benchmarkSizes[len(benchmarkSizes)-1])
+
+	t.Run(string(apis.Packed), func(t *testing.T) {
+		gsa := benchmarkGameServerAllocation(apis.Packed)
+		assert.InDelta(t, findAllocs(gsa, small), findAllocs(gsa, large), allocsDelta)
+	})
+
+	t.Run(string(apis.Distributed), func(t *testing.T) {
+		// the randomised index list is a single allocation, whatever its size
+		gsa := benchmarkGameServerAllocation(apis.Distributed)
+		assert.InDelta(t, findAllocs(gsa, small), findAllocs(gsa, large), allocsDelta)
+	})
+}
+
+// findAllocs returns the average number of memory allocations made by findGameServerForAllocation
+func findAllocs(gsa *allocationv1.GameServerAllocation, list []*agonesv1.GameServer) float64 {
+	return testing.AllocsPerRun(10, func() {
+		_, _, _, _ = findGameServerForAllocation(gsa, list, nil, "")
+	})
+}
+
+// benchmarkGameServerAllocation returns a GameServerAllocation with a required selector that matches
+// every GameServer from benchmarkGameServers, and a preferred selector that matches one in a hundred
+func benchmarkGameServerAllocation(strategy apis.SchedulingStrategy) *allocationv1.GameServerAllocation {
+	return &allocationv1.GameServerAllocation{
+		ObjectMeta: metav1.ObjectMeta{Namespace: defaultNs},
+		Spec: allocationv1.GameServerAllocationSpec{
+			Required:   metav1.LabelSelector{MatchLabels: map[string]string{agonesv1.FleetNameLabel: "fleet"}},
+			Preferred:  []metav1.LabelSelector{{MatchLabels: map[string]string{"mode": "ranked"}}},
+			Scheduling: strategy,
+		},
+	}
+}
+
+// benchmarkGameServers returns size Ready GameServers, spread over nodes of ten GameServers each,
+// with the ranked ones at the end of the list, so that the Packed strategy has to search most of it.
+func benchmarkGameServers(size int) []*agonesv1.GameServer {
+	list := make([]*agonesv1.GameServer, size)
+	for i := range list {
+		mode := "casual"
+		if i >= size-size/100 {
+			mode = "ranked"
+		}
+		list[i] = &agonesv1.GameServer{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      fmt.Sprintf("gs%d", i),
+				Namespace: defaultNs,
+				Labels:    map[string]string{agonesv1.FleetNameLabel: "fleet", "mode": mode},
+			},
+			Status: agonesv1.GameServerStatus{NodeName: fmt.Sprintf("node%d", i/10), State: agonesv1.GameServerStateReady},
+		}
+	}
+	return list
+}
@@ -16,11 +16,13 @@ package gameserverallocations
 
 import (
 	"testing"
+	"time"
 
 	"agones.dev/agones/pkg/apis"
 
 	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
 	allocationv1 "agones.dev/agones/pkg/apis/allocation/v1"
+	"agones.dev/agones/pkg/gameservers"
 	agtesting "agones.dev/agones/pkg/testing"
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -33,6 +35,10 @@ func TestFindGameServerForAllocationPacked(t *testing.T) {
 
 	labels := map[string]string{"role": "gameserver"}
 	prefLabels := map[string]string{"role": "gameserver", "preferred": "true"}
+	now := time.Now()
+	readyTenSecondsAgo := metav1.NewTime(now.Add(-10 * time.Second))
+	readyAMinuteAgo := metav1.NewTime(now.Add(-time.Minute))
+	readyAnHourAgo := metav1.NewTime(now.Add(-time.Hour))
 
 	gsa := &allocationv1.GameServerAllocation{
 		ObjectMeta: metav1.ObjectMeta{Namespace: defaultNs},
@@ -67,7 +73,7 @@ func TestFindGameServerForAllocationPacked(t *testing.T) {
 			test: func(t *testing.T, list []*agonesv1.GameServer) {
 				assert.Len(t, list, 3)
 
-				gs, index, err := findGameServerForAllocation(gsa, list)
+				gs, index, _, err := findGameServerForAllocation(gsa, list, nil, "")
 				assert.NoError(t, err)
 				if !assert.NotNil(t, gs) {
 					assert.FailNow(t, "gameserver should not be nil")
@@ -82,7 +88,7 @@ func TestFindGameServerForAllocationPacked(t *testing.T) {
 				assert.Equal(t, agonesv1.GameServerStateReady, list[0].Status.State)
 				assert.Len(t, list, 2)
 
-				gs, index, err = findGameServerForAllocation(gsa, list)
+				gs, index, _, err = findGameServerForAllocation(gsa, list, nil, "")
 				assert.NoError(t, err)
 				if !assert.NotNil(t, gs) {
 					assert.FailNow(t, "gameserver should not be nil")
@@ -93,7 +99,7 @@ func TestFindGameServerForAllocationPacked(t *testing.T) {
 				assert.Equal(t, agonesv1.GameServerStateReady, gs.Status.State)
 
 				list = nil
-				gs, _, err = findGameServerForAllocation(gsa, list)
+				gs, _, _, err = findGameServerForAllocation(gsa, list, nil, "")
 				assert.Error(t, err)
 				assert.Equal(t, ErrNoGameServerReady, err)
 				assert.Nil(t, gs)
@@ -111,15 +117,16 @@ func TestFindGameServerForAllocationPacked(t *testing.T) {
 			test: func(t *testing.T, list []*agonesv1.GameServer) {
 				assert.Len(t, list, 6)
 
-				gs, index, err := findGameServerForAllocation(prefGsa, list)
+				gs, index, selector, err := findGameServerForAllocation(prefGsa, list, nil, "")
 				assert.NoError(t, err)
+				assert.Equal(t, "preferred_0", selector)
 				assert.Equal(t, "node1", gs.Status.NodeName)
 				assert.Equal(t, "gs1", gs.ObjectMeta.Name)
 				assert.Equal(t, gs, list[index])
 				assert.Equal(t, agonesv1.GameServerStateReady, gs.Status.State)
 
 				list = append(list[:index], list[index+1:]...)
-				gs, index, err = findGameServerForAllocation(prefGsa, list)
+				gs, index, _, err = findGameServerForAllocation(prefGsa, list, nil, "")
 				assert.NoError(t, err)
 				assert.Equal(t, "node2", gs.Status.NodeName)
 				assert.Equal(t, "gs4", gs.ObjectMeta.Name)
@@ -127,8 +134,9 @@ func TestFindGameServerForAllocationPacked(t *testing.T) {
 				assert.Equal(t, agonesv1.GameServerStateReady, gs.Status.State)
 
 				list = append(list[:index], list[index+1:]...)
-				gs, index, err = findGameServerForAllocation(prefGsa, list)
+				gs, index, selector, err = findGameServerForAllocation(prefGsa, list, nil, "")
 				assert.NoError(t, err)
+				assert.Equal(t, "required", selector)
 				assert.Equal(t, "node1", gs.Status.NodeName)
 				assert.Contains(t, []string{"gs3", "gs5", "gs6"}, gs.ObjectMeta.Name)
 				assert.Equal(t, gs, list[index])
@@ -149,13 +157,76 @@ func TestFindGameServerForAllocationPacked(t *testing.T) {
 			test: func(t *testing.T, list []*agonesv1.GameServer) {
 				assert.Len(t, list, 4)
 
-				gs, index, err := findGameServerForAllocation(gsa, list)
+				gs, index, _, err := findGameServerForAllocation(gsa, list, nil, "")
 				assert.Nil(t, err)
 				assert.Equal(t, "node2", gs.Status.NodeName)
 				assert.Equal(t, gs, list[index])
 				assert.Equal(t, agonesv1.GameServerStateReady, gs.Status.State)
 			},
 		},
+		"counters": {
+			list: []agonesv1.GameServer{
+				{ObjectMeta: metav1.ObjectMeta{Name: "gs1", Labels: labels, Namespace: defaultNs}, Status: agonesv1.GameServerStatus{NodeName: "node1", State: agonesv1.GameServerStateReady,
+					Counters: map[string]agonesv1.CounterStatus{"players": {Count: 8, Capacity: 10}}}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "gs2", Labels: labels, Namespace: defaultNs}, Status: agonesv1.GameServerStatus{NodeName: "node1", State: agonesv1.GameServerStateReady}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "gs3", Labels: labels, Namespace: defaultNs}, Status: agonesv1.GameServerStatus{NodeName: "node2", State: agonesv1.GameServerStateReady,
+					Counters: map[string]agonesv1.CounterStatus{"players": {Count: 2, Capacity: 10}}}},
+			},
+			test: func(t *testing.T, list []*agonesv1.GameServer) {
+				assert.Len(t, list, 3)
+
+				counterGsa := gsa.DeepCopy()
+				counterGsa.Spec.Counters = map[string]allocationv1.CounterSelector{"players": {MinAvailable: 4}}
+				gs, index, _, err := findGameServerForAllocation(counterGsa, list, nil, "")
+				assert.NoError(t, err)
+				assert.Equal(t, "gs3", gs.ObjectMeta.Name)
+				assert.Equal(t, gs, list[index])
+
+				counterGsa.Spec.Counters = map[string]allocationv1.CounterSelector{"players": {MinAvailable: 9}}
+				_, _, _, err = findGameServerForAllocation(counterGsa, list, nil, "")
+				assert.Equal(t, ErrNoGameServerReady, err)
+			},
+		},
+		"age": {
+			list: []agonesv1.GameServer{
+				{ObjectMeta: metav1.ObjectMeta{Name: "gs1", Labels: labels, Namespace: defaultNs}, Status: agonesv1.GameServerStatus{NodeName: "node1", State: agonesv1.GameServerStateReady,
+					ReadyTime: &readyTenSecondsAgo}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "gs2", Labels: labels, Namespace: defaultNs, CreationTimestamp: readyAnHourAgo},
+					Status: agonesv1.GameServerStatus{NodeName: "node1", State: agonesv1.GameServerStateReady}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "gs3", Labels: labels, Namespace: defaultNs}, Status: agonesv1.GameServerStatus{NodeName: "node2", State: agonesv1.GameServerStateReady,
+					ReadyTime: &readyAMinuteAgo}},
+			},
+			test: func(t *testing.T, list []*agonesv1.GameServer) {
+				assert.Len(t, list, 3)
+
+				ageGsa := gsa.DeepCopy()
+				ageGsa.Spec.Age = &allocationv1.AgeSelector{MinReadySeconds: 30}
+				gs, index, _, err := findGameServerForAllocation(ageGsa, list, nil, "")
+				assert.NoError(t, err)
+				// gs1 is warming up, and gs2 has no ready time, so its creation time is used
+				assert.Equal(t, "gs2", gs.ObjectMeta.Name)
+				assert.Equal(t, gs, list[index])
+
+				ageGsa.Spec.Age = &allocationv1.AgeSelector{MinReadySeconds: 30, MaxReadySeconds: 300}
+				gs, _, _, err = findGameServerForAllocation(ageGsa, list, nil, "")
+				assert.NoError(t, err)
+				assert.Equal(t, "gs3", gs.ObjectMeta.Name)
+
+				ageGsa.Spec.Age = &allocationv1.AgeSelector{Prefer: allocationv1.NewestAgePreference}
+				gs, _, _, err = findGameServerForAllocation(ageGsa, list, nil, "")
+				assert.NoError(t, err)
+				assert.Equal(t, "gs1", gs.ObjectMeta.Name)
+
+				ageGsa.Spec.Age = &allocationv1.AgeSelector{Prefer: allocationv1.OldestAgePreference}
+				gs, _, _, err = findGameServerForAllocation(ageGsa, list, nil, "")
+				assert.NoError(t, err)
+				assert.Equal(t, "gs2", gs.ObjectMeta.Name)
+
+				ageGsa.Spec.Age = &allocationv1.AgeSelector{MinReadySeconds: 7200}
+				_, _, _, err = findGameServerForAllocation(ageGsa, list, nil, "")
+				assert.Equal(t, ErrNoGameServerReady, err)
+			},
+		},
 	}
 
 	for k, v := range fixtures {
@@ -234,7 +305,7 @@ func TestFindGameServerForAllocationDistributed(t *testing.T) {
 	list := c.ListSortedReadyGameServers()
 	assert.Len(t, list, 6)
 
-	gs, index, err := findGameServerForAllocation(gsa, list)
+	gs, index, _, err := findGameServerForAllocation(gsa, list, nil, "")
 	assert.NoError(t, err)
 	assert.Equal(t, gs, list[index])
 	assert.Equal(t, agonesv1.GameServerStateReady, gs.Status.State)
@@ -242,7 +313,7 @@ func TestFindGameServerForAllocationDistributed(t *testing.T) {
 	past := gs
 	// we should get a different result in 10 tries, so we can see we get some randomness.
 	for i := 0; i < 10; i++ {
-		gs, index, err = findGameServerForAllocation(gsa, list)
+		gs, index, _, err = findGameServerForAllocation(gsa, list, nil, "")
 		assert.NoError(t, err)
 		assert.Equal(t, gs, list[index])
 		assert.Equal(t, agonesv1.GameServerStateReady, gs.Status.State)
@@ -255,3 +326,134 @@ func TestFindGameServerForAllocationDistributed(t *testing.T) {
 	assert.FailNow(t, "We should get a different gameserver by now")
 
 }
+
+func TestFindGameServerForAllocationPreferredNode(t *testing.T) {
+	t.Parallel()
+
+	labels := map[string]string{"role": "gameserver"}
+	prefLabels := map[string]string{"role": "gameserver", "preferred": "true"}
+	gsa := &allocationv1.GameServerAllocation{
+		ObjectMeta: metav1.ObjectMeta{Namespace: defaultNs},
+		Spec: allocationv1.GameServerAllocationSpec{
+			Required: metav1.LabelSelector{MatchLabels: labels},
+			Preferred: []metav1.LabelSelector{
+				{MatchLabels: map[string]string{"preferred": "true"}},
+			},
+		},
+	}
+
+	list := []*agonesv1.GameServer{
+		{ObjectMeta: metav1.ObjectMeta{Name: "gs1", Namespace: defaultNs, Labels: labels},
+			Status: agonesv1.GameServerStatus{NodeName: "node1", State: agonesv1.GameServerStateReady}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "gs2", Namespace: defaultNs, Labels: labels},
+			Status: agonesv1.GameServerStatus{NodeName: "node2", State: agonesv1.GameServerStateReady}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "gs3", Namespace: defaultNs, Labels: labels},
+			Status: agonesv1.GameServerStatus{NodeName: "node2", State: agonesv1.GameServerStateReady}},
+	}
+
+	for _, scheduling := range []apis.SchedulingStrategy{apis.Packed, apis.Distributed} {
+		t.Run(string(scheduling), func(t *testing.T) {
+			gsa := gsa.DeepCopy()
+			gsa.Spec.Scheduling = scheduling
+
+			gs, index, _, err := findGameServerForAllocation(gsa, list, nil, "node2")
+			assert.NoError(t, err)
+			assert.Equal(t, gs, list[index])
+			assert.Equal(t, "node2", gs.Status.NodeName)
+
+			// a node without a matching gameserver is ignored
+			gs, _, _, err = findGameServerForAllocation(gsa, list[:1], nil, "node2")
+			assert.NoError(t, err)
+			assert.Equal(t, "gs1", gs.ObjectMeta.Name)
+		})
+	}
+
+	// a preferred selector takes precedence over the node
+	list[0].ObjectMeta.Labels = prefLabels
+	gsa.Spec.Scheduling = apis.Packed
+	gs, _, selector, err := findGameServerForAllocation(gsa, list, nil, "node2")
+	assert.NoError(t, err)
+	assert.Equal(t, "gs1", gs.ObjectMeta.Name)
+	assert.Equal(t, "preferred_0", selector)
+}
+
+func TestFindGameServerForAllocationDistributedLeastLoaded(t *testing.T) {
+	t.Parallel()
+
+	labels := map[string]string{"role": "gameserver"}
+	gsa := &allocationv1.GameServerAllocation{
+		ObjectMeta: metav1.ObjectMeta{Namespace: defaultNs},
+		Spec: allocationv1.GameServerAllocationSpec{
+			Required:   metav1.LabelSelector{MatchLabels: labels},
+			Scheduling: apis.Distributed,
+		},
+	}
+
+	list := []*agonesv1.GameServer{
+		{ObjectMeta: metav1.ObjectMeta{Name: "gs1", Namespace: defaultNs, Labels: labels},
+			Status: agonesv1.GameServerStatus{NodeName: "node1", State: agonesv1.GameServerStateReady}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "gs2", Namespace: defaultNs, Labels: labels},
+			Status: agonesv1.GameServerStatus{NodeName: "node2", State: agonesv1.GameServerStateReady}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "gs3", Namespace: defaultNs, Labels: labels},
+			Status: agonesv1.GameServerStatus{NodeName: "node2", State: agonesv1.GameServerStateReady}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "gs4", Namespace: defaultNs, Labels: labels},
+			Status: agonesv1.GameServerStatus{NodeName: "node3", State: agonesv1.GameServerStateReady}},
+	}
+	counts := map[string]gameservers.NodeCount{
+		"node1": {Ready: 1, Allocated: 5},
+		"node2": {Ready: 2, Allocated: 1},
+		"node3": {Ready: 1, Allocated: 3},
+	}
+
+	// the gameservers on the least loaded node are picked, randomly between them
+	names := map[string]bool{}
+	for i := 0; i < 20; i++ {
+		gs, index, _, err := findGameServerForAllocation(gsa, list, counts, "")
+		assert.NoError(t, err)
+		assert.Equal(t, gs, list[index])
+		assert.Equal(t, "node2", gs.Status.NodeName)
+		names[gs.ObjectMeta.Name] = true
+	}
+	assert.Len(t, names, 2)
+
+	// followed by the next least loaded node
+	counts["node2"] = gameservers.NodeCount{Ready: 2, Allocated: 4}
+	gs, _, _, err := findGameServerForAllocation(gsa, list, counts, "")
+	assert.NoError(t, err)
+	assert.Equal(t, "gs4", gs.ObjectMeta.Name)
+}
+
+func TestFindGameServerForAllocationMatchExpressions(t *testing.T) {
+	t.Parallel()
+
+	gs := func(name, version string) *agonesv1.GameServer {
+		return &agonesv1.GameServer{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: defaultNs, Labels: map[string]string{"version": version}},
+			Status: agonesv1.GameServerStatus{NodeName: "node1", State: agonesv1.GameServerStateReady}}
+	}
+	list := []*agonesv1.GameServer{gs("gs1", "v11"), gs("gs2", "v12"), gs("gs3", "v13")}
+
+	gsa := &allocationv1.GameServerAllocation{
+		ObjectMeta: metav1.ObjectMeta{Namespace: defaultNs},
+		Spec: allocationv1.GameServerAllocationSpec{
+			Required: metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+				{Key: "version", Operator: metav1.LabelSelectorOpIn, Values: []string{"v12", "v13"}},
+			}},
+			Scheduling: apis.Packed,
+		},
+	}
+
+	result, _, _, err := findGameServerForAllocation(gsa, list, nil, "")
+	assert.NoError(t, err)
+	if assert.NotNil(t, result) {
+		assert.Equal(t, "gs2", result.ObjectMeta.Name)
+	}
+
+	gsa.Spec.Preferred = []metav1.LabelSelector{{MatchExpressions: []metav1.LabelSelectorRequirement{
+		{Key: "version", Operator: metav1.LabelSelectorOpNotIn, Values: []string{"v12"}},
+	}}}
+	result, _, _, err = findGameServerForAllocation(gsa, list, nil, "")
+	assert.NoError(t, err)
+	if assert.NotNil(t, result) {
+		assert.Equal(t, "gs3", result.ObjectMeta.Name)
+	}
+}
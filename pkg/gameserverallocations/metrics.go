@@ -38,8 +38,10 @@ var (
 	keyMultiCluster       = mt.MustTagKey("is_multicluster")
 	keyStatus             = mt.MustTagKey("status")
 	keySchedulingStrategy = mt.MustTagKey("scheduling_strategy")
+	keySelector           = mt.MustTagKey("selector")
 
-	gameServerAllocationsLatency = stats.Float64("gameserver_allocations/latency", "The duration of gameserver allocations", "s")
+	gameServerAllocationsLatency   = stats.Float64("gameserver_allocations/latency", "The duration of gameserver allocations", "s")
+	gameServerAllocationsSelectors = stats.Int64("gameserver_allocations/selectors", "The number of gameserver allocations by the selector that was satisfied", "1")
 )
 
 func init() {
@@ -50,6 +52,28 @@ func init() {
 		Aggregation: view.Distribution(0, 0.01, 0.025, 0.05, 0.075, 0.1, 0.25, 0.5, 0.75, 1, 2, 3),
 		TagKeys:     []tag.Key{keyFleetName, keyNodeName, keyClusterName, keyMultiCluster, keyStatus, keySchedulingStrategy},
 	}))
+	runtime.Must(view.Register(&view.View{
+		Name:        "gameserver_allocations_selector_total",
+		Measure:     gameServerAllocationsSelectors,
+		Description: "The count of gameserver allocations by the selector that was satisfied: required, preferred_<index>, or none",
+		Aggregation: view.Count(),
+		TagKeys:     []tag.Key{keyFleetName, keySelector, keySchedulingStrategy},
+	}))
+}
+
+// recordSelectorOutcome records which of the GameServerAllocation's selectors was satisfied
+// by the allocated GameServer. selector is "none" when no GameServer could be found.
+func recordSelectorOutcome(logger *logrus.Entry, gsa *allocationv1.GameServerAllocation, selector, fleetName string) {
+	if fleetName == "" {
+		fleetName = "none"
+	}
+	if err := stats.RecordWithTags(context.Background(), []tag.Mutator{
+		tag.Upsert(keyFleetName, fleetName),
+		tag.Upsert(keySelector, selector),
+		tag.Upsert(keySchedulingStrategy, string(gsa.Spec.Scheduling)),
+	}, gameServerAllocationsSelectors.M(1)); err != nil {
+		logger.WithError(err).Warn("error while recording allocation selector stats")
+	}
 }
 
 // default set of tags for latency metric
@@ -16,6 +16,7 @@ package gameserverallocations
 
 import (
 	"sort"
+	"sync"
 
 	"agones.dev/agones/pkg/apis/agones"
 	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
@@ -43,6 +44,8 @@ type ReadyGameServerCache struct {
 	gameServerSynced cache.InformerSynced
 	workerqueue      *workerqueue.WorkerQueue
 	counter          *gameservers.PerNodeCounter
+	syncedMutex      sync.RWMutex
+	synced           bool
 }
 
 // NewReadyGameServerCache creates a new instance of ReadyGameServerCache
@@ -87,7 +90,7 @@ func NewReadyGameServerCache(informer informerv1.GameServerInformer, gameServerG
 
 	c.baseLogger = runtime.NewLoggerWithType(c)
 	c.workerqueue = workerqueue.NewWorkerQueue(c.SyncGameServers, c.baseLogger, logfields.GameServerKey, agones.GroupName+".GameServerUpdateController")
-	health.AddLivenessCheck("gameserverallocation-gameserver-workerqueue", healthcheck.Check(c.workerqueue.Healthy))
+	c.workerqueue.AddHealthChecks(health, "gameserverallocation-gameserver-workerqueue")
 
 	return c
 }
@@ -116,6 +119,13 @@ func (c *ReadyGameServerCache) Sync(stop <-chan struct{}) error {
 	return c.syncReadyGSServerCache()
 }
 
+// HasSynced returns true once the cache of Ready GameServers has been built
+func (c *ReadyGameServerCache) HasSynced() bool {
+	c.syncedMutex.RLock()
+	defer c.syncedMutex.RUnlock()
+	return c.synced
+}
+
 // Resync enqueues an empty game server to be synced. Using queue helps avoiding multiple threads syncing at the same time.
 func (c *ReadyGameServerCache) Resync() {
 	// this will trigger syncing of the cache (assuming cache might not be up to date)
@@ -201,10 +211,15 @@ func (c *ReadyGameServerCache) ListSortedReadyGameServers() []*agonesv1.GameServ
 	return list
 }
 
+// NodeCounts returns a copy of the number of Ready and Allocated gameservers on each node
+func (c *ReadyGameServerCache) NodeCounts() map[string]gameservers.NodeCount {
+	return c.counter.Counts()
+}
+
 // PatchGameServerMetadata patches the input gameserver with allocation meta patch and returns the updated gameserver
 func (c *ReadyGameServerCache) PatchGameServerMetadata(fam allocationv1.MetaPatch, gs agonesv1.GameServer) (*agonesv1.GameServer, error) {
 	c.patchMetadata(&gs, fam)
-	gs.Status.State = agonesv1.GameServerStateAllocated
+	gs.MarkAllocated()
 
 	return c.gameServerGetter.GameServers(gs.ObjectMeta.Namespace).Update(&gs)
 }
@@ -281,6 +296,10 @@ func (c *ReadyGameServerCache) syncReadyGSServerCache() error {
 		}
 	}
 
+	c.syncedMutex.Lock()
+	c.synced = true
+	c.syncedMutex.Unlock()
+
 	return nil
 }
 
@@ -0,0 +1,286 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package gameserverdeletions serves GameServerDeletion requests, that shut down
+// the GameServers that match a selector in bulk
+package gameserverdeletions
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+
+	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
+	allocationv1 "agones.dev/agones/pkg/apis/allocation/v1"
+	"agones.dev/agones/pkg/client/clientset/versioned"
+	getterv1 "agones.dev/agones/pkg/client/clientset/versioned/typed/agones/v1"
+	"agones.dev/agones/pkg/client/informers/externalversions"
+	listerv1 "agones.dev/agones/pkg/client/listers/agones/v1"
+	"agones.dev/agones/pkg/gameservers"
+	"agones.dev/agones/pkg/gameserversets"
+	"agones.dev/agones/pkg/util/apiserver"
+	"agones.dev/agones/pkg/util/https"
+	"agones.dev/agones/pkg/util/runtime"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/time/rate"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+)
+
+const (
+	// dryRunAll is the only supported value of the dryRun query parameter, as in the Kubernetes API
+	dryRunAll = "All"
+	// notReadyRetryAfterSeconds is how long clients are asked to wait before retrying a request
+	// that is received before the GameServers are synced
+	notReadyRetryAfterSeconds = 1
+)
+
+// Controller is the GameServerDeletion controller
+type Controller struct {
+	api              *apiserver.APIServer
+	baseLogger       *logrus.Entry
+	recorder         record.EventRecorder
+	counter          *gameservers.PerNodeCounter
+	gameServerGetter getterv1.GameServersGetter
+	gameServerLister listerv1.GameServerLister
+	gameServerSynced cache.InformerSynced
+	limiter          *rate.Limiter
+}
+
+// NewController returns a controller for GameServerDeletions.
+// deletionRate is the maximum number of GameServers shut down per second, across all requests. 0 is unlimited.
+func NewController(apiServer *apiserver.APIServer,
+	counter *gameservers.PerNodeCounter,
+	deletionRate float64,
+	kubeClient kubernetes.Interface,
+	agonesClient versioned.Interface,
+	agonesInformerFactory externalversions.SharedInformerFactory) *Controller {
+
+	gameServers := agonesInformerFactory.Agones().V1().GameServers()
+	c := &Controller{
+		api:              apiServer,
+		counter:          counter,
+		gameServerGetter: agonesClient.AgonesV1(),
+		gameServerLister: gameServers.Lister(),
+		gameServerSynced: gameServers.Informer().HasSynced,
+		limiter:          newDeletionLimiter(deletionRate),
+	}
+	c.baseLogger = runtime.NewLoggerWithType(c)
+
+	eventBroadcaster := record.NewBroadcaster()
+	eventBroadcaster.StartLogging(c.baseLogger.Infof)
+	eventBroadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{Interface: kubeClient.CoreV1().Events("")})
+	c.recorder = eventBroadcaster.NewRecorder(scheme.Scheme, corev1.EventSource{Component: "GameServerDeletion-controller"})
+
+	return c
+}
+
+// newDeletionLimiter returns the limiter of the GameServers shut down per second, which never limits if deletionRate is 0.
+// Its burst is a second's worth of GameServers, which is the most that a single request can shut down.
+func newDeletionLimiter(deletionRate float64) *rate.Limiter {
+	if deletionRate <= 0 {
+		return rate.NewLimiter(rate.Inf, 0)
+	}
+	return rate.NewLimiter(rate.Limit(deletionRate), int(math.Ceil(deletionRate)))
+}
+
+// registers the api resource for gameserverdeletion
+func (c *Controller) registerAPIResource() {
+	resource := metav1.APIResource{
+		Name:         "gameserverdeletions",
+		SingularName: "gameserverdeletion",
+		Namespaced:   true,
+		Kind:         "GameServerDeletion",
+		Verbs: []string{
+			"create",
+		},
+		ShortNames: []string{"gsd"},
+	}
+	c.api.AddAPIResource(allocationv1.SchemeGroupVersion.String(), resource, c.processDeletionRequest)
+}
+
+// Run runs this controller. Will block until the GameServers are synced.
+// Ignores threadiness, as requests are served by the api server.
+// The api resource is registered before the caches are synced, so that
+// early requests are rejected with a Retry-After rather than not being found
+func (c *Controller) Run(_ int, stop <-chan struct{}) error {
+	c.registerAPIResource()
+
+	c.baseLogger.Info("Wait for cache sync")
+	if !cache.WaitForCacheSync(stop, c.gameServerSynced) {
+		return errors.New("failed to wait for caches to sync")
+	}
+	return nil
+}
+
+// processDeletionRequest shuts down the GameServers selected by the GameServerDeletion of the request,
+// and responds with the GameServerDeletion and the names of the GameServers in its status.
+// With the dryRun=All query parameter, the GameServers are only listed.
+func (c *Controller) processDeletionRequest(w http.ResponseWriter, r *http.Request, namespace string) error {
+	if r.Body != nil {
+		defer r.Body.Close() // nolint: errcheck
+	}
+
+	log := https.LogRequest(c.baseLogger, r)
+
+	if r.Method != http.MethodPost {
+		log.Warn("deletion handler only supports POST")
+		http.Error(w, "Method not supported", http.StatusMethodNotAllowed)
+		return nil
+	}
+
+	dryRun := r.URL.Query().Get("dryRun")
+	if dryRun != "" && dryRun != dryRunAll {
+		log.WithField("dryRun", dryRun).Warn("invalid deletion dry run")
+		http.Error(w, "dryRun must be All", http.StatusBadRequest)
+		return nil
+	}
+
+	if !c.gameServerSynced() {
+		w.Header().Set("Retry-After", strconv.Itoa(notReadyRetryAfterSeconds))
+		http.Error(w, "GameServers are not synced yet", http.StatusServiceUnavailable)
+		return nil
+	}
+
+	gsd, err := c.deletionDeserialization(r, namespace)
+	if err != nil {
+		return err
+	}
+
+	var result k8sruntime.Object
+	if causes, ok := gsd.Validate(); !ok {
+		result = invalidStatus(gsd, causes)
+		w.Header().Set("Content-Type", k8sruntime.ContentTypeJSON)
+		w.WriteHeader(http.StatusUnprocessableEntity)
+	} else {
+		list, err := c.gameServersToDelete(gsd)
+		if err != nil {
+			return err
+		}
+		if dryRun == "" {
+			var remaining int
+			list, remaining = c.shutdownGameServers(list)
+			gsd.Status.Remaining += int32(remaining)
+		}
+		for _, gs := range list {
+			gsd.Status.GameServers = append(gsd.Status.GameServers, gs.ObjectMeta.Name)
+		}
+		result = gsd
+	}
+
+	info, err := apiserver.AcceptedSerializer(r, scheme.Codecs)
+	if err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", info.MediaType)
+	return errors.Wrapf(info.Serializer.Encode(result, w), "error encoding %T", result)
+}
+
+// gameServersToDelete returns the GameServers that the GameServerDeletion selects, in the order
+// its strategy deletes them, up to its limit. Sets the number of the ones left over on its status.
+func (c *Controller) gameServersToDelete(gsd *allocationv1.GameServerDeletion) ([]*agonesv1.GameServer, error) {
+	selector, err := metav1.LabelSelectorAsSelector(&gsd.Spec.Selector)
+	if err != nil {
+		return nil, errors.Wrap(err, "error converting the selector of the gameserverdeletion")
+	}
+	all, err := c.gameServerLister.GameServers(gsd.ObjectMeta.Namespace).List(selector)
+	if err != nil {
+		return nil, errors.Wrap(err, "error listing gameservers to delete")
+	}
+
+	var list []*agonesv1.GameServer
+	for _, gs := range all {
+		if gsd.Matches(selector, gs) {
+			list = append(list, gs)
+		}
+	}
+
+	list = gameserversets.SortGameServersForScaleDown(gsd.Spec.Strategy, list, c.counter.Counts())
+	if limit := int(gsd.Spec.Limit); limit > 0 && len(list) > limit {
+		gsd.Status.Remaining = int32(len(list) - limit)
+		list = list[:limit]
+	}
+	return list, nil
+}
+
+// shutdownGameServers moves the GameServers to Shutdown, for the GameServer controller to delete them,
+// as long as the limiter allows it, without waiting for it, so that a request never blocks.
+// Returns the GameServers that were shut down, and the number of the ones left for a later request.
+func (c *Controller) shutdownGameServers(list []*agonesv1.GameServer) ([]*agonesv1.GameServer, int) {
+	var shutdown []*agonesv1.GameServer
+	for i, gs := range list {
+		if !c.limiter.Allow() {
+			return shutdown, len(list) - i
+		}
+
+		gsCopy := gs.DeepCopy()
+		gsCopy.Shutdown(agonesv1.ShutdownReasonDeletion)
+		if _, err := c.gameServerGetter.GameServers(gsCopy.ObjectMeta.Namespace).Update(gsCopy); err != nil {
+			// the GameServer may have been allocated since it was listed, so don't shut it down
+			c.baseLogger.WithError(err).WithField("gs", gs.ObjectMeta.Name).Warn("Could not shut down GameServer")
+			continue
+		}
+		c.recorder.Eventf(gsCopy, corev1.EventTypeNormal, string(gsCopy.Status.State), "Shut down by a GameServerDeletion, from state %s", gs.Status.State)
+		shutdown = append(shutdown, gsCopy)
+	}
+	return shutdown, 0
+}
+
+// deletionDeserialization processes the request and namespace, and attempts to deserialise its values
+// into a GameServerDeletion. Returns an error if it fails for whatever reason.
+func (c *Controller) deletionDeserialization(r *http.Request, namespace string) (*allocationv1.GameServerDeletion, error) {
+	gsd := &allocationv1.GameServerDeletion{}
+
+	gvks, _, err := scheme.Scheme.ObjectKinds(gsd)
+	if err != nil {
+		return gsd, errors.Wrap(err, "error getting objectkinds for gameserverdeletion")
+	}
+
+	gsd.TypeMeta = metav1.TypeMeta{Kind: gvks[0].Kind, APIVersion: gvks[0].GroupVersion().String()}
+
+	gvk := allocationv1.SchemeGroupVersion.WithKind("GameServerDeletion")
+	if err := apiserver.DecodeRequest(r, scheme.Codecs, gvk, gsd, c.baseLogger); err != nil {
+		return gsd, err
+	}
+
+	gsd.ObjectMeta.Namespace = namespace
+	gsd.ObjectMeta.CreationTimestamp = metav1.Now()
+	gsd.ApplyDefaults()
+
+	return gsd, nil
+}
+
+// invalidStatus returns the Status of an invalid GameServerDeletion
+func invalidStatus(gsd *allocationv1.GameServerDeletion, causes []metav1.StatusCause) *metav1.Status {
+	return &metav1.Status{
+		TypeMeta: metav1.TypeMeta{Kind: "Status", APIVersion: "v1"},
+		Status:   metav1.StatusFailure,
+		Message:  fmt.Sprintf("GameServerDeletion is invalid: Invalid value: %#v", gsd.Spec),
+		Reason:   metav1.StatusReasonInvalid,
+		Details: &metav1.StatusDetails{
+			Kind:   "GameServerDeletion",
+			Group:  allocationv1.SchemeGroupVersion.Group,
+			Causes: causes,
+		},
+		Code: http.StatusUnprocessableEntity,
+	}
+}
@@ -0,0 +1,215 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gameserverdeletions
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
+	allocationv1 "agones.dev/agones/pkg/apis/allocation/v1"
+	"agones.dev/agones/pkg/gameservers"
+	agtesting "agones.dev/agones/pkg/testing"
+	"agones.dev/agones/pkg/util/apiserver"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+const defaultNs = "default"
+
+func TestControllerProcessDeletionRequest(t *testing.T) {
+	t.Parallel()
+
+	fixtures := func() []agonesv1.GameServer {
+		now := time.Now()
+		var list []agonesv1.GameServer
+		for i, state := range []agonesv1.GameServerState{agonesv1.GameServerStateReady, agonesv1.GameServerStateAllocated,
+			agonesv1.GameServerStateReady, agonesv1.GameServerStateUnhealthy, agonesv1.GameServerStateReady} {
+			list = append(list, agonesv1.GameServer{
+				ObjectMeta: metav1.ObjectMeta{Name: "gs" + strconv.Itoa(i), Namespace: defaultNs,
+					Labels:            map[string]string{"region": "eu"},
+					CreationTimestamp: metav1.NewTime(now.Add(time.Duration(i) * time.Minute))},
+				Status: agonesv1.GameServerStatus{State: state},
+			})
+		}
+		list[4].ObjectMeta.Labels["region"] = "us"
+		return list
+	}
+
+	newGsd := func() *allocationv1.GameServerDeletion {
+		return &allocationv1.GameServerDeletion{Spec: allocationv1.GameServerDeletionSpec{
+			Selector: metav1.LabelSelector{MatchLabels: map[string]string{"region": "eu"}},
+			Strategy: agonesv1.OldestFirstScaleDown,
+		}}
+	}
+
+	// processWithRate runs the request against a controller with the fixtures and the deletion rate,
+	// and returns the response and the names of the GameServers that were shut down
+	processWithRate := func(t *testing.T, deletionRate float64, method, dryRun string, gsd *allocationv1.GameServerDeletion) (*httptest.ResponseRecorder, []string) {
+		c, m := newFakeController()
+		c.limiter = newDeletionLimiter(deletionRate)
+		gsList := fixtures()
+		m.AgonesClient.AddReactor("list", "gameservers", func(action k8stesting.Action) (bool, k8sruntime.Object, error) {
+			return true, &agonesv1.GameServerList{Items: gsList}, nil
+		})
+		var shutdown []string
+		m.AgonesClient.AddReactor("update", "gameservers", func(action k8stesting.Action) (bool, k8sruntime.Object, error) {
+			gs := action.(k8stesting.UpdateAction).GetObject().(*agonesv1.GameServer)
+			assert.Equal(t, agonesv1.GameServerStateShutdown, gs.Status.State)
+			assert.Equal(t, string(agonesv1.ShutdownReasonDeletion), gs.ObjectMeta.Annotations[agonesv1.GameServerShutdownReasonAnnotation])
+			shutdown = append(shutdown, gs.ObjectMeta.Name)
+			return true, gs, nil
+		})
+
+		_, cancel := agtesting.StartInformers(m, c.gameServerSynced)
+		defer cancel()
+
+		buf := bytes.NewBuffer(nil)
+		assert.NoError(t, json.NewEncoder(buf).Encode(gsd))
+		r, err := http.NewRequest(method, "/", buf)
+		assert.NoError(t, err)
+		r.Header.Set("Content-Type", k8sruntime.ContentTypeJSON)
+		if dryRun != "" {
+			r.URL.RawQuery = "dryRun=" + dryRun
+		}
+
+		rec := httptest.NewRecorder()
+		assert.NoError(t, c.processDeletionRequest(rec, r, defaultNs))
+		return rec, shutdown
+	}
+	process := func(t *testing.T, method, dryRun string, gsd *allocationv1.GameServerDeletion) (*httptest.ResponseRecorder, []string) {
+		return processWithRate(t, 0, method, dryRun, gsd)
+	}
+
+	result := func(t *testing.T, rec *httptest.ResponseRecorder) *allocationv1.GameServerDeletion {
+		ret := &allocationv1.GameServerDeletion{}
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), ret))
+		return ret
+	}
+
+	t.Run("delete", func(t *testing.T) {
+		rec, shutdown := process(t, http.MethodPost, "", newGsd())
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, []string{"gs0", "gs2", "gs3"}, shutdown)
+
+		ret := result(t, rec)
+		assert.Equal(t, "GameServerDeletion", ret.Kind)
+		assert.Equal(t, defaultNs, ret.ObjectMeta.Namespace)
+		assert.Equal(t, []string{"gs0", "gs2", "gs3"}, ret.Status.GameServers)
+		assert.Equal(t, int32(0), ret.Status.Remaining)
+	})
+
+	t.Run("limit and states", func(t *testing.T) {
+		gsd := newGsd()
+		gsd.Spec.Limit = 2
+		gsd.Spec.Strategy = agonesv1.NewestFirstScaleDown
+		gsd.Spec.States = []agonesv1.GameServerState{agonesv1.GameServerStateReady, agonesv1.GameServerStateAllocated}
+		rec, shutdown := process(t, http.MethodPost, "", gsd)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, []string{"gs2", "gs1"}, shutdown)
+
+		ret := result(t, rec)
+		assert.Equal(t, []string{"gs2", "gs1"}, ret.Status.GameServers)
+		assert.Equal(t, int32(1), ret.Status.Remaining)
+	})
+
+	t.Run("rate limited", func(t *testing.T) {
+		gsd := newGsd()
+		gsd.Spec.Limit = 2
+		rec, shutdown := processWithRate(t, 1, http.MethodPost, "", gsd)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, []string{"gs0"}, shutdown)
+
+		ret := result(t, rec)
+		assert.Equal(t, []string{"gs0"}, ret.Status.GameServers)
+		assert.Equal(t, int32(2), ret.Status.Remaining)
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		gsd := newGsd()
+		gsd.Spec.Limit = 1
+		rec, shutdown := process(t, http.MethodPost, "All", gsd)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Empty(t, shutdown)
+
+		ret := result(t, rec)
+		assert.Equal(t, []string{"gs0"}, ret.Status.GameServers)
+		assert.Equal(t, int32(2), ret.Status.Remaining)
+	})
+
+	t.Run("invalid dry run", func(t *testing.T) {
+		rec, shutdown := process(t, http.MethodPost, "Nope", newGsd())
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Empty(t, shutdown)
+	})
+
+	t.Run("method not allowed", func(t *testing.T) {
+		rec, shutdown := process(t, http.MethodGet, "", newGsd())
+		assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+		assert.Empty(t, shutdown)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		gsd := newGsd()
+		gsd.Spec.Selector = metav1.LabelSelector{}
+		rec, shutdown := process(t, http.MethodPost, "", gsd)
+		assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+		assert.Empty(t, shutdown)
+
+		s := &metav1.Status{}
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), s))
+		assert.Equal(t, metav1.StatusReasonInvalid, s.Reason)
+		if assert.NotNil(t, s.Details) && assert.Len(t, s.Details.Causes, 1) {
+			assert.Equal(t, "spec.selector", s.Details.Causes[0].Field)
+		}
+	})
+}
+
+func TestNewDeletionLimiter(t *testing.T) {
+	t.Parallel()
+
+	limiter := newDeletionLimiter(0)
+	for i := 0; i < 100; i++ {
+		assert.True(t, limiter.Allow())
+	}
+
+	limiter = newDeletionLimiter(0.001)
+	assert.True(t, limiter.Allow())
+	assert.False(t, limiter.Allow())
+
+	limiter = newDeletionLimiter(2.5)
+	for i := 0; i < 3; i++ {
+		assert.True(t, limiter.Allow())
+	}
+	assert.False(t, limiter.Allow())
+}
+
+// newFakeController returns a controller, backed by the fake Clientset
+func newFakeController() (*Controller, agtesting.Mocks) {
+	m := agtesting.NewMocks()
+	m.Mux = http.NewServeMux()
+	counter := gameservers.NewPerNodeCounter(m.KubeInformerFactory, m.AgonesInformerFactory)
+	api := apiserver.NewAPIServer(m.Mux)
+	c := NewController(api, counter, 0, m.KubeClient, m.AgonesClient, m.AgonesInformerFactory)
+	c.recorder = m.FakeRecorder
+	return c, m
+}
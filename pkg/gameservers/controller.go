@@ -39,27 +39,33 @@ import (
 	"github.com/sirupsen/logrus"
 	admv1beta1 "k8s.io/api/admission/v1beta1"
 	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	extclientset "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
 	"k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/typed/apiextensions/v1beta1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/clock"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/scheme"
 	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	typedrbacv1 "k8s.io/client-go/kubernetes/typed/rbac/v1"
 	corelisterv1 "k8s.io/client-go/listers/core/v1"
+	rbaclisterv1 "k8s.io/client-go/listers/rbac/v1"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
-	"k8s.io/client-go/util/workqueue"
 )
 
 const (
-	sdkserverSidecarName = "agones-gameserver-sidecar"
+	sdkserverSidecarName = agonesv1.SidecarContainerName
 	grpcPortEnvVar       = "AGONES_SDK_GRPC_PORT"
 	httpPortEnvVar       = "AGONES_SDK_HTTP_PORT"
+	tokenFileEnvVar      = "AGONES_SDK_TOKEN_FILE"
+	// sdkServerTokenFile is the file of the SDK server token, in the containers of a GameServer that sets one
+	sdkServerTokenFile = agonesv1.SdkServerTokenMountPath + "/" + agonesv1.SdkServerTokenKey
 )
 
 // Controller is a the main GameServer crd controller
@@ -69,9 +75,24 @@ type Controller struct {
 	alwaysPullSidecarImage bool
 	sidecarCPURequest      resource.Quantity
 	sidecarCPULimit        resource.Quantity
+	sidecarMemoryRequest   resource.Quantity
+	sidecarMemoryLimit     resource.Quantity
 	sdkServiceAccount      string
+	podSnippet             PodSnippet
+	podModifiers           []PodModifier
+	gameServerEnv          []corev1.EnvVar
+	finalizerTimeout       time.Duration
+	clockSkewTolerance     time.Duration
+	nodeLabels             []string
+	safeToEvictAnnotation  bool
+	clock                  clock.Clock
 	crdGetter              v1beta1.CustomResourceDefinitionInterface
 	podGetter              typedcorev1.PodsGetter
+	serviceAccountGetter   typedcorev1.ServiceAccountsGetter
+	roleBindingGetter      typedrbacv1.RoleBindingsGetter
+	roleBindingLister      rbaclisterv1.RoleBindingLister
+	roleBindingSynced      cache.InformerSynced
+	sdkServiceAccounts     map[string]bool // the namespace/name of the service accounts that can be bound to the SDK server role
 	podLister              corelisterv1.PodLister
 	podSynced              cache.InformerSynced
 	gameServerGetter       getterv1.GameServersGetter
@@ -79,8 +100,11 @@ type Controller struct {
 	gameServerSynced       cache.InformerSynced
 	nodeLister             corelisterv1.NodeLister
 	nodeSynced             cache.InformerSynced
+	namespaceLister        corelisterv1.NamespaceLister
+	namespaceSynced        cache.InformerSynced
 	portAllocator          *PortAllocator
 	healthController       *HealthController
+	disruptionController   *DisruptionController
 	workerqueue            *workerqueue.WorkerQueue
 	creationWorkerQueue    *workerqueue.WorkerQueue // handles creation only
 	deletionWorkerQueue    *workerqueue.WorkerQueue // handles deletion only
@@ -88,7 +112,13 @@ type Controller struct {
 	recorder               record.EventRecorder
 }
 
-// NewController returns a new gameserver crd controller
+// NewController returns a new gameserver crd controller.
+// clockSkewTolerance is added to timeouts that are measured from timestamps set by the API server.
+// sdkServiceAccounts are the namespace/name of the service accounts, of game servers that run the SDK server themselves,
+// that the controller binds to the role of the SDK server.
+// nodeLabels are the labels of a Node that are copied onto the GameServers that are scheduled on it.
+// safeToEvictAnnotation is whether the cluster autoscaler safe-to-evict annotation is set on GameServer Pods.
+// rateLimiter configures the retries of the GameServers that fail to sync, in each of the controller queues.
 func NewController(
 	wh *webhooks.WebHook,
 	health healthcheck.Handler,
@@ -97,7 +127,17 @@ func NewController(
 	alwaysPullSidecarImage bool,
 	sidecarCPURequest resource.Quantity,
 	sidecarCPULimit resource.Quantity,
+	sidecarMemoryRequest resource.Quantity,
+	sidecarMemoryLimit resource.Quantity,
 	sdkServiceAccount string,
+	sdkServiceAccounts []string,
+	podSnippet PodSnippet,
+	gameServerEnv []corev1.EnvVar,
+	finalizerTimeout time.Duration,
+	clockSkewTolerance time.Duration,
+	nodeLabels []string,
+	safeToEvictAnnotation bool,
+	rateLimiter workerqueue.FastSlowRateLimiter,
 	kubeClient kubernetes.Interface,
 	kubeInformerFactory informers.SharedInformerFactory,
 	extClient extclientset.Interface,
@@ -112,10 +152,24 @@ func NewController(
 		sidecarImage:           sidecarImage,
 		sidecarCPULimit:        sidecarCPULimit,
 		sidecarCPURequest:      sidecarCPURequest,
+		sidecarMemoryLimit:     sidecarMemoryLimit,
+		sidecarMemoryRequest:   sidecarMemoryRequest,
 		alwaysPullSidecarImage: alwaysPullSidecarImage,
 		sdkServiceAccount:      sdkServiceAccount,
+		podSnippet:             podSnippet,
+		podModifiers:           registeredPodModifiers(),
+		gameServerEnv:          gameServerEnv,
+		finalizerTimeout:       finalizerTimeout,
+		clockSkewTolerance:     clockSkewTolerance,
+		nodeLabels:             nodeLabels,
+		safeToEvictAnnotation:  safeToEvictAnnotation,
+		clock:                  clock.RealClock{},
 		crdGetter:              extClient.ApiextensionsV1beta1().CustomResourceDefinitions(),
 		podGetter:              kubeClient.CoreV1(),
+		serviceAccountGetter:   kubeClient.CoreV1(),
+		roleBindingGetter:      kubeClient.RbacV1(),
+		roleBindingSynced:      func() bool { return true },
+		sdkServiceAccounts:     map[string]bool{},
 		podLister:              pods.Lister(),
 		podSynced:              pods.Informer().HasSynced,
 		gameServerGetter:       agonesClient.AgonesV1(),
@@ -123,23 +177,36 @@ func NewController(
 		gameServerSynced:       gsInformer.HasSynced,
 		nodeLister:             kubeInformerFactory.Core().V1().Nodes().Lister(),
 		nodeSynced:             kubeInformerFactory.Core().V1().Nodes().Informer().HasSynced,
+		namespaceLister:        kubeInformerFactory.Core().V1().Namespaces().Lister(),
+		namespaceSynced:        kubeInformerFactory.Core().V1().Namespaces().Informer().HasSynced,
 		portAllocator:          NewPortAllocator(minPort, maxPort, kubeInformerFactory, agonesInformerFactory),
 		healthController:       NewHealthController(health, kubeClient, agonesClient, kubeInformerFactory, agonesInformerFactory),
+		disruptionController:   NewDisruptionController(health, kubeClient, agonesClient, kubeInformerFactory, agonesInformerFactory),
 	}
 
 	c.baseLogger = runtime.NewLoggerWithType(c)
 
+	// only watch RoleBindings when there are service accounts to bind to the SDK server role
+	for _, sa := range sdkServiceAccounts {
+		c.sdkServiceAccounts[sa] = true
+	}
+	if len(c.sdkServiceAccounts) > 0 {
+		roleBindings := kubeInformerFactory.Rbac().V1().RoleBindings()
+		c.roleBindingLister = roleBindings.Lister()
+		c.roleBindingSynced = roleBindings.Informer().HasSynced
+	}
+
 	eventBroadcaster := record.NewBroadcaster()
 	eventBroadcaster.StartLogging(c.baseLogger.Infof)
 	eventBroadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{Interface: kubeClient.CoreV1().Events("")})
 	c.recorder = eventBroadcaster.NewRecorder(scheme.Scheme, corev1.EventSource{Component: "gameserver-controller"})
 
-	c.workerqueue = workerqueue.NewWorkerQueueWithRateLimiter(c.syncGameServer, c.baseLogger, logfields.GameServerKey, agones.GroupName+".GameServerController", fastRateLimiter())
-	c.creationWorkerQueue = workerqueue.NewWorkerQueueWithRateLimiter(c.syncGameServer, c.baseLogger.WithField("subqueue", "creation"), logfields.GameServerKey, agones.GroupName+".GameServerControllerCreation", fastRateLimiter())
-	c.deletionWorkerQueue = workerqueue.NewWorkerQueueWithRateLimiter(c.syncGameServer, c.baseLogger.WithField("subqueue", "deletion"), logfields.GameServerKey, agones.GroupName+".GameServerControllerDeletion", fastRateLimiter())
-	health.AddLivenessCheck("gameserver-workerqueue", healthcheck.Check(c.workerqueue.Healthy))
-	health.AddLivenessCheck("gameserver-creation-workerqueue", healthcheck.Check(c.creationWorkerQueue.Healthy))
-	health.AddLivenessCheck("gameserver-deletion-workerqueue", healthcheck.Check(c.deletionWorkerQueue.Healthy))
+	c.workerqueue = workerqueue.NewWorkerQueueWithRateLimiter(c.syncGameServer, c.baseLogger, logfields.GameServerKey, agones.GroupName+".GameServerController", rateLimiter.RateLimiter())
+	c.creationWorkerQueue = workerqueue.NewWorkerQueueWithRateLimiter(c.syncGameServer, c.baseLogger.WithField("subqueue", "creation"), logfields.GameServerKey, agones.GroupName+".GameServerControllerCreation", rateLimiter.RateLimiter())
+	c.deletionWorkerQueue = workerqueue.NewWorkerQueueWithRateLimiter(c.syncGameServer, c.baseLogger.WithField("subqueue", "deletion"), logfields.GameServerKey, agones.GroupName+".GameServerControllerDeletion", rateLimiter.RateLimiter())
+	c.workerqueue.AddHealthChecks(health, "gameserver-workerqueue")
+	c.creationWorkerQueue.AddHealthChecks(health, "gameserver-creation-workerqueue")
+	c.deletionWorkerQueue.AddHealthChecks(health, "gameserver-deletion-workerqueue")
 
 	wh.AddHandler("/mutate", agonesv1.Kind("GameServer"), admv1beta1.Create, c.creationMutationHandler)
 	wh.AddHandler("/validate", agonesv1.Kind("GameServer"), admv1beta1.Create, c.creationValidationHandler)
@@ -152,6 +219,11 @@ func NewController(
 			newGs := newObj.(*agonesv1.GameServer)
 			if oldGs.Status.State != newGs.Status.State || oldGs.ObjectMeta.DeletionTimestamp != newGs.ObjectMeta.DeletionTimestamp {
 				c.enqueueGameServerBasedOnState(newGs)
+				return
+			}
+			// or when the state and node name labels have been changed by another update
+			if newGs.DeepCopy().ApplyStatusLabels() {
+				c.enqueueGameServerBasedOnState(newGs)
 			}
 		},
 	})
@@ -198,17 +270,8 @@ func (c *Controller) enqueueGameServerBasedOnState(item interface{}) {
 	}
 }
 
-// fastRateLimiter returns a fast rate limiter, without exponential back-off.
-func fastRateLimiter() workqueue.RateLimiter {
-	const numFastRetries = 5
-	const fastDelay = 20 * time.Millisecond  // first few retries up to 'numFastRetries' are fast
-	const slowDelay = 500 * time.Millisecond // subsequent retries are slow
-
-	return workqueue.NewItemFastSlowRateLimiter(fastDelay, slowDelay, numFastRetries)
-}
-
 // creationMutationHandler is the handler for the mutating webhook that sets the
-// the default values on the GameServer
+// the default values on the GameServer, the overrides of its namespace first
 // Should only be called on gameserver create operations.
 // nolint:dupl
 func (c *Controller) creationMutationHandler(review admv1beta1.AdmissionReview) (admv1beta1.AdmissionReview, error) {
@@ -222,7 +285,9 @@ func (c *Controller) creationMutationHandler(review admv1beta1.AdmissionReview)
 
 	// This is the main logic of this function
 	// the rest is really just json plumbing
+	GetNamespaceDefaults(c.namespaceLister, review.Request.Namespace, c.baseLogger).ApplyToGameServer(gs)
 	gs.ApplyDefaults()
+	gs.ApplyStatusLabels()
 
 	newGS, err := json.Marshal(gs)
 	if err != nil {
@@ -273,8 +338,13 @@ func (c *Controller) creationValidationHandler(review admv1beta1.AdmissionReview
 
 	c.loggerForGameServer(gs).WithField("review", review).Info("creationValidationHandler")
 
-	causes, ok := gs.Validate()
-	if !ok {
+	causes, _ := gs.Validate()
+	causes = append(causes, c.validatePortRange(gs)...)
+	causes = append(causes, c.podSnippet.validateGameServer(gs)...)
+	if checkStaticPorts(gs) {
+		causes = append(causes, gs.Spec.ValidateStaticPorts(c.portAllocator.PortRange())...)
+	}
+	if len(causes) > 0 {
 		review.Response.Allowed = false
 		details := metav1.StatusDetails{
 			Name:   review.Request.Name,
@@ -296,6 +366,47 @@ func (c *Controller) creationValidationHandler(review admv1beta1.AdmissionReview
 	return review, nil
 }
 
+// checkStaticPorts returns whether the Static ports of the GameServer should be checked against the port range.
+// The ports of the GameServers of a GameServerSet are checked when its Fleet is created, or its ports change,
+// so that Fleets can still be scaled after the port range changes, and development GameServers have no node ports.
+func checkStaticPorts(gs *agonesv1.GameServer) bool {
+	if _, ok := gs.GetDevAddress(); ok {
+		return false
+	}
+	ref := metav1.GetControllerOf(gs)
+	return ref == nil || ref.Kind != "GameServerSet"
+}
+
+// validatePortRange validates that the port range of the GameServer is within
+// the range of ports that can be allocated
+func (c *Controller) validatePortRange(gs *agonesv1.GameServer) []metav1.StatusCause {
+	pr := gs.Spec.PortRange
+	if pr == nil {
+		return nil
+	}
+	r := c.portAllocator.PortRange()
+	if r.Contains(pr.MinPort) && r.Contains(pr.MaxPort) {
+		return nil
+	}
+	return []metav1.StatusCause{{
+		Type:    metav1.CauseTypeFieldValueInvalid,
+		Field:   "portRange",
+		Message: fmt.Sprintf("PortRange %d-%d must be within the allocatable port range %d-%d", pr.MinPort, pr.MaxPort, r.MinPort, r.MaxPort),
+	}}
+}
+
+// PortAllocatorSynced returns true once the port allocator has synced
+// the ports in use by existing GameServers
+func (c *Controller) PortAllocatorSynced() bool {
+	return c.portAllocator.HasSynced()
+}
+
+// PortRange returns the range of ports Dynamic and Passthrough ports are allocated from,
+// which can change while the controller runs
+func (c *Controller) PortRange() agonesv1.PortRange {
+	return c.portAllocator.PortRange()
+}
+
 // Run the GameServer controller. Will block until stop is closed.
 // Runs threadiness number workers to process the rate limited queue
 func (c *Controller) Run(workers int, stop <-chan struct{}) error {
@@ -307,7 +418,7 @@ func (c *Controller) Run(workers int, stop <-chan struct{}) error {
 	}
 
 	c.baseLogger.Info("Wait for cache sync")
-	if !cache.WaitForCacheSync(stop, c.gameServerSynced, c.podSynced, c.nodeSynced) {
+	if !cache.WaitForCacheSync(stop, c.gameServerSynced, c.podSynced, c.nodeSynced, c.namespaceSynced, c.roleBindingSynced) {
 		return errors.New("failed to wait for caches to sync")
 	}
 
@@ -324,6 +435,13 @@ func (c *Controller) Run(workers int, stop <-chan struct{}) error {
 		}
 	}()
 
+	// Run the Disruption Controller
+	go func() {
+		if err := c.disruptionController.Run(stop); err != nil {
+			c.baseLogger.WithError(err).Error("error running disruption controller")
+		}
+	}()
+
 	// start work queues
 	var wg sync.WaitGroup
 
@@ -379,9 +497,15 @@ func (c *Controller) syncGameServer(key string) error {
 	if gs, err = c.syncGameServerRequestReadyState(gs); err != nil {
 		return err
 	}
+	if gs, err = c.syncGameServerReservedState(gs); err != nil {
+		return err
+	}
 	if gs, err = c.syncDevelopmentGameServer(gs); err != nil {
 		return err
 	}
+	if gs, err = c.syncGameServerStatusLabels(gs); err != nil {
+		return err
+	}
 	if err = c.syncGameServerShutdownState(gs); err != nil {
 		return err
 	}
@@ -393,6 +517,8 @@ func (c *Controller) syncGameServer(key string) error {
 // then do one of two things:
 // - if the GameServer has Pods running, delete them
 // - if there no pods, remove the finalizer
+// If the GameServer has been stuck deleting for longer than the configured
+// finalizer timeout, the finalizer is removed regardless of the Pod.
 func (c *Controller) syncGameServerDeletionTimestamp(gs *agonesv1.GameServer) (*agonesv1.GameServer, error) {
 	if gs.ObjectMeta.DeletionTimestamp.IsZero() {
 		return gs, nil
@@ -400,6 +526,10 @@ func (c *Controller) syncGameServerDeletionTimestamp(gs *agonesv1.GameServer) (*
 
 	c.loggerForGameServer(gs).Info("Syncing with Deletion Timestamp")
 
+	if c.finalizerTimeoutExceeded(gs) {
+		return c.forceRemoveFinalizer(gs)
+	}
+
 	pod, err := c.gameServerPod(gs)
 	if err != nil && !k8serrors.IsNotFound(err) {
 		return gs, err
@@ -409,19 +539,97 @@ func (c *Controller) syncGameServerDeletionTimestamp(gs *agonesv1.GameServer) (*
 	if pod != nil && !isDev {
 		// only need to do this once
 		if pod.ObjectMeta.DeletionTimestamp.IsZero() {
+			if remaining := c.terminationGraceRemaining(gs); remaining > 0 {
+				// let the game server finish its game session, the GameServer is synced again
+				// when it moves to Shutdown, or once the window is over
+				c.loggerForGameServer(gs).WithField("remaining", remaining).Info("Waiting for the game server to shut down before deleting its Pod")
+				c.workerqueue.EnqueueAfter(gs, remaining)
+				return gs, nil
+			}
+
 			err = c.podGetter.Pods(pod.ObjectMeta.Namespace).Delete(pod.ObjectMeta.Name, nil)
 			if err != nil {
 				return gs, errors.Wrapf(err, "error deleting pod for GameServer %s, %s", gs.ObjectMeta.Name, pod.ObjectMeta.Name)
 			}
-			c.recorder.Event(gs, corev1.EventTypeNormal, string(gs.Status.State), fmt.Sprintf("Deleting Pod %s", pod.ObjectMeta.Name))
+			msg := fmt.Sprintf("Deleting Pod %s", pod.ObjectMeta.Name)
+			if timeout := preDeleteTimeout(gs); timeout > 0 {
+				msg += fmt.Sprintf(", running its pre-delete hook for up to %s", timeout)
+			}
+			c.recorder.Event(gs, corev1.EventTypeNormal, string(gs.Status.State), msg)
+		}
+
+		// come back once the finalizer timeout has passed, in case the Pod never goes away
+		if c.finalizerTimeout > 0 {
+			c.workerqueue.EnqueueAfter(gs, c.finalizerTimeout+terminationGracePeriod(gs)+preDeleteTimeout(gs)+c.clockSkewTolerance-c.clock.Since(gs.ObjectMeta.DeletionTimestamp.Time))
 		}
 
 		// but no removing finalizers until it's truly gone
 		return gs, nil
 	}
 
+	c.loggerForGameServer(gs).Infof("No pods found, removing finalizer %s", agones.GroupName)
+	return c.removeFinalizer(gs)
+}
+
+// finalizerTimeoutExceeded returns true if the GameServer has been waiting on its
+// finalizer for longer than the configured finalizer timeout, after its termination grace period
+// and the timeout of its pre-delete hook. The DeletionTimestamp is set with the clock of the API server,
+// so the clock skew tolerance is added to the timeout, to not force removal early when the controller clock is ahead.
+func (c *Controller) finalizerTimeoutExceeded(gs *agonesv1.GameServer) bool {
+	if c.finalizerTimeout <= 0 || gs.ObjectMeta.DeletionTimestamp.IsZero() {
+		return false
+	}
+	return c.clock.Since(gs.ObjectMeta.DeletionTimestamp.Time) > c.finalizerTimeout+terminationGracePeriod(gs)+preDeleteTimeout(gs)+c.clockSkewTolerance
+}
+
+// terminationGraceRemaining returns how much longer the Pod of a deleted GameServer is kept running,
+// for the game server to finish its game session and call Shutdown. There is no window left once the
+// GameServer has moved to Shutdown, or if it is Unhealthy or in Error, as its game session is over.
+func (c *Controller) terminationGraceRemaining(gs *agonesv1.GameServer) time.Duration {
+	grace := terminationGracePeriod(gs)
+	if grace <= 0 || gs.ObjectMeta.DeletionTimestamp.IsZero() {
+		return 0
+	}
+	switch gs.Status.State {
+	case agonesv1.GameServerStateShutdown, agonesv1.GameServerStateUnhealthy, agonesv1.GameServerStateError:
+		return 0
+	}
+	return grace + c.clockSkewTolerance - c.clock.Since(gs.ObjectMeta.DeletionTimestamp.Time)
+}
+
+// terminationGracePeriod returns the termination grace period of the GameServer
+func terminationGracePeriod(gs *agonesv1.GameServer) time.Duration {
+	return time.Duration(gs.Spec.TerminationGracePeriodSeconds) * time.Second
+}
+
+// preDeleteTimeout returns how long the pre-delete hook of the GameServer can run for, once its Pod is deleted
+func preDeleteTimeout(gs *agonesv1.GameServer) time.Duration {
+	if gs.Spec.PreDelete == nil {
+		return 0
+	}
+	return time.Duration(gs.Spec.PreDelete.TimeoutSeconds) * time.Second
+}
+
+// forceRemoveFinalizer removes the finalizer from a GameServer that has been stuck
+// in deletion, without waiting for its backing Pod to be removed
+func (c *Controller) forceRemoveFinalizer(gs *agonesv1.GameServer) (*agonesv1.GameServer, error) {
+	c.loggerForGameServer(gs).WithField("finalizerTimeout", c.finalizerTimeout).
+		Warnf("Finalizer timeout exceeded, forcing removal of finalizer %s", agones.GroupName)
+
+	result, err := c.removeFinalizer(gs)
+	if err != nil {
+		return result, err
+	}
+
+	recordFinalizerForceRemoved(c.loggerForGameServer(gs), gs)
+	c.recorder.Eventf(gs, corev1.EventTypeWarning, "FinalizerForceRemoved",
+		"GameServer was still terminating after %s, finalizer removed", c.finalizerTimeout)
+	return result, nil
+}
+
+// removeFinalizer removes the finalizer for this controller from the GameServer
+func (c *Controller) removeFinalizer(gs *agonesv1.GameServer) (*agonesv1.GameServer, error) {
 	gsCopy := gs.DeepCopy()
-	// remove the finalizer for this controller
 	var fin []string
 	for _, f := range gsCopy.ObjectMeta.Finalizers {
 		if f != agones.GroupName {
@@ -429,8 +637,7 @@ func (c *Controller) syncGameServerDeletionTimestamp(gs *agonesv1.GameServer) (*
 		}
 	}
 	gsCopy.ObjectMeta.Finalizers = fin
-	c.loggerForGameServer(gsCopy).Infof("No pods found, removing finalizer %s", agones.GroupName)
-	gs, err = c.gameServerGetter.GameServers(gsCopy.ObjectMeta.Namespace).Update(gsCopy)
+	gs, err := c.gameServerGetter.GameServers(gsCopy.ObjectMeta.Namespace).Update(gsCopy)
 	return gs, errors.Wrapf(err, "error removing finalizer for GameServer %s", gsCopy.ObjectMeta.Name)
 }
 
@@ -443,6 +650,7 @@ func (c *Controller) syncGameServerPortAllocationState(gs *agonesv1.GameServer)
 	gsCopy := c.portAllocator.Allocate(gs.DeepCopy())
 
 	gsCopy.Status.State = agonesv1.GameServerStateCreating
+	gsCopy.ApplyStatusLabels()
 	c.recorder.Event(gs, corev1.EventTypeNormal, string(gs.Status.State), "Port allocated")
 
 	c.loggerForGameServer(gsCopy).Info("Syncing Port Allocation GameServerState")
@@ -469,6 +677,12 @@ func (c *Controller) syncGameServerCreatingState(gs *agonesv1.GameServer) (*agon
 
 	c.loggerForGameServer(gs).Info("Syncing Create State")
 
+	if NamespaceTerminating(c.namespaceLister, gs.ObjectMeta.Namespace) {
+		// the Pod would be rejected, and the GameServer is deleted with the namespace anyway
+		c.loggerForGameServer(gs).Info("Namespace is terminating, not creating Pod")
+		return gs, nil
+	}
+
 	// Maybe something went wrong, and the pod was created, but the state was never moved to Starting, so let's check
 	_, err := c.gameServerPod(gs)
 	if k8serrors.IsNotFound(err) {
@@ -484,6 +698,7 @@ func (c *Controller) syncGameServerCreatingState(gs *agonesv1.GameServer) (*agon
 
 	gsCopy := gs.DeepCopy()
 	gsCopy.Status.State = agonesv1.GameServerStateStarting
+	gsCopy.ApplyStatusLabels()
 	gs, err = c.gameServerGetter.GameServers(gs.ObjectMeta.Namespace).Update(gsCopy)
 	if err != nil {
 		return gs, errors.Wrapf(err, "error updating GameServer %s to Starting state", gs.Name)
@@ -517,6 +732,11 @@ func (c *Controller) syncDevelopmentGameServer(gs *agonesv1.GameServer) (*agones
 	gsCopy.Status.Ports = ports
 	gsCopy.Status.Address = devIPAddress
 	gsCopy.Status.NodeName = devIPAddress
+	if gsCopy.Status.ReadyTime == nil {
+		now := metav1.NewTime(c.clock.Now())
+		gsCopy.Status.ReadyTime = &now
+	}
+	gsCopy.ApplyStatusLabels()
 	gs, err := c.gameServerGetter.GameServers(gs.ObjectMeta.Namespace).Update(gsCopy)
 	if err != nil {
 		return gs, errors.Wrapf(err, "error updating GameServer %s to %v status", gs.Name, gs.Status)
@@ -524,28 +744,38 @@ func (c *Controller) syncDevelopmentGameServer(gs *agonesv1.GameServer) (*agones
 	return gs, nil
 }
 
+// syncGameServerStatusLabels keeps the labels with the state and node name of the GameServer
+// in sync with its status, whichever component has updated it
+func (c *Controller) syncGameServerStatusLabels(gs *agonesv1.GameServer) (*agonesv1.GameServer, error) {
+	gsCopy := gs.DeepCopy()
+	if !gsCopy.ApplyStatusLabels() {
+		return gs, nil
+	}
+
+	c.loggerForGameServer(gs).Debug("Syncing state and node name labels")
+	gs, err := c.gameServerGetter.GameServers(gs.ObjectMeta.Namespace).Update(gsCopy)
+	if err != nil {
+		return gs, errors.Wrapf(err, "error updating the state and node name labels of GameServer %s", gsCopy.ObjectMeta.Name)
+	}
+	return gs, nil
+}
+
 // createGameServerPod creates the backing Pod for a given GameServer
 func (c *Controller) createGameServerPod(gs *agonesv1.GameServer) (*agonesv1.GameServer, error) {
-	sidecar := c.sidecar(gs)
-	pod, err := gs.Pod(sidecar)
+	pod, err := c.buildPod(gs)
 	if err != nil {
-		// this shouldn't happen, but if it does.
 		c.loggerForGameServer(gs).WithError(err).Error("error creating pod from Game Server")
 		gs, err = c.moveToErrorState(gs, err.Error())
 		return gs, err
 	}
 
-	// if the service account is not set, then you are in the "opinionated"
-	// mode. If the user sets the service account, we assume they know what they are
-	// doing, and don't disable the gameserver container.
-	if pod.Spec.ServiceAccountName == "" {
-		pod.Spec.ServiceAccountName = c.sdkServiceAccount
-		gs.DisableServiceAccount(pod)
+	// the service account of the SDK server already has access to the GameServer
+	if !gs.HasSdkServerSidecar() && pod.Spec.ServiceAccountName != c.sdkServiceAccount {
+		if err := c.ensureSdkServerRoleBinding(gs, pod.Spec.ServiceAccountName); err != nil {
+			return gs, err
+		}
 	}
 
-	c.addGameServerHealthCheck(gs, pod)
-	c.addSDKServerEnvVars(gs, pod)
-
 	c.loggerForGameServer(gs).WithField("pod", pod).Info("creating Pod for GameServer")
 	pod, err = c.podGetter.Pods(gs.ObjectMeta.Namespace).Create(pod)
 	if k8serrors.IsAlreadyExists(err) {
@@ -566,6 +796,95 @@ func (c *Controller) createGameServerPod(gs *agonesv1.GameServer) (*agonesv1.Gam
 	return gs, nil
 }
 
+// buildPod builds the backing Pod for a given GameServer, and then lets the registered
+// PodModifiers change it
+func (c *Controller) buildPod(gs *agonesv1.GameServer) (*corev1.Pod, error) {
+	var sidecars []corev1.Container
+	if gs.HasSdkServerSidecar() {
+		sidecars = append(sidecars, c.sidecar(gs))
+	}
+	pod, err := gs.Pod(sidecars...)
+	if err != nil {
+		// this shouldn't happen, but if it does.
+		return nil, err
+	}
+
+	// if the service account is not set, then you are in the "opinionated"
+	// mode. If the user sets the service account, we assume they know what they are
+	// doing, and don't disable the gameserver container.
+	disableServiceAccount := pod.Spec.ServiceAccountName == ""
+	if disableServiceAccount {
+		pod.Spec.ServiceAccountName = c.sdkServiceAccount
+		// a game server that runs the SDK server itself needs the service account of the SDK server
+		if gs.HasSdkServerSidecar() {
+			gs.DisableServiceAccount(pod)
+		}
+	}
+	c.podSnippet.apply(pod, disableServiceAccount)
+	gs.MountSdkServerToken(pod)
+
+	c.addGameServerHealthCheck(gs, pod)
+	c.addPreDeleteHook(gs, pod)
+	c.addSDKServerEnvVars(gs, pod)
+	c.addGameServerEnvVars(gs, pod)
+	c.applySafeToEvictAnnotation(gs, pod)
+
+	for _, m := range c.podModifiers {
+		if err := m.ModifyPod(gs, pod); err != nil {
+			return nil, errors.Wrapf(err, "error modifying Pod with pod modifier %s", m.Name())
+		}
+	}
+	return pod, nil
+}
+
+// ensureSdkServerRoleBinding binds a service account, of a Pod whose game server runs the SDK server itself,
+// to the role of the SDK server in its namespace, so the SDK server can access the GameServer.
+// Only the service accounts that the controller is configured with are bound, others need to be given access
+// to GameServers by the cluster administrator. The RoleBinding is owned by the service account, so it is
+// removed with it.
+func (c *Controller) ensureSdkServerRoleBinding(gs *agonesv1.GameServer, serviceAccount string) error {
+	namespace := gs.ObjectMeta.Namespace
+	key := namespace + "/" + serviceAccount
+	if !c.sdkServiceAccounts[key] {
+		c.recorder.Eventf(gs, corev1.EventTypeWarning, string(gs.Status.State),
+			"Service account %s is not bound to the SDK server role by Agones, it needs to be given access to GameServers", serviceAccount)
+		return nil
+	}
+
+	name := c.sdkServiceAccount + "-" + serviceAccount
+	if _, err := c.roleBindingLister.RoleBindings(namespace).Get(name); err == nil {
+		return nil
+	} else if !k8serrors.IsNotFound(err) {
+		return errors.Wrapf(err, "error retrieving RoleBinding for the SDK server of service account %s", key)
+	}
+
+	sa, err := c.serviceAccountGetter.ServiceAccounts(namespace).Get(serviceAccount, metav1.GetOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "error retrieving service account %s", key)
+	}
+
+	rb := &rbacv1.RoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels:    map[string]string{agones.GroupName + "/role": "sdk-server"},
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: corev1.SchemeGroupVersion.String(),
+				Kind:       "ServiceAccount",
+				Name:       sa.ObjectMeta.Name,
+				UID:        sa.ObjectMeta.UID,
+			}},
+		},
+		Subjects: []rbacv1.Subject{{Kind: rbacv1.ServiceAccountKind, Name: serviceAccount, Namespace: namespace}},
+		// the ClusterRole of the SDK server has the name of its service account
+		RoleRef: rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: c.sdkServiceAccount},
+	}
+	if _, err := c.roleBindingGetter.RoleBindings(namespace).Create(rb); err != nil && !k8serrors.IsAlreadyExists(err) {
+		return errors.Wrapf(err, "error creating RoleBinding for the SDK server of service account %s", key)
+	}
+	return nil
+}
+
 // sidecar creates the sidecar container for a given GameServer
 func (c *Controller) sidecar(gs *agonesv1.GameServer) corev1.Container {
 	sidecar := corev1.Container{
@@ -590,7 +909,7 @@ func (c *Controller) sidecar(gs *agonesv1.GameServer) corev1.Container {
 			Handler: corev1.Handler{
 				HTTPGet: &corev1.HTTPGetAction{
 					Path: "/healthz",
-					Port: intstr.FromInt(8080),
+					Port: intstr.FromInt(agonesv1.SdkServerHealthPort),
 				},
 			},
 			InitialDelaySeconds: 3,
@@ -606,20 +925,77 @@ func (c *Controller) sidecar(gs *agonesv1.GameServer) corev1.Container {
 		sidecar.Args = append(sidecar.Args, fmt.Sprintf("--http-port=%d", gs.Spec.SdkServer.HTTPPort))
 	}
 
-	if !c.sidecarCPURequest.IsZero() {
-		sidecar.Resources.Requests = corev1.ResourceList{corev1.ResourceCPU: c.sidecarCPURequest}
+	if gs.Spec.SdkServer.TokenSecretName != "" {
+		sidecar.Args = append(sidecar.Args, "--token-file="+sdkServerTokenFile)
 	}
 
-	if !c.sidecarCPULimit.IsZero() {
-		sidecar.Resources.Limits = corev1.ResourceList{corev1.ResourceCPU: c.sidecarCPULimit}
+	// the sidecar is sent SIGTERM as soon as the Pod is deleted, so it keeps serving
+	// until the pre-delete hook has ended, which can then still call the SDK
+	if gs.Spec.PreDelete != nil {
+		sidecar.Args = append(sidecar.Args, fmt.Sprintf("--shutdown-delay=%d", gs.Spec.PreDelete.TimeoutSeconds))
 	}
 
+	// the sidecar runs with the same feature gates as the controller
+	if gates := runtime.EncodeFeatures(); gates != "" {
+		sidecar.Args = append(sidecar.Args, "--feature-gates="+gates)
+	}
+
+	// the annotations of the namespace can override the sidecar resources of the controller
+	d := GetNamespaceDefaults(c.namespaceLister, gs.ObjectMeta.Namespace, c.baseLogger)
+	sidecar.Resources.Requests = sidecarResources(sidecarQuantity(d.SidecarCPURequest, c.sidecarCPURequest),
+		sidecarQuantity(d.SidecarMemoryRequest, c.sidecarMemoryRequest))
+	sidecar.Resources.Limits = sidecarResources(sidecarQuantity(d.SidecarCPULimit, c.sidecarCPULimit),
+		sidecarQuantity(d.SidecarMemoryLimit, c.sidecarMemoryLimit))
+
 	if c.alwaysPullSidecarImage {
 		sidecar.ImagePullPolicy = corev1.PullAlways
 	}
 	return sidecar
 }
 
+// sidecarResources returns the list of the cpu and memory resources that are not zero, or nil if both are
+func sidecarResources(cpu, memory resource.Quantity) corev1.ResourceList {
+	var list corev1.ResourceList
+	if !cpu.IsZero() {
+		list = corev1.ResourceList{corev1.ResourceCPU: cpu}
+	}
+	if !memory.IsZero() {
+		if list == nil {
+			list = corev1.ResourceList{}
+		}
+		list[corev1.ResourceMemory] = memory
+	}
+	return list
+}
+
+// addPreDeleteHook sets the pre-delete hook of the GameServer as the preStop hook of the game server container,
+// which the kubelet runs as soon as the Pod is deleted, before the container is sent SIGTERM. The kubelet bounds the
+// hook by the termination grace period of the Pod, so it is extended by the timeout of the hook, to leave
+// the game server the rest of its grace period to shut down. The SDK server sidecar, which is sent SIGTERM
+// straight away, delays its shutdown until the game server has stopped, for at most the same timeout (see sidecar).
+func (c *Controller) addPreDeleteHook(gs *agonesv1.GameServer, pod *corev1.Pod) {
+	hook := gs.Spec.PreDelete
+	if hook == nil {
+		return
+	}
+
+	gs.ApplyToPodGameServerContainer(pod, func(c corev1.Container) corev1.Container {
+		if c.Lifecycle == nil {
+			c.Lifecycle = &corev1.Lifecycle{}
+		}
+		h := hook.DeepCopy()
+		c.Lifecycle.PreStop = &corev1.Handler{Exec: h.Exec, HTTPGet: h.HTTPGet}
+		return c
+	})
+
+	grace := int64(corev1.DefaultTerminationGracePeriodSeconds)
+	if pod.Spec.TerminationGracePeriodSeconds != nil {
+		grace = *pod.Spec.TerminationGracePeriodSeconds
+	}
+	grace += int64(hook.TimeoutSeconds)
+	pod.Spec.TerminationGracePeriodSeconds = &grace
+}
+
 // addGameServerHealthCheck adds the http health check to the GameServer container
 func (c *Controller) addGameServerHealthCheck(gs *agonesv1.GameServer, pod *corev1.Pod) {
 	if gs.Spec.Health.Disabled {
@@ -632,7 +1008,7 @@ func (c *Controller) addGameServerHealthCheck(gs *agonesv1.GameServer, pod *core
 				Handler: corev1.Handler{
 					HTTPGet: &corev1.HTTPGetAction{
 						Path: "/gshealthz",
-						Port: intstr.FromInt(8080),
+						Port: intstr.FromInt(agonesv1.SdkServerHealthPort),
 					},
 				},
 				InitialDelaySeconds: gs.Spec.Health.InitialDelaySeconds,
@@ -645,6 +1021,20 @@ func (c *Controller) addGameServerHealthCheck(gs *agonesv1.GameServer, pod *core
 	})
 }
 
+// applySafeToEvictAnnotation leaves the cluster autoscaler safe-to-evict annotation, that
+// the GameServer sets on its Pod, only if it is enabled. Otherwise the Pod is left with the
+// annotation of the Pod template, if there is one.
+func (c *Controller) applySafeToEvictAnnotation(gs *agonesv1.GameServer, pod *corev1.Pod) {
+	if c.safeToEvictAnnotation {
+		return
+	}
+	if v, ok := gs.Spec.Template.ObjectMeta.Annotations[agonesv1.SafeToEvictAnnotation]; ok {
+		pod.ObjectMeta.Annotations[agonesv1.SafeToEvictAnnotation] = v
+		return
+	}
+	delete(pod.ObjectMeta.Annotations, agonesv1.SafeToEvictAnnotation)
+}
+
 func (c *Controller) addSDKServerEnvVars(gs *agonesv1.GameServer, pod *corev1.Pod) {
 	for i, c := range pod.Spec.Containers {
 		if c.Name != sdkserverSidecarName {
@@ -670,7 +1060,7 @@ func (c *Controller) addSDKServerEnvVars(gs *agonesv1.GameServer, pod *corev1.Po
 }
 
 func reservedEnvironmentVariableName(name string) bool {
-	return name == grpcPortEnvVar || name == httpPortEnvVar
+	return name == grpcPortEnvVar || name == httpPortEnvVar || name == tokenFileEnvVar
 }
 
 func sdkEnvironmentVariables(gs *agonesv1.GameServer) []corev1.EnvVar {
@@ -687,6 +1077,12 @@ func sdkEnvironmentVariables(gs *agonesv1.GameServer) []corev1.EnvVar {
 			Value: strconv.Itoa(int(gs.Spec.SdkServer.HTTPPort)),
 		})
 	}
+	if gs.Spec.SdkServer.TokenSecretName != "" {
+		env = append(env, corev1.EnvVar{
+			Name:  tokenFileEnvVar,
+			Value: sdkServerTokenFile,
+		})
+	}
 	return env
 }
 
@@ -717,6 +1113,7 @@ func (c *Controller) syncGameServerStartingState(gs *agonesv1.GameServer) (*agon
 	}
 
 	gsCopy.Status.State = agonesv1.GameServerStateScheduled
+	gsCopy.ApplyStatusLabels()
 	gs, err = c.gameServerGetter.GameServers(gs.ObjectMeta.Namespace).Update(gsCopy)
 	if err != nil {
 		return gs, errors.Wrapf(err, "error updating GameServer %s to Scheduled state", gs.Name)
@@ -736,6 +1133,9 @@ func (c *Controller) applyGameServerAddressAndPort(gs *agonesv1.GameServer, pod
 
 	gs.Status.Address = addr
 	gs.Status.NodeName = pod.Spec.NodeName
+	if err := c.applyNodeLabels(gs, pod.Spec.NodeName); err != nil {
+		return gs, err
+	}
 	// HostPort is always going to be populated, even when dynamic
 	// This will be a double up of information, but it will be easier to read
 	gs.Status.Ports = make([]agonesv1.GameServerStatusPort, len(gs.Spec.Ports))
@@ -746,6 +1146,31 @@ func (c *Controller) applyGameServerAddressAndPort(gs *agonesv1.GameServer, pod
 	return gs, nil
 }
 
+// applyNodeLabels copies the configured labels of the Node the GameServer is scheduled on
+// onto the GameServer, so that they can be used in allocation selectors and metrics
+func (c *Controller) applyNodeLabels(gs *agonesv1.GameServer, nodeName string) error {
+	if len(c.nodeLabels) == 0 {
+		return nil
+	}
+
+	node, err := c.nodeLister.Get(nodeName)
+	if err != nil {
+		return errors.Wrapf(err, "error retrieving node %s for GameServer %s", nodeName, gs.ObjectMeta.Name)
+	}
+
+	for _, key := range c.nodeLabels {
+		value, ok := node.ObjectMeta.Labels[key]
+		if !ok {
+			continue
+		}
+		if gs.ObjectMeta.Labels == nil {
+			gs.ObjectMeta.Labels = map[string]string{}
+		}
+		gs.ObjectMeta.Labels[key] = value
+	}
+	return nil
+}
+
 // syncGameServerRequestReadyState checks if the Game Server is Requesting to be ready,
 // and then adds the IP and Port information to the Status and marks the GameServer
 // as Ready
@@ -781,6 +1206,9 @@ func (c *Controller) syncGameServerRequestReadyState(gs *agonesv1.GameServer) (*
 	}
 
 	gsCopy.Status.State = agonesv1.GameServerStateReady
+	now := metav1.NewTime(c.clock.Now())
+	gsCopy.Status.ReadyTime = &now
+	gsCopy.ApplyStatusLabels()
 	gs, err := c.gameServerGetter.GameServers(gs.ObjectMeta.Namespace).Update(gsCopy)
 	if err != nil {
 		return gs, errors.Wrapf(err, "error setting Ready, Port and address on GameServer %s Status", gs.ObjectMeta.Name)
@@ -793,6 +1221,37 @@ func (c *Controller) syncGameServerRequestReadyState(gs *agonesv1.GameServer) (*
 	return gs, nil
 }
 
+// syncGameServerReservedState moves a Reserved GameServer back to Ready once its reservation has expired.
+// The SDK server does this as well, but the controller enforces it in case the SDK server is not running.
+// ReservedUntil is set with the clock of the SDK server, so the clock skew tolerance is added to it,
+// to not end the reservation early when the controller clock is ahead.
+func (c *Controller) syncGameServerReservedState(gs *agonesv1.GameServer) (*agonesv1.GameServer, error) {
+	if !(gs.Status.State == agonesv1.GameServerStateReserved && gs.ObjectMeta.DeletionTimestamp.IsZero()) ||
+		gs.Status.ReservedUntil == nil {
+		return gs, nil
+	}
+
+	if remaining := gs.Status.ReservedUntil.Time.Add(c.clockSkewTolerance).Sub(c.clock.Now()); remaining > 0 {
+		// come back once the reservation has expired
+		c.workerqueue.EnqueueAfter(gs, remaining)
+		return gs, nil
+	}
+
+	c.loggerForGameServer(gs).Info("Syncing Reserved State")
+
+	gsCopy := gs.DeepCopy()
+	gsCopy.Status.State = agonesv1.GameServerStateReady
+	gsCopy.Status.ReservedUntil = nil
+	gsCopy.ApplyStatusLabels()
+	gs, err := c.gameServerGetter.GameServers(gs.ObjectMeta.Namespace).Update(gsCopy)
+	if err != nil {
+		return gs, errors.Wrapf(err, "error setting Ready on Reserved GameServer %s", gsCopy.ObjectMeta.Name)
+	}
+
+	c.recorder.Event(gs, corev1.EventTypeNormal, string(gs.Status.State), "Reserve duration expired")
+	return gs, nil
+}
+
 // syncGameServerShutdownState deletes the GameServer (and therefore the backing Pod) if it is in shutdown state
 func (c *Controller) syncGameServerShutdownState(gs *agonesv1.GameServer) error {
 	if !(gs.Status.State == agonesv1.GameServerStateShutdown && gs.ObjectMeta.DeletionTimestamp.IsZero()) {
@@ -806,7 +1265,9 @@ func (c *Controller) syncGameServerShutdownState(gs *agonesv1.GameServer) error
 	if err != nil {
 		return errors.Wrapf(err, "error deleting Game Server %s", gs.ObjectMeta.Name)
 	}
-	c.recorder.Event(gs, corev1.EventTypeNormal, string(gs.Status.State), "Deletion started")
+	reason := gs.ShutdownReason()
+	c.recorder.AnnotatedEventf(gs, map[string]string{agonesv1.GameServerShutdownReasonAnnotation: string(reason)},
+		corev1.EventTypeNormal, string(gs.Status.State), "Deletion started, reason: %s", reason)
 	return nil
 }
 
@@ -814,6 +1275,7 @@ func (c *Controller) syncGameServerShutdownState(gs *agonesv1.GameServer) error
 func (c *Controller) moveToErrorState(gs *agonesv1.GameServer, msg string) (*agonesv1.GameServer, error) {
 	copy := gs.DeepCopy()
 	copy.Status.State = agonesv1.GameServerStateError
+	copy.ApplyStatusLabels()
 
 	gs, err := c.gameServerGetter.GameServers(gs.ObjectMeta.Namespace).Update(copy)
 	if err != nil {
@@ -20,23 +20,30 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 	"testing"
+	"time"
 
 	"agones.dev/agones/pkg/apis/agones"
 	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
 	agtesting "agones.dev/agones/pkg/testing"
+	utilruntime "agones.dev/agones/pkg/util/runtime"
 	"agones.dev/agones/pkg/util/webhooks"
+	"agones.dev/agones/pkg/util/workerqueue"
 	"github.com/heptiolabs/healthcheck"
 	"github.com/mattbaird/jsonpatch"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	admv1beta1 "k8s.io/api/admission/v1beta1"
 	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/clock"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/apimachinery/pkg/watch"
@@ -237,6 +244,7 @@ func TestControllerWatchGameServers(t *testing.T) {
 	c, m := newFakeController()
 	fixture := agonesv1.GameServer{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"}, Spec: newSingleContainerSpec()}
 	fixture.ApplyDefaults()
+	fixture.ApplyStatusLabels()
 	pod, err := fixture.Pod()
 	assert.Nil(t, err)
 	pod.ObjectMeta.Name = pod.ObjectMeta.GenerateName + "-pod"
@@ -305,6 +313,12 @@ func TestControllerWatchGameServers(t *testing.T) {
 	gsWatch.Modify(&fixture)
 	noStateChange(gsSynced)
 
+	// the state label has been changed by another update
+	labelFixture := fixture.DeepCopy()
+	delete(labelFixture.ObjectMeta.Labels, agonesv1.GameServerStateLabel)
+	gsWatch.Modify(labelFixture)
+	assert.Equal(t, "default/test", <-received)
+
 	copyFixture := fixture.DeepCopy()
 	copyFixture.Status.State = agonesv1.GameServerStateStarting
 	logrus.Info("modify copyFixture")
@@ -372,6 +386,29 @@ func TestControllerCreationMutationHandler(t *testing.T) {
 
 	assertContains(patch, jsonpatch.JsonPatchOperation{Operation: "add", Path: "/metadata/finalizers", Value: []interface{}{"agones.dev"}})
 	assertContains(patch, jsonpatch.JsonPatchOperation{Operation: "add", Path: "/spec/ports/0/protocol", Value: "UDP"})
+
+	// a minimal spec is fully populated
+	fixture = &agonesv1.GameServer{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+		Spec: agonesv1.GameServerSpec{
+			Ports:    []agonesv1.GameServerPort{{ContainerPort: 7777}},
+			Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "container", Image: "container/image"}}}},
+		}}
+	raw, err = json.Marshal(fixture)
+	assert.Nil(t, err)
+	review.Request.Object.Raw = raw
+
+	result, err = c.creationMutationHandler(review)
+	assert.Nil(t, err)
+	assert.True(t, result.Response.Allowed)
+	patch = &jsonpatch.ByPath{}
+	assert.Nil(t, json.Unmarshal(result.Response.Patch, patch))
+	assertContains(patch, jsonpatch.JsonPatchOperation{Operation: "add", Path: "/spec/container", Value: "container"})
+	assertContains(patch, jsonpatch.JsonPatchOperation{Operation: "add", Path: "/spec/ports/0/portPolicy", Value: "Dynamic"})
+	assertContains(patch, jsonpatch.JsonPatchOperation{Operation: "add", Path: "/spec/ports/0/protocol", Value: "UDP"})
+	assertContains(patch, jsonpatch.JsonPatchOperation{Operation: "add", Path: "/spec/health/periodSeconds", Value: float64(5)})
+	assertContains(patch, jsonpatch.JsonPatchOperation{Operation: "add", Path: "/spec/health/failureThreshold", Value: float64(3)})
+	assertContains(patch, jsonpatch.JsonPatchOperation{Operation: "add", Path: "/spec/health/initialDelaySeconds", Value: float64(5)})
+	assertContains(patch, jsonpatch.JsonPatchOperation{Operation: "add", Path: "/spec/scheduling", Value: "Packed"})
 }
 
 func TestControllerCreationValidationHandler(t *testing.T) {
@@ -439,6 +476,121 @@ func TestControllerCreationValidationHandler(t *testing.T) {
 		assert.Equal(t, review.Request.Kind.Group, result.Response.Result.Details.Group)
 		assert.NotEmpty(t, result.Response.Result.Details.Causes)
 	})
+
+	t.Run("port range outside of the allocatable ports", func(t *testing.T) {
+		fixture := &agonesv1.GameServer{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+			Spec: newSingleContainerSpec()}
+		fixture.Spec.PortRange = &agonesv1.PortRange{MinPort: 15, MaxPort: 25}
+		fixture.ApplyDefaults()
+
+		raw, err := json.Marshal(fixture)
+		assert.Nil(t, err)
+		review := admv1beta1.AdmissionReview{
+			Request: &admv1beta1.AdmissionRequest{
+				Kind:      GameServerKind,
+				Operation: admv1beta1.Create,
+				Object: runtime.RawExtension{
+					Raw: raw,
+				},
+			},
+			Response: &admv1beta1.AdmissionResponse{Allowed: true},
+		}
+
+		result, err := c.creationValidationHandler(review)
+		assert.Nil(t, err)
+		assert.False(t, result.Response.Allowed)
+		assert.Len(t, result.Response.Result.Details.Causes, 1)
+		assert.Equal(t, "portRange", result.Response.Result.Details.Causes[0].Field)
+	})
+
+	t.Run("static port within the allocatable ports", func(t *testing.T) {
+		fixture := &agonesv1.GameServer{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+			Spec: newSingleContainerSpec()}
+		fixture.Spec.Ports[0].Name = "game"
+		fixture.Spec.Ports[0].HostPort = 15
+		fixture.ApplyDefaults()
+
+		raw, err := json.Marshal(fixture)
+		assert.Nil(t, err)
+		review := admv1beta1.AdmissionReview{
+			Request: &admv1beta1.AdmissionRequest{
+				Kind:      GameServerKind,
+				Operation: admv1beta1.Create,
+				Object: runtime.RawExtension{
+					Raw: raw,
+				},
+			},
+			Response: &admv1beta1.AdmissionResponse{Allowed: true},
+		}
+
+		result, err := c.creationValidationHandler(review)
+		assert.Nil(t, err)
+		assert.False(t, result.Response.Allowed)
+		assert.Len(t, result.Response.Result.Details.Causes, 1)
+		assert.Equal(t, "game.hostPort", result.Response.Result.Details.Causes[0].Field)
+	})
+
+	t.Run("static port within the allocatable ports, of a GameServerSet or in development", func(t *testing.T) {
+		gsSet := &agonesv1.GameServerSet{ObjectMeta: metav1.ObjectMeta{Name: "gsSet", Namespace: "default", UID: "1234"}}
+		owned := &agonesv1.GameServer{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default",
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(gsSet, agonesv1.SchemeGroupVersion.WithKind("GameServerSet"))}},
+			Spec: newSingleContainerSpec()}
+		dev := &agonesv1.GameServer{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default",
+			Annotations: map[string]string{agonesv1.DevAddressAnnotation: "127.0.0.1"}},
+			Spec: newSingleContainerSpec()}
+
+		for _, fixture := range []*agonesv1.GameServer{owned, dev} {
+			fixture.Spec.Ports[0].HostPort = 15
+			fixture.ApplyDefaults()
+
+			raw, err := json.Marshal(fixture)
+			assert.Nil(t, err)
+			review := admv1beta1.AdmissionReview{
+				Request: &admv1beta1.AdmissionRequest{
+					Kind:      GameServerKind,
+					Operation: admv1beta1.Create,
+					Object: runtime.RawExtension{
+						Raw: raw,
+					},
+				},
+				Response: &admv1beta1.AdmissionResponse{Allowed: true},
+			}
+
+			result, err := c.creationValidationHandler(review)
+			assert.Nil(t, err)
+			assert.True(t, result.Response.Allowed, fixture.ObjectMeta.Annotations)
+		}
+	})
+	t.Run("container name used by the pod snippet", func(t *testing.T) {
+		c, _ := newFakeController()
+		c.podSnippet = PodSnippet{Containers: []corev1.Container{{Name: "log-shipper", Image: "fluent/fluent-bit"}}}
+		fixture := &agonesv1.GameServer{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+			Spec: newSingleContainerSpec()}
+		fixture.Spec.Template.Spec.Containers = append(fixture.Spec.Template.Spec.Containers,
+			corev1.Container{Name: "log-shipper", Image: "fluent/fluent-bit"})
+		fixture.Spec.Container = "container"
+		fixture.ApplyDefaults()
+
+		raw, err := json.Marshal(fixture)
+		assert.Nil(t, err)
+		review := admv1beta1.AdmissionReview{
+			Request: &admv1beta1.AdmissionRequest{
+				Kind:      GameServerKind,
+				Operation: admv1beta1.Create,
+				Object: runtime.RawExtension{
+					Raw: raw,
+				},
+			},
+			Response: &admv1beta1.AdmissionResponse{Allowed: true},
+		}
+
+		result, err := c.creationValidationHandler(review)
+		assert.Nil(t, err)
+		assert.False(t, result.Response.Allowed)
+		if assert.Len(t, result.Response.Result.Details.Causes, 1) {
+			assert.Equal(t, "template.spec.containers[1].name", result.Response.Result.Details.Causes[0].Field)
+		}
+	})
 }
 
 func TestControllerSyncGameServerDeletionTimestamp(t *testing.T) {
@@ -475,6 +627,52 @@ func TestControllerSyncGameServerDeletionTimestamp(t *testing.T) {
 			fixture.Status.State, "Deleting Pod "+pod.ObjectMeta.Name))
 	})
 
+	t.Run("GameServer has a Pod, with a termination grace period", func(t *testing.T) {
+		fixtures := map[string]struct {
+			state   agonesv1.GameServerState
+			deleted time.Duration
+			expect  bool
+		}{
+			"allocated, within the window":  {state: agonesv1.GameServerStateAllocated, deleted: 10 * time.Second, expect: false},
+			"allocated, after the window":   {state: agonesv1.GameServerStateAllocated, deleted: 2 * time.Minute, expect: true},
+			"shutdown, within the window":   {state: agonesv1.GameServerStateShutdown, deleted: 10 * time.Second, expect: true},
+			"unhealthy, within the window":  {state: agonesv1.GameServerStateUnhealthy, deleted: 10 * time.Second, expect: true},
+			"ready, just within the window": {state: agonesv1.GameServerStateReady, deleted: 59 * time.Second, expect: false},
+			"ready, after the window":       {state: agonesv1.GameServerStateReady, deleted: 61 * time.Second, expect: true},
+		}
+
+		for k, v := range fixtures {
+			t.Run(k, func(t *testing.T) {
+				c, mocks := newFakeController()
+				fc := clock.NewFakeClock(time.Now())
+				c.clock = fc
+				deletedAt := metav1.NewTime(fc.Now().Add(-v.deleted))
+				fixture := &agonesv1.GameServer{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default", DeletionTimestamp: &deletedAt},
+					Spec: newSingleContainerSpec(), Status: agonesv1.GameServerStatus{State: v.state}}
+				fixture.Spec.TerminationGracePeriodSeconds = 60
+				fixture.ApplyDefaults()
+				pod, err := fixture.Pod()
+				assert.Nil(t, err)
+
+				deleted := false
+				mocks.KubeClient.AddReactor("list", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+					return true, &corev1.PodList{Items: []corev1.Pod{*pod}}, nil
+				})
+				mocks.KubeClient.AddReactor("delete", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+					deleted = true
+					return true, nil, nil
+				})
+
+				_, cancel := agtesting.StartInformers(mocks, c.podSynced)
+				defer cancel()
+
+				_, err = c.syncGameServerDeletionTimestamp(fixture)
+				assert.NoError(t, err)
+				assert.Equal(t, v.expect, deleted)
+			})
+		}
+	})
+
 	t.Run("GameServer's Pods have been deleted", func(t *testing.T) {
 		c, mocks := newFakeController()
 		now := metav1.Now()
@@ -533,6 +731,133 @@ func TestControllerSyncGameServerDeletionTimestamp(t *testing.T) {
 		assert.Equal(t, fixture.ObjectMeta.Name, result.ObjectMeta.Name)
 		assert.Empty(t, result.ObjectMeta.Finalizers)
 	})
+
+	t.Run("GameServer stuck deleting past the finalizer timeout", func(t *testing.T) {
+		c, mocks := newFakeController()
+		c.finalizerTimeout = time.Minute
+		deletedAt := metav1.NewTime(time.Now().Add(-2 * time.Minute))
+		fixture := &agonesv1.GameServer{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default",
+			DeletionTimestamp: &deletedAt, Finalizers: []string{agones.GroupName}},
+			Spec: newSingleContainerSpec()}
+		fixture.ApplyDefaults()
+		pod, err := fixture.Pod()
+		assert.Nil(t, err)
+		pod.ObjectMeta.DeletionTimestamp = &deletedAt
+
+		mocks.KubeClient.AddReactor("list", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			return true, &corev1.PodList{Items: []corev1.Pod{*pod}}, nil
+		})
+		updated := false
+		mocks.AgonesClient.AddReactor("update", "gameservers", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			updated = true
+
+			ua := action.(k8stesting.UpdateAction)
+			gs := ua.GetObject().(*agonesv1.GameServer)
+			assert.Empty(t, gs.ObjectMeta.Finalizers)
+
+			return true, gs, nil
+		})
+
+		_, cancel := agtesting.StartInformers(mocks, c.podSynced)
+		defer cancel()
+
+		result, err := c.syncGameServerDeletionTimestamp(fixture)
+		assert.Nil(t, err)
+		assert.True(t, updated, "gameserver should be updated, to force remove the finaliser")
+		assert.Empty(t, result.ObjectMeta.Finalizers)
+		agtesting.AssertEventContains(t, mocks.FakeRecorder.Events, "Warning FinalizerForceRemoved")
+	})
+
+	t.Run("GameServer deleting within the finalizer timeout", func(t *testing.T) {
+		c, mocks := newFakeController()
+		c.finalizerTimeout = time.Hour
+		now := metav1.Now()
+		fixture := &agonesv1.GameServer{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default",
+			DeletionTimestamp: &now, Finalizers: []string{agones.GroupName}},
+			Spec: newSingleContainerSpec()}
+		fixture.ApplyDefaults()
+		pod, err := fixture.Pod()
+		assert.Nil(t, err)
+		pod.ObjectMeta.DeletionTimestamp = &now
+
+		mocks.KubeClient.AddReactor("list", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			return true, &corev1.PodList{Items: []corev1.Pod{*pod}}, nil
+		})
+		mocks.AgonesClient.AddReactor("update", "gameservers", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			assert.FailNow(t, "gameserver should not be updated")
+			return true, nil, nil
+		})
+
+		_, cancel := agtesting.StartInformers(mocks, c.podSynced)
+		defer cancel()
+
+		result, err := c.syncGameServerDeletionTimestamp(fixture)
+		assert.Nil(t, err)
+		assert.Equal(t, fixture, result)
+	})
+}
+
+func TestControllerFinalizerTimeoutExceeded(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	deletedAt := metav1.NewTime(now)
+	gs := &agonesv1.GameServer{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default", DeletionTimestamp: &deletedAt}}
+
+	fixtures := map[string]struct {
+		timeout   time.Duration
+		tolerance time.Duration
+		elapsed   time.Duration
+		expected  bool
+	}{
+		"disabled":                  {timeout: 0, elapsed: time.Hour, expected: false},
+		"within timeout":            {timeout: time.Minute, elapsed: 30 * time.Second, expected: false},
+		"past timeout":              {timeout: time.Minute, elapsed: 2 * time.Minute, expected: true},
+		"past timeout, within skew": {timeout: time.Minute, tolerance: 2 * time.Minute, elapsed: 2 * time.Minute, expected: false},
+		"past timeout and skew":     {timeout: time.Minute, tolerance: 2 * time.Minute, elapsed: 4 * time.Minute, expected: true},
+	}
+
+	for k, v := range fixtures {
+		t.Run(k, func(t *testing.T) {
+			c, _ := newFakeController()
+			fc := clock.NewFakeClock(now.Add(v.elapsed))
+			c.clock = fc
+			c.finalizerTimeout = v.timeout
+			c.clockSkewTolerance = v.tolerance
+
+			assert.Equal(t, v.expected, c.finalizerTimeoutExceeded(gs))
+		})
+	}
+
+	t.Run("not deleted", func(t *testing.T) {
+		c, _ := newFakeController()
+		c.finalizerTimeout = time.Minute
+		assert.False(t, c.finalizerTimeoutExceeded(&agonesv1.GameServer{}))
+	})
+
+	t.Run("after the termination grace period", func(t *testing.T) {
+		c, _ := newFakeController()
+		c.finalizerTimeout = time.Minute
+		graceful := gs.DeepCopy()
+		graceful.Spec.TerminationGracePeriodSeconds = 120
+
+		c.clock = clock.NewFakeClock(now.Add(2 * time.Minute))
+		assert.False(t, c.finalizerTimeoutExceeded(graceful))
+		c.clock = clock.NewFakeClock(now.Add(4 * time.Minute))
+		assert.True(t, c.finalizerTimeoutExceeded(graceful))
+	})
+
+	t.Run("after the pre-delete hook timeout", func(t *testing.T) {
+		c, _ := newFakeController()
+		c.finalizerTimeout = time.Minute
+		hooked := gs.DeepCopy()
+		hooked.Spec.PreDelete = &agonesv1.PreDeleteHook{Exec: &corev1.ExecAction{Command: []string{"/flush"}}, TimeoutSeconds: 120}
+
+		c.clock = clock.NewFakeClock(now.Add(2 * time.Minute))
+		assert.False(t, c.finalizerTimeoutExceeded(hooked))
+		c.clock = clock.NewFakeClock(now.Add(4 * time.Minute))
+		assert.True(t, c.finalizerTimeoutExceeded(hooked))
+	})
 }
 
 func TestControllerSyncGameServerPortAllocationState(t *testing.T) {
@@ -645,6 +970,32 @@ func TestControllerSyncGameServerCreatingState(t *testing.T) {
 		agtesting.AssertEventContains(t, m.FakeRecorder.Events, "Pod")
 	})
 
+	t.Run("Syncing from Created State, in a terminating namespace", func(t *testing.T) {
+		c, m := newFakeController()
+		fixture := newFixture()
+
+		m.KubeClient.AddReactor("list", "namespaces", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			ns := corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: fixture.ObjectMeta.Namespace},
+				Status: corev1.NamespaceStatus{Phase: corev1.NamespaceTerminating}}
+			return true, &corev1.NamespaceList{Items: []corev1.Namespace{ns}}, nil
+		})
+		m.KubeClient.AddReactor("create", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			assert.FailNow(t, "Pod should not be created")
+			return true, nil, nil
+		})
+		m.AgonesClient.AddReactor("update", "gameservers", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			assert.FailNow(t, "GameServer should not be updated")
+			return true, nil, nil
+		})
+
+		_, cancel := agtesting.StartInformers(m, c.gameServerSynced, c.namespaceSynced)
+		defer cancel()
+
+		gs, err := c.syncGameServerCreatingState(fixture)
+		assert.Nil(t, err)
+		assert.Equal(t, agonesv1.GameServerStateCreating, gs.Status.State)
+	})
+
 	t.Run("Previously started sync, created Pod, but didn't move to Starting", func(t *testing.T) {
 		c, m := newFakeController()
 		fixture := newFixture()
@@ -827,10 +1178,13 @@ func TestControllerCreateGameServerPod(t *testing.T) {
 			assert.Equal(t, pod.Spec.Containers[1].Image, c.sidecarImage)
 			assert.Equal(t, pod.Spec.Containers[1].Resources.Limits.Cpu(), &c.sidecarCPULimit)
 			assert.Equal(t, pod.Spec.Containers[1].Resources.Requests.Cpu(), &c.sidecarCPURequest)
+			assert.Equal(t, pod.Spec.Containers[1].Resources.Limits.Memory(), &c.sidecarMemoryLimit)
+			assert.Equal(t, pod.Spec.Containers[1].Resources.Requests.Memory(), &c.sidecarMemoryRequest)
 			assert.Len(t, pod.Spec.Containers[1].Env, 2, "2 env vars")
 			assert.Equal(t, "GAMESERVER_NAME", pod.Spec.Containers[1].Env[0].Name)
 			assert.Equal(t, fixture.ObjectMeta.Name, pod.Spec.Containers[1].Env[0].Value)
 			assert.Equal(t, "POD_NAMESPACE", pod.Spec.Containers[1].Env[1].Name)
+			assert.Equal(t, "false", pod.ObjectMeta.Annotations[agonesv1.SafeToEvictAnnotation])
 			return true, pod, nil
 		})
 
@@ -842,6 +1196,66 @@ func TestControllerCreateGameServerPod(t *testing.T) {
 		agtesting.AssertEventContains(t, m.FakeRecorder.Events, "Pod")
 	})
 
+	t.Run("pre-delete hook", func(t *testing.T) {
+		c, m := newFakeController()
+		fixture := newFixture()
+		fixture.Spec.PreDelete = &agonesv1.PreDeleteHook{HTTPGet: &corev1.HTTPGetAction{Path: "/flush", Port: intstr.FromInt(7777)}, TimeoutSeconds: 60}
+		created := false
+
+		m.KubeClient.AddReactor("create", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			created = true
+			ca := action.(k8stesting.CreateAction)
+			pod := ca.GetObject().(*corev1.Pod)
+
+			gsContainer := pod.Spec.Containers[0]
+			if assert.NotNil(t, gsContainer.Lifecycle) && assert.NotNil(t, gsContainer.Lifecycle.PreStop) {
+				assert.Equal(t, fixture.Spec.PreDelete.HTTPGet, gsContainer.Lifecycle.PreStop.HTTPGet)
+				assert.Nil(t, gsContainer.Lifecycle.PreStop.Exec)
+			}
+			assert.Nil(t, pod.Spec.Containers[1].Lifecycle)
+			assert.Contains(t, pod.Spec.Containers[1].Args, "--shutdown-delay=60")
+			if assert.NotNil(t, pod.Spec.TerminationGracePeriodSeconds) {
+				assert.Equal(t, int64(corev1.DefaultTerminationGracePeriodSeconds+60), *pod.Spec.TerminationGracePeriodSeconds)
+			}
+			return true, pod, nil
+		})
+
+		_, err := c.createGameServerPod(fixture)
+		assert.Nil(t, err)
+		assert.True(t, created)
+	})
+
+	t.Run("sidecar resources overridden by the namespace", func(t *testing.T) {
+		c, m := newFakeController()
+		fixture := newFixture()
+		created := false
+
+		m.KubeClient.AddReactor("list", "namespaces", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			ns := corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: fixture.ObjectMeta.Namespace,
+				Annotations: map[string]string{NamespaceSidecarCPURequestAnnotation: "10m", NamespaceSidecarMemoryLimitAnnotation: "128Mi"}}}
+			return true, &corev1.NamespaceList{Items: []corev1.Namespace{ns}}, nil
+		})
+		m.KubeClient.AddReactor("create", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			created = true
+			ca := action.(k8stesting.CreateAction)
+			pod := ca.GetObject().(*corev1.Pod)
+
+			sidecar := pod.Spec.Containers[1]
+			assert.Equal(t, resource.MustParse("10m"), *sidecar.Resources.Requests.Cpu())
+			assert.Equal(t, resource.MustParse("128Mi"), *sidecar.Resources.Limits.Memory())
+			assert.Equal(t, &c.sidecarCPULimit, sidecar.Resources.Limits.Cpu())
+			assert.Equal(t, &c.sidecarMemoryRequest, sidecar.Resources.Requests.Memory())
+			return true, pod, nil
+		})
+
+		_, cancel := agtesting.StartInformers(m, c.namespaceSynced)
+		defer cancel()
+
+		_, err := c.createGameServerPod(fixture)
+		assert.Nil(t, err)
+		assert.True(t, created)
+	})
+
 	t.Run("service account", func(t *testing.T) {
 		c, m := newFakeController()
 		fixture := newFixture()
@@ -864,6 +1278,143 @@ func TestControllerCreateGameServerPod(t *testing.T) {
 		assert.True(t, created)
 	})
 
+	t.Run("sdk server token", func(t *testing.T) {
+		c, _ := newFakeController()
+		fixture := newFixture()
+		fixture.Spec.SdkServer.TokenSecretName = "sdk-token"
+
+		pod, err := c.buildPod(fixture)
+		assert.NoError(t, err)
+
+		var volume *corev1.Volume
+		for i, v := range pod.Spec.Volumes {
+			if v.Name == agonesv1.SdkServerTokenVolumeName {
+				volume = &pod.Spec.Volumes[i]
+			}
+		}
+		if assert.NotNil(t, volume) && assert.NotNil(t, volume.Secret) {
+			assert.Equal(t, "sdk-token", volume.Secret.SecretName)
+		}
+
+		mount := corev1.VolumeMount{Name: agonesv1.SdkServerTokenVolumeName, MountPath: "/var/run/agones/sdk", ReadOnly: true}
+		if assert.Len(t, pod.Spec.Containers, 2) {
+			assert.Contains(t, pod.Spec.Containers[0].VolumeMounts, mount)
+			assert.Contains(t, pod.Spec.Containers[0].Env, corev1.EnvVar{Name: "AGONES_SDK_TOKEN_FILE", Value: "/var/run/agones/sdk/token"})
+			assert.Contains(t, pod.Spec.Containers[1].VolumeMounts, mount)
+			assert.Contains(t, pod.Spec.Containers[1].Args, "--token-file=/var/run/agones/sdk/token")
+		}
+	})
+
+	t.Run("feature gates", func(t *testing.T) {
+		defer func() {
+			assert.NoError(t, utilruntime.ParseFeatures(""))
+		}()
+		c, _ := newFakeController()
+
+		pod, err := c.buildPod(newFixture())
+		assert.NoError(t, err)
+		for _, arg := range pod.Spec.Containers[1].Args {
+			assert.NotContains(t, arg, "--feature-gates")
+		}
+
+		assert.NoError(t, utilruntime.ParseFeatures("Chaos=true"))
+		pod, err = c.buildPod(newFixture())
+		assert.NoError(t, err)
+		assert.Contains(t, pod.Spec.Containers[1].Args, "--feature-gates=Chaos=true")
+	})
+
+	t.Run("safe to evict annotation disabled", func(t *testing.T) {
+		c, m := newFakeController()
+		c.safeToEvictAnnotation = false
+		fixture := newFixture()
+		pods := map[string]*corev1.Pod{}
+
+		m.KubeClient.AddReactor("create", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			ca := action.(k8stesting.CreateAction)
+			pod := ca.GetObject().(*corev1.Pod)
+			pods[pod.ObjectMeta.Name] = pod
+			return true, pod, nil
+		})
+
+		_, err := c.createGameServerPod(fixture)
+		assert.Nil(t, err)
+		if assert.Contains(t, pods, fixture.ObjectMeta.Name) {
+			assert.NotContains(t, pods[fixture.ObjectMeta.Name].ObjectMeta.Annotations, agonesv1.SafeToEvictAnnotation)
+		}
+
+		fixture = newFixture()
+		fixture.ObjectMeta.Name = "template"
+		fixture.Spec.Template.ObjectMeta.Annotations = map[string]string{agonesv1.SafeToEvictAnnotation: "true"}
+		_, err = c.createGameServerPod(fixture)
+		assert.Nil(t, err)
+		if assert.Contains(t, pods, "template") {
+			assert.Equal(t, "true", pods["template"].ObjectMeta.Annotations[agonesv1.SafeToEvictAnnotation])
+		}
+	})
+
+	t.Run("pod snippet", func(t *testing.T) {
+		c, m := newFakeController()
+		c.podSnippet = PodSnippet{
+			Containers: []corev1.Container{{Name: "log-shipper", Image: "fluent/fluent-bit"}},
+			Volumes:    []corev1.Volume{{Name: "logs"}},
+		}
+		fixture := newFixture()
+		created := false
+
+		m.KubeClient.AddReactor("create", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			created = true
+			pod := action.(k8stesting.CreateAction).GetObject().(*corev1.Pod)
+			if assert.Len(t, pod.Spec.Containers, 3, "Should have the pod snippet container") {
+				assert.Equal(t, sdkserverSidecarName, pod.Spec.Containers[1].Name)
+				assert.Equal(t, "log-shipper", pod.Spec.Containers[2].Name)
+				assert.Len(t, pod.Spec.Containers[2].VolumeMounts, 1)
+				assert.Equal(t, "/var/run/secrets/kubernetes.io/serviceaccount", pod.Spec.Containers[2].VolumeMounts[0].MountPath)
+			}
+			assert.Contains(t, pod.Spec.Volumes, corev1.Volume{Name: "logs"})
+			return true, pod, nil
+		})
+
+		_, err := c.createGameServerPod(fixture)
+		assert.Nil(t, err)
+		assert.True(t, created)
+	})
+
+	t.Run("game server environment variables", func(t *testing.T) {
+		c, m := newFakeController()
+		c.gameServerEnv = []corev1.EnvVar{{Name: "REGION", Value: "europe-west1"}, {Name: "BUILD", Value: "default"}}
+		fixture := newFixture()
+		fixture.ObjectMeta.Labels = map[string]string{
+			agonesv1.FleetNameLabel:               "fleet",
+			agonesv1.GameServerSetGameServerLabel: "fleet-abcde",
+		}
+		fixture.Spec.Template.Spec.Containers[0].Env = []corev1.EnvVar{{Name: "BUILD", Value: "1.2.3"}}
+		fixture.Spec.Ports = append(fixture.Spec.Ports, agonesv1.GameServerPort{Name: "query-port", HostPort: 7001, ContainerPort: 27015})
+		fixture.Spec.Ports[0].Name = "game"
+		fixture.Spec.Ports[0].HostPort = 7000
+		created := false
+
+		m.KubeClient.AddReactor("create", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			created = true
+			pod := action.(k8stesting.CreateAction).GetObject().(*corev1.Pod)
+			env := pod.Spec.Containers[0].Env
+			assert.Contains(t, env, corev1.EnvVar{Name: gameServerNameEnvVar, Value: "test"})
+			assert.Contains(t, env, corev1.EnvVar{Name: namespaceEnvVar, Value: "default"})
+			assert.Contains(t, env, corev1.EnvVar{Name: "AGONES_PORT_GAME", Value: "7000"})
+			assert.Contains(t, env, corev1.EnvVar{Name: "AGONES_PORT_QUERY_PORT", Value: "7001"})
+			assert.Contains(t, env, corev1.EnvVar{Name: fleetNameEnvVar, Value: "fleet"})
+			assert.Contains(t, env, corev1.EnvVar{Name: gameServerSetNameEnvVar, Value: "fleet-abcde"})
+			assert.Contains(t, env, corev1.EnvVar{Name: "REGION", Value: "europe-west1"})
+			assert.Contains(t, env, corev1.EnvVar{Name: "BUILD", Value: "1.2.3"})
+			assert.NotContains(t, env, corev1.EnvVar{Name: "BUILD", Value: "default"})
+			assert.NotContains(t, pod.Spec.Containers[1].Env, corev1.EnvVar{Name: "REGION", Value: "europe-west1"})
+			return true, pod, nil
+		})
+
+		_, err := c.createGameServerPod(fixture)
+		assert.Nil(t, err)
+		assert.True(t, created)
+	})
+
 	t.Run("invalid podspec", func(t *testing.T) {
 		c, mocks := newFakeController()
 		fixture := newFixture()
@@ -889,6 +1440,147 @@ func TestControllerCreateGameServerPod(t *testing.T) {
 		assert.True(t, gsUpdated, "GameServer should be updated")
 		assert.Equal(t, agonesv1.GameServerStateError, gs.Status.State)
 	})
+
+	t.Run("pod modifiers", func(t *testing.T) {
+		c, mocks := newFakeController()
+		fixture := newFixture()
+		podCreated := false
+		c.podModifiers = []PodModifier{
+			PodModifierFunc{ModifierName: "runtime", Func: func(gs *agonesv1.GameServer, pod *corev1.Pod) error {
+				runtimeClass := "gvisor"
+				pod.Spec.RuntimeClassName = &runtimeClass
+				return nil
+			}},
+			PodModifierFunc{ModifierName: "label", Func: func(gs *agonesv1.GameServer, pod *corev1.Pod) error {
+				// runs after the previous modifier
+				pod.ObjectMeta.Labels["runtime"] = *pod.Spec.RuntimeClassName
+				return nil
+			}},
+		}
+
+		mocks.KubeClient.AddReactor("create", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			podCreated = true
+			pod := action.(k8stesting.CreateAction).GetObject().(*corev1.Pod)
+			assert.Equal(t, "gvisor", *pod.Spec.RuntimeClassName)
+			assert.Equal(t, "gvisor", pod.ObjectMeta.Labels["runtime"])
+			assert.Len(t, pod.Spec.Containers, 2)
+			return true, pod, nil
+		})
+
+		_, err := c.createGameServerPod(fixture)
+		assert.Nil(t, err)
+		assert.True(t, podCreated)
+	})
+
+	t.Run("without sdk server sidecar", func(t *testing.T) {
+		c, mocks := newFakeController()
+		fixture := newFixture()
+		fixture.ObjectMeta.Annotations = map[string]string{agonesv1.SdkServerSidecarAnnotation: "false"}
+		podCreated := false
+
+		mocks.KubeClient.AddReactor("create", "rolebindings", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			assert.FailNow(t, "the sdk service account should not be bound again")
+			return true, nil, nil
+		})
+		mocks.KubeClient.AddReactor("create", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			podCreated = true
+			pod := action.(k8stesting.CreateAction).GetObject().(*corev1.Pod)
+			if assert.Len(t, pod.Spec.Containers, 1) {
+				gsContainer := pod.Spec.Containers[0]
+				assert.Equal(t, "/gshealthz", gsContainer.LivenessProbe.HTTPGet.Path)
+				assert.Empty(t, gsContainer.VolumeMounts)
+				assert.Contains(t, gsContainer.Env, corev1.EnvVar{Name: grpcPortEnvVar, Value: strconv.Itoa(int(fixture.Spec.SdkServer.GRPCPort))})
+			}
+			assert.Equal(t, "sdk-service-account", pod.Spec.ServiceAccountName)
+			assert.Empty(t, pod.Spec.Volumes)
+			return true, pod, nil
+		})
+
+		_, err := c.createGameServerPod(fixture)
+		assert.Nil(t, err)
+		assert.True(t, podCreated)
+	})
+
+	t.Run("without sdk server sidecar, with a service account", func(t *testing.T) {
+		c, mocks := newFakeController()
+		c.sdkServiceAccounts = map[string]bool{"default/game": true, "default/existing": true}
+		roleBindingInformer := mocks.KubeInformerFactory.Rbac().V1().RoleBindings()
+		c.roleBindingLister = roleBindingInformer.Lister()
+		c.roleBindingSynced = roleBindingInformer.Informer().HasSynced
+		var roleBindings []string
+
+		mocks.KubeClient.AddReactor("list", "rolebindings", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			existing := rbacv1.RoleBinding{ObjectMeta: metav1.ObjectMeta{Name: "sdk-service-account-existing", Namespace: "default"}}
+			return true, &rbacv1.RoleBindingList{Items: []rbacv1.RoleBinding{existing}}, nil
+		})
+		mocks.KubeClient.AddReactor("get", "serviceaccounts", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			name := action.(k8stesting.GetAction).GetName()
+			return true, &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", UID: "1234"}}, nil
+		})
+		mocks.KubeClient.AddReactor("create", "rolebindings", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			rb := action.(k8stesting.CreateAction).GetObject().(*rbacv1.RoleBinding)
+			roleBindings = append(roleBindings, rb.ObjectMeta.Name)
+			assert.Equal(t, "default", rb.ObjectMeta.Namespace)
+			assert.Equal(t, []rbacv1.Subject{{Kind: rbacv1.ServiceAccountKind, Name: "game", Namespace: "default"}}, rb.Subjects)
+			assert.Equal(t, "sdk-service-account", rb.RoleRef.Name)
+			assert.Equal(t, "ClusterRole", rb.RoleRef.Kind)
+			if assert.Len(t, rb.ObjectMeta.OwnerReferences, 1) {
+				assert.Equal(t, "ServiceAccount", rb.ObjectMeta.OwnerReferences[0].Kind)
+				assert.Equal(t, "game", rb.ObjectMeta.OwnerReferences[0].Name)
+				assert.Equal(t, types.UID("1234"), rb.ObjectMeta.OwnerReferences[0].UID)
+			}
+			return true, rb, nil
+		})
+		mocks.KubeClient.AddReactor("create", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			pod := action.(k8stesting.CreateAction).GetObject().(*corev1.Pod)
+			return true, pod, nil
+		})
+
+		_, cancel := agtesting.StartInformers(mocks, c.roleBindingSynced)
+		defer cancel()
+
+		for _, sa := range []string{"game", "existing", "other"} {
+			fixture := newFixture()
+			fixture.ObjectMeta.Annotations = map[string]string{agonesv1.SdkServerSidecarAnnotation: "false"}
+			fixture.Spec.Template.Spec.ServiceAccountName = sa
+			_, err := c.createGameServerPod(fixture)
+			assert.Nil(t, err)
+		}
+		// only the service accounts that can be bound, and are not bound yet, get a role binding
+		assert.Equal(t, []string{"sdk-service-account-game"}, roleBindings)
+		var events []string
+		for len(mocks.FakeRecorder.Events) > 0 {
+			events = append(events, <-mocks.FakeRecorder.Events)
+		}
+		assert.Contains(t, strings.Join(events, "\n"), "Warning Creating Service account other is not bound")
+	})
+
+	t.Run("pod modifier error", func(t *testing.T) {
+		c, mocks := newFakeController()
+		fixture := newFixture()
+		podCreated := false
+		gsUpdated := false
+		c.podModifiers = []PodModifier{PodModifierFunc{ModifierName: "broken", Func: func(gs *agonesv1.GameServer, pod *corev1.Pod) error {
+			return fmt.Errorf("no device available")
+		}}}
+
+		mocks.KubeClient.AddReactor("create", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			podCreated = true
+			return true, nil, nil
+		})
+		mocks.AgonesClient.AddReactor("update", "gameservers", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			gsUpdated = true
+			gs := action.(k8stesting.UpdateAction).GetObject().(*agonesv1.GameServer)
+			assert.Equal(t, agonesv1.GameServerStateError, gs.Status.State)
+			return true, gs, nil
+		})
+
+		gs, err := c.createGameServerPod(fixture)
+		assert.Nil(t, err)
+		assert.False(t, podCreated)
+		assert.True(t, gsUpdated)
+		assert.Equal(t, agonesv1.GameServerStateError, gs.Status.State)
+	})
 }
 
 func TestControllerApplyGameServerAddressAndPort(t *testing.T) {
@@ -917,11 +1609,57 @@ func TestControllerApplyGameServerAddressAndPort(t *testing.T) {
 	assert.Equal(t, node.ObjectMeta.Name, gs.Status.NodeName)
 }
 
+func TestControllerApplyNodeLabels(t *testing.T) {
+	t.Parallel()
+
+	node := corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: nodeFixtureName,
+		Labels: map[string]string{"zone": "us-west1-a", "instance-type": "n1-standard-4", "other": "value"}}}
+
+	setup := func(nodeLabels []string) (*Controller, context.CancelFunc) {
+		c, m := newFakeController()
+		c.nodeLabels = nodeLabels
+		m.KubeClient.AddReactor("list", "nodes", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			return true, &corev1.NodeList{Items: []corev1.Node{node}}, nil
+		})
+		_, cancel := agtesting.StartInformers(m, c.nodeSynced)
+		return c, cancel
+	}
+
+	t.Run("configured labels are copied", func(t *testing.T) {
+		c, cancel := setup([]string{"zone", "instance-type", "missing"})
+		defer cancel()
+
+		gs := &agonesv1.GameServer{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default",
+			Labels: map[string]string{"zone": "stale", "game": "test"}}}
+		assert.NoError(t, c.applyNodeLabels(gs, node.ObjectMeta.Name))
+		assert.Equal(t, map[string]string{"zone": "us-west1-a", "instance-type": "n1-standard-4", "game": "test"}, gs.ObjectMeta.Labels)
+	})
+
+	t.Run("no configured labels", func(t *testing.T) {
+		c, cancel := setup(nil)
+		defer cancel()
+
+		gs := &agonesv1.GameServer{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"}}
+		assert.NoError(t, c.applyNodeLabels(gs, "does-not-exist"))
+		assert.Empty(t, gs.ObjectMeta.Labels)
+	})
+
+	t.Run("missing node", func(t *testing.T) {
+		c, cancel := setup([]string{"zone"})
+		defer cancel()
+
+		gs := &agonesv1.GameServer{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"}}
+		assert.Error(t, c.applyNodeLabels(gs, "does-not-exist"))
+	})
+}
+
 func TestControllerSyncGameServerRequestReadyState(t *testing.T) {
 	t.Parallel()
 
 	t.Run("GameServer with ReadyRequest State", func(t *testing.T) {
 		c, m := newFakeController()
+		now := time.Now()
+		c.clock = clock.NewFakeClock(now)
 
 		gsFixture := &agonesv1.GameServer{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
 			Spec: newSingleContainerSpec(), Status: agonesv1.GameServerStatus{State: agonesv1.GameServerStateRequestReady}}
@@ -949,6 +1687,9 @@ func TestControllerSyncGameServerRequestReadyState(t *testing.T) {
 		assert.Nil(t, err, "should not error")
 		assert.True(t, gsUpdated, "GameServer wasn't updated")
 		assert.Equal(t, agonesv1.GameServerStateReady, gs.Status.State)
+		if assert.NotNil(t, gs.Status.ReadyTime) {
+			assert.True(t, now.Equal(gs.Status.ReadyTime.Time))
+		}
 		agtesting.AssertEventContains(t, m.FakeRecorder.Events, "SDK.Ready() complete")
 	})
 
@@ -1012,6 +1753,129 @@ func TestControllerSyncGameServerRequestReadyState(t *testing.T) {
 	})
 }
 
+func TestControllerSyncGameServerStatusLabels(t *testing.T) {
+	t.Parallel()
+
+	fixture := &agonesv1.GameServer{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default", Labels: map[string]string{"foo": "bar"}},
+		Spec: newSingleContainerSpec(), Status: agonesv1.GameServerStatus{State: agonesv1.GameServerStateReady, NodeName: "node-1"}}
+
+	t.Run("labels out of sync", func(t *testing.T) {
+		c, mocks := newFakeController()
+		updated := false
+
+		mocks.AgonesClient.AddReactor("update", "gameservers", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			updated = true
+			ua := action.(k8stesting.UpdateAction)
+			gs := ua.GetObject().(*agonesv1.GameServer)
+			assert.Equal(t, map[string]string{"foo": "bar", agonesv1.GameServerStateLabel: "Ready", agonesv1.GameServerNodeNameLabel: "node-1"}, gs.ObjectMeta.Labels)
+			return true, gs, nil
+		})
+
+		gs, err := c.syncGameServerStatusLabels(fixture.DeepCopy())
+		assert.Nil(t, err)
+		assert.True(t, updated, "GameServer should be updated")
+		assert.Equal(t, "Ready", gs.ObjectMeta.Labels[agonesv1.GameServerStateLabel])
+	})
+
+	t.Run("labels in sync", func(t *testing.T) {
+		c, mocks := newFakeController()
+		updated := false
+
+		mocks.AgonesClient.AddReactor("update", "gameservers", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			updated = true
+			return true, nil, nil
+		})
+
+		gsFixture := fixture.DeepCopy()
+		gsFixture.ApplyStatusLabels()
+		gs, err := c.syncGameServerStatusLabels(gsFixture)
+		assert.Nil(t, err)
+		assert.False(t, updated, "GameServer should not be updated")
+		assert.Equal(t, gsFixture, gs)
+	})
+}
+
+func TestControllerSyncGameServerReservedState(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	newFixture := func(reservedUntil time.Time) *agonesv1.GameServer {
+		until := metav1.NewTime(reservedUntil)
+		gs := &agonesv1.GameServer{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+			Spec: newSingleContainerSpec(), Status: agonesv1.GameServerStatus{State: agonesv1.GameServerStateReserved, ReservedUntil: &until}}
+		gs.ApplyDefaults()
+		return gs
+	}
+
+	t.Run("reservation expired", func(t *testing.T) {
+		c, mocks := newFakeController()
+		c.clock = clock.NewFakeClock(now)
+		fixture := newFixture(now.Add(-time.Second))
+		updated := false
+
+		mocks.AgonesClient.AddReactor("update", "gameservers", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			updated = true
+			ua := action.(k8stesting.UpdateAction)
+			gs := ua.GetObject().(*agonesv1.GameServer)
+			assert.Equal(t, agonesv1.GameServerStateReady, gs.Status.State)
+			assert.Nil(t, gs.Status.ReservedUntil)
+			return true, gs, nil
+		})
+
+		gs, err := c.syncGameServerReservedState(fixture)
+		assert.Nil(t, err)
+		assert.True(t, updated, "GameServer should be updated")
+		assert.Equal(t, agonesv1.GameServerStateReady, gs.Status.State)
+		assert.Contains(t, <-mocks.FakeRecorder.Events, "Reserve duration expired")
+	})
+
+	t.Run("reservation not expired", func(t *testing.T) {
+		c, mocks := newFakeController()
+		c.clock = clock.NewFakeClock(now)
+		fixture := newFixture(now.Add(time.Minute))
+		updated := false
+
+		mocks.AgonesClient.AddReactor("update", "gameservers", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			updated = true
+			return true, nil, nil
+		})
+
+		gs, err := c.syncGameServerReservedState(fixture)
+		assert.Nil(t, err)
+		assert.False(t, updated, "GameServer should not be updated")
+		assert.Equal(t, fixture, gs)
+	})
+
+	t.Run("reservation expired, within clock skew", func(t *testing.T) {
+		c, mocks := newFakeController()
+		c.clock = clock.NewFakeClock(now)
+		c.clockSkewTolerance = time.Minute
+		fixture := newFixture(now.Add(-time.Second))
+		updated := false
+
+		mocks.AgonesClient.AddReactor("update", "gameservers", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			updated = true
+			return true, nil, nil
+		})
+
+		_, err := c.syncGameServerReservedState(fixture)
+		assert.Nil(t, err)
+		assert.False(t, updated, "GameServer should not be updated")
+	})
+
+	t.Run("GameServer with unknown state", func(t *testing.T) {
+		testNoChange(t, "Unknown", func(c *Controller, fixture *agonesv1.GameServer) (*agonesv1.GameServer, error) {
+			return c.syncGameServerReservedState(fixture)
+		})
+	})
+
+	t.Run("GameServer with non zero deletion datetime", func(t *testing.T) {
+		testWithNonZeroDeletionTimestamp(t, func(c *Controller, fixture *agonesv1.GameServer) (*agonesv1.GameServer, error) {
+			return c.syncGameServerReservedState(fixture)
+		})
+	})
+}
+
 func TestControllerSyncGameServerShutdownState(t *testing.T) {
 	t.Parallel()
 
@@ -1441,13 +2305,26 @@ func testWithNonZeroDeletionTimestamp(t *testing.T, f func(*Controller, *agonesv
 	assert.Equal(t, fixture, result)
 }
 
+func TestSidecarResources(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, sidecarResources(resource.Quantity{}, resource.Quantity{}))
+	assert.Equal(t, corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("30m")},
+		sidecarResources(resource.MustParse("30m"), resource.Quantity{}))
+	assert.Equal(t, corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("64Mi")},
+		sidecarResources(resource.MustParse("0"), resource.MustParse("64Mi")))
+	assert.Equal(t, corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("30m"), corev1.ResourceMemory: resource.MustParse("64Mi")},
+		sidecarResources(resource.MustParse("30m"), resource.MustParse("64Mi")))
+}
+
 // newFakeController returns a controller, backed by the fake Clientset
 func newFakeController() (*Controller, agtesting.Mocks) {
 	m := agtesting.NewMocks()
 	wh := webhooks.NewWebHook(http.NewServeMux())
 	c := NewController(wh, healthcheck.NewHandler(),
 		10, 20, "sidecar:dev", false,
-		resource.MustParse("0.05"), resource.MustParse("0.1"), "sdk-service-account",
+		resource.MustParse("0.05"), resource.MustParse("0.1"), resource.MustParse("32Mi"), resource.MustParse("64Mi"), "sdk-service-account", nil, PodSnippet{}, nil, 0, 0, nil, true,
+		workerqueue.FastSlowRateLimiter{FastDelay: 20 * time.Millisecond, SlowDelay: 500 * time.Millisecond, FastRetries: 5},
 		m.KubeClient, m.KubeInformerFactory, m.ExtClient, m.AgonesClient, m.AgonesInformerFactory)
 	c.recorder = m.FakeRecorder
 	return c, m
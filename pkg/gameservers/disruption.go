@@ -0,0 +1,313 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gameservers
+
+import (
+	"fmt"
+
+	"agones.dev/agones/pkg/apis/agones"
+	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
+	"agones.dev/agones/pkg/client/clientset/versioned"
+	getterv1 "agones.dev/agones/pkg/client/clientset/versioned/typed/agones/v1"
+	"agones.dev/agones/pkg/client/informers/externalversions"
+	listerv1 "agones.dev/agones/pkg/client/listers/agones/v1"
+	"agones.dev/agones/pkg/util/logfields"
+	"agones.dev/agones/pkg/util/runtime"
+	"agones.dev/agones/pkg/util/workerqueue"
+	"github.com/heptiolabs/healthcheck"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	corelisterv1 "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+)
+
+const (
+	// toBeDeletedTaint is the taint the cluster autoscaler applies to a node that it is removing
+	toBeDeletedTaint = "ToBeDeletedByClusterAutoscaler"
+	// deletionCandidateTaint is the taint the cluster autoscaler applies to a node that it considers unneeded
+	deletionCandidateTaint = "DeletionCandidateOfClusterAutoscaler"
+	// drainTaint is the taint that drains the GameServers of a node, without cordoning it
+	drainTaint = agones.GroupName + "/drain"
+)
+
+// DisruptionController watches Nodes, and sets a forecast of
+// an imminent disruption on the Status of the GameServers running on
+// a Node that is being removed by the cluster autoscaler, is being drained,
+// or is not ready.
+// The Ready GameServers of a Node that is being removed or drained are shut down,
+// so that they are not allocated, and are replaced on other Nodes, while the
+// Allocated GameServers finish their game sessions.
+type DisruptionController struct {
+	baseLogger       *logrus.Entry
+	nodeSynced       cache.InformerSynced
+	nodeLister       corelisterv1.NodeLister
+	gameServerSynced cache.InformerSynced
+	gameServerGetter getterv1.GameServersGetter
+	gameServerLister listerv1.GameServerLister
+	workerqueue      *workerqueue.WorkerQueue
+	recorder         record.EventRecorder
+}
+
+// NewDisruptionController returns a DisruptionController
+func NewDisruptionController(health healthcheck.Handler,
+	kubeClient kubernetes.Interface,
+	agonesClient versioned.Interface,
+	kubeInformerFactory informers.SharedInformerFactory,
+	agonesInformerFactory externalversions.SharedInformerFactory) *DisruptionController {
+
+	nodeInformer := kubeInformerFactory.Core().V1().Nodes().Informer()
+	gameServers := agonesInformerFactory.Agones().V1().GameServers()
+	dc := &DisruptionController{
+		nodeSynced:       nodeInformer.HasSynced,
+		nodeLister:       kubeInformerFactory.Core().V1().Nodes().Lister(),
+		gameServerSynced: gameServers.Informer().HasSynced,
+		gameServerGetter: agonesClient.AgonesV1(),
+		gameServerLister: gameServers.Lister(),
+	}
+
+	dc.baseLogger = runtime.NewLoggerWithType(dc)
+	dc.workerqueue = workerqueue.NewWorkerQueue(dc.syncGameServer, dc.baseLogger, logfields.GameServerKey, agones.GroupName+".DisruptionController")
+	dc.workerqueue.AddHealthChecks(health, "gameserver-disruption-workerqueue")
+
+	eventBroadcaster := record.NewBroadcaster()
+	eventBroadcaster.StartLogging(dc.baseLogger.Infof)
+	eventBroadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{Interface: kubeClient.CoreV1().Events("")})
+	dc.recorder = eventBroadcaster.NewRecorder(scheme.Scheme, corev1.EventSource{Component: "disruption-controller"})
+
+	nodeInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		// the Node may already have been cordoned, tainted or not ready when the controller started
+		AddFunc: func(obj interface{}) {
+			node := obj.(*corev1.Node)
+			if forecastDisruption(node) != nil {
+				dc.enqueueGameServersOnNode(node.ObjectMeta.Name)
+			}
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			oldNode := oldObj.(*corev1.Node)
+			newNode := newObj.(*corev1.Node)
+			if !sameDisruption(forecastDisruption(oldNode), forecastDisruption(newNode)) {
+				dc.enqueueGameServersOnNode(newNode.ObjectMeta.Name)
+			}
+		},
+	})
+
+	gameServers.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		// the GameServer may not have been in the cache yet when its Node was added,
+		// or its Node may have recovered while the controller was not running
+		AddFunc: func(obj interface{}) {
+			gs := obj.(*agonesv1.GameServer)
+			if gs.Status.NodeName == "" {
+				return
+			}
+			if gs.Status.Disruption != nil {
+				dc.workerqueue.Enqueue(gs)
+				return
+			}
+			if node, err := dc.nodeLister.Get(gs.Status.NodeName); err == nil && forecastDisruption(node) != nil {
+				dc.workerqueue.Enqueue(gs)
+			}
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			oldGs := oldObj.(*agonesv1.GameServer)
+			newGs := newObj.(*agonesv1.GameServer)
+			// the GameServer has been scheduled, possibly onto a Node that is already going away
+			if oldGs.Status.NodeName != newGs.Status.NodeName && newGs.Status.NodeName != "" {
+				dc.workerqueue.Enqueue(newGs)
+				return
+			}
+			// the GameServer has become Ready on a Node that is being drained
+			if oldGs.Status.State != newGs.Status.State && newGs.Status.State == agonesv1.GameServerStateReady &&
+				drains(newGs.Status.Disruption) {
+				dc.workerqueue.Enqueue(newGs)
+			}
+		},
+	})
+
+	return dc
+}
+
+// Run processes the rate limited queue.
+// Will block until stop is closed
+func (dc *DisruptionController) Run(stop <-chan struct{}) error {
+	dc.baseLogger.Info("Wait for cache sync")
+	if !cache.WaitForCacheSync(stop, dc.gameServerSynced, dc.nodeSynced) {
+		return errors.New("failed to wait for caches to sync")
+	}
+
+	dc.workerqueue.Run(1, stop)
+
+	return nil
+}
+
+func (dc *DisruptionController) loggerForGameServerKey(key string) *logrus.Entry {
+	return logfields.AugmentLogEntry(dc.baseLogger, logfields.GameServerKey, key)
+}
+
+func (dc *DisruptionController) loggerForGameServer(gs *agonesv1.GameServer) *logrus.Entry {
+	gsName := "NilGameServer"
+	if gs != nil {
+		gsName = gs.Namespace + "/" + gs.Name
+	}
+	return dc.loggerForGameServerKey(gsName).WithField("gs", gs)
+}
+
+// enqueueGameServersOnNode enqueues all the GameServers running on the named Node
+func (dc *DisruptionController) enqueueGameServersOnNode(nodeName string) {
+	list, err := dc.gameServerLister.List(labels.Everything())
+	if err != nil {
+		runtime.HandleError(dc.baseLogger.WithField("node", nodeName), errors.Wrap(err, "error listing GameServers"))
+		return
+	}
+	for _, gs := range list {
+		if gs.Status.NodeName == nodeName {
+			dc.workerqueue.Enqueue(gs)
+		}
+	}
+}
+
+// syncGameServer sets the disruption forecast for the GameServer's Node on the GameServer's
+// Status, or clears it if the Node is no longer likely to be disrupted.
+// A Ready GameServer is shut down if its Node is being drained.
+func (dc *DisruptionController) syncGameServer(key string) error {
+	// Convert the namespace/name string into a distinct namespace and name
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		// don't return an error, as we don't want this retried
+		runtime.HandleError(dc.loggerForGameServerKey(key), errors.Wrapf(err, "invalid resource key"))
+		return nil
+	}
+
+	gs, err := dc.gameServerLister.GameServers(namespace).Get(name)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			dc.loggerForGameServerKey(key).Info("GameServer is no longer available for syncing")
+			return nil
+		}
+		return errors.Wrapf(err, "error retrieving GameServer %s from namespace %s", name, namespace)
+	}
+
+	if gs.IsBeingDeleted() || gs.Status.NodeName == "" {
+		return nil
+	}
+
+	node, err := dc.nodeLister.Get(gs.Status.NodeName)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil
+		}
+		return errors.Wrapf(err, "error retrieving Node %s for GameServer %s", gs.Status.NodeName, gs.ObjectMeta.Name)
+	}
+
+	disruption := forecastDisruption(node)
+	same := sameDisruption(gs.Status.Disruption, disruption)
+	shutdown := gs.Status.State == agonesv1.GameServerStateReady && drains(disruption)
+	if same && !shutdown {
+		return nil
+	}
+
+	gsCopy := gs.DeepCopy()
+	if !same {
+		gsCopy.Status.Disruption = disruption
+	}
+	if shutdown {
+		gsCopy.Shutdown(agonesv1.ShutdownReasonNodeDrain)
+	}
+	if _, err := dc.gameServerGetter.GameServers(gs.ObjectMeta.Namespace).Update(gsCopy); err != nil {
+		return errors.Wrapf(err, "error updating disruption forecast of GameServer %s", gs.ObjectMeta.Name)
+	}
+
+	if shutdown {
+		dc.loggerForGameServer(gs).WithField("reason", disruption.Reason).Info("Shutting down Ready GameServer on drained Node")
+		dc.recorder.Eventf(gs, corev1.EventTypeNormal, string(agonesv1.GameServerStateShutdown),
+			"Shutting down, as Node %s is being drained", node.ObjectMeta.Name)
+	}
+	if same {
+		return nil
+	}
+	if disruption != nil {
+		dc.loggerForGameServer(gs).WithField("reason", disruption.Reason).Info("GameServer is likely to be disrupted")
+		dc.recorder.Event(gs, corev1.EventTypeWarning, "Disruption", disruption.Message)
+	} else {
+		dc.recorder.Event(gs, corev1.EventTypeNormal, "Disruption", "GameServer is no longer likely to be disrupted")
+	}
+
+	return nil
+}
+
+// forecastDisruption returns a forecast of the disruption of the GameServers running on the Node,
+// or nil if they are not likely to be disrupted
+func forecastDisruption(node *corev1.Node) *agonesv1.GameServerDisruption {
+	newDisruption := func(reason agonesv1.GameServerDisruptionReason, message string) *agonesv1.GameServerDisruption {
+		return &agonesv1.GameServerDisruption{Reason: reason, Message: message, Since: metav1.Now()}
+	}
+
+	if hasTaint(node, toBeDeletedTaint) {
+		return newDisruption(agonesv1.DisruptionNodeScaleDown,
+			fmt.Sprintf("Node %s is being removed by the cluster autoscaler", node.ObjectMeta.Name))
+	}
+	for _, cond := range node.Status.Conditions {
+		if cond.Type == corev1.NodeReady && cond.Status != corev1.ConditionTrue {
+			return newDisruption(agonesv1.DisruptionNodeNotReady,
+				fmt.Sprintf("Node %s is not ready: %s", node.ObjectMeta.Name, cond.Reason))
+		}
+	}
+	if node.Spec.Unschedulable {
+		return newDisruption(agonesv1.DisruptionNodeDraining,
+			fmt.Sprintf("Node %s has been cordoned, and may be drained", node.ObjectMeta.Name))
+	}
+	if hasTaint(node, drainTaint) {
+		return newDisruption(agonesv1.DisruptionNodeDraining,
+			fmt.Sprintf("Node %s has the %s taint, and is being drained", node.ObjectMeta.Name, drainTaint))
+	}
+	if hasTaint(node, deletionCandidateTaint) {
+		return newDisruption(agonesv1.DisruptionNodeScaleDownCandidate,
+			fmt.Sprintf("Node %s is unneeded, and may be removed by the cluster autoscaler", node.ObjectMeta.Name))
+	}
+
+	return nil
+}
+
+// drains returns true if the disruption is of a Node that is being drained or removed,
+// whose Ready GameServers should be shut down
+func drains(d *agonesv1.GameServerDisruption) bool {
+	return d != nil && (d.Reason == agonesv1.DisruptionNodeDraining || d.Reason == agonesv1.DisruptionNodeScaleDown)
+}
+
+// sameDisruption returns true if both forecasts are for the same disruption,
+// regardless of when they were made
+func sameDisruption(a, b *agonesv1.GameServerDisruption) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Reason == b.Reason && a.Message == b.Message
+}
+
+func hasTaint(node *corev1.Node, key string) bool {
+	for _, taint := range node.Spec.Taints {
+		if taint.Key == key {
+			return true
+		}
+	}
+	return false
+}
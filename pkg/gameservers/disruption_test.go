@@ -0,0 +1,299 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gameservers
+
+import (
+	"testing"
+	"time"
+
+	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
+	agtesting "agones.dev/agones/pkg/testing"
+	"github.com/heptiolabs/healthcheck"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apimachinery/pkg/watch"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestForecastDisruption(t *testing.T) {
+	t.Parallel()
+
+	ready := corev1.NodeCondition{Type: corev1.NodeReady, Status: corev1.ConditionTrue}
+	notReady := corev1.NodeCondition{Type: corev1.NodeReady, Status: corev1.ConditionUnknown, Reason: "NodeStatusUnknown"}
+
+	fixtures := map[string]struct {
+		node     corev1.Node
+		expected agonesv1.GameServerDisruptionReason
+	}{
+		"healthy node": {
+			node:     corev1.Node{Status: corev1.NodeStatus{Conditions: []corev1.NodeCondition{ready}}},
+			expected: "",
+		},
+		"not ready": {
+			node:     corev1.Node{Status: corev1.NodeStatus{Conditions: []corev1.NodeCondition{notReady}}},
+			expected: agonesv1.DisruptionNodeNotReady,
+		},
+		"cordoned": {
+			node: corev1.Node{Spec: corev1.NodeSpec{Unschedulable: true},
+				Status: corev1.NodeStatus{Conditions: []corev1.NodeCondition{ready}}},
+			expected: agonesv1.DisruptionNodeDraining,
+		},
+		"scale down": {
+			node: corev1.Node{Spec: corev1.NodeSpec{Unschedulable: true, Taints: []corev1.Taint{{Key: toBeDeletedTaint}}},
+				Status: corev1.NodeStatus{Conditions: []corev1.NodeCondition{notReady}}},
+			expected: agonesv1.DisruptionNodeScaleDown,
+		},
+		"scale down candidate": {
+			node: corev1.Node{Spec: corev1.NodeSpec{Taints: []corev1.Taint{{Key: deletionCandidateTaint}}},
+				Status: corev1.NodeStatus{Conditions: []corev1.NodeCondition{ready}}},
+			expected: agonesv1.DisruptionNodeScaleDownCandidate,
+		},
+		"drain taint": {
+			node: corev1.Node{Spec: corev1.NodeSpec{Taints: []corev1.Taint{{Key: drainTaint, Effect: corev1.TaintEffectNoSchedule}}},
+				Status: corev1.NodeStatus{Conditions: []corev1.NodeCondition{ready}}},
+			expected: agonesv1.DisruptionNodeDraining,
+		},
+	}
+
+	for k, v := range fixtures {
+		t.Run(k, func(t *testing.T) {
+			v.node.ObjectMeta.Name = "node1"
+			d := forecastDisruption(&v.node)
+			if v.expected == "" {
+				assert.Nil(t, d)
+				return
+			}
+			if assert.NotNil(t, d) {
+				assert.Equal(t, v.expected, d.Reason)
+				assert.Contains(t, d.Message, "node1")
+			}
+		})
+	}
+}
+
+func TestDisruptionControllerSyncGameServer(t *testing.T) {
+	t.Parallel()
+
+	cordoned := corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node1"}, Spec: corev1.NodeSpec{Unschedulable: true}}
+	healthy := corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node1"}}
+	candidate := corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node1"},
+		Spec: corev1.NodeSpec{Taints: []corev1.Taint{{Key: deletionCandidateTaint}}}}
+	draining := forecastDisruption(&cordoned)
+	draining.Since = metav1.NewTime(time.Now().Add(-time.Minute))
+
+	fixtures := map[string]struct {
+		node       corev1.Node
+		state      agonesv1.GameServerState
+		disruption *agonesv1.GameServerDisruption
+		updated    bool
+		expected   *agonesv1.GameServerDisruption
+		shutdown   bool
+	}{
+		"new disruption": {
+			node:     cordoned,
+			updated:  true,
+			expected: forecastDisruption(&cordoned),
+		},
+		"existing disruption": {
+			node:       cordoned,
+			disruption: draining,
+			updated:    false,
+		},
+		"disruption over": {
+			node:       healthy,
+			disruption: draining,
+			updated:    true,
+			expected:   nil,
+		},
+		"no disruption": {
+			node:    healthy,
+			updated: false,
+		},
+		"ready, new disruption": {
+			node:     cordoned,
+			state:    agonesv1.GameServerStateReady,
+			updated:  true,
+			expected: forecastDisruption(&cordoned),
+			shutdown: true,
+		},
+		"ready, existing disruption": {
+			node:       cordoned,
+			state:      agonesv1.GameServerStateReady,
+			disruption: draining,
+			updated:    true,
+			expected:   draining,
+			shutdown:   true,
+		},
+		"ready, scale down candidate": {
+			node:     candidate,
+			state:    agonesv1.GameServerStateReady,
+			updated:  true,
+			expected: forecastDisruption(&candidate),
+		},
+	}
+
+	for k, v := range fixtures {
+		t.Run(k, func(t *testing.T) {
+			m := agtesting.NewMocks()
+			dc := NewDisruptionController(healthcheck.NewHandler(), m.KubeClient, m.AgonesClient, m.KubeInformerFactory, m.AgonesInformerFactory)
+			dc.recorder = m.FakeRecorder
+
+			state := v.state
+			if state == "" {
+				state = agonesv1.GameServerStateAllocated
+			}
+			gs := agonesv1.GameServer{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "test"}, Spec: newSingleContainerSpec(),
+				Status: agonesv1.GameServerStatus{State: state, NodeName: "node1", Disruption: v.disruption}}
+
+			m.KubeClient.AddReactor("list", "nodes", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				return true, &corev1.NodeList{Items: []corev1.Node{v.node}}, nil
+			})
+			m.AgonesClient.AddReactor("list", "gameservers", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				return true, &agonesv1.GameServerList{Items: []agonesv1.GameServer{gs}}, nil
+			})
+			updated := false
+			m.AgonesClient.AddReactor("update", "gameservers", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				updated = true
+				gsObj := action.(k8stesting.UpdateAction).GetObject().(*agonesv1.GameServer)
+				assert.True(t, sameDisruption(v.expected, gsObj.Status.Disruption))
+				if v.shutdown {
+					assert.Equal(t, agonesv1.GameServerStateShutdown, gsObj.Status.State)
+					assert.Equal(t, agonesv1.ShutdownReasonNodeDrain, gsObj.ShutdownReason())
+				} else {
+					assert.Equal(t, state, gsObj.Status.State)
+				}
+				return true, gsObj, nil
+			})
+
+			_, cancel := agtesting.StartInformers(m, dc.gameServerSynced, dc.nodeSynced)
+			defer cancel()
+
+			err := dc.syncGameServer("default/test")
+			assert.NoError(t, err)
+			assert.Equal(t, v.updated, updated)
+			if v.shutdown {
+				agtesting.AssertEventContains(t, m.FakeRecorder.Events, "Shutting down")
+			}
+			if v.updated && !sameDisruption(v.disruption, v.expected) {
+				agtesting.AssertEventContains(t, m.FakeRecorder.Events, "Disruption")
+			}
+		})
+	}
+}
+
+func TestDisruptionControllerNodeUpdate(t *testing.T) {
+	m := agtesting.NewMocks()
+	dc := NewDisruptionController(healthcheck.NewHandler(), m.KubeClient, m.AgonesClient, m.KubeInformerFactory, m.AgonesInformerFactory)
+	dc.recorder = m.FakeRecorder
+
+	nodeWatch := watch.NewFake()
+	m.KubeClient.AddWatchReactor("nodes", k8stesting.DefaultWatchReactor(nodeWatch, nil))
+
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node1"}}
+	gs := agonesv1.GameServer{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "test"}, Spec: newSingleContainerSpec(),
+		Status: agonesv1.GameServerStatus{State: agonesv1.GameServerStateReady, NodeName: node.ObjectMeta.Name}}
+	other := agonesv1.GameServer{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "other"}, Spec: newSingleContainerSpec(),
+		Status: agonesv1.GameServerStatus{State: agonesv1.GameServerStateReady, NodeName: "node2"}}
+	m.AgonesClient.AddReactor("list", "gameservers", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, &agonesv1.GameServerList{Items: []agonesv1.GameServer{gs, other}}, nil
+	})
+
+	updated := make(chan *agonesv1.GameServer, 10)
+	m.AgonesClient.AddReactor("update", "gameservers", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		gsObj := action.(k8stesting.UpdateAction).GetObject().(*agonesv1.GameServer)
+		updated <- gsObj
+		return true, gsObj, nil
+	})
+
+	stop, cancel := agtesting.StartInformers(m)
+	defer cancel()
+
+	nodeWatch.Add(node.DeepCopy())
+	go dc.Run(stop) // nolint: errcheck
+
+	err := wait.PollImmediate(100*time.Millisecond, 10*time.Second, func() (bool, error) {
+		_, err := dc.nodeLister.Get(node.ObjectMeta.Name)
+		return err == nil, nil
+	})
+	assert.NoError(t, err)
+
+	node.Spec.Taints = []corev1.Taint{{Key: toBeDeletedTaint}}
+	nodeWatch.Modify(node.DeepCopy())
+
+	select {
+	case gsObj := <-updated:
+		assert.Equal(t, "test", gsObj.ObjectMeta.Name)
+		if assert.NotNil(t, gsObj.Status.Disruption) {
+			assert.Equal(t, agonesv1.DisruptionNodeScaleDown, gsObj.Status.Disruption.Reason)
+		}
+		assert.Equal(t, agonesv1.GameServerStateShutdown, gsObj.Status.State)
+	case <-time.After(10 * time.Second):
+		assert.FailNow(t, "GameServer should have been updated")
+	}
+	select {
+	case gsObj := <-updated:
+		assert.FailNow(t, "unexpected update", gsObj.ObjectMeta.Name)
+	case <-time.After(500 * time.Millisecond):
+	}
+}
+
+func TestDisruptionControllerNodeAdd(t *testing.T) {
+	m := agtesting.NewMocks()
+	dc := NewDisruptionController(healthcheck.NewHandler(), m.KubeClient, m.AgonesClient, m.KubeInformerFactory, m.AgonesInformerFactory)
+	dc.recorder = m.FakeRecorder
+
+	// the Node was cordoned before the controller started
+	node := corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node1"}, Spec: corev1.NodeSpec{Unschedulable: true}}
+	gs := agonesv1.GameServer{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "test"}, Spec: newSingleContainerSpec(),
+		Status: agonesv1.GameServerStatus{State: agonesv1.GameServerStateAllocated, NodeName: node.ObjectMeta.Name}}
+	other := agonesv1.GameServer{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "other"}, Spec: newSingleContainerSpec(),
+		Status: agonesv1.GameServerStatus{State: agonesv1.GameServerStateReady, NodeName: "node2"}}
+	m.KubeClient.AddReactor("list", "nodes", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, &corev1.NodeList{Items: []corev1.Node{node}}, nil
+	})
+	m.AgonesClient.AddReactor("list", "gameservers", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, &agonesv1.GameServerList{Items: []agonesv1.GameServer{gs, other}}, nil
+	})
+
+	updated := make(chan *agonesv1.GameServer, 10)
+	m.AgonesClient.AddReactor("update", "gameservers", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		gsObj := action.(k8stesting.UpdateAction).GetObject().(*agonesv1.GameServer)
+		updated <- gsObj
+		return true, gsObj, nil
+	})
+
+	stop, cancel := agtesting.StartInformers(m)
+	defer cancel()
+	go dc.Run(stop) // nolint: errcheck
+
+	select {
+	case gsObj := <-updated:
+		assert.Equal(t, "test", gsObj.ObjectMeta.Name)
+		if assert.NotNil(t, gsObj.Status.Disruption) {
+			assert.Equal(t, agonesv1.DisruptionNodeDraining, gsObj.Status.Disruption.Reason)
+		}
+		assert.Equal(t, agonesv1.GameServerStateAllocated, gsObj.Status.State)
+	case <-time.After(10 * time.Second):
+		assert.FailNow(t, "GameServer should have been updated")
+	}
+	select {
+	case gsObj := <-updated:
+		assert.FailNow(t, "unexpected update", gsObj.ObjectMeta.Name)
+	case <-time.After(500 * time.Millisecond):
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gameservers
+
+import (
+	"strconv"
+	"strings"
+
+	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+const (
+	gameServerNameEnvVar    = "AGONES_GAMESERVER_NAME"
+	namespaceEnvVar         = "AGONES_NAMESPACE"
+	fleetNameEnvVar         = "AGONES_FLEET_NAME"
+	gameServerSetNameEnvVar = "AGONES_GAMESERVERSET_NAME"
+	// portEnvVarPrefix is suffixed with the upper cased name of each port, e.g. AGONES_PORT_DEFAULT
+	portEnvVarPrefix = "AGONES_PORT_"
+
+	// reservedEnvVarPrefix is the prefix of the environment variables set by Agones
+	reservedEnvVarPrefix = "AGONES_"
+)
+
+// ParseEnvironmentVariables parses a comma separated list of NAME=value environment variables,
+// e.g. REGION=europe-west1,CLUSTER=prod, that the controller adds to every game server container
+func ParseEnvironmentVariables(s string) ([]corev1.EnvVar, error) {
+	var env []corev1.EnvVar
+	names := map[string]bool{}
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v == "" {
+			continue
+		}
+		kv := strings.SplitN(v, "=", 2)
+		if len(kv) != 2 {
+			return nil, errors.Errorf("invalid environment variable %q, should be NAME=value", v)
+		}
+		name := strings.TrimSpace(kv[0])
+		if errs := validation.IsEnvVarName(name); len(errs) > 0 {
+			return nil, errors.Errorf("invalid environment variable name %q: %s", name, strings.Join(errs, ", "))
+		}
+		if strings.HasPrefix(name, reservedEnvVarPrefix) {
+			return nil, errors.Errorf("environment variable name %q is reserved, as it starts with %s", name, reservedEnvVarPrefix)
+		}
+		if names[name] {
+			return nil, errors.Errorf("environment variable %q is set more than once", name)
+		}
+		names[name] = true
+		env = append(env, corev1.EnvVar{Name: name, Value: kv[1]})
+	}
+	return env, nil
+}
+
+// addGameServerEnvVars adds environment variables to the game server container, with the identity
+// and the host ports of the GameServer, and the defaults of the controller, unless the container already sets them
+func (c *Controller) addGameServerEnvVars(gs *agonesv1.GameServer, pod *corev1.Pod) {
+	env := append(gameServerEnvironmentVariables(gs), c.gameServerEnv...)
+	gs.ApplyToPodGameServerContainer(pod, func(container corev1.Container) corev1.Container {
+		defined := map[string]bool{}
+		for _, e := range container.Env {
+			defined[e.Name] = true
+		}
+		for _, e := range env {
+			if !defined[e.Name] {
+				container.Env = append(container.Env, e)
+			}
+		}
+		return container
+	})
+}
+
+// gameServerEnvironmentVariables returns the environment variables with the name and namespace of the GameServer,
+// the names of its Fleet and GameServerSet, if it has any, and the host port allocated to each of its named ports,
+// so that game servers can discover them at startup, without the SDK
+func gameServerEnvironmentVariables(gs *agonesv1.GameServer) []corev1.EnvVar {
+	env := []corev1.EnvVar{
+		{Name: gameServerNameEnvVar, Value: gs.ObjectMeta.Name},
+		{Name: namespaceEnvVar, Value: gs.ObjectMeta.Namespace},
+	}
+	if fleet := gs.ObjectMeta.Labels[agonesv1.FleetNameLabel]; fleet != "" {
+		env = append(env, corev1.EnvVar{Name: fleetNameEnvVar, Value: fleet})
+	}
+	if gsSet := gs.ObjectMeta.Labels[agonesv1.GameServerSetGameServerLabel]; gsSet != "" {
+		env = append(env, corev1.EnvVar{Name: gameServerSetNameEnvVar, Value: gsSet})
+	}
+	for _, p := range gs.Spec.Ports {
+		if p.Name != "" && p.HostPort != 0 {
+			env = append(env, corev1.EnvVar{Name: portEnvVarPrefix + agonesv1.EnvVarSuffix(p.Name), Value: strconv.Itoa(int(p.HostPort))})
+		}
+	}
+	return env
+}
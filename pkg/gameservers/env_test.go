@@ -0,0 +1,44 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gameservers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestParseEnvironmentVariables(t *testing.T) {
+	t.Parallel()
+
+	env, err := ParseEnvironmentVariables("")
+	assert.NoError(t, err)
+	assert.Empty(t, env)
+
+	env, err = ParseEnvironmentVariables("REGION=europe-west1, CLUSTER=prod,EMPTY=,URL=http://example.com/?a=b")
+	assert.NoError(t, err)
+	assert.Equal(t, []corev1.EnvVar{
+		{Name: "REGION", Value: "europe-west1"},
+		{Name: "CLUSTER", Value: "prod"},
+		{Name: "EMPTY", Value: ""},
+		{Name: "URL", Value: "http://example.com/?a=b"},
+	}, env)
+
+	for _, s := range []string{"REGION", "=value", "1REGION=a", "AGONES_SDK_GRPC_PORT=1234", "REGION=a,REGION=b"} {
+		_, err := ParseEnvironmentVariables(s)
+		assert.Error(t, err, s)
+	}
+}
@@ -74,7 +74,7 @@ func NewHealthController(health healthcheck.Handler,
 
 	hc.baseLogger = runtime.NewLoggerWithType(hc)
 	hc.workerqueue = workerqueue.NewWorkerQueue(hc.syncGameServer, hc.baseLogger, logfields.GameServerKey, agones.GroupName+".HealthController")
-	health.AddLivenessCheck("gameserver-health-workerqueue", healthcheck.Check(hc.workerqueue.Healthy))
+	hc.workerqueue.AddHealthChecks(health, "gameserver-health-workerqueue")
 
 	eventBroadcaster := record.NewBroadcaster()
 	eventBroadcaster.StartLogging(hc.baseLogger.Infof)
@@ -192,7 +192,7 @@ func (hc *HealthController) syncGameServer(key string) error {
 
 	hc.loggerForGameServer(gs).Info("Issue with GameServer pod, marking as GameServerStateUnhealthy")
 	gsCopy := gs.DeepCopy()
-	gsCopy.Status.State = agonesv1.GameServerStateUnhealthy
+	gsCopy.MarkUnhealthy()
 
 	if _, err := hc.gameServerGetter.GameServers(gs.ObjectMeta.Namespace).Update(gsCopy); err != nil {
 		return errors.Wrapf(err, "error updating GameServer %s to unhealthy", gs.ObjectMeta.Name)
@@ -0,0 +1,70 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gameservers
+
+import (
+	"context"
+
+	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
+	mt "agones.dev/agones/pkg/metrics"
+	"agones.dev/agones/pkg/util/runtime"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+)
+
+var (
+	keyFleetName = mt.MustTagKey("fleet_name")
+
+	finalizerForceRemovedStats        = stats.Int64("gameservers/finalizer_force_removed", "The count of finalizers force removed from gameservers", "1")
+	portAllocatorInconsistenciesStats = stats.Int64("port_allocator/inconsistencies", "The count of ports in use that were not allocated on their node, or allocated to gameservers that no longer exist", "1")
+	nodePortUtilizationStats          = stats.Float64("port_allocator/node_port_utilization", "The ratio of the port range allocated on a node", "1")
+)
+
+func init() {
+	runtime.Must(view.Register(&view.View{
+		Name:        "gameservers_finalizer_force_removed_total",
+		Measure:     finalizerForceRemovedStats,
+		Description: "The total of gameservers that had their finalizer force removed after the finalizer timeout",
+		Aggregation: view.Count(),
+		TagKeys:     []tag.Key{keyFleetName},
+	}))
+	runtime.Must(view.Register(&view.View{
+		Name:        "port_allocator_inconsistencies_total",
+		Measure:     portAllocatorInconsistenciesStats,
+		Description: "The total of ports in use by gameservers that were found not allocated on their node, and were allocated, or allocated to gameservers that no longer exist, and were freed",
+		Aggregation: view.Count(),
+	}))
+	runtime.Must(view.Register(&view.View{
+		Name:        "port_allocator_node_port_utilization",
+		Measure:     nodePortUtilizationStats,
+		Description: "The distribution of the ratio of the port range allocated per node",
+		Aggregation: view.Distribution(0.00001, 0.1, 0.2, 0.3, 0.4, 0.5, 0.6, 0.7, 0.8, 0.9, 0.99999),
+	}))
+}
+
+// recordFinalizerForceRemoved records that the finalizer of the GameServer
+// was removed without waiting for its Pod to be deleted
+func recordFinalizerForceRemoved(logger *logrus.Entry, gs *agonesv1.GameServer) {
+	fleetName := gs.ObjectMeta.Labels[agonesv1.FleetNameLabel]
+	if fleetName == "" {
+		fleetName = "none"
+	}
+	if err := stats.RecordWithTags(context.Background(), []tag.Mutator{tag.Upsert(keyFleetName, fleetName)},
+		finalizerForceRemovedStats.M(1)); err != nil {
+		logger.WithError(err).Warn("error while recording finalizer stats")
+	}
+}
@@ -0,0 +1,33 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gameservers
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	corelisterv1 "k8s.io/client-go/listers/core/v1"
+)
+
+// NamespaceTerminating returns true if the namespace is being deleted. The API server rejects
+// the creation of resources in a terminating namespace, so controllers should not attempt to
+// create replacement GameServers, GameServerSets or Pods in it, while the namespace controller
+// deletes its content.
+func NamespaceTerminating(namespaceLister corelisterv1.NamespaceLister, namespace string) bool {
+	ns, err := namespaceLister.Get(namespace)
+	if err != nil {
+		// if the namespace can't be found, let the API server decide
+		return false
+	}
+	return ns.Status.Phase == corev1.NamespaceTerminating || !ns.ObjectMeta.DeletionTimestamp.IsZero()
+}
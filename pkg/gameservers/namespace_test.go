@@ -0,0 +1,50 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gameservers
+
+import (
+	"testing"
+
+	agtesting "agones.dev/agones/pkg/testing"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestNamespaceTerminating(t *testing.T) {
+	t.Parallel()
+
+	now := metav1.Now()
+	m := agtesting.NewMocks()
+	m.KubeClient.AddReactor("list", "namespaces", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, &corev1.NamespaceList{Items: []corev1.Namespace{
+			{ObjectMeta: metav1.ObjectMeta{Name: "active"}, Status: corev1.NamespaceStatus{Phase: corev1.NamespaceActive}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "terminating"}, Status: corev1.NamespaceStatus{Phase: corev1.NamespaceTerminating}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "deleted", DeletionTimestamp: &now}, Status: corev1.NamespaceStatus{Phase: corev1.NamespaceActive}},
+		}}, nil
+	})
+
+	namespaces := m.KubeInformerFactory.Core().V1().Namespaces()
+	lister := namespaces.Lister()
+	_, cancel := agtesting.StartInformers(m, namespaces.Informer().HasSynced)
+	defer cancel()
+
+	assert.False(t, NamespaceTerminating(lister, "active"))
+	assert.True(t, NamespaceTerminating(lister, "terminating"))
+	assert.True(t, NamespaceTerminating(lister, "deleted"))
+	assert.False(t, NamespaceTerminating(lister, "missing"))
+}
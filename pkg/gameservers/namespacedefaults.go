@@ -0,0 +1,179 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gameservers
+
+import (
+	"strconv"
+
+	"agones.dev/agones/pkg/apis"
+	"agones.dev/agones/pkg/apis/agones"
+	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	corelisterv1 "k8s.io/client-go/listers/core/v1"
+)
+
+const (
+	// NamespaceSchedulingAnnotation is the namespace annotation that overrides the default
+	// scheduling strategy of the Fleets and GameServers created in the namespace
+	NamespaceSchedulingAnnotation = agones.GroupName + "/scheduling"
+	// NamespaceHealthPeriodSecondsAnnotation is the namespace annotation that overrides
+	// the default health period seconds of the GameServers created in the namespace
+	NamespaceHealthPeriodSecondsAnnotation = agones.GroupName + "/health-period-seconds"
+	// NamespaceHealthFailureThresholdAnnotation is the namespace annotation that overrides
+	// the default health failure threshold of the GameServers created in the namespace
+	NamespaceHealthFailureThresholdAnnotation = agones.GroupName + "/health-failure-threshold"
+	// NamespaceHealthInitialDelaySecondsAnnotation is the namespace annotation that overrides
+	// the default health initial delay seconds of the GameServers created in the namespace
+	NamespaceHealthInitialDelaySecondsAnnotation = agones.GroupName + "/health-initial-delay-seconds"
+	// NamespaceSidecarCPURequestAnnotation is the namespace annotation that overrides
+	// the cpu request of the sidecar of the GameServers in the namespace
+	NamespaceSidecarCPURequestAnnotation = agones.GroupName + "/sidecar-cpu-request"
+	// NamespaceSidecarCPULimitAnnotation is the namespace annotation that overrides
+	// the cpu limit of the sidecar of the GameServers in the namespace
+	NamespaceSidecarCPULimitAnnotation = agones.GroupName + "/sidecar-cpu-limit"
+	// NamespaceSidecarMemoryRequestAnnotation is the namespace annotation that overrides
+	// the memory request of the sidecar of the GameServers in the namespace
+	NamespaceSidecarMemoryRequestAnnotation = agones.GroupName + "/sidecar-memory-request"
+	// NamespaceSidecarMemoryLimitAnnotation is the namespace annotation that overrides
+	// the memory limit of the sidecar of the GameServers in the namespace
+	NamespaceSidecarMemoryLimitAnnotation = agones.GroupName + "/sidecar-memory-limit"
+)
+
+// NamespaceDefaults are the defaults that the annotations of a namespace override
+// for the Fleets and GameServers in it, so that, for example, a development namespace
+// can run with smaller sidecars than a production one. Zero values are not overridden.
+type NamespaceDefaults struct {
+	// Scheduling is the default scheduling strategy
+	Scheduling apis.SchedulingStrategy
+	// HealthPeriodSeconds is the default health period seconds
+	HealthPeriodSeconds int32
+	// HealthFailureThreshold is the default health failure threshold
+	HealthFailureThreshold int32
+	// HealthInitialDelaySeconds is the default health initial delay seconds
+	HealthInitialDelaySeconds int32
+	// SidecarCPURequest is the cpu request of the sidecar
+	SidecarCPURequest *resource.Quantity
+	// SidecarCPULimit is the cpu limit of the sidecar
+	SidecarCPULimit *resource.Quantity
+	// SidecarMemoryRequest is the memory request of the sidecar
+	SidecarMemoryRequest *resource.Quantity
+	// SidecarMemoryLimit is the memory limit of the sidecar
+	SidecarMemoryLimit *resource.Quantity
+}
+
+// ParseNamespaceDefaults returns the NamespaceDefaults set by the annotations of the namespace,
+// or an error if any of them is invalid
+func ParseNamespaceDefaults(ns *corev1.Namespace) (NamespaceDefaults, error) {
+	var d NamespaceDefaults
+	a := ns.ObjectMeta.Annotations
+
+	if s, ok := a[NamespaceSchedulingAnnotation]; ok {
+		d.Scheduling = apis.SchedulingStrategy(s)
+		if d.Scheduling != apis.Packed && d.Scheduling != apis.Distributed {
+			return NamespaceDefaults{}, errors.Errorf("annotation %s must be %s or %s, was %q",
+				NamespaceSchedulingAnnotation, apis.Packed, apis.Distributed, s)
+		}
+	}
+
+	for key, value := range map[string]*int32{
+		NamespaceHealthPeriodSecondsAnnotation:       &d.HealthPeriodSeconds,
+		NamespaceHealthFailureThresholdAnnotation:    &d.HealthFailureThreshold,
+		NamespaceHealthInitialDelaySecondsAnnotation: &d.HealthInitialDelaySeconds,
+	} {
+		s, ok := a[key]
+		if !ok {
+			continue
+		}
+		i, err := strconv.ParseInt(s, 10, 32)
+		if err != nil || i <= 0 {
+			return NamespaceDefaults{}, errors.Errorf("annotation %s must be an integer greater than 0, was %q", key, s)
+		}
+		*value = int32(i)
+	}
+
+	for key, value := range map[string]**resource.Quantity{
+		NamespaceSidecarCPURequestAnnotation:    &d.SidecarCPURequest,
+		NamespaceSidecarCPULimitAnnotation:      &d.SidecarCPULimit,
+		NamespaceSidecarMemoryRequestAnnotation: &d.SidecarMemoryRequest,
+		NamespaceSidecarMemoryLimitAnnotation:   &d.SidecarMemoryLimit,
+	} {
+		s, ok := a[key]
+		if !ok {
+			continue
+		}
+		q, err := resource.ParseQuantity(s)
+		if err != nil || q.Sign() < 0 {
+			return NamespaceDefaults{}, errors.Errorf("annotation %s must be a quantity that is not negative, was %q", key, s)
+		}
+		*value = &q
+	}
+
+	return d, nil
+}
+
+// GetNamespaceDefaults returns the NamespaceDefaults of the namespace. If the namespace
+// can't be found, or its annotations are invalid, it returns no overrides, so that the
+// creation of resources is never blocked by the configuration of their namespace.
+func GetNamespaceDefaults(namespaceLister corelisterv1.NamespaceLister, namespace string, logger *logrus.Entry) NamespaceDefaults {
+	ns, err := namespaceLister.Get(namespace)
+	if err != nil {
+		return NamespaceDefaults{}
+	}
+	d, err := ParseNamespaceDefaults(ns)
+	if err != nil {
+		logger.WithField("namespace", namespace).WithError(err).Warn("Ignoring the invalid default overrides of the namespace")
+		return NamespaceDefaults{}
+	}
+	return d
+}
+
+// ApplyToGameServer sets the overridden scheduling and health defaults on the fields the
+// GameServer does not set, before GameServer.ApplyDefaults sets any that are left
+func (d NamespaceDefaults) ApplyToGameServer(gs *agonesv1.GameServer) {
+	if gs.Spec.Scheduling == "" {
+		gs.Spec.Scheduling = d.Scheduling
+	}
+	if gs.Spec.Health.Disabled {
+		return
+	}
+	if gs.Spec.Health.PeriodSeconds <= 0 {
+		gs.Spec.Health.PeriodSeconds = d.HealthPeriodSeconds
+	}
+	if gs.Spec.Health.FailureThreshold <= 0 {
+		gs.Spec.Health.FailureThreshold = d.HealthFailureThreshold
+	}
+	if gs.Spec.Health.InitialDelaySeconds <= 0 {
+		gs.Spec.Health.InitialDelaySeconds = d.HealthInitialDelaySeconds
+	}
+}
+
+// ApplyToFleet sets the overridden scheduling strategy on the Fleet if it does not set one.
+// It takes precedence over the defaults configured on the controller.
+func (d NamespaceDefaults) ApplyToFleet(fleet *agonesv1.Fleet) {
+	if fleet.Spec.Scheduling == "" {
+		fleet.Spec.Scheduling = d.Scheduling
+	}
+}
+
+// sidecarQuantity returns the overridden quantity if it is set, or else the controller's
+func sidecarQuantity(override *resource.Quantity, q resource.Quantity) resource.Quantity {
+	if override != nil {
+		return *override
+	}
+	return q
+}
@@ -0,0 +1,159 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gameservers
+
+import (
+	"testing"
+
+	"agones.dev/agones/pkg/apis"
+	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
+	agtesting "agones.dev/agones/pkg/testing"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestParseNamespaceDefaults(t *testing.T) {
+	t.Parallel()
+
+	cpu := resource.MustParse("20m")
+	memory := resource.MustParse("128Mi")
+
+	fixtures := map[string]struct {
+		annotations map[string]string
+		expected    NamespaceDefaults
+		err         bool
+	}{
+		"no annotations": {
+			expected: NamespaceDefaults{},
+		},
+		"all annotations": {
+			annotations: map[string]string{
+				NamespaceSchedulingAnnotation:                "Distributed",
+				NamespaceHealthPeriodSecondsAnnotation:       "10",
+				NamespaceHealthFailureThresholdAnnotation:    "6",
+				NamespaceHealthInitialDelaySecondsAnnotation: "30",
+				NamespaceSidecarCPURequestAnnotation:         "20m",
+				NamespaceSidecarCPULimitAnnotation:           "20m",
+				NamespaceSidecarMemoryRequestAnnotation:      "128Mi",
+				NamespaceSidecarMemoryLimitAnnotation:        "128Mi",
+			},
+			expected: NamespaceDefaults{
+				Scheduling:                apis.Distributed,
+				HealthPeriodSeconds:       10,
+				HealthFailureThreshold:    6,
+				HealthInitialDelaySeconds: 30,
+				SidecarCPURequest:         &cpu,
+				SidecarCPULimit:           &cpu,
+				SidecarMemoryRequest:      &memory,
+				SidecarMemoryLimit:        &memory,
+			},
+		},
+		"unrelated annotations": {
+			annotations: map[string]string{"example.com/team": "dev"},
+			expected:    NamespaceDefaults{},
+		},
+		"invalid scheduling": {
+			annotations: map[string]string{NamespaceSchedulingAnnotation: "Random"},
+			err:         true,
+		},
+		"invalid health": {
+			annotations: map[string]string{NamespaceHealthPeriodSecondsAnnotation: "ten"},
+			err:         true,
+		},
+		"health not positive": {
+			annotations: map[string]string{NamespaceHealthFailureThresholdAnnotation: "0"},
+			err:         true,
+		},
+		"invalid sidecar resource": {
+			annotations: map[string]string{NamespaceSidecarCPULimitAnnotation: "lots"},
+			err:         true,
+		},
+		"negative sidecar resource": {
+			annotations: map[string]string{NamespaceSidecarMemoryRequestAnnotation: "-1Mi"},
+			err:         true,
+		},
+	}
+
+	for k, v := range fixtures {
+		t.Run(k, func(t *testing.T) {
+			ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "ns", Annotations: v.annotations}}
+			d, err := ParseNamespaceDefaults(ns)
+			if v.err {
+				assert.NotNil(t, err)
+				assert.Equal(t, NamespaceDefaults{}, d)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, v.expected, d)
+		})
+	}
+}
+
+func TestGetNamespaceDefaults(t *testing.T) {
+	t.Parallel()
+
+	m := agtesting.NewMocks()
+	m.KubeClient.AddReactor("list", "namespaces", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, &corev1.NamespaceList{Items: []corev1.Namespace{
+			{ObjectMeta: metav1.ObjectMeta{Name: "dev", Annotations: map[string]string{NamespaceSchedulingAnnotation: "Distributed"}}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "invalid", Annotations: map[string]string{NamespaceSchedulingAnnotation: "Random"}}},
+		}}, nil
+	})
+
+	namespaces := m.KubeInformerFactory.Core().V1().Namespaces()
+	lister := namespaces.Lister()
+	_, cancel := agtesting.StartInformers(m, namespaces.Informer().HasSynced)
+	defer cancel()
+
+	logger := logrus.NewEntry(logrus.New())
+	assert.Equal(t, NamespaceDefaults{Scheduling: apis.Distributed}, GetNamespaceDefaults(lister, "dev", logger))
+	assert.Equal(t, NamespaceDefaults{}, GetNamespaceDefaults(lister, "invalid", logger))
+	assert.Equal(t, NamespaceDefaults{}, GetNamespaceDefaults(lister, "missing", logger))
+}
+
+func TestNamespaceDefaultsApplyToGameServer(t *testing.T) {
+	t.Parallel()
+
+	d := NamespaceDefaults{Scheduling: apis.Distributed, HealthPeriodSeconds: 10, HealthFailureThreshold: 6, HealthInitialDelaySeconds: 30}
+
+	gs := &agonesv1.GameServer{}
+	d.ApplyToGameServer(gs)
+	gs.ApplyDefaults()
+	assert.Equal(t, apis.Distributed, gs.Spec.Scheduling)
+	assert.Equal(t, int32(10), gs.Spec.Health.PeriodSeconds)
+	assert.Equal(t, int32(6), gs.Spec.Health.FailureThreshold)
+	assert.Equal(t, int32(30), gs.Spec.Health.InitialDelaySeconds)
+
+	// the values set on the GameServer are kept
+	gs = &agonesv1.GameServer{Spec: agonesv1.GameServerSpec{Scheduling: apis.Packed, Health: agonesv1.Health{PeriodSeconds: 2}}}
+	d.ApplyToGameServer(gs)
+	assert.Equal(t, apis.Packed, gs.Spec.Scheduling)
+	assert.Equal(t, int32(2), gs.Spec.Health.PeriodSeconds)
+	assert.Equal(t, int32(6), gs.Spec.Health.FailureThreshold)
+
+	// no overrides leave the defaults of the GameServer
+	gs = &agonesv1.GameServer{}
+	NamespaceDefaults{}.ApplyToGameServer(gs)
+	gs.ApplyDefaults()
+	assert.Equal(t, apis.Packed, gs.Spec.Scheduling)
+	assert.Equal(t, int32(5), gs.Spec.Health.PeriodSeconds)
+	assert.Equal(t, int32(3), gs.Spec.Health.FailureThreshold)
+	assert.Equal(t, int32(5), gs.Spec.Health.InitialDelaySeconds)
+}
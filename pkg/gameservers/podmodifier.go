@@ -0,0 +1,79 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gameservers
+
+import (
+	"fmt"
+	"sync"
+
+	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// PodModifier changes the Pod that the controller builds for a GameServer, before it is created,
+// e.g. to set a runtime class or to request a device. This lets custom builds of the controller
+// change GameServer Pods without patching how the controller builds them.
+type PodModifier interface {
+	// Name identifies the PodModifier, and must be unique
+	Name() string
+	// ModifyPod changes the pod of the GameServer. If it returns an error, the Pod is not created,
+	// and the GameServer moves to the Error state.
+	ModifyPod(gs *agonesv1.GameServer, pod *corev1.Pod) error
+}
+
+// PodModifierFunc is a PodModifier that calls a function
+type PodModifierFunc struct {
+	// ModifierName is returned by Name
+	ModifierName string
+	// Func is called by ModifyPod
+	Func func(gs *agonesv1.GameServer, pod *corev1.Pod) error
+}
+
+// Name returns the name of the PodModifierFunc
+func (f PodModifierFunc) Name() string {
+	return f.ModifierName
+}
+
+// ModifyPod calls the function of the PodModifierFunc
+func (f PodModifierFunc) ModifyPod(gs *agonesv1.GameServer, pod *corev1.Pod) error {
+	return f.Func(gs, pod)
+}
+
+var (
+	podModifiersMu sync.Mutex
+	podModifiers   []PodModifier
+)
+
+// RegisterPodModifier adds a PodModifier to every GameServer Pod that controllers created afterwards build.
+// PodModifiers run in the order they are registered, after the controller is done building the Pod.
+// It is meant to be called from the init function of a package that is compiled into the controller,
+// and panics if a PodModifier with the same name is already registered.
+func RegisterPodModifier(m PodModifier) {
+	podModifiersMu.Lock()
+	defer podModifiersMu.Unlock()
+	for _, r := range podModifiers {
+		if r.Name() == m.Name() {
+			panic(fmt.Sprintf("pod modifier %s is already registered", m.Name()))
+		}
+	}
+	podModifiers = append(podModifiers, m)
+}
+
+// registeredPodModifiers returns a copy of the PodModifiers registered so far
+func registeredPodModifiers() []PodModifier {
+	podModifiersMu.Lock()
+	defer podModifiersMu.Unlock()
+	return append([]PodModifier(nil), podModifiers...)
+}
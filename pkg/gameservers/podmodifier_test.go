@@ -0,0 +1,50 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gameservers
+
+import (
+	"testing"
+
+	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestRegisterPodModifier(t *testing.T) {
+	// not parallel, as it changes the registered PodModifiers
+	defer func(registered []PodModifier) {
+		podModifiers = registered
+	}(podModifiers)
+	podModifiers = nil
+
+	noop := func(gs *agonesv1.GameServer, pod *corev1.Pod) error { return nil }
+	RegisterPodModifier(PodModifierFunc{ModifierName: "first", Func: noop})
+	RegisterPodModifier(PodModifierFunc{ModifierName: "second", Func: noop})
+
+	registered := registeredPodModifiers()
+	if assert.Len(t, registered, 2) {
+		assert.Equal(t, "first", registered[0].Name())
+		assert.Equal(t, "second", registered[1].Name())
+	}
+
+	assert.Panics(t, func() {
+		RegisterPodModifier(PodModifierFunc{ModifierName: "first", Func: noop})
+	})
+	assert.Len(t, registeredPodModifiers(), 2)
+
+	// the returned PodModifiers are a copy
+	registered[0] = nil
+	assert.NotNil(t, registeredPodModifiers()[0])
+}
@@ -0,0 +1,129 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gameservers
+
+import (
+	"fmt"
+	"os"
+
+	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// PodSnippet is the part of a Pod specification that the controller adds to every GameServer Pod,
+// so that operators can run their own sidecars, such as a log shipper, next to every game server
+type PodSnippet struct {
+	// Containers are added to the Pod, after the SDK server sidecar
+	Containers []corev1.Container `json:"containers,omitempty"`
+	// Volumes are added to the Pod, for the Containers to mount
+	Volumes []corev1.Volume `json:"volumes,omitempty"`
+}
+
+// LoadPodSnippet reads a PodSnippet from a yaml or json file, and validates it
+func LoadPodSnippet(path string) (PodSnippet, error) {
+	var snippet PodSnippet
+	f, err := os.Open(path)
+	if err != nil {
+		return snippet, errors.Wrapf(err, "error opening pod snippet %s", path)
+	}
+	defer f.Close() // nolint: errcheck
+
+	if err := yaml.NewYAMLOrJSONDecoder(f, 4096).Decode(&snippet); err != nil {
+		return snippet, errors.Wrapf(err, "error decoding pod snippet %s", path)
+	}
+	return snippet, snippet.Validate()
+}
+
+// Validate returns an error if the containers or volumes of the PodSnippet are missing a name,
+// or have a name that is already used by the controller
+func (s PodSnippet) Validate() error {
+	names := map[string]bool{sdkserverSidecarName: true}
+	for _, c := range s.Containers {
+		if c.Name == "" || c.Image == "" {
+			return errors.New("pod snippet containers must have a name and an image")
+		}
+		if names[c.Name] {
+			return errors.Errorf("pod snippet container name %s is already in use", c.Name)
+		}
+		names[c.Name] = true
+	}
+
+	// this volume is used to disable the service account of the game server
+	names = map[string]bool{agonesv1.ServiceAccountVolumeName: true}
+	for _, v := range s.Volumes {
+		if v.Name == "" {
+			return errors.New("pod snippet volumes must have a name")
+		}
+		if names[v.Name] {
+			return errors.Errorf("pod snippet volume name %s is already in use", v.Name)
+		}
+		names[v.Name] = true
+	}
+	return nil
+}
+
+// validateGameServer returns the causes for the containers and volumes of the template of the GameServer
+// that have the same name as a container or volume of the PodSnippet, as its Pod could not be created
+func (s PodSnippet) validateGameServer(gs *agonesv1.GameServer) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	containers := map[string]bool{}
+	for _, c := range s.Containers {
+		containers[c.Name] = true
+	}
+	for i, c := range gs.Spec.Template.Spec.Containers {
+		if containers[c.Name] {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Field:   fmt.Sprintf("template.spec.containers[%d].name", i),
+				Message: fmt.Sprintf("Container name %s is already used by the pod snippet", c.Name),
+			})
+		}
+	}
+
+	volumes := map[string]bool{}
+	for _, v := range s.Volumes {
+		volumes[v.Name] = true
+	}
+	for i, v := range gs.Spec.Template.Spec.Volumes {
+		if volumes[v.Name] {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Field:   fmt.Sprintf("template.spec.volumes[%d].name", i),
+				Message: fmt.Sprintf("Volume name %s is already used by the pod snippet", v.Name),
+			})
+		}
+	}
+	return causes
+}
+
+// apply adds copies of the containers and volumes of the PodSnippet to the pod.
+// If disableServiceAccount is true, the containers don't get access to the Kubernetes API,
+// the same as the game server container.
+func (s PodSnippet) apply(pod *corev1.Pod, disableServiceAccount bool) {
+	for _, c := range s.Containers {
+		c = *c.DeepCopy()
+		if disableServiceAccount {
+			c.VolumeMounts = append(c.VolumeMounts,
+				corev1.VolumeMount{MountPath: "/var/run/secrets/kubernetes.io/serviceaccount", Name: "empty", ReadOnly: true})
+		}
+		pod.Spec.Containers = append(pod.Spec.Containers, c)
+	}
+	for _, v := range s.Volumes {
+		pod.Spec.Volumes = append(pod.Spec.Volumes, *v.DeepCopy())
+	}
+}
@@ -0,0 +1,154 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gameservers
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestLoadPodSnippet(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "podsnippet")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir) // nolint: errcheck
+
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		assert.NoError(t, ioutil.WriteFile(path, []byte(content), 0644))
+		return path
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		path := write("valid.yaml", `
+containers:
+- name: log-shipper
+  image: fluent/fluent-bit
+  volumeMounts:
+  - name: logs
+    mountPath: /var/log/game
+volumes:
+- name: logs
+  emptyDir: {}
+`)
+		snippet, err := LoadPodSnippet(path)
+		assert.NoError(t, err)
+		if assert.Len(t, snippet.Containers, 1) {
+			assert.Equal(t, "log-shipper", snippet.Containers[0].Name)
+			assert.Equal(t, "fluent/fluent-bit", snippet.Containers[0].Image)
+			assert.Equal(t, "/var/log/game", snippet.Containers[0].VolumeMounts[0].MountPath)
+		}
+		if assert.Len(t, snippet.Volumes, 1) {
+			assert.Equal(t, "logs", snippet.Volumes[0].Name)
+			assert.NotNil(t, snippet.Volumes[0].EmptyDir)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		path := write("invalid.yaml", `
+containers:
+- name: agones-gameserver-sidecar
+  image: fluent/fluent-bit
+`)
+		_, err := LoadPodSnippet(path)
+		assert.EqualError(t, err, "pod snippet container name agones-gameserver-sidecar is already in use")
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		_, err := LoadPodSnippet(filepath.Join(dir, "missing.yaml"))
+		assert.Error(t, err)
+	})
+}
+
+func TestPodSnippetValidate(t *testing.T) {
+	t.Parallel()
+
+	container := func(name string) corev1.Container {
+		return corev1.Container{Name: name, Image: "image"}
+	}
+	volume := func(name string) corev1.Volume {
+		return corev1.Volume{Name: name}
+	}
+
+	assert.NoError(t, PodSnippet{}.Validate())
+	assert.NoError(t, PodSnippet{Containers: []corev1.Container{container("a"), container("b")}, Volumes: []corev1.Volume{volume("a")}}.Validate())
+
+	assert.Error(t, PodSnippet{Containers: []corev1.Container{{Name: "a"}}}.Validate())
+	assert.Error(t, PodSnippet{Containers: []corev1.Container{container("a"), container("a")}}.Validate())
+	assert.Error(t, PodSnippet{Containers: []corev1.Container{container(sdkserverSidecarName)}}.Validate())
+	assert.Error(t, PodSnippet{Volumes: []corev1.Volume{volume("")}}.Validate())
+	assert.Error(t, PodSnippet{Volumes: []corev1.Volume{volume("a"), volume("a")}}.Validate())
+	assert.Error(t, PodSnippet{Volumes: []corev1.Volume{volume("empty")}}.Validate())
+}
+
+func TestPodSnippetValidateGameServer(t *testing.T) {
+	t.Parallel()
+
+	snippet := PodSnippet{
+		Containers: []corev1.Container{{Name: "log-shipper", Image: "fluent/fluent-bit"}},
+		Volumes:    []corev1.Volume{{Name: "logs"}},
+	}
+
+	gs := &agonesv1.GameServer{Spec: agonesv1.GameServerSpec{Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+		Containers: []corev1.Container{{Name: "game-server", Image: "image"}},
+		Volumes:    []corev1.Volume{{Name: "config"}},
+	}}}}
+	assert.Empty(t, snippet.validateGameServer(gs))
+	assert.Empty(t, PodSnippet{}.validateGameServer(gs))
+
+	gs.Spec.Template.Spec.Containers = append(gs.Spec.Template.Spec.Containers, corev1.Container{Name: "log-shipper", Image: "image"})
+	gs.Spec.Template.Spec.Volumes = append(gs.Spec.Template.Spec.Volumes, corev1.Volume{Name: "logs"})
+	causes := snippet.validateGameServer(gs)
+	if assert.Len(t, causes, 2) {
+		assert.Equal(t, "template.spec.containers[1].name", causes[0].Field)
+		assert.Equal(t, "template.spec.volumes[1].name", causes[1].Field)
+	}
+}
+
+func TestPodSnippetApply(t *testing.T) {
+	t.Parallel()
+
+	snippet := PodSnippet{
+		Containers: []corev1.Container{{Name: "log-shipper", Image: "fluent/fluent-bit",
+			VolumeMounts: []corev1.VolumeMount{{Name: "logs", MountPath: "/var/log/game"}}}},
+		Volumes: []corev1.Volume{{Name: "logs"}},
+	}
+
+	pod := &corev1.Pod{Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "gameserver"}}}}
+	snippet.apply(pod, true)
+	if assert.Len(t, pod.Spec.Containers, 2) {
+		assert.Equal(t, "log-shipper", pod.Spec.Containers[1].Name)
+		assert.Equal(t, []corev1.VolumeMount{
+			{Name: "logs", MountPath: "/var/log/game"},
+			{Name: "empty", MountPath: "/var/run/secrets/kubernetes.io/serviceaccount", ReadOnly: true},
+		}, pod.Spec.Containers[1].VolumeMounts)
+	}
+	assert.Equal(t, []corev1.Volume{{Name: "logs"}}, pod.Spec.Volumes)
+	// the snippet is not modified by changes to the pod
+	assert.Len(t, snippet.Containers[0].VolumeMounts, 1)
+
+	pod = &corev1.Pod{Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "gameserver"}}}}
+	snippet.apply(pod, false)
+	if assert.Len(t, pod.Spec.Containers, 2) {
+		assert.Len(t, pod.Spec.Containers[1].VolumeMounts, 1)
+	}
+}
@@ -15,8 +15,10 @@
 package gameservers
 
 import (
+	"context"
 	"sort"
 	"sync"
+	"time"
 
 	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
 	"agones.dev/agones/pkg/client/informers/externalversions"
@@ -24,27 +26,57 @@ import (
 	"agones.dev/agones/pkg/util/runtime"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"go.opencensus.io/stats"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/informers"
 	corelisterv1 "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/tools/cache"
 )
 
+// portAllocatorCheckPeriod is how often the port allocations are checked against the GameServers
+// in the informer cache, and the port utilization of the nodes is recorded
+const portAllocatorCheckPeriod = time.Minute
+
 // A set of port allocations for a node
 type portAllocation map[int32]bool
 
+// heldPort is a port that is taken in the port allocation at an index of portAllocations
+type heldPort struct {
+	index int
+	port  int32
+}
+
+// gameServerPorts are the ports held by a GameServer
+type gameServerPorts struct {
+	namespace string
+	name      string
+	ports     []heldPort
+}
+
 // PortAllocator manages the dynamic port
 // allocation strategy. Only use exposed methods to ensure
 // appropriate locking is taken.
 // The PortAllocator does not currently support mixing static portAllocations (or any pods with defined HostPort)
-// within the dynamic port range other than the ones it coordinates.
+// within the dynamic port range other than the ones it coordinates. GameServers with Static ports within the range
+// are rejected on creation, but the ones that already exist are tracked on their node when the ports are synced.
+// Once synced, the port allocations are kept up to date incrementally: the PortAllocator records the port allocation
+// each port of a GameServer is held in, so that exactly those are freed when it is deleted, or moved to the port
+// allocation of its node when it is scheduled. New nodes get a port allocation, so that the full rebuild from the
+// informer cache only happens on startup, and is built without holding the lock.
+// The port range can be changed at runtime with SetPortRange: ports that are added are free on every node, and
+// ports that are removed are no longer allocated, but the ones in use are tracked until their GameServers are deleted.
 type PortAllocator struct {
 	logger             *logrus.Entry
 	mutex              sync.RWMutex
 	portAllocations    []portAllocation
-	gameServerRegistry map[types.UID]bool
+	gameServerRegistry map[types.UID]*gameServerPorts
+	portHolders        map[heldPort]types.UID // the GameServer that holds each taken port
+	// nodeIndex is the index in portAllocations of the port allocation of each node.
+	// Port allocations that are not in it are held for GameServers that are not scheduled yet.
+	nodeIndex          map[string]int
 	minPort            int32
 	maxPort            int32
 	gameServerSynced   cache.InformerSynced
@@ -53,6 +85,7 @@ type PortAllocator struct {
 	nodeSynced         cache.InformerSynced
 	nodeLister         corelisterv1.NodeLister
 	nodeInformer       cache.SharedIndexInformer
+	synced             bool
 }
 
 // NewPortAllocator returns a new dynamic port
@@ -70,7 +103,9 @@ func NewPortAllocator(minPort, maxPort int32,
 		mutex:              sync.RWMutex{},
 		minPort:            minPort,
 		maxPort:            maxPort,
-		gameServerRegistry: map[types.UID]bool{},
+		gameServerRegistry: map[types.UID]*gameServerPorts{},
+		portHolders:        map[heldPort]types.UID{},
+		nodeIndex:          map[string]int{},
 		gameServerSynced:   gameServers.Informer().HasSynced,
 		gameServerLister:   gameServers.Lister(),
 		gameServerInformer: gameServers.Informer(),
@@ -81,8 +116,22 @@ func NewPortAllocator(minPort, maxPort int32,
 	pa.logger = runtime.NewLoggerWithType(pa)
 
 	pa.gameServerInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			oldGs := oldObj.(*agonesv1.GameServer)
+			newGs := newObj.(*agonesv1.GameServer)
+			if oldGs.Status.NodeName == "" && newGs.Status.NodeName != "" {
+				pa.syncScheduledGameServer(newGs)
+			}
+		},
 		DeleteFunc: pa.syncDeleteGameServer,
 	})
+	pa.nodeInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: pa.syncNode,
+		UpdateFunc: func(_, newObj interface{}) {
+			pa.syncNode(newObj)
+		},
+		DeleteFunc: pa.syncDeleteNode,
+	})
 
 	pa.logger.WithField("minPort", minPort).WithField("maxPort", maxPort).Info("Starting")
 	return pa
@@ -102,29 +151,99 @@ func (pa *PortAllocator) Run(stop <-chan struct{}) error {
 		return errors.Wrap(err, "error performing initial sync")
 	}
 
+	go wait.Until(pa.checkConsistency, portAllocatorCheckPeriod, stop)
+
+	return nil
+}
+
+// HasSynced returns true once the initial sync of the ports in use
+// by existing GameServers has completed
+func (pa *PortAllocator) HasSynced() bool {
+	pa.mutex.RLock()
+	defer pa.mutex.RUnlock()
+	return pa.synced
+}
+
+// PortRange returns the range of ports the PortAllocator allocates from
+func (pa *PortAllocator) PortRange() agonesv1.PortRange {
+	pa.mutex.RLock()
+	defer pa.mutex.RUnlock()
+	return pa.currentPortRange()
+}
+
+// currentPortRange returns the range of ports the PortAllocator allocates from.
+// Only call it while the mutex is held.
+func (pa *PortAllocator) currentPortRange() agonesv1.PortRange {
+	return agonesv1.PortRange{MinPort: pa.minPort, MaxPort: pa.maxPort}
+}
+
+// SetPortRange changes the range of ports the PortAllocator allocates from, without
+// losing track of the ports in use. The ports added to the range become free on every node.
+// The ports removed from the range are no longer allocated, and are forgotten once they are free,
+// so the ones in use stay taken until their GameServers are deleted.
+func (pa *PortAllocator) SetPortRange(r agonesv1.PortRange) error {
+	if r.MinPort <= 0 || r.MaxPort < r.MinPort {
+		return errors.Errorf("invalid port range %d-%d", r.MinPort, r.MaxPort)
+	}
+
+	expanded := func() bool {
+		pa.mutex.Lock()
+		defer pa.mutex.Unlock()
+
+		old := pa.currentPortRange()
+		if old == r {
+			return false
+		}
+		pa.logger.WithField("old", old).WithField("new", r).Info("Changing port range")
+
+		pa.minPort, pa.maxPort = r.MinPort, r.MaxPort
+		for _, np := range pa.portAllocations {
+			resizePortAllocation(np, r)
+		}
+		return r.MinPort < old.MinPort || r.MaxPort > old.MaxPort
+	}()
+
+	// Static ports that were outside of the old range are not tracked yet, so take them
+	// straight away, rather than waiting for the next consistency check
+	if expanded && pa.HasSynced() {
+		pa.checkConsistency()
+	}
 	return nil
 }
 
+// resizePortAllocation adds the ports of the range that are missing from the port allocation,
+// and removes the free ports that are not in the range
+func resizePortAllocation(np portAllocation, r agonesv1.PortRange) {
+	for p, taken := range np {
+		if !taken && !r.Contains(p) {
+			delete(np, p)
+		}
+	}
+	for p := r.MinPort; p <= r.MaxPort; p++ {
+		if _, ok := np[p]; !ok {
+			np[p] = false
+		}
+	}
+}
+
 // Allocate assigns a port to the GameServer and returns it.
+// If the GameServer has a port range, only ports within it are assigned.
 // Return ErrPortNotFound if no port is allocatable
 func (pa *PortAllocator) Allocate(gs *agonesv1.GameServer) *agonesv1.GameServer {
 	pa.mutex.Lock()
 	defer pa.mutex.Unlock()
 
-	type pn struct {
-		pa   portAllocation
-		port int32
-	}
+	portRange := pa.portRange(gs)
 
 	// we only want this to be called inside the mutex lock
 	// so let's define the function here so it can never be called elsewhere.
 	// Also the return gives an escape from the double loop
-	findOpenPorts := func(amount int) []pn {
-		var ports []pn
-		for _, n := range pa.portAllocations {
+	findOpenPorts := func(amount int) []heldPort {
+		var ports []heldPort
+		for i, n := range pa.portAllocations {
 			for p, taken := range n {
-				if !taken {
-					ports = append(ports, pn{pa: n, port: p})
+				if !taken && portRange.Contains(p) {
+					ports = append(ports, heldPort{index: i, port: p})
 					// only allocate as many ports as are asked for by the GameServer
 					if len(ports) == amount {
 						return ports
@@ -144,14 +263,14 @@ func (pa *PortAllocator) Allocate(gs *agonesv1.GameServer) *agonesv1.GameServer
 		allocations := findOpenPorts(amount)
 
 		if len(allocations) == amount {
-			pa.gameServerRegistry[gs.ObjectMeta.UID] = true
+			held := pa.register(gs)
 
 			for i, p := range gs.Spec.Ports {
 				if p.PortPolicy == agonesv1.Dynamic || p.PortPolicy == agonesv1.Passthrough {
 					// pop off allocation
-					var a pn
+					var a heldPort
 					a, allocations = allocations[0], allocations[1:]
-					a.pa[a.port] = true
+					pa.hold(gs.ObjectMeta.UID, held, a)
 					gs.Spec.Ports[i].HostPort = a.port
 
 					if p.PortPolicy == agonesv1.Passthrough {
@@ -175,35 +294,140 @@ func (pa *PortAllocator) Allocate(gs *agonesv1.GameServer) *agonesv1.GameServer
 	return allocate(gs)
 }
 
-// DeAllocate marks the given port as no longer allocated
+// portRange returns the range of ports that can be allocated to the GameServer,
+// which is the port range of the GameServer if it is within the range of the PortAllocator
+func (pa *PortAllocator) portRange(gs *agonesv1.GameServer) agonesv1.PortRange {
+	r := pa.currentPortRange()
+	pr := gs.Spec.PortRange
+	if pr == nil {
+		return r
+	}
+
+	// the range is validated on creation, but make sure that an invalid range can never
+	// stop us from finding a port, since we keep adding nodes until one is found
+	if pr.MinPort > pr.MaxPort || !r.Contains(pr.MinPort) || !r.Contains(pr.MaxPort) {
+		pa.logger.WithField("gs", gs.ObjectMeta.Name).WithField("portRange", *pr).
+			Warn("GameServer port range is outside of the allocatable port range. Ignoring")
+		return r
+	}
+	return *pr
+}
+
+// DeAllocate marks the ports held by the given GameServer as no longer allocated
 func (pa *PortAllocator) DeAllocate(gs *agonesv1.GameServer) {
+	pa.mutex.Lock()
+	defer pa.mutex.Unlock()
+
 	// skip if it wasn't previously allocated
+	if !pa.release(gs.ObjectMeta.UID) {
+		pa.logger.WithField("gs", gs.ObjectMeta.Name).
+			Info("Did not allocate this GameServer. Ignoring for DeAllocation")
+	}
+}
 
-	found := func() bool {
-		pa.mutex.RLock()
-		defer pa.mutex.RUnlock()
-		if _, ok := pa.gameServerRegistry[gs.ObjectMeta.UID]; ok {
-			return true
-		}
+// register returns the ports held by the GameServer, registering it if it holds none yet.
+// Only call it while the mutex is held.
+func (pa *PortAllocator) register(gs *agonesv1.GameServer) *gameServerPorts {
+	held, ok := pa.gameServerRegistry[gs.ObjectMeta.UID]
+	if !ok {
+		held = &gameServerPorts{namespace: gs.ObjectMeta.Namespace, name: gs.ObjectMeta.Name}
+		pa.gameServerRegistry[gs.ObjectMeta.UID] = held
+	}
+	return held
+}
+
+// hold takes the port for the GameServer. Only call it while the mutex is held.
+func (pa *PortAllocator) hold(uid types.UID, held *gameServerPorts, hp heldPort) {
+	pa.portAllocations[hp.index][hp.port] = true
+	pa.portHolders[hp] = uid
+	held.ports = append(held.ports, hp)
+}
+
+// release frees the ports held by the GameServer, and returns whether it held any.
+// Only call it while the mutex is held.
+func (pa *PortAllocator) release(uid types.UID) bool {
+	held, ok := pa.gameServerRegistry[uid]
+	if !ok {
 		return false
 	}
+	for _, hp := range held.ports {
+		if pa.portHolders[hp] == uid {
+			delete(pa.portHolders, hp)
+			pa.freePort(pa.portAllocations[hp.index], hp.port)
+		}
+	}
+	delete(pa.gameServerRegistry, uid)
+	return true
+}
 
-	if !found() {
-		pa.logger.WithField("gs", gs.ObjectMeta.Name).
-			Info("Did not allocate this GameServer. Ignoring for DeAllocation")
+// place moves the hold of the GameServer on the port to the port allocation at index i, freeing the
+// port allocation it was held in. If another GameServer held the port there, its hold is moved
+// to another port allocation, since the port is in use on the node of that port allocation.
+// Only call it while the mutex is held.
+func (pa *PortAllocator) place(uid types.UID, held *gameServerPorts, i int, port int32) {
+	target := heldPort{index: i, port: port}
+	if pa.portHolders[target] == uid {
 		return
 	}
 
-	pa.mutex.Lock()
-	defer pa.mutex.Unlock()
-	for _, p := range gs.Spec.Ports {
-		if p.HostPort < pa.minPort || p.HostPort > pa.maxPort {
-			continue
+	k := len(held.ports)
+	for j, hp := range held.ports {
+		if hp.port == port {
+			k = j
+			if pa.portHolders[hp] == uid {
+				delete(pa.portHolders, hp)
+				pa.freePort(pa.portAllocations[hp.index], hp.port)
+			}
+			break
 		}
-		pa.portAllocations = setPortAllocation(p.HostPort, pa.portAllocations, false)
 	}
+	if k == len(held.ports) {
+		held.ports = append(held.ports, target)
+	}
+	held.ports[k] = target
 
-	delete(pa.gameServerRegistry, gs.ObjectMeta.UID)
+	if other, ok := pa.portHolders[target]; ok {
+		pa.relocate(other, target)
+	}
+	pa.portAllocations[i][port] = true
+	pa.portHolders[target] = uid
+}
+
+// relocate moves the hold of a GameServer on a port to another port allocation where the port is free,
+// adding a port allocation if there is none. Only call it while the mutex is held.
+func (pa *PortAllocator) relocate(uid types.UID, from heldPort) {
+	to := heldPort{index: -1, port: from.port}
+	for i, np := range pa.portAllocations {
+		if taken, ok := np[from.port]; ok && !taken && i != from.index {
+			to.index = i
+			break
+		}
+	}
+	if to.index < 0 {
+		pa.portAllocations = append(pa.portAllocations, pa.newPortAllocation())
+		to.index = len(pa.portAllocations) - 1
+	}
+
+	delete(pa.portHolders, from)
+	pa.portAllocations[to.index][to.port] = true
+	pa.portHolders[to] = uid
+	if held, ok := pa.gameServerRegistry[uid]; ok {
+		for j, hp := range held.ports {
+			if hp == from {
+				held.ports[j] = to
+			}
+		}
+	}
+}
+
+// freePort marks the port as free in the port allocation, or removes it
+// if it is no longer in the port range. Only call it while the mutex is held.
+func (pa *PortAllocator) freePort(np portAllocation, port int32) {
+	if pa.currentPortRange().Contains(port) {
+		np[port] = false
+		return
+	}
+	delete(np, port)
 }
 
 // syncDeleteGameServer when a GameServer Pod is deleted
@@ -215,16 +439,196 @@ func (pa *PortAllocator) syncDeleteGameServer(object interface{}) {
 	}
 }
 
+// syncScheduledGameServer moves the ports of a GameServer that was just scheduled to the
+// port allocation of its node, so that they are freed from the port allocation they were held in
+func (pa *PortAllocator) syncScheduledGameServer(gs *agonesv1.GameServer) {
+	pa.mutex.Lock()
+	defer pa.mutex.Unlock()
+
+	held, ok := pa.gameServerRegistry[gs.ObjectMeta.UID]
+	if !pa.synced || !ok {
+		return
+	}
+	i, ok := pa.nodeIndex[gs.Status.NodeName]
+	if !ok {
+		return
+	}
+
+	for _, hp := range append([]heldPort{}, held.ports...) {
+		pa.place(gs.ObjectMeta.UID, held, i, hp.port)
+	}
+	// Static ports within the range are not allocated, so they are only held once scheduled
+	for _, p := range pa.trackedPorts(gs) {
+		pa.place(gs.ObjectMeta.UID, held, i, p)
+	}
+}
+
+// syncNode adds a port allocation for a schedulable node that doesn't have one yet.
+// A port allocation that was added for GameServers that could not be placed on the
+// existing nodes is used if there is one, as they are likely to be scheduled on the new node.
+func (pa *PortAllocator) syncNode(object interface{}) {
+	node, ok := object.(*corev1.Node)
+	if !ok || node.Spec.Unschedulable {
+		return
+	}
+
+	pa.mutex.Lock()
+	defer pa.mutex.Unlock()
+
+	if !pa.synced {
+		return
+	}
+	if _, ok := pa.nodeIndex[node.ObjectMeta.Name]; ok {
+		return
+	}
+
+	indexed := make(map[int]bool, len(pa.nodeIndex))
+	for _, i := range pa.nodeIndex {
+		indexed[i] = true
+	}
+	for i := range pa.portAllocations {
+		if !indexed[i] {
+			pa.nodeIndex[node.ObjectMeta.Name] = i
+			return
+		}
+	}
+
+	pa.portAllocations = append(pa.portAllocations, pa.newPortAllocation())
+	pa.nodeIndex[node.ObjectMeta.Name] = len(pa.portAllocations) - 1
+}
+
+// syncDeleteNode removes a deleted node from the node index. Its port allocation is kept,
+// as its ports are only freed once its GameServers are deleted.
+func (pa *PortAllocator) syncDeleteNode(object interface{}) {
+	node, ok := object.(*corev1.Node)
+	if !ok {
+		return
+	}
+
+	pa.mutex.Lock()
+	defer pa.mutex.Unlock()
+	delete(pa.nodeIndex, node.ObjectMeta.Name)
+}
+
+// checkConsistency checks the port allocations against the GameServers in the informer cache:
+// the ports of the scheduled GameServers must be held in the port allocation of their node, since
+// a port that is in use must never be allocated again, and the ports of GameServers that no longer exist
+// must be freed. The check is done while holding the read lock, and the lock is only taken to fix
+// the inconsistencies that were found. Also records the port utilization of the nodes.
+func (pa *PortAllocator) checkConsistency() {
+	gameservers, err := pa.gameServerLister.List(labels.Everything())
+	if err != nil {
+		pa.logger.WithError(err).Warn("error listing GameServers to check port allocations")
+		return
+	}
+
+	missing, deleted := pa.findInconsistencies(gameservers)
+	if len(missing) == 0 && len(deleted) == 0 {
+		return
+	}
+
+	pa.mutex.Lock()
+	defer pa.mutex.Unlock()
+
+	for _, gs := range missing {
+		i, ok := pa.nodeIndex[gs.Status.NodeName]
+		if !ok {
+			continue
+		}
+		held := pa.register(gs)
+		for _, p := range pa.trackedPorts(gs) {
+			if pa.portHolders[heldPort{index: i, port: p}] != gs.ObjectMeta.UID {
+				pa.logger.WithField("gs", gs.ObjectMeta.Name).WithField("node", gs.Status.NodeName).WithField("port", p).
+					Warn("Port of GameServer was not allocated on its node. Allocating")
+				pa.place(gs.ObjectMeta.UID, held, i, p)
+				stats.Record(context.Background(), portAllocatorInconsistenciesStats.M(1))
+			}
+		}
+	}
+
+	for _, uid := range deleted {
+		held, ok := pa.gameServerRegistry[uid]
+		// the GameServer may have been allocated ports since it was checked, so make sure it doesn't exist
+		if !ok || pa.exists(uid, held) {
+			continue
+		}
+		pa.logger.WithField("gs", held.namespace+"/"+held.name).Warn("GameServer no longer exists. Freeing its ports")
+		pa.release(uid)
+		stats.Record(context.Background(), portAllocatorInconsistenciesStats.M(int64(len(held.ports))))
+	}
+}
+
+// findInconsistencies returns the scheduled GameServers that don't hold all of their ports in the port allocation of their
+// node, and the GameServers that hold ports but no longer exist. Also records the port utilization of the nodes.
+func (pa *PortAllocator) findInconsistencies(gameservers []*agonesv1.GameServer) ([]*agonesv1.GameServer, []types.UID) {
+	pa.mutex.RLock()
+	defer pa.mutex.RUnlock()
+
+	var missing []*agonesv1.GameServer
+	existing := make(map[types.UID]bool, len(gameservers))
+	for _, gs := range gameservers {
+		existing[gs.ObjectMeta.UID] = true
+		i, ok := pa.nodeIndex[gs.Status.NodeName]
+		if !ok || gs.IsBeingDeleted() {
+			continue
+		}
+		for _, p := range pa.trackedPorts(gs) {
+			if pa.portHolders[heldPort{index: i, port: p}] != gs.ObjectMeta.UID {
+				missing = append(missing, gs)
+				break
+			}
+		}
+	}
+
+	var deleted []types.UID
+	for uid, held := range pa.gameServerRegistry {
+		if !existing[uid] && !pa.exists(uid, held) {
+			deleted = append(deleted, uid)
+		}
+	}
+
+	size := float64(pa.maxPort - pa.minPort + 1)
+	for _, i := range pa.nodeIndex {
+		taken := 0
+		for _, t := range pa.portAllocations[i] {
+			if t {
+				taken++
+			}
+		}
+		stats.Record(context.Background(), nodePortUtilizationStats.M(float64(taken)/size))
+	}
+
+	return missing, deleted
+}
+
+// exists returns whether the GameServer that holds the ports is in the informer cache
+func (pa *PortAllocator) exists(uid types.UID, held *gameServerPorts) bool {
+	gs, err := pa.gameServerLister.GameServers(held.namespace).Get(held.name)
+	return err == nil && gs.ObjectMeta.UID == uid
+}
+
+// trackedPorts returns the host ports of the GameServer that are tracked by the PortAllocator
+func (pa *PortAllocator) trackedPorts(gs *agonesv1.GameServer) []int32 {
+	var ports []int32
+	for _, p := range gs.Spec.Ports {
+		if p.HostPort < pa.minPort || p.HostPort > pa.maxPort {
+			continue
+		}
+		if p.PortPolicy == agonesv1.Dynamic || p.PortPolicy == agonesv1.Passthrough || p.PortPolicy == agonesv1.Static {
+			ports = append(ports, p.HostPort)
+		}
+	}
+	return ports
+}
+
 // syncAll syncs the pod, node and gameserver caches then
 // traverses all Nodes in the cluster and all looks at GameServers
 // and Terminating Pods values make sure those
 // portAllocations are marked as taken.
-// Locks the mutex while doing this.
-// This is basically a stop the world Garbage Collection on port allocations, but it only happens on startup.
+// The port allocations are built from the informer caches without holding the lock,
+// which is only locked to replace them, and this only happens on startup.
+// Once done, the port allocations are kept up to date incrementally.
 func (pa *PortAllocator) syncAll() error {
-	pa.mutex.Lock()
-	defer pa.mutex.Unlock()
-
 	pa.logger.Info("Resetting Port Allocation")
 
 	nodes, err := pa.nodeLister.List(labels.Everything())
@@ -237,50 +641,84 @@ func (pa *PortAllocator) syncAll() error {
 		return errors.Wrapf(err, "error listing all GameServers")
 	}
 
-	gsRegistry := map[types.UID]bool{}
+	gsRegistry := map[types.UID]*gameServerPorts{}
+	holders := map[heldPort]types.UID{}
+
+	pa.mutex.RLock()
+	minPort, maxPort := pa.minPort, pa.maxPort
+	pa.mutex.RUnlock()
 
 	// place to put GameServer port allocations that are not ready yet/after the ready state
-	allocations, nonReadyNodesPorts := pa.registerExistingGameServerPorts(gameservers, nodes, gsRegistry)
+	allocations, nodeIndex, nonReadyNodesPorts := registerExistingGameServerPorts(minPort, maxPort, gameservers, nodes, gsRegistry, holders)
 
 	// close off the port on the first node you find
 	// we actually don't mind what node it is, since we only care
 	// that there is a port open *somewhere* as the default scheduler
 	// will re-route for us based on HostPort allocation
-	for _, p := range nonReadyNodesPorts {
-		allocations = setPortAllocation(p, allocations, true)
+	for _, hp := range nonReadyNodesPorts {
+		var i int
+		allocations, i = takePortAllocation(hp.port, allocations, minPort, maxPort)
+		held := heldPort{index: i, port: hp.port}
+		holders[held] = hp.uid
+		gsRegistry[hp.uid].ports = append(gsRegistry[hp.uid].ports, held)
 	}
 
+	pa.mutex.Lock()
+	defer pa.mutex.Unlock()
+
+	// the port range may have changed while the port allocations were built
+	if r := pa.currentPortRange(); r.MinPort != minPort || r.MaxPort != maxPort {
+		for _, np := range allocations {
+			resizePortAllocation(np, r)
+		}
+	}
 	pa.portAllocations = allocations
+	pa.nodeIndex = nodeIndex
 	pa.gameServerRegistry = gsRegistry
+	pa.portHolders = holders
+	pa.synced = true
 
 	return nil
 }
 
-// registerExistingGameServerPorts registers the gameservers against gsRegistry and the ports against nodePorts.
-// and returns an ordered list of portAllocations per cluster nodes, and an array of
-// any GameServers allocated a port, but not yet assigned a Node will returned as an array of port values.
-func (pa *PortAllocator) registerExistingGameServerPorts(gameservers []*agonesv1.GameServer, nodes []*corev1.Node, gsRegistry map[types.UID]bool) ([]portAllocation, []int32) {
+// unscheduledPort is a port of a GameServer that is not scheduled on a node yet
+type unscheduledPort struct {
+	uid  types.UID
+	port int32
+}
+
+// registerExistingGameServerPorts registers the gameservers against gsRegistry and the ports they hold against holders,
+// and returns an ordered list of portAllocations per cluster nodes, the index of the node of each of them, and
+// the ports of any GameServers allocated a port, but not yet assigned a Node, which are not registered yet.
+func registerExistingGameServerPorts(minPort, maxPort int32, gameservers []*agonesv1.GameServer, nodes []*corev1.Node,
+	gsRegistry map[types.UID]*gameServerPorts, holders map[heldPort]types.UID) ([]portAllocation, map[string]int, []unscheduledPort) {
 	// setup blank port values
-	nodePortAllocation := pa.nodePortAllocation(nodes)
+	nodePortAllocation := nodePortAllocation(minPort, maxPort, nodes)
 	nodePortCount := make(map[string]int64, len(nodes))
 	for _, n := range nodes {
 		nodePortCount[n.ObjectMeta.Name] = 0
 	}
 
-	var nonReadyNodesPorts []int32
+	var nonReadyNodesPorts []unscheduledPort
+	scheduledPorts := map[string][]unscheduledPort{}
 
 	for _, gs := range gameservers {
 		for _, p := range gs.Spec.Ports {
-			if p.PortPolicy == agonesv1.Dynamic || p.PortPolicy == agonesv1.Passthrough {
-				gsRegistry[gs.ObjectMeta.UID] = true
+			// Static ports only take a port that could be allocated if they are within the range
+			static := p.PortPolicy == agonesv1.Static && p.HostPort >= minPort && p.HostPort <= maxPort
+			if p.PortPolicy == agonesv1.Dynamic || p.PortPolicy == agonesv1.Passthrough || static {
+				if _, ok := gsRegistry[gs.ObjectMeta.UID]; !ok {
+					gsRegistry[gs.ObjectMeta.UID] = &gameServerPorts{namespace: gs.ObjectMeta.Namespace, name: gs.ObjectMeta.Name}
+				}
 
 				// if the node doesn't exist, it's likely unscheduled
 				_, ok := nodePortAllocation[gs.Status.NodeName]
 				if gs.Status.NodeName != "" && ok {
 					nodePortAllocation[gs.Status.NodeName][p.HostPort] = true
 					nodePortCount[gs.Status.NodeName]++
+					scheduledPorts[gs.Status.NodeName] = append(scheduledPorts[gs.Status.NodeName], unscheduledPort{uid: gs.ObjectMeta.UID, port: p.HostPort})
 				} else if p.HostPort != 0 {
-					nonReadyNodesPorts = append(nonReadyNodesPorts, p.HostPort)
+					nonReadyNodesPorts = append(nonReadyNodesPorts, unscheduledPort{uid: gs.ObjectMeta.UID, port: p.HostPort})
 				}
 			}
 		}
@@ -300,45 +738,60 @@ func (pa *PortAllocator) registerExistingGameServerPorts(gameservers []*agonesv1
 
 	// this gives us back an ordered node list
 	allocations := make([]portAllocation, len(nodePortAllocation))
+	nodeIndex := make(map[string]int, len(nodePortAllocation))
 	for i, k := range keys {
 		allocations[i] = nodePortAllocation[k]
-
+		nodeIndex[k] = i
+		for _, p := range scheduledPorts[k] {
+			held := heldPort{index: i, port: p.port}
+			holders[held] = p.uid
+			gsRegistry[p.uid].ports = append(gsRegistry[p.uid].ports, held)
+		}
 	}
 
-	return allocations, nonReadyNodesPorts
+	return allocations, nodeIndex, nonReadyNodesPorts
 }
 
 // nodePortAllocation returns a map of port allocations all set to being available
 // with a map key for each node, as well as the node registry record (since we're already looping)
-func (pa *PortAllocator) nodePortAllocation(nodes []*corev1.Node) map[string]portAllocation {
+func nodePortAllocation(minPort, maxPort int32, nodes []*corev1.Node) map[string]portAllocation {
 	nodePorts := map[string]portAllocation{}
 
 	for _, n := range nodes {
 		// ignore unschedulable nodes
 		if !n.Spec.Unschedulable {
-			nodePorts[n.Name] = pa.newPortAllocation()
+			nodePorts[n.Name] = newPortAllocation(minPort, maxPort)
 		}
 	}
 
 	return nodePorts
 }
 
+// newPortAllocation returns a port allocation of the current port range, with all ports available.
+// Only call it while the mutex is held.
 func (pa *PortAllocator) newPortAllocation() portAllocation {
-	p := make(portAllocation, (pa.maxPort-pa.minPort)+1)
-	for i := pa.minPort; i <= pa.maxPort; i++ {
+	return newPortAllocation(pa.minPort, pa.maxPort)
+}
+
+func newPortAllocation(minPort, maxPort int32) portAllocation {
+	p := make(portAllocation, (maxPort-minPort)+1)
+	for i := minPort; i <= maxPort; i++ {
 		p[i] = false
 	}
 
 	return p
 }
 
-// setPortAllocation takes a port from an all
-func setPortAllocation(port int32, allocations []portAllocation, taken bool) []portAllocation {
-	for _, np := range allocations {
-		if np[port] != taken {
-			np[port] = taken
-			break
+// takePortAllocation takes the port in the first port allocation it is free in, adding a port allocation
+// if there is none, and returns the port allocations and the index of the one the port was taken in
+func takePortAllocation(port int32, allocations []portAllocation, minPort, maxPort int32) ([]portAllocation, int) {
+	for i, np := range allocations {
+		if !np[port] {
+			np[port] = true
+			return allocations, i
 		}
 	}
-	return allocations
+	np := newPortAllocation(minPort, maxPort)
+	np[port] = true
+	return append(allocations, np), len(allocations)
 }
@@ -19,6 +19,7 @@ import (
 	"strconv"
 	"sync"
 	"testing"
+	"time"
 
 	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
 	agtesting "agones.dev/agones/pkg/testing"
@@ -26,8 +27,10 @@ import (
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/apimachinery/pkg/watch"
 	k8stesting "k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/cache"
@@ -39,6 +42,21 @@ var (
 	n3 = corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node3", UID: "node3"}}
 )
 
+func TestPortAllocatorHasSynced(t *testing.T) {
+	t.Parallel()
+
+	m := agtesting.NewMocks()
+	pa := NewPortAllocator(10, 50, m.KubeInformerFactory, m.AgonesInformerFactory)
+	assert.False(t, pa.HasSynced())
+
+	stop, cancel := agtesting.StartInformers(m, pa.gameServerSynced, pa.nodeSynced)
+	defer cancel()
+
+	err := pa.Run(stop)
+	assert.Nil(t, err)
+	assert.True(t, pa.HasSynced())
+}
+
 func TestPortAllocatorAllocate(t *testing.T) {
 	t.Parallel()
 	fixture := dynamicGameServerFixture()
@@ -222,6 +240,41 @@ func TestPortAllocatorAllocate(t *testing.T) {
 			ports = append(ports, gs.Spec.Ports[0].HostPort)
 		}
 	})
+
+	t.Run("ports are within the GameServer port range", func(t *testing.T) {
+		m := agtesting.NewMocks()
+		pa := NewPortAllocator(10, 50, m.KubeInformerFactory, m.AgonesInformerFactory)
+
+		m.KubeClient.AddReactor("list", "nodes", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			nl := &corev1.NodeList{Items: []corev1.Node{n1}}
+			return true, nl, nil
+		})
+		_, cancel := agtesting.StartInformers(m, pa.nodeSynced)
+		defer cancel()
+		err := pa.syncAll()
+		assert.Nil(t, err)
+
+		rangeFixture := fixture.DeepCopy()
+		rangeFixture.Spec.PortRange = &agonesv1.PortRange{MinPort: 20, MaxPort: 22}
+
+		// fill the range on the node, and one more which needs another node
+		for i := 0; i < 4; i++ {
+			gs := pa.Allocate(rangeFixture.DeepCopy())
+			assert.True(t, 20 <= gs.Spec.Ports[0].HostPort && gs.Spec.Ports[0].HostPort <= 22, "%v is not between 20 and 22", gs.Spec.Ports[0].HostPort)
+		}
+		assert.Len(t, pa.portAllocations, 2)
+		assert.Equal(t, 4, countTotalAllocatedPorts(pa))
+
+		// ports outside of the range are still available to other GameServers
+		gs := pa.Allocate(fixture.DeepCopy())
+		assert.NotEmpty(t, gs.Spec.Ports[0].HostPort)
+		assert.Len(t, pa.portAllocations, 2)
+
+		// a range outside of the allocatable ports is ignored
+		rangeFixture.Spec.PortRange = &agonesv1.PortRange{MinPort: 40, MaxPort: 60}
+		gs = pa.Allocate(rangeFixture.DeepCopy())
+		assert.True(t, 10 <= gs.Spec.Ports[0].HostPort && gs.Spec.Ports[0].HostPort <= 50, "%v is not between 10 and 50", gs.Spec.Ports[0].HostPort)
+	})
 }
 
 func TestPortAllocatorMultithreadAllocate(t *testing.T) {
@@ -353,18 +406,18 @@ func TestPortAllocatorSyncPortAllocations(t *testing.T) {
 	assert.Nil(t, err)
 
 	assert.Len(t, pa.portAllocations, 3)
-	assert.Len(t, pa.gameServerRegistry, 5)
+	assert.Len(t, pa.gameServerRegistry, 6)
 
-	// count the number of allocated ports,
+	// count the number of allocated ports, including the Static port within the range
 	assert.Equal(t, 2, countAllocatedPorts(pa, 10))
 	assert.Equal(t, 1, countAllocatedPorts(pa, 11))
-	assert.Equal(t, 2, countAllocatedPorts(pa, 12))
+	assert.Equal(t, 3, countAllocatedPorts(pa, 12))
 
 	count := 0
 	for i := int32(10); i <= 20; i++ {
 		count += countAllocatedPorts(pa, i)
 	}
-	assert.Equal(t, 5, count)
+	assert.Equal(t, 6, count)
 }
 
 func TestPortAllocatorSyncDeleteGameServer(t *testing.T) {
@@ -439,6 +492,208 @@ func TestPortAllocatorSyncDeleteGameServer(t *testing.T) {
 	pa.mutex.RUnlock()
 }
 
+func TestPortAllocatorSyncScheduledGameServer(t *testing.T) {
+	t.Parallel()
+
+	m := agtesting.NewMocks()
+	pa := NewPortAllocator(10, 20, m.KubeInformerFactory, m.AgonesInformerFactory)
+	m.KubeClient.AddReactor("list", "nodes", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, &corev1.NodeList{Items: []corev1.Node{n1, n2}}, nil
+	})
+
+	_, cancel := agtesting.StartInformers(m, pa.gameServerSynced, pa.nodeSynced)
+	defer cancel()
+
+	assert.Nil(t, pa.syncAll())
+
+	gs := pa.Allocate(dynamicGameServerFixture())
+	port := gs.Spec.Ports[0].HostPort
+
+	// ports are allocated from the first port allocation, so schedule it on the node of the other one
+	pa.mutex.RLock()
+	assert.True(t, pa.portAllocations[0][port])
+	node := n1.ObjectMeta.Name
+	if pa.nodeIndex[node] == 0 {
+		node = n2.ObjectMeta.Name
+	}
+	pa.mutex.RUnlock()
+
+	scheduled := gs.DeepCopy()
+	scheduled.Status.NodeName = node
+	pa.syncScheduledGameServer(scheduled)
+
+	pa.mutex.RLock()
+	assert.Equal(t, 1, countAllocatedPorts(pa, port))
+	assert.False(t, pa.portAllocations[0][port])
+	assert.True(t, pa.portAllocations[1][port])
+	pa.mutex.RUnlock()
+
+	// freed on its node when deleted
+	pa.DeAllocate(scheduled)
+	pa.mutex.RLock()
+	assert.Equal(t, 0, countTotalAllocatedPorts(pa))
+	pa.mutex.RUnlock()
+
+	// a port held on the node of the GameServer by a GameServer that is not scheduled yet is moved
+	// to another port allocation, and the port allocation the GameServer held it in is freed
+	gs1 := dynamicGameServerFixture()
+	gs1.ObjectMeta.UID = "1"
+	gs1 = pa.Allocate(gs1)
+	port = gs1.Spec.Ports[0].HostPort
+	pa.mutex.Lock()
+	// hold the same port in the other port allocation
+	gs2 := dynamicGameServerFixture()
+	gs2.ObjectMeta.UID = "2"
+	gs2.Spec.Ports[0].HostPort = port
+	pa.hold(gs2.ObjectMeta.UID, pa.register(gs2), heldPort{index: 1, port: port})
+	pa.mutex.Unlock()
+
+	scheduled = gs1.DeepCopy()
+	scheduled.Status.NodeName = node
+	pa.syncScheduledGameServer(scheduled)
+
+	pa.mutex.RLock()
+	assert.Equal(t, 2, countAllocatedPorts(pa, port))
+	assert.Equal(t, []heldPort{{index: 1, port: port}}, pa.gameServerRegistry[gs1.ObjectMeta.UID].ports)
+	assert.Equal(t, []heldPort{{index: 0, port: port}}, pa.gameServerRegistry[gs2.ObjectMeta.UID].ports)
+	pa.mutex.RUnlock()
+
+	// each GameServer frees the port allocation it holds the port in
+	pa.DeAllocate(gs2)
+	pa.mutex.RLock()
+	assert.False(t, pa.portAllocations[0][port])
+	assert.True(t, pa.portAllocations[1][port])
+	pa.mutex.RUnlock()
+	pa.DeAllocate(scheduled)
+	pa.mutex.RLock()
+	assert.Equal(t, 0, countTotalAllocatedPorts(pa))
+	pa.mutex.RUnlock()
+
+	// a GameServer that wasn't allocated ports is ignored
+	unknown := dynamicGameServerFixture()
+	unknown.ObjectMeta.UID = "unknown"
+	unknown.Spec.Ports[0].HostPort = 15
+	unknown.Status.NodeName = node
+	pa.syncScheduledGameServer(unknown)
+	pa.mutex.RLock()
+	assert.Equal(t, 0, countTotalAllocatedPorts(pa))
+	pa.mutex.RUnlock()
+}
+
+func TestPortAllocatorSyncNode(t *testing.T) {
+	t.Parallel()
+
+	m := agtesting.NewMocks()
+	pa := NewPortAllocator(10, 11, m.KubeInformerFactory, m.AgonesInformerFactory)
+	m.KubeClient.AddReactor("list", "nodes", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, &corev1.NodeList{Items: []corev1.Node{n1}}, nil
+	})
+
+	_, cancel := agtesting.StartInformers(m, pa.gameServerSynced, pa.nodeSynced)
+	defer cancel()
+
+	// ignored until synced
+	pa.syncNode(n2.DeepCopy())
+	assert.Empty(t, pa.nodeIndex)
+
+	assert.Nil(t, pa.syncAll())
+	assert.Len(t, pa.portAllocations, 1)
+
+	// no room on node1, so a port allocation is added for the GameServer
+	for i := 0; i < 3; i++ {
+		gs := dynamicGameServerFixture()
+		gs.ObjectMeta.UID = types.UID(fmt.Sprintf("gs-%d", i))
+		pa.Allocate(gs)
+	}
+	assert.Len(t, pa.portAllocations, 2)
+
+	// the new node takes it over
+	pa.syncNode(n2.DeepCopy())
+	assert.Len(t, pa.portAllocations, 2)
+	assert.Equal(t, 1, pa.nodeIndex[n2.ObjectMeta.Name])
+
+	// another new node gets a new port allocation, unless it is unschedulable
+	unschedulable := n3.DeepCopy()
+	unschedulable.Spec.Unschedulable = true
+	pa.syncNode(unschedulable)
+	assert.Len(t, pa.portAllocations, 2)
+
+	pa.syncNode(n3.DeepCopy())
+	assert.Len(t, pa.portAllocations, 3)
+	assert.Equal(t, 2, pa.nodeIndex[n3.ObjectMeta.Name])
+
+	// a known node is not added again
+	pa.syncNode(n3.DeepCopy())
+	assert.Len(t, pa.portAllocations, 3)
+
+	// a deleted node keeps its port allocation, until its GameServers are deleted
+	pa.syncDeleteNode(n2.DeepCopy())
+	assert.Len(t, pa.portAllocations, 3)
+	assert.Len(t, pa.nodeIndex, 2)
+	assert.Equal(t, 3, countTotalAllocatedPorts(pa))
+}
+
+func TestPortAllocatorCheckConsistency(t *testing.T) {
+	t.Parallel()
+
+	m := agtesting.NewMocks()
+	gsWatch := watch.NewFake()
+	m.AgonesClient.AddWatchReactor("gameservers", k8stesting.DefaultWatchReactor(gsWatch, nil))
+	m.KubeClient.AddReactor("list", "nodes", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, &corev1.NodeList{Items: []corev1.Node{n1, n2}}, nil
+	})
+	pa := NewPortAllocator(10, 20, m.KubeInformerFactory, m.AgonesInformerFactory)
+
+	stop, cancel := agtesting.StartInformers(m, pa.gameServerSynced, pa.nodeSynced)
+	defer cancel()
+
+	assert.Nil(t, pa.syncAll())
+	assert.Equal(t, 0, countTotalAllocatedPorts(pa))
+
+	// created by another controller, while this one was not watching
+	gs := &agonesv1.GameServer{ObjectMeta: metav1.ObjectMeta{Name: "gs1", UID: "1"},
+		Spec: agonesv1.GameServerSpec{
+			Ports: []agonesv1.GameServerPort{{PortPolicy: agonesv1.Dynamic, HostPort: 12}, {PortPolicy: agonesv1.Static, HostPort: 7777}},
+		},
+		Status: agonesv1.GameServerStatus{State: agonesv1.GameServerStateReady, NodeName: n2.ObjectMeta.Name}}
+	gsWatch.Add(gs)
+	assert.True(t, cache.WaitForCacheSync(stop, pa.gameServerSynced))
+	err := wait.PollImmediate(10*time.Millisecond, 5*time.Second, func() (bool, error) {
+		list, err := pa.gameServerLister.List(labels.Everything())
+		return len(list) == 1, err
+	})
+	assert.NoError(t, err)
+
+	pa.checkConsistency()
+
+	pa.mutex.RLock()
+	assert.Equal(t, 1, countTotalAllocatedPorts(pa))
+	assert.True(t, pa.portAllocations[pa.nodeIndex[n2.ObjectMeta.Name]][12])
+	assert.Equal(t, []heldPort{{index: pa.nodeIndex[n2.ObjectMeta.Name], port: 12}}, pa.gameServerRegistry[gs.ObjectMeta.UID].ports)
+	pa.mutex.RUnlock()
+
+	// the ports of a GameServer that no longer exists, such as one whose deletion was missed, are freed
+	deleted := dynamicGameServerFixture()
+	deleted.ObjectMeta.UID = "deleted"
+	deleted = pa.Allocate(deleted)
+	// another GameServer with the same name is not the one the ports were allocated to
+	renamed := gs.DeepCopy()
+	renamed.ObjectMeta.UID = "renamed"
+	renamed = pa.Allocate(renamed)
+	pa.mutex.RLock()
+	assert.Equal(t, 3, countTotalAllocatedPorts(pa))
+	pa.mutex.RUnlock()
+
+	pa.checkConsistency()
+
+	pa.mutex.RLock()
+	defer pa.mutex.RUnlock()
+	assert.Equal(t, 1, countTotalAllocatedPorts(pa))
+	assert.NotContains(t, pa.gameServerRegistry, deleted.ObjectMeta.UID)
+	assert.NotContains(t, pa.gameServerRegistry, renamed.ObjectMeta.UID)
+	assert.Contains(t, pa.gameServerRegistry, gs.ObjectMeta.UID)
+}
+
 func TestNodePortAllocation(t *testing.T) {
 	t.Parallel()
 
@@ -449,7 +704,7 @@ func TestNodePortAllocation(t *testing.T) {
 		nl := &corev1.NodeList{Items: nodes}
 		return true, nl, nil
 	})
-	result := pa.nodePortAllocation([]*corev1.Node{&n1, &n2, &n3})
+	result := nodePortAllocation(pa.minPort, pa.maxPort, []*corev1.Node{&n1, &n2, &n3})
 	assert.Len(t, result, 3)
 	for _, n := range nodes {
 		ports, ok := result[n.ObjectMeta.Name]
@@ -465,7 +720,8 @@ func TestTakePortAllocation(t *testing.T) {
 	t.Parallel()
 
 	fixture := []portAllocation{{1: false, 2: false}, {1: false, 2: false}, {1: false, 3: false}}
-	result := setPortAllocation(2, fixture, true)
+	result, i := takePortAllocation(2, fixture, 1, 3)
+	assert.Equal(t, 0, i)
 	assert.True(t, result[0][2])
 
 	for i, row := range fixture {
@@ -475,12 +731,19 @@ func TestTakePortAllocation(t *testing.T) {
 			}
 		}
 	}
+
+	result, i = takePortAllocation(2, result, 1, 3)
+	assert.Equal(t, 1, i)
+	assert.True(t, result[1][2])
+
+	// a port allocation is added when the port is taken in all of them
+	result, i = takePortAllocation(1, []portAllocation{{1: true}}, 1, 3)
+	assert.Equal(t, 1, i)
+	assert.Equal(t, []portAllocation{{1: true}, {1: true, 2: false, 3: false}}, result)
 }
 
-func TestPortAllocatorRegisterExistingGameServerPorts(t *testing.T) {
+func TestRegisterExistingGameServerPorts(t *testing.T) {
 	t.Parallel()
-	m := agtesting.NewMocks()
-	pa := NewPortAllocator(10, 13, m.KubeInformerFactory, m.AgonesInformerFactory)
 
 	gs1 := &agonesv1.GameServer{ObjectMeta: metav1.ObjectMeta{Name: "gs1", UID: "1"},
 		Spec: agonesv1.GameServerSpec{
@@ -506,12 +769,33 @@ func TestPortAllocatorRegisterExistingGameServerPorts(t *testing.T) {
 		},
 		Status: agonesv1.GameServerStatus{State: agonesv1.GameServerStatePortAllocation, Ports: []agonesv1.GameServerStatusPort{{Port: 13}}}}
 
-	allocations, nonReadyNodesPorts := pa.registerExistingGameServerPorts([]*agonesv1.GameServer{gs1, gs2, gs3, gs4}, []*corev1.Node{&n1, &n2, &n3}, map[types.UID]bool{})
+	// created before Static ports within the range were rejected
+	gs5 := &agonesv1.GameServer{ObjectMeta: metav1.ObjectMeta{Name: "gs5", UID: "5"},
+		Spec: agonesv1.GameServerSpec{
+			Ports: []agonesv1.GameServerPort{{PortPolicy: agonesv1.Static, HostPort: 11}, {PortPolicy: agonesv1.Static, HostPort: 7777}},
+		},
+		Status: agonesv1.GameServerStatus{State: agonesv1.GameServerStateReady, Ports: []agonesv1.GameServerStatusPort{{Port: 11}, {Port: 7777}}, NodeName: n1.ObjectMeta.Name}}
 
-	assert.Equal(t, []int32{13}, nonReadyNodesPorts)
-	assert.Equal(t, portAllocation{10: true, 11: false, 12: true, 13: false}, allocations[0])
+	gs6 := &agonesv1.GameServer{ObjectMeta: metav1.ObjectMeta{Name: "gs6", UID: "6"},
+		Spec: agonesv1.GameServerSpec{
+			Ports: []agonesv1.GameServerPort{{PortPolicy: agonesv1.Static, HostPort: 7777}},
+		},
+		Status: agonesv1.GameServerStatus{State: agonesv1.GameServerStateReady, Ports: []agonesv1.GameServerStatusPort{{Port: 7777}}, NodeName: n2.ObjectMeta.Name}}
+
+	gsRegistry := map[types.UID]*gameServerPorts{}
+	holders := map[heldPort]types.UID{}
+	allocations, nodeIndex, nonReadyNodesPorts := registerExistingGameServerPorts(10, 13, []*agonesv1.GameServer{gs1, gs2, gs3, gs4, gs5, gs6}, []*corev1.Node{&n1, &n2, &n3}, gsRegistry, holders)
+
+	assert.Equal(t, []unscheduledPort{{uid: gs4.ObjectMeta.UID, port: 13}}, nonReadyNodesPorts)
+	assert.Equal(t, []heldPort{{index: 0, port: 11}}, gsRegistry[gs5.ObjectMeta.UID].ports)
+	assert.NotContains(t, gsRegistry, gs6.ObjectMeta.UID)
+	assert.Equal(t, gs2.ObjectMeta.UID, holders[heldPort{index: 1, port: 11}])
+	assert.Equal(t, gs5.ObjectMeta.UID, holders[heldPort{index: 0, port: 11}])
+	assert.Len(t, allocations[0], 4)
+	assert.Equal(t, portAllocation{10: true, 11: true, 12: true, 13: false}, allocations[0])
 	assert.Equal(t, portAllocation{10: false, 11: true, 12: false, 13: false}, allocations[1])
 	assert.Equal(t, portAllocation{10: false, 11: false, 12: false, 13: false}, allocations[2])
+	assert.Equal(t, map[string]int{n1.ObjectMeta.Name: 0, n2.ObjectMeta.Name: 1, n3.ObjectMeta.Name: 2}, nodeIndex)
 }
 
 func dynamicGameServerFixture() *agonesv1.GameServer {
@@ -546,3 +830,64 @@ func countTotalAllocatedPorts(pa *PortAllocator) int {
 	}
 	return count
 }
+
+func TestPortAllocatorSetPortRange(t *testing.T) {
+	t.Parallel()
+
+	fixture := dynamicGameServerFixture()
+	m := agtesting.NewMocks()
+	gsWatch := watch.NewFake()
+	m.AgonesClient.AddWatchReactor("gameservers", k8stesting.DefaultWatchReactor(gsWatch, nil))
+	pa := NewPortAllocator(10, 11, m.KubeInformerFactory, m.AgonesInformerFactory)
+	m.KubeClient.AddReactor("list", "nodes", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, &corev1.NodeList{Items: []corev1.Node{n1}}, nil
+	})
+	_, cancel := agtesting.StartInformers(m, pa.gameServerSynced, pa.nodeSynced)
+	defer cancel()
+	assert.NoError(t, pa.syncAll())
+
+	// the GameServers exist, so that their ports are kept when the ports are checked
+	allocate := func(uid types.UID) *agonesv1.GameServer {
+		gs := fixture.DeepCopy()
+		gs.ObjectMeta.Name = string(uid)
+		gs.ObjectMeta.UID = uid
+		gs.Status.NodeName = n1.ObjectMeta.Name
+		gsWatch.Add(gs.DeepCopy())
+		err := wait.PollImmediate(10*time.Millisecond, 5*time.Second, func() (bool, error) {
+			_, err := pa.gameServerLister.GameServers(gs.ObjectMeta.Namespace).Get(gs.ObjectMeta.Name)
+			return err == nil, nil
+		})
+		assert.NoError(t, err)
+		return pa.Allocate(gs)
+	}
+	gs1 := allocate("1")
+	gs2 := allocate("2")
+	assert.ElementsMatch(t, []int32{10, 11}, []int32{gs1.Spec.Ports[0].HostPort, gs2.Spec.Ports[0].HostPort})
+	assert.Len(t, pa.portAllocations, 1)
+
+	assert.Error(t, pa.SetPortRange(agonesv1.PortRange{MinPort: 0, MaxPort: 10}))
+	assert.Error(t, pa.SetPortRange(agonesv1.PortRange{MinPort: 12, MaxPort: 11}))
+	assert.Equal(t, agonesv1.PortRange{MinPort: 10, MaxPort: 11}, pa.PortRange())
+
+	// port 10 is removed from the range, but stays taken while it is in use
+	assert.NoError(t, pa.SetPortRange(agonesv1.PortRange{MinPort: 11, MaxPort: 12}))
+	assert.Equal(t, agonesv1.PortRange{MinPort: 11, MaxPort: 12}, pa.PortRange())
+	assert.Equal(t, portAllocation{10: true, 11: true, 12: false}, pa.portAllocations[0])
+
+	gs3 := allocate("3")
+	assert.Equal(t, int32(12), gs3.Spec.Ports[0].HostPort)
+	assert.Len(t, pa.portAllocations, 1)
+
+	// once free, a port outside of the range is forgotten, and one inside it can be allocated again
+	gs := gs1
+	if gs2.Spec.Ports[0].HostPort == 10 {
+		gs = gs2
+	}
+	pa.DeAllocate(gs)
+	assert.Equal(t, portAllocation{11: true, 12: true}, pa.portAllocations[0])
+	pa.DeAllocate(gs3)
+	assert.Equal(t, portAllocation{11: true, 12: false}, pa.portAllocations[0])
+
+	// new port allocations only have the ports of the range
+	assert.Equal(t, portAllocation{11: false, 12: false}, pa.newPortAllocation())
+}
@@ -0,0 +1,126 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gameservers
+
+import (
+	"strconv"
+
+	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
+	"agones.dev/agones/pkg/util/runtime"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+)
+
+const (
+	// PortRangeMinPortKey is the key of the minimum port in a port range ConfigMap
+	PortRangeMinPortKey = "minPort"
+	// PortRangeMaxPortKey is the key of the maximum port in a port range ConfigMap
+	PortRangeMaxPortKey = "maxPort"
+)
+
+// ParsePortRange returns the port range of a ConfigMap, which must have
+// both the minPort and maxPort keys
+func ParsePortRange(cm *corev1.ConfigMap) (agonesv1.PortRange, error) {
+	var r agonesv1.PortRange
+	for key, port := range map[string]*int32{PortRangeMinPortKey: &r.MinPort, PortRangeMaxPortKey: &r.MaxPort} {
+		v, ok := cm.Data[key]
+		if !ok {
+			return r, errors.Errorf("ConfigMap %s is missing the %s key", cm.ObjectMeta.Name, key)
+		}
+		p, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			return r, errors.Wrapf(err, "invalid %s in ConfigMap %s", key, cm.ObjectMeta.Name)
+		}
+		*port = int32(p)
+	}
+	if r.MinPort <= 0 || r.MaxPort < r.MinPort {
+		return r, errors.Errorf("invalid port range %d-%d in ConfigMap %s", r.MinPort, r.MaxPort, cm.ObjectMeta.Name)
+	}
+	return r, nil
+}
+
+// PortRangeWatcher watches a ConfigMap with a port range, and changes the range of
+// ports the GameServer controller allocates from whenever it is updated,
+// so that the range can be expanded or contracted without restarting the controller
+type PortRangeWatcher struct {
+	logger          *logrus.Entry
+	name            string
+	portAllocator   *PortAllocator
+	informerFactory informers.SharedInformerFactory
+	configMapSynced cache.InformerSynced
+}
+
+// NewPortRangeWatcher returns a PortRangeWatcher of the ConfigMap with the name in the namespace,
+// which only watches that ConfigMap, so it only needs permissions for the ConfigMaps of the namespace
+func NewPortRangeWatcher(name, namespace string, gsController *Controller, kubeClient kubernetes.Interface) *PortRangeWatcher {
+	informerFactory := informers.NewSharedInformerFactoryWithOptions(kubeClient, 0, informers.WithNamespace(namespace),
+		informers.WithTweakListOptions(func(opts *metav1.ListOptions) {
+			opts.FieldSelector = fields.OneTermEqualSelector("metadata.name", name).String()
+		}))
+	configMaps := informerFactory.Core().V1().ConfigMaps().Informer()
+
+	w := &PortRangeWatcher{
+		name:            name,
+		portAllocator:   gsController.portAllocator,
+		informerFactory: informerFactory,
+		configMapSynced: configMaps.HasSynced,
+	}
+	w.logger = runtime.NewLoggerWithType(w)
+
+	configMaps.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: w.syncConfigMap,
+		UpdateFunc: func(_, newObj interface{}) {
+			w.syncConfigMap(newObj)
+		},
+		DeleteFunc: func(_ interface{}) {
+			w.logger.WithField("configMap", w.name).Warn("Port range ConfigMap was deleted. Keeping the current port range")
+		},
+	})
+
+	return w
+}
+
+// Run watches the ConfigMap until stop is closed
+func (w *PortRangeWatcher) Run(_ int, stop <-chan struct{}) error {
+	w.informerFactory.Start(stop)
+	if !cache.WaitForCacheSync(stop, w.configMapSynced) {
+		return errors.New("failed to wait for caches to sync")
+	}
+	<-stop
+	return nil
+}
+
+// syncConfigMap sets the port range of the ConfigMap. An invalid port range is logged
+// and ignored, so the ports keep being allocated from the current range.
+func (w *PortRangeWatcher) syncConfigMap(obj interface{}) {
+	cm, ok := obj.(*corev1.ConfigMap)
+	if !ok || cm.ObjectMeta.Name != w.name {
+		return
+	}
+
+	r, err := ParsePortRange(cm)
+	if err == nil {
+		err = w.portAllocator.SetPortRange(r)
+	}
+	if err != nil {
+		runtime.HandleError(w.logger.WithField("configMap", w.name), err)
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gameservers
+
+import (
+	"testing"
+	"time"
+
+	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apimachinery/pkg/watch"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestParsePortRange(t *testing.T) {
+	t.Parallel()
+
+	fixtures := map[string]struct {
+		data     map[string]string
+		expected agonesv1.PortRange
+		err      bool
+	}{
+		"valid":           {data: map[string]string{"minPort": "7000", "maxPort": "8000"}, expected: agonesv1.PortRange{MinPort: 7000, MaxPort: 8000}},
+		"single port":     {data: map[string]string{"minPort": "7000", "maxPort": "7000"}, expected: agonesv1.PortRange{MinPort: 7000, MaxPort: 7000}},
+		"missing max":     {data: map[string]string{"minPort": "7000"}, err: true},
+		"missing min":     {data: map[string]string{"maxPort": "8000"}, err: true},
+		"not a number":    {data: map[string]string{"minPort": "seven", "maxPort": "8000"}, err: true},
+		"zero":            {data: map[string]string{"minPort": "0", "maxPort": "8000"}, err: true},
+		"max below min":   {data: map[string]string{"minPort": "8000", "maxPort": "7000"}, err: true},
+		"out of int32":    {data: map[string]string{"minPort": "7000", "maxPort": "4294967296"}, err: true},
+		"no data at all?": {err: true},
+	}
+
+	for k, v := range fixtures {
+		t.Run(k, func(t *testing.T) {
+			cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "ports"}, Data: v.data}
+			r, err := ParsePortRange(cm)
+			if v.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, v.expected, r)
+		})
+	}
+}
+
+func TestPortRangeWatcher(t *testing.T) {
+	t.Parallel()
+
+	c, m := newFakeController()
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "ports", Namespace: "agones-system"},
+		Data:       map[string]string{"minPort": "10", "maxPort": "30"},
+	}
+	other := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "agones-system"},
+		Data:       map[string]string{"minPort": "1", "maxPort": "2"},
+	}
+	m.KubeClient.AddReactor("list", "configmaps", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, &corev1.ConfigMapList{Items: []corev1.ConfigMap{*cm.DeepCopy()}}, nil
+	})
+	cmWatch := watch.NewFake()
+	m.KubeClient.AddWatchReactor("configmaps", k8stesting.DefaultWatchReactor(cmWatch, nil))
+
+	w := NewPortRangeWatcher(cm.ObjectMeta.Name, cm.ObjectMeta.Namespace, c, m.KubeClient)
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		assert.NoError(t, w.Run(1, stop))
+	}()
+
+	waitForRange := func(expected agonesv1.PortRange) {
+		err := wait.PollImmediate(10*time.Millisecond, 5*time.Second, func() (bool, error) {
+			return c.PortRange() == expected, nil
+		})
+		assert.NoError(t, err, "expected port range %v, got %v", expected, c.PortRange())
+	}
+	waitForRange(agonesv1.PortRange{MinPort: 10, MaxPort: 30})
+
+	cm.Data["maxPort"] = "40"
+	cmWatch.Modify(cm.DeepCopy())
+	waitForRange(agonesv1.PortRange{MinPort: 10, MaxPort: 40})
+
+	// other ConfigMaps and invalid port ranges are ignored
+	cmWatch.Add(other)
+	cm.Data["maxPort"] = "5"
+	cmWatch.Modify(cm.DeepCopy())
+	cm.Data["minPort"] = "20"
+	cm.Data["maxPort"] = "50"
+	cmWatch.Modify(cm.DeepCopy())
+	waitForRange(agonesv1.PortRange{MinPort: 20, MaxPort: 50})
+
+	// the port range is kept when the ConfigMap is deleted
+	cmWatch.Delete(cm.DeepCopy())
+	cm.Data["maxPort"] = "60"
+	cmWatch.Add(cm.DeepCopy())
+	waitForRange(agonesv1.PortRange{MinPort: 20, MaxPort: 60})
+}
@@ -16,9 +16,13 @@ package gameserversets
 
 import (
 	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
 	"sync"
+	"time"
 
-	"agones.dev/agones/pkg/apis"
 	"agones.dev/agones/pkg/apis/agones"
 	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
 	"agones.dev/agones/pkg/client/clientset/versioned"
@@ -34,15 +38,22 @@ import (
 	"github.com/heptiolabs/healthcheck"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"golang.org/x/time/rate"
 	admv1beta1 "k8s.io/api/admission/v1beta1"
 	corev1 "k8s.io/api/core/v1"
 	extclientset "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
 	"k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/typed/apiextensions/v1beta1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/clock"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/scheme"
 	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	corelisterv1 "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
 )
@@ -62,6 +73,15 @@ const (
 
 	// maxPodPendingCount is the maximum number of pending pods per game server set
 	maxPodPendingCount = 5000
+
+	// maxConsecutiveAPIFailures is the number of API failures in a row for a game server set
+	// after which creating and deleting its game servers backs off for apiFailureCooldown
+	maxConsecutiveAPIFailures = 10
+	apiFailureCooldown        = 30 * time.Second
+
+	// maxNameConflictRetries is the number of times the creation of a named game server is retried
+	// with the next unused index, when another game server set has taken its name first
+	maxNameConflictRetries = 5
 )
 
 // Controller is a the GameServerSet controller
@@ -75,18 +95,34 @@ type Controller struct {
 	gameServerSetGetter getterv1.GameServerSetsGetter
 	gameServerSetLister listerv1.GameServerSetLister
 	gameServerSetSynced cache.InformerSynced
+	fleetLister         listerv1.FleetLister
+	fleetSynced         cache.InformerSynced
+	namespaceLister     corelisterv1.NamespaceLister
+	namespaceSynced     cache.InformerSynced
 	workerqueue         *workerqueue.WorkerQueue
 	stop                <-chan struct{}
 	recorder            record.EventRecorder
 	stateCache          *gameServerStateCache
+	clock               clock.Clock
+	// replacementLimiter limits the rate at which Unhealthy and Error GameServers are replaced, across all GameServerSets
+	replacementLimiter *rate.Limiter
+	// summarizeFleetEvents is true when the GameServer events of Fleets are summarized
+	// on the Fleet, in which case no event is recorded per GameServer of a Fleet
+	summarizeFleetEvents bool
 }
 
-// NewController returns a new gameserverset crd controller
+// NewController returns a new gameserverset crd controller.
+// When summarizeFleetEvents is true, no event is recorded for each GameServer created or deleted for a Fleet,
+// as they are summarized on the Fleet by the fleets.EventSummaryController.
+// maxReplacementRate is the maximum number of Unhealthy and Error GameServers replaced per second, 0 is unlimited.
 func NewController(
 	wh *webhooks.WebHook,
 	health healthcheck.Handler,
 	counter *gameservers.PerNodeCounter,
+	summarizeFleetEvents bool,
+	maxReplacementRate float64,
 	kubeClient kubernetes.Interface,
+	kubeInformerFactory informers.SharedInformerFactory,
 	extClient extclientset.Interface,
 	agonesClient versioned.Interface,
 	agonesInformerFactory externalversions.SharedInformerFactory) *Controller {
@@ -95,22 +131,30 @@ func NewController(
 	gsInformer := gameServers.Informer()
 	gameServerSets := agonesInformerFactory.Agones().V1().GameServerSets()
 	gsSetInformer := gameServerSets.Informer()
+	fleets := agonesInformerFactory.Agones().V1().Fleets()
 
 	c := &Controller{
-		crdGetter:           extClient.ApiextensionsV1beta1().CustomResourceDefinitions(),
-		counter:             counter,
-		gameServerGetter:    agonesClient.AgonesV1(),
-		gameServerLister:    gameServers.Lister(),
-		gameServerSynced:    gsInformer.HasSynced,
-		gameServerSetGetter: agonesClient.AgonesV1(),
-		gameServerSetLister: gameServerSets.Lister(),
-		gameServerSetSynced: gsSetInformer.HasSynced,
-		stateCache:          &gameServerStateCache{},
+		crdGetter:            extClient.ApiextensionsV1beta1().CustomResourceDefinitions(),
+		counter:              counter,
+		gameServerGetter:     agonesClient.AgonesV1(),
+		gameServerLister:     gameServers.Lister(),
+		gameServerSynced:     gsInformer.HasSynced,
+		gameServerSetGetter:  agonesClient.AgonesV1(),
+		gameServerSetLister:  gameServerSets.Lister(),
+		gameServerSetSynced:  gsSetInformer.HasSynced,
+		fleetLister:          fleets.Lister(),
+		fleetSynced:          fleets.Informer().HasSynced,
+		namespaceLister:      kubeInformerFactory.Core().V1().Namespaces().Lister(),
+		namespaceSynced:      kubeInformerFactory.Core().V1().Namespaces().Informer().HasSynced,
+		stateCache:           &gameServerStateCache{},
+		clock:                clock.RealClock{},
+		summarizeFleetEvents: summarizeFleetEvents,
+		replacementLimiter:   newReplacementLimiter(maxReplacementRate),
 	}
 
 	c.baseLogger = runtime.NewLoggerWithType(c)
 	c.workerqueue = workerqueue.NewWorkerQueue(c.syncGameServerSet, c.baseLogger, logfields.GameServerSetKey, agones.GroupName+".GameServerSetController")
-	health.AddLivenessCheck("gameserverset-workerqueue", healthcheck.Check(c.workerqueue.Healthy))
+	c.workerqueue.AddHealthChecks(health, "gameserverset-workerqueue")
 
 	eventBroadcaster := record.NewBroadcaster()
 	eventBroadcaster.StartLogging(c.baseLogger.Infof)
@@ -142,6 +186,11 @@ func NewController(
 			if gs.ObjectMeta.DeletionTimestamp == nil {
 				c.gameServerEventHandler(gs)
 			}
+			// sync the previous owner as well, if the gameserver has been transferred to another gameserverset
+			oldGs := oldObj.(*agonesv1.GameServer)
+			if oldRef, ref := metav1.GetControllerOf(oldGs), metav1.GetControllerOf(gs); oldRef != nil && (ref == nil || oldRef.UID != ref.UID) {
+				c.gameServerEventHandler(oldGs)
+			}
 		},
 		DeleteFunc: c.gameServerEventHandler,
 	})
@@ -160,7 +209,7 @@ func (c *Controller) Run(workers int, stop <-chan struct{}) error {
 	}
 
 	c.baseLogger.Info("Wait for cache sync")
-	if !cache.WaitForCacheSync(stop, c.gameServerSynced, c.gameServerSetSynced) {
+	if !cache.WaitForCacheSync(stop, c.gameServerSynced, c.gameServerSetSynced, c.fleetSynced, c.namespaceSynced) {
 		return errors.New("failed to wait for caches to sync")
 	}
 
@@ -307,10 +356,59 @@ func (c *Controller) syncGameServerSet(key string) error {
 		return err
 	}
 
-	list = c.stateCache.forGameServerSet(gsSet).reconcileWithUpdatedServerList(list)
+	entry := c.stateCache.forGameServerSet(gsSet)
+	list = entry.reconcileWithUpdatedServerList(list)
 
-	numServersToAdd, toDelete, isPartial := computeReconciliationAction(gsSet.Spec.Scheduling, list, c.counter.Counts(),
-		int(gsSet.Spec.Replicas), maxGameServerCreationsPerBatch, maxGameServerDeletionsPerBatch, maxPodPendingCount)
+	if gsSet.Spec.Paused {
+		// leave the GameServers as they are while they are being inspected, but keep reporting them
+		c.loggerForGameServerSet(gsSet).Info("GameServerSet is paused, not creating or deleting game servers")
+		return c.syncGameServerSetStatus(gsSet, list)
+	}
+
+	if remaining := entry.backoffRemaining(c.clock.Now()); remaining > 0 {
+		// too many API calls have failed in a row, so don't add to the load on the API server until the cooldown is over
+		c.loggerForGameServerSet(gsSet).WithField("remaining", remaining).Warning("Backing off creating and deleting game servers after consecutive API failures")
+		defer c.workerqueue.EnqueueAfter(gsSet, remaining)
+		return c.syncGameServerSetStatus(gsSet, list)
+	}
+
+	reserved, throttled := c.allowReplacements(list)
+	maxReplacements := len(reserved.reservations)
+	if throttled > 0 {
+		// come back once the limiter lets more replacements through
+		c.loggerForGameServerSet(gsSet).WithField("throttled", throttled).Info("Throttling the replacement of unhealthy game servers")
+		defer c.workerqueue.EnqueueAfter(gsSet, c.replacementRetryPeriod())
+	}
+
+	var numServersToAdd int
+	var toDelete []*agonesv1.GameServer
+	var isPartial bool
+	if naming := gsSet.Spec.Naming; naming != nil && naming.Ordinal {
+		numServersToAdd, toDelete, isPartial = computeOrdinalReconciliationAction(naming, list,
+			int(gsSet.Spec.Replicas), maxGameServerCreationsPerBatch, maxGameServerDeletionsPerBatch, maxReplacements, maxPodPendingCount)
+	} else {
+		// GameServers past their MaxLifetime, or restarted by their Fleet, are recycled by creating their replacements first, and shutting them down
+		// once there are enough available GameServers without them, so that the capacity of the GameServerSet never dips
+		replicas := int(gsSet.Spec.Replicas)
+		recycle := recyclableGameServers(gsSet, list, c.clock.Now())
+		numServersToAdd, toDelete, isPartial = computeReconciliationAction(gsSet.Spec.GetScaleDownStrategy(), list, c.counter.Counts(),
+			replicas+len(recycle), maxGameServerCreationsPerBatch, maxGameServerDeletionsPerBatch, maxReplacements, maxPodPendingCount)
+		if len(recycle) > 0 && numServersToAdd == 0 && len(toDelete) == 0 && availableCount(list) >= replicas+len(recycle) {
+			toDelete = recycle
+		}
+		if next := nextExpiry(gsSet, list, c.clock.Now()); next > 0 {
+			defer c.workerqueue.EnqueueAfter(gsSet, next)
+		}
+	}
+	// fewer GameServers may be replaced than were allowed, once the deletions are capped
+	reserved.release(replacedCount(toDelete))
+	if numServersToAdd > 0 && gameservers.NamespaceTerminating(c.namespaceLister, gsSet.ObjectMeta.Namespace) {
+		// the API server would reject the GameServers, and the namespace controller deletes the existing ones
+		c.loggerForGameServerSet(gsSet).WithField("numServersToAdd", numServersToAdd).
+			Info("Namespace is terminating, not creating game servers")
+		numServersToAdd = 0
+		isPartial = false
+	}
 	status := computeStatus(list)
 	fields := logrus.Fields{}
 
@@ -341,26 +439,37 @@ func (c *Controller) syncGameServerSet(key string) error {
 		defer c.workerqueue.EnqueueImmediately(gsSet)
 	}
 
+	var failed bool
 	if numServersToAdd > 0 {
 		if err := c.addMoreGameServers(gsSet, numServersToAdd); err != nil {
 			c.loggerForGameServerSet(gsSet).WithError(err).Warning("error adding game servers")
+			failed = true
 		}
 	}
 
 	if len(toDelete) > 0 {
 		if err := c.deleteGameServers(gsSet, toDelete); err != nil {
 			c.loggerForGameServerSet(gsSet).WithError(err).Warning("error deleting game servers")
+			failed = true
 		}
 	}
 
+	if remaining := entry.backoffRemaining(c.clock.Now()); remaining > 0 {
+		defer c.workerqueue.EnqueueAfter(gsSet, remaining)
+	} else if failed && !isPartial {
+		// the successful creations and deletions are tracked in the state cache, so the
+		// next reconciliation will only retry the portion that failed
+		defer c.workerqueue.Enqueue(gsSet)
+	}
+
 	return c.syncGameServerSetStatus(gsSet, list)
 }
 
 // computeReconciliationAction computes the action to take to reconcile a game server set set given
 // the list of game servers that were found and target replica count.
-func computeReconciliationAction(strategy apis.SchedulingStrategy, list []*agonesv1.GameServer,
+func computeReconciliationAction(strategy agonesv1.ScaleDownStrategy, list []*agonesv1.GameServer,
 	counts map[string]gameservers.NodeCount, targetReplicaCount int, maxCreations int, maxDeletions int,
-	maxPending int) (int, []*agonesv1.GameServer, bool) {
+	maxReplacements int, maxPending int) (int, []*agonesv1.GameServer, bool) {
 	var upCount int     // up == Ready or will become ready
 	var deleteCount int // number of gameservers to delete
 
@@ -370,6 +479,7 @@ func computeReconciliationAction(strategy apis.SchedulingStrategy, list []*agone
 
 	var potentialDeletions []*agonesv1.GameServer
 	var toDelete []*agonesv1.GameServer
+	var unhealthy []*agonesv1.GameServer
 
 	scheduleDeletion := func(gs *agonesv1.GameServer) {
 		toDelete = append(toDelete, gs)
@@ -429,13 +539,23 @@ func computeReconciliationAction(strategy apis.SchedulingStrategy, list []*agone
 		// GameServerStateShutdown - already handled above
 		// GameServerStateAllocated - already handled above
 		case agonesv1.GameServerStateError, agonesv1.GameServerStateUnhealthy:
-			scheduleDeletion(gs)
+			unhealthy = append(unhealthy, gs)
 		default:
 			// unrecognized state, assume it's up.
 			handleGameServerUp(gs)
 		}
 	}
 
+	for i, gs := range sortGameServersForReplacement(unhealthy) {
+		if i < maxReplacements {
+			scheduleDeletion(gs)
+		} else {
+			// the replacement is throttled, so keep its place until it can be deleted,
+			// rather than creating a new gameserver for it already
+			upCount++
+		}
+	}
+
 	var partialReconciliation bool
 	var numServersToAdd int
 
@@ -460,13 +580,72 @@ func computeReconciliationAction(strategy apis.SchedulingStrategy, list []*agone
 	}
 
 	if deleteCount > 0 {
-		if strategy == apis.Packed {
-			potentialDeletions = sortGameServersByLeastFullNodes(potentialDeletions, counts)
-		} else {
-			potentialDeletions = sortGameServersByNewFirst(potentialDeletions)
+		potentialDeletions = SortGameServersForScaleDown(strategy, potentialDeletions, counts)
+		toDelete = append(toDelete, potentialDeletions[0:deleteCount]...)
+	}
+
+	if len(toDelete) > maxDeletions {
+		toDelete = toDelete[0:maxDeletions]
+		partialReconciliation = true
+	}
+
+	return numServersToAdd, toDelete, partialReconciliation
+}
+
+// computeOrdinalReconciliationAction computes the action to take to reconcile a game server set with
+// ordinal naming, so that it has exactly one game server for each index from 0 to targetReplicaCount - 1.
+// Game servers outside of that range are deleted, unless they are allocated or reserved, and those in
+// Error or Unhealthy are deleted, so that their index is recreated once they are gone.
+func computeOrdinalReconciliationAction(naming *agonesv1.GameServerNaming, list []*agonesv1.GameServer,
+	targetReplicaCount int, maxCreations int, maxDeletions int, maxReplacements int, maxPending int) (int, []*agonesv1.GameServer, bool) {
+	var toDelete []*agonesv1.GameServer
+	var unhealthy []*agonesv1.GameServer
+	var podPendingCount int
+	// indices that have a game server, including those being deleted, as their name is still in use
+	used := map[int]bool{}
+
+	for _, gs := range list {
+		index, ok := naming.Index(gs.ObjectMeta.Name)
+		inRange := ok && index < targetReplicaCount
+		if inRange {
+			used[index] = true
+		}
+		if gs.IsBeingDeleted() {
+			continue
 		}
 
-		toDelete = append(toDelete, potentialDeletions[0:deleteCount]...)
+		switch gs.Status.State {
+		case agonesv1.GameServerStatePortAllocation, agonesv1.GameServerStateCreating,
+			agonesv1.GameServerStateStarting, agonesv1.GameServerStateScheduled:
+			podPendingCount++
+		}
+
+		if gs.Status.State == agonesv1.GameServerStateError || gs.Status.State == agonesv1.GameServerStateUnhealthy {
+			unhealthy = append(unhealthy, gs)
+		} else if !inRange && gs.IsDeletable() {
+			toDelete = append(toDelete, gs)
+		}
+	}
+
+	// the index of a throttled replacement stays in use until it can be deleted
+	unhealthy = sortGameServersForReplacement(unhealthy)
+	if len(unhealthy) > maxReplacements {
+		unhealthy = unhealthy[0:maxReplacements]
+	}
+	toDelete = append(unhealthy, toDelete...)
+
+	var partialReconciliation bool
+	numServersToAdd := targetReplicaCount - len(used)
+	if numServersToAdd > maxCreations {
+		numServersToAdd = maxCreations
+		partialReconciliation = true
+	}
+	if numServersToAdd > 0 && numServersToAdd+podPendingCount > maxPending {
+		numServersToAdd = maxPending - podPendingCount
+		if numServersToAdd < 0 {
+			numServersToAdd = 0
+		}
+		partialReconciliation = true
 	}
 
 	if len(toDelete) > maxDeletions {
@@ -477,47 +656,326 @@ func computeReconciliationAction(strategy apis.SchedulingStrategy, list []*agone
 	return numServersToAdd, toDelete, partialReconciliation
 }
 
+// newReplacementLimiter returns the limiter of the rate at which Unhealthy and Error GameServers are replaced,
+// which lets a second worth of replacements through at once, and is unlimited if maxReplacementRate is 0
+func newReplacementLimiter(maxReplacementRate float64) *rate.Limiter {
+	if maxReplacementRate <= 0 {
+		return rate.NewLimiter(rate.Inf, 0)
+	}
+	return rate.NewLimiter(rate.Limit(maxReplacementRate), int(math.Ceil(maxReplacementRate)))
+}
+
+// replacements are the tokens of the replacement rate limit that are reserved for the Unhealthy and Error GameServers of a sync
+type replacements struct {
+	// at is when the tokens were reserved, which they are released at too, as the limiter
+	// only gives back the tokens of a reservation that is cancelled before it is due
+	at           time.Time
+	reservations []*rate.Reservation
+}
+
+// release gives the tokens of the replacements past the used ones back to the limiter, latest first,
+// so that the GameServers that were not replaced don't use up the rate limit
+func (r replacements) release(used int) {
+	for i := len(r.reservations) - 1; i >= used; i-- {
+		r.reservations[i].CancelAt(r.at)
+	}
+}
+
+// allowReplacements reserves the replacements of the Unhealthy and Error GameServers in the list that can be replaced now,
+// and returns how many have to wait for the replacement rate limit. The replacements that are not used must be released.
+func (c *Controller) allowReplacements(list []*agonesv1.GameServer) (replacements, int) {
+	candidates := replacedCount(list)
+
+	reserved := replacements{at: time.Now()}
+	for len(reserved.reservations) < candidates {
+		r := c.replacementLimiter.ReserveN(reserved.at, 1)
+		if !r.OK() || r.DelayFrom(reserved.at) > 0 {
+			r.CancelAt(reserved.at)
+			break
+		}
+		reserved.reservations = append(reserved.reservations, r)
+	}
+	return reserved, candidates - len(reserved.reservations)
+}
+
+// replacedCount returns the number of Unhealthy and Error GameServers in the list that are not being deleted yet,
+// which are replaced once they are deleted
+func replacedCount(list []*agonesv1.GameServer) int {
+	count := 0
+	for _, gs := range list {
+		if !gs.IsBeingDeleted() &&
+			(gs.Status.State == agonesv1.GameServerStateError || gs.Status.State == agonesv1.GameServerStateUnhealthy) {
+			count++
+		}
+	}
+	return count
+}
+
+// replacementRetryPeriod returns how long until the replacement rate limit lets another replacement through
+func (c *Controller) replacementRetryPeriod() time.Duration {
+	return time.Duration(float64(time.Second) / float64(c.replacementLimiter.Limit()))
+}
+
 // addMoreGameServers adds diff more GameServers to the set
 func (c *Controller) addMoreGameServers(gsSet *agonesv1.GameServerSet, count int) error {
 	c.loggerForGameServerSet(gsSet).WithField("count", count).Info("Adding more gameservers")
 
-	return parallelize(newGameServersChannel(count, gsSet), maxCreationParalellism, func(gs *agonesv1.GameServer) error {
-		gs, err := c.gameServerGetter.GameServers(gs.Namespace).Create(gs)
+	entry := c.stateCache.forGameServerSet(gsSet)
+	names, err := c.gameServerIndices(gsSet, entry)
+	if err != nil {
+		return err
+	}
+	var indices []int
+	if names != nil {
+		indices = make([]int, 0, count)
+		for len(indices) < count {
+			index, ok := names.next()
+			if !ok {
+				// with ordinal naming, there may be fewer indices available than requested
+				break
+			}
+			indices = append(indices, index)
+		}
+		count = len(indices)
+	}
+	err = parallelize(newGameServersChannel(count, gsSet, indices), maxCreationParalellism, func(gs *agonesv1.GameServer) error {
+		if entry.backoffRemaining(c.clock.Now()) > 0 {
+			return nil
+		}
+		created, err := c.gameServerGetter.GameServers(gs.Namespace).Create(gs)
+		// the GameServerSets of the node pools of a Fleet share its Naming, so another one may have taken
+		// the name first, which is retried with the next unused index rather than counted as an API failure
+		for retries := 0; k8serrors.IsAlreadyExists(err) && names != nil && retries < maxNameConflictRetries; retries++ {
+			index, ok := names.next()
+			if !ok {
+				break
+			}
+			created, err = c.gameServerGetter.GameServers(gs.Namespace).Create(gsSet.NamedGameServer(index))
+		}
 		if err != nil {
+			if !k8serrors.IsAlreadyExists(err) {
+				c.apiFailed(gsSet, entry)
+			}
 			return errors.Wrapf(err, "error creating gameserver for gameserverset %s", gsSet.ObjectMeta.Name)
 		}
+		entry.apiSucceeded()
+		gs = created
 
-		c.stateCache.forGameServerSet(gsSet).created(gs)
-		c.recorder.Eventf(gsSet, corev1.EventTypeNormal, "SuccessfulCreate", "Created gameserver: %s", gs.ObjectMeta.Name)
+		entry.created(gs)
+		if !c.fleetEventsSummarized(gsSet) {
+			c.recorder.Eventf(gsSet, corev1.EventTypeNormal, "SuccessfulCreate", "Created gameserver: %s", gs.ObjectMeta.Name)
+		}
 		return nil
 	})
+	c.recordFailures(gsSet, "FailedCreate", "create", err)
+	return err
+}
+
+// fleetEventsSummarized returns true if the events of the GameServers of the GameServerSet
+// are summarized on its Fleet, rather than recorded one by one
+func (c *Controller) fleetEventsSummarized(gsSet *agonesv1.GameServerSet) bool {
+	return c.summarizeFleetEvents && gsSet.ObjectMeta.Labels[agonesv1.FleetNameLabel] != ""
 }
 
 func (c *Controller) deleteGameServers(gsSet *agonesv1.GameServerSet, toDelete []*agonesv1.GameServer) error {
 	c.loggerForGameServerSet(gsSet).WithField("diff", len(toDelete)).Info("Deleting gameservers")
 
-	return parallelize(gameServerListToChannel(toDelete), maxDeletionParallelism, func(gs *agonesv1.GameServer) error {
+	entry := c.stateCache.forGameServerSet(gsSet)
+	scaleDownReason := agonesv1.ShutdownReasonScaleDown
+	if c.isRollingOut(gsSet) {
+		scaleDownReason = agonesv1.ShutdownReasonRollout
+	}
+	err := parallelize(gameServerListToChannel(toDelete), maxDeletionParallelism, func(gs *agonesv1.GameServer) error {
+		if entry.backoffRemaining(c.clock.Now()) > 0 {
+			return nil
+		}
+		reason := scaleDownReason
+		switch gs.Status.State {
+		case agonesv1.GameServerStateUnhealthy:
+			reason = agonesv1.ShutdownReasonUnhealthy
+		case agonesv1.GameServerStateError:
+			reason = agonesv1.ShutdownReasonError
+		case agonesv1.GameServerStateReady:
+			if gs.IsRestartPending() {
+				reason = agonesv1.ShutdownReasonRestart
+			} else if gsSet.Spec.MaxLifetime != nil && isExpired(gs, gsSet.Spec.MaxLifetime.Duration, c.clock.Now()) {
+				reason = agonesv1.ShutdownReasonRecycle
+			}
+		}
 		// We should not delete the gameservers directly buy set their state to shutdown and let the gameserver controller to delete
 		gsCopy := gs.DeepCopy()
-		gsCopy.Status.State = agonesv1.GameServerStateShutdown
+		gsCopy.Shutdown(reason)
 		_, err := c.gameServerGetter.GameServers(gs.Namespace).Update(gsCopy)
 		if err != nil {
+			c.apiFailed(gsSet, entry)
 			return errors.Wrapf(err, "error updating gameserver %s from status %s to Shutdown status.", gs.ObjectMeta.Name, gs.Status.State)
 		}
+		entry.apiSucceeded()
 
-		c.stateCache.forGameServerSet(gsSet).deleted(gs)
-		c.recorder.Eventf(gsSet, corev1.EventTypeNormal, "SuccessfulDelete", "Deleted gameserver in state %s: %v", gs.Status.State, gs.ObjectMeta.Name)
+		entry.deleted(gs)
+		if !c.fleetEventsSummarized(gsSet) {
+			c.recorder.AnnotatedEventf(gsSet, map[string]string{agonesv1.GameServerShutdownReasonAnnotation: string(reason)}, corev1.EventTypeNormal,
+				"SuccessfulDelete", "Deleted gameserver in state %s for %s: %v", gs.Status.State, reason, gs.ObjectMeta.Name)
+		}
 		return nil
 	})
+	c.recordFailures(gsSet, "FailedDelete", "delete", err)
+	return err
 }
 
-func newGameServersChannel(n int, gsSet *agonesv1.GameServerSet) chan *agonesv1.GameServer {
+// isRollingOut returns true if the GameServerSet belongs to a Fleet whose template has been updated,
+// so that it is scaled down to be replaced by the GameServerSet of the new template
+func (c *Controller) isRollingOut(gsSet *agonesv1.GameServerSet) bool {
+	fleetName := gsSet.ObjectMeta.Labels[agonesv1.FleetNameLabel]
+	if fleetName == "" {
+		return false
+	}
+	fleet, err := c.fleetLister.Fleets(gsSet.ObjectMeta.Namespace).Get(fleetName)
+	if err != nil {
+		return false
+	}
+	return metav1.IsControlledBy(gsSet, fleet) && !reflect.DeepEqual(&gsSet.Spec.Template, fleetTemplate(fleet, gsSet))
+}
+
+// fleetTemplate returns the template of the Fleet for the GameServerSet, which is the template of its
+// node pool if the Fleet has node pools, or nil if the node pool of the GameServerSet was removed
+func fleetTemplate(fleet *agonesv1.Fleet, gsSet *agonesv1.GameServerSet) *agonesv1.GameServerTemplateSpec {
+	if len(fleet.Spec.NodePools) == 0 {
+		return &fleet.Spec.Template
+	}
+	pool := gsSet.ObjectMeta.Labels[agonesv1.FleetNodePoolLabel]
+	for i := range fleet.Spec.NodePools {
+		if fleet.Spec.NodePools[i].Name == pool {
+			return &fleet.NodePoolFleet(i).Spec.Template
+		}
+	}
+	return nil
+}
+
+// apiFailed records an API failure for the GameServerSet, and the start of a backoff
+// if this failure has tripped the circuit breaker
+func (c *Controller) apiFailed(gsSet *agonesv1.GameServerSet, entry *gameServerSetCacheEntry) {
+	if entry.apiFailed(c.clock.Now(), maxConsecutiveAPIFailures, apiFailureCooldown) {
+		c.loggerForGameServerSet(gsSet).WithField("cooldown", apiFailureCooldown).Warning("Too many consecutive API failures, backing off")
+		c.recorder.Eventf(gsSet, corev1.EventTypeWarning, string(agonesv1.GameServerSetBackoff),
+			"Backing off for %v after %d consecutive API failures", apiFailureCooldown, maxConsecutiveAPIFailures)
+	}
+}
+
+// recordFailures records a warning event on the GameServerSet for each distinct kind of failure
+// in an aggregate error from parallelize, with the number of GameServers that failed that way.
+// The event reason is the prefix followed by the kind of failure, e.g. FailedCreateQuotaExceeded
+func (c *Controller) recordFailures(gsSet *agonesv1.GameServerSet, prefix, action string, err error) {
+	if err == nil {
+		return
+	}
+
+	errs := []error{err}
+	if agg, ok := err.(utilerrors.Aggregate); ok {
+		errs = agg.Errors()
+	}
+
+	type failure struct {
+		count int
+		err   error
+	}
+	var reasons []string
+	failures := map[string]*failure{}
+	for _, e := range errs {
+		reason := prefix + failureReason(e)
+		if f, ok := failures[reason]; ok {
+			f.count++
+			continue
+		}
+		reasons = append(reasons, reason)
+		failures[reason] = &failure{count: 1, err: e}
+	}
+
+	for _, reason := range reasons {
+		f := failures[reason]
+		c.recorder.Eventf(gsSet, corev1.EventTypeWarning, reason, "Failed to %s %d gameserver(s): %v", action, f.count, f.err)
+	}
+}
+
+// failureReason classifies an error returned from the Kubernetes API,
+// so that different kinds of failures can be told apart in events
+func failureReason(err error) string {
+	cause := errors.Cause(err)
+	switch {
+	case k8serrors.IsForbidden(cause) && strings.Contains(cause.Error(), "exceeded quota"):
+		return "QuotaExceeded"
+	case strings.Contains(cause.Error(), "admission webhook"):
+		return "WebhookDenied"
+	case k8serrors.IsInvalid(cause):
+		return "Invalid"
+	case k8serrors.IsConflict(cause):
+		return "Conflict"
+	case k8serrors.IsAlreadyExists(cause):
+		return "AlreadyExists"
+	}
+	return ""
+}
+
+// namingIndices hands out the indices of the Naming of a GameServerSet that are not used yet, lowest first
+type namingIndices struct {
+	mu    sync.Mutex
+	used  map[int]bool
+	limit int // with ordinal naming, the indices are lower than the replicas, otherwise it is -1
+}
+
+// next returns the lowest index that is not used, and marks it as used, or false if there is none left
+func (n *namingIndices) next() (int, bool) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	for i := 0; n.limit < 0 || i < n.limit; i++ {
+		if !n.used[i] {
+			n.used[i] = true
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// gameServerIndices returns the indices of the GameServerSet Naming that are not used by a GameServer
+// in its namespace, including those pending creation, or nil if the GameServerSet has no Naming.
+// With ordinal naming, only indices lower than the replicas are handed out.
+func (c *Controller) gameServerIndices(gsSet *agonesv1.GameServerSet, entry *gameServerSetCacheEntry) (*namingIndices, error) {
+	naming := gsSet.Spec.Naming
+	if naming == nil {
+		return nil, nil
+	}
+
+	list, err := c.gameServerLister.GameServers(gsSet.ObjectMeta.Namespace).List(labels.Everything())
+	if err != nil {
+		return nil, errors.Wrapf(err, "error listing gameservers for gameserverset %s", gsSet.ObjectMeta.Name)
+	}
+	used := map[int]bool{}
+	for _, gs := range entry.reconcileWithUpdatedServerList(list) {
+		if index, ok := naming.Index(gs.ObjectMeta.Name); ok {
+			used[index] = true
+		}
+	}
+
+	limit := -1
+	if naming.Ordinal {
+		limit = int(gsSet.Spec.Replicas)
+	}
+	return &namingIndices{used: used, limit: limit}, nil
+}
+
+// newGameServersChannel returns a channel of n new GameServers for the GameServerSet,
+// named with the given indices if it has a Naming
+func newGameServersChannel(n int, gsSet *agonesv1.GameServerSet, indices []int) chan *agonesv1.GameServer {
 	gameServers := make(chan *agonesv1.GameServer)
 	go func() {
 		defer close(gameServers)
 
 		for i := 0; i < n; i++ {
-			gameServers <- gsSet.GameServer()
+			if indices != nil {
+				gameServers <- gsSet.NamedGameServer(indices[i])
+			} else {
+				gameServers <- gsSet.GameServer()
+			}
 		}
 	}()
 
@@ -538,9 +996,11 @@ func gameServerListToChannel(list []*agonesv1.GameServer) chan *agonesv1.GameSer
 }
 
 // parallelize processes a channel of game server objects, invoking the provided callback for items in the channel with the specified degree of parallelism up to a limit.
-// Returns nil if all callbacks returned nil or one of the error responses, not necessarily the first one.
+// A failed callback does not stop the processing of the remaining items.
+// Returns nil if all callbacks returned nil, or an aggregate of all the errors returned.
 func parallelize(gameServers chan *agonesv1.GameServer, parallelism int, work func(gs *agonesv1.GameServer) error) error {
-	errch := make(chan error, parallelism)
+	var mu sync.Mutex
+	var errs []error
 
 	var wg sync.WaitGroup
 
@@ -550,33 +1010,91 @@ func parallelize(gameServers chan *agonesv1.GameServer, parallelism int, work fu
 		go func() {
 			defer wg.Done()
 			for it := range gameServers {
-				err := work(it)
-				if err != nil {
-					errch <- err
-					break
+				if err := work(it); err != nil {
+					mu.Lock()
+					errs = append(errs, err)
+					mu.Unlock()
 				}
 			}
 		}()
 	}
 	wg.Wait()
-	close(errch)
-
-	for range gameServers {
-		// drain any remaining game servers in the channel, in case we did not consume them all
-	}
 
-	// return first error from the channel, or nil if all successful.
-	return <-errch
+	return utilerrors.NewAggregate(errs)
 }
 
 // syncGameServerSetStatus synchronises the GameServerSet State with active GameServer counts
 func (c *Controller) syncGameServerSetStatus(gsSet *agonesv1.GameServerSet, list []*agonesv1.GameServer) error {
-	return c.updateStatusIfChanged(gsSet, computeStatus(list))
+	status := computeStatus(list)
+	status.Conditions = c.pausedConditions(gsSet, c.backoffConditions(gsSet))
+	return c.updateStatusIfChanged(gsSet, status)
+}
+
+// pausedConditions returns the conditions, with the Paused condition reflecting
+// whether the GameServerSet is currently paused
+func (c *Controller) pausedConditions(gsSet *agonesv1.GameServerSet, conditions []agonesv1.GameServerSetCondition) []agonesv1.GameServerSetCondition {
+	status := agonesv1.GameServerSetStatus{Conditions: conditions}
+	cond := status.GetCondition(agonesv1.GameServerSetPaused)
+	if cond == nil {
+		if !gsSet.Spec.Paused {
+			return status.Conditions
+		}
+		status.Conditions = append(status.Conditions, agonesv1.GameServerSetCondition{Type: agonesv1.GameServerSetPaused})
+		cond = &status.Conditions[len(status.Conditions)-1]
+	}
+
+	switch {
+	case gsSet.Spec.Paused && cond.Status != corev1.ConditionTrue:
+		cond.Status = corev1.ConditionTrue
+		cond.LastTransitionTime = metav1.NewTime(c.clock.Now())
+		cond.Reason = "Paused"
+		cond.Message = "Not creating or deleting GameServers until the GameServerSet is unpaused"
+	case !gsSet.Spec.Paused && cond.Status != corev1.ConditionFalse:
+		cond.Status = corev1.ConditionFalse
+		cond.LastTransitionTime = metav1.NewTime(c.clock.Now())
+		cond.Reason = "Resumed"
+		cond.Message = "Creating and deleting GameServers has resumed"
+	}
+
+	return status.Conditions
+}
+
+// backoffConditions returns the GameServerSet's conditions, with the Backoff condition
+// reflecting whether the GameServerSet is currently backing off after API failures
+func (c *Controller) backoffConditions(gsSet *agonesv1.GameServerSet) []agonesv1.GameServerSetCondition {
+	status := gsSet.Status.DeepCopy()
+	now := c.clock.Now()
+	remaining := c.stateCache.forGameServerSet(gsSet).backoffRemaining(now)
+
+	cond := status.GetCondition(agonesv1.GameServerSetBackoff)
+	if cond == nil {
+		if remaining == 0 {
+			return status.Conditions
+		}
+		status.Conditions = append(status.Conditions, agonesv1.GameServerSetCondition{Type: agonesv1.GameServerSetBackoff})
+		cond = &status.Conditions[len(status.Conditions)-1]
+	}
+
+	switch {
+	case remaining > 0 && cond.Status != corev1.ConditionTrue:
+		cond.Status = corev1.ConditionTrue
+		cond.LastTransitionTime = metav1.NewTime(now)
+		cond.Reason = "ConsecutiveAPIFailures"
+		cond.Message = fmt.Sprintf("Not creating or deleting GameServers until %s, after %d consecutive API failures",
+			now.Add(remaining).UTC().Format(time.RFC3339), maxConsecutiveAPIFailures)
+	case remaining == 0 && cond.Status != corev1.ConditionFalse:
+		cond.Status = corev1.ConditionFalse
+		cond.LastTransitionTime = metav1.NewTime(now)
+		cond.Reason = "CooldownExpired"
+		cond.Message = "Creating and deleting GameServers has resumed"
+	}
+
+	return status.Conditions
 }
 
 // updateStatusIfChanged updates GameServerSet status if it's different than provided.
 func (c *Controller) updateStatusIfChanged(gsSet *agonesv1.GameServerSet, status agonesv1.GameServerSetStatus) error {
-	if gsSet.Status != status {
+	if !apiequality.Semantic.DeepEqual(gsSet.Status, status) {
 		gsSetCopy := gsSet.DeepCopy()
 		gsSetCopy.Status = status
 		_, err := c.gameServerSetGetter.GameServerSets(gsSet.ObjectMeta.Namespace).UpdateStatus(gsSetCopy)
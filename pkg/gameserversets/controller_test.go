@@ -17,7 +17,10 @@ package gameserversets
 import (
 	"encoding/json"
 	"net/http"
+	"sort"
 	"strconv"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -27,11 +30,16 @@ import (
 	agtesting "agones.dev/agones/pkg/testing"
 	"agones.dev/agones/pkg/util/webhooks"
 	"github.com/heptiolabs/healthcheck"
+	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	admv1beta1 "k8s.io/api/admission/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/clock"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/watch"
 	k8stesting "k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/cache"
@@ -54,6 +62,8 @@ const (
 	maxTestCreationsPerBatch = 3
 	maxTestDeletionsPerBatch = 3
 	maxTestPendingPerBatch   = 3
+	// no limit on replacements, unless a test sets one
+	maxTestReplacementsPerBatch = 1000
 )
 
 func TestComputeReconciliationAction(t *testing.T) {
@@ -209,8 +219,8 @@ func TestComputeReconciliationAction(t *testing.T) {
 
 	for _, tc := range cases {
 		t.Run(tc.desc, func(t *testing.T) {
-			toAdd, toDelete, isPartial := computeReconciliationAction(apis.Distributed, tc.list, map[string]gameservers.NodeCount{},
-				tc.targetReplicaCount, maxTestCreationsPerBatch, maxTestDeletionsPerBatch, maxTestPendingPerBatch)
+			toAdd, toDelete, isPartial := computeReconciliationAction(agonesv1.OldestFirstScaleDown, tc.list, map[string]gameservers.NodeCount{},
+				tc.targetReplicaCount, maxTestCreationsPerBatch, maxTestDeletionsPerBatch, maxTestReplacementsPerBatch, maxTestPendingPerBatch)
 
 			assert.Equal(t, tc.wantNumServersToAdd, toAdd, "# of GameServers to add")
 			assert.Len(t, toDelete, tc.wantNumServersToDelete, "# of GameServers to delete")
@@ -218,6 +228,29 @@ func TestComputeReconciliationAction(t *testing.T) {
 		})
 	}
 
+	t.Run("throttled replacements", func(t *testing.T) {
+		wasAllocated := gsWithState(agonesv1.GameServerStateUnhealthy)
+		wasAllocated.ObjectMeta.Name = "was-allocated"
+		wasAllocated.ObjectMeta.Annotations = map[string]string{
+			agonesv1.GameServerUnhealthyFromAnnotation: string(agonesv1.GameServerStateAllocated)}
+		list := []*agonesv1.GameServer{
+			gsWithState(agonesv1.GameServerStateReady),
+			gsWithState(agonesv1.GameServerStateUnhealthy),
+			gsWithState(agonesv1.GameServerStateError),
+			wasAllocated,
+		}
+
+		toAdd, toDelete, isPartial := computeReconciliationAction(agonesv1.OldestFirstScaleDown, list, map[string]gameservers.NodeCount{},
+			4, maxTestCreationsPerBatch, maxTestDeletionsPerBatch, 1, maxTestPendingPerBatch)
+
+		// only the deleted gameserver is replaced, the throttled ones keep their place
+		assert.Equal(t, 1, toAdd)
+		if assert.Len(t, toDelete, 1) {
+			assert.Equal(t, "was-allocated", toDelete[0].ObjectMeta.Name)
+		}
+		assert.False(t, isPartial)
+	})
+
 	t.Run("test packed scale down", func(t *testing.T) {
 		list := []*agonesv1.GameServer{
 			{ObjectMeta: metav1.ObjectMeta{Name: "gs1"}, Status: agonesv1.GameServerStatus{State: agonesv1.GameServerStateReady, NodeName: "node3"}},
@@ -227,8 +260,8 @@ func TestComputeReconciliationAction(t *testing.T) {
 		}
 
 		counts := map[string]gameservers.NodeCount{"node1": {Ready: 1}, "node3": {Ready: 2}}
-		toAdd, toDelete, isPartial := computeReconciliationAction(apis.Packed, list, counts, 2,
-			1000, 1000, 1000)
+		toAdd, toDelete, isPartial := computeReconciliationAction(agonesv1.PackedScaleDown, list, counts, 2,
+			1000, 1000, 1000, 1000)
 
 		assert.Empty(t, toAdd)
 		assert.False(t, isPartial, "shouldn't be partial")
@@ -239,6 +272,25 @@ func TestComputeReconciliationAction(t *testing.T) {
 	})
 
 	t.Run("test distributed scale down", func(t *testing.T) {
+		list := []*agonesv1.GameServer{
+			{ObjectMeta: metav1.ObjectMeta{Name: "gs1"}, Status: agonesv1.GameServerStatus{State: agonesv1.GameServerStateReady, NodeName: "node1"}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "gs2"}, Status: agonesv1.GameServerStatus{State: agonesv1.GameServerStateReady, NodeName: "node3"}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "gs3"}, Status: agonesv1.GameServerStatus{State: agonesv1.GameServerStateReady, NodeName: "node2"}},
+		}
+
+		counts := map[string]gameservers.NodeCount{"node1": {Ready: 1}, "node2": {Ready: 1, Allocated: 1}, "node3": {Ready: 1, Allocated: 3}}
+		toAdd, toDelete, isPartial := computeReconciliationAction(agonesv1.DistributedScaleDown, list, counts, 1,
+			1000, 1000, 1000, 1000)
+
+		assert.Empty(t, toAdd)
+		assert.False(t, isPartial, "shouldn't be partial")
+
+		assert.Len(t, toDelete, 2)
+		assert.Equal(t, "gs2", toDelete[0].ObjectMeta.Name)
+		assert.Equal(t, "gs3", toDelete[1].ObjectMeta.Name)
+	})
+
+	t.Run("test newest first scale down", func(t *testing.T) {
 		now := metav1.Now()
 
 		list := []*agonesv1.GameServer{
@@ -252,8 +304,33 @@ func TestComputeReconciliationAction(t *testing.T) {
 				CreationTimestamp: metav1.Time{Time: now.Add(30 * time.Second)}}, Status: agonesv1.GameServerStatus{State: agonesv1.GameServerStateReady}},
 		}
 
-		toAdd, toDelete, isPartial := computeReconciliationAction(apis.Distributed, list, map[string]gameservers.NodeCount{},
-			2, 1000, 1000, 1000)
+		toAdd, toDelete, isPartial := computeReconciliationAction(agonesv1.NewestFirstScaleDown, list, map[string]gameservers.NodeCount{},
+			2, 1000, 1000, 1000, 1000)
+
+		assert.Empty(t, toAdd)
+		assert.False(t, isPartial, "shouldn't be partial")
+
+		assert.Len(t, toDelete, 2)
+		assert.Equal(t, "gs3", toDelete[0].ObjectMeta.Name)
+		assert.Equal(t, "gs4", toDelete[1].ObjectMeta.Name)
+	})
+
+	t.Run("test oldest first scale down", func(t *testing.T) {
+		now := metav1.Now()
+
+		list := []*agonesv1.GameServer{
+			{ObjectMeta: metav1.ObjectMeta{Name: "gs1",
+				CreationTimestamp: metav1.Time{Time: now.Add(10 * time.Second)}}, Status: agonesv1.GameServerStatus{State: agonesv1.GameServerStateReady}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "gs2",
+				CreationTimestamp: now}, Status: agonesv1.GameServerStatus{State: agonesv1.GameServerStateReady}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "gs3",
+				CreationTimestamp: metav1.Time{Time: now.Add(40 * time.Second)}}, Status: agonesv1.GameServerStatus{State: agonesv1.GameServerStateReady}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "gs4",
+				CreationTimestamp: metav1.Time{Time: now.Add(30 * time.Second)}}, Status: agonesv1.GameServerStatus{State: agonesv1.GameServerStateReady}},
+		}
+
+		toAdd, toDelete, isPartial := computeReconciliationAction(agonesv1.OldestFirstScaleDown, list, map[string]gameservers.NodeCount{},
+			2, 1000, 1000, 1000, 1000)
 
 		assert.Empty(t, toAdd)
 		assert.False(t, isPartial, "shouldn't be partial")
@@ -264,6 +341,185 @@ func TestComputeReconciliationAction(t *testing.T) {
 	})
 }
 
+func TestComputeOrdinalReconciliationAction(t *testing.T) {
+	t.Parallel()
+
+	naming := &agonesv1.GameServerNaming{Prefix: "shard-", Ordinal: true}
+	gs := func(name string, st agonesv1.GameServerState) *agonesv1.GameServer {
+		return &agonesv1.GameServer{ObjectMeta: metav1.ObjectMeta{Name: name}, Status: agonesv1.GameServerStatus{State: st}}
+	}
+	pendingDeletion := func(name string) *agonesv1.GameServer {
+		gs := gs(name, agonesv1.GameServerStateShutdown)
+		gs.ObjectMeta.DeletionTimestamp = &deletionTime
+		return gs
+	}
+	names := func(list []*agonesv1.GameServer) []string {
+		var result []string
+		for _, gs := range list {
+			result = append(result, gs.ObjectMeta.Name)
+		}
+		return result
+	}
+
+	cases := []struct {
+		desc                string
+		list                []*agonesv1.GameServer
+		targetReplicaCount  int
+		wantNumServersToAdd int
+		wantToDelete        []string
+		wantIsPartial       bool
+	}{
+		{
+			desc:                "Empty",
+			targetReplicaCount:  2,
+			wantNumServersToAdd: 2,
+		},
+		{
+			desc: "RecreateMissingIndex",
+			list: []*agonesv1.GameServer{
+				gs("shard-0", agonesv1.GameServerStateReady),
+				gs("shard-2", agonesv1.GameServerStateAllocated),
+			},
+			targetReplicaCount:  3,
+			wantNumServersToAdd: 1,
+		},
+		{
+			desc: "IndexPendingDeletionIsInUse",
+			list: []*agonesv1.GameServer{
+				gs("shard-0", agonesv1.GameServerStateReady),
+				pendingDeletion("shard-1"),
+			},
+			targetReplicaCount: 2,
+		},
+		{
+			desc: "ReplaceUnhealthyAndError",
+			list: []*agonesv1.GameServer{
+				gs("shard-0", agonesv1.GameServerStateUnhealthy),
+				gs("shard-1", agonesv1.GameServerStateError),
+			},
+			targetReplicaCount: 2,
+			wantToDelete:       []string{"shard-0", "shard-1"},
+		},
+		{
+			desc: "DeleteOutOfRange",
+			list: []*agonesv1.GameServer{
+				gs("shard-0", agonesv1.GameServerStateReady),
+				gs("shard-1", agonesv1.GameServerStateReady),
+				gs("shard-2", agonesv1.GameServerStateReady),
+				gs("shard-3", agonesv1.GameServerStateAllocated),
+				gs("shard-4", agonesv1.GameServerStateReserved),
+				gs("other", agonesv1.GameServerStateReady),
+			},
+			targetReplicaCount: 2,
+			wantToDelete:       []string{"shard-2", "other"},
+		},
+		{
+			desc:                "AddServersPartial",
+			targetReplicaCount:  30,
+			wantNumServersToAdd: maxTestCreationsPerBatch,
+			wantIsPartial:       true,
+		},
+		{
+			desc: "AddServersExceedsInFlightLimit",
+			list: []*agonesv1.GameServer{
+				gs("shard-0", agonesv1.GameServerStateCreating),
+				gs("shard-1", agonesv1.GameServerStatePortAllocation),
+			},
+			targetReplicaCount:  30,
+			wantNumServersToAdd: 1,
+			wantIsPartial:       true,
+		},
+		{
+			desc: "DeleteServersPartial",
+			list: []*agonesv1.GameServer{
+				gs("shard-0", agonesv1.GameServerStateReady),
+				gs("shard-1", agonesv1.GameServerStateReady),
+				gs("shard-2", agonesv1.GameServerStateReady),
+				gs("shard-3", agonesv1.GameServerStateReady),
+			},
+			targetReplicaCount: 0,
+			wantToDelete:       []string{"shard-0", "shard-1", "shard-2"},
+			wantIsPartial:      true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			toAdd, toDelete, isPartial := computeOrdinalReconciliationAction(naming, tc.list, tc.targetReplicaCount,
+				maxTestCreationsPerBatch, maxTestDeletionsPerBatch, maxTestReplacementsPerBatch, maxTestPendingPerBatch)
+
+			assert.Equal(t, tc.wantNumServersToAdd, toAdd, "# of GameServers to add")
+			assert.Equal(t, tc.wantToDelete, names(toDelete), "GameServers to delete")
+			assert.Equal(t, tc.wantIsPartial, isPartial, "is partial reconciliation")
+		})
+	}
+
+	t.Run("ThrottledReplacements", func(t *testing.T) {
+		wasReserved := gs("shard-2", agonesv1.GameServerStateUnhealthy)
+		wasReserved.ObjectMeta.Annotations = map[string]string{
+			agonesv1.GameServerUnhealthyFromAnnotation: string(agonesv1.GameServerStateReserved)}
+		list := []*agonesv1.GameServer{
+			gs("shard-0", agonesv1.GameServerStateUnhealthy),
+			gs("shard-1", agonesv1.GameServerStateError),
+			wasReserved,
+			gs("shard-3", agonesv1.GameServerStateReady),
+		}
+
+		toAdd, toDelete, isPartial := computeOrdinalReconciliationAction(naming, list, 3,
+			maxTestCreationsPerBatch, maxTestDeletionsPerBatch, 2, maxTestPendingPerBatch)
+
+		assert.Equal(t, 0, toAdd)
+		assert.Equal(t, []string{"shard-2", "shard-0", "shard-3"}, names(toDelete))
+		assert.False(t, isPartial)
+	})
+}
+
+func TestControllerAllowReplacements(t *testing.T) {
+	t.Parallel()
+
+	list := []*agonesv1.GameServer{
+		gsWithState(agonesv1.GameServerStateReady),
+		gsWithState(agonesv1.GameServerStateUnhealthy),
+		gsWithState(agonesv1.GameServerStateError),
+		gsWithState(agonesv1.GameServerStateUnhealthy),
+		gsPendingDeletionWithState(agonesv1.GameServerStateUnhealthy),
+	}
+
+	t.Run("unlimited", func(t *testing.T) {
+		c, _ := newFakeController()
+		allowed, throttled := c.allowReplacements(list)
+		assert.Len(t, allowed.reservations, 3)
+		assert.Equal(t, 0, throttled)
+	})
+
+	t.Run("limited", func(t *testing.T) {
+		c, _ := newFakeController()
+		c.replacementLimiter = newReplacementLimiter(2)
+		allowed, throttled := c.allowReplacements(list)
+		assert.Len(t, allowed.reservations, 2)
+		assert.Equal(t, 1, throttled)
+		assert.Equal(t, 500*time.Millisecond, c.replacementRetryPeriod())
+
+		// the limit is shared across syncs
+		allowed, throttled = c.allowReplacements(list)
+		assert.Len(t, allowed.reservations, 0)
+		assert.Equal(t, 3, throttled)
+	})
+
+	t.Run("released", func(t *testing.T) {
+		c, _ := newFakeController()
+		c.replacementLimiter = newReplacementLimiter(2)
+		allowed, _ := c.allowReplacements(list)
+		assert.Len(t, allowed.reservations, 2)
+
+		// only one GameServer was replaced, so the other one can be replaced by the next sync
+		allowed.release(1)
+		allowed, throttled := c.allowReplacements(list)
+		assert.Len(t, allowed.reservations, 1)
+		assert.Equal(t, 2, throttled)
+	})
+}
+
 func TestComputeStatus(t *testing.T) {
 	cases := []struct {
 		list       []*agonesv1.GameServer
@@ -451,6 +707,153 @@ func TestSyncGameServerSet(t *testing.T) {
 
 		assert.Equal(t, 5, count)
 	})
+
+	t.Run("terminating namespace", func(t *testing.T) {
+		gsSet := defaultFixture()
+		list := createGameServers(gsSet, 5)
+		list[0].Status.State = agonesv1.GameServerStateUnhealthy
+		updated := false
+
+		c, m := newFakeController()
+		m.KubeClient.AddReactor("list", "namespaces", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			ns := corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: gsSet.ObjectMeta.Namespace},
+				Status: corev1.NamespaceStatus{Phase: corev1.NamespaceTerminating}}
+			return true, &corev1.NamespaceList{Items: []corev1.Namespace{ns}}, nil
+		})
+		m.AgonesClient.AddReactor("list", "gameserversets", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			return true, &agonesv1.GameServerSetList{Items: []agonesv1.GameServerSet{*gsSet}}, nil
+		})
+		m.AgonesClient.AddReactor("list", "gameservers", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			return true, &agonesv1.GameServerList{Items: list}, nil
+		})
+		m.AgonesClient.AddReactor("update", "gameservers", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			updated = true
+			return true, nil, nil
+		})
+		m.AgonesClient.AddReactor("create", "gameservers", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			assert.FailNow(t, "game servers should not be created in a terminating namespace")
+			return true, nil, nil
+		})
+
+		_, cancel := agtesting.StartInformers(m, c.gameServerSetSynced, c.gameServerSynced, c.namespaceSynced)
+		defer cancel()
+
+		assert.NoError(t, c.syncGameServerSet(gsSet.ObjectMeta.Namespace+"/"+gsSet.ObjectMeta.Name))
+		assert.True(t, updated, "unhealthy game servers should still be deleted")
+	})
+
+	t.Run("paused", func(t *testing.T) {
+		gsSet := defaultFixture()
+		gsSet.Spec.Paused = true
+		list := createGameServers(gsSet, 5)
+		list[0].Status.State = agonesv1.GameServerStateUnhealthy
+
+		c, m := newFakeController()
+		m.AgonesClient.AddReactor("list", "gameserversets", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			return true, &agonesv1.GameServerSetList{Items: []agonesv1.GameServerSet{*gsSet}}, nil
+		})
+		m.AgonesClient.AddReactor("list", "gameservers", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			return true, &agonesv1.GameServerList{Items: list}, nil
+		})
+		m.AgonesClient.AddReactor("update", "gameservers", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			assert.FailNow(t, "game servers should not be deleted while paused")
+			return true, nil, nil
+		})
+		m.AgonesClient.AddReactor("create", "gameservers", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			assert.FailNow(t, "game servers should not be created while paused")
+			return true, nil, nil
+		})
+		var status *agonesv1.GameServerSetStatus
+		m.AgonesClient.AddReactor("update", "gameserversets", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			gsSet := action.(k8stesting.UpdateAction).GetObject().(*agonesv1.GameServerSet)
+			status = gsSet.Status.DeepCopy()
+			return true, gsSet, nil
+		})
+
+		_, cancel := agtesting.StartInformers(m, c.gameServerSetSynced, c.gameServerSynced)
+		defer cancel()
+
+		assert.NoError(t, c.syncGameServerSet(gsSet.ObjectMeta.Namespace+"/"+gsSet.ObjectMeta.Name))
+		if assert.NotNil(t, status, "the status should still be updated") {
+			assert.Equal(t, int32(4), status.ReadyReplicas)
+			cond := status.GetCondition(agonesv1.GameServerSetPaused)
+			if assert.NotNil(t, cond) {
+				assert.Equal(t, corev1.ConditionTrue, cond.Status)
+			}
+		}
+	})
+
+	t.Run("recycling gameservers past their max lifetime", func(t *testing.T) {
+		now := time.Now()
+		gsSet := defaultFixture()
+		gsSet.Spec.MaxLifetime = &metav1.Duration{Duration: time.Hour}
+		reason := agonesv1.ShutdownReasonRecycle
+
+		// sync returns the number of game servers created, and the names of those recycled
+		sync := func(list []agonesv1.GameServer) (int, []string) {
+			created := 0
+			var recycled []string
+			c, m := newFakeController()
+			c.clock = clock.NewFakeClock(now)
+			m.AgonesClient.AddReactor("list", "gameserversets", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				return true, &agonesv1.GameServerSetList{Items: []agonesv1.GameServerSet{*gsSet}}, nil
+			})
+			m.AgonesClient.AddReactor("list", "gameservers", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				return true, &agonesv1.GameServerList{Items: list}, nil
+			})
+			m.AgonesClient.AddReactor("update", "gameservers", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				gs := action.(k8stesting.UpdateAction).GetObject().(*agonesv1.GameServer)
+				assert.Equal(t, reason, gs.ShutdownReason())
+				recycled = append(recycled, gs.ObjectMeta.Name)
+				return true, gs, nil
+			})
+			m.AgonesClient.AddReactor("create", "gameservers", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				created++
+				return true, action.(k8stesting.CreateAction).GetObject(), nil
+			})
+
+			_, cancel := agtesting.StartInformers(m, c.gameServerSetSynced, c.gameServerSynced)
+			defer cancel()
+
+			assert.NoError(t, c.syncGameServerSet(gsSet.ObjectMeta.Namespace+"/"+gsSet.ObjectMeta.Name))
+			return created, recycled
+		}
+
+		list := createGameServers(gsSet, 12)
+		for i := range list {
+			list[i].ObjectMeta.CreationTimestamp = metav1.NewTime(now.Add(-time.Minute))
+		}
+		list[3].ObjectMeta.CreationTimestamp = metav1.NewTime(now.Add(-2 * time.Hour))
+		list[7].ObjectMeta.CreationTimestamp = metav1.NewTime(now.Add(-3 * time.Hour))
+
+		// the replacements are created first
+		created, recycled := sync(list[:10])
+		assert.Equal(t, 2, created)
+		assert.Empty(t, recycled)
+
+		// nothing is shut down until the replacements are Ready
+		list[10].Status.State = agonesv1.GameServerStateStarting
+		created, recycled = sync(list)
+		assert.Equal(t, 0, created)
+		assert.Empty(t, recycled)
+
+		list[10].Status.State = agonesv1.GameServerStateReady
+		created, recycled = sync(list)
+		assert.Equal(t, 0, created)
+		assert.ElementsMatch(t, []string{"test-3", "test-7"}, recycled)
+
+		// gameservers restarted by their fleet are replaced first too
+		gsSet.Spec.MaxLifetime = nil
+		reason = agonesv1.ShutdownReasonRestart
+		list[5].ObjectMeta.Annotations = map[string]string{agonesv1.GameServerRestartAnnotation: "true"}
+		created, recycled = sync(list[:10])
+		assert.Equal(t, 1, created)
+		assert.Empty(t, recycled)
+
+		created, recycled = sync(list[:11])
+		assert.Equal(t, 0, created)
+		assert.Equal(t, []string{"test-5"}, recycled)
+	})
 }
 
 func TestControllerSyncUnhealthyGameServers(t *testing.T) {
@@ -478,6 +881,11 @@ func TestControllerSyncUnhealthyGameServers(t *testing.T) {
 		gs := ua.GetObject().(*agonesv1.GameServer)
 
 		assert.Equal(t, gs.Status.State, agonesv1.GameServerStateShutdown)
+		if gs.ObjectMeta.Name == "test-1" {
+			assert.Equal(t, agonesv1.ShutdownReasonUnhealthy, gs.ShutdownReason())
+		} else {
+			assert.Equal(t, agonesv1.ShutdownReasonScaleDown, gs.ShutdownReason())
+		}
 
 		updatedCount++
 		return true, nil, nil
@@ -490,6 +898,87 @@ func TestControllerSyncUnhealthyGameServers(t *testing.T) {
 	assert.Nil(t, err)
 
 	assert.Equal(t, 3, updatedCount, "Updates should have occurred")
+	agtesting.AssertEventContains(t, m.FakeRecorder.Events, "Unhealthy")
+}
+
+func TestControllerDeleteGameServersRollout(t *testing.T) {
+	f := &agonesv1.Fleet{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "fleet", UID: "1234"},
+		Spec: agonesv1.FleetSpec{
+			Replicas: 5,
+			Template: agonesv1.GameServerTemplateSpec{
+				Spec: agonesv1.GameServerSpec{
+					Ports: []agonesv1.GameServerPort{{ContainerPort: 7654}},
+				},
+			},
+		},
+	}
+	gsSet := f.GameServerSet()
+	gsSet.ObjectMeta.Name = "fleet-1"
+	gsSet.ObjectMeta.UID = "4321"
+	// the fleet template has been updated since the GameServerSet was created
+	f.Spec.Template.Spec.Ports = []agonesv1.GameServerPort{{ContainerPort: 7777}}
+
+	gs := gsSet.GameServer()
+	gs.ObjectMeta.Name = "test-1"
+	gs.Status = agonesv1.GameServerStatus{State: agonesv1.GameServerStateReady}
+
+	var updated *agonesv1.GameServer
+
+	c, m := newFakeController()
+	m.AgonesClient.AddReactor("list", "fleets", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, &agonesv1.FleetList{Items: []agonesv1.Fleet{*f}}, nil
+	})
+	m.AgonesClient.AddReactor("update", "gameservers", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		ua := action.(k8stesting.UpdateAction)
+		updated = ua.GetObject().(*agonesv1.GameServer)
+		return true, updated, nil
+	})
+
+	_, cancel := agtesting.StartInformers(m, c.fleetSynced)
+	defer cancel()
+
+	err := c.deleteGameServers(gsSet, []*agonesv1.GameServer{gs})
+	assert.Nil(t, err)
+
+	if assert.NotNil(t, updated) {
+		assert.Equal(t, agonesv1.GameServerStateShutdown, updated.Status.State)
+		assert.Equal(t, agonesv1.ShutdownReasonRollout, updated.ShutdownReason())
+	}
+	agtesting.AssertEventContains(t, m.FakeRecorder.Events, "Rollout")
+}
+
+func TestFleetTemplate(t *testing.T) {
+	t.Parallel()
+
+	f := &agonesv1.Fleet{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "fleet", UID: "1234"},
+		Spec: agonesv1.FleetSpec{
+			Replicas: 4,
+			Template: agonesv1.GameServerTemplateSpec{
+				Spec: agonesv1.GameServerSpec{
+					Ports: []agonesv1.GameServerPort{{ContainerPort: 7654}},
+				},
+			},
+		},
+	}
+	gsSet := f.GameServerSet()
+	assert.Equal(t, &f.Spec.Template, fleetTemplate(f, gsSet))
+
+	f.Spec.NodePools = []agonesv1.FleetNodePool{
+		{Name: "a", Weight: 1, NodeSelector: map[string]string{"pool": "a"}},
+		{Name: "b", Weight: 1, NodeSelector: map[string]string{"pool": "b"}},
+	}
+	poolSet := f.NodePoolFleet(1).GameServerSet()
+	poolSet.ObjectMeta.Labels[agonesv1.FleetNodePoolLabel] = "b"
+	// the GameServerSet of a node pool has the template of the node pool, so it is not rolling out
+	assert.Equal(t, &poolSet.Spec.Template, fleetTemplate(f, poolSet))
+	assert.NotEqual(t, &f.Spec.Template, fleetTemplate(f, poolSet))
+
+	// GameServerSets of removed node pools, or from before the Fleet had node pools, are rolling out
+	poolSet.ObjectMeta.Labels[agonesv1.FleetNodePoolLabel] = "c"
+	assert.Nil(t, fleetTemplate(f, poolSet))
+	assert.Nil(t, fleetTemplate(f, gsSet))
 }
 
 func TestSyncMoreGameServers(t *testing.T) {
@@ -518,6 +1007,278 @@ func TestSyncMoreGameServers(t *testing.T) {
 	agtesting.AssertEventContains(t, m.FakeRecorder.Events, "SuccessfulCreate")
 }
 
+func TestSyncMoreGameServersNaming(t *testing.T) {
+	gsSet := defaultFixture()
+	gsSet.Spec.Naming = &agonesv1.GameServerNaming{Prefix: "shard-", Digits: 4}
+
+	c, m := newFakeController()
+	var mu sync.Mutex
+	var names []string
+
+	existing := []agonesv1.GameServer{
+		{ObjectMeta: metav1.ObjectMeta{Name: "shard-0001", Namespace: gsSet.ObjectMeta.Namespace}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "shard-0003", Namespace: gsSet.ObjectMeta.Namespace}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "other-0000", Namespace: gsSet.ObjectMeta.Namespace}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "shard-0000", Namespace: "other"}},
+	}
+	m.AgonesClient.AddReactor("list", "gameservers", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, &agonesv1.GameServerList{Items: existing}, nil
+	})
+	m.AgonesClient.AddReactor("create", "gameservers", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		gs := action.(k8stesting.CreateAction).GetObject().(*agonesv1.GameServer)
+		assert.True(t, metav1.IsControlledBy(gs, gsSet))
+		mu.Lock()
+		names = append(names, gs.ObjectMeta.Name)
+		mu.Unlock()
+		return true, gs, nil
+	})
+
+	_, cancel := agtesting.StartInformers(m, c.gameServerSynced)
+	defer cancel()
+
+	assert.Nil(t, c.addMoreGameServers(gsSet, 3))
+	sort.Strings(names)
+	assert.Equal(t, []string{"shard-0000", "shard-0002", "shard-0004"}, names)
+
+	// the GameServers pending creation are not reused
+	names = nil
+	assert.Nil(t, c.addMoreGameServers(gsSet, 2))
+	sort.Strings(names)
+	assert.Equal(t, []string{"shard-0005", "shard-0006"}, names)
+}
+
+func TestSyncMoreGameServersOrdinalNaming(t *testing.T) {
+	gsSet := defaultFixture()
+	gsSet.Spec.Replicas = 3
+	gsSet.Spec.Naming = &agonesv1.GameServerNaming{Prefix: "shard-", Ordinal: true}
+
+	c, m := newFakeController()
+	var mu sync.Mutex
+	var names []string
+
+	m.AgonesClient.AddReactor("list", "gameservers", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, &agonesv1.GameServerList{Items: []agonesv1.GameServer{
+			{ObjectMeta: metav1.ObjectMeta{Name: "shard-1", Namespace: gsSet.ObjectMeta.Namespace}},
+		}}, nil
+	})
+	m.AgonesClient.AddReactor("create", "gameservers", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		gs := action.(k8stesting.CreateAction).GetObject().(*agonesv1.GameServer)
+		mu.Lock()
+		names = append(names, gs.ObjectMeta.Name)
+		mu.Unlock()
+		return true, gs, nil
+	})
+
+	_, cancel := agtesting.StartInformers(m, c.gameServerSynced)
+	defer cancel()
+
+	// only the indices lower than the replicas are created
+	assert.Nil(t, c.addMoreGameServers(gsSet, 3))
+	sort.Strings(names)
+	assert.Equal(t, []string{"shard-0", "shard-2"}, names)
+}
+
+func TestSyncMoreGameServersNamingConflict(t *testing.T) {
+	gsSet := defaultFixture()
+	gsSet.Spec.Naming = &agonesv1.GameServerNaming{Prefix: "shard-"}
+
+	c, m := newFakeController()
+	var mu sync.Mutex
+	var names []string
+
+	// another GameServerSet with the same Naming has just created shard-0 and shard-1
+	taken := map[string]bool{"shard-0": true, "shard-1": true}
+	m.AgonesClient.AddReactor("create", "gameservers", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		gs := action.(k8stesting.CreateAction).GetObject().(*agonesv1.GameServer)
+		mu.Lock()
+		defer mu.Unlock()
+		if taken[gs.ObjectMeta.Name] {
+			return true, nil, k8serrors.NewAlreadyExists(agonesv1.Resource("gameservers"), gs.ObjectMeta.Name)
+		}
+		names = append(names, gs.ObjectMeta.Name)
+		return true, gs, nil
+	})
+
+	_, cancel := agtesting.StartInformers(m, c.gameServerSynced)
+	defer cancel()
+
+	// the names that are taken are retried with the next indices, and are not API failures
+	assert.Nil(t, c.addMoreGameServers(gsSet, 2))
+	sort.Strings(names)
+	assert.Equal(t, []string{"shard-2", "shard-3"}, names)
+	assert.Equal(t, 0, c.stateCache.forGameServerSet(gsSet).consecutiveFailures)
+}
+
+func TestSyncMoreGameServersSummarizedFleetEvents(t *testing.T) {
+	gsSet := defaultFixture()
+	gsSet.ObjectMeta.Labels = map[string]string{agonesv1.FleetNameLabel: "fleet"}
+
+	c, m := newFakeController()
+	c.summarizeFleetEvents = true
+	count := 0
+
+	m.AgonesClient.AddReactor("create", "gameservers", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		count++
+		return true, action.(k8stesting.CreateAction).GetObject(), nil
+	})
+
+	_, cancel := agtesting.StartInformers(m)
+	defer cancel()
+
+	err := c.addMoreGameServers(gsSet, 5)
+	assert.Nil(t, err)
+	assert.Equal(t, 5, count)
+	agtesting.AssertNoEvent(t, m.FakeRecorder.Events)
+}
+
+func TestSyncMoreGameServersPartialFailure(t *testing.T) {
+	gsSet := defaultFixture()
+
+	c, m := newFakeController()
+	var mu sync.Mutex
+	count := 0
+
+	m.AgonesClient.AddReactor("create", "gameservers", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		ca := action.(k8stesting.CreateAction)
+		gs := ca.GetObject().(*agonesv1.GameServer)
+
+		mu.Lock()
+		defer mu.Unlock()
+		count++
+		switch {
+		case count <= 3:
+			return true, nil, k8serrors.NewForbidden(agonesv1.Resource("gameservers"), gs.ObjectMeta.Name,
+				errors.New("exceeded quota: compute-resources"))
+		case count <= 5:
+			return true, nil, k8serrors.NewInvalid(agonesv1.Kind("GameServer"), gs.ObjectMeta.Name, nil)
+		}
+		return true, gs, nil
+	})
+
+	_, cancel := agtesting.StartInformers(m)
+	defer cancel()
+
+	err := c.addMoreGameServers(gsSet, 10)
+	assert.NotNil(t, err)
+	agg, ok := err.(utilerrors.Aggregate)
+	if assert.True(t, ok) {
+		assert.Len(t, agg.Errors(), 5)
+	}
+	// every game server was attempted, even after the first failure
+	assert.Equal(t, 10, count)
+
+	events := map[string]int{}
+	for i := 0; i < 7; i++ {
+		e := <-m.FakeRecorder.Events
+		for _, reason := range []string{"SuccessfulCreate", "FailedCreateQuotaExceeded", "FailedCreateInvalid"} {
+			if strings.Contains(e, " "+reason+" ") {
+				events[reason]++
+			}
+		}
+	}
+	assert.Equal(t, map[string]int{"SuccessfulCreate": 5, "FailedCreateQuotaExceeded": 1, "FailedCreateInvalid": 1}, events)
+}
+
+func TestSyncMoreGameServersCircuitBreaker(t *testing.T) {
+	gsSet := defaultFixture()
+
+	c, m := newFakeController()
+	fc := clock.NewFakeClock(time.Now())
+	c.clock = fc
+	var mu sync.Mutex
+	count := 0
+
+	m.AgonesClient.AddReactor("create", "gameservers", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		count++
+		return true, nil, k8serrors.NewServiceUnavailable("etcd is unavailable")
+	})
+
+	_, cancel := agtesting.StartInformers(m)
+	defer cancel()
+
+	err := c.addMoreGameServers(gsSet, 100)
+	assert.NotNil(t, err)
+	// calls already in flight when the circuit breaker trips still fail, but no more are made
+	assert.True(t, count >= maxConsecutiveAPIFailures, "count: %d", count)
+	assert.True(t, count < maxConsecutiveAPIFailures+maxCreationParalellism, "count: %d", count)
+	agtesting.AssertEventContains(t, m.FakeRecorder.Events, "Backoff")
+
+	entry := c.stateCache.forGameServerSet(gsSet)
+	assert.Equal(t, apiFailureCooldown, entry.backoffRemaining(fc.Now()))
+
+	t.Run("no calls during backoff", func(t *testing.T) {
+		count = 0
+		assert.Nil(t, c.addMoreGameServers(gsSet, 10))
+		assert.Equal(t, 0, count)
+	})
+
+	t.Run("backoff condition", func(t *testing.T) {
+		conditions := c.backoffConditions(gsSet)
+		if assert.Len(t, conditions, 1) {
+			assert.Equal(t, agonesv1.GameServerSetBackoff, conditions[0].Type)
+			assert.Equal(t, corev1.ConditionTrue, conditions[0].Status)
+			assert.Equal(t, "ConsecutiveAPIFailures", conditions[0].Reason)
+		}
+
+		gsSet.Status.Conditions = conditions
+		fc.Step(apiFailureCooldown)
+		conditions = c.backoffConditions(gsSet)
+		if assert.Len(t, conditions, 1) {
+			assert.Equal(t, corev1.ConditionFalse, conditions[0].Status)
+			assert.Equal(t, "CooldownExpired", conditions[0].Reason)
+		}
+		// the condition on the original GameServerSet is not modified
+		assert.Equal(t, corev1.ConditionTrue, gsSet.Status.Conditions[0].Status)
+	})
+}
+
+func TestFailureReason(t *testing.T) {
+	t.Parallel()
+
+	resource := agonesv1.Resource("gameservers")
+	fixtures := map[string]struct {
+		err      error
+		expected string
+	}{
+		"quota": {
+			err:      k8serrors.NewForbidden(resource, "gs", errors.New(`exceeded quota: pods, requested: pods=1, used: pods=10, limited: pods=10`)),
+			expected: "QuotaExceeded",
+		},
+		"webhook": {
+			err:      k8serrors.NewForbidden(resource, "gs", errors.New(`admission webhook "validate.example.com" denied the request`)),
+			expected: "WebhookDenied",
+		},
+		"invalid": {
+			err:      k8serrors.NewInvalid(agonesv1.Kind("GameServer"), "gs", nil),
+			expected: "Invalid",
+		},
+		"conflict": {
+			err:      k8serrors.NewConflict(resource, "gs", errors.New("conflict")),
+			expected: "Conflict",
+		},
+		"already exists": {
+			err:      k8serrors.NewAlreadyExists(resource, "gs"),
+			expected: "AlreadyExists",
+		},
+		"wrapped": {
+			err:      errors.Wrap(k8serrors.NewInvalid(agonesv1.Kind("GameServer"), "gs", nil), "error creating gameserver"),
+			expected: "Invalid",
+		},
+		"other": {
+			err:      errors.New("something went wrong"),
+			expected: "",
+		},
+	}
+
+	for k, v := range fixtures {
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, v.expected, failureReason(v.err))
+		})
+	}
+}
+
 func TestControllerSyncGameServerSetStatus(t *testing.T) {
 	t.Parallel()
 
@@ -577,6 +1338,30 @@ func TestControllerSyncGameServerSetStatus(t *testing.T) {
 	})
 }
 
+func TestControllerPausedConditions(t *testing.T) {
+	t.Parallel()
+
+	c, _ := newFakeController()
+	gsSet := defaultFixture()
+	assert.Empty(t, c.pausedConditions(gsSet, nil))
+
+	gsSet.Spec.Paused = true
+	conditions := c.pausedConditions(gsSet, nil)
+	if assert.Len(t, conditions, 1) {
+		assert.Equal(t, agonesv1.GameServerSetPaused, conditions[0].Type)
+		assert.Equal(t, corev1.ConditionTrue, conditions[0].Status)
+		assert.Equal(t, "Paused", conditions[0].Reason)
+	}
+	assert.Equal(t, conditions, c.pausedConditions(gsSet, conditions))
+
+	gsSet.Spec.Paused = false
+	conditions = c.pausedConditions(gsSet, conditions)
+	if assert.Len(t, conditions, 1) {
+		assert.Equal(t, corev1.ConditionFalse, conditions[0].Status)
+		assert.Equal(t, "Resumed", conditions[0].Reason)
+	}
+}
+
 func TestControllerUpdateValidationHandler(t *testing.T) {
 	t.Parallel()
 
@@ -678,7 +1463,7 @@ func newFakeController() (*Controller, agtesting.Mocks) {
 	m := agtesting.NewMocks()
 	wh := webhooks.NewWebHook(http.NewServeMux())
 	counter := gameservers.NewPerNodeCounter(m.KubeInformerFactory, m.AgonesInformerFactory)
-	c := NewController(wh, healthcheck.NewHandler(), counter, m.KubeClient, m.ExtClient, m.AgonesClient, m.AgonesInformerFactory)
+	c := NewController(wh, healthcheck.NewHandler(), counter, false, 0, m.KubeClient, m.KubeInformerFactory, m.ExtClient, m.AgonesClient, m.AgonesInformerFactory)
 	c.recorder = m.FakeRecorder
 	return c, m
 }
@@ -16,16 +16,22 @@ package gameserversets
 
 import (
 	"sync"
+	"time"
 
 	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // gameServerSetCacheEntry manages a list of items created and deleted locally for a single game server set.
+// It also acts as a circuit breaker for the Kubernetes API calls made for the game server set.
 type gameServerSetCacheEntry struct {
 	mu              sync.Mutex
 	pendingCreation map[string]*agonesv1.GameServer
 	pendingDeletion map[string]*agonesv1.GameServer
+	// consecutiveFailures is the number of API calls for the game server set that have failed in a row
+	consecutiveFailures int
+	// backoffUntil is the time until which no further API calls should be made for the game server set
+	backoffUntil time.Time
 }
 
 func (e *gameServerSetCacheEntry) created(gs *agonesv1.GameServer) {
@@ -54,6 +60,39 @@ func (e *gameServerSetCacheEntry) deleted(gs *agonesv1.GameServer) {
 	e.pendingDeletion[gs.Name] = gsClone
 }
 
+// apiSucceeded resets the count of consecutive API failures.
+func (e *gameServerSetCacheEntry) apiSucceeded() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.consecutiveFailures = 0
+}
+
+// apiFailed records an API failure, and starts a backoff of the given cooldown once maxFailures
+// consecutive failures have been recorded. Returns true if a new backoff was started.
+// The count is not reset when a backoff starts, so after the cooldown a single further failure
+// starts the next backoff, while a success closes the circuit again.
+func (e *gameServerSetCacheEntry) apiFailed(now time.Time, maxFailures int, cooldown time.Duration) bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.consecutiveFailures++
+	if e.consecutiveFailures < maxFailures || now.Before(e.backoffUntil) {
+		return false
+	}
+	e.backoffUntil = now.Add(cooldown)
+	return true
+}
+
+// backoffRemaining returns how long until API calls for the game server set can be made again,
+// or zero if they can be made now.
+func (e *gameServerSetCacheEntry) backoffRemaining(now time.Time) time.Duration {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if remaining := e.backoffUntil.Sub(now); remaining > 0 {
+		return remaining
+	}
+	return 0
+}
+
 // reconcileWithUpdatedServerList returns a list of game servers for a game server set taking into account
 // the complete list of game servers passed as parameter and a list of pending creations and deletions.
 func (e *gameServerSetCacheEntry) reconcileWithUpdatedServerList(list []*agonesv1.GameServer) []*agonesv1.GameServer {
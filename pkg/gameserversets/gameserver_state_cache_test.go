@@ -17,6 +17,7 @@ package gameserversets
 import (
 	"sort"
 	"testing"
+	"time"
 
 	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
 	"github.com/stretchr/testify/assert"
@@ -210,3 +211,38 @@ func sortedStatusInfo(list []*agonesv1.GameServer) []gameServerStatusInfo {
 	})
 	return result
 }
+
+func TestGameServerSetCacheEntryCircuitBreaker(t *testing.T) {
+	t.Parallel()
+
+	var e gameServerSetCacheEntry
+	now := time.Now()
+	cooldown := 30 * time.Second
+
+	assert.Equal(t, time.Duration(0), e.backoffRemaining(now))
+
+	assert.False(t, e.apiFailed(now, 3, cooldown))
+	assert.False(t, e.apiFailed(now, 3, cooldown))
+	e.apiSucceeded()
+	assert.False(t, e.apiFailed(now, 3, cooldown))
+	assert.False(t, e.apiFailed(now, 3, cooldown))
+	assert.Equal(t, time.Duration(0), e.backoffRemaining(now))
+
+	assert.True(t, e.apiFailed(now, 3, cooldown))
+	assert.Equal(t, cooldown, e.backoffRemaining(now))
+	// failures during the backoff don't extend it
+	assert.False(t, e.apiFailed(now.Add(time.Second), 3, cooldown))
+	assert.Equal(t, cooldown-time.Second, e.backoffRemaining(now.Add(time.Second)))
+
+	// after the cooldown, a single failure starts the next backoff
+	later := now.Add(cooldown)
+	assert.Equal(t, time.Duration(0), e.backoffRemaining(later))
+	assert.True(t, e.apiFailed(later, 3, cooldown))
+	assert.Equal(t, cooldown, e.backoffRemaining(later))
+
+	// while a success closes the circuit again
+	later = later.Add(cooldown)
+	e.apiSucceeded()
+	assert.False(t, e.apiFailed(later, 3, cooldown))
+	assert.Equal(t, time.Duration(0), e.backoffRemaining(later))
+}
@@ -25,6 +25,47 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 )
 
+// SortGameServersForScaleDown sorts the list of gameservers in the order that they are deleted:
+// by lowest deletion cost first, and then in the order of the given scale down strategy, and returns them
+func SortGameServersForScaleDown(strategy agonesv1.ScaleDownStrategy, list []*agonesv1.GameServer, count map[string]gameservers.NodeCount) []*agonesv1.GameServer {
+	switch strategy {
+	case agonesv1.PackedScaleDown:
+		list = sortGameServersByLeastFullNodes(list, count)
+	case agonesv1.DistributedScaleDown:
+		list = sortGameServersByMostFullNodes(list, count)
+	case agonesv1.NewestFirstScaleDown:
+		list = sortGameServersByNewestFirst(list)
+	default:
+		list = sortGameServersByOldestFirst(list)
+	}
+	return sortGameServersByDeletionCost(list)
+}
+
+// sortGameServersForReplacement sorts the list of Unhealthy and Error gameservers in the order that they are
+// replaced: the gameservers that were Allocated or Reserved when they became Unhealthy first, as they are the
+// ones that had players on them, keeping the current order otherwise, and returns them
+func sortGameServersForReplacement(list []*agonesv1.GameServer) []*agonesv1.GameServer {
+	inUse := func(gs *agonesv1.GameServer) bool {
+		from := agonesv1.GameServerState(gs.ObjectMeta.Annotations[agonesv1.GameServerUnhealthyFromAnnotation])
+		return from == agonesv1.GameServerStateAllocated || from == agonesv1.GameServerStateReserved
+	}
+	sort.SliceStable(list, func(i, j int) bool {
+		return inUse(list[i]) && !inUse(list[j])
+	})
+
+	return list
+}
+
+// sortGameServersByDeletionCost sorts the list of gameservers by lowest deletion cost first,
+// keeping the current order of gameservers with the same deletion cost, and returns them
+func sortGameServersByDeletionCost(list []*agonesv1.GameServer) []*agonesv1.GameServer {
+	sort.SliceStable(list, func(i, j int) bool {
+		return list[i].DeletionCost() < list[j].DeletionCost()
+	})
+
+	return list
+}
+
 // sortGameServersByLeastFullNodes sorts the list of gameservers by which gameservers reside on the least full nodes
 func sortGameServersByLeastFullNodes(list []*agonesv1.GameServer, count map[string]gameservers.NodeCount) []*agonesv1.GameServer {
 	sort.Slice(list, func(i, j int) bool {
@@ -47,8 +88,30 @@ func sortGameServersByLeastFullNodes(list []*agonesv1.GameServer, count map[stri
 	return list
 }
 
-// sortGameServersByNewFirst sorts by newest gameservers first, and returns them
-func sortGameServersByNewFirst(list []*agonesv1.GameServer) []*agonesv1.GameServer {
+// sortGameServersByMostFullNodes sorts the list of gameservers by which gameservers reside on the most full nodes
+func sortGameServersByMostFullNodes(list []*agonesv1.GameServer, count map[string]gameservers.NodeCount) []*agonesv1.GameServer {
+	sort.Slice(list, func(i, j int) bool {
+		a := list[i]
+		b := list[j]
+		// not scheduled yet/node deleted, put them first
+		ac, ok := count[a.Status.NodeName]
+		if !ok {
+			return true
+		}
+
+		bc, ok := count[b.Status.NodeName]
+		if !ok {
+			return false
+		}
+
+		return (ac.Allocated + ac.Ready) > (bc.Allocated + bc.Ready)
+	})
+
+	return list
+}
+
+// sortGameServersByOldestFirst sorts by oldest gameservers first, and returns them
+func sortGameServersByOldestFirst(list []*agonesv1.GameServer) []*agonesv1.GameServer {
 	sort.Slice(list, func(i, j int) bool {
 		a := list[i]
 		b := list[j]
@@ -59,6 +122,18 @@ func sortGameServersByNewFirst(list []*agonesv1.GameServer) []*agonesv1.GameServ
 	return list
 }
 
+// sortGameServersByNewestFirst sorts by newest gameservers first, and returns them
+func sortGameServersByNewestFirst(list []*agonesv1.GameServer) []*agonesv1.GameServer {
+	sort.Slice(list, func(i, j int) bool {
+		a := list[i]
+		b := list[j]
+
+		return b.ObjectMeta.CreationTimestamp.Before(&a.ObjectMeta.CreationTimestamp)
+	})
+
+	return list
+}
+
 // ListGameServersByGameServerSetOwner lists the GameServers for a given GameServerSet
 func ListGameServersByGameServerSetOwner(gameServerLister listerv1.GameServerLister,
 	gsSet *agonesv1.GameServerSet) ([]*agonesv1.GameServer, error) {
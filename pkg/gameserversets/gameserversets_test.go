@@ -50,7 +50,29 @@ func TestSortGameServersByLeastFullNodes(t *testing.T) {
 	assert.Equal(t, "g1", result[2].ObjectMeta.Name)
 }
 
-func TestSortGameServersByNewFirst(t *testing.T) {
+func TestSortGameServersByMostFullNodes(t *testing.T) {
+	t.Parallel()
+
+	nc := map[string]gameservers.NodeCount{
+		"n1": {Ready: 1, Allocated: 0},
+		"n2": {Ready: 0, Allocated: 2},
+	}
+
+	list := []*agonesv1.GameServer{
+		{ObjectMeta: metav1.ObjectMeta{Name: "g1"}, Status: agonesv1.GameServerStatus{NodeName: "n1"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "g2"}, Status: agonesv1.GameServerStatus{NodeName: ""}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "g3"}, Status: agonesv1.GameServerStatus{NodeName: "n2"}},
+	}
+
+	result := sortGameServersByMostFullNodes(list, nc)
+
+	assert.Len(t, result, len(list))
+	assert.Equal(t, "g2", result[0].ObjectMeta.Name)
+	assert.Equal(t, "g3", result[1].ObjectMeta.Name)
+	assert.Equal(t, "g1", result[2].ObjectMeta.Name)
+}
+
+func TestSortGameServersByOldestFirst(t *testing.T) {
 	now := metav1.Now()
 
 	list := []*agonesv1.GameServer{
@@ -60,11 +82,43 @@ func TestSortGameServersByNewFirst(t *testing.T) {
 	}
 	l := len(list)
 
-	result := sortGameServersByNewFirst(list)
+	result := sortGameServersByOldestFirst(list)
 	assert.Len(t, result, l)
 	assert.Equal(t, "g2", result[0].ObjectMeta.Name)
 	assert.Equal(t, "g1", result[1].ObjectMeta.Name)
 	assert.Equal(t, "g3", result[2].ObjectMeta.Name)
+
+	result = sortGameServersByNewestFirst(list)
+	assert.Len(t, result, l)
+	assert.Equal(t, "g3", result[0].ObjectMeta.Name)
+	assert.Equal(t, "g1", result[1].ObjectMeta.Name)
+	assert.Equal(t, "g2", result[2].ObjectMeta.Name)
+}
+
+func TestSortGameServersForScaleDownDeletionCost(t *testing.T) {
+	t.Parallel()
+
+	now := metav1.Now()
+	cost := func(value string) map[string]string {
+		return map[string]string{agonesv1.GameServerDeletionCostAnnotation: value}
+	}
+
+	list := []*agonesv1.GameServer{
+		{ObjectMeta: metav1.ObjectMeta{Name: "g1", CreationTimestamp: now, Annotations: cost("100")}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "g2", CreationTimestamp: metav1.Time{Time: now.Add(10 * time.Second)}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "g3", CreationTimestamp: metav1.Time{Time: now.Add(20 * time.Second)}, Annotations: cost("-5")}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "g4", CreationTimestamp: metav1.Time{Time: now.Add(30 * time.Second)},
+			Annotations: map[string]string{agonesv1.GameServerSDKDeletionCostAnnotation: "50"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "g5", CreationTimestamp: metav1.Time{Time: now.Add(40 * time.Second)}}},
+	}
+
+	result := SortGameServersForScaleDown(agonesv1.OldestFirstScaleDown, list, nil)
+	assert.Len(t, result, 5)
+	var names []string
+	for _, gs := range result {
+		names = append(names, gs.ObjectMeta.Name)
+	}
+	assert.Equal(t, []string{"g3", "g2", "g5", "g4", "g1"}, names)
 }
 
 func TestListGameServersByGameServerSetOwner(t *testing.T) {
@@ -0,0 +1,83 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gameserversets
+
+import (
+	"sort"
+	"time"
+
+	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
+)
+
+// recyclableGameServers returns the Ready GameServers of the GameServerSet that are older than its MaxLifetime,
+// or that are restarted by their Fleet, oldest first and no more than can be created in a batch,
+// as they are replaced before they are shut down
+func recyclableGameServers(gsSet *agonesv1.GameServerSet, list []*agonesv1.GameServer, now time.Time) []*agonesv1.GameServer {
+	var expired []*agonesv1.GameServer
+	for _, gs := range list {
+		if gs.IsRestartPending() || (gsSet.Spec.MaxLifetime != nil && isExpired(gs, gsSet.Spec.MaxLifetime.Duration, now)) {
+			expired = append(expired, gs)
+		}
+	}
+	sort.SliceStable(expired, func(i, j int) bool {
+		return expired[i].ObjectMeta.CreationTimestamp.Before(&expired[j].ObjectMeta.CreationTimestamp)
+	})
+	if len(expired) > maxGameServerCreationsPerBatch {
+		expired = expired[:maxGameServerCreationsPerBatch]
+	}
+	return expired
+}
+
+// nextExpiry returns how long it is until the next Ready GameServer of the GameServerSet is older than
+// its MaxLifetime, or 0 if there is none that isn't already
+func nextExpiry(gsSet *agonesv1.GameServerSet, list []*agonesv1.GameServer, now time.Time) time.Duration {
+	if gsSet.Spec.MaxLifetime == nil {
+		return 0
+	}
+
+	var next time.Duration
+	for _, gs := range list {
+		if gs.Status.State != agonesv1.GameServerStateReady || gs.IsBeingDeleted() {
+			continue
+		}
+		if d := gs.ObjectMeta.CreationTimestamp.Add(gsSet.Spec.MaxLifetime.Duration).Sub(now); d > 0 && (next == 0 || d < next) {
+			next = d
+		}
+	}
+	return next
+}
+
+// isExpired returns true if the GameServer is Ready, has never been Allocated, and was created more than maxLifetime ago
+func isExpired(gs *agonesv1.GameServer, maxLifetime time.Duration, now time.Time) bool {
+	_, allocated := gs.ObjectMeta.Annotations[agonesv1.GameServerAllocatedAnnotation]
+	return gs.Status.State == agonesv1.GameServerStateReady && !gs.IsBeingDeleted() && !allocated &&
+		now.Sub(gs.ObjectMeta.CreationTimestamp.Time) >= maxLifetime
+}
+
+// availableCount returns the number of GameServers that are Ready, Reserved or Allocated
+// and are not being deleted
+func availableCount(list []*agonesv1.GameServer) int {
+	count := 0
+	for _, gs := range list {
+		if gs.IsBeingDeleted() {
+			continue
+		}
+		switch gs.Status.State {
+		case agonesv1.GameServerStateReady, agonesv1.GameServerStateReserved, agonesv1.GameServerStateAllocated:
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,63 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gameserversets
+
+import (
+	"testing"
+	"time"
+
+	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestRecyclableGameServers(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	gsSet := defaultFixture()
+	list := createGameServers(gsSet, 7)
+	var gsList []*agonesv1.GameServer
+	for i := range list {
+		list[i].ObjectMeta.CreationTimestamp = metav1.NewTime(now.Add(-time.Duration(i) * time.Hour))
+		gsList = append(gsList, &list[i])
+	}
+	list[4].Status.State = agonesv1.GameServerStateAllocated
+	deleted := metav1.NewTime(now)
+	list[5].ObjectMeta.DeletionTimestamp = &deleted
+	// Ready again after it was Allocated
+	list[6].ObjectMeta.Annotations = map[string]string{agonesv1.GameServerAllocatedAnnotation: "true"}
+
+	assert.Empty(t, recyclableGameServers(gsSet, gsList, now))
+	assert.Equal(t, time.Duration(0), nextExpiry(gsSet, gsList, now))
+
+	gsSet.Spec.MaxLifetime = &metav1.Duration{Duration: 2 * time.Hour}
+	var names []string
+	for _, gs := range recyclableGameServers(gsSet, gsList, now) {
+		names = append(names, gs.ObjectMeta.Name)
+	}
+	assert.Equal(t, []string{"test-3", "test-2"}, names)
+	assert.Equal(t, time.Hour, nextExpiry(gsSet, gsList, now))
+	assert.Equal(t, 6, availableCount(gsList))
+
+	gsSet.Spec.MaxLifetime = nil
+	list[0].ObjectMeta.Annotations = map[string]string{agonesv1.GameServerRestartAnnotation: "true"}
+	list[4].ObjectMeta.Annotations = map[string]string{agonesv1.GameServerRestartAnnotation: "true"}
+	names = nil
+	for _, gs := range recyclableGameServers(gsSet, gsList, now) {
+		names = append(names, gs.ObjectMeta.Name)
+	}
+	assert.Equal(t, []string{"test-0"}, names)
+}
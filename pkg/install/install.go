@@ -0,0 +1,273 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package install
+
+import (
+	"strings"
+
+	"agones.dev/agones/pkg/util/crd"
+	"agones.dev/agones/pkg/util/runtime"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	apiv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+	extclientset "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	extv1beta1 "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/typed/apiextensions/v1beta1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+// Installer creates and verifies the objects of an install manifest in a cluster.
+// Objects of any kind the cluster serves are supported, including APIServices and
+// webhook configurations, as the resource of each kind is found through discovery.
+type Installer struct {
+	discovery discovery.DiscoveryInterface
+	client    rest.Interface
+	crdGetter extv1beta1.CustomResourceDefinitionsGetter
+	resources map[string]*metav1.APIResourceList
+	logger    *logrus.Entry
+}
+
+// NewInstaller returns an Installer for the cluster of the given clientsets
+func NewInstaller(kubeClient kubernetes.Interface, extClient extclientset.Interface) *Installer {
+	i := &Installer{
+		discovery: kubeClient.Discovery(),
+		client:    kubeClient.Discovery().RESTClient(),
+		crdGetter: extClient.ApiextensionsV1beta1(),
+		resources: map[string]*metav1.APIResourceList{},
+	}
+	i.logger = runtime.NewLoggerWithType(i)
+	return i
+}
+
+// Install creates the namespaces of the objects that do not exist yet, then creates the objects in order,
+// patching the ones that already exist, and waits for the CustomResourceDefinitions to be established.
+// Objects that already exist are patched with the fields of the manifest, so Install can also upgrade Agones,
+// while the fields that are set by the cluster or the controller, such as the caBundle of a webhook, are kept.
+func (i *Installer) Install(objs []*unstructured.Unstructured) error {
+	objs, err := i.withNamespaces(objs)
+	if err != nil {
+		return err
+	}
+
+	var crds []string
+	for _, obj := range objs {
+		if err := i.apply(obj); err != nil {
+			return err
+		}
+		if isCRD(obj) {
+			crds = append(crds, obj.GetName())
+		}
+	}
+
+	for _, name := range crds {
+		if err := crd.WaitForEstablishedCRD(i.crdGetter.CustomResourceDefinitions(), name, i.logger); err != nil {
+			return errors.Wrapf(err, "error waiting for custom resource definition %s to be established", name)
+		}
+	}
+	return nil
+}
+
+// Verify checks that all the objects exist in the cluster, and that the CustomResourceDefinitions
+// are established. The returned error lists the objects that are missing or not established.
+func (i *Installer) Verify(objs []*unstructured.Unstructured) error {
+	var problems []string
+	for _, obj := range objs {
+		obj, err := i.normalize(obj)
+		if err != nil {
+			return err
+		}
+		segments, err := i.collection(obj)
+		if err != nil {
+			return err
+		}
+		existing, err := i.get(segments, obj.GetName())
+		if k8serrors.IsNotFound(err) {
+			problems = append(problems, describe(obj)+" is missing")
+			continue
+		}
+		if err != nil {
+			return errors.Wrapf(err, "error getting %s", describe(obj))
+		}
+		if isCRD(obj) && !isEstablished(existing) {
+			problems = append(problems, describe(obj)+" is not established")
+		}
+	}
+
+	if len(problems) > 0 {
+		return errors.Errorf("Agones is not installed: %s", strings.Join(problems, ", "))
+	}
+	return nil
+}
+
+// withNamespaces returns the normalized objects, preceded by the namespaces of the namespaced objects
+func (i *Installer) withNamespaces(objs []*unstructured.Unstructured) ([]*unstructured.Unstructured, error) {
+	var namespaces []*unstructured.Unstructured
+	seen := map[string]bool{}
+	result := make([]*unstructured.Unstructured, 0, len(objs))
+	for _, obj := range objs {
+		obj, err := i.normalize(obj)
+		if err != nil {
+			return nil, err
+		}
+		if ns := obj.GetNamespace(); ns != "" && !seen[ns] {
+			seen[ns] = true
+			namespace := &unstructured.Unstructured{}
+			namespace.SetAPIVersion("v1")
+			namespace.SetKind("Namespace")
+			namespace.SetName(ns)
+			namespaces = append(namespaces, namespace)
+		}
+		result = append(result, obj)
+	}
+	return append(namespaces, result...), nil
+}
+
+// normalize returns a copy of the object without a namespace if its resource is cluster scoped,
+// or in the default namespace if it is namespaced and has none
+func (i *Installer) normalize(obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	resource, err := i.resource(obj)
+	if err != nil {
+		return nil, err
+	}
+	obj = obj.DeepCopy()
+	if !resource.Namespaced {
+		obj.SetNamespace("")
+	} else if obj.GetNamespace() == "" {
+		obj.SetNamespace(metav1.NamespaceDefault)
+	}
+	return obj, nil
+}
+
+// apply creates the object, or patches it if it already exists. Namespaces that already exist are left as they are.
+// Objects are patched with a strategic merge patch, so that list items such as the webhooks of a webhook configuration
+// are merged by name, or with a JSON merge patch for the resources that don't support it, such as custom resources.
+func (i *Installer) apply(obj *unstructured.Unstructured) error {
+	logger := i.logger.WithField("object", describe(obj))
+	segments, err := i.collection(obj)
+	if err != nil {
+		return err
+	}
+
+	body, err := obj.MarshalJSON()
+	if err != nil {
+		return errors.Wrapf(err, "error encoding %s", describe(obj))
+	}
+	err = i.client.Post().AbsPath(segments...).Body(body).Do().Error()
+	if err == nil {
+		logger.Info("Created")
+		return nil
+	}
+	if !k8serrors.IsAlreadyExists(err) {
+		return errors.Wrapf(err, "error creating %s", describe(obj))
+	}
+	if obj.GetKind() == "Namespace" {
+		return nil
+	}
+
+	path := append(segments, obj.GetName())
+	err = i.client.Patch(types.StrategicMergePatchType).AbsPath(path...).Body(body).Do().Error()
+	if k8serrors.IsUnsupportedMediaType(err) {
+		err = i.client.Patch(types.MergePatchType).AbsPath(path...).Body(body).Do().Error()
+	}
+	if err != nil {
+		return errors.Wrapf(err, "error updating %s", describe(obj))
+	}
+	logger.Info("Updated")
+	return nil
+}
+
+// get returns the object with the given name, from the collection at the given path
+func (i *Installer) get(segments []string, name string) (*unstructured.Unstructured, error) {
+	raw, err := i.client.Get().AbsPath(append(segments, name)...).Do().Raw()
+	if err != nil {
+		return nil, err
+	}
+	obj := &unstructured.Unstructured{}
+	return obj, errors.Wrap(obj.UnmarshalJSON(raw), "error decoding object")
+}
+
+// collection returns the path segments of the collection of the object's resource
+func (i *Installer) collection(obj *unstructured.Unstructured) ([]string, error) {
+	resource, err := i.resource(obj)
+	if err != nil {
+		return nil, err
+	}
+	gv := obj.GroupVersionKind().GroupVersion()
+	segments := []string{"/apis", gv.Group, gv.Version}
+	if gv.Group == "" {
+		segments = []string{"/api", gv.Version}
+	}
+	if resource.Namespaced {
+		ns := obj.GetNamespace()
+		if ns == "" {
+			ns = metav1.NamespaceDefault
+		}
+		segments = append(segments, "namespaces", ns)
+	}
+	return append(segments, resource.Name), nil
+}
+
+// resource returns the resource of the object's kind, as served by the cluster
+func (i *Installer) resource(obj *unstructured.Unstructured) (metav1.APIResource, error) {
+	gvk := obj.GroupVersionKind()
+	gv := gvk.GroupVersion().String()
+	list, ok := i.resources[gv]
+	if !ok {
+		var err error
+		list, err = i.discovery.ServerResourcesForGroupVersion(gv)
+		if err != nil {
+			return metav1.APIResource{}, errors.Wrapf(err, "error discovering the resources of %s", gv)
+		}
+		i.resources[gv] = list
+	}
+
+	for _, r := range list.APIResources {
+		// skip subresources, such as deployments/scale, which can share the kind of their resource
+		if r.Kind == gvk.Kind && !strings.Contains(r.Name, "/") {
+			return r, nil
+		}
+	}
+	return metav1.APIResource{}, errors.Errorf("the cluster does not serve %s", gvk)
+}
+
+// isCRD returns true if the object is a CustomResourceDefinition
+func isCRD(obj *unstructured.Unstructured) bool {
+	return obj.GroupVersionKind().GroupKind() == apiv1beta1.Kind("CustomResourceDefinition")
+}
+
+// isEstablished returns true if the CustomResourceDefinition has the Established condition
+func isEstablished(obj *unstructured.Unstructured) bool {
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	for _, c := range conditions {
+		if cond, ok := c.(map[string]interface{}); ok &&
+			cond["type"] == string(apiv1beta1.Established) && cond["status"] == string(apiv1beta1.ConditionTrue) {
+			return true
+		}
+	}
+	return false
+}
+
+// describe returns the kind, namespace and name of the object, for errors and logs
+func describe(obj *unstructured.Unstructured) string {
+	if ns := obj.GetNamespace(); ns != "" {
+		return obj.GetKind() + " " + ns + "/" + obj.GetName()
+	}
+	return obj.GetKind() + " " + obj.GetName()
+}
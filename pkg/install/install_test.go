@@ -0,0 +1,401 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package install
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	admregv1beta1 "k8s.io/api/admissionregistration/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+	extfake "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/fake"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	"k8s.io/client-go/kubernetes"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+const manifest = `
+apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: agones-controller
+  namespace: agones-system
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: agones-controller
+  namespace: agones-system
+---
+apiVersion: apiextensions.k8s.io/v1beta1
+kind: CustomResourceDefinition
+metadata:
+  name: gameservers.agones.dev
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: agones-controller-service
+spec:
+  ports:
+  - port: 443
+---
+apiVersion: admissionregistration.k8s.io/v1beta1
+kind: ValidatingWebhookConfiguration
+metadata:
+  name: agones-validation-webhook
+webhooks:
+- name: validations.agones.dev
+  clientConfig:
+    service:
+      name: agones-controller-service
+      namespace: default
+      path: /validate
+`
+
+func TestInstallerInstall(t *testing.T) {
+	t.Parallel()
+
+	i, server, stop := newFakeInstaller(t)
+	defer stop()
+	objs, err := Decode(strings.NewReader(manifest))
+	assert.NoError(t, err)
+
+	assert.NoError(t, i.Install(objs))
+	assert.Equal(t, []string{
+		"POST /api/v1/namespaces",
+		"POST /api/v1/namespaces",
+		"POST /api/v1/namespaces/agones-system/serviceaccounts",
+		"POST /apis/rbac.authorization.k8s.io/v1/clusterroles",
+		"POST /apis/apiextensions.k8s.io/v1beta1/customresourcedefinitions",
+		"POST /api/v1/namespaces/default/services",
+		"POST /apis/admissionregistration.k8s.io/v1beta1/validatingwebhookconfigurations",
+	}, server.requests())
+	assert.Contains(t, server.objects, "/api/v1/namespaces/agones-system")
+	assert.Contains(t, server.objects, "/api/v1/namespaces/default")
+	assert.Equal(t, "", server.object("/apis/rbac.authorization.k8s.io/v1/clusterroles/agones-controller").GetNamespace())
+	// the objects passed in are not changed
+	assert.Equal(t, "agones-system", objs[1].GetNamespace())
+	assert.Equal(t, "", objs[3].GetNamespace())
+
+	// the controller sets the caBundle of the webhook
+	webhookKey := "/apis/admissionregistration.k8s.io/v1beta1/validatingwebhookconfigurations/agones-validation-webhook"
+	webhook := server.object(webhookKey)
+	webhooks, _, _ := unstructured.NestedSlice(webhook.Object, "webhooks")
+	assert.NoError(t, unstructured.SetNestedField(webhooks[0].(map[string]interface{}), "Y2E=", "clientConfig", "caBundle"))
+	assert.NoError(t, unstructured.SetNestedSlice(webhook.Object, webhooks, "webhooks"))
+	server.store(webhookKey, webhook)
+
+	// installing again patches the objects, keeping the fields set by the cluster
+	server.reset()
+	assert.NoError(t, i.Install(objs))
+	assert.Equal(t, []string{
+		"POST /api/v1/namespaces",
+		"POST /api/v1/namespaces",
+		"POST /api/v1/namespaces/agones-system/serviceaccounts",
+		"PATCH /api/v1/namespaces/agones-system/serviceaccounts/agones-controller",
+		"POST /apis/rbac.authorization.k8s.io/v1/clusterroles",
+		"PATCH /apis/rbac.authorization.k8s.io/v1/clusterroles/agones-controller",
+		"POST /apis/apiextensions.k8s.io/v1beta1/customresourcedefinitions",
+		// CustomResourceDefinitions don't support strategic merge patches
+		"PATCH /apis/apiextensions.k8s.io/v1beta1/customresourcedefinitions/gameservers.agones.dev",
+		"PATCH /apis/apiextensions.k8s.io/v1beta1/customresourcedefinitions/gameservers.agones.dev",
+		"POST /api/v1/namespaces/default/services",
+		"PATCH /api/v1/namespaces/default/services/agones-controller-service",
+		"POST /apis/admissionregistration.k8s.io/v1beta1/validatingwebhookconfigurations",
+		"PATCH " + webhookKey,
+	}, server.requests())
+	service := server.object("/api/v1/namespaces/default/services/agones-controller-service")
+	assert.Equal(t, "2", service.GetResourceVersion())
+	ip, _, _ := unstructured.NestedString(service.Object, "spec", "clusterIP")
+	assert.Equal(t, "10.0.0.1", ip)
+	webhooks, _, _ = unstructured.NestedSlice(server.object(webhookKey).Object, "webhooks")
+	if assert.Len(t, webhooks, 1) {
+		caBundle, _, _ := unstructured.NestedString(webhooks[0].(map[string]interface{}), "clientConfig", "caBundle")
+		assert.Equal(t, "Y2E=", caBundle)
+	}
+	crd := server.object("/apis/apiextensions.k8s.io/v1beta1/customresourcedefinitions/gameservers.agones.dev")
+	assert.Equal(t, "2", crd.GetResourceVersion())
+	_, ok, _ := unstructured.NestedSlice(crd.Object, "status", "conditions")
+	assert.True(t, ok)
+
+	// kinds the cluster does not serve
+	objs[0].SetAPIVersion("v2")
+	err = i.Install(objs)
+	assert.EqualError(t, err, "error discovering the resources of v2: GroupVersion \"v2\" not found")
+}
+
+func TestInstallerVerify(t *testing.T) {
+	t.Parallel()
+
+	i, server, stop := newFakeInstaller(t)
+	defer stop()
+	objs, err := Decode(strings.NewReader(manifest))
+	assert.NoError(t, err)
+
+	err = i.Verify(objs)
+	assert.EqualError(t, err, "Agones is not installed: ServiceAccount agones-system/agones-controller is missing, "+
+		"ClusterRole agones-controller is missing, CustomResourceDefinition gameservers.agones.dev is missing, "+
+		"Service default/agones-controller-service is missing, "+
+		"ValidatingWebhookConfiguration agones-validation-webhook is missing")
+
+	assert.NoError(t, i.Install(objs))
+	assert.NoError(t, i.Verify(objs))
+
+	crd := server.object("/apis/apiextensions.k8s.io/v1beta1/customresourcedefinitions/gameservers.agones.dev")
+	unstructured.RemoveNestedField(crd.Object, "status")
+	server.store("/apis/apiextensions.k8s.io/v1beta1/customresourcedefinitions/gameservers.agones.dev", crd)
+	err = i.Verify(objs)
+	assert.EqualError(t, err, "Agones is not installed: CustomResourceDefinition gameservers.agones.dev is not established")
+}
+
+// newFakeInstaller returns an Installer that discovers a fixed set of resources,
+// and sends its requests to a fake API server, which is stopped by the returned function
+func newFakeInstaller(t *testing.T) (*Installer, *fakeAPIServer, func()) {
+	kubeClient := kubefake.NewSimpleClientset()
+	kubeClient.Discovery().(*fakediscovery.FakeDiscovery).Resources = []*metav1.APIResourceList{
+		{GroupVersion: "v1", APIResources: []metav1.APIResource{
+			{Name: "namespaces", Kind: "Namespace"},
+			{Name: "serviceaccounts", Kind: "ServiceAccount", Namespaced: true},
+			{Name: "services", Kind: "Service", Namespaced: true},
+		}},
+		{GroupVersion: "rbac.authorization.k8s.io/v1", APIResources: []metav1.APIResource{
+			{Name: "clusterroles", Kind: "ClusterRole"},
+		}},
+		{GroupVersion: "apiextensions.k8s.io/v1beta1", APIResources: []metav1.APIResource{
+			{Name: "customresourcedefinitions/status", Kind: "CustomResourceDefinition"},
+			{Name: "customresourcedefinitions", Kind: "CustomResourceDefinition"},
+		}},
+		{GroupVersion: "admissionregistration.k8s.io/v1beta1", APIResources: []metav1.APIResource{
+			{Name: "validatingwebhookconfigurations", Kind: "ValidatingWebhookConfiguration"},
+		}},
+	}
+
+	extClient := &extfake.Clientset{}
+	extClient.AddReactor("get", "customresourcedefinitions", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, &v1beta1.CustomResourceDefinition{Status: v1beta1.CustomResourceDefinitionStatus{
+			Conditions: []v1beta1.CustomResourceDefinitionCondition{{Type: v1beta1.Established, Status: v1beta1.ConditionTrue}},
+		}}, nil
+	})
+
+	server := &fakeAPIServer{objects: map[string][]byte{}}
+	ts := httptest.NewServer(server)
+	client, err := kubernetes.NewForConfig(&rest.Config{Host: ts.URL})
+	assert.NoError(t, err)
+
+	i := NewInstaller(kubeClient, extClient)
+	i.client = client.Discovery().RESTClient()
+	return i, server, ts.Close
+}
+
+// patchSchemas are the types of the kinds that the fake API server can apply strategic merge patches to
+var patchSchemas = map[string]interface{}{
+	"ServiceAccount":                 corev1.ServiceAccount{},
+	"Service":                        corev1.Service{},
+	"ClusterRole":                    rbacv1.ClusterRole{},
+	"ValidatingWebhookConfiguration": admregv1beta1.ValidatingWebhookConfiguration{},
+}
+
+// fakeAPIServer is an in memory API server, that supports creating, getting and patching objects
+type fakeAPIServer struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+	log     []string
+}
+
+func (s *fakeAPIServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if r.Method != http.MethodGet {
+		s.log = append(s.log, r.Method+" "+r.URL.Path)
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		body, ok := s.objects[r.URL.Path]
+		if !ok {
+			writeStatus(w, k8serrors.NewNotFound(schema.GroupResource{}, path.Base(r.URL.Path)))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(body) // nolint: errcheck
+	case http.MethodPatch:
+		s.patch(w, r)
+	case http.MethodPost:
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			writeStatus(w, k8serrors.NewBadRequest(err.Error()))
+			return
+		}
+		obj := &unstructured.Unstructured{}
+		if err := obj.UnmarshalJSON(body); err != nil {
+			writeStatus(w, k8serrors.NewBadRequest(err.Error()))
+			return
+		}
+		key := r.URL.Path + "/" + obj.GetName()
+		if _, ok := s.objects[key]; ok {
+			writeStatus(w, k8serrors.NewAlreadyExists(schema.GroupResource{}, obj.GetName()))
+			return
+		}
+		if obj.GetKind() == "Service" {
+			unstructured.SetNestedField(obj.Object, "10.0.0.1", "spec", "clusterIP") // nolint: errcheck
+		}
+		if isCRD(obj) {
+			unstructured.SetNestedSlice(obj.Object, []interface{}{map[string]interface{}{"type": "Established", "status": "True"}}, "status", "conditions") // nolint: errcheck
+		}
+		obj.SetResourceVersion("1")
+		body, _ = json.Marshal(obj.Object)
+		s.objects[key] = body
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		w.Write(body) // nolint: errcheck
+	default:
+		writeStatus(w, k8serrors.NewMethodNotSupported(schema.GroupResource{}, r.Method))
+	}
+}
+
+// patch applies a strategic merge patch to the kinds in patchSchemas, or a JSON merge patch to any kind
+func (s *fakeAPIServer) patch(w http.ResponseWriter, r *http.Request) {
+	original, ok := s.objects[r.URL.Path]
+	if !ok {
+		writeStatus(w, k8serrors.NewNotFound(schema.GroupResource{}, path.Base(r.URL.Path)))
+		return
+	}
+	patch, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		writeStatus(w, k8serrors.NewBadRequest(err.Error()))
+		return
+	}
+	obj := &unstructured.Unstructured{}
+	if err := obj.UnmarshalJSON(original); err != nil {
+		writeStatus(w, k8serrors.NewInternalError(err))
+		return
+	}
+
+	var body []byte
+	switch types.PatchType(r.Header.Get("Content-Type")) {
+	case types.StrategicMergePatchType:
+		dataStruct, ok := patchSchemas[obj.GetKind()]
+		if !ok {
+			writeStatus(w, &k8serrors.StatusError{ErrStatus: metav1.Status{
+				Status: metav1.StatusFailure,
+				Code:   http.StatusUnsupportedMediaType,
+				Reason: metav1.StatusReasonUnsupportedMediaType,
+			}})
+			return
+		}
+		body, err = strategicpatch.StrategicMergePatch(original, patch, dataStruct)
+	case types.MergePatchType:
+		var patchObj map[string]interface{}
+		if err = json.Unmarshal(patch, &patchObj); err == nil {
+			body, err = json.Marshal(mergePatch(obj.Object, patchObj))
+		}
+	default:
+		err = errors.New("unexpected patch type " + r.Header.Get("Content-Type"))
+	}
+	if err != nil {
+		writeStatus(w, k8serrors.NewBadRequest(err.Error()))
+		return
+	}
+
+	obj = &unstructured.Unstructured{}
+	if err := obj.UnmarshalJSON(body); err != nil {
+		writeStatus(w, k8serrors.NewBadRequest(err.Error()))
+		return
+	}
+	rv, _ := strconv.Atoi(obj.GetResourceVersion())
+	obj.SetResourceVersion(strconv.Itoa(rv + 1))
+	body, _ = json.Marshal(obj.Object)
+	s.objects[r.URL.Path] = body
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(body) // nolint: errcheck
+}
+
+// mergePatch applies a JSON merge patch (RFC 7386) to the original object
+func mergePatch(original, patch map[string]interface{}) map[string]interface{} {
+	if original == nil {
+		original = map[string]interface{}{}
+	}
+	for k, v := range patch {
+		switch v := v.(type) {
+		case nil:
+			delete(original, k)
+		case map[string]interface{}:
+			o, _ := original[k].(map[string]interface{})
+			original[k] = mergePatch(o, v)
+		default:
+			original[k] = v
+		}
+	}
+	return original
+}
+
+// requests returns the methods and paths of the requests that change objects
+func (s *fakeAPIServer) requests() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string{}, s.log...)
+}
+
+// reset clears the requests
+func (s *fakeAPIServer) reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.log = nil
+}
+
+// object returns the object at the given path
+func (s *fakeAPIServer) object(key string) *unstructured.Unstructured {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	obj := &unstructured.Unstructured{}
+	obj.UnmarshalJSON(s.objects[key]) // nolint: errcheck
+	return obj
+}
+
+// store replaces the object at the given path
+func (s *fakeAPIServer) store(key string, obj *unstructured.Unstructured) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.objects[key], _ = json.Marshal(obj.Object)
+}
+
+func writeStatus(w http.ResponseWriter, err *k8serrors.StatusError) {
+	status := err.ErrStatus
+	status.TypeMeta = metav1.TypeMeta{Kind: "Status", APIVersion: "v1"}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(int(status.Code))
+	json.NewEncoder(w).Encode(status) // nolint: errcheck
+}
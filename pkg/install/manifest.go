@@ -0,0 +1,49 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package install creates and verifies the Agones cluster resources of an install
+// manifest, such as install/yaml/install.yaml, without shelling out to helm or kubectl,
+// so that Agones can be bootstrapped by an operator that embeds it.
+package install
+
+import (
+	"io"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// Decode returns the objects of a multi document YAML, or JSON, manifest,
+// in the order they appear in the manifest. Empty documents are skipped.
+func Decode(r io.Reader) ([]*unstructured.Unstructured, error) {
+	decoder := yaml.NewYAMLOrJSONDecoder(r, 4096)
+	var objs []*unstructured.Unstructured
+	for {
+		obj := &unstructured.Unstructured{}
+		if err := decoder.Decode(&obj.Object); err != nil {
+			if err == io.EOF {
+				return objs, nil
+			}
+			return nil, errors.Wrapf(err, "error decoding manifest document after %d objects", len(objs))
+		}
+		if len(obj.Object) == 0 {
+			continue
+		}
+		if obj.GetAPIVersion() == "" || obj.GetKind() == "" || obj.GetName() == "" {
+			return nil, errors.Errorf("manifest object %d must have an apiVersion, kind and name", len(objs))
+		}
+		objs = append(objs, obj)
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package install
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecode(t *testing.T) {
+	t.Parallel()
+
+	f, err := os.Open("../../install/yaml/install.yaml")
+	assert.NoError(t, err)
+	defer f.Close() // nolint: errcheck
+
+	objs, err := Decode(f)
+	assert.NoError(t, err)
+	if assert.NotEmpty(t, objs) {
+		assert.Equal(t, "ServiceAccount", objs[0].GetKind())
+		assert.Equal(t, "agones-controller", objs[0].GetName())
+		assert.Equal(t, "agones-system", objs[0].GetNamespace())
+	}
+	crds := 0
+	for _, obj := range objs {
+		if isCRD(obj) {
+			crds++
+		}
+	}
+	assert.Equal(t, 5, crds)
+
+	objs, err = Decode(strings.NewReader("---\n# comment\n---\napiVersion: v1\nkind: Namespace\nmetadata:\n  name: agones-system\n"))
+	assert.NoError(t, err)
+	if assert.Len(t, objs, 1) {
+		assert.Equal(t, "agones-system", objs[0].GetName())
+	}
+
+	_, err = Decode(strings.NewReader("apiVersion: v1\nmetadata:\n  name: agones-system\n"))
+	assert.EqualError(t, err, "manifest object 0 must have an apiVersion, kind and name")
+
+	_, err = Decode(strings.NewReader("apiVersion: [v1\n"))
+	assert.Error(t, err)
+}
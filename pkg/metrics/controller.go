@@ -16,6 +16,7 @@ package metrics
 
 import (
 	"context"
+	"net/http"
 	"strconv"
 	"strings"
 	"sync"
@@ -33,6 +34,7 @@ import (
 	"go.opencensus.io/tag"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/clock"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/informers"
@@ -62,10 +64,22 @@ type Controller struct {
 	lock             sync.Mutex
 	gsCount          GameServerCount
 	faCount          map[string]int64
+	costModel        NodeCostModel
+	exportedLabels   *ExportedLabels
+	fleetCosts       map[string]float64
+	replacements     *unhealthyReplacements
+	sessions         *allocationSessions
+	fleetChurn       map[string]int64
+	overview         *fleetsOverview
+	clock            clock.Clock
 }
 
-// NewController returns a new metrics controller
+// NewController returns a new metrics controller.
+// costModel is optional, and when provided the estimated hourly cost per fleet is recorded.
+// exportedLabels is optional, and when provided the GameServer and Fleet metrics are tagged with those labels.
 func NewController(
+	costModel NodeCostModel,
+	exportedLabels *ExportedLabels,
 	kubeClient kubernetes.Interface,
 	agonesClient versioned.Interface,
 	kubeInformerFactory informers.SharedInformerFactory,
@@ -76,11 +90,16 @@ func NewController(
 
 	fleets := agonesInformerFactory.Agones().V1().Fleets()
 	fInformer := fleets.Informer()
+	gsSetInformer := agonesInformerFactory.Agones().V1().GameServerSets().Informer()
 	fas := agonesInformerFactory.Autoscaling().V1().FleetAutoscalers()
 	fasInformer := fas.Informer()
 	node := kubeInformerFactory.Core().V1().Nodes()
 	nodeInformer := node.Informer()
 
+	if exportedLabels == nil {
+		exportedLabels = &ExportedLabels{}
+	}
+
 	c := &Controller{
 		gameServerLister: gameServer.Lister(),
 		nodeLister:       node.Lister(),
@@ -90,6 +109,14 @@ func NewController(
 		nodeSynced:       nodeInformer.HasSynced,
 		gsCount:          GameServerCount{},
 		faCount:          map[string]int64{},
+		costModel:        costModel,
+		exportedLabels:   exportedLabels,
+		fleetCosts:       map[string]float64{},
+		replacements:     newUnhealthyReplacements(),
+		sessions:         newAllocationSessions(),
+		fleetChurn:       map[string]int64{},
+		overview:         newFleetsOverview(),
+		clock:            clock.RealClock{},
 	}
 
 	c.logger = runtime.NewLoggerWithType(c)
@@ -110,8 +137,17 @@ func NewController(
 		DeleteFunc: c.recordFleetAutoScalerDeletion,
 	})
 
+	gsSetInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: c.recordGameServerSetChanges,
+		UpdateFunc: func(old, new interface{}) {
+			c.recordGameServerSetChanges(new)
+		},
+		DeleteFunc: c.recordGameServerSetDeletion,
+	})
+
 	gsInformer.AddEventHandlerWithResyncPeriod(cache.ResourceEventHandlerFuncs{
 		UpdateFunc: c.recordGameServerStatusChanges,
+		DeleteFunc: c.recordGameServerDeletion,
 	}, 0)
 
 	return c
@@ -138,6 +174,7 @@ func (c *Controller) recordFleetAutoScalerChanges(old, new interface{}) {
 		c.recordFleetAutoScalerDeletion(fas)
 		return
 	}
+	c.overview.upsertFleetAutoscaler(fas)
 
 	ctx, _ := tag.New(context.Background(), tag.Upsert(keyName, fas.Name),
 		tag.Upsert(keyFleetName, fas.Spec.FleetName))
@@ -184,10 +221,14 @@ func (c *Controller) recordFleetAutoScalerChanges(old, new interface{}) {
 }
 
 func (c *Controller) recordFleetAutoScalerDeletion(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
 	fas, ok := obj.(*autoscalingv1.FleetAutoscaler)
 	if !ok {
 		return
 	}
+	c.overview.deleteFleetAutoscaler(fas)
 	ctx, _ := tag.New(context.Background(), tag.Upsert(keyName, fas.Name),
 		tag.Upsert(keyFleetName, fas.Spec.FleetName))
 
@@ -210,23 +251,30 @@ func (c *Controller) recordFleetChanges(obj interface{}) {
 		c.recordFleetDeletion(f)
 		return
 	}
+	c.overview.upsertFleet(f)
 
-	c.recordFleetReplicas(f.Name, f.Status.Replicas, f.Status.AllocatedReplicas,
+	c.recordFleetReplicas(f, f.Status.Replicas, f.Status.AllocatedReplicas,
 		f.Status.ReadyReplicas, f.Spec.Replicas)
 }
 
 func (c *Controller) recordFleetDeletion(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
 	f, ok := obj.(*agonesv1.Fleet)
 	if !ok {
 		return
 	}
+	c.overview.deleteFleet(f)
 
-	c.recordFleetReplicas(f.Name, 0, 0, 0, 0)
+	c.recordFleetReplicas(f, 0, 0, 0, 0)
 }
 
-func (c *Controller) recordFleetReplicas(fleetName string, total, allocated, ready, desired int32) {
+func (c *Controller) recordFleetReplicas(f *agonesv1.Fleet, total, allocated, ready, desired int32) {
 
-	ctx, _ := tag.New(context.Background(), tag.Upsert(keyName, fleetName))
+	mutators := append([]tag.Mutator{tag.Upsert(keyName, f.ObjectMeta.Name)},
+		c.exportedLabels.mutators(c.exportedLabels.tagValues(f.ObjectMeta.Labels))...)
+	ctx, _ := tag.New(context.Background(), mutators...)
 
 	recordWithTags(ctx, []tag.Mutator{tag.Upsert(keyType, "total")},
 		fleetsReplicasCountStats.M(int64(total)))
@@ -238,6 +286,27 @@ func (c *Controller) recordFleetReplicas(fleetName string, total, allocated, rea
 		fleetsReplicasCountStats.M(int64(desired)))
 }
 
+// recordGameServerSetChanges keeps track of the gameserversets of each fleet for the fleets overview
+func (c *Controller) recordGameServerSetChanges(obj interface{}) {
+	gsSet, ok := obj.(*agonesv1.GameServerSet)
+	if !ok {
+		return
+	}
+	c.overview.upsertGameServerSet(gsSet)
+}
+
+// recordGameServerSetDeletion removes a deleted gameserverset from the fleets overview
+func (c *Controller) recordGameServerSetDeletion(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	gsSet, ok := obj.(*agonesv1.GameServerSet)
+	if !ok {
+		return
+	}
+	c.overview.deleteGameServerSet(gsSet)
+}
+
 // recordGameServerStatusChanged records gameserver status changes, however since it's based
 // on cache events some events might collapsed and not appear, for example transition state
 // like creating, port allocation, could be skipped.
@@ -260,9 +329,91 @@ func (c *Controller) recordGameServerStatusChanges(old, new interface{}) {
 		if fleetName == "" {
 			fleetName = "none"
 		}
-		recordWithTags(context.Background(), []tag.Mutator{tag.Upsert(keyType, string(newGs.Status.State)),
-			tag.Upsert(keyFleetName, fleetName)}, gameServerTotalStats.M(1))
+		mutators := append([]tag.Mutator{tag.Upsert(keyType, string(newGs.Status.State)),
+			tag.Upsert(keyFleetName, fleetName)}, c.exportedLabels.mutators(c.exportedLabels.tagValues(newGs.ObjectMeta.Labels))...)
+		recordWithTags(context.Background(), mutators, gameServerTotalStats.M(1))
+		c.recordGameServerLatencies(oldGs, newGs, fleetName)
+		c.recordAllocationSession(oldGs, newGs, fleetName)
+	}
+}
+
+// recordAllocationSession tracks when a gameserver whose state has changed was allocated, for the allocation
+// churn of its fleet, and records how long it was Allocated once it is Ready again or shut down
+func (c *Controller) recordAllocationSession(oldGs, gs *agonesv1.GameServer, fleetName string) {
+	now := c.clock.Now()
+	if gs.Status.State == agonesv1.GameServerStateAllocated {
+		c.sessions.allocate(gs.ObjectMeta.UID, gs.Labels[agonesv1.FleetNameLabel], now)
+		return
+	}
+	if oldGs.Status.State != agonesv1.GameServerStateAllocated {
+		return
+	}
+	if d, ok := c.sessions.end(gs.ObjectMeta.UID, now); ok {
+		recordWithTags(context.Background(), []tag.Mutator{tag.Upsert(keyFleetName, fleetName)}, gsAllocatedDurationStats.M(d.Seconds()))
+	}
+}
+
+// recordGameServerLatencies records the latencies of the reconcile loops of a gameserver whose state has changed:
+// from its creation to the creation of its pod, which moves it to Starting, and, for a gameserver of a fleet or
+// a gameserverset, from another one becoming unhealthy to it first being ready as its replacement
+func (c *Controller) recordGameServerLatencies(oldGs, gs *agonesv1.GameServer, fleetName string) {
+	now := c.clock.Now()
+	tags := []tag.Mutator{tag.Upsert(keyFleetName, fleetName)}
+
+	if gs.Status.State == agonesv1.GameServerStateStarting && !gs.ObjectMeta.CreationTimestamp.IsZero() {
+		recordWithTags(context.Background(), tags,
+			gsPodCreationLatencyStats.M(now.Sub(gs.ObjectMeta.CreationTimestamp.Time).Seconds()))
+	}
+
+	owner := gs.Labels[agonesv1.FleetNameLabel]
+	if owner == "" {
+		owner = gs.Labels[agonesv1.GameServerSetGameServerLabel]
+	}
+	if owner == "" {
+		return
+	}
+	key := gs.ObjectMeta.Namespace + "/" + owner
+
+	switch gs.Status.State {
+	case agonesv1.GameServerStateUnhealthy:
+		c.replacements.unhealthy(key, now)
+	case agonesv1.GameServerStateReady:
+		// a gameserver is only a replacement the first time it is ready, not when a reservation expires
+		if oldGs.Status.State != agonesv1.GameServerStateRequestReady {
+			return
+		}
+		if d, ok := c.replacements.ready(key, gs.ObjectMeta.CreationTimestamp.Time, now); ok {
+			recordWithTags(context.Background(), tags, gsReplacementLatencyStats.M(d.Seconds()))
+		}
+	}
+}
+
+// recordGameServerDeletion counts the deleted gameservers by the reason they were shut down,
+// e.g. to find out from metrics alone why many gameservers were deleted at once
+func (c *Controller) recordGameServerDeletion(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
 	}
+	gs, ok := obj.(*agonesv1.GameServer)
+	if !ok {
+		return
+	}
+	fleetName := gs.Labels[agonesv1.FleetNameLabel]
+	if fleetName == "" {
+		fleetName = "none"
+	}
+	recordWithTags(context.Background(), []tag.Mutator{tag.Upsert(keyReason, string(gs.ShutdownReason())),
+		tag.Upsert(keyFleetName, fleetName)}, gameServerDeletionsStats.M(1))
+
+	// deleted while Allocated, without moving to Shutdown first
+	if d, ok := c.sessions.end(gs.ObjectMeta.UID, c.clock.Now()); ok {
+		recordWithTags(context.Background(), []tag.Mutator{tag.Upsert(keyFleetName, fleetName)}, gsAllocatedDurationStats.M(d.Seconds()))
+	}
+}
+
+// FleetsOverviewHandler returns the handler that serves the summary of all the fleets of the cluster as JSON
+func (c *Controller) FleetsOverviewHandler() http.Handler {
+	return c.overview
 }
 
 // Run the Metrics controller. Will block until stop is closed.
@@ -283,6 +434,9 @@ func (c *Controller) collect() {
 	defer c.lock.Unlock()
 	c.collectGameServerCounts()
 	c.collectNodeCounts()
+	c.collectFleetCosts()
+	c.collectAllocationChurn()
+	c.replacements.prune(c.clock.Now())
 }
 
 // collects gameservers count by going through our informer cache
@@ -295,7 +449,7 @@ func (c *Controller) collectGameServerCounts() {
 		return
 	}
 
-	if err := c.gsCount.record(gameservers); err != nil {
+	if err := c.gsCount.record(gameservers, c.exportedLabels); err != nil {
 		c.logger.WithError(err).Warn("error while recoding stats")
 	}
 }
@@ -333,6 +487,70 @@ func (c *Controller) collectNodeCounts() {
 
 }
 
+// collectFleetCosts estimates the hourly cost of each fleet using the cost model,
+// based on the cpu requests of its gameservers and the nodes they are placed on.
+func (c *Controller) collectFleetCosts() {
+	if len(c.costModel) == 0 {
+		return
+	}
+
+	gameservers, err := c.gameServerLister.List(labels.Everything())
+	if err != nil {
+		c.logger.WithError(err).Warn("failed listing gameservers")
+		return
+	}
+
+	// there is no way to remove a gauge, so zero the fleets that have no cost anymore once
+	for fleet, cost := range c.fleetCosts {
+		if cost == 0 {
+			delete(c.fleetCosts, fleet)
+		} else {
+			c.fleetCosts[fleet] = 0
+		}
+	}
+
+	for _, gs := range gameservers {
+		if gs.Status.NodeName == "" {
+			continue
+		}
+		node, err := c.nodeLister.Get(gs.Status.NodeName)
+		if err != nil {
+			c.logger.WithError(err).WithField("node", gs.Status.NodeName).Debug("failed getting node")
+			continue
+		}
+		fleetName := gs.Labels[agonesv1.FleetNameLabel]
+		if fleetName == "" {
+			fleetName = "none"
+		}
+		c.fleetCosts[fleetName] += c.costModel.estimateCost(gs, node)
+	}
+
+	for fleet, cost := range c.fleetCosts {
+		recordWithTags(context.Background(), []tag.Mutator{tag.Upsert(keyName, fleet)},
+			fleetsEstimatedCostStats.M(cost))
+	}
+}
+
+// collectAllocationChurn records the number of allocations of each fleet in the last minute
+func (c *Controller) collectAllocationChurn() {
+	// there is no way to remove a gauge, so zero the fleets that had no allocations in the last minute once
+	for fleet, churn := range c.fleetChurn {
+		if churn == 0 {
+			delete(c.fleetChurn, fleet)
+		} else {
+			c.fleetChurn[fleet] = 0
+		}
+	}
+	for fleet, churn := range c.sessions.churn(c.clock.Now()) {
+		c.fleetChurn[fleet] = churn
+	}
+
+	for fleet, churn := range c.fleetChurn {
+		recordWithTags(context.Background(), []tag.Mutator{tag.Upsert(keyName, fleet)},
+			fleetsAllocationRateStats.M(churn))
+	}
+}
+
 func removeSystemNodes(nodes []*corev1.Node) []*corev1.Node {
 	var result []*corev1.Node
 
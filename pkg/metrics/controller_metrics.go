@@ -30,8 +30,20 @@ var (
 	fasLimitedStats           = stats.Int64("fas/limited", "The fleet autoscaler is capped (0 indicates false, 1 indicates true)", "1")
 	gameServerCountStats      = stats.Int64("gameservers/count", "The count of gameservers", "1")
 	gameServerTotalStats      = stats.Int64("gameservers/total", "The total of gameservers", "1")
+	gameServerDeletionsStats  = stats.Int64("gameservers/deletions", "The deleted gameservers", "1")
 	nodesCountStats           = stats.Int64("nodes/count", "The count of nodes in the cluster", "1")
 	gsPerNodesCountStats      = stats.Int64("gameservers_node/count", "The count of gameservers per node in the cluster", "1")
+	fleetsEstimatedCostStats  = stats.Float64("fleets/estimated_hourly_cost", "The estimated hourly cost per fleet", "1")
+	gsPodCreationLatencyStats = stats.Float64("gameservers/pod_creation_latency", "The time from gameserver creation to pod creation", "s")
+	gsReplacementLatencyStats = stats.Float64("gameservers/unhealthy_replacement_latency", "The time from a gameserver becoming unhealthy to its replacement being ready", "s")
+	gsAllocatedDurationStats  = stats.Float64("gameservers/allocated_duration", "The time a gameserver stays Allocated", "s")
+	fleetsAllocationRateStats = stats.Int64("fleets/allocations_per_minute", "The allocations per fleet in the last minute", "1")
+
+	// latencyBuckets are the buckets, in seconds, of the distributions of the latencies of the reconcile loops
+	latencyBuckets = view.Distribution(0, 0.5, 1, 2.5, 5, 10, 15, 30, 60, 120, 300, 600)
+
+	// sessionBuckets are the buckets, in seconds, of the distribution of how long gameservers stay Allocated
+	sessionBuckets = view.Distribution(0, 30, 60, 120, 300, 600, 900, 1200, 1800, 2700, 3600, 5400, 7200, 10800, 21600, 43200, 86400)
 
 	stateViews = []*view.View{
 		&view.View{
@@ -97,6 +109,13 @@ var (
 			Aggregation: view.Count(),
 			TagKeys:     []tag.Key{keyType, keyFleetName},
 		},
+		&view.View{
+			Name:        "gameserver_deletions_total",
+			Measure:     gameServerDeletionsStats,
+			Description: "The total of deleted gameservers, by the reason they were shut down",
+			Aggregation: view.Count(),
+			TagKeys:     []tag.Key{keyReason, keyFleetName},
+		},
 		&view.View{
 			Name:        "nodes_count",
 			Measure:     nodesCountStats,
@@ -110,6 +129,41 @@ var (
 			Description: "The count of gameservers per node in the cluster",
 			Aggregation: view.Distribution(0.00001, 1.00001, 2.00001, 3.00001, 4.00001, 5.00001, 6.00001, 7.00001, 8.00001, 9.00001, 10.00001, 11.00001, 12.00001, 13.00001, 14.00001, 15.00001, 16.00001, 32.00001, 40.00001, 50.00001, 60.00001, 70.00001, 80.00001, 90.00001, 100.00001, 110.00001, 120.00001),
 		},
+		&view.View{
+			Name:        "gameserver_pod_creation_duration_seconds",
+			Measure:     gsPodCreationLatencyStats,
+			Description: "The distribution of the time from gameserver creation to the creation of its pod",
+			Aggregation: latencyBuckets,
+			TagKeys:     []tag.Key{keyFleetName},
+		},
+		&view.View{
+			Name:        "gameserver_unhealthy_replacement_duration_seconds",
+			Measure:     gsReplacementLatencyStats,
+			Description: "The distribution of the time from a gameserver becoming unhealthy to a replacement gameserver being ready",
+			Aggregation: latencyBuckets,
+			TagKeys:     []tag.Key{keyFleetName},
+		},
+		&view.View{
+			Name:        "gameserver_allocated_duration_seconds",
+			Measure:     gsAllocatedDurationStats,
+			Description: "The distribution of the time gameservers stay Allocated, until they are Ready again or shut down",
+			Aggregation: sessionBuckets,
+			TagKeys:     []tag.Key{keyFleetName},
+		},
+		&view.View{
+			Name:        "fleets_allocations_per_minute",
+			Measure:     fleetsAllocationRateStats,
+			Description: "The number of allocations per fleet in the last minute",
+			Aggregation: view.LastValue(),
+			TagKeys:     []tag.Key{keyName},
+		},
+		&view.View{
+			Name:        "fleets_estimated_hourly_cost",
+			Measure:     fleetsEstimatedCostStats,
+			Description: "The estimated hourly cost per fleet, based on the node cost model",
+			Aggregation: view.LastValue(),
+			TagKeys:     []tag.Key{keyName},
+		},
 	}
 )
 
@@ -17,12 +17,19 @@ package metrics
 import (
 	"strings"
 	"testing"
+	"time"
 
 	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/clock"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/wait"
 )
 
 func TestControllerGameServerCount(t *testing.T) {
@@ -87,6 +94,162 @@ func TestControllerGameServersTotal(t *testing.T) {
 	assert.Nil(t, testutil.GatherAndCompare(registry, strings.NewReader(gsTotalExpected), "agones_gameservers_total"))
 }
 
+func TestControllerGameServerDeletionsTotal(t *testing.T) {
+
+	registry := prometheus.NewRegistry()
+	_, err := RegisterPrometheusExporter(registry)
+	assert.Nil(t, err)
+
+	c := newFakeController()
+	defer c.close()
+	c.run(t)
+	// reset the deletions recorded by other tests
+	report()
+
+	scaleDown := gameServerWithFleetAndState("test", agonesv1.GameServerStateShutdown)
+	scaleDown.Shutdown(agonesv1.ShutdownReasonScaleDown)
+	unhealthy := gameServerWithFleetAndState("", agonesv1.GameServerStateShutdown)
+	unhealthy.Shutdown(agonesv1.ShutdownReasonUnhealthy)
+	sdk := gameServerWithFleetAndState("test", agonesv1.GameServerStateShutdown)
+	manual := gameServerWithFleetAndState("test", agonesv1.GameServerStateReady)
+
+	for _, gs := range []*agonesv1.GameServer{scaleDown, unhealthy, sdk, manual} {
+		c.gsWatch.Add(gs)
+		c.gsWatch.Delete(gs)
+	}
+
+	c.sync()
+	report()
+
+	assert.Nil(t, testutil.GatherAndCompare(registry, strings.NewReader(gsDeletionsExpected), "agones_gameserver_deletions_total"))
+}
+
+func TestControllerGameServerLatencies(t *testing.T) {
+
+	registry := prometheus.NewRegistry()
+	_, err := RegisterPrometheusExporter(registry)
+	assert.Nil(t, err)
+
+	c := newFakeController()
+	defer c.close()
+	now := time.Now()
+	c.clock = clock.NewFakeClock(now)
+	// reset the latencies recorded by other tests
+	report()
+
+	transition := func(gs *agonesv1.GameServer, state agonesv1.GameServerState) *agonesv1.GameServer {
+		gsCopy := gs.DeepCopy()
+		gsCopy.Status.State = state
+		c.recordGameServerStatusChanges(gs, gsCopy)
+		return gsCopy
+	}
+
+	// the pod was created 3 seconds after the gameserver
+	gs := gameServerWithFleetAndState("test", agonesv1.GameServerStateCreating)
+	gs.ObjectMeta.CreationTimestamp = metav1.NewTime(now.Add(-3 * time.Second))
+	transition(gs, agonesv1.GameServerStateStarting)
+
+	unhealthy := gameServerWithFleetAndState("test", agonesv1.GameServerStateReady)
+	transition(unhealthy, agonesv1.GameServerStateUnhealthy)
+
+	// the replacement is ready 20 seconds later
+	c.clock = clock.NewFakeClock(now.Add(20 * time.Second))
+	replacement := gameServerWithFleetAndState("test", agonesv1.GameServerStateRequestReady)
+	replacement.ObjectMeta.CreationTimestamp = metav1.NewTime(now.Add(time.Second))
+	transition(replacement, agonesv1.GameServerStateReady)
+
+	report()
+
+	assert.Nil(t, testutil.GatherAndCompare(registry, strings.NewReader(gsLatenciesExpected),
+		"agones_gameserver_pod_creation_duration_seconds", "agones_gameserver_unhealthy_replacement_duration_seconds"))
+}
+
+func TestControllerAllocationSessions(t *testing.T) {
+
+	registry := prometheus.NewRegistry()
+	_, err := RegisterPrometheusExporter(registry)
+	assert.Nil(t, err)
+
+	c := newFakeController()
+	defer c.close()
+	now := time.Now()
+	c.clock = clock.NewFakeClock(now)
+	// reset the sessions recorded by other tests
+	report()
+
+	transition := func(gs *agonesv1.GameServer, state agonesv1.GameServerState) *agonesv1.GameServer {
+		gsCopy := gs.DeepCopy()
+		gsCopy.Status.State = state
+		c.recordGameServerStatusChanges(gs, gsCopy)
+		return gsCopy
+	}
+
+	ready := transition(gameServerWithFleetAndState("test", agonesv1.GameServerStateReady), agonesv1.GameServerStateAllocated)
+	deleted := transition(gameServerWithFleetAndState("test", agonesv1.GameServerStateReady), agonesv1.GameServerStateAllocated)
+	transition(gameServerWithFleetAndState("test", agonesv1.GameServerStateReserved), agonesv1.GameServerStateAllocated)
+
+	c.clock = clock.NewFakeClock(now.Add(10 * time.Minute))
+	transition(ready, agonesv1.GameServerStateReady)
+	// not allocated since the controller started
+	transition(gameServerWithFleetAndState("test", agonesv1.GameServerStateAllocated), agonesv1.GameServerStateShutdown)
+
+	c.clock = clock.NewFakeClock(now.Add(time.Hour))
+	c.recordGameServerDeletion(deleted)
+
+	report()
+	assert.Nil(t, testutil.GatherAndCompare(registry, strings.NewReader(gsAllocatedDurationExpected),
+		"agones_gameserver_allocated_duration_seconds"))
+
+	// the allocations are counted within the last minute
+	c.clock = clock.NewFakeClock(now.Add(30 * time.Second))
+	c.collectAllocationChurn()
+	report()
+	assert.Nil(t, testutil.GatherAndCompare(registry, strings.NewReader(fleetAllocationsExpected(3)),
+		"agones_fleets_allocations_per_minute"))
+
+	c.clock = clock.NewFakeClock(now.Add(2 * time.Minute))
+	c.collectAllocationChurn()
+	report()
+	assert.Nil(t, testutil.GatherAndCompare(registry, strings.NewReader(fleetAllocationsExpected(0)),
+		"agones_fleets_allocations_per_minute"))
+	c.collectAllocationChurn()
+	assert.Empty(t, c.fleetChurn)
+}
+
+func TestControllerFleetCosts(t *testing.T) {
+	c := newFakeController()
+	defer c.close()
+	c.costModel = NodeCostModel{"n1-standard-4": 0.2}
+
+	node := nodeWithName("node1")
+	node.ObjectMeta.Labels = map[string]string{instanceTypeLabel: "n1-standard-4"}
+	node.Status.Allocatable = corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("4")}
+	gs := gameServerWithNode("node1")
+	gs.Spec.Template.Spec.Containers = []corev1.Container{{Name: "game", Resources: corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")}}}}
+	c.nodeWatch.Add(node)
+	c.gsWatch.Add(gs)
+
+	err := wait.PollImmediate(10*time.Millisecond, 5*time.Second, func() (bool, error) {
+		c.collectFleetCosts()
+		return len(c.fleetCosts) > 0, nil
+	})
+	assert.NoError(t, err)
+	assert.InDelta(t, 0.05, c.fleetCosts["fleet"], 0.0001)
+
+	// the fleet is zeroed once after it has no cost anymore, and then removed
+	c.gsWatch.Delete(gs)
+	err = wait.PollImmediate(10*time.Millisecond, 5*time.Second, func() (bool, error) {
+		list, err := c.gameServerLister.List(labels.Everything())
+		return len(list) == 0, err
+	})
+	assert.NoError(t, err)
+	c.collectFleetCosts()
+	assert.Equal(t, map[string]float64{"fleet": 0}, c.fleetCosts)
+	c.collectFleetCosts()
+	assert.Empty(t, c.fleetCosts)
+}
+
 func TestControllerFleetReplicasCount(t *testing.T) {
 
 	registry := prometheus.NewRegistry()
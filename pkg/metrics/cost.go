@@ -0,0 +1,94 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"strconv"
+	"strings"
+
+	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// instanceTypeLabel is the well known label for the instance type of a node
+	instanceTypeLabel = "node.kubernetes.io/instance-type"
+	// betaInstanceTypeLabel is the deprecated label for the instance type of a node
+	betaInstanceTypeLabel = "beta.kubernetes.io/instance-type"
+)
+
+// NodeCostModel is the hourly cost of a node, by node instance type
+type NodeCostModel map[string]float64
+
+// ParseNodeCostModel parses a cost model in the format of
+// `instance-type=hourly-cost` pairs separated by commas, e.g. "n1-standard-4=0.19,n1-standard-8=0.38"
+func ParseNodeCostModel(s string) (NodeCostModel, error) {
+	model := NodeCostModel{}
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return model, nil
+	}
+
+	for _, pair := range strings.Split(s, ",") {
+		kv := strings.SplitN(strings.TrimSpace(pair), "=", 2)
+		if len(kv) != 2 || kv[0] == "" {
+			return nil, errors.Errorf("invalid node cost %q, expected instance-type=cost", pair)
+		}
+		cost, err := strconv.ParseFloat(kv[1], 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid cost for node instance type %s", kv[0])
+		}
+		if cost < 0 {
+			return nil, errors.Errorf("cost for node instance type %s cannot be negative", kv[0])
+		}
+		model[kv[0]] = cost
+	}
+
+	return model, nil
+}
+
+// nodeCost returns the hourly cost of the node, and whether the node's instance type
+// is part of the cost model
+func (m NodeCostModel) nodeCost(n *corev1.Node) (float64, bool) {
+	instanceType, ok := n.ObjectMeta.Labels[instanceTypeLabel]
+	if !ok {
+		instanceType = n.ObjectMeta.Labels[betaInstanceTypeLabel]
+	}
+	cost, ok := m[instanceType]
+	return cost, ok
+}
+
+// estimateCost returns the estimated hourly cost of running the GameServer on the given node.
+// The node cost is split based on the share of the node's allocatable cpu that
+// the GameServer's containers request.
+func (m NodeCostModel) estimateCost(gs *agonesv1.GameServer, n *corev1.Node) float64 {
+	cost, ok := m.nodeCost(n)
+	if !ok {
+		return 0
+	}
+
+	allocatable := n.Status.Allocatable.Cpu().MilliValue()
+	if allocatable <= 0 {
+		return 0
+	}
+
+	var requested int64
+	for _, c := range gs.Spec.Template.Spec.Containers {
+		requested += c.Resources.Requests.Cpu().MilliValue()
+	}
+
+	return cost * float64(requested) / float64(allocatable)
+}
@@ -0,0 +1,75 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"testing"
+
+	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestParseNodeCostModel(t *testing.T) {
+	t.Parallel()
+
+	fixtures := map[string]struct {
+		value    string
+		expected NodeCostModel
+		wantErr  bool
+	}{
+		"empty":          {value: "", expected: NodeCostModel{}},
+		"single":         {value: "n1-standard-4=0.19", expected: NodeCostModel{"n1-standard-4": 0.19}},
+		"multiple":       {value: "n1-standard-4=0.19, n1-standard-8=0.38", expected: NodeCostModel{"n1-standard-4": 0.19, "n1-standard-8": 0.38}},
+		"missing cost":   {value: "n1-standard-4", wantErr: true},
+		"invalid cost":   {value: "n1-standard-4=abc", wantErr: true},
+		"negative cost":  {value: "n1-standard-4=-1", wantErr: true},
+		"missing type":   {value: "=0.19", wantErr: true},
+		"trailing comma": {value: "n1-standard-4=0.19,", wantErr: true},
+	}
+
+	for k, v := range fixtures {
+		t.Run(k, func(t *testing.T) {
+			model, err := ParseNodeCostModel(v.value)
+			if v.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, v.expected, model)
+		})
+	}
+}
+
+func TestNodeCostModelEstimateCost(t *testing.T) {
+	t.Parallel()
+
+	model := NodeCostModel{"n1-standard-4": 0.2}
+	gs := &agonesv1.GameServer{Spec: agonesv1.GameServerSpec{Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+		Containers: []corev1.Container{{Name: "game", Resources: corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")}}}}}}}}
+
+	node := func(instanceType, label string) *corev1.Node {
+		return &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node", Labels: map[string]string{label: instanceType}},
+			Status: corev1.NodeStatus{Allocatable: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("4")}}}
+	}
+
+	assert.InDelta(t, 0.05, model.estimateCost(gs, node("n1-standard-4", instanceTypeLabel)), 0.0001)
+	assert.InDelta(t, 0.05, model.estimateCost(gs, node("n1-standard-4", betaInstanceTypeLabel)), 0.0001)
+	assert.Equal(t, float64(0), model.estimateCost(gs, node("n1-standard-8", instanceTypeLabel)))
+	assert.Equal(t, float64(0), model.estimateCost(gs, &corev1.Node{}))
+}
@@ -16,6 +16,7 @@ package metrics
 
 import (
 	"context"
+	"strings"
 
 	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
 	"go.opencensus.io/stats"
@@ -23,17 +24,27 @@ import (
 	"k8s.io/apimachinery/pkg/util/errors"
 )
 
-// GameServerCount  is the count of gameserver per current state and per fleet name
-type GameServerCount map[agonesv1.GameServerState]map[string]int64
+// GameServerCount  is the count of gameserver per current state, per fleet name and per value of the exported labels
+type GameServerCount map[agonesv1.GameServerState]map[gameServerCountKey]int64
 
-// increment adds the count of gameservers for a given fleetName and state
-func (c GameServerCount) increment(fleetName string, state agonesv1.GameServerState) {
+// gameServerCountKey is the fleet name and the exported label values that gameservers are counted by
+type gameServerCountKey struct {
+	fleetName string
+	// labelValues are the tag values of the exported labels, separated by labelValuesSeparator
+	labelValues string
+}
+
+// labelValuesSeparator separates label values in a gameServerCountKey, it cannot be part of a label value
+const labelValuesSeparator = "/"
+
+// increment adds the count of gameservers for a given fleetName, exported label values and state
+func (c GameServerCount) increment(fleetName string, labelValues []string, state agonesv1.GameServerState) {
 	fleets, ok := c[state]
 	if !ok {
-		fleets = map[string]int64{}
+		fleets = map[gameServerCountKey]int64{}
 		c[state] = fleets
 	}
-	fleets[fleetName]++
+	fleets[gameServerCountKey{fleetName: fleetName, labelValues: strings.Join(labelValues, labelValuesSeparator)}]++
 }
 
 // reset sets zero to the whole metrics set
@@ -45,8 +56,8 @@ func (c GameServerCount) reset() {
 	}
 }
 
-// record counts the list of gameserver per status and fleet name and record it to OpenCensus
-func (c GameServerCount) record(gameservers []*agonesv1.GameServer) error {
+// record counts the list of gameserver per status, fleet name and exported label values and record it to OpenCensus
+func (c GameServerCount) record(gameservers []*agonesv1.GameServer, exportedLabels *ExportedLabels) error {
 	// Currently there is no way to remove a metric so we have to reset our values to zero
 	// so that statuses that have no count anymore are zeroed.
 	// Otherwise OpenCensus will write the last value recorded to the prom endpoint.
@@ -54,16 +65,20 @@ func (c GameServerCount) record(gameservers []*agonesv1.GameServer) error {
 	c.reset()
 	// counts gameserver per state and fleet
 	for _, g := range gameservers {
-		c.increment(g.Labels[agonesv1.FleetNameLabel], g.Status.State)
+		c.increment(g.Labels[agonesv1.FleetNameLabel], exportedLabels.tagValues(g.Labels), g.Status.State)
 	}
 	errs := []error{}
 	for state, fleets := range c {
-		for fleet, count := range fleets {
+		for key, count := range fleets {
+			fleet := key.fleetName
 			if fleet == "" {
 				fleet = "none"
 			}
-			if err := stats.RecordWithTags(context.Background(), []tag.Mutator{tag.Upsert(keyType, string(state)),
-				tag.Upsert(keyFleetName, fleet)}, gameServerCountStats.M(count)); err != nil {
+			mutators := []tag.Mutator{tag.Upsert(keyType, string(state)), tag.Upsert(keyFleetName, fleet)}
+			if key.labelValues != "" {
+				mutators = append(mutators, exportedLabels.mutators(strings.Split(key.labelValues, labelValuesSeparator))...)
+			}
+			if err := stats.RecordWithTags(context.Background(), mutators, gameServerCountStats.M(count)); err != nil {
 				errs = append(errs, err)
 			}
 		}
@@ -0,0 +1,156 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"go.opencensus.io/tag"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+const (
+	// labelTagPrefix is prepended to the tag name of each exported label
+	labelTagPrefix = "label_"
+	// labelValueOther is the tag value of a label once its maximum number of distinct values has been reached
+	labelValueOther = "other"
+	// labelValueNone is the tag value of a label that is not set
+	labelValueNone = "none"
+)
+
+// labelViews are the names of the views that are tagged with the exported labels
+var labelViews = map[string]bool{
+	"fleets_replicas_count": true,
+	"gameservers_count":     true,
+	"gameservers_total":     true,
+}
+
+// SetExportedLabels tags the fleets_replicas_count, gameservers_count and gameservers_total metrics
+// with the given exported labels, which are then recorded by the Controller they are passed to.
+// This should be called before any metrics are recorded.
+func SetExportedLabels(el *ExportedLabels) {
+	unRegisterViews()
+	for _, v := range stateViews {
+		if !labelViews[v.Name] {
+			continue
+		}
+		v.TagKeys = append(withoutLabelTagKeys(v.TagKeys), el.keys...)
+	}
+	registerViews()
+}
+
+// ExportedLabels are the GameServer and Fleet labels exported as metric tags.
+// To protect the metrics backend from a cardinality explosion, at most maxValues distinct values are
+// exported per label, values seen after that are exported as "other".
+type ExportedLabels struct {
+	lock      sync.Mutex
+	labels    []string
+	keys      []tag.Key
+	maxValues int
+	values    map[string]map[string]bool
+}
+
+// NewExportedLabels returns the ExportedLabels for the given label keys, with at most maxValues
+// distinct values exported per label
+func NewExportedLabels(labels []string, maxValues int) (*ExportedLabels, error) {
+	lt := &ExportedLabels{maxValues: maxValues, values: map[string]map[string]bool{}}
+	if len(labels) > 0 && maxValues < 1 {
+		return nil, errors.Errorf("maximum number of values per label must be at least 1, was %d", maxValues)
+	}
+
+	names := map[string]string{}
+	for _, l := range labels {
+		l = strings.TrimSpace(l)
+		if l == "" {
+			continue
+		}
+		if errs := validation.IsQualifiedName(l); len(errs) > 0 {
+			return nil, errors.Errorf("invalid label %q: %s", l, strings.Join(errs, ", "))
+		}
+		name := labelTagName(l)
+		if other, ok := names[name]; ok {
+			return nil, errors.Errorf("labels %q and %q would both be exported as %s", other, l, name)
+		}
+		key, err := tag.NewKey(name)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not create tag for label %s", l)
+		}
+		names[name] = l
+		lt.labels = append(lt.labels, l)
+		lt.keys = append(lt.keys, key)
+		lt.values[l] = map[string]bool{}
+	}
+
+	return lt, nil
+}
+
+// tagValues returns the tag value of each exported label, in the order of the exported labels
+func (lt *ExportedLabels) tagValues(labels map[string]string) []string {
+	if len(lt.labels) == 0 {
+		return nil
+	}
+
+	lt.lock.Lock()
+	defer lt.lock.Unlock()
+
+	result := make([]string, len(lt.labels))
+	for i, l := range lt.labels {
+		value, ok := labels[l]
+		switch {
+		case !ok || value == "":
+			value = labelValueNone
+		case lt.values[l][value]:
+		case len(lt.values[l]) < lt.maxValues:
+			lt.values[l][value] = true
+		default:
+			value = labelValueOther
+		}
+		result[i] = value
+	}
+	return result
+}
+
+// mutators returns the tag mutators for values returned by tagValues
+func (lt *ExportedLabels) mutators(values []string) []tag.Mutator {
+	result := make([]tag.Mutator, 0, len(values))
+	for i, v := range values {
+		result = append(result, tag.Upsert(lt.keys[i], v))
+	}
+	return result
+}
+
+// labelTagName returns the tag name of a label, e.g. "label_example_com_game_mode" for
+// the label "example.com/game-mode"
+func labelTagName(label string) string {
+	return labelTagPrefix + strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			return r
+		}
+		return '_'
+	}, label)
+}
+
+// withoutLabelTagKeys returns the tag keys that are not exported labels
+func withoutLabelTagKeys(keys []tag.Key) []tag.Key {
+	var result []tag.Key
+	for _, k := range keys {
+		if !strings.HasPrefix(k.Name(), labelTagPrefix) {
+			result = append(result, k)
+		}
+	}
+	return result
+}
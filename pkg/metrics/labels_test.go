@@ -0,0 +1,131 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+func TestNewExportedLabels(t *testing.T) {
+	t.Parallel()
+
+	fixtures := map[string]struct {
+		labels    []string
+		maxValues int
+		keys      []string
+		err       bool
+	}{
+		"none": {
+			labels: nil,
+			keys:   nil,
+		},
+		"labels": {
+			labels:    []string{"game_mode", " region ", "", "example.com/game-type"},
+			maxValues: 10,
+			keys:      []string{"label_game_mode", "label_region", "label_example_com_game_type"},
+		},
+		"invalid label": {
+			labels:    []string{"game mode"},
+			maxValues: 10,
+			err:       true,
+		},
+		"conflicting labels": {
+			labels:    []string{"game-mode", "game.mode"},
+			maxValues: 10,
+			err:       true,
+		},
+		"no values": {
+			labels:    []string{"game_mode"},
+			maxValues: 0,
+			err:       true,
+		},
+	}
+
+	for k, v := range fixtures {
+		t.Run(k, func(t *testing.T) {
+			lt, err := NewExportedLabels(v.labels, v.maxValues)
+			if v.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			var keys []string
+			for _, key := range lt.keys {
+				keys = append(keys, key.Name())
+			}
+			assert.Equal(t, v.keys, keys)
+		})
+	}
+}
+
+func TestExportedLabelsTagValues(t *testing.T) {
+	t.Parallel()
+
+	lt, err := NewExportedLabels([]string{"game_mode", "region"}, 2)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{"ctf", "none"}, lt.tagValues(map[string]string{"game_mode": "ctf"}))
+	assert.Equal(t, []string{"deathmatch", "eu"}, lt.tagValues(map[string]string{"game_mode": "deathmatch", "region": "eu"}))
+	// the cap of distinct game modes has been reached
+	assert.Equal(t, []string{"other", "us"}, lt.tagValues(map[string]string{"game_mode": "race", "region": "us"}))
+	// known values are still exported
+	assert.Equal(t, []string{"ctf", "eu"}, lt.tagValues(map[string]string{"game_mode": "ctf", "region": "eu"}))
+	assert.Equal(t, []string{"none", "none"}, lt.tagValues(map[string]string{"game_mode": ""}))
+
+	none, err := NewExportedLabels(nil, 0)
+	assert.NoError(t, err)
+	assert.Nil(t, none.tagValues(map[string]string{"game_mode": "ctf"}))
+}
+
+func TestControllerExportedLabels(t *testing.T) {
+	c := newFakeController()
+	defer c.close()
+	el, err := NewExportedLabels([]string{"game_mode"}, 1)
+	assert.NoError(t, err)
+	c.exportedLabels = el
+	// the order gameservers are listed in is not deterministic, so make sure "ctf" is the value that is exported
+	el.tagValues(map[string]string{"game_mode": "ctf"})
+
+	gameServerWithMode := func(mode string) *agonesv1.GameServer {
+		gs := gameServerWithFleetAndState("test-fleet", agonesv1.GameServerStateReady)
+		if mode != "" {
+			gs.ObjectMeta.Labels["game_mode"] = mode
+		}
+		return gs
+	}
+	c.gsWatch.Add(gameServerWithMode("ctf"))
+	c.gsWatch.Add(gameServerWithMode("ctf"))
+	c.gsWatch.Add(gameServerWithMode("race"))
+	c.gsWatch.Add(gameServerWithMode(""))
+
+	err = wait.PollImmediate(10*time.Millisecond, 5*time.Second, func() (bool, error) {
+		list, err := c.gameServerLister.List(labels.Everything())
+		return len(list) == 4, err
+	})
+	assert.NoError(t, err)
+	c.collectGameServerCounts()
+
+	assert.Equal(t, map[gameServerCountKey]int64{
+		{fleetName: "test-fleet", labelValues: "ctf"}:   2,
+		{fleetName: "test-fleet", labelValues: "other"}: 1,
+		{fleetName: "test-fleet", labelValues: "none"}:  1,
+	}, c.gsCount[agonesv1.GameServerStateReady])
+}
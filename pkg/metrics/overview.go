@@ -0,0 +1,223 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"sync"
+
+	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
+	autoscalingv1 "agones.dev/agones/pkg/apis/autoscaling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// FleetsOverview is the summary of all the Fleets of the cluster
+type FleetsOverview struct {
+	// Replicas is the total number of GameServer replicas of all the Fleets
+	Replicas int32 `json:"replicas"`
+	// ReadyReplicas is the total number of Ready GameServer replicas of all the Fleets
+	ReadyReplicas int32 `json:"readyReplicas"`
+	// ReservedReplicas is the total number of Reserved GameServer replicas of all the Fleets
+	ReservedReplicas int32 `json:"reservedReplicas"`
+	// AllocatedReplicas is the total number of Allocated GameServer replicas of all the Fleets
+	AllocatedReplicas int32 `json:"allocatedReplicas"`
+	// Fleets are the summaries of each Fleet, sorted by namespace and name
+	Fleets []FleetOverview `json:"fleets"`
+}
+
+// FleetOverview is the summary of a single Fleet
+type FleetOverview struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	// DesiredReplicas is the number of replicas in the spec of the Fleet
+	DesiredReplicas   int32 `json:"desiredReplicas"`
+	Replicas          int32 `json:"replicas"`
+	ReadyReplicas     int32 `json:"readyReplicas"`
+	ReservedReplicas  int32 `json:"reservedReplicas"`
+	AllocatedReplicas int32 `json:"allocatedReplicas"`
+	// GameServerSets is the number of GameServerSets of the Fleet
+	GameServerSets int `json:"gameServerSets"`
+	// RollingOut is true while the Fleet, or one of its node pools, has more than one GameServerSet,
+	// i.e. it is rolling out a new template, or GameServers of a previous template are still Allocated
+	RollingOut bool `json:"rollingOut"`
+	// Autoscaler is the summary of the FleetAutoscaler of the Fleet, if it has one
+	Autoscaler *FleetAutoscalerOverview `json:"autoscaler,omitempty"`
+}
+
+// FleetAutoscalerOverview is the summary of a FleetAutoscaler
+type FleetAutoscalerOverview struct {
+	Name            string       `json:"name"`
+	AbleToScale     bool         `json:"ableToScale"`
+	ScalingLimited  bool         `json:"scalingLimited"`
+	CurrentReplicas int32        `json:"currentReplicas"`
+	DesiredReplicas int32        `json:"desiredReplicas"`
+	LastScaleTime   *metav1.Time `json:"lastScaleTime,omitempty"`
+}
+
+// fleetsOverview keeps the summary of all the Fleets up to date from the Fleet, FleetAutoscaler
+// and GameServerSet events, so that serving it doesn't have to go through the informer caches
+type fleetsOverview struct {
+	lock sync.RWMutex
+	// fleets are the summaries of the Fleets by namespace/name, without their GameServerSets and FleetAutoscaler
+	fleets map[string]FleetOverview
+	// autoscalers are the FleetAutoscalers by the namespace/name of their Fleet
+	autoscalers map[string]*FleetAutoscalerOverview
+	// gameServerSets are the node pools of the GameServerSets by their name, by the namespace/name of their Fleet
+	gameServerSets map[string]map[string]string
+}
+
+// newFleetsOverview returns an empty fleetsOverview
+func newFleetsOverview() *fleetsOverview {
+	return &fleetsOverview{
+		fleets:         map[string]FleetOverview{},
+		autoscalers:    map[string]*FleetAutoscalerOverview{},
+		gameServerSets: map[string]map[string]string{},
+	}
+}
+
+// fleetKey returns the namespace/name key of a Fleet
+func fleetKey(namespace, name string) string {
+	return namespace + "/" + name
+}
+
+// upsertFleet adds or updates a Fleet
+func (o *fleetsOverview) upsertFleet(f *agonesv1.Fleet) {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	o.fleets[fleetKey(f.ObjectMeta.Namespace, f.ObjectMeta.Name)] = FleetOverview{
+		Namespace:         f.ObjectMeta.Namespace,
+		Name:              f.ObjectMeta.Name,
+		DesiredReplicas:   f.Spec.Replicas,
+		Replicas:          f.Status.Replicas,
+		ReadyReplicas:     f.Status.ReadyReplicas,
+		ReservedReplicas:  f.Status.ReservedReplicas,
+		AllocatedReplicas: f.Status.AllocatedReplicas,
+	}
+}
+
+// deleteFleet removes a Fleet
+func (o *fleetsOverview) deleteFleet(f *agonesv1.Fleet) {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	delete(o.fleets, fleetKey(f.ObjectMeta.Namespace, f.ObjectMeta.Name))
+}
+
+// upsertFleetAutoscaler adds or updates a FleetAutoscaler
+func (o *fleetsOverview) upsertFleetAutoscaler(fas *autoscalingv1.FleetAutoscaler) {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	o.autoscalers[fleetKey(fas.ObjectMeta.Namespace, fas.Spec.FleetName)] = &FleetAutoscalerOverview{
+		Name:            fas.ObjectMeta.Name,
+		AbleToScale:     fas.Status.AbleToScale,
+		ScalingLimited:  fas.Status.ScalingLimited,
+		CurrentReplicas: fas.Status.CurrentReplicas,
+		DesiredReplicas: fas.Status.DesiredReplicas,
+		LastScaleTime:   fas.Status.LastScaleTime,
+	}
+}
+
+// deleteFleetAutoscaler removes a FleetAutoscaler, unless another one has since been added for its Fleet
+func (o *fleetsOverview) deleteFleetAutoscaler(fas *autoscalingv1.FleetAutoscaler) {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	key := fleetKey(fas.ObjectMeta.Namespace, fas.Spec.FleetName)
+	if a, ok := o.autoscalers[key]; ok && a.Name == fas.ObjectMeta.Name {
+		delete(o.autoscalers, key)
+	}
+}
+
+// upsertGameServerSet adds a GameServerSet to its Fleet
+func (o *fleetsOverview) upsertGameServerSet(gsSet *agonesv1.GameServerSet) {
+	fleetName := gsSet.ObjectMeta.Labels[agonesv1.FleetNameLabel]
+	if fleetName == "" {
+		return
+	}
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	key := fleetKey(gsSet.ObjectMeta.Namespace, fleetName)
+	if o.gameServerSets[key] == nil {
+		o.gameServerSets[key] = map[string]string{}
+	}
+	o.gameServerSets[key][gsSet.ObjectMeta.Name] = gsSet.ObjectMeta.Labels[agonesv1.FleetNodePoolLabel]
+}
+
+// deleteGameServerSet removes a GameServerSet from its Fleet
+func (o *fleetsOverview) deleteGameServerSet(gsSet *agonesv1.GameServerSet) {
+	key := fleetKey(gsSet.ObjectMeta.Namespace, gsSet.ObjectMeta.Labels[agonesv1.FleetNameLabel])
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	delete(o.gameServerSets[key], gsSet.ObjectMeta.Name)
+	if len(o.gameServerSets[key]) == 0 {
+		delete(o.gameServerSets, key)
+	}
+}
+
+// overview returns the summary of all the Fleets
+func (o *fleetsOverview) overview() FleetsOverview {
+	o.lock.RLock()
+	defer o.lock.RUnlock()
+
+	result := FleetsOverview{Fleets: make([]FleetOverview, 0, len(o.fleets))}
+	for key, f := range o.fleets {
+		f.GameServerSets = len(o.gameServerSets[key])
+		f.RollingOut = rollingOut(o.gameServerSets[key])
+		if a, ok := o.autoscalers[key]; ok {
+			aCopy := *a
+			f.Autoscaler = &aCopy
+		}
+		result.Fleets = append(result.Fleets, f)
+
+		result.Replicas += f.Replicas
+		result.ReadyReplicas += f.ReadyReplicas
+		result.ReservedReplicas += f.ReservedReplicas
+		result.AllocatedReplicas += f.AllocatedReplicas
+	}
+
+	sort.Slice(result.Fleets, func(i, j int) bool {
+		if result.Fleets[i].Namespace != result.Fleets[j].Namespace {
+			return result.Fleets[i].Namespace < result.Fleets[j].Namespace
+		}
+		return result.Fleets[i].Name < result.Fleets[j].Name
+	})
+
+	return result
+}
+
+// rollingOut returns whether a node pool has more than one of the GameServerSets, which are by name,
+// with their node pool. GameServerSets of Fleets without node pools have no node pool.
+func rollingOut(gameServerSets map[string]string) bool {
+	pools := make(map[string]bool, len(gameServerSets))
+	for _, pool := range gameServerSets {
+		if pools[pool] {
+			return true
+		}
+		pools[pool] = true
+	}
+	return false
+}
+
+// ServeHTTP serves the summary of all the Fleets as JSON
+func (o *fleetsOverview) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(o.overview()); err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
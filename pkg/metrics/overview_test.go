@@ -0,0 +1,155 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+func TestFleetsOverview(t *testing.T) {
+	t.Parallel()
+
+	gameServerSet := func(fleetName, name string) *agonesv1.GameServerSet {
+		return &agonesv1.GameServerSet{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default",
+			Labels: map[string]string{agonesv1.FleetNameLabel: fleetName}}}
+	}
+	nodePoolGameServerSet := func(fleetName, pool, name string) *agonesv1.GameServerSet {
+		gsSet := gameServerSet(fleetName, name)
+		gsSet.ObjectMeta.Labels[agonesv1.FleetNodePoolLabel] = pool
+		return gsSet
+	}
+
+	o := newFleetsOverview()
+	o.upsertFleet(fleet("fleet-b", 8, 2, 5, 10))
+	o.upsertFleet(fleet("fleet-a", 3, 1, 2, 3))
+	o.upsertFleet(fleet("fleet-c", 0, 0, 0, 0))
+	deleted := fleet("fleet-deleted", 100, 100, 100, 100)
+	o.upsertFleet(deleted)
+	o.deleteFleet(deleted)
+
+	o.upsertFleetAutoscaler(fleetAutoScaler("fleet-b", "fas-b"))
+	o.upsertFleetAutoscaler(fleetAutoScaler("fleet-a", "fas-a"))
+	// a deleted autoscaler that has been replaced doesn't remove its replacement
+	o.deleteFleetAutoscaler(fleetAutoScaler("fleet-b", "fas-old"))
+	o.deleteFleetAutoscaler(fleetAutoScaler("fleet-a", "fas-a"))
+
+	o.upsertGameServerSet(gameServerSet("fleet-b", "fleet-b-1"))
+	o.upsertGameServerSet(gameServerSet("fleet-b", "fleet-b-2"))
+	o.upsertGameServerSet(gameServerSet("fleet-a", "fleet-a-1"))
+	o.upsertGameServerSet(gameServerSet("fleet-a", "fleet-a-2"))
+	o.deleteGameServerSet(gameServerSet("fleet-a", "fleet-a-1"))
+	o.upsertGameServerSet(gameServerSet("", "no-fleet"))
+	// one GameServerSet per node pool
+	o.upsertGameServerSet(nodePoolGameServerSet("fleet-c", "pool-1", "fleet-c-pool-1-1"))
+	o.upsertGameServerSet(nodePoolGameServerSet("fleet-c", "pool-2", "fleet-c-pool-2-1"))
+
+	result := o.overview()
+	assert.Equal(t, int32(11), result.Replicas)
+	assert.Equal(t, int32(7), result.ReadyReplicas)
+	assert.Equal(t, int32(3), result.AllocatedReplicas)
+	if assert.Len(t, result.Fleets, 3) {
+		a := result.Fleets[0]
+		assert.Equal(t, "fleet-a", a.Name)
+		assert.Equal(t, "default", a.Namespace)
+		assert.Equal(t, int32(3), a.DesiredReplicas)
+		assert.Equal(t, 1, a.GameServerSets)
+		assert.False(t, a.RollingOut)
+		assert.Nil(t, a.Autoscaler)
+
+		b := result.Fleets[1]
+		assert.Equal(t, "fleet-b", b.Name)
+		assert.Equal(t, int32(10), b.DesiredReplicas)
+		assert.Equal(t, int32(8), b.Replicas)
+		assert.Equal(t, int32(5), b.ReadyReplicas)
+		assert.Equal(t, int32(2), b.AllocatedReplicas)
+		assert.Equal(t, 2, b.GameServerSets)
+		assert.True(t, b.RollingOut)
+		if assert.NotNil(t, b.Autoscaler) {
+			assert.Equal(t, "fas-b", b.Autoscaler.Name)
+			assert.True(t, b.Autoscaler.AbleToScale)
+			assert.Equal(t, int32(20), b.Autoscaler.DesiredReplicas)
+		}
+
+		c := result.Fleets[2]
+		assert.Equal(t, "fleet-c", c.Name)
+		assert.Equal(t, 2, c.GameServerSets)
+		assert.False(t, c.RollingOut)
+	}
+
+	// a node pool rolling out a new template
+	o.upsertGameServerSet(nodePoolGameServerSet("fleet-c", "pool-2", "fleet-c-pool-2-2"))
+	c := o.overview().Fleets[2]
+	assert.Equal(t, 3, c.GameServerSets)
+	assert.True(t, c.RollingOut)
+
+	t.Run("http", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		o.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/fleets", nil))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+
+		served := FleetsOverview{}
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &served))
+		assert.Equal(t, o.overview(), served)
+
+		rec = httptest.NewRecorder()
+		o.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/fleets", nil))
+		assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+	})
+}
+
+func TestControllerFleetsOverview(t *testing.T) {
+	c := newFakeController()
+	defer c.close()
+	c.run(t)
+
+	fd := fleet("fleet-deleted", 100, 100, 100, 100)
+	c.fleetWatch.Add(fd)
+	c.fleetWatch.Delete(fd)
+	c.fleetWatch.Add(fleet("fleet-test", 8, 2, 5, 1))
+
+	fas := fleetAutoScaler("first-fleet", "fas-test")
+	c.fasWatch.Add(fas)
+	fas = fas.DeepCopy()
+	fas.Spec.FleetName = "fleet-test"
+	c.fasWatch.Modify(fas)
+
+	c.sync()
+
+	// wait for the events to be processed
+	err := wait.PollImmediate(10*time.Millisecond, 5*time.Second, func() (bool, error) {
+		result := c.overview.overview()
+		return len(result.Fleets) == 1 && result.Fleets[0].Name == "fleet-test" && result.Fleets[0].Autoscaler != nil, nil
+	})
+	assert.NoError(t, err)
+
+	result := c.overview.overview()
+	if assert.Len(t, result.Fleets, 1) {
+		assert.Equal(t, "fleet-test", result.Fleets[0].Name)
+		if assert.NotNil(t, result.Fleets[0].Autoscaler) {
+			assert.Equal(t, "fas-test", result.Fleets[0].Autoscaler.Name)
+		}
+	}
+	assert.Len(t, c.overview.autoscalers, 1)
+}
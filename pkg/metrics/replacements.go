@@ -0,0 +1,86 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// replacementClockSkew is how much earlier than a gameserver became unhealthy, as seen by the metrics controller,
+	// a gameserver can be created and still count as its replacement, since creation timestamps are set by the API server
+	replacementClockSkew = 10 * time.Second
+	// replacementMaxAge is how long an unhealthy gameserver waits for a replacement, before it is no longer tracked
+	replacementMaxAge = time.Hour
+)
+
+// unhealthyReplacements keeps track of when gameservers became unhealthy, per fleet or gameserverset,
+// to measure how long it takes until a replacement gameserver is Ready
+type unhealthyReplacements struct {
+	mu sync.Mutex
+	// pending is the times that gameservers became unhealthy, oldest first, by owner key
+	pending map[string][]time.Time
+}
+
+// newUnhealthyReplacements returns an unhealthyReplacements with no unhealthy gameservers
+func newUnhealthyReplacements() *unhealthyReplacements {
+	return &unhealthyReplacements{pending: map[string][]time.Time{}}
+}
+
+// unhealthy records that a gameserver of the given owner became unhealthy at the given time
+func (u *unhealthyReplacements) unhealthy(key string, at time.Time) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.pending[key] = append(u.pending[key], at)
+}
+
+// ready records that a gameserver of the given owner, created at the given time, is Ready.
+// If it was created after the oldest unhealthy gameserver of the owner, it is its replacement,
+// and the time from when that gameserver became unhealthy is returned.
+func (u *unhealthyReplacements) ready(key string, created time.Time, now time.Time) (time.Duration, bool) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	pending := u.pending[key]
+	if len(pending) == 0 || created.Before(pending[0].Add(-replacementClockSkew)) {
+		return 0, false
+	}
+	if len(pending) == 1 {
+		delete(u.pending, key)
+	} else {
+		u.pending[key] = pending[1:]
+	}
+	return now.Sub(pending[0]), true
+}
+
+// prune stops tracking the unhealthy gameservers that have not been replaced for longer than replacementMaxAge,
+// e.g. because their fleet has been scaled down or deleted
+func (u *unhealthyReplacements) prune(now time.Time) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	for key, pending := range u.pending {
+		i := 0
+		for i < len(pending) && now.Sub(pending[i]) > replacementMaxAge {
+			i++
+		}
+		if i == len(pending) {
+			delete(u.pending, key)
+		} else if i > 0 {
+			u.pending[key] = pending[i:]
+		}
+	}
+}
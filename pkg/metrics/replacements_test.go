@@ -0,0 +1,63 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnhealthyReplacements(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	t.Run("replaced in order", func(t *testing.T) {
+		u := newUnhealthyReplacements()
+		u.unhealthy("default/fleet", now)
+		u.unhealthy("default/fleet", now.Add(time.Second))
+
+		// created before the gameservers became unhealthy
+		_, ok := u.ready("default/fleet", now.Add(-time.Minute), now.Add(5*time.Second))
+		assert.False(t, ok)
+		// other owners are not affected
+		_, ok = u.ready("default/other", now.Add(time.Second), now.Add(5*time.Second))
+		assert.False(t, ok)
+
+		d, ok := u.ready("default/fleet", now.Add(time.Second), now.Add(5*time.Second))
+		assert.True(t, ok)
+		assert.Equal(t, 5*time.Second, d)
+		// created a little before, within the clock skew
+		d, ok = u.ready("default/fleet", now.Add(-time.Second), now.Add(6*time.Second))
+		assert.True(t, ok)
+		assert.Equal(t, 5*time.Second, d)
+
+		_, ok = u.ready("default/fleet", now.Add(time.Second), now.Add(7*time.Second))
+		assert.False(t, ok)
+		assert.Empty(t, u.pending)
+	})
+
+	t.Run("prune", func(t *testing.T) {
+		u := newUnhealthyReplacements()
+		u.unhealthy("default/fleet", now)
+		u.unhealthy("default/fleet", now.Add(time.Minute))
+		u.unhealthy("default/other", now)
+
+		u.prune(now.Add(replacementMaxAge + time.Second))
+		assert.Equal(t, map[string][]time.Time{"default/fleet": {now.Add(time.Minute)}}, u.pending)
+	})
+}
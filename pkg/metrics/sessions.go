@@ -0,0 +1,88 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// churnWindow is the window over which the allocations of each fleet are counted
+const churnWindow = time.Minute
+
+// allocationSessions keeps track of when gameservers were allocated, to measure the allocation churn
+// of each fleet, and how long gameservers stay Allocated.
+// Gameservers that were already Allocated when the controller started are not tracked.
+type allocationSessions struct {
+	mu sync.Mutex
+	// allocated is when each Allocated gameserver was allocated, by uid
+	allocated map[types.UID]time.Time
+	// recent is the times of the allocations within the churn window, oldest first, by fleet
+	recent map[string][]time.Time
+}
+
+// newAllocationSessions returns an allocationSessions with no allocated gameservers
+func newAllocationSessions() *allocationSessions {
+	return &allocationSessions{allocated: map[types.UID]time.Time{}, recent: map[string][]time.Time{}}
+}
+
+// allocate records that the gameserver with the given uid, of the given fleet, was allocated at the given time.
+// Gameservers that are not part of a fleet are not counted in the churn of any fleet.
+func (s *allocationSessions) allocate(uid types.UID, fleet string, at time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.allocated[uid] = at
+	if fleet != "" {
+		s.recent[fleet] = append(s.recent[fleet], at)
+	}
+}
+
+// end records that the gameserver with the given uid is no longer Allocated,
+// and returns how long it was Allocated, if it was tracked
+func (s *allocationSessions) end(uid types.UID, now time.Time) (time.Duration, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	at, ok := s.allocated[uid]
+	if !ok {
+		return 0, false
+	}
+	delete(s.allocated, uid)
+	return now.Sub(at), true
+}
+
+// churn returns the number of allocations of each fleet within the churn window,
+// for the fleets that had any, and stops tracking the older allocations
+func (s *allocationSessions) churn(now time.Time) map[string]int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result := make(map[string]int64, len(s.recent))
+	for fleet, recent := range s.recent {
+		i := 0
+		for i < len(recent) && now.Sub(recent[i]) > churnWindow {
+			i++
+		}
+		if i == len(recent) {
+			delete(s.recent, fleet)
+			continue
+		}
+		s.recent[fleet] = recent[i:]
+		result[fleet] = int64(len(recent) - i)
+	}
+	return result
+}
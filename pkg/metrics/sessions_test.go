@@ -0,0 +1,61 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAllocationSessions(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	t.Run("end", func(t *testing.T) {
+		s := newAllocationSessions()
+		s.allocate("1", "fleet", now)
+
+		// not tracked
+		_, ok := s.end("2", now.Add(time.Minute))
+		assert.False(t, ok)
+
+		d, ok := s.end("1", now.Add(time.Minute))
+		assert.True(t, ok)
+		assert.Equal(t, time.Minute, d)
+
+		_, ok = s.end("1", now.Add(2*time.Minute))
+		assert.False(t, ok)
+		assert.Empty(t, s.allocated)
+	})
+
+	t.Run("churn", func(t *testing.T) {
+		s := newAllocationSessions()
+		s.allocate("1", "fleet", now)
+		s.allocate("2", "fleet", now.Add(30*time.Second))
+		s.allocate("3", "fleet", now.Add(40*time.Second))
+		s.allocate("4", "other", now)
+		// not part of a fleet
+		s.allocate("5", "", now)
+
+		assert.Equal(t, map[string]int64{"fleet": 3, "other": 1}, s.churn(now.Add(40*time.Second)))
+		assert.Equal(t, map[string]int64{"fleet": 2}, s.churn(now.Add(churnWindow+time.Second)))
+		assert.Equal(t, map[string][]time.Time{"fleet": {now.Add(30 * time.Second), now.Add(40 * time.Second)}}, s.recent)
+		assert.Empty(t, s.churn(now.Add(2*churnWindow)))
+		assert.Empty(t, s.recent)
+	})
+}
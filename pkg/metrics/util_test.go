@@ -16,6 +16,7 @@ package metrics
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
@@ -35,7 +36,7 @@ import (
 // newFakeController returns a controller, backed by the fake Clientset
 func newFakeController() *fakeController {
 	m := agtesting.NewMocks()
-	c := NewController(m.KubeClient, m.AgonesClient, m.KubeInformerFactory, m.AgonesInformerFactory)
+	c := NewController(NodeCostModel{}, nil, m.KubeClient, m.AgonesClient, m.KubeInformerFactory, m.AgonesInformerFactory)
 	gsWatch := watch.NewFake()
 	fasWatch := watch.NewFake()
 	fleetWatch := watch.NewFake()
@@ -195,6 +196,81 @@ agones_gameservers_count{fleet_name="test-fleet",type="Shutdown"} 1
 agones_gameservers_count{fleet_name="none",type="PortAllocation"} 2
 `
 
+var gsDeletionsExpected = `# HELP agones_gameserver_deletions_total The total of deleted gameservers, by the reason they were shut down
+# TYPE agones_gameserver_deletions_total counter
+agones_gameserver_deletions_total{fleet_name="none",reason="Unhealthy"} 1
+agones_gameserver_deletions_total{fleet_name="test",reason="Manual"} 1
+agones_gameserver_deletions_total{fleet_name="test",reason="SDKShutdown"} 1
+agones_gameserver_deletions_total{fleet_name="test",reason="ScaleDown"} 1
+`
+
+var gsLatenciesExpected = `# HELP agones_gameserver_pod_creation_duration_seconds The distribution of the time from gameserver creation to the creation of its pod
+# TYPE agones_gameserver_pod_creation_duration_seconds histogram
+agones_gameserver_pod_creation_duration_seconds_bucket{fleet_name="test",le="0"} 0
+agones_gameserver_pod_creation_duration_seconds_bucket{fleet_name="test",le="0.5"} 0
+agones_gameserver_pod_creation_duration_seconds_bucket{fleet_name="test",le="1"} 0
+agones_gameserver_pod_creation_duration_seconds_bucket{fleet_name="test",le="2.5"} 0
+agones_gameserver_pod_creation_duration_seconds_bucket{fleet_name="test",le="5"} 1
+agones_gameserver_pod_creation_duration_seconds_bucket{fleet_name="test",le="10"} 1
+agones_gameserver_pod_creation_duration_seconds_bucket{fleet_name="test",le="15"} 1
+agones_gameserver_pod_creation_duration_seconds_bucket{fleet_name="test",le="30"} 1
+agones_gameserver_pod_creation_duration_seconds_bucket{fleet_name="test",le="60"} 1
+agones_gameserver_pod_creation_duration_seconds_bucket{fleet_name="test",le="120"} 1
+agones_gameserver_pod_creation_duration_seconds_bucket{fleet_name="test",le="300"} 1
+agones_gameserver_pod_creation_duration_seconds_bucket{fleet_name="test",le="600"} 1
+agones_gameserver_pod_creation_duration_seconds_bucket{fleet_name="test",le="+Inf"} 1
+agones_gameserver_pod_creation_duration_seconds_sum{fleet_name="test"} 3
+agones_gameserver_pod_creation_duration_seconds_count{fleet_name="test"} 1
+# HELP agones_gameserver_unhealthy_replacement_duration_seconds The distribution of the time from a gameserver becoming unhealthy to a replacement gameserver being ready
+# TYPE agones_gameserver_unhealthy_replacement_duration_seconds histogram
+agones_gameserver_unhealthy_replacement_duration_seconds_bucket{fleet_name="test",le="0"} 0
+agones_gameserver_unhealthy_replacement_duration_seconds_bucket{fleet_name="test",le="0.5"} 0
+agones_gameserver_unhealthy_replacement_duration_seconds_bucket{fleet_name="test",le="1"} 0
+agones_gameserver_unhealthy_replacement_duration_seconds_bucket{fleet_name="test",le="2.5"} 0
+agones_gameserver_unhealthy_replacement_duration_seconds_bucket{fleet_name="test",le="5"} 0
+agones_gameserver_unhealthy_replacement_duration_seconds_bucket{fleet_name="test",le="10"} 0
+agones_gameserver_unhealthy_replacement_duration_seconds_bucket{fleet_name="test",le="15"} 0
+agones_gameserver_unhealthy_replacement_duration_seconds_bucket{fleet_name="test",le="30"} 1
+agones_gameserver_unhealthy_replacement_duration_seconds_bucket{fleet_name="test",le="60"} 1
+agones_gameserver_unhealthy_replacement_duration_seconds_bucket{fleet_name="test",le="120"} 1
+agones_gameserver_unhealthy_replacement_duration_seconds_bucket{fleet_name="test",le="300"} 1
+agones_gameserver_unhealthy_replacement_duration_seconds_bucket{fleet_name="test",le="600"} 1
+agones_gameserver_unhealthy_replacement_duration_seconds_bucket{fleet_name="test",le="+Inf"} 1
+agones_gameserver_unhealthy_replacement_duration_seconds_sum{fleet_name="test"} 20
+agones_gameserver_unhealthy_replacement_duration_seconds_count{fleet_name="test"} 1
+`
+
+var gsAllocatedDurationExpected = `# HELP agones_gameserver_allocated_duration_seconds The distribution of the time gameservers stay Allocated, until they are Ready again or shut down
+# TYPE agones_gameserver_allocated_duration_seconds histogram
+agones_gameserver_allocated_duration_seconds_bucket{fleet_name="test",le="0"} 0
+agones_gameserver_allocated_duration_seconds_bucket{fleet_name="test",le="30"} 0
+agones_gameserver_allocated_duration_seconds_bucket{fleet_name="test",le="60"} 0
+agones_gameserver_allocated_duration_seconds_bucket{fleet_name="test",le="120"} 0
+agones_gameserver_allocated_duration_seconds_bucket{fleet_name="test",le="300"} 0
+agones_gameserver_allocated_duration_seconds_bucket{fleet_name="test",le="600"} 0
+agones_gameserver_allocated_duration_seconds_bucket{fleet_name="test",le="900"} 1
+agones_gameserver_allocated_duration_seconds_bucket{fleet_name="test",le="1200"} 1
+agones_gameserver_allocated_duration_seconds_bucket{fleet_name="test",le="1800"} 1
+agones_gameserver_allocated_duration_seconds_bucket{fleet_name="test",le="2700"} 1
+agones_gameserver_allocated_duration_seconds_bucket{fleet_name="test",le="3600"} 1
+agones_gameserver_allocated_duration_seconds_bucket{fleet_name="test",le="5400"} 2
+agones_gameserver_allocated_duration_seconds_bucket{fleet_name="test",le="7200"} 2
+agones_gameserver_allocated_duration_seconds_bucket{fleet_name="test",le="10800"} 2
+agones_gameserver_allocated_duration_seconds_bucket{fleet_name="test",le="21600"} 2
+agones_gameserver_allocated_duration_seconds_bucket{fleet_name="test",le="43200"} 2
+agones_gameserver_allocated_duration_seconds_bucket{fleet_name="test",le="86400"} 2
+agones_gameserver_allocated_duration_seconds_bucket{fleet_name="test",le="+Inf"} 2
+agones_gameserver_allocated_duration_seconds_sum{fleet_name="test"} 4200
+agones_gameserver_allocated_duration_seconds_count{fleet_name="test"} 2
+`
+
+func fleetAllocationsExpected(count int) string {
+	return fmt.Sprintf(`# HELP agones_fleets_allocations_per_minute The number of allocations per fleet in the last minute
+# TYPE agones_fleets_allocations_per_minute gauge
+agones_fleets_allocations_per_minute{name="test"} %d
+`, count)
+}
+
 var gsTotalExpected = `# HELP agones_gameservers_total The total of gameservers
 # TYPE agones_gameservers_total counter
 agones_gameservers_total{fleet_name="test",type="Creating"} 16
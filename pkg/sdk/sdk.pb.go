@@ -50,7 +50,7 @@ func (m *Empty) Reset()         { *m = Empty{} }
 func (m *Empty) String() string { return proto.CompactTextString(m) }
 func (*Empty) ProtoMessage()    {}
 func (*Empty) Descriptor() ([]byte, []int) {
-	return fileDescriptor_sdk_a0e878ab4087e6bc, []int{0}
+	return fileDescriptor_sdk_211a8e1a69b10fc2, []int{0}
 }
 func (m *Empty) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Empty.Unmarshal(m, b)
@@ -83,7 +83,7 @@ func (m *KeyValue) Reset()         { *m = KeyValue{} }
 func (m *KeyValue) String() string { return proto.CompactTextString(m) }
 func (*KeyValue) ProtoMessage()    {}
 func (*KeyValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_sdk_a0e878ab4087e6bc, []int{1}
+	return fileDescriptor_sdk_211a8e1a69b10fc2, []int{1}
 }
 func (m *KeyValue) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_KeyValue.Unmarshal(m, b)
@@ -129,7 +129,7 @@ func (m *Duration) Reset()         { *m = Duration{} }
 func (m *Duration) String() string { return proto.CompactTextString(m) }
 func (*Duration) ProtoMessage()    {}
 func (*Duration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_sdk_a0e878ab4087e6bc, []int{2}
+	return fileDescriptor_sdk_211a8e1a69b10fc2, []int{2}
 }
 func (m *Duration) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Duration.Unmarshal(m, b)
@@ -156,6 +156,100 @@ func (m *Duration) GetSeconds() int64 {
 	return 0
 }
 
+// An update to a named Counter
+type CounterUpdate struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Amount               int64    `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CounterUpdate) Reset()         { *m = CounterUpdate{} }
+func (m *CounterUpdate) String() string { return proto.CompactTextString(m) }
+func (*CounterUpdate) ProtoMessage()    {}
+func (*CounterUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_sdk_211a8e1a69b10fc2, []int{3}
+}
+func (m *CounterUpdate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CounterUpdate.Unmarshal(m, b)
+}
+func (m *CounterUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CounterUpdate.Marshal(b, m, deterministic)
+}
+func (dst *CounterUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CounterUpdate.Merge(dst, src)
+}
+func (m *CounterUpdate) XXX_Size() int {
+	return xxx_messageInfo_CounterUpdate.Size(m)
+}
+func (m *CounterUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_CounterUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CounterUpdate proto.InternalMessageInfo
+
+func (m *CounterUpdate) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CounterUpdate) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+// A value in a named List
+type ListValue struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value                string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListValue) Reset()         { *m = ListValue{} }
+func (m *ListValue) String() string { return proto.CompactTextString(m) }
+func (*ListValue) ProtoMessage()    {}
+func (*ListValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_sdk_211a8e1a69b10fc2, []int{4}
+}
+func (m *ListValue) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListValue.Unmarshal(m, b)
+}
+func (m *ListValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListValue.Marshal(b, m, deterministic)
+}
+func (dst *ListValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListValue.Merge(dst, src)
+}
+func (m *ListValue) XXX_Size() int {
+	return xxx_messageInfo_ListValue.Size(m)
+}
+func (m *ListValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListValue proto.InternalMessageInfo
+
+func (m *ListValue) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ListValue) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
 // A GameServer Custom Resource Definition object
 // We will only export those resources that make the most
 // sense. Can always expand to more as needed.
@@ -172,7 +266,7 @@ func (m *GameServer) Reset()         { *m = GameServer{} }
 func (m *GameServer) String() string { return proto.CompactTextString(m) }
 func (*GameServer) ProtoMessage()    {}
 func (*GameServer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_sdk_a0e878ab4087e6bc, []int{3}
+	return fileDescriptor_sdk_211a8e1a69b10fc2, []int{5}
 }
 func (m *GameServer) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GameServer.Unmarshal(m, b)
@@ -235,7 +329,7 @@ func (m *GameServer_ObjectMeta) Reset()         { *m = GameServer_ObjectMeta{} }
 func (m *GameServer_ObjectMeta) String() string { return proto.CompactTextString(m) }
 func (*GameServer_ObjectMeta) ProtoMessage()    {}
 func (*GameServer_ObjectMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_sdk_a0e878ab4087e6bc, []int{3, 0}
+	return fileDescriptor_sdk_211a8e1a69b10fc2, []int{5, 0}
 }
 func (m *GameServer_ObjectMeta) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GameServer_ObjectMeta.Unmarshal(m, b)
@@ -329,7 +423,7 @@ func (m *GameServer_Spec) Reset()         { *m = GameServer_Spec{} }
 func (m *GameServer_Spec) String() string { return proto.CompactTextString(m) }
 func (*GameServer_Spec) ProtoMessage()    {}
 func (*GameServer_Spec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_sdk_a0e878ab4087e6bc, []int{3, 1}
+	return fileDescriptor_sdk_211a8e1a69b10fc2, []int{5, 1}
 }
 func (m *GameServer_Spec) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GameServer_Spec.Unmarshal(m, b)
@@ -370,7 +464,7 @@ func (m *GameServer_Spec_Health) Reset()         { *m = GameServer_Spec_Health{}
 func (m *GameServer_Spec_Health) String() string { return proto.CompactTextString(m) }
 func (*GameServer_Spec_Health) ProtoMessage()    {}
 func (*GameServer_Spec_Health) Descriptor() ([]byte, []int) {
-	return fileDescriptor_sdk_a0e878ab4087e6bc, []int{3, 1, 0}
+	return fileDescriptor_sdk_211a8e1a69b10fc2, []int{5, 1, 0}
 }
 func (m *GameServer_Spec_Health) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GameServer_Spec_Health.Unmarshal(m, b)
@@ -419,19 +513,22 @@ func (m *GameServer_Spec_Health) GetInitialDelaySeconds() int32 {
 }
 
 type GameServer_Status struct {
-	State                string                    `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
-	Address              string                    `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	Ports                []*GameServer_Status_Port `protobuf:"bytes,3,rep,name=ports,proto3" json:"ports,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
-	XXX_unrecognized     []byte                    `json:"-"`
-	XXX_sizecache        int32                     `json:"-"`
+	State                string                                `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	Address              string                                `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Ports                []*GameServer_Status_Port             `protobuf:"bytes,3,rep,name=ports,proto3" json:"ports,omitempty"`
+	Counters             map[string]*GameServer_Status_Counter `protobuf:"bytes,4,rep,name=counters,proto3" json:"counters,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Lists                map[string]*GameServer_Status_List    `protobuf:"bytes,5,rep,name=lists,proto3" json:"lists,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Disruption           *GameServer_Status_Disruption         `protobuf:"bytes,6,opt,name=disruption,proto3" json:"disruption,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                              `json:"-"`
+	XXX_unrecognized     []byte                                `json:"-"`
+	XXX_sizecache        int32                                 `json:"-"`
 }
 
 func (m *GameServer_Status) Reset()         { *m = GameServer_Status{} }
 func (m *GameServer_Status) String() string { return proto.CompactTextString(m) }
 func (*GameServer_Status) ProtoMessage()    {}
 func (*GameServer_Status) Descriptor() ([]byte, []int) {
-	return fileDescriptor_sdk_a0e878ab4087e6bc, []int{3, 2}
+	return fileDescriptor_sdk_211a8e1a69b10fc2, []int{5, 2}
 }
 func (m *GameServer_Status) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GameServer_Status.Unmarshal(m, b)
@@ -472,6 +569,27 @@ func (m *GameServer_Status) GetPorts() []*GameServer_Status_Port {
 	return nil
 }
 
+func (m *GameServer_Status) GetCounters() map[string]*GameServer_Status_Counter {
+	if m != nil {
+		return m.Counters
+	}
+	return nil
+}
+
+func (m *GameServer_Status) GetLists() map[string]*GameServer_Status_List {
+	if m != nil {
+		return m.Lists
+	}
+	return nil
+}
+
+func (m *GameServer_Status) GetDisruption() *GameServer_Status_Disruption {
+	if m != nil {
+		return m.Disruption
+	}
+	return nil
+}
+
 type GameServer_Status_Port struct {
 	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Port                 int32    `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
@@ -484,7 +602,7 @@ func (m *GameServer_Status_Port) Reset()         { *m = GameServer_Status_Port{}
 func (m *GameServer_Status_Port) String() string { return proto.CompactTextString(m) }
 func (*GameServer_Status_Port) ProtoMessage()    {}
 func (*GameServer_Status_Port) Descriptor() ([]byte, []int) {
-	return fileDescriptor_sdk_a0e878ab4087e6bc, []int{3, 2, 0}
+	return fileDescriptor_sdk_211a8e1a69b10fc2, []int{5, 2, 0}
 }
 func (m *GameServer_Status_Port) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GameServer_Status_Port.Unmarshal(m, b)
@@ -518,10 +636,160 @@ func (m *GameServer_Status_Port) GetPort() int32 {
 	return 0
 }
 
+type GameServer_Status_Counter struct {
+	Count                int64    `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Capacity             int64    `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GameServer_Status_Counter) Reset()         { *m = GameServer_Status_Counter{} }
+func (m *GameServer_Status_Counter) String() string { return proto.CompactTextString(m) }
+func (*GameServer_Status_Counter) ProtoMessage()    {}
+func (*GameServer_Status_Counter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_sdk_211a8e1a69b10fc2, []int{5, 2, 1}
+}
+func (m *GameServer_Status_Counter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GameServer_Status_Counter.Unmarshal(m, b)
+}
+func (m *GameServer_Status_Counter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GameServer_Status_Counter.Marshal(b, m, deterministic)
+}
+func (dst *GameServer_Status_Counter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GameServer_Status_Counter.Merge(dst, src)
+}
+func (m *GameServer_Status_Counter) XXX_Size() int {
+	return xxx_messageInfo_GameServer_Status_Counter.Size(m)
+}
+func (m *GameServer_Status_Counter) XXX_DiscardUnknown() {
+	xxx_messageInfo_GameServer_Status_Counter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GameServer_Status_Counter proto.InternalMessageInfo
+
+func (m *GameServer_Status_Counter) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *GameServer_Status_Counter) GetCapacity() int64 {
+	if m != nil {
+		return m.Capacity
+	}
+	return 0
+}
+
+type GameServer_Status_List struct {
+	Capacity             int64    `protobuf:"varint,1,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Values               []string `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GameServer_Status_List) Reset()         { *m = GameServer_Status_List{} }
+func (m *GameServer_Status_List) String() string { return proto.CompactTextString(m) }
+func (*GameServer_Status_List) ProtoMessage()    {}
+func (*GameServer_Status_List) Descriptor() ([]byte, []int) {
+	return fileDescriptor_sdk_211a8e1a69b10fc2, []int{5, 2, 2}
+}
+func (m *GameServer_Status_List) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GameServer_Status_List.Unmarshal(m, b)
+}
+func (m *GameServer_Status_List) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GameServer_Status_List.Marshal(b, m, deterministic)
+}
+func (dst *GameServer_Status_List) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GameServer_Status_List.Merge(dst, src)
+}
+func (m *GameServer_Status_List) XXX_Size() int {
+	return xxx_messageInfo_GameServer_Status_List.Size(m)
+}
+func (m *GameServer_Status_List) XXX_DiscardUnknown() {
+	xxx_messageInfo_GameServer_Status_List.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GameServer_Status_List proto.InternalMessageInfo
+
+func (m *GameServer_Status_List) GetCapacity() int64 {
+	if m != nil {
+		return m.Capacity
+	}
+	return 0
+}
+
+func (m *GameServer_Status_List) GetValues() []string {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+// A forecast of an imminent disruption of the GameServer,
+// e.g. because its node is being drained or removed
+type GameServer_Status_Disruption struct {
+	Reason               string   `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	Message              string   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Since                int64    `protobuf:"varint,3,opt,name=since,proto3" json:"since,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GameServer_Status_Disruption) Reset()         { *m = GameServer_Status_Disruption{} }
+func (m *GameServer_Status_Disruption) String() string { return proto.CompactTextString(m) }
+func (*GameServer_Status_Disruption) ProtoMessage()    {}
+func (*GameServer_Status_Disruption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_sdk_211a8e1a69b10fc2, []int{5, 2, 3}
+}
+func (m *GameServer_Status_Disruption) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GameServer_Status_Disruption.Unmarshal(m, b)
+}
+func (m *GameServer_Status_Disruption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GameServer_Status_Disruption.Marshal(b, m, deterministic)
+}
+func (dst *GameServer_Status_Disruption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GameServer_Status_Disruption.Merge(dst, src)
+}
+func (m *GameServer_Status_Disruption) XXX_Size() int {
+	return xxx_messageInfo_GameServer_Status_Disruption.Size(m)
+}
+func (m *GameServer_Status_Disruption) XXX_DiscardUnknown() {
+	xxx_messageInfo_GameServer_Status_Disruption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GameServer_Status_Disruption proto.InternalMessageInfo
+
+func (m *GameServer_Status_Disruption) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *GameServer_Status_Disruption) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *GameServer_Status_Disruption) GetSince() int64 {
+	if m != nil {
+		return m.Since
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Empty)(nil), "agones.dev.sdk.Empty")
 	proto.RegisterType((*KeyValue)(nil), "agones.dev.sdk.KeyValue")
 	proto.RegisterType((*Duration)(nil), "agones.dev.sdk.Duration")
+	proto.RegisterType((*CounterUpdate)(nil), "agones.dev.sdk.CounterUpdate")
+	proto.RegisterType((*ListValue)(nil), "agones.dev.sdk.ListValue")
 	proto.RegisterType((*GameServer)(nil), "agones.dev.sdk.GameServer")
 	proto.RegisterType((*GameServer_ObjectMeta)(nil), "agones.dev.sdk.GameServer.ObjectMeta")
 	proto.RegisterMapType((map[string]string)(nil), "agones.dev.sdk.GameServer.ObjectMeta.AnnotationsEntry")
@@ -529,7 +797,12 @@ func init() {
 	proto.RegisterType((*GameServer_Spec)(nil), "agones.dev.sdk.GameServer.Spec")
 	proto.RegisterType((*GameServer_Spec_Health)(nil), "agones.dev.sdk.GameServer.Spec.Health")
 	proto.RegisterType((*GameServer_Status)(nil), "agones.dev.sdk.GameServer.Status")
+	proto.RegisterMapType((map[string]*GameServer_Status_Counter)(nil), "agones.dev.sdk.GameServer.Status.CountersEntry")
+	proto.RegisterMapType((map[string]*GameServer_Status_List)(nil), "agones.dev.sdk.GameServer.Status.ListsEntry")
 	proto.RegisterType((*GameServer_Status_Port)(nil), "agones.dev.sdk.GameServer.Status.Port")
+	proto.RegisterType((*GameServer_Status_Counter)(nil), "agones.dev.sdk.GameServer.Status.Counter")
+	proto.RegisterType((*GameServer_Status_List)(nil), "agones.dev.sdk.GameServer.Status.List")
+	proto.RegisterType((*GameServer_Status_Disruption)(nil), "agones.dev.sdk.GameServer.Status.Disruption")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -562,6 +835,14 @@ type SDKClient interface {
 	SetAnnotation(ctx context.Context, in *KeyValue, opts ...grpc.CallOption) (*Empty, error)
 	// Marks the GameServer as the Reserved state for Duration
 	Reserve(ctx context.Context, in *Duration, opts ...grpc.CallOption) (*Empty, error)
+	// Increments the count of a Counter on the backing GameServer. A negative amount decrements it.
+	IncrementCounter(ctx context.Context, in *CounterUpdate, opts ...grpc.CallOption) (*Empty, error)
+	// Sets the capacity of a Counter on the backing GameServer
+	SetCounterCapacity(ctx context.Context, in *CounterUpdate, opts ...grpc.CallOption) (*Empty, error)
+	// Appends a value to a List on the backing GameServer
+	AppendListValue(ctx context.Context, in *ListValue, opts ...grpc.CallOption) (*Empty, error)
+	// Removes a value from a List on the backing GameServer
+	DeleteListValue(ctx context.Context, in *ListValue, opts ...grpc.CallOption) (*Empty, error)
 }
 
 type sDKClient struct {
@@ -701,6 +982,42 @@ func (c *sDKClient) Reserve(ctx context.Context, in *Duration, opts ...grpc.Call
 	return out, nil
 }
 
+func (c *sDKClient) IncrementCounter(ctx context.Context, in *CounterUpdate, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/agones.dev.sdk.SDK/IncrementCounter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sDKClient) SetCounterCapacity(ctx context.Context, in *CounterUpdate, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/agones.dev.sdk.SDK/SetCounterCapacity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sDKClient) AppendListValue(ctx context.Context, in *ListValue, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/agones.dev.sdk.SDK/AppendListValue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sDKClient) DeleteListValue(ctx context.Context, in *ListValue, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/agones.dev.sdk.SDK/DeleteListValue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SDKServer is the server API for SDK service.
 type SDKServer interface {
 	// Call when the GameServer is ready
@@ -721,6 +1038,14 @@ type SDKServer interface {
 	SetAnnotation(context.Context, *KeyValue) (*Empty, error)
 	// Marks the GameServer as the Reserved state for Duration
 	Reserve(context.Context, *Duration) (*Empty, error)
+	// Increments the count of a Counter on the backing GameServer. A negative amount decrements it.
+	IncrementCounter(context.Context, *CounterUpdate) (*Empty, error)
+	// Sets the capacity of a Counter on the backing GameServer
+	SetCounterCapacity(context.Context, *CounterUpdate) (*Empty, error)
+	// Appends a value to a List on the backing GameServer
+	AppendListValue(context.Context, *ListValue) (*Empty, error)
+	// Removes a value from a List on the backing GameServer
+	DeleteListValue(context.Context, *ListValue) (*Empty, error)
 }
 
 func RegisterSDKServer(s *grpc.Server, srv SDKServer) {
@@ -900,6 +1225,78 @@ func _SDK_Reserve_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SDK_IncrementCounter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CounterUpdate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDKServer).IncrementCounter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agones.dev.sdk.SDK/IncrementCounter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDKServer).IncrementCounter(ctx, req.(*CounterUpdate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDK_SetCounterCapacity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CounterUpdate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDKServer).SetCounterCapacity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agones.dev.sdk.SDK/SetCounterCapacity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDKServer).SetCounterCapacity(ctx, req.(*CounterUpdate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDK_AppendListValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListValue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDKServer).AppendListValue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agones.dev.sdk.SDK/AppendListValue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDKServer).AppendListValue(ctx, req.(*ListValue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SDK_DeleteListValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListValue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SDKServer).DeleteListValue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agones.dev.sdk.SDK/DeleteListValue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SDKServer).DeleteListValue(ctx, req.(*ListValue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _SDK_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "agones.dev.sdk.SDK",
 	HandlerType: (*SDKServer)(nil),
@@ -932,6 +1329,22 @@ var _SDK_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Reserve",
 			Handler:    _SDK_Reserve_Handler,
 		},
+		{
+			MethodName: "IncrementCounter",
+			Handler:    _SDK_IncrementCounter_Handler,
+		},
+		{
+			MethodName: "SetCounterCapacity",
+			Handler:    _SDK_SetCounterCapacity_Handler,
+		},
+		{
+			MethodName: "AppendListValue",
+			Handler:    _SDK_AppendListValue_Handler,
+		},
+		{
+			MethodName: "DeleteListValue",
+			Handler:    _SDK_DeleteListValue_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -948,61 +1361,81 @@ var _SDK_serviceDesc = grpc.ServiceDesc{
 	Metadata: "sdk.proto",
 }
 
-func init() { proto.RegisterFile("sdk.proto", fileDescriptor_sdk_a0e878ab4087e6bc) }
-
-var fileDescriptor_sdk_a0e878ab4087e6bc = []byte{
-	// 843 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x95, 0x5f, 0x8f, 0xdb, 0x44,
-	0x10, 0xc0, 0xe5, 0x4b, 0xe2, 0x24, 0x13, 0xee, 0xdf, 0xde, 0x55, 0x72, 0xad, 0x8a, 0x16, 0x8b,
-	0xa2, 0xe3, 0x10, 0x36, 0xa4, 0x12, 0xa2, 0x27, 0x54, 0xa9, 0xe8, 0x4a, 0x8b, 0x5a, 0x28, 0x72,
-	0xaa, 0x16, 0xf1, 0x12, 0xed, 0x79, 0x87, 0xc4, 0x9c, 0xe3, 0xb5, 0x76, 0x37, 0x57, 0xe5, 0x11,
-	0x1e, 0xf8, 0x02, 0x3c, 0xf1, 0x05, 0x78, 0xe2, 0xdb, 0xf0, 0x15, 0xf8, 0x0a, 0xbc, 0xa3, 0x9d,
-	0xb5, 0x2f, 0xe1, 0xe8, 0xb5, 0x17, 0x78, 0xca, 0xce, 0xbf, 0xdf, 0x6c, 0xc6, 0x33, 0xb3, 0xd0,
-	0xd7, 0xe2, 0x34, 0xae, 0x94, 0x34, 0x92, 0x6d, 0xf1, 0x89, 0x2c, 0x51, 0xc7, 0x02, 0xcf, 0x62,
-	0x2d, 0x4e, 0xc3, 0x1b, 0x13, 0x29, 0x27, 0x05, 0x26, 0xbc, 0xca, 0x13, 0x5e, 0x96, 0xd2, 0x70,
-	0x93, 0xcb, 0x52, 0x3b, 0xef, 0xa8, 0x0b, 0x9d, 0x07, 0xb3, 0xca, 0x2c, 0xa2, 0x21, 0xf4, 0x1e,
-	0xe3, 0xe2, 0x39, 0x2f, 0xe6, 0xc8, 0x76, 0xa0, 0x75, 0x8a, 0x8b, 0xc0, 0xbb, 0xe5, 0x1d, 0xf4,
-	0x53, 0x7b, 0x64, 0xfb, 0xd0, 0x39, 0xb3, 0xa6, 0x60, 0x83, 0x74, 0x4e, 0x88, 0xde, 0x85, 0xde,
-	0xf1, 0x5c, 0x11, 0x8f, 0x05, 0xd0, 0xd5, 0x98, 0xc9, 0x52, 0x68, 0x8a, 0x6b, 0xa5, 0x8d, 0x18,
-	0xfd, 0xd8, 0x07, 0x78, 0xc8, 0x67, 0x38, 0x42, 0x75, 0x86, 0x8a, 0x7d, 0x01, 0x03, 0x79, 0xf2,
-	0x03, 0x66, 0x66, 0x3c, 0x43, 0xc3, 0xc9, 0x79, 0x30, 0xbc, 0x1d, 0xff, 0xf3, 0xd6, 0xf1, 0x32,
-	0x20, 0x7e, 0x4a, 0xde, 0x5f, 0xa1, 0xe1, 0x29, 0xc8, 0xf3, 0x33, 0xbb, 0x03, 0x6d, 0x5d, 0x61,
-	0x46, 0x37, 0x1a, 0x0c, 0x6f, 0xbe, 0x06, 0x30, 0xaa, 0x30, 0x4b, 0xc9, 0x99, 0xdd, 0x05, 0x5f,
-	0x1b, 0x6e, 0xe6, 0x3a, 0x68, 0x51, 0xd8, 0x3b, 0xaf, 0x0b, 0x23, 0xc7, 0xb4, 0x0e, 0x08, 0x7f,
-	0x6d, 0x03, 0x2c, 0xaf, 0xc2, 0x18, 0xb4, 0x4b, 0x3e, 0xc3, 0xba, 0x48, 0x74, 0x66, 0x37, 0xa0,
-	0x6f, 0x7f, 0x75, 0xc5, 0xb3, 0xa6, 0x52, 0x4b, 0x85, 0xad, 0xea, 0x3c, 0x17, 0x94, 0xb8, 0x9f,
-	0xda, 0x23, 0x7b, 0x1f, 0x76, 0x14, 0x6a, 0x39, 0x57, 0x19, 0x8e, 0xcf, 0x50, 0xe9, 0x5c, 0x96,
-	0x41, 0x9b, 0xcc, 0xdb, 0x8d, 0xfe, 0xb9, 0x53, 0xb3, 0xb7, 0x01, 0x26, 0x58, 0xa2, 0x2b, 0x76,
-	0xd0, 0xa1, 0x0a, 0xaf, 0x68, 0xd8, 0x87, 0xc0, 0x32, 0x85, 0x74, 0x1e, 0x9b, 0x7c, 0x86, 0xda,
-	0xf0, 0x59, 0x15, 0xf8, 0xe4, 0xb7, 0xdb, 0x58, 0x9e, 0x35, 0x06, 0xeb, 0x2e, 0xb0, 0xc0, 0x0b,
-	0xee, 0x5d, 0xe7, 0xde, 0x58, 0x96, 0xee, 0xdf, 0xc2, 0x60, 0xa5, 0x75, 0x82, 0xde, 0xad, 0xd6,
-	0xc1, 0x60, 0xf8, 0xc9, 0x95, 0xbe, 0x59, 0x7c, 0x7f, 0x19, 0xf8, 0xa0, 0x34, 0x6a, 0x91, 0xae,
-	0xa2, 0xd8, 0x97, 0xe0, 0x17, 0xfc, 0x04, 0x0b, 0x1d, 0xf4, 0x09, 0xfa, 0xf1, 0xd5, 0xa0, 0x4f,
-	0x28, 0xc6, 0xf1, 0x6a, 0x40, 0x78, 0x0f, 0x76, 0x2e, 0xe6, 0xba, 0x6a, 0x27, 0x1f, 0x6d, 0x7c,
-	0xea, 0x85, 0x77, 0x61, 0xb0, 0x82, 0x5d, 0x2b, 0xf4, 0x2f, 0x0f, 0xda, 0xb6, 0xcb, 0xd8, 0x3d,
-	0xf0, 0xa7, 0xc8, 0x0b, 0x33, 0xad, 0xfb, 0xfa, 0xbd, 0x37, 0xb4, 0x65, 0xfc, 0x88, 0xbc, 0xd3,
-	0x3a, 0x2a, 0xfc, 0xdd, 0x03, 0xdf, 0xa9, 0x58, 0x08, 0x3d, 0x91, 0x6b, 0x7e, 0x52, 0xa0, 0x20,
-	0x58, 0x2f, 0x3d, 0x97, 0xd9, 0x6d, 0xd8, 0xaa, 0x50, 0xe5, 0x52, 0x8c, 0x9b, 0x99, 0xb3, 0x57,
-	0xea, 0xa4, 0x9b, 0x4e, 0x3b, 0x72, 0x4a, 0xf6, 0x01, 0xec, 0x7e, 0xcf, 0xf3, 0x62, 0xae, 0x70,
-	0x6c, 0xa6, 0x0a, 0xf5, 0x54, 0x16, 0xae, 0xff, 0x3a, 0xe9, 0x4e, 0x6d, 0x78, 0xd6, 0xe8, 0xd9,
-	0x10, 0xae, 0xe5, 0x65, 0x6e, 0x72, 0x5e, 0x8c, 0x05, 0x16, 0x7c, 0x71, 0x8e, 0x6e, 0x53, 0xc0,
-	0x5e, 0x6d, 0x3c, 0xb6, 0xb6, 0x3a, 0x41, 0xf8, 0x9b, 0x07, 0xbe, 0x1b, 0x13, 0x5b, 0x1c, 0x3b,
-	0x28, 0xcd, 0x40, 0x38, 0xc1, 0x6e, 0x05, 0x2e, 0x84, 0x42, 0xad, 0xeb, 0xa2, 0x35, 0x22, 0xfb,
-	0x0c, 0x3a, 0x95, 0x54, 0xc6, 0x0e, 0x62, 0xeb, 0x4d, 0x85, 0xa2, 0x0c, 0xf1, 0x37, 0x52, 0x99,
-	0xd4, 0x05, 0x85, 0x31, 0xb4, 0xad, 0xf8, 0xca, 0x29, 0x64, 0xd0, 0xb6, 0x4e, 0x75, 0x49, 0xe8,
-	0x3c, 0xfc, 0xd9, 0x87, 0xd6, 0xe8, 0xf8, 0x31, 0x7b, 0x04, 0x9d, 0x14, 0xb9, 0x58, 0xb0, 0x6b,
-	0x17, 0xf3, 0xd1, 0x16, 0x0c, 0x5f, 0xad, 0x8e, 0x76, 0x7f, 0xfa, 0xe3, 0xcf, 0x5f, 0x36, 0x06,
-	0x91, 0x9f, 0x28, 0x1b, 0x7d, 0xe4, 0x1d, 0xb2, 0xaf, 0xa1, 0x77, 0xbf, 0x28, 0x64, 0x66, 0xff,
-	0xe5, 0x7a, 0xb0, 0x7d, 0x82, 0x6d, 0x45, 0xfd, 0x84, 0xd7, 0x80, 0x9a, 0x37, 0x9a, 0xce, 0x8d,
-	0x90, 0x2f, 0xcb, 0xff, 0xcc, 0xd3, 0x35, 0xc0, 0xf2, 0x9e, 0x9c, 0x37, 0xd2, 0x7a, 0x34, 0x46,
-	0xb4, 0xb7, 0xa2, 0x6e, 0xe2, 0x5a, 0xf2, 0xc8, 0x3b, 0x3c, 0xf0, 0xd8, 0x0b, 0xd8, 0x7c, 0x88,
-	0x66, 0x65, 0x8b, 0x5f, 0x02, 0x0d, 0x2f, 0xff, 0x8c, 0xd1, 0x1e, 0x91, 0x37, 0xd9, 0x20, 0x99,
-	0xd8, 0x9d, 0xe8, 0x38, 0x1c, 0xb6, 0x5f, 0x70, 0x93, 0x4d, 0xff, 0x1f, 0xfa, 0x3a, 0xa1, 0xf7,
-	0xd8, 0x6e, 0xf2, 0xd2, 0xc2, 0x56, 0x12, 0x7c, 0x64, 0xef, 0xde, 0x1b, 0xa1, 0xa1, 0xd1, 0x66,
-	0xc1, 0x45, 0x48, 0xf3, 0xe6, 0x5d, 0x56, 0x8e, 0x90, 0xc8, 0xfb, 0xe1, 0x76, 0x62, 0x5f, 0x2b,
-	0xc1, 0x0d, 0x4f, 0x68, 0xdd, 0xd8, 0x12, 0x73, 0xd8, 0x1c, 0xa1, 0x59, 0xee, 0x9c, 0xf5, 0xe9,
-	0x37, 0x89, 0x7e, 0x3d, 0xdc, 0x5f, 0xd2, 0x97, 0xcb, 0xd1, 0xa6, 0x78, 0x0a, 0xdd, 0xd4, 0xfd,
-	0x93, 0x7f, 0xc3, 0x9b, 0xa7, 0xf7, 0x32, 0x78, 0x5d, 0xef, 0xa8, 0x97, 0x28, 0x87, 0x38, 0xf2,
-	0x0e, 0x3f, 0xef, 0x7c, 0xd7, 0xd2, 0xe2, 0xf4, 0xc4, 0xa7, 0xd7, 0xff, 0xce, 0xdf, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0x42, 0xa7, 0x46, 0xe0, 0x38, 0x08, 0x00, 0x00,
+func init() { proto.RegisterFile("sdk.proto", fileDescriptor_sdk_211a8e1a69b10fc2) }
+
+var fileDescriptor_sdk_211a8e1a69b10fc2 = []byte{
+	// 1156 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x97, 0x5f, 0x6f, 0x1b, 0x45,
+	0x10, 0xc0, 0xe5, 0xfa, 0xff, 0xb8, 0x6e, 0xe2, 0x4d, 0xda, 0x3a, 0xa7, 0x94, 0x86, 0x13, 0xad,
+	0xd2, 0x02, 0x3e, 0x70, 0x05, 0xa2, 0x69, 0x55, 0x94, 0x36, 0xa5, 0xad, 0x12, 0x28, 0x3a, 0x87,
+	0x16, 0x21, 0x24, 0xb3, 0xb9, 0x9b, 0xda, 0x47, 0xce, 0x77, 0xa7, 0xdb, 0x75, 0x2a, 0xbf, 0xf2,
+	0x15, 0x10, 0x0f, 0xbc, 0xf0, 0x09, 0xf8, 0x36, 0x7c, 0x05, 0xbe, 0x02, 0x0f, 0xbc, 0xa1, 0x9d,
+	0xdd, 0xb3, 0x1d, 0x63, 0x37, 0x4e, 0xe1, 0xc9, 0x37, 0xbb, 0x33, 0xbf, 0xd9, 0xdd, 0xf9, 0xb3,
+	0x6b, 0xa8, 0x0a, 0xff, 0xb8, 0x95, 0xa4, 0xb1, 0x8c, 0xd9, 0x25, 0xde, 0x8b, 0x23, 0x14, 0x2d,
+	0x1f, 0x4f, 0x5a, 0xc2, 0x3f, 0xb6, 0x36, 0x7b, 0x71, 0xdc, 0x0b, 0xd1, 0xe1, 0x49, 0xe0, 0xf0,
+	0x28, 0x8a, 0x25, 0x97, 0x41, 0x1c, 0x09, 0xad, 0x6d, 0x97, 0xa1, 0xf8, 0x78, 0x90, 0xc8, 0x91,
+	0xdd, 0x86, 0xca, 0x3e, 0x8e, 0x5e, 0xf0, 0x70, 0x88, 0x6c, 0x15, 0xf2, 0xc7, 0x38, 0x6a, 0xe6,
+	0xb6, 0x72, 0xdb, 0x55, 0x57, 0x7d, 0xb2, 0x75, 0x28, 0x9e, 0xa8, 0xa9, 0xe6, 0x05, 0x1a, 0xd3,
+	0x82, 0xfd, 0x1e, 0x54, 0xf6, 0x86, 0x29, 0xf1, 0x58, 0x13, 0xca, 0x02, 0xbd, 0x38, 0xf2, 0x05,
+	0xd9, 0xe5, 0xdd, 0x4c, 0xb4, 0xef, 0x41, 0xfd, 0x51, 0x3c, 0x8c, 0x24, 0xa6, 0xdf, 0x24, 0x3e,
+	0x97, 0xc8, 0x18, 0x14, 0x22, 0x3e, 0x40, 0xc3, 0xa7, 0x6f, 0x76, 0x05, 0x4a, 0x7c, 0xa0, 0xb4,
+	0xc8, 0x43, 0xde, 0x35, 0x92, 0xfd, 0x09, 0x54, 0x0f, 0x02, 0x21, 0xf5, 0xba, 0xe6, 0x19, 0xce,
+	0x5f, 0xd9, 0xdf, 0x75, 0x80, 0x27, 0x7c, 0x80, 0x1d, 0x4c, 0x4f, 0x30, 0x65, 0x5f, 0x40, 0x2d,
+	0x3e, 0xfa, 0x11, 0x3d, 0xd9, 0x1d, 0xa0, 0xe4, 0x64, 0x5f, 0x6b, 0xdf, 0x68, 0x9d, 0x3e, 0xa9,
+	0xd6, 0xc4, 0xa0, 0xf5, 0x9c, 0xb4, 0xbf, 0x44, 0xc9, 0x5d, 0x88, 0xc7, 0xdf, 0xec, 0x0e, 0x14,
+	0x44, 0x82, 0x1e, 0xf9, 0xaa, 0xb5, 0xaf, 0xbf, 0x01, 0xd0, 0x49, 0xd0, 0x73, 0x49, 0x99, 0xdd,
+	0x85, 0x92, 0x90, 0x5c, 0x0e, 0x45, 0x33, 0x4f, 0x66, 0xef, 0xbe, 0xc9, 0x8c, 0x14, 0x5d, 0x63,
+	0x60, 0xfd, 0x5a, 0x00, 0x98, 0x2c, 0x65, 0xee, 0xfe, 0x37, 0xa1, 0xaa, 0x7e, 0x45, 0xc2, 0xbd,
+	0xec, 0x0c, 0x26, 0x03, 0x2a, 0x92, 0xc3, 0xc0, 0x27, 0xc7, 0x55, 0x57, 0x7d, 0xb2, 0x5b, 0xb0,
+	0x9a, 0xa2, 0x88, 0x87, 0xa9, 0x87, 0xdd, 0x13, 0x4c, 0x45, 0x10, 0x47, 0xcd, 0x02, 0x4d, 0xaf,
+	0x64, 0xe3, 0x2f, 0xf4, 0x30, 0x7b, 0x07, 0xa0, 0x87, 0x11, 0xea, 0x00, 0x37, 0x8b, 0x14, 0x97,
+	0xa9, 0x11, 0xf6, 0x21, 0x30, 0x2f, 0x45, 0xfa, 0xee, 0xca, 0x60, 0x80, 0x42, 0xf2, 0x41, 0xd2,
+	0x2c, 0x91, 0x5e, 0x23, 0x9b, 0x39, 0xcc, 0x26, 0x94, 0xba, 0x8f, 0x21, 0xce, 0xa8, 0x97, 0xb5,
+	0x7a, 0x36, 0x33, 0x51, 0xff, 0x16, 0x6a, 0x53, 0xe9, 0xda, 0xac, 0x6c, 0xe5, 0xb7, 0x6b, 0xed,
+	0x4f, 0x97, 0x8a, 0x59, 0x6b, 0x77, 0x62, 0xf8, 0x38, 0x92, 0xe9, 0xc8, 0x9d, 0x46, 0xb1, 0x67,
+	0x50, 0x0a, 0xf9, 0x11, 0x86, 0xa2, 0x59, 0x25, 0xe8, 0xc7, 0xcb, 0x41, 0x0f, 0xc8, 0x46, 0xf3,
+	0x0c, 0xc0, 0x7a, 0x00, 0xab, 0xb3, 0xbe, 0x96, 0xad, 0x9e, 0x9d, 0x0b, 0x9f, 0xe5, 0xac, 0xbb,
+	0x50, 0x9b, 0xc2, 0x9e, 0xcb, 0xf4, 0xaf, 0x1c, 0x14, 0x54, 0x96, 0xb1, 0x07, 0x50, 0xea, 0x23,
+	0x0f, 0x65, 0xdf, 0xe4, 0xf5, 0xcd, 0x33, 0xd2, 0xb2, 0xf5, 0x94, 0xb4, 0x5d, 0x63, 0x65, 0xfd,
+	0x9e, 0x83, 0x92, 0x1e, 0x62, 0x16, 0x54, 0xfc, 0x40, 0xf0, 0xa3, 0x10, 0x7d, 0x82, 0x55, 0xdc,
+	0xb1, 0xcc, 0x6e, 0xc0, 0xa5, 0x04, 0xd3, 0x20, 0xf6, 0xbb, 0x59, 0x9d, 0xab, 0x25, 0x15, 0xdd,
+	0xba, 0x1e, 0xed, 0xe8, 0x41, 0xf6, 0x3e, 0x34, 0x5e, 0xf1, 0x20, 0x1c, 0xa6, 0xd8, 0x95, 0xfd,
+	0x14, 0x45, 0x3f, 0x0e, 0x75, 0xfe, 0x15, 0xdd, 0x55, 0x33, 0x71, 0x98, 0x8d, 0xb3, 0x36, 0x5c,
+	0x0e, 0xa2, 0x40, 0x06, 0x3c, 0xec, 0xfa, 0x18, 0xf2, 0xd1, 0x18, 0x5d, 0x20, 0x83, 0x35, 0x33,
+	0xb9, 0xa7, 0xe6, 0x8c, 0x03, 0xeb, 0xb7, 0x12, 0x94, 0x74, 0x99, 0xa8, 0xc3, 0x51, 0x85, 0x92,
+	0x15, 0x84, 0x16, 0x54, 0x27, 0xe2, 0xbe, 0x9f, 0xa2, 0x10, 0xe6, 0xd0, 0x32, 0x91, 0xdd, 0x87,
+	0x62, 0x12, 0xa7, 0x52, 0x15, 0x62, 0xfe, 0xac, 0x83, 0x22, 0x0f, 0xad, 0xaf, 0xe3, 0x54, 0xba,
+	0xda, 0x88, 0xed, 0x43, 0xc5, 0xd3, 0x7d, 0x4c, 0xad, 0x4f, 0x01, 0x9c, 0xb3, 0x01, 0xa6, 0xf3,
+	0x99, 0xb4, 0x19, 0x03, 0xd8, 0x43, 0x28, 0x86, 0x81, 0x90, 0xa2, 0x59, 0x24, 0xd2, 0x07, 0x67,
+	0x93, 0x54, 0x1b, 0x34, 0x18, 0x6d, 0xca, 0x0e, 0x00, 0xfc, 0x40, 0xa4, 0xc3, 0x84, 0xea, 0xb3,
+	0xb4, 0x95, 0x5b, 0x0e, 0xb4, 0x37, 0xb6, 0x71, 0xa7, 0xec, 0xad, 0x16, 0x14, 0xd4, 0x6e, 0xe7,
+	0x36, 0x19, 0x06, 0x05, 0x75, 0x06, 0x26, 0xe2, 0xf4, 0x6d, 0xdd, 0x83, 0xb2, 0xd9, 0x9c, 0x8a,
+	0x03, 0x6d, 0xcc, 0x74, 0x7e, 0x2d, 0xa8, 0x64, 0xf2, 0x78, 0xc2, 0xbd, 0x40, 0x8e, 0x4c, 0x53,
+	0x1f, 0xcb, 0xd6, 0x0e, 0x14, 0xd4, 0x7e, 0x4e, 0xe9, 0xe4, 0x4e, 0xeb, 0xa8, 0x2b, 0x81, 0xb2,
+	0x5d, 0x85, 0x31, 0xbf, 0x5d, 0x75, 0x8d, 0x64, 0x1d, 0x02, 0x4c, 0xb6, 0xa0, 0xb4, 0x52, 0xe4,
+	0x22, 0x8e, 0xcc, 0x82, 0x8d, 0xa4, 0xb2, 0x60, 0x80, 0x42, 0xf0, 0x5e, 0x56, 0x3a, 0x99, 0x48,
+	0x59, 0x13, 0x44, 0x1e, 0x52, 0x56, 0xe6, 0x5d, 0x2d, 0x58, 0xaf, 0xc6, 0xb7, 0xd4, 0xc2, 0x5a,
+	0xfc, 0x7c, 0xba, 0x16, 0x6b, 0xed, 0x5b, 0x4b, 0x47, 0x7f, 0xba, 0x6c, 0x7f, 0x00, 0x98, 0x44,
+	0x72, 0x8e, 0x93, 0xfb, 0xa7, 0x9d, 0xdc, 0x5c, 0x2e, 0x31, 0xa6, 0x3c, 0xb4, 0x7f, 0xa9, 0x42,
+	0xbe, 0xb3, 0xb7, 0xcf, 0x9e, 0x42, 0xd1, 0x45, 0xee, 0x8f, 0xd8, 0xe5, 0x59, 0x06, 0xdd, 0xf8,
+	0xd6, 0xfc, 0x61, 0xbb, 0xf1, 0xd3, 0x1f, 0x7f, 0xfe, 0x7c, 0xa1, 0x66, 0x97, 0x9c, 0x54, 0x59,
+	0xef, 0xe4, 0x6e, 0xb3, 0xaf, 0xa0, 0xb2, 0x1b, 0x86, 0xb1, 0xa7, 0xaa, 0xeb, 0x7c, 0xb0, 0x75,
+	0x82, 0x5d, 0xb2, 0xab, 0x0e, 0x37, 0x00, 0xc3, 0xeb, 0xf4, 0x87, 0xd2, 0x8f, 0x5f, 0x47, 0x6f,
+	0xcd, 0x13, 0x06, 0xa0, 0x78, 0x07, 0xe3, 0x06, 0x76, 0x3e, 0x1a, 0x23, 0xda, 0x45, 0xbb, 0xec,
+	0xe8, 0x56, 0xb8, 0x93, 0xbb, 0xbd, 0x9d, 0x63, 0x2f, 0xa1, 0xfe, 0x04, 0xe5, 0xd4, 0xeb, 0x61,
+	0x01, 0xd4, 0x5a, 0x1c, 0x1a, 0x7b, 0x8d, 0xc8, 0x75, 0x56, 0x73, 0x7a, 0xea, 0x2e, 0xd6, 0x1c,
+	0x0e, 0x2b, 0x2f, 0xb9, 0xf4, 0xfa, 0xff, 0x0d, 0xbd, 0x41, 0xe8, 0x35, 0xd6, 0x70, 0x5e, 0x2b,
+	0xd8, 0x94, 0x83, 0x8f, 0xd4, 0xda, 0x2b, 0x1d, 0x94, 0x74, 0xa5, 0xb0, 0xe6, 0x2c, 0x24, 0x7b,
+	0xdf, 0x2d, 0x3a, 0x0e, 0x8b, 0xc8, 0xeb, 0xd6, 0x8a, 0x33, 0x40, 0xc9, 0x7d, 0x2e, 0xb9, 0x43,
+	0xd7, 0x9c, 0x3a, 0x62, 0x0e, 0xf5, 0x0e, 0xca, 0xc9, 0x5d, 0x77, 0x7e, 0xfa, 0x75, 0xa2, 0x6f,
+	0x58, 0xeb, 0x13, 0xfa, 0xe4, 0x52, 0x56, 0x2e, 0x9e, 0x43, 0xd9, 0xd5, 0x3b, 0xf9, 0x37, 0x3c,
+	0x7b, 0x66, 0x2e, 0x82, 0x9b, 0xf3, 0xb6, 0x2b, 0x4e, 0xaa, 0x11, 0x0a, 0xd8, 0x83, 0xd5, 0x67,
+	0x91, 0x97, 0xe2, 0x00, 0x23, 0x99, 0xb5, 0xaa, 0x6b, 0xb3, 0xf6, 0xa7, 0x9e, 0xa6, 0x8b, 0xf0,
+	0xd7, 0x08, 0x7f, 0xd5, 0x66, 0x8e, 0x69, 0xe0, 0x4e, 0x90, 0x81, 0x95, 0xa3, 0x3e, 0xb0, 0x0e,
+	0x66, 0x2e, 0x1e, 0x65, 0xfd, 0xeb, 0xed, 0x5c, 0x6d, 0x92, 0xab, 0x2b, 0x56, 0x63, 0xec, 0x2a,
+	0x6b, 0x88, 0xca, 0xd3, 0xf7, 0xb0, 0xb2, 0x9b, 0x24, 0x18, 0xf9, 0x93, 0x47, 0xf1, 0xc6, 0x2c,
+	0x67, 0x3c, 0xb5, 0xc8, 0xc5, 0x55, 0x72, 0xd1, 0xb0, 0x2f, 0x3a, 0xea, 0x1e, 0x71, 0x38, 0x01,
+	0x0d, 0x7d, 0x0f, 0x43, 0x94, 0xf8, 0x3f, 0xd2, 0xe9, 0x61, 0xa7, 0xc2, 0xf1, 0xb0, 0xf8, 0x5d,
+	0x5e, 0xf8, 0xc7, 0x47, 0x25, 0xfa, 0xe3, 0x71, 0xe7, 0x9f, 0x01, 0x00, 0x90, 0x44, 0x2b, 0x6d,
+	0xb3, 0x0c, 0x00, 0x00,
 }
@@ -0,0 +1,84 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sdkserver
+
+import (
+	"crypto/subtle"
+	"io/ioutil"
+	"strings"
+
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// bearerPrefix is the prefix of the token in the authorization metadata of a request
+const bearerPrefix = "Bearer "
+
+// TokenAuth makes the SDK server require a token from its clients, so only the processes of the Pod
+// that can read the token can control the GameServer. The token is sent as a bearer token in the
+// authorization metadata of gRPC requests, and in the Authorization header of HTTP requests,
+// which the gRPC gateway passes through.
+type TokenAuth struct {
+	token []byte
+}
+
+// NewTokenAuth returns a TokenAuth that requires the token in the given file
+func NewTokenAuth(tokenFile string) (*TokenAuth, error) {
+	b, err := ioutil.ReadFile(tokenFile)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read the SDK server token file %s", tokenFile)
+	}
+	token := strings.TrimSpace(string(b))
+	if token == "" {
+		return nil, errors.Errorf("SDK server token file %s is empty", tokenFile)
+	}
+	return &TokenAuth{token: []byte(token)}, nil
+}
+
+// ServerOptions returns the options of a gRPC server that rejects the requests without the token
+func (a *TokenAuth) ServerOptions() []grpc.ServerOption {
+	return []grpc.ServerOption{grpc.UnaryInterceptor(a.unaryInterceptor), grpc.StreamInterceptor(a.streamInterceptor)}
+}
+
+// unaryInterceptor rejects the unary requests without the token
+func (a *TokenAuth) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := a.authenticate(ctx); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// streamInterceptor rejects the streams without the token
+func (a *TokenAuth) streamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := a.authenticate(ss.Context()); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
+
+// authenticate returns an Unauthenticated error, unless the incoming metadata of the context has the token
+func (a *TokenAuth) authenticate(ctx context.Context) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, v := range md.Get("authorization") {
+		if strings.HasPrefix(v, bearerPrefix) && subtle.ConstantTimeCompare([]byte(v[len(bearerPrefix):]), a.token) == 1 {
+			return nil
+		}
+	}
+	return status.Error(codes.Unauthenticated, "a valid SDK server token is required")
+}
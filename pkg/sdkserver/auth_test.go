@@ -0,0 +1,84 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sdkserver
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestNewTokenAuth(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "sdk-token")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir) // nolint: errcheck
+
+	file := filepath.Join(dir, "token")
+	assert.NoError(t, ioutil.WriteFile(file, []byte("secret\n"), 0600))
+	a, err := NewTokenAuth(file)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("secret"), a.token)
+
+	_, err = NewTokenAuth(filepath.Join(dir, "missing"))
+	assert.Error(t, err)
+
+	assert.NoError(t, ioutil.WriteFile(file, []byte(" \n"), 0600))
+	_, err = NewTokenAuth(file)
+	assert.EqualError(t, err, "SDK server token file "+file+" is empty")
+}
+
+func TestTokenAuthInterceptor(t *testing.T) {
+	t.Parallel()
+
+	a := &TokenAuth{token: []byte("secret")}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+
+	fixtures := map[string]struct {
+		md   metadata.MD
+		code codes.Code
+	}{
+		"valid token":   {md: metadata.Pairs("authorization", "Bearer secret"), code: codes.OK},
+		"invalid token": {md: metadata.Pairs("authorization", "Bearer nope"), code: codes.Unauthenticated},
+		"not bearer":    {md: metadata.Pairs("authorization", "secret"), code: codes.Unauthenticated},
+		"no token":      {md: metadata.MD{}, code: codes.Unauthenticated},
+	}
+
+	for k, v := range fixtures {
+		t.Run(k, func(t *testing.T) {
+			ctx := metadata.NewIncomingContext(context.Background(), v.md)
+			resp, err := a.unaryInterceptor(ctx, nil, &grpc.UnaryServerInfo{}, handler)
+			assert.Equal(t, v.code, status.Code(err))
+			if v.code == codes.OK {
+				assert.Equal(t, "ok", resp)
+			}
+		})
+	}
+
+	// without any metadata
+	_, err := a.unaryInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{}, handler)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
@@ -100,12 +100,14 @@ func (m *emptyMockStream) RecvMsg(msg interface{}) error {
 
 type gameServerMockStream struct {
 	msgs chan *sdk.GameServer
+	ctx  netcontext.Context
 }
 
 // newGameServerMockStream implements SDK_WatchGameServerServer for testing
 func newGameServerMockStream() *gameServerMockStream {
 	return &gameServerMockStream{
 		msgs: make(chan *sdk.GameServer, 10),
+		ctx:  netcontext.Background(),
 	}
 }
 
@@ -126,8 +128,8 @@ func (*gameServerMockStream) SetTrailer(metadata.MD) {
 	panic("implement me")
 }
 
-func (*gameServerMockStream) Context() netcontext.Context {
-	panic("implement me")
+func (m *gameServerMockStream) Context() netcontext.Context {
+	return m.ctx
 }
 
 func (*gameServerMockStream) SendMsg(m interface{}) error {
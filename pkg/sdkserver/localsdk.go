@@ -26,9 +26,11 @@ import (
 	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
 	"agones.dev/agones/pkg/sdk"
 	"github.com/fsnotify/fsnotify"
+	"github.com/golang/protobuf/proto"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc/metadata"
 	"k8s.io/apimachinery/pkg/util/yaml"
 )
 
@@ -273,6 +275,97 @@ func (l *LocalSDKServer) SetAnnotation(_ context.Context, kv *sdk.KeyValue) (*sd
 	return &sdk.Empty{}, nil
 }
 
+// IncrementCounter adds the amount to the named Counter on the backing GameServer status
+func (l *LocalSDKServer) IncrementCounter(_ context.Context, cu *sdk.CounterUpdate) (*sdk.Empty, error) {
+	logrus.WithField("values", cu).Info("Incrementing counter")
+	l.gsMutex.Lock()
+	defer l.gsMutex.Unlock()
+
+	counter, ok := l.gs.GetStatus().GetCounters()[cu.Name]
+	if !ok {
+		return nil, errors.Errorf("could not find counter %s", cu.Name)
+	}
+	count := counter.Count + cu.Amount
+	if count < 0 || count > counter.Capacity {
+		return nil, errors.Errorf("could not increment counter %s by %d: count %d is out of range 0-%d", cu.Name, cu.Amount, count, counter.Capacity)
+	}
+
+	counter.Count = count
+	l.update <- struct{}{}
+	l.recordRequest("incrementcounter")
+	return &sdk.Empty{}, nil
+}
+
+// SetCounterCapacity sets the capacity of the named Counter on the backing GameServer status
+func (l *LocalSDKServer) SetCounterCapacity(_ context.Context, cu *sdk.CounterUpdate) (*sdk.Empty, error) {
+	logrus.WithField("values", cu).Info("Setting counter capacity")
+	l.gsMutex.Lock()
+	defer l.gsMutex.Unlock()
+
+	counter, ok := l.gs.GetStatus().GetCounters()[cu.Name]
+	if !ok {
+		return nil, errors.Errorf("could not find counter %s", cu.Name)
+	}
+	if cu.Amount < 0 {
+		return nil, errors.Errorf("could not set capacity of counter %s to %d: capacity cannot be negative", cu.Name, cu.Amount)
+	}
+
+	counter.Capacity = cu.Amount
+	if counter.Count > counter.Capacity {
+		counter.Count = counter.Capacity
+	}
+	l.update <- struct{}{}
+	l.recordRequest("setcountercapacity")
+	return &sdk.Empty{}, nil
+}
+
+// AppendListValue appends the value to the named List on the backing GameServer status
+func (l *LocalSDKServer) AppendListValue(_ context.Context, lv *sdk.ListValue) (*sdk.Empty, error) {
+	logrus.WithField("values", lv).Info("Appending list value")
+	l.gsMutex.Lock()
+	defer l.gsMutex.Unlock()
+
+	list, ok := l.gs.GetStatus().GetLists()[lv.Name]
+	if !ok {
+		return nil, errors.Errorf("could not find list %s", lv.Name)
+	}
+	for _, v := range list.Values {
+		if v == lv.Value {
+			return nil, errors.Errorf("could not append value %s to list %s: value already exists", lv.Value, lv.Name)
+		}
+	}
+	if int64(len(list.Values)) >= list.Capacity {
+		return nil, errors.Errorf("could not append value %s to list %s: list is at capacity %d", lv.Value, lv.Name, list.Capacity)
+	}
+
+	list.Values = append(list.Values, lv.Value)
+	l.update <- struct{}{}
+	l.recordRequest("appendlistvalue")
+	return &sdk.Empty{}, nil
+}
+
+// DeleteListValue removes the value from the named List on the backing GameServer status
+func (l *LocalSDKServer) DeleteListValue(_ context.Context, lv *sdk.ListValue) (*sdk.Empty, error) {
+	logrus.WithField("values", lv).Info("Deleting list value")
+	l.gsMutex.Lock()
+	defer l.gsMutex.Unlock()
+
+	list, ok := l.gs.GetStatus().GetLists()[lv.Name]
+	if !ok {
+		return nil, errors.Errorf("could not find list %s", lv.Name)
+	}
+	for i, v := range list.Values {
+		if v == lv.Value {
+			list.Values = append(list.Values[:i], list.Values[i+1:]...)
+			l.update <- struct{}{}
+			l.recordRequest("deletelistvalue")
+			return &sdk.Empty{}, nil
+		}
+	}
+
+	return nil, errors.Errorf("could not delete value %s from list %s: value does not exist", lv.Value, lv.Name)
+}
+
 // GetGameServer returns current GameServer configuration.
 func (l *LocalSDKServer) GetGameServer(context.Context, *sdk.Empty) (*sdk.GameServer, error) {
 	logrus.Info("getting GameServer details")
@@ -284,7 +377,12 @@ func (l *LocalSDKServer) GetGameServer(context.Context, *sdk.Empty) (*sdk.GameSe
 
 // WatchGameServer will return current GameServer configuration, 3 times, every 5 seconds
 func (l *LocalSDKServer) WatchGameServer(_ *sdk.Empty, stream sdk.SDK_WatchGameServerServer) error {
-	logrus.Info("connected to watch GameServer...")
+	md, _ := metadata.FromIncomingContext(stream.Context())
+	fields, err := watchFields(md)
+	if err != nil {
+		return err
+	}
+	logrus.WithField("fields", fields).Info("connected to watch GameServer...")
 	observer := make(chan struct{})
 
 	defer func() {
@@ -294,10 +392,21 @@ func (l *LocalSDKServer) WatchGameServer(_ *sdk.Empty, stream sdk.SDK_WatchGameS
 	l.updateObservers.Store(observer, true)
 
 	l.recordRequest("watch")
+	var last *sdk.GameServer
 	for range observer {
 		l.gsMutex.RLock()
-		err := stream.Send(l.gs)
+		msg := l.gs
+		if fields != nil {
+			msg = filterFields(l.gs, fields)
+		}
 		l.gsMutex.RUnlock()
+		if fields != nil {
+			if last != nil && proto.Equal(last, msg) {
+				continue
+			}
+			last = msg
+		}
+		err := stream.Send(msg)
 		if err != nil {
 			logrus.WithError(err).Error("error sending gameserver")
 			return err
@@ -410,6 +519,14 @@ func (l *LocalSDKServer) setGameServerFromFilePath(filePath string) error {
 		return err
 	}
 
+	// there is no controller to initialise the status Counters and Lists from the spec
+	if gs.Status.Counters == nil {
+		gs.Status.Counters = gs.Spec.Counters
+	}
+	if gs.Status.Lists == nil {
+		gs.Status.Lists = gs.Spec.Lists
+	}
+
 	l.gsMutex.Lock()
 	defer l.gsMutex.Unlock()
 	l.gs = convert(&gs)
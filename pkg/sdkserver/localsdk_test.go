@@ -275,6 +275,51 @@ func TestLocalSDKServerWatchGameServer(t *testing.T) {
 	}
 }
 
+func TestLocalSDKServerCountersAndLists(t *testing.T) {
+	t.Parallel()
+
+	fixture := &agonesv1.GameServer{
+		ObjectMeta: metav1.ObjectMeta{Name: "counters"},
+		Spec: agonesv1.GameServerSpec{
+			Counters: map[string]agonesv1.CounterStatus{"rooms": {Count: 1, Capacity: 3}},
+			Lists:    map[string]agonesv1.ListStatus{"players": {Capacity: 2, Values: []string{"alice"}}},
+		},
+	}
+	path, err := gsToTmpFile(fixture)
+	assert.Nil(t, err)
+
+	l, err := NewLocalSDKServer(path)
+	assert.Nil(t, err)
+	defer l.Close()
+
+	ctx := context.Background()
+	e := &sdk.Empty{}
+
+	_, err = l.IncrementCounter(ctx, &sdk.CounterUpdate{Name: "rooms", Amount: 2})
+	assert.Nil(t, err)
+	_, err = l.IncrementCounter(ctx, &sdk.CounterUpdate{Name: "rooms", Amount: 1})
+	assert.NotNil(t, err)
+	_, err = l.IncrementCounter(ctx, &sdk.CounterUpdate{Name: "missing", Amount: 1})
+	assert.NotNil(t, err)
+	_, err = l.SetCounterCapacity(ctx, &sdk.CounterUpdate{Name: "rooms", Amount: 2})
+	assert.Nil(t, err)
+
+	_, err = l.AppendListValue(ctx, &sdk.ListValue{Name: "players", Value: "bob"})
+	assert.Nil(t, err)
+	_, err = l.AppendListValue(ctx, &sdk.ListValue{Name: "players", Value: "carol"})
+	assert.NotNil(t, err)
+	_, err = l.DeleteListValue(ctx, &sdk.ListValue{Name: "players", Value: "alice"})
+	assert.Nil(t, err)
+	_, err = l.DeleteListValue(ctx, &sdk.ListValue{Name: "players", Value: "alice"})
+	assert.NotNil(t, err)
+
+	gs, err := l.GetGameServer(ctx, e)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), gs.Status.Counters["rooms"].Count)
+	assert.Equal(t, int64(2), gs.Status.Counters["rooms"].Capacity)
+	assert.Equal(t, []string{"bob"}, gs.Status.Lists["players"].Values)
+}
+
 func gsToTmpFile(gs *agonesv1.GameServer) (string, error) {
 	file, err := ioutil.TempFile(os.TempDir(), "gameserver-")
 	if err != nil {
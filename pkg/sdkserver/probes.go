@@ -0,0 +1,86 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sdkserver
+
+import (
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
+	"github.com/pkg/errors"
+)
+
+const (
+	// healthProbeTimeout is how long each check of a health probe can take before it fails
+	healthProbeTimeout = time.Second
+
+	// problemHealthProbeFailed is the event reason when a health probe of the game server fails too many times in a row
+	problemHealthProbeFailed = "SDKHealthProbeFailed"
+)
+
+// probeHealth makes the check of a health probe against the game server,
+// which shares the network of the Pod with the sidecar
+func probeHealth(p agonesv1.HealthProbe, timeout time.Duration) error {
+	addr := net.JoinHostPort("localhost", strconv.Itoa(int(p.Port)))
+	switch p.Type {
+	case agonesv1.HealthProbeTCP:
+		conn, err := net.DialTimeout("tcp", addr, timeout)
+		if err != nil {
+			return errors.Wrapf(err, "could not connect to port %d", p.Port)
+		}
+		return conn.Close()
+	case agonesv1.HealthProbeHTTP:
+		client := http.Client{Timeout: timeout}
+		resp, err := client.Get("http://" + addr + p.Path)
+		if err != nil {
+			return errors.Wrapf(err, "could not get %s on port %d", p.Path, p.Port)
+		}
+		defer resp.Body.Close() // nolint: errcheck
+		if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusBadRequest {
+			return errors.Errorf("getting %s on port %d returned status code %d", p.Path, p.Port, resp.StatusCode)
+		}
+		return nil
+	}
+	return errors.Errorf("unknown health probe type %s", p.Type)
+}
+
+// checkHealthProbes makes the checks of the health probes, once the initial delay has passed,
+// and counts how many times in a row each probe has failed
+func (s *SDKServer) checkHealthProbes() {
+	if len(s.health.Probes) == 0 || s.clock.Now().Before(s.healthProbesAfter) {
+		return
+	}
+
+	for _, p := range s.health.Probes {
+		err := s.probe(p, healthProbeTimeout)
+
+		s.healthMutex.Lock()
+		if err == nil {
+			delete(s.healthProbeFails, p.Name)
+			s.healthMutex.Unlock()
+			continue
+		}
+		s.healthProbeFails[p.Name]++
+		failures := s.healthProbeFails[p.Name]
+		s.healthMutex.Unlock()
+
+		s.logger.WithError(err).WithField("probe", p.Name).WithField("failureCount", failures).Info("GameServer health probe failed")
+		if failures >= s.health.FailureThreshold {
+			s.reportProblem(problemHealthProbeFailed, "Health probe %s of the game server failed %d times in a row: %v", p.Name, failures, err)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sdkserver
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
+	agtesting "agones.dev/agones/pkg/testing"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/clock"
+)
+
+func TestProbeHealth(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/healthy" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+	u, err := url.Parse(server.URL)
+	assert.NoError(t, err)
+	port, err := strconv.Atoi(u.Port())
+	assert.NoError(t, err)
+
+	// a port that nothing listens on
+	l, err := net.Listen("tcp", "localhost:0")
+	assert.NoError(t, err)
+	closedPort := l.Addr().(*net.TCPAddr).Port
+	assert.NoError(t, l.Close())
+
+	assert.NoError(t, probeHealth(agonesv1.HealthProbe{Type: agonesv1.HealthProbeTCP, Port: int32(port)}, time.Second))
+	assert.Error(t, probeHealth(agonesv1.HealthProbe{Type: agonesv1.HealthProbeTCP, Port: int32(closedPort)}, time.Second))
+	assert.NoError(t, probeHealth(agonesv1.HealthProbe{Type: agonesv1.HealthProbeHTTP, Port: int32(port), Path: "/healthy"}, time.Second))
+	assert.Error(t, probeHealth(agonesv1.HealthProbe{Type: agonesv1.HealthProbeHTTP, Port: int32(port), Path: "/"}, time.Second))
+	assert.Error(t, probeHealth(agonesv1.HealthProbe{Type: agonesv1.HealthProbeHTTP, Port: int32(closedPort), Path: "/"}, time.Second))
+	assert.Error(t, probeHealth(agonesv1.HealthProbe{Type: "UDP", Port: int32(port)}, time.Second))
+}
+
+func TestSDKServerCheckHealthProbes(t *testing.T) {
+	t.Parallel()
+
+	m := agtesting.NewMocks()
+	sc, err := defaultSidecar(m)
+	assert.NoError(t, err)
+
+	failing := map[string]bool{}
+	sc.probe = func(p agonesv1.HealthProbe, timeout time.Duration) error {
+		if failing[p.Name] {
+			return errors.New("probe failed")
+		}
+		return nil
+	}
+	sc.health = agonesv1.Health{FailureThreshold: 2, Probes: []agonesv1.HealthProbe{
+		{Name: "netcode", Type: agonesv1.HealthProbeTCP, Port: 7777},
+		{Name: "status", Type: agonesv1.HealthProbeHTTP, Port: 8000, Path: "/"},
+	}}
+	fc := clock.NewFakeClock(time.Now())
+	sc.clock = fc
+	sc.initHealthLastUpdated(10 * time.Second)
+	failing["netcode"] = true
+
+	// not checked during the initial delay
+	sc.checkHealthProbes()
+	assert.Empty(t, sc.healthProbeFails)
+
+	fc.Step(10 * time.Second)
+	sc.checkHealthProbes()
+	assert.Equal(t, map[string]int32{"netcode": 1}, sc.healthProbeFails)
+	assert.True(t, sc.healthy())
+
+	// a success resets the failures in a row
+	failing["netcode"] = false
+	sc.checkHealthProbes()
+	assert.Empty(t, sc.healthProbeFails)
+
+	failing["status"] = true
+	sc.checkHealthProbes()
+	sc.checkHealthProbes()
+	assert.Equal(t, map[string]int32{"status": 2}, sc.healthProbeFails)
+	assert.False(t, sc.healthy())
+
+	// health pings don't make up for failing probes
+	sc.touchHealthLastUpdated()
+	assert.False(t, sc.healthy())
+}
@@ -0,0 +1,86 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sdkserver
+
+import (
+	"fmt"
+	"time"
+
+	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// defaultReadyTimeout is how long the game server has to call Ready() (or Reserve() or Allocate())
+	// once the sidecar has started, before it is reported as a problem
+	defaultReadyTimeout = 5 * time.Minute
+
+	// problemReadyNotCalled is the event reason when the game server hasn't called Ready()
+	problemReadyNotCalled = "SDKReadyNotCalled"
+	// problemHealthNeverPinged is the event reason when health checks fail without a single health ping
+	problemHealthNeverPinged = "SDKHealthNeverPinged"
+	// problemHealthStreamError is the event reason when the health stream of the game server fails
+	problemHealthStreamError = "SDKHealthStreamError"
+)
+
+// reportProblem records a Warning event on the GameServer for a problem with how the game server
+// integrates with the SDK, so that it shows up on the GameServer rather than only in the sidecar logs.
+// Each problem is only reported once, to not flood the GameServer with events.
+func (s *SDKServer) reportProblem(reason, format string, args ...interface{}) {
+	s.problemsMutex.Lock()
+	reported := s.reportedProblems[reason]
+	s.reportedProblems[reason] = true
+	s.problemsMutex.Unlock()
+	if reported {
+		return
+	}
+
+	message := fmt.Sprintf(format, args...)
+	s.logger.WithField("reason", reason).Warn(message)
+
+	gs, err := s.gameServerLister.GameServers(s.namespace).Get(s.gameServerName)
+	if err != nil {
+		s.logger.WithError(err).Error("could not retrieve GameServer to report SDK problem")
+		return
+	}
+	s.recorder.Event(gs, corev1.EventTypeWarning, reason, message)
+}
+
+// runReadyCheck waits for the ready timeout, and then checks that the game server has called Ready()
+func (s *SDKServer) runReadyCheck(stop <-chan struct{}) {
+	select {
+	case <-stop:
+	case <-s.clock.After(s.readyTimeout):
+		s.checkReadyCalled()
+	}
+}
+
+// checkReadyCalled reports a problem if the GameServer is still Scheduled, as the game server
+// has then not moved it on with Ready(), Reserve(), Allocate() or Shutdown()
+func (s *SDKServer) checkReadyCalled() {
+	gs, err := s.gameServer()
+	if err != nil {
+		s.logger.WithError(err).Error("could not retrieve GameServer to check that Ready() was called")
+		return
+	}
+
+	s.gsUpdateMutex.RLock()
+	pending := s.gsState
+	s.gsUpdateMutex.RUnlock()
+
+	if gs.Status.State == agonesv1.GameServerStateScheduled && pending == "" {
+		s.reportProblem(problemReadyNotCalled, "Ready() was not called by the game server within %s of the SDK server starting", s.readyTimeout)
+	}
+}
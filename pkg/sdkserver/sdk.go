@@ -15,15 +15,46 @@
 package sdkserver
 
 import (
+	"strings"
+
 	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
 	"agones.dev/agones/pkg/sdk"
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/metadata"
 )
 
 const (
 	// metadataPrefix prefix for labels and annotations
 	metadataPrefix = "agones.dev/sdk-"
+
+	// watchFieldsMetadataKey is the gRPC metadata key with the comma separated list of fields
+	// that a WatchGameServer stream is sent, which is the HTTP header Grpc-Metadata-Agones-Watch-Fields
+	// through the REST API
+	watchFieldsMetadataKey = "agones-watch-fields"
+
+	watchFieldState       = "state"
+	watchFieldAddress     = "address"
+	watchFieldPorts       = "ports"
+	watchFieldLabels      = "labels"
+	watchFieldAnnotations = "annotations"
+	watchFieldCounters    = "counters"
+	watchFieldLists       = "lists"
+	watchFieldDisruption  = "disruption"
 )
 
+// watchableFields are the fields a WatchGameServer stream can be filtered to
+var watchableFields = map[string]bool{
+	watchFieldState:       true,
+	watchFieldAddress:     true,
+	watchFieldPorts:       true,
+	watchFieldLabels:      true,
+	watchFieldAnnotations: true,
+	watchFieldCounters:    true,
+	watchFieldLists:       true,
+	watchFieldDisruption:  true,
+}
+
 // convert converts a K8s GameServer object, into a gRPC SDK GameServer object
 func convert(gs *agonesv1.GameServer) *sdk.GameServer {
 	meta := gs.ObjectMeta
@@ -65,6 +96,104 @@ func convert(gs *agonesv1.GameServer) *sdk.GameServer {
 		}
 		result.Status.Ports = append(result.Status.Ports, grpcPort)
 	}
+	// the host ports are known as soon as they are allocated, but are only copied to the status
+	// once the Pod is scheduled, so share them straight away
+	if len(status.Ports) == 0 {
+		for _, p := range gs.Spec.Ports {
+			if p.HostPort == 0 {
+				continue
+			}
+			result.Status.Ports = append(result.Status.Ports, &sdk.GameServer_Status_Port{Name: p.Name, Port: p.HostPort})
+		}
+	}
+
+	if len(status.Counters) > 0 {
+		result.Status.Counters = make(map[string]*sdk.GameServer_Status_Counter, len(status.Counters))
+		for name, c := range status.Counters {
+			result.Status.Counters[name] = &sdk.GameServer_Status_Counter{Count: c.Count, Capacity: c.Capacity}
+		}
+	}
+
+	if len(status.Lists) > 0 {
+		result.Status.Lists = make(map[string]*sdk.GameServer_Status_List, len(status.Lists))
+		for name, l := range status.Lists {
+			values := make([]string, len(l.Values))
+			copy(values, l.Values)
+			result.Status.Lists[name] = &sdk.GameServer_Status_List{Capacity: l.Capacity, Values: values}
+		}
+	}
+
+	if d := status.Disruption; d != nil {
+		result.Status.Disruption = &sdk.GameServer_Status_Disruption{
+			Reason:  string(d.Reason),
+			Message: d.Message,
+			Since:   d.Since.Unix(),
+		}
+	}
 
 	return result
 }
+
+// watchFields returns the GameServer fields requested through the watchFieldsMetadataKey
+// gRPC metadata of a WatchGameServer call, or nil if the whole GameServer was requested
+func watchFields(md metadata.MD) (map[string]bool, error) {
+	var fields map[string]bool
+	for _, value := range md.Get(watchFieldsMetadataKey) {
+		for _, f := range strings.Split(value, ",") {
+			f = strings.ToLower(strings.TrimSpace(f))
+			if f == "" {
+				continue
+			}
+			if !watchableFields[f] {
+				return nil, errors.Errorf("unknown WatchGameServer field %q", f)
+			}
+			if fields == nil {
+				fields = map[string]bool{}
+			}
+			fields[f] = true
+		}
+	}
+	return fields, nil
+}
+
+// filterFields returns a deep copy of the gRPC SDK GameServer that only has its identity,
+// its deletion timestamp and the given fields set
+func filterFields(gs *sdk.GameServer, fields map[string]bool) *sdk.GameServer {
+	meta := gs.ObjectMeta
+	status := gs.Status
+	result := &sdk.GameServer{
+		ObjectMeta: &sdk.GameServer_ObjectMeta{
+			Name:      meta.Name,
+			Namespace: meta.Namespace,
+			Uid:       meta.Uid,
+			// always sent, so that the game server is notified when it is deleted
+			DeletionTimestamp: meta.DeletionTimestamp,
+		},
+		Status: &sdk.GameServer_Status{},
+	}
+	if fields[watchFieldLabels] {
+		result.ObjectMeta.Labels = meta.Labels
+	}
+	if fields[watchFieldAnnotations] {
+		result.ObjectMeta.Annotations = meta.Annotations
+	}
+	if fields[watchFieldState] {
+		result.Status.State = status.State
+	}
+	if fields[watchFieldAddress] {
+		result.Status.Address = status.Address
+	}
+	if fields[watchFieldPorts] {
+		result.Status.Ports = status.Ports
+	}
+	if fields[watchFieldCounters] {
+		result.Status.Counters = status.Counters
+	}
+	if fields[watchFieldLists] {
+		result.Status.Lists = status.Lists
+	}
+	if fields[watchFieldDisruption] {
+		result.Status.Disruption = status.Disruption
+	}
+	return proto.Clone(result).(*sdk.GameServer)
+}
@@ -84,4 +84,28 @@ func TestConvert(t *testing.T) {
 	sdkGs = convert(fixture)
 	eq(t, fixture, sdkGs)
 	assert.Equal(t, fixture.ObjectMeta.DeletionTimestamp.Unix(), sdkGs.ObjectMeta.DeletionTimestamp)
+	assert.Nil(t, sdkGs.Status.Disruption)
+
+	fixture.Status.Disruption = &agonesv1.GameServerDisruption{
+		Reason:  agonesv1.DisruptionNodeDraining,
+		Message: "Node george has been cordoned",
+		Since:   now,
+	}
+	sdkGs = convert(fixture)
+	eq(t, fixture, sdkGs)
+	if assert.NotNil(t, sdkGs.Status.Disruption) {
+		assert.Equal(t, "NodeDraining", sdkGs.Status.Disruption.Reason)
+		assert.Equal(t, fixture.Status.Disruption.Message, sdkGs.Status.Disruption.Message)
+		assert.Equal(t, now.Unix(), sdkGs.Status.Disruption.Since)
+	}
+
+	// before the Pod is scheduled, the allocated host ports are used
+	fixture.Status.Ports = nil
+	fixture.Spec.Ports = []agonesv1.GameServerPort{
+		{Name: "default", PortPolicy: agonesv1.Dynamic, ContainerPort: 7777, HostPort: 7001},
+		{Name: "passthrough", PortPolicy: agonesv1.Passthrough, ContainerPort: 7002, HostPort: 7002},
+		{Name: "unallocated", PortPolicy: agonesv1.Dynamic, ContainerPort: 7777},
+	}
+	sdkGs = convert(fixture)
+	assert.Equal(t, []*sdk.GameServer_Status_Port{{Name: "default", Port: 7001}, {Name: "passthrough", Port: 7002}}, sdkGs.Status.Ports)
 }
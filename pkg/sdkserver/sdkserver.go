@@ -32,14 +32,17 @@ import (
 	"agones.dev/agones/pkg/util/logfields"
 	"agones.dev/agones/pkg/util/runtime"
 	"agones.dev/agones/pkg/util/workerqueue"
+	"github.com/golang/protobuf/proto"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc/metadata"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/util/clock"
 	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/scheme"
 	k8sv1 "k8s.io/client-go/kubernetes/typed/core/v1"
@@ -54,8 +57,17 @@ const (
 	updateState      Operation = "updateState"
 	updateLabel      Operation = "updateLabel"
 	updateAnnotation Operation = "updateAnnotation"
+	updateCounters   Operation = "updateCounters"
+	updateLists      Operation = "updateLists"
 )
 
+// listOperation is a pending append to, or removal from, a GameServer List
+type listOperation struct {
+	name   string
+	value  string
+	remove bool
+}
+
 var _ sdk.SDKServer = &SDKServer{}
 
 // SDKServer is a gRPC server, that is meant to be a sidecar
@@ -66,6 +78,7 @@ type SDKServer struct {
 	gameServerName     string
 	namespace          string
 	informerFactory    externalversions.SharedInformerFactory
+	kubeInformers      informers.SharedInformerFactory
 	gameServerGetter   typedv1.GameServersGetter
 	gameServerLister   listersv1.GameServerLister
 	gameServerSynced   cache.InformerSynced
@@ -76,18 +89,39 @@ type SDKServer struct {
 	healthMutex        sync.RWMutex
 	healthLastUpdated  time.Time
 	healthFailureCount int32
+	healthPinged       bool
+	healthProbesAfter  time.Time
+	healthProbeFails   map[string]int32 // how many times in a row each failing health probe has failed, by name
+	probe              func(p agonesv1.HealthProbe, timeout time.Duration) error
+	readyTimeout       time.Duration
+	problemsMutex      sync.Mutex
+	reportedProblems   map[string]bool
 	workerqueue        *workerqueue.WorkerQueue
 	streamMutex        sync.RWMutex
-	connectedStreams   []sdk.SDK_WatchGameServerServer
+	connectedStreams   []*watchStream
 	stop               <-chan struct{}
 	recorder           record.EventRecorder
 	gsLabels           map[string]string
 	gsAnnotations      map[string]string
+	gsCounterDiffs     map[string]int64
+	gsCounterCapacity  map[string]int64
+	gsListOperations   []listOperation
 	gsState            agonesv1.GameServerState
 	gsUpdateMutex      sync.RWMutex
 	gsWaitForSync      sync.WaitGroup
 	reserveTimer       *time.Timer
 	gsReserveDuration  *time.Duration
+	gsStopped          chan struct{}
+	gsStoppedOnce      sync.Once
+}
+
+// watchStream is a connected WatchGameServer stream
+type watchStream struct {
+	stream sdk.SDK_WatchGameServerServer
+	// fields the stream is filtered to, or nil if it is sent the whole GameServer
+	fields map[string]bool
+	// last is the last GameServer sent to a filtered stream
+	last *sdk.GameServer
 }
 
 // NewSDKServer creates a SDKServer that sets up an
@@ -102,6 +136,11 @@ func NewSDKServer(gameServerName, namespace string, kubeClient kubernetes.Interf
 		opts.FieldSelector = s1.String()
 	})
 	gameServers := factory.Agones().V1().GameServers()
+	// and to its Pod, which has the same name
+	kubeInformers := informers.NewFilteredSharedInformerFactory(kubeClient, 30*time.Second, namespace, func(opts *metav1.ListOptions) {
+		opts.FieldSelector = fields.OneTermEqualSelector("metadata.name", gameServerName).String()
+	})
+	pods := kubeInformers.Core().V1().Pods()
 
 	s := &SDKServer{
 		gameServerName:   gameServerName,
@@ -116,14 +155,22 @@ func NewSDKServer(gameServerName, namespace string, kubeClient kubernetes.Interf
 		clock:              clock.RealClock{},
 		healthMutex:        sync.RWMutex{},
 		healthFailureCount: 0,
+		healthProbeFails:   map[string]int32{},
+		probe:              probeHealth,
+		readyTimeout:       defaultReadyTimeout,
+		reportedProblems:   map[string]bool{},
 		streamMutex:        sync.RWMutex{},
 		gsLabels:           map[string]string{},
 		gsAnnotations:      map[string]string{},
+		gsCounterDiffs:     map[string]int64{},
+		gsCounterCapacity:  map[string]int64{},
 		gsUpdateMutex:      sync.RWMutex{},
 		gsWaitForSync:      sync.WaitGroup{},
+		gsStopped:          make(chan struct{}),
 	}
 
 	s.informerFactory = factory
+	s.kubeInformers = kubeInformers
 	s.logger = runtime.NewLoggerWithType(s).WithField("gsKey", namespace+"/"+gameServerName)
 
 	gameServers.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -132,6 +179,12 @@ func NewSDKServer(gameServerName, namespace string, kubeClient kubernetes.Interf
 			s.sendGameServerUpdate(gs)
 		},
 	})
+	pods.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: s.checkGameServerContainer,
+		UpdateFunc: func(_, newObj interface{}) {
+			s.checkGameServerContainer(newObj)
+		},
+	})
 
 	eventBroadcaster := record.NewBroadcaster()
 	eventBroadcaster.StartLogging(s.logger.Infof)
@@ -171,15 +224,19 @@ func NewSDKServer(gameServerName, namespace string, kubeClient kubernetes.Interf
 }
 
 // initHealthLastUpdated adds the initial delay to now, then it will always be after `now`
-// until the delay passes
+// until the delay passes.
+// healthLastUpdated keeps the monotonic clock reading of now (which UTC() would strip), so that
+// health checks are not affected by steps of the wall clock, e.g. from NTP.
 func (s *SDKServer) initHealthLastUpdated(healthInitialDelay time.Duration) {
-	s.healthLastUpdated = s.clock.Now().UTC().Add(healthInitialDelay)
+	s.healthLastUpdated = s.clock.Now().Add(healthInitialDelay)
+	s.healthProbesAfter = s.healthLastUpdated
 }
 
 // Run processes the rate limited queue.
 // Will block until stop is closed
 func (s *SDKServer) Run(stop <-chan struct{}) error {
 	s.informerFactory.Start(stop)
+	s.kubeInformers.Start(stop)
 	if !cache.WaitForCacheSync(stop, s.gameServerSynced) {
 		return errors.New("failed to wait for caches to sync")
 	}
@@ -210,6 +267,8 @@ func (s *SDKServer) Run(stop <-chan struct{}) error {
 	s.healthTimeout = time.Duration(gs.Spec.Health.PeriodSeconds) * time.Second
 	s.initHealthLastUpdated(time.Duration(gs.Spec.Health.InitialDelaySeconds) * time.Second)
 
+	restarted := s.restoreState(gs)
+
 	if gs.Status.State == agonesv1.GameServerStateReserved && gs.Status.ReservedUntil != nil {
 		s.gsUpdateMutex.Lock()
 		s.resetReserveAfter(context.Background(), time.Until(gs.Status.ReservedUntil.Time))
@@ -217,11 +276,20 @@ func (s *SDKServer) Run(stop <-chan struct{}) error {
 	}
 
 	// start health checking running
-	if !s.health.Disabled {
+	switch {
+	case s.health.Disabled:
+	case gs.Status.State == agonesv1.GameServerStateUnhealthy || gs.Status.State == agonesv1.GameServerStateShutdown:
+		// the GameServer can't become healthy again, so there is nothing left to check
+		s.logger.WithField("state", gs.Status.State).Info("Not starting GameServer health checking")
+	default:
 		s.logger.Info("Starting GameServer health checking")
 		go wait.Until(s.runHealth, s.healthTimeout, stop)
 	}
 
+	if !restarted {
+		go s.runReadyCheck(stop)
+	}
+
 	// then start the http endpoints
 	s.logger.Info("Starting SDKServer http health check...")
 	go func() {
@@ -242,6 +310,31 @@ func (s *SDKServer) Run(stop <-chan struct{}) error {
 	return nil
 }
 
+// restoreState restores the view of the SDKServer from the GameServer, if the game server has already
+// moved it on from Scheduled, which means the sdk-server container has restarted while the game server kept
+// running. The state of the GameServer becomes the current state, rather than none, and the game server
+// is considered to have pinged health already, as it only needs to reconnect its health stream.
+// Returns true if the SDKServer has restarted.
+func (s *SDKServer) restoreState(gs *agonesv1.GameServer) bool {
+	switch gs.Status.State {
+	case "", agonesv1.GameServerStateCreating, agonesv1.GameServerStateStarting, agonesv1.GameServerStateScheduled:
+		return false
+	}
+
+	s.logger.WithField("state", gs.Status.State).Info("Restoring state of restarted SDKServer")
+	s.gsUpdateMutex.Lock()
+	// don't overwrite a state the game server already requested since the restart
+	if s.gsState == "" {
+		s.gsState = gs.Status.State
+	}
+	s.gsUpdateMutex.Unlock()
+
+	s.healthMutex.Lock()
+	s.healthPinged = true
+	s.healthMutex.Unlock()
+	return true
+}
+
 // syncGameServer synchronises the GameServer with the requested operations.
 // The format of the key is {operation}. To prevent old operation data from
 // overwriting the new one, the operation data is persisted in SDKServer.
@@ -253,6 +346,8 @@ func (s *SDKServer) syncGameServer(key string) error {
 		return s.updateLabels()
 	case updateAnnotation:
 		return s.updateAnnotations()
+	case updateCounters, updateLists:
+		return s.updateCountersAndLists()
 	}
 
 	return errors.Errorf("could not sync game server key: %s", key)
@@ -272,8 +367,10 @@ func (s *SDKServer) updateState() error {
 		return err
 	}
 
-	// If we are currently in shutdown/being deleted, there is no escaping.
-	if gs.IsBeingDeleted() {
+	// If we are currently in shutdown/being deleted, there is no escaping,
+	// but a deleted GameServer can still be shut down, to end its termination grace period.
+	shutdownDeleted := gs.Status.State != agonesv1.GameServerStateShutdown && s.gsState == agonesv1.GameServerStateShutdown
+	if gs.IsBeingDeleted() && !shutdownDeleted {
 		s.logger.Info("GameServerState being shutdown. Skipping update.")
 		return nil
 	}
@@ -285,7 +382,15 @@ func (s *SDKServer) updateState() error {
 	}
 
 	s.gsUpdateMutex.RLock()
-	gs.Status.State = s.gsState
+	switch s.gsState {
+	case agonesv1.GameServerStateUnhealthy:
+		gs.MarkUnhealthy()
+	case agonesv1.GameServerStateAllocated:
+		gs.MarkAllocated()
+	default:
+		gs.Status.State = s.gsState
+		gs.ApplyStatusLabels()
+	}
 
 	// If we are setting the Reserved status, check for the duration, and set that too.
 	if gs.Status.State == agonesv1.GameServerStateReserved && s.gsReserveDuration != nil {
@@ -377,6 +482,107 @@ func (s *SDKServer) updateAnnotations() error {
 	return err
 }
 
+// updateCountersAndLists applies all the Counter and List changes persisted in SDKServer,
+// i.e. SDKServer.gsCounterDiffs, SDKServer.gsCounterCapacity and SDKServer.gsListOperations,
+// to the GameServer Status in a single update. If the update fails, the changes are kept
+// so they can be applied on top of the latest version of the GameServer when retried.
+func (s *SDKServer) updateCountersAndLists() error {
+	s.gsUpdateMutex.Lock()
+	diffs, capacity, listOps := s.gsCounterDiffs, s.gsCounterCapacity, s.gsListOperations
+	s.gsCounterDiffs = map[string]int64{}
+	s.gsCounterCapacity = map[string]int64{}
+	s.gsListOperations = nil
+	s.gsUpdateMutex.Unlock()
+
+	if len(diffs) == 0 && len(capacity) == 0 && len(listOps) == 0 {
+		return nil
+	}
+
+	s.logger.WithField("counters", diffs).WithField("capacity", capacity).WithField("lists", listOps).Info("Updating counters and lists")
+
+	gs, err := s.gameServer()
+	if err == nil {
+		gsCopy := gs.DeepCopy()
+		applyCountersAndLists(&gsCopy.Status, diffs, capacity, listOps)
+		_, err = s.gameServerGetter.GameServers(s.namespace).Update(gsCopy)
+		err = errors.Wrapf(err, "could not update counters and lists on GameServer %s/%s", s.namespace, s.gameServerName)
+	}
+
+	if err != nil {
+		// put the changes back in front of anything that has come in since, so they are retried in order
+		s.gsUpdateMutex.Lock()
+		for k, v := range diffs {
+			s.gsCounterDiffs[k] += v
+		}
+		for k, v := range capacity {
+			if _, ok := s.gsCounterCapacity[k]; !ok {
+				s.gsCounterCapacity[k] = v
+			}
+		}
+		s.gsListOperations = append(listOps, s.gsListOperations...)
+		s.gsUpdateMutex.Unlock()
+	}
+
+	return err
+}
+
+// applyCountersAndLists applies pending Counter and List changes to a GameServerStatus.
+// Counts are kept within the bounds of zero and the Counter's capacity.
+func applyCountersAndLists(status *agonesv1.GameServerStatus, diffs, capacity map[string]int64, listOps []listOperation) {
+	for name, c := range capacity {
+		if counter, ok := status.Counters[name]; ok {
+			counter.Capacity = c
+			status.Counters[name] = counter
+		}
+	}
+	for name, diff := range diffs {
+		if counter, ok := status.Counters[name]; ok {
+			counter.Count += diff
+			if counter.Count < 0 {
+				counter.Count = 0
+			}
+			status.Counters[name] = counter
+		}
+	}
+	for name, counter := range status.Counters {
+		if counter.Count > counter.Capacity {
+			counter.Count = counter.Capacity
+			status.Counters[name] = counter
+		}
+	}
+
+	for _, op := range listOps {
+		list, ok := status.Lists[op.name]
+		if !ok {
+			continue
+		}
+		if op.remove {
+			values := make([]string, 0, len(list.Values))
+			for _, v := range list.Values {
+				if v != op.value {
+					values = append(values, v)
+				}
+			}
+			list.Values = values
+		} else if !list.Contains(op.value) && int64(len(list.Values)) < list.Capacity {
+			list.Values = append(list.Values, op.value)
+		}
+		status.Lists[op.name] = list
+	}
+}
+
+// pendingStatus returns the Status of the GameServer, with all the Counter and List
+// changes that have not yet been applied to it. Should be called with a s.gsUpdateMutex lock.
+func (s *SDKServer) pendingStatus() (*agonesv1.GameServerStatus, error) {
+	gs, err := s.gameServer()
+	if err != nil {
+		return nil, err
+	}
+	status := gs.Status.DeepCopy()
+	applyCountersAndLists(status, s.gsCounterDiffs, s.gsCounterCapacity, s.gsListOperations)
+	return status, nil
+}
+
 // enqueueState enqueue a State change request into the
 // workerqueue
 func (s *SDKServer) enqueueState(state agonesv1.GameServerState) {
@@ -408,9 +614,44 @@ func (s *SDKServer) Shutdown(ctx context.Context, e *sdk.Empty) (*sdk.Empty, err
 	s.logger.Info("Received Shutdown request, adding to queue")
 	s.stopReserveTimer()
 	s.enqueueState(agonesv1.GameServerStateShutdown)
+	s.gameServerStopped()
 	return e, nil
 }
 
+// GameServerStopped returns a channel that is closed once the game server no longer needs the SDK:
+// when it has called Shutdown, or when its container has exited after its Pod was deleted,
+// which the kubelet only stops it for once its pre-delete hook has ended
+func (s *SDKServer) GameServerStopped() <-chan struct{} {
+	return s.gsStopped
+}
+
+// gameServerStopped closes the GameServerStopped channel, if it isn't closed already
+func (s *SDKServer) gameServerStopped() {
+	s.gsStoppedOnce.Do(func() {
+		close(s.gsStopped)
+	})
+}
+
+// checkGameServerContainer marks the game server as stopped if the game server container
+// of its Pod has exited after the Pod was deleted
+func (s *SDKServer) checkGameServerContainer(obj interface{}) {
+	pod, ok := obj.(*corev1.Pod)
+	if !ok || pod.ObjectMeta.DeletionTimestamp == nil {
+		return
+	}
+	gs, err := s.gameServer()
+	if err != nil {
+		return
+	}
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.Name == gs.Spec.Container && status.State.Terminated != nil {
+			s.logger.Info("The game server container has exited")
+			s.gameServerStopped()
+			return
+		}
+	}
+}
+
 // Health receives each health ping, and tracks the last time the health
 // check was received, to track if a GameServer is healthy
 func (s *SDKServer) Health(stream sdk.SDK_HealthServer) error {
@@ -421,6 +662,7 @@ func (s *SDKServer) Health(stream sdk.SDK_HealthServer) error {
 			return stream.SendAndClose(&sdk.Empty{})
 		}
 		if err != nil {
+			s.reportProblem(problemHealthStreamError, "Health stream from the game server failed: %v", err)
 			return errors.Wrap(err, "Error with Health check")
 		}
 		s.logger.Info("Health Ping Received")
@@ -454,6 +696,119 @@ func (s *SDKServer) SetAnnotation(_ context.Context, kv *sdk.KeyValue) (*sdk.Emp
 	return &sdk.Empty{}, nil
 }
 
+// IncrementCounter adds the amount to the named Counter on the `GameServer` status. A negative
+// amount decrements the Counter. Returns an error if the Counter does not exist, or if
+// the change would take the count below zero or above the Counter's capacity.
+func (s *SDKServer) IncrementCounter(_ context.Context, cu *sdk.CounterUpdate) (*sdk.Empty, error) {
+	s.logger.WithField("values", cu).Info("Adding IncrementCounter to queue")
+
+	s.gsUpdateMutex.Lock()
+	status, err := s.pendingStatus()
+	if err != nil {
+		s.gsUpdateMutex.Unlock()
+		return nil, err
+	}
+	counter, ok := status.Counters[cu.Name]
+	if !ok {
+		s.gsUpdateMutex.Unlock()
+		return nil, errors.Errorf("could not find counter %s", cu.Name)
+	}
+	count := counter.Count + cu.Amount
+	if count < 0 || count > counter.Capacity {
+		s.gsUpdateMutex.Unlock()
+		return nil, errors.Errorf("could not increment counter %s by %d: count %d is out of range 0-%d", cu.Name, cu.Amount, count, counter.Capacity)
+	}
+	s.gsCounterDiffs[cu.Name] += cu.Amount
+	s.gsUpdateMutex.Unlock()
+
+	s.workerqueue.Enqueue(cache.ExplicitKey(string(updateCounters)))
+	return &sdk.Empty{}, nil
+}
+
+// SetCounterCapacity sets the capacity of the named Counter on the `GameServer` status.
+// Returns an error if the Counter does not exist, or the capacity is negative.
+func (s *SDKServer) SetCounterCapacity(_ context.Context, cu *sdk.CounterUpdate) (*sdk.Empty, error) {
+	s.logger.WithField("values", cu).Info("Adding SetCounterCapacity to queue")
+
+	if cu.Amount < 0 {
+		return nil, errors.Errorf("could not set capacity of counter %s to %d: capacity cannot be negative", cu.Name, cu.Amount)
+	}
+
+	s.gsUpdateMutex.Lock()
+	status, err := s.pendingStatus()
+	if err != nil {
+		s.gsUpdateMutex.Unlock()
+		return nil, err
+	}
+	if _, ok := status.Counters[cu.Name]; !ok {
+		s.gsUpdateMutex.Unlock()
+		return nil, errors.Errorf("could not find counter %s", cu.Name)
+	}
+	s.gsCounterCapacity[cu.Name] = cu.Amount
+	s.gsUpdateMutex.Unlock()
+
+	s.workerqueue.Enqueue(cache.ExplicitKey(string(updateCounters)))
+	return &sdk.Empty{}, nil
+}
+
+// AppendListValue appends the value to the named List on the `GameServer` status.
+// Returns an error if the List does not exist, is at capacity, or already contains the value.
+func (s *SDKServer) AppendListValue(_ context.Context, lv *sdk.ListValue) (*sdk.Empty, error) {
+	s.logger.WithField("values", lv).Info("Adding AppendListValue to queue")
+
+	s.gsUpdateMutex.Lock()
+	status, err := s.pendingStatus()
+	if err != nil {
+		s.gsUpdateMutex.Unlock()
+		return nil, err
+	}
+	list, ok := status.Lists[lv.Name]
+	if !ok {
+		s.gsUpdateMutex.Unlock()
+		return nil, errors.Errorf("could not find list %s", lv.Name)
+	}
+	if list.Contains(lv.Value) {
+		s.gsUpdateMutex.Unlock()
+		return nil, errors.Errorf("could not append value %s to list %s: value already exists", lv.Value, lv.Name)
+	}
+	if int64(len(list.Values)) >= list.Capacity {
+		s.gsUpdateMutex.Unlock()
+		return nil, errors.Errorf("could not append value %s to list %s: list is at capacity %d", lv.Value, lv.Name, list.Capacity)
+	}
+	s.gsListOperations = append(s.gsListOperations, listOperation{name: lv.Name, value: lv.Value})
+	s.gsUpdateMutex.Unlock()
+
+	s.workerqueue.Enqueue(cache.ExplicitKey(string(updateLists)))
+	return &sdk.Empty{}, nil
+}
+
+// DeleteListValue removes the value from the named List on the `GameServer` status.
+// Returns an error if the List does not exist, or does not contain the value.
+func (s *SDKServer) DeleteListValue(_ context.Context, lv *sdk.ListValue) (*sdk.Empty, error) {
+	s.logger.WithField("values", lv).Info("Adding DeleteListValue to queue")
+
+	s.gsUpdateMutex.Lock()
+	status, err := s.pendingStatus()
+	if err != nil {
+		s.gsUpdateMutex.Unlock()
+		return nil, err
+	}
+	list, ok := status.Lists[lv.Name]
+	if !ok {
+		s.gsUpdateMutex.Unlock()
+		return nil, errors.Errorf("could not find list %s", lv.Name)
+	}
+	if !list.Contains(lv.Value) {
+		s.gsUpdateMutex.Unlock()
+		return nil, errors.Errorf("could not delete value %s from list %s: value does not exist", lv.Value, lv.Name)
+	}
+	s.gsListOperations = append(s.gsListOperations, listOperation{name: lv.Name, value: lv.Value, remove: true})
+	s.gsUpdateMutex.Unlock()
+
+	s.workerqueue.Enqueue(cache.ExplicitKey(string(updateLists)))
+	return &sdk.Empty{}, nil
+}
+
 // GetGameServer returns the current GameServer configuration and state from the backing GameServer CRD
 func (s *SDKServer) GetGameServer(context.Context, *sdk.Empty) (*sdk.GameServer, error) {
 	s.logger.Info("Received GetGameServer request")
@@ -466,11 +821,18 @@ func (s *SDKServer) GetGameServer(context.Context, *sdk.Empty) (*sdk.GameServer,
 }
 
 // WatchGameServer sends events through the stream when changes occur to the
-// backing GameServer configuration / status
+// backing GameServer configuration / status.
+// If the agones-watch-fields metadata is set, the stream is only sent the listed fields,
+// and only when one of them changes
 func (s *SDKServer) WatchGameServer(_ *sdk.Empty, stream sdk.SDK_WatchGameServerServer) error {
-	s.logger.Info("Received WatchGameServer request, adding stream to connectedStreams")
+	md, _ := metadata.FromIncomingContext(stream.Context())
+	fields, err := watchFields(md)
+	if err != nil {
+		return err
+	}
+	s.logger.WithField("fields", fields).Info("Received WatchGameServer request, adding stream to connectedStreams")
 	s.streamMutex.Lock()
-	s.connectedStreams = append(s.connectedStreams, stream)
+	s.connectedStreams = append(s.connectedStreams, &watchStream{stream: stream, fields: fields})
 	s.streamMutex.Unlock()
 	// don't exit until we shutdown, because that will close the stream
 	<-s.stop
@@ -526,11 +888,20 @@ func (s *SDKServer) stopReserveTimer() {
 func (s *SDKServer) sendGameServerUpdate(gs *agonesv1.GameServer) {
 	s.logger.Info("Sending GameServer Event to connectedStreams")
 
-	s.streamMutex.RLock()
-	defer s.streamMutex.RUnlock()
-
-	for _, stream := range s.connectedStreams {
-		err := stream.Send(convert(gs))
+	s.streamMutex.Lock()
+	defer s.streamMutex.Unlock()
+
+	update := convert(gs)
+	for _, ws := range s.connectedStreams {
+		msg := update
+		if ws.fields != nil {
+			msg = filterFields(update, ws.fields)
+			if ws.last != nil && proto.Equal(ws.last, msg) {
+				continue
+			}
+			ws.last = msg
+		}
+		err := ws.stream.Send(msg)
 		// We essentially ignoring any disconnected streams.
 		// I think this is fine, as disconnections shouldn't actually happen.
 		// but we should log them, just in case they do happen, and we can track it
@@ -546,27 +917,36 @@ func (s *SDKServer) sendGameServerUpdate(gs *agonesv1.GameServer) {
 // it can be updated
 func (s *SDKServer) runHealth() {
 	s.checkHealth()
+	s.checkHealthProbes()
 	if !s.healthy() {
 		s.logger.WithField("gameServerName", s.gameServerName).Info("GameServer has failed health check")
+		s.healthMutex.RLock()
+		pinged := s.healthPinged
+		pingsFailed := s.healthFailureCount >= s.health.FailureThreshold
+		s.healthMutex.RUnlock()
+		if !pinged && pingsFailed {
+			s.reportProblem(problemHealthNeverPinged, "No health ping was received from the game server, it should call Health() every %ds",
+				s.health.PeriodSeconds)
+		}
 		s.enqueueState(agonesv1.GameServerStateUnhealthy)
 	}
 }
 
 // touchHealthLastUpdated sets the healthLastUpdated
-// value to now in UTC
+// value to now
 func (s *SDKServer) touchHealthLastUpdated() {
 	s.healthMutex.Lock()
 	defer s.healthMutex.Unlock()
-	s.healthLastUpdated = s.clock.Now().UTC()
+	s.healthLastUpdated = s.clock.Now()
 	s.healthFailureCount = 0
+	s.healthPinged = true
 }
 
 // checkHealth checks the healthLastUpdated value
 // and if it is outside the timeout value, logger and
 // count a failure
 func (s *SDKServer) checkHealth() {
-	timeout := s.healthLastUpdated.Add(s.healthTimeout)
-	if timeout.Before(s.clock.Now().UTC()) {
+	if s.clock.Since(s.healthLastUpdated) > s.healthTimeout {
 		s.healthMutex.Lock()
 		defer s.healthMutex.Unlock()
 		s.healthFailureCount++
@@ -584,5 +964,10 @@ func (s *SDKServer) healthy() bool {
 
 	s.healthMutex.RLock()
 	defer s.healthMutex.RUnlock()
+	for _, failures := range s.healthProbeFails {
+		if failures >= s.health.FailureThreshold {
+			return false
+		}
+	}
 	return s.healthFailureCount < s.health.FailureThreshold
 }
@@ -23,9 +23,12 @@ import (
 	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
 	"agones.dev/agones/pkg/sdk"
 	agtesting "agones.dev/agones/pkg/testing"
+	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc/metadata"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/clock"
@@ -74,7 +77,7 @@ func TestSidecarRun(t *testing.T) {
 			},
 			expected: expected{
 				state:      agonesv1.GameServerStateUnhealthy,
-				recordings: []string{"Warning " + string(agonesv1.GameServerStateUnhealthy)},
+				recordings: []string{"Warning " + problemHealthNeverPinged, "Warning " + string(agonesv1.GameServerStateUnhealthy)},
 			},
 		},
 		"label": {
@@ -109,8 +112,9 @@ func TestSidecarRun(t *testing.T) {
 				assert.NoError(t, err)
 			},
 			expected: expected{
-				state:      agonesv1.GameServerStateAllocated,
-				recordings: []string{string(agonesv1.GameServerStateAllocated)},
+				state:       agonesv1.GameServerStateAllocated,
+				annotations: map[string]string{agonesv1.GameServerAllocatedAnnotation: "true"},
+				recordings:  []string{string(agonesv1.GameServerStateAllocated)},
 			},
 		},
 		"reserved": {
@@ -152,6 +156,7 @@ func TestSidecarRun(t *testing.T) {
 
 				if v.expected.state != "" {
 					assert.Equal(t, v.expected.state, gs.Status.State)
+					assert.Equal(t, string(v.expected.state), gs.ObjectMeta.Labels[agonesv1.GameServerStateLabel])
 				}
 
 				for label, value := range v.expected.labels {
@@ -362,6 +367,86 @@ func TestSidecarUpdateState(t *testing.T) {
 			assert.False(t, updated)
 		})
 	}
+
+	t.Run("shutdown when deleted", func(t *testing.T) {
+		m := agtesting.NewMocks()
+		sc, err := defaultSidecar(m)
+		assert.Nil(t, err)
+		sc.gsState = agonesv1.GameServerStateShutdown
+
+		updated := false
+		m.AgonesClient.AddReactor("list", "gameservers", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			now := metav1.Now()
+			gs := agonesv1.GameServer{
+				ObjectMeta: metav1.ObjectMeta{Name: sc.gameServerName, Namespace: sc.namespace, DeletionTimestamp: &now},
+				Status:     agonesv1.GameServerStatus{State: agonesv1.GameServerStateAllocated},
+			}
+			return true, &agonesv1.GameServerList{Items: []agonesv1.GameServer{gs}}, nil
+		})
+		m.AgonesClient.AddReactor("update", "gameservers", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			updated = true
+			gs := action.(k8stesting.UpdateAction).GetObject().(*agonesv1.GameServer)
+			assert.Equal(t, agonesv1.GameServerStateShutdown, gs.Status.State)
+			return true, gs, nil
+		})
+
+		stop := make(chan struct{})
+		defer close(stop)
+		sc.informerFactory.Start(stop)
+		assert.True(t, cache.WaitForCacheSync(stop, sc.gameServerSynced))
+		sc.gsWaitForSync.Done()
+
+		err = sc.updateState()
+		assert.Nil(t, err)
+		assert.True(t, updated, "a deleted GameServer should be shut down")
+	})
+}
+
+func TestSDKServerRestoreState(t *testing.T) {
+	t.Parallel()
+
+	fixtures := map[string]struct {
+		state     agonesv1.GameServerState
+		pending   agonesv1.GameServerState
+		restarted bool
+		expected  agonesv1.GameServerState
+	}{
+		"scheduled": {state: agonesv1.GameServerStateScheduled},
+		"ready": {
+			state:     agonesv1.GameServerStateReady,
+			restarted: true,
+			expected:  agonesv1.GameServerStateReady,
+		},
+		"allocated": {
+			state:     agonesv1.GameServerStateAllocated,
+			restarted: true,
+			expected:  agonesv1.GameServerStateAllocated,
+		},
+		"shutdown requested since the restart": {
+			state:     agonesv1.GameServerStateAllocated,
+			pending:   agonesv1.GameServerStateShutdown,
+			restarted: true,
+			expected:  agonesv1.GameServerStateShutdown,
+		},
+	}
+
+	for k, v := range fixtures {
+		t.Run(k, func(t *testing.T) {
+			m := agtesting.NewMocks()
+			sc, err := defaultSidecar(m)
+			assert.NoError(t, err)
+			sc.gsState = v.pending
+
+			gs := &agonesv1.GameServer{
+				ObjectMeta: metav1.ObjectMeta{Name: sc.gameServerName, Namespace: sc.namespace},
+				Status:     agonesv1.GameServerStatus{State: v.state},
+			}
+
+			assert.Equal(t, v.restarted, sc.restoreState(gs))
+			assert.Equal(t, v.expected, sc.gsState)
+			assert.Equal(t, v.restarted, sc.healthPinged)
+		})
+	}
 }
 
 func TestSidecarHealthLastUpdated(t *testing.T) {
@@ -412,6 +497,22 @@ func TestSidecarHealthLastUpdated(t *testing.T) {
 	wg.Wait()
 }
 
+func TestSidecarHealthLastUpdatedMonotonic(t *testing.T) {
+	t.Parallel()
+	m := agtesting.NewMocks()
+
+	sc, err := defaultSidecar(m)
+	assert.Nil(t, err)
+	sc.clock = clock.RealClock{}
+
+	// Round(0) strips the monotonic clock reading, which health checks rely on
+	// to not be affected by steps of the wall clock
+	sc.initHealthLastUpdated(0)
+	assert.NotEqual(t, sc.healthLastUpdated.Round(0), sc.healthLastUpdated)
+	sc.touchHealthLastUpdated()
+	assert.NotEqual(t, sc.healthLastUpdated.Round(0), sc.healthLastUpdated)
+}
+
 func TestSidecarHealthy(t *testing.T) {
 	t.Parallel()
 
@@ -597,6 +698,89 @@ func TestSDKServerGetGameServer(t *testing.T) {
 	assert.Equal(t, string(fixture.Status.State), result.Status.State)
 }
 
+func TestSDKServerCountersAndLists(t *testing.T) {
+	t.Parallel()
+
+	fixture := &agonesv1.GameServer{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "default",
+		},
+		Status: agonesv1.GameServerStatus{
+			State:    agonesv1.GameServerStateReady,
+			Counters: map[string]agonesv1.CounterStatus{"rooms": {Count: 1, Capacity: 3}},
+			Lists:    map[string]agonesv1.ListStatus{"players": {Capacity: 2, Values: []string{"alice"}}},
+		},
+	}
+
+	m := agtesting.NewMocks()
+	m.AgonesClient.AddReactor("list", "gameservers", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, &agonesv1.GameServerList{Items: []agonesv1.GameServer{*fixture}}, nil
+	})
+
+	updateCount := 0
+	var failUpdate error
+	m.AgonesClient.AddReactor("update", "gameservers", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if failUpdate != nil {
+			return true, nil, failUpdate
+		}
+		updateCount++
+		gs := action.(k8stesting.UpdateAction).GetObject().(*agonesv1.GameServer)
+		assert.Equal(t, agonesv1.CounterStatus{Count: 2, Capacity: 2}, gs.Status.Counters["rooms"])
+		assert.Equal(t, []string{"bob"}, gs.Status.Lists["players"].Values)
+		return true, gs, nil
+	})
+
+	stop := make(chan struct{})
+	defer close(stop)
+
+	sc, err := defaultSidecar(m)
+	assert.Nil(t, err)
+
+	sc.informerFactory.Start(stop)
+	assert.True(t, cache.WaitForCacheSync(stop, sc.gameServerSynced))
+	sc.gsWaitForSync.Done()
+
+	ctx := context.Background()
+
+	_, err = sc.IncrementCounter(ctx, &sdk.CounterUpdate{Name: "rooms", Amount: 2})
+	assert.Nil(t, err)
+	// pending increments count towards the capacity
+	_, err = sc.IncrementCounter(ctx, &sdk.CounterUpdate{Name: "rooms", Amount: 1})
+	assert.NotNil(t, err)
+	_, err = sc.IncrementCounter(ctx, &sdk.CounterUpdate{Name: "missing", Amount: 1})
+	assert.NotNil(t, err)
+	_, err = sc.SetCounterCapacity(ctx, &sdk.CounterUpdate{Name: "rooms", Amount: -1})
+	assert.NotNil(t, err)
+	_, err = sc.SetCounterCapacity(ctx, &sdk.CounterUpdate{Name: "rooms", Amount: 2})
+	assert.Nil(t, err)
+
+	_, err = sc.AppendListValue(ctx, &sdk.ListValue{Name: "players", Value: "bob"})
+	assert.Nil(t, err)
+	_, err = sc.AppendListValue(ctx, &sdk.ListValue{Name: "players", Value: "carol"})
+	assert.NotNil(t, err)
+	_, err = sc.DeleteListValue(ctx, &sdk.ListValue{Name: "players", Value: "alice"})
+	assert.Nil(t, err)
+	_, err = sc.DeleteListValue(ctx, &sdk.ListValue{Name: "players", Value: "alice"})
+	assert.NotNil(t, err)
+
+	// a failed update keeps the changes, so they can be retried
+	failUpdate = errors.New("conflict")
+	err = sc.syncGameServer(string(updateCounters))
+	assert.NotNil(t, err)
+	assert.Equal(t, 0, updateCount)
+
+	failUpdate = nil
+	err = sc.syncGameServer(string(updateCounters))
+	assert.Nil(t, err)
+	assert.Equal(t, 1, updateCount)
+
+	// all changes were sent in the single update
+	err = sc.syncGameServer(string(updateLists))
+	assert.Nil(t, err)
+	assert.Equal(t, 1, updateCount)
+}
+
 func TestSDKServerWatchGameServer(t *testing.T) {
 	t.Parallel()
 	m := agtesting.NewMocks()
@@ -607,13 +791,14 @@ func TestSDKServerWatchGameServer(t *testing.T) {
 	stream := newGameServerMockStream()
 	asyncWatchGameServer(t, sc, stream)
 	assert.Nil(t, waitConnectedStreamCount(sc, 1))
-	assert.Equal(t, stream, sc.connectedStreams[0])
+	assert.Equal(t, stream, sc.connectedStreams[0].stream)
+	assert.Nil(t, sc.connectedStreams[0].fields)
 
 	stream = newGameServerMockStream()
 	asyncWatchGameServer(t, sc, stream)
 	assert.Nil(t, waitConnectedStreamCount(sc, 2))
 	assert.Len(t, sc.connectedStreams, 2)
-	assert.Equal(t, stream, sc.connectedStreams[1])
+	assert.Equal(t, stream, sc.connectedStreams[1].stream)
 }
 
 func TestSDKServerSendGameServerUpdate(t *testing.T) {
@@ -645,6 +830,74 @@ func TestSDKServerSendGameServerUpdate(t *testing.T) {
 	assert.Equal(t, fixture.ObjectMeta.Name, sdkGS.ObjectMeta.Name)
 }
 
+func TestSDKServerSendGameServerUpdateWatchFields(t *testing.T) {
+	t.Parallel()
+	m := agtesting.NewMocks()
+	sc, err := defaultSidecar(m)
+	assert.Nil(t, err)
+
+	stop := make(chan struct{})
+	defer close(stop)
+	sc.stop = stop
+
+	// unknown fields are rejected
+	stream := newGameServerMockStream()
+	stream.ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(watchFieldsMetadataKey, "state,players"))
+	err = sc.WatchGameServer(&sdk.Empty{}, stream)
+	assert.EqualError(t, err, `unknown WatchGameServer field "players"`)
+	assert.Empty(t, sc.connectedStreams)
+
+	stream = newGameServerMockStream()
+	stream.ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(watchFieldsMetadataKey, "State, labels"))
+	asyncWatchGameServer(t, sc, stream)
+	assert.Nil(t, waitConnectedStreamCount(sc, 1))
+	assert.Equal(t, map[string]bool{watchFieldState: true, watchFieldLabels: true}, sc.connectedStreams[0].fields)
+
+	fixture := &agonesv1.GameServer{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-server", Namespace: "default", UID: "1234",
+			Labels: map[string]string{"foo": "bar"}, Annotations: map[string]string{"bar": "foo"}},
+		Status: agonesv1.GameServerStatus{State: agonesv1.GameServerStateReady, Address: "127.0.0.1",
+			Ports: []agonesv1.GameServerStatusPort{{Name: "default", Port: 7777}}},
+	}
+
+	sc.sendGameServerUpdate(fixture)
+	var sdkGS *sdk.GameServer
+	select {
+	case sdkGS = <-stream.msgs:
+	case <-time.After(3 * time.Second):
+		assert.FailNow(t, "Event stream should not have timed out")
+	}
+	assert.Equal(t, "test-server", sdkGS.ObjectMeta.Name)
+	assert.Equal(t, "default", sdkGS.ObjectMeta.Namespace)
+	assert.Equal(t, "1234", sdkGS.ObjectMeta.Uid)
+	assert.Equal(t, map[string]string{"foo": "bar"}, sdkGS.ObjectMeta.Labels)
+	assert.Empty(t, sdkGS.ObjectMeta.Annotations)
+	assert.Nil(t, sdkGS.Spec)
+	assert.Equal(t, string(agonesv1.GameServerStateReady), sdkGS.Status.State)
+	assert.Empty(t, sdkGS.Status.Address)
+	assert.Empty(t, sdkGS.Status.Ports)
+
+	// changes to fields that were not requested are not sent
+	fixture.ObjectMeta.Annotations["bar"] = "baz"
+	fixture.Status.Address = "127.0.0.2"
+	sc.sendGameServerUpdate(fixture)
+	select {
+	case sdkGS = <-stream.msgs:
+		assert.FailNow(t, "Unexpected event", sdkGS)
+	default:
+	}
+
+	fixture.Status.State = agonesv1.GameServerStateAllocated
+	sc.sendGameServerUpdate(fixture)
+	select {
+	case sdkGS = <-stream.msgs:
+	case <-time.After(3 * time.Second):
+		assert.FailNow(t, "Event stream should not have timed out")
+	}
+	assert.Equal(t, string(agonesv1.GameServerStateAllocated), sdkGS.Status.State)
+	assert.Equal(t, map[string]string{"foo": "bar"}, sdkGS.ObjectMeta.Labels)
+}
+
 func TestSDKServerUpdateEventHandler(t *testing.T) {
 	t.Parallel()
 	m := agtesting.NewMocks()
@@ -860,6 +1113,89 @@ func TestSDKServerReserveTimeout(t *testing.T) {
 	wg.Wait()
 }
 
+func TestSDKServerReportProblems(t *testing.T) {
+	t.Parallel()
+
+	setup := func(t *testing.T, state agonesv1.GameServerState) (*SDKServer, agtesting.Mocks, func()) {
+		fixture := agonesv1.GameServer{
+			ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+			Status:     agonesv1.GameServerStatus{State: state},
+		}
+		m := agtesting.NewMocks()
+		m.AgonesClient.AddReactor("list", "gameservers", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			return true, &agonesv1.GameServerList{Items: []agonesv1.GameServer{fixture}}, nil
+		})
+
+		stop := make(chan struct{})
+		sc, err := defaultSidecar(m)
+		assert.NoError(t, err)
+		sc.informerFactory.Start(stop)
+		assert.True(t, cache.WaitForCacheSync(stop, sc.gameServerSynced))
+		sc.gsWaitForSync.Done()
+
+		return sc, m, func() { close(stop) }
+	}
+
+	t.Run("ready not called", func(t *testing.T) {
+		sc, m, cancel := setup(t, agonesv1.GameServerStateScheduled)
+		defer cancel()
+
+		sc.checkReadyCalled()
+		agtesting.AssertEventContains(t, m.FakeRecorder.Events, problemReadyNotCalled)
+
+		// only reported once
+		sc.checkReadyCalled()
+		agtesting.AssertNoEvent(t, m.FakeRecorder.Events)
+	})
+
+	t.Run("ready called", func(t *testing.T) {
+		sc, m, cancel := setup(t, agonesv1.GameServerStateReady)
+		defer cancel()
+
+		sc.checkReadyCalled()
+		agtesting.AssertNoEvent(t, m.FakeRecorder.Events)
+	})
+
+	t.Run("ready pending", func(t *testing.T) {
+		sc, m, cancel := setup(t, agonesv1.GameServerStateScheduled)
+		defer cancel()
+
+		sc.gsState = agonesv1.GameServerStateRequestReady
+		sc.checkReadyCalled()
+		agtesting.AssertNoEvent(t, m.FakeRecorder.Events)
+	})
+
+	t.Run("health never pinged", func(t *testing.T) {
+		sc, m, cancel := setup(t, agonesv1.GameServerStateReady)
+		defer cancel()
+
+		sc.health = agonesv1.Health{FailureThreshold: 1, PeriodSeconds: 5}
+		sc.healthTimeout = 5 * time.Second
+		fc := clock.NewFakeClock(time.Now())
+		sc.clock = fc
+		sc.initHealthLastUpdated(0)
+
+		fc.Step(10 * time.Second)
+		sc.runHealth()
+		agtesting.AssertEventContains(t, m.FakeRecorder.Events, problemHealthNeverPinged)
+	})
+
+	t.Run("health pinged", func(t *testing.T) {
+		sc, m, cancel := setup(t, agonesv1.GameServerStateReady)
+		defer cancel()
+
+		sc.health = agonesv1.Health{FailureThreshold: 1, PeriodSeconds: 5}
+		sc.healthTimeout = 5 * time.Second
+		fc := clock.NewFakeClock(time.Now())
+		sc.clock = fc
+		sc.touchHealthLastUpdated()
+
+		fc.Step(10 * time.Second)
+		sc.runHealth()
+		agtesting.AssertNoEvent(t, m.FakeRecorder.Events)
+	})
+}
+
 func defaultSidecar(m agtesting.Mocks) (*SDKServer, error) {
 	server, err := NewSDKServer("test", "default", m.KubeClient, m.AgonesClient)
 	if err != nil {
@@ -892,3 +1228,74 @@ func asyncWatchGameServer(t *testing.T, sc *SDKServer, stream sdk.SDK_WatchGameS
 		assert.Nil(t, err)
 	}()
 }
+
+func TestSDKServerGameServerStopped(t *testing.T) {
+	t.Parallel()
+
+	setup := func(t *testing.T) (*SDKServer, func()) {
+		fixture := agonesv1.GameServer{
+			ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+			Spec:       agonesv1.GameServerSpec{Container: "game-server"},
+		}
+		m := agtesting.NewMocks()
+		m.AgonesClient.AddReactor("list", "gameservers", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			return true, &agonesv1.GameServerList{Items: []agonesv1.GameServer{fixture}}, nil
+		})
+
+		stop := make(chan struct{})
+		sc, err := defaultSidecar(m)
+		assert.NoError(t, err)
+		sc.informerFactory.Start(stop)
+		assert.True(t, cache.WaitForCacheSync(stop, sc.gameServerSynced))
+		sc.gsWaitForSync.Done()
+
+		return sc, func() { close(stop) }
+	}
+	stopped := func(sc *SDKServer) bool {
+		select {
+		case <-sc.GameServerStopped():
+			return true
+		default:
+			return false
+		}
+	}
+
+	t.Run("game server container exited", func(t *testing.T) {
+		sc, cancel := setup(t)
+		defer cancel()
+
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+			Status: corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{
+				{Name: "game-server", State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}},
+				{Name: "agones-gameserver-sidecar", State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}},
+			}},
+		}
+		sc.checkGameServerContainer(pod)
+		assert.False(t, stopped(sc))
+
+		// the pre-delete hook is running
+		now := metav1.Now()
+		pod.ObjectMeta.DeletionTimestamp = &now
+		sc.checkGameServerContainer(pod)
+		assert.False(t, stopped(sc))
+
+		pod.Status.ContainerStatuses[0].State = corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{}}
+		sc.checkGameServerContainer(pod)
+		assert.True(t, stopped(sc))
+
+		// only closed once
+		sc.checkGameServerContainer(pod)
+		assert.True(t, stopped(sc))
+	})
+
+	t.Run("shutdown", func(t *testing.T) {
+		sc, cancel := setup(t)
+		defer cancel()
+
+		assert.False(t, stopped(sc))
+		_, err := sc.Shutdown(context.Background(), &sdk.Empty{})
+		assert.NoError(t, err)
+		assert.True(t, stopped(sc))
+	})
+}
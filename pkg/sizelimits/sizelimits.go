@@ -0,0 +1,188 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sizelimits rejects GameServers, GameServerSets and Fleets with pathologically large annotations
+// or templates, as the template of a Fleet is copied into each of its GameServers, and every GameServer
+// is held in memory by the informers of the controllers and of the SDK servers
+package sizelimits
+
+import (
+	"encoding/json"
+	"fmt"
+
+	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
+	"agones.dev/agones/pkg/util/runtime"
+	"agones.dev/agones/pkg/util/webhooks"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	admv1beta1 "k8s.io/api/admission/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// object is the part of a GameServer, GameServerSet or Fleet whose size is limited
+type object struct {
+	Metadata struct {
+		Annotations     map[string]string       `json:"annotations"`
+		OwnerReferences []metav1.OwnerReference `json:"ownerReferences"`
+	} `json:"metadata"`
+	Spec struct {
+		Template json.RawMessage `json:"template"`
+	} `json:"spec"`
+}
+
+// templateMeta is the metadata of the template of a GameServer, GameServerSet or Fleet
+type templateMeta struct {
+	Metadata struct {
+		Annotations map[string]string `json:"annotations"`
+	} `json:"metadata"`
+}
+
+// Validator validates the size of the annotations and templates of GameServers, GameServerSets and Fleets
+type Validator struct {
+	logger *logrus.Entry
+	// maxAnnotationBytes is the maximum total size of the keys and values of the annotations
+	// of an object, and of its template. 0 is unlimited.
+	maxAnnotationBytes int
+	// maxTemplateBytes is the maximum size of the JSON of the template of an object. 0 is unlimited.
+	maxTemplateBytes int
+}
+
+// NewValidator returns a Validator, and adds its handlers to the validation webhook.
+// GameServers are validated on creation, as their annotations are then set by the game server through the SDK,
+// and GameServerSets and Fleets on creation and update. The GameServers of a GameServerSet are not validated,
+// as they are copies of its template, which is validated with the GameServerSet, so that a GameServerSet
+// created before the limits were set can still be scaled up. Updates are only rejected if they grow an object that
+// is over the limits, so the controllers can still update the objects that were created before the limits were set.
+func NewValidator(wh *webhooks.WebHook, maxAnnotationBytes, maxTemplateBytes int) *Validator {
+	v := &Validator{maxAnnotationBytes: maxAnnotationBytes, maxTemplateBytes: maxTemplateBytes}
+	v.logger = runtime.NewLoggerWithType(v)
+
+	if maxAnnotationBytes == 0 && maxTemplateBytes == 0 {
+		return v
+	}
+	wh.AddHandler("/validate", agonesv1.Kind("GameServer"), admv1beta1.Create, v.validationHandler)
+	for _, kind := range []string{"GameServerSet", "Fleet"} {
+		wh.AddHandler("/validate", agonesv1.Kind(kind), admv1beta1.Create, v.validationHandler)
+		wh.AddHandler("/validate", agonesv1.Kind(kind), admv1beta1.Update, v.validationHandler)
+	}
+	return v
+}
+
+// validationHandler denies the objects whose annotations or template are over the limits
+func (v *Validator) validationHandler(review admv1beta1.AdmissionReview) (admv1beta1.AdmissionReview, error) {
+	if !review.Response.Allowed {
+		// already denied by another handler
+		return review, nil
+	}
+
+	obj := object{}
+	if err := json.Unmarshal(review.Request.Object.Raw, &obj); err != nil {
+		return review, errors.Wrapf(err, "error unmarshalling %s json", review.Request.Kind.Kind)
+	}
+	if review.Request.Kind.Kind == "GameServer" && ownedByGameServerSet(obj) {
+		return review, nil
+	}
+
+	newSizes, err := sizes(review.Request.Object.Raw)
+	if err != nil {
+		return review, errors.Wrapf(err, "error unmarshalling %s json", review.Request.Kind.Kind)
+	}
+	var oldSizes map[string]int
+	if review.Request.Operation == admv1beta1.Update {
+		if oldSizes, err = sizes(review.Request.OldObject.Raw); err != nil {
+			return review, errors.Wrapf(err, "error unmarshalling old %s json", review.Request.Kind.Kind)
+		}
+	}
+
+	causes := v.validate(newSizes, oldSizes)
+	if len(causes) > 0 {
+		review.Response.Allowed = false
+		review.Response.Result = &metav1.Status{
+			Status:  metav1.StatusFailure,
+			Message: fmt.Sprintf("%s is too large", review.Request.Kind.Kind),
+			Reason:  metav1.StatusReasonInvalid,
+			Details: &metav1.StatusDetails{
+				Name:   review.Request.Name,
+				Group:  review.Request.Kind.Group,
+				Kind:   review.Request.Kind.Kind,
+				Causes: causes,
+			},
+		}
+		v.logger.WithField("kind", review.Request.Kind.Kind).WithField("name", review.Request.Name).
+			WithField("causes", causes).Info("Object is too large")
+	}
+	return review, nil
+}
+
+// validate returns the causes for the sizes that are over their limit, and grew from the old sizes, if there are any
+func (v *Validator) validate(sizes, oldSizes map[string]int) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	for _, field := range []string{"metadata.annotations", "spec.template.metadata.annotations", "spec.template"} {
+		limit := v.maxAnnotationBytes
+		if field == "spec.template" {
+			limit = v.maxTemplateBytes
+		}
+		size := sizes[field]
+		if limit == 0 || size <= limit {
+			continue
+		}
+		if old, ok := oldSizes[field]; ok && size <= old {
+			continue
+		}
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Field:   field,
+			Message: fmt.Sprintf("size of %d bytes is over the limit of %d bytes", size, limit),
+		})
+	}
+	return causes
+}
+
+// sizes returns the sizes of the annotations and of the template of the JSON of an object, by field
+func sizes(raw []byte) (map[string]int, error) {
+	obj := object{}
+	if err := json.Unmarshal(raw, &obj); err != nil {
+		return nil, err
+	}
+	template := templateMeta{}
+	if len(obj.Spec.Template) > 0 {
+		if err := json.Unmarshal(obj.Spec.Template, &template); err != nil {
+			return nil, err
+		}
+	}
+	return map[string]int{
+		"metadata.annotations":               annotationBytes(obj.Metadata.Annotations),
+		"spec.template.metadata.annotations": annotationBytes(template.Metadata.Annotations),
+		"spec.template":                      len(obj.Spec.Template),
+	}, nil
+}
+
+// ownedByGameServerSet returns true if the object is controlled by a GameServerSet
+func ownedByGameServerSet(obj object) bool {
+	for _, ref := range obj.Metadata.OwnerReferences {
+		if ref.Controller != nil && *ref.Controller && ref.Kind == "GameServerSet" {
+			return true
+		}
+	}
+	return false
+}
+
+// annotationBytes returns the total size of the keys and values of the annotations
+func annotationBytes(annotations map[string]string) int {
+	size := 0
+	for k, v := range annotations {
+		size += len(k) + len(v)
+	}
+	return size
+}
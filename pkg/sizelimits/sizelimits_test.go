@@ -0,0 +1,154 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sizelimits
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
+	"agones.dev/agones/pkg/util/webhooks"
+	"github.com/stretchr/testify/assert"
+	admv1beta1 "k8s.io/api/admission/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestValidatorValidationHandler(t *testing.T) {
+	t.Parallel()
+
+	v := NewValidator(webhooks.NewWebHook(http.NewServeMux()), 100, 1000)
+	gvk := metav1.GroupVersionKind(agonesv1.SchemeGroupVersion.WithKind("Fleet"))
+
+	// fleet returns a Fleet with annotations of the given size, and a template with an env value of the given size
+	fleet := func(annotationBytes, envBytes int) *agonesv1.Fleet {
+		return &agonesv1.Fleet{
+			ObjectMeta: metav1.ObjectMeta{Name: "fleet", Namespace: "default",
+				Annotations: map[string]string{"a": strings.Repeat("x", annotationBytes-1)}},
+			Spec: agonesv1.FleetSpec{Template: agonesv1.GameServerTemplateSpec{
+				Spec: agonesv1.GameServerSpec{Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "game", Image: "game",
+						Env: []corev1.EnvVar{{Name: "BLOB", Value: strings.Repeat("x", envBytes)}}}}}}}}},
+		}
+	}
+
+	review := func(t *testing.T, op admv1beta1.Operation, old, f *agonesv1.Fleet) admv1beta1.AdmissionReview {
+		raw, err := json.Marshal(f)
+		assert.NoError(t, err)
+		r := admv1beta1.AdmissionReview{
+			Request: &admv1beta1.AdmissionRequest{
+				Kind:      gvk,
+				Operation: op,
+				Object:    runtime.RawExtension{Raw: raw},
+			},
+			Response: &admv1beta1.AdmissionResponse{Allowed: true},
+		}
+		if old != nil {
+			raw, err = json.Marshal(old)
+			assert.NoError(t, err)
+			r.Request.OldObject = runtime.RawExtension{Raw: raw}
+		}
+		result, err := v.validationHandler(r)
+		assert.NoError(t, err)
+		return result
+	}
+
+	fields := func(r admv1beta1.AdmissionReview) []string {
+		var result []string
+		if r.Response.Result != nil && r.Response.Result.Details != nil {
+			for _, c := range r.Response.Result.Details.Causes {
+				result = append(result, c.Field)
+			}
+		}
+		return result
+	}
+
+	t.Run("within the limits", func(t *testing.T) {
+		r := review(t, admv1beta1.Create, nil, fleet(10, 10))
+		assert.True(t, r.Response.Allowed)
+	})
+
+	t.Run("over the limits", func(t *testing.T) {
+		f := fleet(200, 2000)
+		f.Spec.Template.ObjectMeta.Annotations = map[string]string{"b": strings.Repeat("x", 200)}
+		r := review(t, admv1beta1.Create, nil, f)
+		assert.False(t, r.Response.Allowed)
+		assert.Equal(t, []string{"metadata.annotations", "spec.template.metadata.annotations", "spec.template"}, fields(r))
+		assert.Equal(t, "size of 200 bytes is over the limit of 100 bytes", r.Response.Result.Details.Causes[0].Message)
+	})
+
+	t.Run("update that doesn't grow", func(t *testing.T) {
+		old := fleet(10, 2000)
+		f := old.DeepCopy()
+		f.Spec.Replicas = 5
+		r := review(t, admv1beta1.Update, old, f)
+		assert.True(t, r.Response.Allowed)
+
+		f = fleet(10, 2001)
+		r = review(t, admv1beta1.Update, old, f)
+		assert.False(t, r.Response.Allowed)
+		assert.Equal(t, []string{"spec.template"}, fields(r))
+	})
+
+	t.Run("gameserver of a gameserverset", func(t *testing.T) {
+		f := fleet(10, 2000)
+		gsSet := f.GameServerSet()
+		gsSet.ObjectMeta.Name = "gsSet"
+		gs := gsSet.GameServer()
+		gs.ObjectMeta.Name = "gs"
+		raw, err := json.Marshal(gs)
+		assert.NoError(t, err)
+		r := admv1beta1.AdmissionReview{
+			Request: &admv1beta1.AdmissionRequest{Kind: metav1.GroupVersionKind(agonesv1.SchemeGroupVersion.WithKind("GameServer")),
+				Operation: admv1beta1.Create, Object: runtime.RawExtension{Raw: raw}},
+			Response: &admv1beta1.AdmissionResponse{Allowed: true},
+		}
+		result, err := v.validationHandler(r)
+		assert.NoError(t, err)
+		assert.True(t, result.Response.Allowed)
+
+		gs.ObjectMeta.OwnerReferences = nil
+		raw, err = json.Marshal(gs)
+		assert.NoError(t, err)
+		r.Request.Object = runtime.RawExtension{Raw: raw}
+		result, err = v.validationHandler(r)
+		assert.NoError(t, err)
+		assert.False(t, result.Response.Allowed)
+	})
+
+	t.Run("already denied", func(t *testing.T) {
+		raw, err := json.Marshal(fleet(200, 10))
+		assert.NoError(t, err)
+		r := admv1beta1.AdmissionReview{
+			Request:  &admv1beta1.AdmissionRequest{Kind: gvk, Operation: admv1beta1.Create, Object: runtime.RawExtension{Raw: raw}},
+			Response: &admv1beta1.AdmissionResponse{Allowed: false, Result: &metav1.Status{Message: "invalid"}},
+		}
+		r, err = v.validationHandler(r)
+		assert.NoError(t, err)
+		assert.Equal(t, "invalid", r.Response.Result.Message)
+	})
+
+	t.Run("unlimited", func(t *testing.T) {
+		unlimited := &Validator{}
+		s, err := json.Marshal(fleet(200, 2000))
+		assert.NoError(t, err)
+		newSizes, err := sizes(s)
+		assert.NoError(t, err)
+		assert.Empty(t, unlimited.validate(newSizes, nil))
+	})
+}
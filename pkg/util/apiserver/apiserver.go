@@ -18,6 +18,7 @@ package apiserver
 import (
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"strings"
 
@@ -203,6 +204,26 @@ func AcceptedSerializer(r *http.Request, codecs serializer.CodecFactory) (k8srun
 	return info, nil
 }
 
+// DecodeRequest decodes the body of the request into obj, with the deserializer of the codec factory
+// for the Content-Type of the request, and gvk as the default kind if the body doesn't specify one
+func DecodeRequest(r *http.Request, codecs serializer.CodecFactory, gvk schema.GroupVersionKind, obj k8sruntime.Object, logger *logrus.Entry) error {
+	info, ok := k8sruntime.SerializerInfoForMediaType(codecs.SupportedMediaTypes(), r.Header.Get(ContentTypeHeader))
+	if !ok {
+		return errors.New("Could not find deserializer")
+	}
+
+	b, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return errors.Wrap(err, "could not read body")
+	}
+
+	if _, _, err := info.Serializer.Decode(b, &gvk, obj); err != nil {
+		logger.WithField("body", string(b)).Error("error decoding body")
+		return errors.Wrap(err, "error decoding body")
+	}
+	return nil
+}
+
 // splitNameSpaceResource returns the namespace and the type of resource
 func splitNameSpaceResource(path string) (namespace, resource string, err error) {
 	list := strings.Split(strings.Trim(path, "/"), "/")
@@ -19,8 +19,10 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
+	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8sruntime "k8s.io/apimachinery/pkg/runtime"
@@ -165,6 +167,29 @@ func TestAPIServerAddAPIResourceDiscovery(t *testing.T) {
 	})
 }
 
+func TestDecodeRequest(t *testing.T) {
+	t.Parallel()
+
+	gvk := unversionedVersion.WithKind("Status")
+	logger := logrus.NewEntry(logrus.New())
+	request := func(contentType, body string) *http.Request {
+		r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		r.Header.Set(ContentTypeHeader, contentType)
+		return r
+	}
+
+	status := &metav1.Status{}
+	err := DecodeRequest(request(k8sruntime.ContentTypeJSON, `{"message": "hello"}`), Codecs, gvk, status, logger)
+	assert.NoError(t, err)
+	assert.Equal(t, "hello", status.Message)
+
+	err = DecodeRequest(request("text/plain", `{"message": "hello"}`), Codecs, gvk, &metav1.Status{}, logger)
+	assert.EqualError(t, err, "Could not find deserializer")
+
+	err = DecodeRequest(request(k8sruntime.ContentTypeJSON, `{"message": `), Codecs, gvk, &metav1.Status{}, logger)
+	assert.Error(t, err)
+}
+
 func TestSplitNameSpaceResource(t *testing.T) {
 	type expected struct {
 		namespace string
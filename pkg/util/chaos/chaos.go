@@ -0,0 +1,132 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package chaos injects artificial failures into the requests sent to the
+// Kubernetes API server, to validate the resilience of Fleets and alerting
+// when soak testing. It should never be used in production.
+package chaos
+
+import (
+	"bytes"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"agones.dev/agones/pkg/util/runtime"
+	"github.com/pkg/errors"
+)
+
+// injectedFailure is the body of the response to a request that is failed on purpose
+const injectedFailure = `{"kind":"Status","apiVersion":"v1","metadata":{},"status":"Failure",` +
+	`"message":"chaos: injected failure","reason":"InternalError","code":500}`
+
+var logger = runtime.NewLoggerWithSource("chaos")
+
+// Config is the configuration of the failures to inject
+type Config struct {
+	// PodCreationDelay delays each request to create a Pod
+	PodCreationDelay time.Duration
+	// UpdateFailurePercentage is the percentage of update and patch requests that fail with
+	// an internal server error, without being sent to the API server
+	UpdateFailurePercentage float64
+}
+
+// Enabled returns true if any failure is injected
+func (c Config) Enabled() bool {
+	return c.PodCreationDelay > 0 || c.UpdateFailurePercentage > 0
+}
+
+// Validate returns an error if the configuration is invalid, or if failures are injected
+// while the Chaos feature gate is switched off
+func (c Config) Validate() error {
+	if c.PodCreationDelay < 0 {
+		return errors.New("chaos pod creation delay cannot be negative")
+	}
+	if c.UpdateFailurePercentage < 0 || c.UpdateFailurePercentage > 100 {
+		return errors.Errorf("chaos update failure percentage must be between 0 and 100, was %v", c.UpdateFailurePercentage)
+	}
+	if c.Enabled() && !runtime.FeatureEnabled(runtime.FeatureChaos) {
+		return errors.Errorf("injecting failures requires the %s feature gate", runtime.FeatureChaos)
+	}
+	return nil
+}
+
+// WrapTransport wraps the transport of a Kubernetes client, to inject failures into its requests.
+// It can be set as the WrapTransport of a rest.Config.
+func (c Config) WrapTransport(rt http.RoundTripper) http.RoundTripper {
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	var lock sync.Mutex
+	return &roundTripper{
+		config: c,
+		next:   rt,
+		random: func() float64 {
+			lock.Lock()
+			defer lock.Unlock()
+			return r.Float64()
+		},
+	}
+}
+
+// roundTripper injects failures into the requests sent through it
+type roundTripper struct {
+	config Config
+	next   http.RoundTripper
+	// random returns a pseudo-random number in [0.0,1.0)
+	random func() float64
+}
+
+// RoundTrip implements http.RoundTripper
+func (rt *roundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	// events are left alone, so that the effects of the failures can be observed
+	if strings.HasSuffix(req.URL.Path, "/events") || strings.Contains(req.URL.Path, "/events/") {
+		return rt.next.RoundTrip(req)
+	}
+
+	switch req.Method {
+	case http.MethodPost:
+		if rt.config.PodCreationDelay > 0 && strings.HasSuffix(req.URL.Path, "/pods") {
+			logger.WithField("path", req.URL.Path).WithField("delay", rt.config.PodCreationDelay).Info("Delaying pod creation")
+			select {
+			case <-time.After(rt.config.PodCreationDelay):
+			case <-req.Context().Done():
+				if req.Body != nil {
+					req.Body.Close() // nolint: errcheck
+				}
+				return nil, req.Context().Err()
+			}
+		}
+	case http.MethodPut, http.MethodPatch:
+		if rt.random()*100 < rt.config.UpdateFailurePercentage {
+			logger.WithField("path", req.URL.Path).WithField("method", req.Method).Info("Injecting update failure")
+			if req.Body != nil {
+				req.Body.Close() // nolint: errcheck
+			}
+			return &http.Response{
+				Status:     "500 Internal Server Error",
+				StatusCode: http.StatusInternalServerError,
+				Proto:      req.Proto,
+				ProtoMajor: req.ProtoMajor,
+				ProtoMinor: req.ProtoMinor,
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+				Body:       ioutil.NopCloser(bytes.NewBufferString(injectedFailure)),
+				Request:    req,
+			}, nil
+		}
+	}
+
+	return rt.next.RoundTrip(req)
+}
@@ -0,0 +1,102 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaos
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"agones.dev/agones/pkg/util/runtime"
+	"github.com/stretchr/testify/assert"
+)
+
+type roundTripFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestConfigValidate(t *testing.T) {
+	assert.NoError(t, runtime.ParseFeatures(""))
+	defer func() {
+		assert.NoError(t, runtime.ParseFeatures(""))
+	}()
+
+	assert.NoError(t, Config{}.Validate())
+	assert.Error(t, Config{PodCreationDelay: time.Second}.Validate())
+	assert.Error(t, Config{UpdateFailurePercentage: 10}.Validate())
+
+	assert.NoError(t, runtime.ParseFeatures("Chaos=true"))
+	assert.NoError(t, Config{PodCreationDelay: time.Second, UpdateFailurePercentage: 10}.Validate())
+	assert.Error(t, Config{PodCreationDelay: -time.Second}.Validate())
+	assert.Error(t, Config{UpdateFailurePercentage: 101}.Validate())
+}
+
+func TestRoundTripper(t *testing.T) {
+	t.Parallel()
+
+	sent := 0
+	next := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		sent++
+		return &http.Response{StatusCode: http.StatusOK, Request: req}, nil
+	})
+
+	t.Run("update failures", func(t *testing.T) {
+		sent = 0
+		random := 0.0
+		rt := &roundTripper{config: Config{UpdateFailurePercentage: 50}, next: next, random: func() float64 { return random }}
+
+		resp, err := rt.RoundTrip(httptest.NewRequest(http.MethodPut, "/apis/agones.dev/v1/namespaces/default/gameservers/gs1", nil))
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+		assert.Equal(t, 0, sent)
+
+		// events are never failed
+		resp, err = rt.RoundTrip(httptest.NewRequest(http.MethodPatch, "/api/v1/namespaces/default/events/gs1.123", nil))
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, 1, sent)
+
+		// reads are never failed
+		resp, err = rt.RoundTrip(httptest.NewRequest(http.MethodGet, "/apis/agones.dev/v1/namespaces/default/gameservers/gs1", nil))
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, 2, sent)
+
+		random = 0.5
+		resp, err = rt.RoundTrip(httptest.NewRequest(http.MethodPut, "/apis/agones.dev/v1/namespaces/default/gameservers/gs1", nil))
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, 3, sent)
+	})
+
+	t.Run("pod creation delay", func(t *testing.T) {
+		rt := &roundTripper{config: Config{PodCreationDelay: 100 * time.Millisecond}, next: next, random: func() float64 { return 0 }}
+
+		start := time.Now()
+		resp, err := rt.RoundTrip(httptest.NewRequest(http.MethodPost, "/api/v1/namespaces/default/pods", nil))
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.True(t, time.Since(start) >= 100*time.Millisecond)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err = rt.RoundTrip(httptest.NewRequest(http.MethodPost, "/api/v1/namespaces/default/pods", nil).WithContext(ctx))
+		assert.Equal(t, context.Canceled, err)
+	})
+}
@@ -0,0 +1,156 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cron parses the standard five field cron expressions
+// (minute, hour, day of month, month and day of week), and matches them against times.
+package cron
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// field is the range of values of a field of a cron expression
+type field struct {
+	name     string
+	min, max int
+}
+
+var fields = []field{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12},
+	// 7 is also Sunday
+	{name: "day of week", min: 0, max: 7},
+}
+
+// Schedule is a parsed cron expression
+type Schedule struct {
+	minutes, hours, daysOfMonth, months, daysOfWeek uint64
+	// restricted days of month and of week match if either matches, as in cron
+	anyDayOfMonth, anyDayOfWeek bool
+}
+
+// Parse parses a cron expression of five fields separated by spaces: minute, hour,
+// day of month, month and day of week. Each field is either *, a value, a range
+// such as 1-5, or a comma separated list of them, optionally followed by a step such as */15.
+func Parse(spec string) (*Schedule, error) {
+	parts := strings.Fields(spec)
+	if len(parts) != len(fields) {
+		return nil, errors.Errorf("cron expression %q should have %d fields, has %d", spec, len(fields), len(parts))
+	}
+
+	bits := make([]uint64, len(fields))
+	for i, f := range fields {
+		b, err := parseField(parts[i], f)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid cron expression %q", spec)
+		}
+		bits[i] = b
+	}
+
+	s := &Schedule{
+		minutes:       bits[0],
+		hours:         bits[1],
+		daysOfMonth:   bits[2],
+		months:        bits[3],
+		daysOfWeek:    bits[4],
+		anyDayOfMonth: strings.HasPrefix(parts[2], "*"),
+		anyDayOfWeek:  strings.HasPrefix(parts[4], "*"),
+	}
+	// Sunday is both 0 and 7
+	if s.daysOfWeek&(1<<7) != 0 {
+		s.daysOfWeek |= 1
+	}
+	return s, nil
+}
+
+// parseField returns the values of a field of a cron expression, as a bit set
+func parseField(s string, f field) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(s, ",") {
+		step := 1
+		if i := strings.Index(part, "/"); i >= 0 {
+			var err error
+			if step, err = strconv.Atoi(part[i+1:]); err != nil || step < 1 {
+				return 0, errors.Errorf("invalid step %q in %s field", part[i+1:], f.name)
+			}
+			part = part[:i]
+		}
+
+		low, high := f.min, f.max
+		if part != "*" {
+			var err error
+			r := strings.SplitN(part, "-", 2)
+			if low, err = parseValue(r[0], f); err != nil {
+				return 0, err
+			}
+			high = low
+			if len(r) == 2 {
+				if high, err = parseValue(r[1], f); err != nil {
+					return 0, err
+				}
+			} else if step > 1 {
+				// 5/15 means every 15 from 5, as 5-max/15
+				high = f.max
+			}
+			if low > high {
+				return 0, errors.Errorf("invalid range %q in %s field", part, f.name)
+			}
+		}
+
+		for v := low; v <= high; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+	return bits, nil
+}
+
+// parseValue parses a single value of a field of a cron expression
+func parseValue(s string, f field) (int, error) {
+	v, err := strconv.Atoi(s)
+	if err != nil || v < f.min || v > f.max {
+		return 0, errors.Errorf("invalid value %q in %s field, should be between %d and %d", s, f.name, f.min, f.max)
+	}
+	return v, nil
+}
+
+// Matches returns true if the schedule matches the minute of t
+func (s *Schedule) Matches(t time.Time) bool {
+	if s.minutes&(1<<uint(t.Minute())) == 0 || s.hours&(1<<uint(t.Hour())) == 0 || s.months&(1<<uint(t.Month())) == 0 {
+		return false
+	}
+
+	dayOfMonth := s.daysOfMonth&(1<<uint(t.Day())) != 0
+	dayOfWeek := s.daysOfWeek&(1<<uint(t.Weekday())) != 0
+	if s.anyDayOfMonth || s.anyDayOfWeek {
+		return dayOfMonth && dayOfWeek
+	}
+	return dayOfMonth || dayOfWeek
+}
+
+// Active returns true if t is within a window of the given duration,
+// that started at a minute matched by the schedule
+func (s *Schedule) Active(t time.Time, duration time.Duration) bool {
+	for start := t.Truncate(time.Minute); t.Sub(start) < duration; start = start.Add(-time.Minute) {
+		if s.Matches(start) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,109 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	for _, spec := range []string{"* * * * *", "0 18 * * 5", "*/15 9-17 * * 1-5", "0,30 0 1,15 * *", "5/20 * * 1-12/3 0-7"} {
+		_, err := Parse(spec)
+		assert.NoError(t, err, spec)
+	}
+
+	for _, spec := range []string{"", "* * * *", "* * * * * *", "60 * * * *", "* 24 * * *", "* * 0 * *",
+		"* * * 13 *", "* * * * 8", "a * * * *", "5-1 * * * *", "*/0 * * * *", "*/a * * * *", "1-2-3 * * * *"} {
+		_, err := Parse(spec)
+		assert.Error(t, err, spec)
+	}
+}
+
+func TestScheduleMatches(t *testing.T) {
+	t.Parallel()
+
+	// Friday, 8 November 2019
+	friday := time.Date(2019, 11, 8, 18, 0, 0, 0, time.UTC)
+
+	fixtures := map[string]struct {
+		spec     string
+		matches  []time.Time
+		excludes []time.Time
+	}{
+		"every minute": {
+			spec:    "* * * * *",
+			matches: []time.Time{friday, friday.Add(time.Minute + 30*time.Second)},
+		},
+		"fridays at 6PM": {
+			spec:     "0 18 * * 5",
+			matches:  []time.Time{friday, friday.Add(30 * time.Second), friday.AddDate(0, 0, 7)},
+			excludes: []time.Time{friday.Add(time.Minute), friday.Add(time.Hour), friday.AddDate(0, 0, 1)},
+		},
+		"steps and ranges": {
+			spec:     "*/15 9-17 * * 1-5",
+			matches:  []time.Time{friday.Add(-45 * time.Minute), friday.Add(-9 * time.Hour), friday.AddDate(0, 0, -4).Add(-time.Hour)},
+			excludes: []time.Time{friday, friday.Add(-50 * time.Minute), friday.AddDate(0, 0, 1).Add(-time.Hour)},
+		},
+		"sunday as 7": {
+			spec:     "0 18 * * 7",
+			matches:  []time.Time{friday.AddDate(0, 0, 2)},
+			excludes: []time.Time{friday},
+		},
+		"day of month or day of week": {
+			spec:     "0 18 1 * 5",
+			matches:  []time.Time{friday, time.Date(2019, 12, 1, 18, 0, 0, 0, time.UTC)},
+			excludes: []time.Time{friday.AddDate(0, 0, 1)},
+		},
+		"day of month and month": {
+			spec:     "0 18 8 11 *",
+			matches:  []time.Time{friday},
+			excludes: []time.Time{friday.AddDate(0, 1, 0), friday.AddDate(0, 0, 7)},
+		},
+	}
+
+	for k, v := range fixtures {
+		t.Run(k, func(t *testing.T) {
+			s, err := Parse(v.spec)
+			assert.NoError(t, err)
+			for _, m := range v.matches {
+				assert.True(t, s.Matches(m), "%s should match", m)
+			}
+			for _, e := range v.excludes {
+				assert.False(t, s.Matches(e), "%s should not match", e)
+			}
+		})
+	}
+}
+
+func TestScheduleActive(t *testing.T) {
+	t.Parallel()
+
+	s, err := Parse("0 18 * * 5")
+	assert.NoError(t, err)
+
+	friday := time.Date(2019, 11, 8, 18, 0, 0, 0, time.UTC)
+	assert.False(t, s.Active(friday.Add(-time.Second), 4*time.Hour))
+	assert.True(t, s.Active(friday, 4*time.Hour))
+	assert.True(t, s.Active(friday.Add(4*time.Hour-time.Second), 4*time.Hour))
+	assert.False(t, s.Active(friday.Add(4*time.Hour), 4*time.Hour))
+	assert.False(t, s.Active(friday, 0))
+	assert.True(t, s.Active(friday.Add(30*time.Second), time.Minute))
+	assert.False(t, s.Active(friday.Add(30*time.Second), 10*time.Second))
+}
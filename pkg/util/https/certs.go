@@ -0,0 +1,142 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package https
+
+import (
+	cryptotls "crypto/tls"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"agones.dev/agones/pkg/util/runtime"
+	"github.com/fsnotify/fsnotify"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// CertReloader serves the latest TLS keypair of a cert and key file, reloading it when the files change,
+// so that a certificate rotated by cert-manager, or an update of the Secret it is mounted from, is picked
+// up without a restart. The new keypair is used for new connections, while open connections are left as is.
+type CertReloader struct {
+	logger   *logrus.Entry
+	certFile string
+	keyFile  string
+	// interval is how often the keypair is re-read, in case a change is missed by the watcher. 0 disables.
+	interval time.Duration
+
+	mu   sync.RWMutex
+	cert *cryptotls.Certificate
+}
+
+// NewCertReloader returns a CertReloader that has loaded the keypair of the given cert and key files
+func NewCertReloader(certFile, keyFile string, interval time.Duration) (*CertReloader, error) {
+	r := &CertReloader{certFile: certFile, keyFile: keyFile, interval: interval}
+	r.logger = runtime.NewLoggerWithType(r)
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// GetCertificate returns the latest keypair, to be used as the GetCertificate of a tls.Config
+func (r *CertReloader) GetCertificate(*cryptotls.ClientHelloInfo) (*cryptotls.Certificate, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.cert, nil
+}
+
+// Run watches the directories of the cert and key files, and reloads the keypair on any change in them,
+// as Secret volumes are updated by swapping a symlink, until the stop channel is closed
+func (r *CertReloader) Run(stop <-chan struct{}) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return errors.Wrap(err, "could not create the certificate watcher")
+	}
+	defer watcher.Close() // nolint: errcheck
+
+	for _, dir := range r.dirs() {
+		if err := watcher.Add(dir); err != nil {
+			return errors.Wrapf(err, "could not watch the certificate directory %s", dir)
+		}
+	}
+
+	var tick <-chan time.Time
+	if r.interval > 0 {
+		ticker := time.NewTicker(r.interval)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+
+	for {
+		select {
+		case <-stop:
+			return nil
+		case e := <-watcher.Events:
+			r.logger.WithField("event", e.String()).Debug("certificate directory changed")
+			r.reload()
+		case err := <-watcher.Errors:
+			r.logger.WithError(err).Warn("error watching the certificate files")
+		case <-tick:
+			r.reload()
+		}
+	}
+}
+
+// dirs returns the directories of the cert and key files
+func (r *CertReloader) dirs() []string {
+	certDir, keyDir := filepath.Dir(r.certFile), filepath.Dir(r.keyFile)
+	if certDir == keyDir {
+		return []string{certDir}
+	}
+	return []string{certDir, keyDir}
+}
+
+// reload loads the keypair, and keeps the current one if it can't be loaded, e.g. if only one of the
+// files was written yet, until the next change
+func (r *CertReloader) reload() {
+	if err := r.load(); err != nil {
+		r.logger.WithError(err).Warn("could not reload the certificate, keeping the current one")
+	}
+}
+
+// load loads the keypair of the cert and key files, if it changed
+func (r *CertReloader) load() error {
+	cert, err := cryptotls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return errors.Wrapf(err, "could not load the certificate %s and key %s", r.certFile, r.keyFile)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.cert != nil && equalCertificates(r.cert, &cert) {
+		return nil
+	}
+	r.cert = &cert
+	r.logger.WithField("certFile", r.certFile).Info("loaded certificate")
+	return nil
+}
+
+// equalCertificates returns whether the certificate chains of two keypairs are the same
+func equalCertificates(a, b *cryptotls.Certificate) bool {
+	if len(a.Certificate) != len(b.Certificate) {
+		return false
+	}
+	for i := range a.Certificate {
+		if string(a.Certificate[i]) != string(b.Certificate[i]) {
+			return false
+		}
+	}
+	return true
+}
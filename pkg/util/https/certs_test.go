@@ -0,0 +1,88 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package https
+
+import (
+	cryptotls "crypto/tls"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+const certsDir = "../../../install/helm/agones/certs"
+
+func TestCertReloader(t *testing.T) {
+	t.Parallel()
+
+	// copyKeypair copies the keypair of the given directory into dir
+	copyKeypair := func(t *testing.T, src, dir string) {
+		for _, f := range []string{"server.crt", "server.key"} {
+			b, err := ioutil.ReadFile(filepath.Join(src, f))
+			assert.NoError(t, err)
+			assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, f), b, 0600))
+		}
+	}
+
+	// certificate returns the certificate the reloader currently serves
+	certificate := func(t *testing.T, r *CertReloader) []byte {
+		cert, err := r.GetCertificate(&cryptotls.ClientHelloInfo{})
+		assert.NoError(t, err)
+		return cert.Certificate[0]
+	}
+
+	rotated, err := cryptotls.LoadX509KeyPair(filepath.Join(certsDir, "allocator/server.crt"), filepath.Join(certsDir, "allocator/server.key"))
+	assert.NoError(t, err)
+
+	for name, interval := range map[string]time.Duration{"without interval": 0, "with interval": 10 * time.Millisecond} {
+		interval := interval
+		t.Run(name, func(t *testing.T) {
+			dir, err := ioutil.TempDir("", "certs")
+			assert.NoError(t, err)
+			defer os.RemoveAll(dir) // nolint: errcheck
+
+			_, err = NewCertReloader(filepath.Join(dir, "server.crt"), filepath.Join(dir, "server.key"), interval)
+			assert.Error(t, err)
+
+			copyKeypair(t, certsDir, dir)
+			r, err := NewCertReloader(filepath.Join(dir, "server.crt"), filepath.Join(dir, "server.key"), interval)
+			assert.NoError(t, err)
+			original := certificate(t, r)
+
+			stop := make(chan struct{})
+			defer close(stop)
+			go func() {
+				assert.NoError(t, r.Run(stop))
+			}()
+			// give the watcher time to start
+			time.Sleep(100 * time.Millisecond)
+
+			// an invalid keypair is ignored
+			assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "server.key"), []byte("nope"), 0600))
+			time.Sleep(100 * time.Millisecond)
+			assert.Equal(t, original, certificate(t, r))
+
+			copyKeypair(t, filepath.Join(certsDir, "allocator"), dir)
+			err = wait.PollImmediate(10*time.Millisecond, 5*time.Second, func() (bool, error) {
+				return string(certificate(t, r)) == string(rotated.Certificate[0]), nil
+			})
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -15,7 +15,9 @@
 package https
 
 import (
+	cryptotls "crypto/tls"
 	"net/http"
+	"time"
 
 	"agones.dev/agones/pkg/util/runtime"
 	"github.com/pkg/errors"
@@ -37,21 +39,29 @@ type Server struct {
 	tls      tls
 	certFile string
 	keyFile  string
+	// certReloadInterval is how often the certificate is re-read, on top of when its files change
+	certReloadInterval time.Duration
+	tlsConfig          *cryptotls.Config
 }
 
-// NewServer returns a Server instance.
-func NewServer(certFile, keyFile string) *Server {
+// NewServer returns a Server instance, that reloads its certificate when the cert or key file changes,
+// and also every certReloadInterval, unless it is 0.
+func NewServer(certFile, keyFile string, certReloadInterval time.Duration) *Server {
 	mux := http.NewServeMux()
+	tlsConfig := &cryptotls.Config{}
 	tls := &http.Server{
-		Addr:    ":8081",
-		Handler: mux,
+		Addr:      ":8081",
+		Handler:   mux,
+		TLSConfig: tlsConfig,
 	}
 
 	wh := &Server{
-		Mux:      mux,
-		tls:      tls,
-		certFile: certFile,
-		keyFile:  keyFile,
+		Mux:                mux,
+		tls:                tls,
+		certFile:           certFile,
+		keyFile:            keyFile,
+		certReloadInterval: certReloadInterval,
+		tlsConfig:          tlsConfig,
 	}
 	wh.Mux.HandleFunc("/", wh.defaultHandler)
 	wh.logger = runtime.NewLoggerWithType(wh)
@@ -59,9 +69,20 @@ func NewServer(certFile, keyFile string) *Server {
 	return wh
 }
 
-// Run runs the webhook server, starting a https listener.
+// Run runs the webhook server, starting a https listener, and reloading the certificate when it changes.
 // Will close the http server on stop channel close.
 func (s *Server) Run(_ int, stop <-chan struct{}) error {
+	certs, err := NewCertReloader(s.certFile, s.keyFile, s.certReloadInterval)
+	if err != nil {
+		return err
+	}
+	s.tlsConfig.GetCertificate = certs.GetCertificate
+
+	go func() {
+		if err := certs.Run(stop); err != nil {
+			s.logger.WithError(err).Error("could not watch the certificate, it will not be reloaded")
+		}
+	}()
 	go func() {
 		<-stop
 		s.tls.Close() // nolint: errcheck,gosec
@@ -69,7 +90,8 @@ func (s *Server) Run(_ int, stop <-chan struct{}) error {
 
 	s.logger.WithField("server", s).Infof("https server started")
 
-	err := s.tls.ListenAndServeTLS(s.certFile, s.keyFile)
+	// the certificate is served by the tls config
+	err = s.tls.ListenAndServeTLS("", "")
 	if err == http.ErrServerClosed {
 		s.logger.WithError(err).Info("https server closed")
 		return nil
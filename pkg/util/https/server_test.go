@@ -40,7 +40,7 @@ func (ts *testServer) ListenAndServeTLS(certFile, keyFile string) error {
 func TestServerRun(t *testing.T) {
 	t.Parallel()
 
-	s := NewServer("", "")
+	s := NewServer("../../../install/helm/agones/certs/server.crt", "../../../install/helm/agones/certs/server.key", 0)
 	ts := &testServer{server: httptest.NewUnstartedServer(s.Mux)}
 	s.tls = ts
 
@@ -49,6 +49,7 @@ func TestServerRun(t *testing.T) {
 
 	err := s.Run(0, stop)
 	assert.Nil(t, err)
+	assert.NotNil(t, s.tlsConfig.GetCertificate)
 
 	client := ts.server.Client()
 	resp, err := client.Get(ts.server.URL + "/test")
@@ -60,4 +61,7 @@ func TestServerRun(t *testing.T) {
 	assert.Nil(t, err)
 	defer resp.Body.Close() // nolint: errcheck
 	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	err = NewServer("missing.crt", "missing.key", 0).Run(0, stop)
+	assert.Error(t, err)
 }
@@ -0,0 +1,116 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// Feature is the name of a feature that can be switched on or off with a feature gate
+type Feature string
+
+// FeatureStage is the maturity of a Feature, which decides whether it is on by default
+type FeatureStage string
+
+const (
+	// FeatureStageAlpha features are off by default, and may change or be removed in any release
+	FeatureStageAlpha FeatureStage = "Alpha"
+	// FeatureStageBeta features are on by default, but can still be switched off
+	FeatureStageBeta FeatureStage = "Beta"
+)
+
+const (
+	// FeatureChaos enables the injection of artificial failures, for soak testing only
+	FeatureChaos Feature = "Chaos"
+)
+
+var (
+	// featureStages is the stage of each feature gate,
+	// only the features listed here can be set
+	featureStages = map[Feature]FeatureStage{
+		FeatureChaos: FeatureStageAlpha,
+	}
+
+	featureLock  sync.RWMutex
+	featureGates = map[Feature]bool{}
+)
+
+// FeatureDefault returns whether the Feature is on when it is not set,
+// which is true for Beta features and false for Alpha or unknown ones
+func FeatureDefault(f Feature) bool {
+	return featureStages[f] == FeatureStageBeta
+}
+
+// ParseFeatures sets the feature gates from a list of `Feature=true|false` pairs
+// separated by commas, e.g. "Chaos=true". Features that are not listed are reset to their default.
+func ParseFeatures(s string) error {
+	gates := map[Feature]bool{}
+
+	for _, pair := range strings.Split(s, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		kv := strings.SplitN(pair, "=", 2)
+		if len(kv) != 2 {
+			return errors.Errorf("invalid feature gate %q, expected Feature=true|false", pair)
+		}
+		f := Feature(strings.TrimSpace(kv[0]))
+		if _, ok := featureStages[f]; !ok {
+			return errors.Errorf("unknown feature gate %q", f)
+		}
+		enabled, err := strconv.ParseBool(strings.TrimSpace(kv[1]))
+		if err != nil {
+			return errors.Wrapf(err, "invalid value for feature gate %s", f)
+		}
+		gates[f] = enabled
+	}
+
+	featureLock.Lock()
+	defer featureLock.Unlock()
+	featureGates = gates
+	return nil
+}
+
+// EncodeFeatures returns the feature gates that differ from their default,
+// in the format accepted by ParseFeatures, so they can be passed on to another process
+func EncodeFeatures() string {
+	featureLock.RLock()
+	defer featureLock.RUnlock()
+
+	var pairs []string
+	for f, enabled := range featureGates {
+		if enabled != FeatureDefault(f) {
+			pairs = append(pairs, string(f)+"="+strconv.FormatBool(enabled))
+		}
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
+
+// FeatureEnabled returns true if the feature gate of the Feature is switched on
+func FeatureEnabled(f Feature) bool {
+	featureLock.RLock()
+	defer featureLock.RUnlock()
+	if enabled, ok := featureGates[f]; ok {
+		return enabled
+	}
+	return FeatureDefault(f)
+}
@@ -0,0 +1,67 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseFeatures(t *testing.T) {
+	defer func() {
+		assert.NoError(t, ParseFeatures(""))
+	}()
+
+	assert.NoError(t, ParseFeatures(""))
+	assert.False(t, FeatureEnabled(FeatureChaos))
+
+	assert.NoError(t, ParseFeatures(" Chaos = true "))
+	assert.True(t, FeatureEnabled(FeatureChaos))
+
+	assert.NoError(t, ParseFeatures("Chaos=false"))
+	assert.False(t, FeatureEnabled(FeatureChaos))
+
+	assert.NoError(t, ParseFeatures("Chaos=true"))
+	assert.Error(t, ParseFeatures("Unknown=true"))
+	assert.Error(t, ParseFeatures("Chaos"))
+	assert.Error(t, ParseFeatures("Chaos=maybe"))
+	// the feature gates are unchanged when they fail to parse
+	assert.True(t, FeatureEnabled(FeatureChaos))
+}
+
+func TestFeatureStages(t *testing.T) {
+	featureStages["TestBeta"] = FeatureStageBeta
+	defer func() {
+		delete(featureStages, "TestBeta")
+		assert.NoError(t, ParseFeatures(""))
+	}()
+
+	assert.False(t, FeatureDefault(FeatureChaos))
+	assert.True(t, FeatureDefault("TestBeta"))
+	assert.False(t, FeatureDefault("Unknown"))
+
+	assert.NoError(t, ParseFeatures(""))
+	assert.False(t, FeatureEnabled(FeatureChaos))
+	assert.True(t, FeatureEnabled("TestBeta"))
+	assert.Equal(t, "", EncodeFeatures())
+
+	assert.NoError(t, ParseFeatures("TestBeta=false,Chaos=false"))
+	assert.False(t, FeatureEnabled("TestBeta"))
+	assert.Equal(t, "TestBeta=false", EncodeFeatures())
+
+	assert.NoError(t, ParseFeatures("TestBeta=false,Chaos=true"))
+	assert.Equal(t, "Chaos=true,TestBeta=false", EncodeFeatures())
+}
@@ -34,9 +34,11 @@ type WebHook struct {
 	handlers map[string][]operationHandler
 }
 
-// operationHandler stores the data for a handler to match against
+// operationHandler stores the data for a handler to match against.
+// Only one of handler and warnings is set.
 type operationHandler struct {
 	handler   Handler
+	warnings  WarningHandler
 	groupKind schema.GroupKind
 	operation v1beta1.Operation
 }
@@ -45,6 +47,25 @@ type operationHandler struct {
 // AdmissionReview that will be the return value of the webhook
 type Handler func(review v1beta1.AdmissionReview) (v1beta1.AdmissionReview, error)
 
+// WarningHandler returns the warnings for a webhook's AdmissionReview coming in,
+// which are returned to the client without denying the request
+type WarningHandler func(review v1beta1.AdmissionReview) []string
+
+// admissionResponse is an AdmissionResponse with the warnings that Kubernetes 1.19 and later
+// return to the client, which the vendored API does not have yet.
+// Older versions of Kubernetes ignore the warnings.
+type admissionResponse struct {
+	*v1beta1.AdmissionResponse
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// admissionReview is an AdmissionReview with an admissionResponse
+type admissionReview struct {
+	metav1.TypeMeta `json:",inline"`
+	Request         *v1beta1.AdmissionRequest `json:"request,omitempty"`
+	Response        *admissionResponse        `json:"response,omitempty"`
+}
+
 // NewWebHook returns a Kubernetes webhook manager
 func NewWebHook(mux *http.ServeMux) *WebHook {
 	wh := &WebHook{
@@ -58,6 +79,17 @@ func NewWebHook(mux *http.ServeMux) *WebHook {
 
 // AddHandler adds a handler for a given path, group and kind, and operation
 func (wh *WebHook) AddHandler(path string, gk schema.GroupKind, op v1beta1.Operation, h Handler) {
+	wh.addOperationHandler(path, operationHandler{groupKind: gk, operation: op, handler: h})
+}
+
+// AddWarningHandler adds a handler for a given path, group and kind, and operation, that returns
+// warnings for the request, e.g. for a configuration that is valid but likely a mistake
+func (wh *WebHook) AddWarningHandler(path string, gk schema.GroupKind, op v1beta1.Operation, h WarningHandler) {
+	wh.addOperationHandler(path, operationHandler{groupKind: gk, operation: op, warnings: h})
+}
+
+// addOperationHandler adds the operation handler to the given path
+func (wh *WebHook) addOperationHandler(path string, oh operationHandler) {
 	if len(wh.handlers[path]) == 0 {
 		wh.mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
 			err := wh.handle(path, w, r)
@@ -67,8 +99,8 @@ func (wh *WebHook) AddHandler(path string, gk schema.GroupKind, op v1beta1.Opera
 			}
 		})
 	}
-	wh.logger.WithField("path", path).WithField("groupKind", gk).WithField("op", op).Info("Added webhook handler")
-	wh.handlers[path] = append(wh.handlers[path], operationHandler{groupKind: gk, operation: op, handler: h})
+	wh.logger.WithField("path", path).WithField("groupKind", oh.groupKind).WithField("op", oh.operation).Info("Added webhook handler")
+	wh.handlers[path] = append(wh.handlers[path], oh)
 }
 
 // handle Handles http requests for webhooks
@@ -85,11 +117,17 @@ func (wh *WebHook) handle(path string, w http.ResponseWriter, r *http.Request) e
 	if review.Response == nil {
 		review.Response = &v1beta1.AdmissionResponse{Allowed: true}
 	}
+	var warnings []string
 	for _, oh := range wh.handlers[path] {
 		if oh.operation == review.Request.Operation &&
 			oh.groupKind.Kind == review.Request.Kind.Kind &&
 			review.Request.Kind.Group == oh.groupKind.Group {
 
+			if oh.warnings != nil {
+				warnings = append(warnings, oh.warnings(review)...)
+				continue
+			}
+
 			review, err = oh.handler(review)
 			if err != nil {
 				causes := make([]metav1.StatusCause, 0)
@@ -109,7 +147,14 @@ func (wh *WebHook) handle(path string, w http.ResponseWriter, r *http.Request) e
 			}
 		}
 	}
-	err = json.NewEncoder(w).Encode(review)
+	if len(warnings) > 0 {
+		wh.logger.WithField("path", path).WithField("name", review.Request.Name).WithField("warnings", warnings).Info("Returning warnings")
+	}
+	err = json.NewEncoder(w).Encode(admissionReview{
+		TypeMeta: review.TypeMeta,
+		Request:  review.Request,
+		Response: &admissionResponse{AdmissionResponse: review.Response, Warnings: warnings},
+	})
 	if err != nil {
 		return errors.Wrapf(err, "error decoding encoding json for path %v", path)
 	}
@@ -128,6 +128,56 @@ func TestWebHookAddHandler(t *testing.T) {
 	}
 }
 
+func TestWebHookWarningHandler(t *testing.T) {
+	t.Parallel()
+
+	gk := schema.GroupKind{Group: "group", Kind: "kind"}
+	fixture := v1beta1.AdmissionReview{Request: &v1beta1.AdmissionRequest{
+		Kind:      metav1.GroupVersionKind{Kind: "kind", Group: "group", Version: "version"},
+		Operation: v1beta1.Create,
+		UID:       "1234"}}
+
+	mux := http.NewServeMux()
+	ts := httptest.NewUnstartedServer(mux)
+	wh := NewWebHook(mux)
+	wh.AddHandler("/test", gk, v1beta1.Create, func(review v1beta1.AdmissionReview) (v1beta1.AdmissionReview, error) {
+		return review, nil
+	})
+	wh.AddWarningHandler("/test", gk, v1beta1.Create, func(review v1beta1.AdmissionReview) []string {
+		return []string{"first"}
+	})
+	wh.AddWarningHandler("/test", gk, v1beta1.Create, func(review v1beta1.AdmissionReview) []string {
+		return []string{"second"}
+	})
+	wh.AddWarningHandler("/test", gk, v1beta1.Update, func(review v1beta1.AdmissionReview) []string {
+		return []string{"update"}
+	})
+
+	ts.StartTLS()
+	defer ts.Close()
+
+	buf := &bytes.Buffer{}
+	err := json.NewEncoder(buf).Encode(fixture)
+	assert.Nil(t, err)
+
+	resp, err := ts.Client().Post(ts.URL+"/test", "application/json", buf)
+	assert.Nil(t, err)
+	defer resp.Body.Close() // nolint: errcheck
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	result := struct {
+		Response struct {
+			Allowed  bool     `json:"allowed"`
+			UID      string   `json:"uid"`
+			Warnings []string `json:"warnings"`
+		} `json:"response"`
+	}{}
+	err = json.NewDecoder(resp.Body).Decode(&result)
+	assert.Nil(t, err)
+	assert.True(t, result.Response.Allowed)
+	assert.Equal(t, []string{"first", "second"}, result.Response.Warnings)
+}
+
 func TestWebHookFleetValidationHandler(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,53 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workerqueue
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+)
+
+// FastSlowRateLimiter is the configuration of a rate limiter that retries a failed item
+// after FastDelay for its first FastRetries retries, and after SlowDelay from then on
+type FastSlowRateLimiter struct {
+	// FastDelay is the delay of the first retries of an item
+	FastDelay time.Duration
+	// SlowDelay is the delay of the retries after the fast ones
+	SlowDelay time.Duration
+	// FastRetries is the number of retries of an item that are fast
+	FastRetries int
+}
+
+// Validate returns an error if the FastSlowRateLimiter can't be used
+func (r FastSlowRateLimiter) Validate() error {
+	if r.FastDelay < 0 || r.SlowDelay < 0 {
+		return errors.New("rate limiter delays cannot be negative")
+	}
+	if r.SlowDelay < r.FastDelay {
+		return errors.Errorf("rate limiter slow delay %s cannot be less than the fast delay %s", r.SlowDelay, r.FastDelay)
+	}
+	if r.FastRetries < 0 {
+		return errors.New("rate limiter fast retries cannot be negative")
+	}
+	return nil
+}
+
+// RateLimiter returns a new rate limiter with the FastSlowRateLimiter configuration.
+// Each queue needs its own rate limiter, as it tracks the retries of each item.
+func (r FastSlowRateLimiter) RateLimiter() workqueue.RateLimiter {
+	return workqueue.NewItemFastSlowRateLimiter(r.FastDelay, r.SlowDelay, r.FastRetries)
+}
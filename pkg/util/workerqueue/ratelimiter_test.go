@@ -0,0 +1,61 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workerqueue
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFastSlowRateLimiterValidate(t *testing.T) {
+	t.Parallel()
+
+	fixtures := map[string]struct {
+		limiter FastSlowRateLimiter
+		valid   bool
+	}{
+		"valid":               {limiter: FastSlowRateLimiter{FastDelay: 20 * time.Millisecond, SlowDelay: 500 * time.Millisecond, FastRetries: 5}, valid: true},
+		"no fast retries":     {limiter: FastSlowRateLimiter{FastDelay: 20 * time.Millisecond, SlowDelay: 500 * time.Millisecond}, valid: true},
+		"negative delay":      {limiter: FastSlowRateLimiter{FastDelay: -time.Millisecond, SlowDelay: 500 * time.Millisecond, FastRetries: 5}},
+		"slow less than fast": {limiter: FastSlowRateLimiter{FastDelay: time.Second, SlowDelay: 500 * time.Millisecond, FastRetries: 5}},
+		"negative retries":    {limiter: FastSlowRateLimiter{FastDelay: 20 * time.Millisecond, SlowDelay: 500 * time.Millisecond, FastRetries: -1}},
+	}
+
+	for k, v := range fixtures {
+		t.Run(k, func(t *testing.T) {
+			err := v.limiter.Validate()
+			if v.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestFastSlowRateLimiterRateLimiter(t *testing.T) {
+	t.Parallel()
+
+	limiter := FastSlowRateLimiter{FastDelay: 20 * time.Millisecond, SlowDelay: 500 * time.Millisecond, FastRetries: 2}.RateLimiter()
+	assert.Equal(t, 20*time.Millisecond, limiter.When("a"))
+	assert.Equal(t, 20*time.Millisecond, limiter.When("a"))
+	assert.Equal(t, 500*time.Millisecond, limiter.When("a"))
+	assert.Equal(t, 20*time.Millisecond, limiter.When("b"))
+
+	limiter.Forget("a")
+	assert.Equal(t, 20*time.Millisecond, limiter.When("a"))
+}
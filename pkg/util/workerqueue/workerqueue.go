@@ -24,6 +24,7 @@ import (
 
 	"agones.dev/agones/pkg/util/logfields"
 	"agones.dev/agones/pkg/util/runtime"
+	"github.com/heptiolabs/healthcheck"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -49,9 +50,16 @@ type WorkerQueue struct {
 	// SyncHandler is exported to make testing easier (hack)
 	SyncHandler Handler
 
-	mu      sync.Mutex
-	workers int
-	running int
+	mu       sync.Mutex
+	workers  int
+	running  int
+	lastSync time.Time
+}
+
+// lastSyncHandler is implemented by health handlers that can report
+// when each registered worker queue last synced
+type lastSyncHandler interface {
+	AddLastSync(name string, lastSync func() time.Time)
 }
 
 // NewWorkerQueue returns a new worker queue for a given name
@@ -148,6 +156,7 @@ func (wq *WorkerQueue) processNextWorkItem() bool {
 		return true
 	}
 
+	wq.setLastSync()
 	wq.queue.Forget(obj)
 	return true
 }
@@ -185,6 +194,36 @@ func (wq *WorkerQueue) Healthy() error {
 	return nil
 }
 
+// Ready reports whether Run has been called, and the workers have been started.
+func (wq *WorkerQueue) Ready() error {
+	wq.mu.Lock()
+	defer wq.mu.Unlock()
+	if wq.workers == 0 {
+		return errors.New("worker goroutines have not been started")
+	}
+	return nil
+}
+
+// LastSync returns the last time an item was successfully processed by the SyncHandler.
+// Returns the zero time if nothing has been processed yet.
+func (wq *WorkerQueue) LastSync() time.Time {
+	wq.mu.Lock()
+	defer wq.mu.Unlock()
+	return wq.lastSync
+}
+
+// AddHealthChecks registers this queue with the health handler under the given name:
+// as a liveness check that all the worker goroutines are running, and a readiness check
+// (with a "-ready" suffix) that the workers have been started. If the handler can report it,
+// the time of the last successful sync is registered under the name as well.
+func (wq *WorkerQueue) AddHealthChecks(health healthcheck.Handler, name string) {
+	health.AddLivenessCheck(name, wq.Healthy)
+	health.AddReadinessCheck(name+"-ready", wq.Ready)
+	if h, ok := health.(lastSyncHandler); ok {
+		h.AddLastSync(name, wq.LastSync)
+	}
+}
+
 // RunCount reports the number of running worker goroutines started by Run.
 func (wq *WorkerQueue) RunCount() int {
 	wq.mu.Lock()
@@ -198,6 +237,12 @@ func (wq *WorkerQueue) setWorkerCount(n int) {
 	wq.workers = n
 }
 
+func (wq *WorkerQueue) setLastSync() {
+	wq.mu.Lock()
+	defer wq.mu.Unlock()
+	wq.lastSync = time.Now().UTC()
+}
+
 func (wq *WorkerQueue) inc() {
 	wq.mu.Lock()
 	defer wq.mu.Unlock()
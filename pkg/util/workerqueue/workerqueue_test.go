@@ -165,6 +165,48 @@ func TestWorkQueueHealthCheck(t *testing.T) {
 	f(t, url, http.StatusServiceUnavailable)
 }
 
+func TestWorkerQueueReadyAndLastSync(t *testing.T) {
+	t.Parallel()
+
+	synced := make(chan struct{})
+	handler := func(string) error {
+		synced <- struct{}{}
+		return nil
+	}
+	wq := NewWorkerQueue(handler, logrus.WithField("source", "test"), "testKey", "test")
+
+	health := healthcheck.NewHandler()
+	wq.AddHealthChecks(health, "test")
+
+	// gate
+	assert.Error(t, wq.Ready())
+	assert.True(t, wq.LastSync().IsZero())
+	req := httptest.NewRequest(http.MethodGet, "/ready", nil)
+	rec := httptest.NewRecorder()
+	health.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+
+	stop := make(chan struct{})
+	defer close(stop)
+	go wq.Run(1, stop)
+
+	wq.Enqueue(cache.ExplicitKey("default/test"))
+	select {
+	case <-synced:
+	case <-time.After(5 * time.Second):
+		assert.FailNow(t, "should have synced")
+	}
+
+	err := wait.PollImmediate(100*time.Millisecond, 5*time.Second, func() (bool, error) {
+		return wq.Ready() == nil && !wq.LastSync().IsZero(), nil
+	})
+	assert.Nil(t, err)
+
+	rec = httptest.NewRecorder()
+	health.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
 func TestWorkerQueueEnqueueAfter(t *testing.T) {
 	t.Parallel()
 
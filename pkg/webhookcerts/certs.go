@@ -0,0 +1,170 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhookcerts
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// caValidity is how long the generated CA is valid for
+	caValidity = 10 * 365 * 24 * time.Hour
+	// servingValidity is how long the generated serving certificates are valid for
+	servingValidity = 365 * 24 * time.Hour
+	// renewBefore is how long before a certificate expires it is replaced
+	renewBefore = 30 * 24 * time.Hour
+)
+
+// newCA returns the PEM encoded certificate and key of a new self signed CA
+func newCA(now time.Time) (certPEM, keyPEM []byte, err error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not generate the CA key")
+	}
+	template, err := certificateTemplate("agones-controller-ca", now, caValidity)
+	if err != nil {
+		return nil, nil, err
+	}
+	template.IsCA = true
+	template.KeyUsage = x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature
+	template.BasicConstraintsValid = true
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not create the CA certificate")
+	}
+	return encode(der, key)
+}
+
+// newServingCert returns the PEM encoded certificate and key of a new serving certificate for
+// the given DNS names, signed by the given PEM encoded CA
+func newServingCert(caCertPEM, caKeyPEM []byte, dnsNames []string, now time.Time) (certPEM, keyPEM []byte, err error) {
+	ca, err := parseCertificate(caCertPEM)
+	if err != nil {
+		return nil, nil, err
+	}
+	block, _ := pem.Decode(caKeyPEM)
+	if block == nil {
+		return nil, nil, errors.New("could not decode the CA key")
+	}
+	caKey, err := x509.ParseECPrivateKey(block.Bytes)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not parse the CA key")
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not generate the serving key")
+	}
+	template, err := certificateTemplate(dnsNames[0], now, servingValidity)
+	if err != nil {
+		return nil, nil, err
+	}
+	template.DNSNames = dnsNames
+	template.KeyUsage = x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment
+	template.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, ca, key.Public(), caKey)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not create the serving certificate")
+	}
+	return encode(der, key)
+}
+
+// certificateTemplate returns the template of a certificate with the given common name,
+// that is valid from now, with a little slack for clock skew, for the given duration
+func certificateTemplate(commonName string, now time.Time, validity time.Duration) (*x509.Certificate, error) {
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not generate a serial number")
+	}
+	return &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    now.Add(-time.Hour),
+		NotAfter:     now.Add(validity),
+	}, nil
+}
+
+// encode returns the PEM encoding of a certificate and its key
+func encode(der []byte, key *ecdsa.PrivateKey) (certPEM, keyPEM []byte, err error) {
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not marshal the key")
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), nil
+}
+
+// parseCertificate parses the first certificate of PEM encoded data
+func parseCertificate(certPEM []byte) (*x509.Certificate, error) {
+	block, _ := pem.Decode(certPEM)
+	if block == nil {
+		return nil, errors.New("could not decode the certificate")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	return cert, errors.Wrap(err, "could not parse the certificate")
+}
+
+// validCA returns whether the PEM encoded CA certificate and key can be used until renewBefore from now
+func validCA(certPEM, keyPEM []byte, now time.Time) bool {
+	cert, err := parseCertificate(certPEM)
+	if err != nil || !cert.IsCA || cert.NotAfter.Before(now.Add(renewBefore)) {
+		return false
+	}
+	return matchesKey(cert, keyPEM)
+}
+
+// validServingCert returns whether the PEM encoded serving certificate and key are signed by the CA, are for the given
+// DNS names, and can be used until renewBefore from now
+func validServingCert(certPEM, keyPEM, caCertPEM []byte, dnsNames []string, now time.Time) bool {
+	cert, err := parseCertificate(certPEM)
+	if err != nil || cert.NotAfter.Before(now.Add(renewBefore)) || !matchesKey(cert, keyPEM) {
+		return false
+	}
+	roots := x509.NewCertPool()
+	if !roots.AppendCertsFromPEM(caCertPEM) {
+		return false
+	}
+	for _, name := range dnsNames {
+		if _, err := cert.Verify(x509.VerifyOptions{DNSName: name, Roots: roots, CurrentTime: now}); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// matchesKey returns whether the PEM encoded key is the private key of the certificate
+func matchesKey(cert *x509.Certificate, keyPEM []byte) bool {
+	block, _ := pem.Decode(keyPEM)
+	if block == nil {
+		return false
+	}
+	key, err := x509.ParseECPrivateKey(block.Bytes)
+	if err != nil {
+		return false
+	}
+	pub, ok := cert.PublicKey.(*ecdsa.PublicKey)
+	return ok && pub.X.Cmp(key.X) == 0 && pub.Y.Cmp(key.Y) == 0
+}
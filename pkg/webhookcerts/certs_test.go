@@ -0,0 +1,57 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhookcerts
+
+import (
+	"crypto/tls"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCertificates(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	dnsNames := []string{"service", "service.ns", "service.ns.svc"}
+
+	caCert, caKey, err := newCA(now)
+	assert.NoError(t, err)
+	assert.True(t, validCA(caCert, caKey, now))
+	assert.False(t, validCA(caCert, caKey, now.Add(caValidity-renewBefore+time.Hour)))
+	assert.False(t, validCA(nil, nil, now))
+
+	cert, key, err := newServingCert(caCert, caKey, dnsNames, now)
+	assert.NoError(t, err)
+	_, err = tls.X509KeyPair(cert, key)
+	assert.NoError(t, err)
+
+	assert.True(t, validServingCert(cert, key, caCert, dnsNames, now))
+	assert.False(t, validServingCert(cert, key, caCert, dnsNames, now.Add(servingValidity-renewBefore+time.Hour)))
+	assert.False(t, validServingCert(cert, key, caCert, []string{"other.ns.svc"}, now))
+	// the serving certificate is not a CA
+	assert.False(t, validCA(cert, key, now))
+
+	// signed by another CA, or with another key
+	otherCACert, otherCAKey, err := newCA(now)
+	assert.NoError(t, err)
+	assert.False(t, validServingCert(cert, key, otherCACert, dnsNames, now))
+	assert.False(t, validServingCert(cert, caKey, caCert, dnsNames, now))
+	assert.False(t, validCA(caCert, otherCAKey, now))
+
+	_, _, err = newServingCert(caCert, []byte("nope"), dnsNames, now)
+	assert.EqualError(t, err, "could not decode the CA key")
+}
@@ -0,0 +1,396 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package webhookcerts lets the controller manage the certificates of its webhooks and APIService itself:
+// it generates a CA and a serving certificate, keeps them in a Secret, renews them before they expire,
+// and patches the caBundle of the webhook configurations and APIServices of its Service,
+// so no certificates have to be provisioned when Agones is installed
+package webhookcerts
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"agones.dev/agones/pkg/apis/agones"
+	"agones.dev/agones/pkg/util/runtime"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	admregv1beta1 "k8s.io/api/admissionregistration/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/clock"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	typedadmregv1beta1 "k8s.io/client-go/kubernetes/typed/admissionregistration/v1beta1"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+)
+
+const (
+	// the keys of the Secret the certificates are kept in
+	caCertKey      = "ca.crt"
+	caKeyKey       = "ca.key"
+	servingCertKey = "server.crt"
+	servingKeyKey  = "server.key"
+
+	// syncPeriod is how often the certificates are checked for renewal, and the caBundles are patched.
+	// The webhook configurations are also watched, so that their caBundles are patched as soon as they
+	// are reset, e.g. by a helm upgrade
+	syncPeriod = time.Minute
+
+	// apiServicesPath is the path of the APIServices, which have no client in client-go
+	apiServicesPath = "/apis/apiregistration.k8s.io/v1beta1/apiservices"
+)
+
+// Controller keeps the certificates of the webhooks and APIServices of a Service
+// in a Secret, writes the serving certificate into a directory, and patches the CA
+// into the caBundle of the webhook configurations and APIServices of the Service
+type Controller struct {
+	logger     *logrus.Entry
+	service    string
+	namespace  string
+	secretName string
+	certDir    string
+	clock      clock.Clock
+
+	secretGetter   typedcorev1.SecretsGetter
+	webhookConfigs typedadmregv1beta1.AdmissionregistrationV1beta1Interface
+	apiServices    rest.Interface
+
+	informerFactory  informers.SharedInformerFactory
+	validatingSynced cache.InformerSynced
+	mutatingSynced   cache.InformerSynced
+	// resync is sent to when the caBundle of a webhook of the Service is not the CA
+	resync chan struct{}
+
+	mutex sync.Mutex
+	// ca is the CA the caBundles were last patched with
+	ca []byte
+}
+
+// NewController returns a Controller for the certificates of the given Service,
+// that are kept in the given Secret, and written into the given directory
+func NewController(service, namespace, secretName, certDir string, kubeClient kubernetes.Interface) *Controller {
+	informerFactory := informers.NewSharedInformerFactory(kubeClient, 0)
+	validating := informerFactory.Admissionregistration().V1beta1().ValidatingWebhookConfigurations().Informer()
+	mutating := informerFactory.Admissionregistration().V1beta1().MutatingWebhookConfigurations().Informer()
+
+	c := &Controller{
+		service:          service,
+		namespace:        namespace,
+		secretName:       secretName,
+		certDir:          certDir,
+		clock:            clock.RealClock{},
+		secretGetter:     kubeClient.CoreV1(),
+		webhookConfigs:   kubeClient.AdmissionregistrationV1beta1(),
+		apiServices:      kubeClient.Discovery().RESTClient(),
+		informerFactory:  informerFactory,
+		validatingSynced: validating.HasSynced,
+		mutatingSynced:   mutating.HasSynced,
+		resync:           make(chan struct{}, 1),
+	}
+	c.logger = runtime.NewLoggerWithType(c)
+
+	handler := cache.ResourceEventHandlerFuncs{
+		AddFunc: c.syncWebhookConfig,
+		UpdateFunc: func(_, newObj interface{}) {
+			c.syncWebhookConfig(newObj)
+		},
+	}
+	validating.AddEventHandler(handler)
+	mutating.AddEventHandler(handler)
+
+	return c
+}
+
+// CertFile returns the file of the serving certificate
+func (c *Controller) CertFile() string {
+	return filepath.Join(c.certDir, servingCertKey)
+}
+
+// KeyFile returns the file of the key of the serving certificate
+func (c *Controller) KeyFile() string {
+	return filepath.Join(c.certDir, servingKeyKey)
+}
+
+// Run syncs the certificates every syncPeriod, and when the caBundle of a webhook of the Service is reset,
+// until the stop channel is closed. Sync should be called before, so the certificates exist when the https server starts.
+func (c *Controller) Run(_ int, stop <-chan struct{}) error {
+	c.informerFactory.Start(stop)
+	if !cache.WaitForCacheSync(stop, c.validatingSynced, c.mutatingSynced) {
+		return errors.New("failed to wait for caches to sync")
+	}
+
+	ticker := time.NewTicker(syncPeriod)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return nil
+		case <-ticker.C:
+		case <-c.resync:
+		}
+		if err := c.Sync(); err != nil {
+			runtime.HandleError(c.logger, err)
+		}
+	}
+}
+
+// syncWebhookConfig triggers a sync if the caBundle of a webhook of the Service in the webhook configuration
+// is not the CA, so that admission requests don't fail until the next periodic sync
+func (c *Controller) syncWebhookConfig(obj interface{}) {
+	var webhooks []admregv1beta1.Webhook
+	switch config := obj.(type) {
+	case *admregv1beta1.ValidatingWebhookConfiguration:
+		webhooks = config.Webhooks
+	case *admregv1beta1.MutatingWebhookConfiguration:
+		webhooks = config.Webhooks
+	default:
+		return
+	}
+
+	c.mutex.Lock()
+	ca := c.ca
+	c.mutex.Unlock()
+	if ca == nil {
+		return
+	}
+	for _, w := range webhooks {
+		if c.ownWebhook(w) && !bytes.Equal(w.ClientConfig.CABundle, ca) {
+			select {
+			case c.resync <- struct{}{}:
+			default:
+				// a sync is already pending
+			}
+			return
+		}
+	}
+}
+
+// Sync generates or renews the certificates, writes the serving certificate into the directory,
+// and patches the caBundle of the webhook configurations and APIServices of the Service
+func (c *Controller) Sync() error {
+	secret, err := c.ensureSecret()
+	if err != nil {
+		return err
+	}
+	if err := c.writeFiles(secret.Data); err != nil {
+		return err
+	}
+	if err := c.patchWebhookConfigs(secret.Data[caCertKey]); err != nil {
+		return err
+	}
+	c.mutex.Lock()
+	c.ca = secret.Data[caCertKey]
+	c.mutex.Unlock()
+	return c.patchAPIServices(secret.Data[caCertKey])
+}
+
+// dnsNames returns the DNS names the Service is reached on from the API server
+func (c *Controller) dnsNames() []string {
+	return []string{c.service, c.service + "." + c.namespace, c.service + "." + c.namespace + ".svc"}
+}
+
+// ensureSecret returns the Secret of the certificates, after creating it, or renewing its certificates if needed
+func (c *Controller) ensureSecret() (*corev1.Secret, error) {
+	secret, err := c.secretGetter.Secrets(c.namespace).Get(c.secretName, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: c.secretName, Namespace: c.namespace,
+				Labels: map[string]string{agones.GroupName + "/role": "webhook-certs"}},
+			Type: corev1.SecretTypeOpaque,
+			Data: map[string][]byte{},
+		}
+		if _, err = c.renew(secret.Data); err != nil {
+			return nil, err
+		}
+		c.logger.WithField("secret", c.secretName).Info("Creating the Secret of the webhook certificates")
+		created, err := c.secretGetter.Secrets(c.namespace).Create(secret)
+		if k8serrors.IsAlreadyExists(err) {
+			// created by another instance of the controller since
+			created, err = c.secretGetter.Secrets(c.namespace).Get(c.secretName, metav1.GetOptions{})
+		}
+		return created, errors.Wrapf(err, "error creating Secret %s", c.secretName)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting Secret %s", c.secretName)
+	}
+
+	secret = secret.DeepCopy()
+	if secret.Data == nil {
+		secret.Data = map[string][]byte{}
+	}
+	renewed, err := c.renew(secret.Data)
+	if err != nil || !renewed {
+		return secret, err
+	}
+	c.logger.WithField("secret", c.secretName).Info("Renewing the webhook certificates")
+	secret, err = c.secretGetter.Secrets(c.namespace).Update(secret)
+	return secret, errors.Wrapf(err, "error updating Secret %s", c.secretName)
+}
+
+// renew generates a new CA, if the current one is missing, invalid or about to expire, and a new serving
+// certificate, if there is a new CA, or the current one is missing, invalid or about to expire.
+// It returns whether the data of the Secret was changed.
+func (c *Controller) renew(data map[string][]byte) (bool, error) {
+	now := c.clock.Now()
+	if !validCA(data[caCertKey], data[caKeyKey], now) {
+		cert, key, err := newCA(now)
+		if err != nil {
+			return false, err
+		}
+		data[caCertKey], data[caKeyKey] = cert, key
+	} else if validServingCert(data[servingCertKey], data[servingKeyKey], data[caCertKey], c.dnsNames(), now) {
+		return false, nil
+	}
+
+	cert, key, err := newServingCert(data[caCertKey], data[caKeyKey], c.dnsNames(), now)
+	if err != nil {
+		return false, err
+	}
+	data[servingCertKey], data[servingKeyKey] = cert, key
+	return true, nil
+}
+
+// writeFiles writes the serving certificate and key into the directory, if they changed.
+// Each file is replaced atomically, and the key is written first, so that a reload
+// in between fails, and the current certificate is kept until the second file is written.
+func (c *Controller) writeFiles(data map[string][]byte) error {
+	if err := os.MkdirAll(c.certDir, 0700); err != nil {
+		return errors.Wrapf(err, "could not create the certificate directory %s", c.certDir)
+	}
+	for _, key := range []string{servingKeyKey, servingCertKey} {
+		file := filepath.Join(c.certDir, key)
+		if current, err := ioutil.ReadFile(file); err == nil && bytes.Equal(current, data[key]) {
+			continue
+		}
+		tmp := file + ".tmp"
+		if err := ioutil.WriteFile(tmp, data[key], 0600); err != nil {
+			return errors.Wrapf(err, "could not write %s", tmp)
+		}
+		if err := os.Rename(tmp, file); err != nil {
+			return errors.Wrapf(err, "could not replace %s", file)
+		}
+	}
+	return nil
+}
+
+// ownWebhook returns whether the webhook calls the Service
+func (c *Controller) ownWebhook(w admregv1beta1.Webhook) bool {
+	s := w.ClientConfig.Service
+	return s != nil && s.Name == c.service && s.Namespace == c.namespace
+}
+
+// setCABundle sets the CA as the caBundle of the webhooks of the Service, and returns whether any changed
+func (c *Controller) setCABundle(webhooks []admregv1beta1.Webhook, ca []byte) bool {
+	changed := false
+	for i := range webhooks {
+		if !c.ownWebhook(webhooks[i]) || bytes.Equal(webhooks[i].ClientConfig.CABundle, ca) {
+			continue
+		}
+		webhooks[i].ClientConfig.CABundle = ca
+		changed = true
+	}
+	return changed
+}
+
+// patchWebhookConfigs sets the CA as the caBundle of the validating and mutating webhooks of the Service
+func (c *Controller) patchWebhookConfigs(ca []byte) error {
+	validating, err := c.webhookConfigs.ValidatingWebhookConfigurations().List(metav1.ListOptions{})
+	if err != nil {
+		return errors.Wrap(err, "error listing ValidatingWebhookConfigurations")
+	}
+	for i := range validating.Items {
+		config := &validating.Items[i]
+		if !c.setCABundle(config.Webhooks, ca) {
+			continue
+		}
+		c.logger.WithField("config", config.ObjectMeta.Name).Info("Patching the caBundle of ValidatingWebhookConfiguration")
+		if _, err := c.webhookConfigs.ValidatingWebhookConfigurations().Update(config); err != nil {
+			return errors.Wrapf(err, "error updating ValidatingWebhookConfiguration %s", config.ObjectMeta.Name)
+		}
+	}
+
+	mutating, err := c.webhookConfigs.MutatingWebhookConfigurations().List(metav1.ListOptions{})
+	if err != nil {
+		return errors.Wrap(err, "error listing MutatingWebhookConfigurations")
+	}
+	for i := range mutating.Items {
+		config := &mutating.Items[i]
+		if !c.setCABundle(config.Webhooks, ca) {
+			continue
+		}
+		c.logger.WithField("config", config.ObjectMeta.Name).Info("Patching the caBundle of MutatingWebhookConfiguration")
+		if _, err := c.webhookConfigs.MutatingWebhookConfigurations().Update(config); err != nil {
+			return errors.Wrapf(err, "error updating MutatingWebhookConfiguration %s", config.ObjectMeta.Name)
+		}
+	}
+	return nil
+}
+
+// apiServiceList is the part of a list of APIServices that the caBundle is patched from
+type apiServiceList struct {
+	Items []struct {
+		Metadata struct {
+			Name string `json:"name"`
+		} `json:"metadata"`
+		Spec struct {
+			Service *struct {
+				Name      string `json:"name"`
+				Namespace string `json:"namespace"`
+			} `json:"service"`
+			CABundle []byte `json:"caBundle"`
+		} `json:"spec"`
+	} `json:"items"`
+}
+
+// patchAPIServices sets the CA as the caBundle of the APIServices of the Service,
+// unless the API server doesn't serve APIServices
+func (c *Controller) patchAPIServices(ca []byte) error {
+	raw, err := c.apiServices.Get().AbsPath(apiServicesPath).Do().Raw()
+	if k8serrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "error listing APIServices")
+	}
+	list := apiServiceList{}
+	if err := json.Unmarshal(raw, &list); err != nil {
+		return errors.Wrap(err, "error unmarshalling APIServices")
+	}
+
+	patch, err := json.Marshal(map[string]interface{}{"spec": map[string]interface{}{"caBundle": ca}})
+	if err != nil {
+		return errors.Wrap(err, "error marshalling the caBundle patch")
+	}
+	for _, s := range list.Items {
+		if s.Spec.Service == nil || s.Spec.Service.Name != c.service || s.Spec.Service.Namespace != c.namespace ||
+			bytes.Equal(s.Spec.CABundle, ca) {
+			continue
+		}
+		c.logger.WithField("apiService", s.Metadata.Name).Info("Patching the caBundle of APIService")
+		if err := c.apiServices.Patch(types.MergePatchType).AbsPath(apiServicesPath, s.Metadata.Name).Body(patch).Do().Error(); err != nil {
+			return errors.Wrapf(err, "error patching APIService %s", s.Metadata.Name)
+		}
+	}
+	return nil
+}
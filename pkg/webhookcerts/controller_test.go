@@ -0,0 +1,211 @@
+// Copyright 2019 Google LLC All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhookcerts
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	admregv1beta1 "k8s.io/api/admissionregistration/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/clock"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestControllerSync(t *testing.T) {
+	t.Parallel()
+
+	webhook := func(name, service string) admregv1beta1.Webhook {
+		return admregv1beta1.Webhook{Name: name, ClientConfig: admregv1beta1.WebhookClientConfig{
+			Service: &admregv1beta1.ServiceReference{Name: service, Namespace: "agones-system"}}}
+	}
+	kubeClient := kubefake.NewSimpleClientset(
+		&admregv1beta1.ValidatingWebhookConfiguration{ObjectMeta: metav1.ObjectMeta{Name: "agones-validation-webhook"},
+			Webhooks: []admregv1beta1.Webhook{webhook("validations.agones.dev", "agones-controller-service")}},
+		&admregv1beta1.ValidatingWebhookConfiguration{ObjectMeta: metav1.ObjectMeta{Name: "other"},
+			Webhooks: []admregv1beta1.Webhook{webhook("other.example.com", "other")}},
+		&admregv1beta1.MutatingWebhookConfiguration{ObjectMeta: metav1.ObjectMeta{Name: "agones-mutation-webhook"},
+			Webhooks: []admregv1beta1.Webhook{webhook("mutations.agones.dev", "agones-controller-service")}},
+	)
+	var updates []string
+	kubeClient.PrependReactor("*", "*", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if action.GetVerb() == "create" || action.GetVerb() == "update" {
+			updates = append(updates, action.GetVerb()+" "+action.GetResource().Resource)
+		}
+		return false, nil, nil
+	})
+
+	apiServer := &fakeAPIServiceServer{}
+	ts := httptest.NewServer(apiServer)
+	defer ts.Close()
+
+	dir, err := ioutil.TempDir("", "webhookcerts")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir) // nolint: errcheck
+
+	c := NewController("agones-controller-service", "agones-system", "agones-controller-self-managed-cert", filepath.Join(dir, "certs"), kubeClient)
+	fakeClock := clock.NewFakeClock(c.clock.Now())
+	c.clock = fakeClock
+	client, err := kubernetes.NewForConfig(&rest.Config{Host: ts.URL})
+	assert.NoError(t, err)
+	c.apiServices = client.Discovery().RESTClient()
+
+	assert.NoError(t, c.Sync())
+	assert.Equal(t, []string{"create secrets", "update validatingwebhookconfigurations", "update mutatingwebhookconfigurations"}, updates)
+
+	secret, err := kubeClient.CoreV1().Secrets("agones-system").Get("agones-controller-self-managed-cert", metav1.GetOptions{})
+	assert.NoError(t, err)
+	ca := secret.Data[caCertKey]
+	assert.True(t, validServingCert(secret.Data[servingCertKey], secret.Data[servingKeyKey], ca,
+		[]string{"agones-controller-service.agones-system.svc"}, fakeClock.Now()))
+
+	cert, err := ioutil.ReadFile(c.CertFile())
+	assert.NoError(t, err)
+	assert.Equal(t, secret.Data[servingCertKey], cert)
+	key, err := ioutil.ReadFile(c.KeyFile())
+	assert.NoError(t, err)
+	assert.Equal(t, secret.Data[servingKeyKey], key)
+
+	validating, err := kubeClient.AdmissionregistrationV1beta1().ValidatingWebhookConfigurations().Get("agones-validation-webhook", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, ca, validating.Webhooks[0].ClientConfig.CABundle)
+	other, err := kubeClient.AdmissionregistrationV1beta1().ValidatingWebhookConfigurations().Get("other", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Empty(t, other.Webhooks[0].ClientConfig.CABundle)
+	mutating, err := kubeClient.AdmissionregistrationV1beta1().MutatingWebhookConfigurations().Get("agones-mutation-webhook", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, ca, mutating.Webhooks[0].ClientConfig.CABundle)
+
+	assert.Equal(t, []string{"v1.allocation.agones.dev"}, apiServer.patched)
+	assert.Equal(t, ca, apiServer.caBundle)
+
+	// nothing changes
+	updates = nil
+	assert.NoError(t, c.Sync())
+	assert.Empty(t, updates)
+
+	// the serving certificate is renewed before it expires, with the same CA
+	fakeClock.Step(servingValidity - renewBefore + 1)
+	assert.NoError(t, c.Sync())
+	assert.Equal(t, []string{"update secrets"}, updates)
+	secret, err = kubeClient.CoreV1().Secrets("agones-system").Get("agones-controller-self-managed-cert", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, ca, secret.Data[caCertKey])
+	assert.NotEqual(t, cert, secret.Data[servingCertKey])
+	cert, err = ioutil.ReadFile(c.CertFile())
+	assert.NoError(t, err)
+	assert.Equal(t, secret.Data[servingCertKey], cert)
+}
+
+func TestControllerRun(t *testing.T) {
+	t.Parallel()
+
+	kubeClient := kubefake.NewSimpleClientset(
+		&admregv1beta1.ValidatingWebhookConfiguration{ObjectMeta: metav1.ObjectMeta{Name: "agones-validation-webhook"},
+			Webhooks: []admregv1beta1.Webhook{{Name: "validations.agones.dev", ClientConfig: admregv1beta1.WebhookClientConfig{
+				Service: &admregv1beta1.ServiceReference{Name: "agones-controller-service", Namespace: "agones-system"}}}}},
+	)
+
+	ts := httptest.NewServer(&fakeAPIServiceServer{})
+	defer ts.Close()
+	dir, err := ioutil.TempDir("", "webhookcerts")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir) // nolint: errcheck
+
+	c := NewController("agones-controller-service", "agones-system", "agones-controller-self-managed-cert", filepath.Join(dir, "certs"), kubeClient)
+	client, err := kubernetes.NewForConfig(&rest.Config{Host: ts.URL})
+	assert.NoError(t, err)
+	c.apiServices = client.Discovery().RESTClient()
+
+	assert.NoError(t, c.Sync())
+	secret, err := kubeClient.CoreV1().Secrets("agones-system").Get("agones-controller-self-managed-cert", metav1.GetOptions{})
+	assert.NoError(t, err)
+	ca := secret.Data[caCertKey]
+
+	stop := make(chan struct{})
+	defer close(stop)
+	go c.Run(1, stop) // nolint: errcheck
+
+	// a helm upgrade resets the caBundle, which is patched straight away, rather than on the next periodic sync
+	configs := kubeClient.AdmissionregistrationV1beta1().ValidatingWebhookConfigurations()
+	config, err := configs.Get("agones-validation-webhook", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, ca, config.Webhooks[0].ClientConfig.CABundle)
+	config.Webhooks[0].ClientConfig.CABundle = nil
+	_, err = configs.Update(config)
+	assert.NoError(t, err)
+
+	err = wait.PollImmediate(10*time.Millisecond, 5*time.Second, func() (bool, error) {
+		config, err := configs.Get("agones-validation-webhook", metav1.GetOptions{})
+		return err == nil && bytes.Equal(ca, config.Webhooks[0].ClientConfig.CABundle), err
+	})
+	assert.NoError(t, err)
+}
+
+// fakeAPIServiceServer serves the APIService of the allocation API, and records the caBundle it is patched with
+type fakeAPIServiceServer struct {
+	mu       sync.Mutex
+	caBundle []byte
+	patched  []string
+}
+
+func (s *fakeAPIServiceServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == apiServicesPath:
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{"items": []interface{}{ // nolint: errcheck
+			map[string]interface{}{
+				"metadata": map[string]interface{}{"name": "v1.allocation.agones.dev"},
+				"spec": map[string]interface{}{
+					"service":  map[string]interface{}{"name": "agones-controller-service", "namespace": "agones-system"},
+					"caBundle": s.caBundle,
+				},
+			},
+		}})
+	case r.Method == http.MethodPatch && r.URL.Path == apiServicesPath+"/v1.allocation.agones.dev":
+		patch := struct {
+			Spec struct {
+				CABundle []byte `json:"caBundle"`
+			} `json:"spec"`
+		}{}
+		if err := json.NewDecoder(r.Body).Decode(&patch); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		s.caBundle = patch.Spec.CABundle
+		s.patched = append(s.patched, "v1.allocation.agones.dev")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte("{}")) // nolint: errcheck
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
@@ -144,6 +144,38 @@ class SDK final {
     std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::agones::dev::sdk::Empty>> PrepareAsyncReserve(::grpc::ClientContext* context, const ::agones::dev::sdk::Duration& request, ::grpc::CompletionQueue* cq) {
       return std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::agones::dev::sdk::Empty>>(PrepareAsyncReserveRaw(context, request, cq));
     }
+    // Increments the count of a Counter on the backing GameServer. A negative amount decrements it.
+    virtual ::grpc::Status IncrementCounter(::grpc::ClientContext* context, const ::agones::dev::sdk::CounterUpdate& request, ::agones::dev::sdk::Empty* response) = 0;
+    std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::agones::dev::sdk::Empty>> AsyncIncrementCounter(::grpc::ClientContext* context, const ::agones::dev::sdk::CounterUpdate& request, ::grpc::CompletionQueue* cq) {
+      return std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::agones::dev::sdk::Empty>>(AsyncIncrementCounterRaw(context, request, cq));
+    }
+    std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::agones::dev::sdk::Empty>> PrepareAsyncIncrementCounter(::grpc::ClientContext* context, const ::agones::dev::sdk::CounterUpdate& request, ::grpc::CompletionQueue* cq) {
+      return std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::agones::dev::sdk::Empty>>(PrepareAsyncIncrementCounterRaw(context, request, cq));
+    }
+    // Sets the capacity of a Counter on the backing GameServer
+    virtual ::grpc::Status SetCounterCapacity(::grpc::ClientContext* context, const ::agones::dev::sdk::CounterUpdate& request, ::agones::dev::sdk::Empty* response) = 0;
+    std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::agones::dev::sdk::Empty>> AsyncSetCounterCapacity(::grpc::ClientContext* context, const ::agones::dev::sdk::CounterUpdate& request, ::grpc::CompletionQueue* cq) {
+      return std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::agones::dev::sdk::Empty>>(AsyncSetCounterCapacityRaw(context, request, cq));
+    }
+    std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::agones::dev::sdk::Empty>> PrepareAsyncSetCounterCapacity(::grpc::ClientContext* context, const ::agones::dev::sdk::CounterUpdate& request, ::grpc::CompletionQueue* cq) {
+      return std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::agones::dev::sdk::Empty>>(PrepareAsyncSetCounterCapacityRaw(context, request, cq));
+    }
+    // Appends a value to a List on the backing GameServer
+    virtual ::grpc::Status AppendListValue(::grpc::ClientContext* context, const ::agones::dev::sdk::ListValue& request, ::agones::dev::sdk::Empty* response) = 0;
+    std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::agones::dev::sdk::Empty>> AsyncAppendListValue(::grpc::ClientContext* context, const ::agones::dev::sdk::ListValue& request, ::grpc::CompletionQueue* cq) {
+      return std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::agones::dev::sdk::Empty>>(AsyncAppendListValueRaw(context, request, cq));
+    }
+    std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::agones::dev::sdk::Empty>> PrepareAsyncAppendListValue(::grpc::ClientContext* context, const ::agones::dev::sdk::ListValue& request, ::grpc::CompletionQueue* cq) {
+      return std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::agones::dev::sdk::Empty>>(PrepareAsyncAppendListValueRaw(context, request, cq));
+    }
+    // Removes a value from a List on the backing GameServer
+    virtual ::grpc::Status DeleteListValue(::grpc::ClientContext* context, const ::agones::dev::sdk::ListValue& request, ::agones::dev::sdk::Empty* response) = 0;
+    std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::agones::dev::sdk::Empty>> AsyncDeleteListValue(::grpc::ClientContext* context, const ::agones::dev::sdk::ListValue& request, ::grpc::CompletionQueue* cq) {
+      return std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::agones::dev::sdk::Empty>>(AsyncDeleteListValueRaw(context, request, cq));
+    }
+    std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::agones::dev::sdk::Empty>> PrepareAsyncDeleteListValue(::grpc::ClientContext* context, const ::agones::dev::sdk::ListValue& request, ::grpc::CompletionQueue* cq) {
+      return std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::agones::dev::sdk::Empty>>(PrepareAsyncDeleteListValueRaw(context, request, cq));
+    }
     class experimental_async_interface {
      public:
       virtual ~experimental_async_interface() {}
@@ -163,6 +195,14 @@ class SDK final {
       virtual void SetAnnotation(::grpc::ClientContext* context, const ::agones::dev::sdk::KeyValue* request, ::agones::dev::sdk::Empty* response, std::function<void(::grpc::Status)>) = 0;
       // Marks the GameServer as the Reserved state for Duration
       virtual void Reserve(::grpc::ClientContext* context, const ::agones::dev::sdk::Duration* request, ::agones::dev::sdk::Empty* response, std::function<void(::grpc::Status)>) = 0;
+      // Increments the count of a Counter on the backing GameServer. A negative amount decrements it.
+      virtual void IncrementCounter(::grpc::ClientContext* context, const ::agones::dev::sdk::CounterUpdate* request, ::agones::dev::sdk::Empty* response, std::function<void(::grpc::Status)>) = 0;
+      // Sets the capacity of a Counter on the backing GameServer
+      virtual void SetCounterCapacity(::grpc::ClientContext* context, const ::agones::dev::sdk::CounterUpdate* request, ::agones::dev::sdk::Empty* response, std::function<void(::grpc::Status)>) = 0;
+      // Appends a value to a List on the backing GameServer
+      virtual void AppendListValue(::grpc::ClientContext* context, const ::agones::dev::sdk::ListValue* request, ::agones::dev::sdk::Empty* response, std::function<void(::grpc::Status)>) = 0;
+      // Removes a value from a List on the backing GameServer
+      virtual void DeleteListValue(::grpc::ClientContext* context, const ::agones::dev::sdk::ListValue* request, ::agones::dev::sdk::Empty* response, std::function<void(::grpc::Status)>) = 0;
     };
     virtual class experimental_async_interface* experimental_async() { return nullptr; }
   private:
@@ -186,6 +226,14 @@ class SDK final {
     virtual ::grpc::ClientAsyncResponseReaderInterface< ::agones::dev::sdk::Empty>* PrepareAsyncSetAnnotationRaw(::grpc::ClientContext* context, const ::agones::dev::sdk::KeyValue& request, ::grpc::CompletionQueue* cq) = 0;
     virtual ::grpc::ClientAsyncResponseReaderInterface< ::agones::dev::sdk::Empty>* AsyncReserveRaw(::grpc::ClientContext* context, const ::agones::dev::sdk::Duration& request, ::grpc::CompletionQueue* cq) = 0;
     virtual ::grpc::ClientAsyncResponseReaderInterface< ::agones::dev::sdk::Empty>* PrepareAsyncReserveRaw(::grpc::ClientContext* context, const ::agones::dev::sdk::Duration& request, ::grpc::CompletionQueue* cq) = 0;
+    virtual ::grpc::ClientAsyncResponseReaderInterface< ::agones::dev::sdk::Empty>* AsyncIncrementCounterRaw(::grpc::ClientContext* context, const ::agones::dev::sdk::CounterUpdate& request, ::grpc::CompletionQueue* cq) = 0;
+    virtual ::grpc::ClientAsyncResponseReaderInterface< ::agones::dev::sdk::Empty>* PrepareAsyncIncrementCounterRaw(::grpc::ClientContext* context, const ::agones::dev::sdk::CounterUpdate& request, ::grpc::CompletionQueue* cq) = 0;
+    virtual ::grpc::ClientAsyncResponseReaderInterface< ::agones::dev::sdk::Empty>* AsyncSetCounterCapacityRaw(::grpc::ClientContext* context, const ::agones::dev::sdk::CounterUpdate& request, ::grpc::CompletionQueue* cq) = 0;
+    virtual ::grpc::ClientAsyncResponseReaderInterface< ::agones::dev::sdk::Empty>* PrepareAsyncSetCounterCapacityRaw(::grpc::ClientContext* context, const ::agones::dev::sdk::CounterUpdate& request, ::grpc::CompletionQueue* cq) = 0;
+    virtual ::grpc::ClientAsyncResponseReaderInterface< ::agones::dev::sdk::Empty>* AsyncAppendListValueRaw(::grpc::ClientContext* context, const ::agones::dev::sdk::ListValue& request, ::grpc::CompletionQueue* cq) = 0;
+    virtual ::grpc::ClientAsyncResponseReaderInterface< ::agones::dev::sdk::Empty>* PrepareAsyncAppendListValueRaw(::grpc::ClientContext* context, const ::agones::dev::sdk::ListValue& request, ::grpc::CompletionQueue* cq) = 0;
+    virtual ::grpc::ClientAsyncResponseReaderInterface< ::agones::dev::sdk::Empty>* AsyncDeleteListValueRaw(::grpc::ClientContext* context, const ::agones::dev::sdk::ListValue& request, ::grpc::CompletionQueue* cq) = 0;
+    virtual ::grpc::ClientAsyncResponseReaderInterface< ::agones::dev::sdk::Empty>* PrepareAsyncDeleteListValueRaw(::grpc::ClientContext* context, const ::agones::dev::sdk::ListValue& request, ::grpc::CompletionQueue* cq) = 0;
   };
   class Stub final : public StubInterface {
    public:
@@ -257,6 +305,34 @@ class SDK final {
     std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::agones::dev::sdk::Empty>> PrepareAsyncReserve(::grpc::ClientContext* context, const ::agones::dev::sdk::Duration& request, ::grpc::CompletionQueue* cq) {
       return std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::agones::dev::sdk::Empty>>(PrepareAsyncReserveRaw(context, request, cq));
     }
+    ::grpc::Status IncrementCounter(::grpc::ClientContext* context, const ::agones::dev::sdk::CounterUpdate& request, ::agones::dev::sdk::Empty* response) override;
+    std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::agones::dev::sdk::Empty>> AsyncIncrementCounter(::grpc::ClientContext* context, const ::agones::dev::sdk::CounterUpdate& request, ::grpc::CompletionQueue* cq) {
+      return std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::agones::dev::sdk::Empty>>(AsyncIncrementCounterRaw(context, request, cq));
+    }
+    std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::agones::dev::sdk::Empty>> PrepareAsyncIncrementCounter(::grpc::ClientContext* context, const ::agones::dev::sdk::CounterUpdate& request, ::grpc::CompletionQueue* cq) {
+      return std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::agones::dev::sdk::Empty>>(PrepareAsyncIncrementCounterRaw(context, request, cq));
+    }
+    ::grpc::Status SetCounterCapacity(::grpc::ClientContext* context, const ::agones::dev::sdk::CounterUpdate& request, ::agones::dev::sdk::Empty* response) override;
+    std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::agones::dev::sdk::Empty>> AsyncSetCounterCapacity(::grpc::ClientContext* context, const ::agones::dev::sdk::CounterUpdate& request, ::grpc::CompletionQueue* cq) {
+      return std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::agones::dev::sdk::Empty>>(AsyncSetCounterCapacityRaw(context, request, cq));
+    }
+    std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::agones::dev::sdk::Empty>> PrepareAsyncSetCounterCapacity(::grpc::ClientContext* context, const ::agones::dev::sdk::CounterUpdate& request, ::grpc::CompletionQueue* cq) {
+      return std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::agones::dev::sdk::Empty>>(PrepareAsyncSetCounterCapacityRaw(context, request, cq));
+    }
+    ::grpc::Status AppendListValue(::grpc::ClientContext* context, const ::agones::dev::sdk::ListValue& request, ::agones::dev::sdk::Empty* response) override;
+    std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::agones::dev::sdk::Empty>> AsyncAppendListValue(::grpc::ClientContext* context, const ::agones::dev::sdk::ListValue& request, ::grpc::CompletionQueue* cq) {
+      return std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::agones::dev::sdk::Empty>>(AsyncAppendListValueRaw(context, request, cq));
+    }
+    std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::agones::dev::sdk::Empty>> PrepareAsyncAppendListValue(::grpc::ClientContext* context, const ::agones::dev::sdk::ListValue& request, ::grpc::CompletionQueue* cq) {
+      return std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::agones::dev::sdk::Empty>>(PrepareAsyncAppendListValueRaw(context, request, cq));
+    }
+    ::grpc::Status DeleteListValue(::grpc::ClientContext* context, const ::agones::dev::sdk::ListValue& request, ::agones::dev::sdk::Empty* response) override;
+    std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::agones::dev::sdk::Empty>> AsyncDeleteListValue(::grpc::ClientContext* context, const ::agones::dev::sdk::ListValue& request, ::grpc::CompletionQueue* cq) {
+      return std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::agones::dev::sdk::Empty>>(AsyncDeleteListValueRaw(context, request, cq));
+    }
+    std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::agones::dev::sdk::Empty>> PrepareAsyncDeleteListValue(::grpc::ClientContext* context, const ::agones::dev::sdk::ListValue& request, ::grpc::CompletionQueue* cq) {
+      return std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::agones::dev::sdk::Empty>>(PrepareAsyncDeleteListValueRaw(context, request, cq));
+    }
     class experimental_async final :
       public StubInterface::experimental_async_interface {
      public:
@@ -267,6 +343,10 @@ class SDK final {
       void SetLabel(::grpc::ClientContext* context, const ::agones::dev::sdk::KeyValue* request, ::agones::dev::sdk::Empty* response, std::function<void(::grpc::Status)>) override;
       void SetAnnotation(::grpc::ClientContext* context, const ::agones::dev::sdk::KeyValue* request, ::agones::dev::sdk::Empty* response, std::function<void(::grpc::Status)>) override;
       void Reserve(::grpc::ClientContext* context, const ::agones::dev::sdk::Duration* request, ::agones::dev::sdk::Empty* response, std::function<void(::grpc::Status)>) override;
+      void IncrementCounter(::grpc::ClientContext* context, const ::agones::dev::sdk::CounterUpdate* request, ::agones::dev::sdk::Empty* response, std::function<void(::grpc::Status)>) override;
+      void SetCounterCapacity(::grpc::ClientContext* context, const ::agones::dev::sdk::CounterUpdate* request, ::agones::dev::sdk::Empty* response, std::function<void(::grpc::Status)>) override;
+      void AppendListValue(::grpc::ClientContext* context, const ::agones::dev::sdk::ListValue* request, ::agones::dev::sdk::Empty* response, std::function<void(::grpc::Status)>) override;
+      void DeleteListValue(::grpc::ClientContext* context, const ::agones::dev::sdk::ListValue* request, ::agones::dev::sdk::Empty* response, std::function<void(::grpc::Status)>) override;
      private:
       friend class Stub;
       explicit experimental_async(Stub* stub): stub_(stub) { }
@@ -298,6 +378,14 @@ class SDK final {
     ::grpc::ClientAsyncResponseReader< ::agones::dev::sdk::Empty>* PrepareAsyncSetAnnotationRaw(::grpc::ClientContext* context, const ::agones::dev::sdk::KeyValue& request, ::grpc::CompletionQueue* cq) override;
     ::grpc::ClientAsyncResponseReader< ::agones::dev::sdk::Empty>* AsyncReserveRaw(::grpc::ClientContext* context, const ::agones::dev::sdk::Duration& request, ::grpc::CompletionQueue* cq) override;
     ::grpc::ClientAsyncResponseReader< ::agones::dev::sdk::Empty>* PrepareAsyncReserveRaw(::grpc::ClientContext* context, const ::agones::dev::sdk::Duration& request, ::grpc::CompletionQueue* cq) override;
+    ::grpc::ClientAsyncResponseReader< ::agones::dev::sdk::Empty>* AsyncIncrementCounterRaw(::grpc::ClientContext* context, const ::agones::dev::sdk::CounterUpdate& request, ::grpc::CompletionQueue* cq) override;
+    ::grpc::ClientAsyncResponseReader< ::agones::dev::sdk::Empty>* PrepareAsyncIncrementCounterRaw(::grpc::ClientContext* context, const ::agones::dev::sdk::CounterUpdate& request, ::grpc::CompletionQueue* cq) override;
+    ::grpc::ClientAsyncResponseReader< ::agones::dev::sdk::Empty>* AsyncSetCounterCapacityRaw(::grpc::ClientContext* context, const ::agones::dev::sdk::CounterUpdate& request, ::grpc::CompletionQueue* cq) override;
+    ::grpc::ClientAsyncResponseReader< ::agones::dev::sdk::Empty>* PrepareAsyncSetCounterCapacityRaw(::grpc::ClientContext* context, const ::agones::dev::sdk::CounterUpdate& request, ::grpc::CompletionQueue* cq) override;
+    ::grpc::ClientAsyncResponseReader< ::agones::dev::sdk::Empty>* AsyncAppendListValueRaw(::grpc::ClientContext* context, const ::agones::dev::sdk::ListValue& request, ::grpc::CompletionQueue* cq) override;
+    ::grpc::ClientAsyncResponseReader< ::agones::dev::sdk::Empty>* PrepareAsyncAppendListValueRaw(::grpc::ClientContext* context, const ::agones::dev::sdk::ListValue& request, ::grpc::CompletionQueue* cq) override;
+    ::grpc::ClientAsyncResponseReader< ::agones::dev::sdk::Empty>* AsyncDeleteListValueRaw(::grpc::ClientContext* context, const ::agones::dev::sdk::ListValue& request, ::grpc::CompletionQueue* cq) override;
+    ::grpc::ClientAsyncResponseReader< ::agones::dev::sdk::Empty>* PrepareAsyncDeleteListValueRaw(::grpc::ClientContext* context, const ::agones::dev::sdk::ListValue& request, ::grpc::CompletionQueue* cq) override;
     const ::grpc::internal::RpcMethod rpcmethod_Ready_;
     const ::grpc::internal::RpcMethod rpcmethod_Allocate_;
     const ::grpc::internal::RpcMethod rpcmethod_Shutdown_;
@@ -307,6 +395,10 @@ class SDK final {
     const ::grpc::internal::RpcMethod rpcmethod_SetLabel_;
     const ::grpc::internal::RpcMethod rpcmethod_SetAnnotation_;
     const ::grpc::internal::RpcMethod rpcmethod_Reserve_;
+    const ::grpc::internal::RpcMethod rpcmethod_IncrementCounter_;
+    const ::grpc::internal::RpcMethod rpcmethod_SetCounterCapacity_;
+    const ::grpc::internal::RpcMethod rpcmethod_AppendListValue_;
+    const ::grpc::internal::RpcMethod rpcmethod_DeleteListValue_;
   };
   static std::unique_ptr<Stub> NewStub(const std::shared_ptr< ::grpc::ChannelInterface>& channel, const ::grpc::StubOptions& options = ::grpc::StubOptions());
 
@@ -332,6 +424,14 @@ class SDK final {
     virtual ::grpc::Status SetAnnotation(::grpc::ServerContext* context, const ::agones::dev::sdk::KeyValue* request, ::agones::dev::sdk::Empty* response);
     // Marks the GameServer as the Reserved state for Duration
     virtual ::grpc::Status Reserve(::grpc::ServerContext* context, const ::agones::dev::sdk::Duration* request, ::agones::dev::sdk::Empty* response);
+    // Increments the count of a Counter on the backing GameServer. A negative amount decrements it.
+    virtual ::grpc::Status IncrementCounter(::grpc::ServerContext* context, const ::agones::dev::sdk::CounterUpdate* request, ::agones::dev::sdk::Empty* response);
+    // Sets the capacity of a Counter on the backing GameServer
+    virtual ::grpc::Status SetCounterCapacity(::grpc::ServerContext* context, const ::agones::dev::sdk::CounterUpdate* request, ::agones::dev::sdk::Empty* response);
+    // Appends a value to a List on the backing GameServer
+    virtual ::grpc::Status AppendListValue(::grpc::ServerContext* context, const ::agones::dev::sdk::ListValue* request, ::agones::dev::sdk::Empty* response);
+    // Removes a value from a List on the backing GameServer
+    virtual ::grpc::Status DeleteListValue(::grpc::ServerContext* context, const ::agones::dev::sdk::ListValue* request, ::agones::dev::sdk::Empty* response);
   };
   template <class BaseClass>
   class WithAsyncMethod_Ready : public BaseClass {
@@ -513,7 +613,87 @@ class SDK final {
       ::grpc::Service::RequestAsyncUnary(8, context, request, response, new_call_cq, notification_cq, tag);
     }
   };
-  typedef WithAsyncMethod_Ready<WithAsyncMethod_Allocate<WithAsyncMethod_Shutdown<WithAsyncMethod_Health<WithAsyncMethod_GetGameServer<WithAsyncMethod_WatchGameServer<WithAsyncMethod_SetLabel<WithAsyncMethod_SetAnnotation<WithAsyncMethod_Reserve<Service > > > > > > > > > AsyncService;
+  template <class BaseClass>
+  class WithAsyncMethod_IncrementCounter : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service *service) {}
+   public:
+    WithAsyncMethod_IncrementCounter() {
+      ::grpc::Service::MarkMethodAsync(9);
+    }
+    ~WithAsyncMethod_IncrementCounter() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable synchronous version of this method
+    ::grpc::Status IncrementCounter(::grpc::ServerContext* context, const ::agones::dev::sdk::CounterUpdate* request, ::agones::dev::sdk::Empty* response) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+    void RequestIncrementCounter(::grpc::ServerContext* context, ::agones::dev::sdk::CounterUpdate* request, ::grpc::ServerAsyncResponseWriter< ::agones::dev::sdk::Empty>* response, ::grpc::CompletionQueue* new_call_cq, ::grpc::ServerCompletionQueue* notification_cq, void *tag) {
+      ::grpc::Service::RequestAsyncUnary(9, context, request, response, new_call_cq, notification_cq, tag);
+    }
+  };
+  template <class BaseClass>
+  class WithAsyncMethod_SetCounterCapacity : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service *service) {}
+   public:
+    WithAsyncMethod_SetCounterCapacity() {
+      ::grpc::Service::MarkMethodAsync(10);
+    }
+    ~WithAsyncMethod_SetCounterCapacity() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable synchronous version of this method
+    ::grpc::Status SetCounterCapacity(::grpc::ServerContext* context, const ::agones::dev::sdk::CounterUpdate* request, ::agones::dev::sdk::Empty* response) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+    void RequestSetCounterCapacity(::grpc::ServerContext* context, ::agones::dev::sdk::CounterUpdate* request, ::grpc::ServerAsyncResponseWriter< ::agones::dev::sdk::Empty>* response, ::grpc::CompletionQueue* new_call_cq, ::grpc::ServerCompletionQueue* notification_cq, void *tag) {
+      ::grpc::Service::RequestAsyncUnary(10, context, request, response, new_call_cq, notification_cq, tag);
+    }
+  };
+  template <class BaseClass>
+  class WithAsyncMethod_AppendListValue : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service *service) {}
+   public:
+    WithAsyncMethod_AppendListValue() {
+      ::grpc::Service::MarkMethodAsync(11);
+    }
+    ~WithAsyncMethod_AppendListValue() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable synchronous version of this method
+    ::grpc::Status AppendListValue(::grpc::ServerContext* context, const ::agones::dev::sdk::ListValue* request, ::agones::dev::sdk::Empty* response) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+    void RequestAppendListValue(::grpc::ServerContext* context, ::agones::dev::sdk::ListValue* request, ::grpc::ServerAsyncResponseWriter< ::agones::dev::sdk::Empty>* response, ::grpc::CompletionQueue* new_call_cq, ::grpc::ServerCompletionQueue* notification_cq, void *tag) {
+      ::grpc::Service::RequestAsyncUnary(11, context, request, response, new_call_cq, notification_cq, tag);
+    }
+  };
+  template <class BaseClass>
+  class WithAsyncMethod_DeleteListValue : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service *service) {}
+   public:
+    WithAsyncMethod_DeleteListValue() {
+      ::grpc::Service::MarkMethodAsync(12);
+    }
+    ~WithAsyncMethod_DeleteListValue() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable synchronous version of this method
+    ::grpc::Status DeleteListValue(::grpc::ServerContext* context, const ::agones::dev::sdk::ListValue* request, ::agones::dev::sdk::Empty* response) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+    void RequestDeleteListValue(::grpc::ServerContext* context, ::agones::dev::sdk::ListValue* request, ::grpc::ServerAsyncResponseWriter< ::agones::dev::sdk::Empty>* response, ::grpc::CompletionQueue* new_call_cq, ::grpc::ServerCompletionQueue* notification_cq, void *tag) {
+      ::grpc::Service::RequestAsyncUnary(12, context, request, response, new_call_cq, notification_cq, tag);
+    }
+  };
+  typedef WithAsyncMethod_Ready<WithAsyncMethod_Allocate<WithAsyncMethod_Shutdown<WithAsyncMethod_Health<WithAsyncMethod_GetGameServer<WithAsyncMethod_WatchGameServer<WithAsyncMethod_SetLabel<WithAsyncMethod_SetAnnotation<WithAsyncMethod_Reserve<WithAsyncMethod_IncrementCounter<WithAsyncMethod_SetCounterCapacity<WithAsyncMethod_AppendListValue<WithAsyncMethod_DeleteListValue<Service > > > > > > > > > > > > > AsyncService;
   template <class BaseClass>
   class WithGenericMethod_Ready : public BaseClass {
    private:
@@ -668,6 +848,74 @@ class SDK final {
     }
   };
   template <class BaseClass>
+  class WithGenericMethod_IncrementCounter : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service *service) {}
+   public:
+    WithGenericMethod_IncrementCounter() {
+      ::grpc::Service::MarkMethodGeneric(9);
+    }
+    ~WithGenericMethod_IncrementCounter() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable synchronous version of this method
+    ::grpc::Status IncrementCounter(::grpc::ServerContext* context, const ::agones::dev::sdk::CounterUpdate* request, ::agones::dev::sdk::Empty* response) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+  };
+  template <class BaseClass>
+  class WithGenericMethod_SetCounterCapacity : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service *service) {}
+   public:
+    WithGenericMethod_SetCounterCapacity() {
+      ::grpc::Service::MarkMethodGeneric(10);
+    }
+    ~WithGenericMethod_SetCounterCapacity() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable synchronous version of this method
+    ::grpc::Status SetCounterCapacity(::grpc::ServerContext* context, const ::agones::dev::sdk::CounterUpdate* request, ::agones::dev::sdk::Empty* response) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+  };
+  template <class BaseClass>
+  class WithGenericMethod_AppendListValue : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service *service) {}
+   public:
+    WithGenericMethod_AppendListValue() {
+      ::grpc::Service::MarkMethodGeneric(11);
+    }
+    ~WithGenericMethod_AppendListValue() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable synchronous version of this method
+    ::grpc::Status AppendListValue(::grpc::ServerContext* context, const ::agones::dev::sdk::ListValue* request, ::agones::dev::sdk::Empty* response) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+  };
+  template <class BaseClass>
+  class WithGenericMethod_DeleteListValue : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service *service) {}
+   public:
+    WithGenericMethod_DeleteListValue() {
+      ::grpc::Service::MarkMethodGeneric(12);
+    }
+    ~WithGenericMethod_DeleteListValue() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable synchronous version of this method
+    ::grpc::Status DeleteListValue(::grpc::ServerContext* context, const ::agones::dev::sdk::ListValue* request, ::agones::dev::sdk::Empty* response) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+  };
+  template <class BaseClass>
   class WithRawMethod_Ready : public BaseClass {
    private:
     void BaseClassMustBeDerivedFromService(const Service *service) {}
@@ -848,6 +1096,86 @@ class SDK final {
     }
   };
   template <class BaseClass>
+  class WithRawMethod_IncrementCounter : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service *service) {}
+   public:
+    WithRawMethod_IncrementCounter() {
+      ::grpc::Service::MarkMethodRaw(9);
+    }
+    ~WithRawMethod_IncrementCounter() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable synchronous version of this method
+    ::grpc::Status IncrementCounter(::grpc::ServerContext* context, const ::agones::dev::sdk::CounterUpdate* request, ::agones::dev::sdk::Empty* response) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+    void RequestIncrementCounter(::grpc::ServerContext* context, ::grpc::ByteBuffer* request, ::grpc::ServerAsyncResponseWriter< ::grpc::ByteBuffer>* response, ::grpc::CompletionQueue* new_call_cq, ::grpc::ServerCompletionQueue* notification_cq, void *tag) {
+      ::grpc::Service::RequestAsyncUnary(9, context, request, response, new_call_cq, notification_cq, tag);
+    }
+  };
+  template <class BaseClass>
+  class WithRawMethod_SetCounterCapacity : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service *service) {}
+   public:
+    WithRawMethod_SetCounterCapacity() {
+      ::grpc::Service::MarkMethodRaw(10);
+    }
+    ~WithRawMethod_SetCounterCapacity() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable synchronous version of this method
+    ::grpc::Status SetCounterCapacity(::grpc::ServerContext* context, const ::agones::dev::sdk::CounterUpdate* request, ::agones::dev::sdk::Empty* response) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+    void RequestSetCounterCapacity(::grpc::ServerContext* context, ::grpc::ByteBuffer* request, ::grpc::ServerAsyncResponseWriter< ::grpc::ByteBuffer>* response, ::grpc::CompletionQueue* new_call_cq, ::grpc::ServerCompletionQueue* notification_cq, void *tag) {
+      ::grpc::Service::RequestAsyncUnary(10, context, request, response, new_call_cq, notification_cq, tag);
+    }
+  };
+  template <class BaseClass>
+  class WithRawMethod_AppendListValue : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service *service) {}
+   public:
+    WithRawMethod_AppendListValue() {
+      ::grpc::Service::MarkMethodRaw(11);
+    }
+    ~WithRawMethod_AppendListValue() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable synchronous version of this method
+    ::grpc::Status AppendListValue(::grpc::ServerContext* context, const ::agones::dev::sdk::ListValue* request, ::agones::dev::sdk::Empty* response) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+    void RequestAppendListValue(::grpc::ServerContext* context, ::grpc::ByteBuffer* request, ::grpc::ServerAsyncResponseWriter< ::grpc::ByteBuffer>* response, ::grpc::CompletionQueue* new_call_cq, ::grpc::ServerCompletionQueue* notification_cq, void *tag) {
+      ::grpc::Service::RequestAsyncUnary(11, context, request, response, new_call_cq, notification_cq, tag);
+    }
+  };
+  template <class BaseClass>
+  class WithRawMethod_DeleteListValue : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service *service) {}
+   public:
+    WithRawMethod_DeleteListValue() {
+      ::grpc::Service::MarkMethodRaw(12);
+    }
+    ~WithRawMethod_DeleteListValue() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable synchronous version of this method
+    ::grpc::Status DeleteListValue(::grpc::ServerContext* context, const ::agones::dev::sdk::ListValue* request, ::agones::dev::sdk::Empty* response) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+    void RequestDeleteListValue(::grpc::ServerContext* context, ::grpc::ByteBuffer* request, ::grpc::ServerAsyncResponseWriter< ::grpc::ByteBuffer>* response, ::grpc::CompletionQueue* new_call_cq, ::grpc::ServerCompletionQueue* notification_cq, void *tag) {
+      ::grpc::Service::RequestAsyncUnary(12, context, request, response, new_call_cq, notification_cq, tag);
+    }
+  };
+  template <class BaseClass>
   class WithStreamedUnaryMethod_Ready : public BaseClass {
    private:
     void BaseClassMustBeDerivedFromService(const Service *service) {}
@@ -987,7 +1315,87 @@ class SDK final {
     // replace default version of method with streamed unary
     virtual ::grpc::Status StreamedReserve(::grpc::ServerContext* context, ::grpc::ServerUnaryStreamer< ::agones::dev::sdk::Duration,::agones::dev::sdk::Empty>* server_unary_streamer) = 0;
   };
-  typedef WithStreamedUnaryMethod_Ready<WithStreamedUnaryMethod_Allocate<WithStreamedUnaryMethod_Shutdown<WithStreamedUnaryMethod_GetGameServer<WithStreamedUnaryMethod_SetLabel<WithStreamedUnaryMethod_SetAnnotation<WithStreamedUnaryMethod_Reserve<Service > > > > > > > StreamedUnaryService;
+  template <class BaseClass>
+  class WithStreamedUnaryMethod_IncrementCounter : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service *service) {}
+   public:
+    WithStreamedUnaryMethod_IncrementCounter() {
+      ::grpc::Service::MarkMethodStreamed(9,
+        new ::grpc::internal::StreamedUnaryHandler< ::agones::dev::sdk::CounterUpdate, ::agones::dev::sdk::Empty>(std::bind(&WithStreamedUnaryMethod_IncrementCounter<BaseClass>::StreamedIncrementCounter, this, std::placeholders::_1, std::placeholders::_2)));
+    }
+    ~WithStreamedUnaryMethod_IncrementCounter() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable regular version of this method
+    ::grpc::Status IncrementCounter(::grpc::ServerContext* context, const ::agones::dev::sdk::CounterUpdate* request, ::agones::dev::sdk::Empty* response) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+    // replace default version of method with streamed unary
+    virtual ::grpc::Status StreamedIncrementCounter(::grpc::ServerContext* context, ::grpc::ServerUnaryStreamer< ::agones::dev::sdk::CounterUpdate,::agones::dev::sdk::Empty>* server_unary_streamer) = 0;
+  };
+  template <class BaseClass>
+  class WithStreamedUnaryMethod_SetCounterCapacity : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service *service) {}
+   public:
+    WithStreamedUnaryMethod_SetCounterCapacity() {
+      ::grpc::Service::MarkMethodStreamed(10,
+        new ::grpc::internal::StreamedUnaryHandler< ::agones::dev::sdk::CounterUpdate, ::agones::dev::sdk::Empty>(std::bind(&WithStreamedUnaryMethod_SetCounterCapacity<BaseClass>::StreamedSetCounterCapacity, this, std::placeholders::_1, std::placeholders::_2)));
+    }
+    ~WithStreamedUnaryMethod_SetCounterCapacity() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable regular version of this method
+    ::grpc::Status SetCounterCapacity(::grpc::ServerContext* context, const ::agones::dev::sdk::CounterUpdate* request, ::agones::dev::sdk::Empty* response) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+    // replace default version of method with streamed unary
+    virtual ::grpc::Status StreamedSetCounterCapacity(::grpc::ServerContext* context, ::grpc::ServerUnaryStreamer< ::agones::dev::sdk::CounterUpdate,::agones::dev::sdk::Empty>* server_unary_streamer) = 0;
+  };
+  template <class BaseClass>
+  class WithStreamedUnaryMethod_AppendListValue : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service *service) {}
+   public:
+    WithStreamedUnaryMethod_AppendListValue() {
+      ::grpc::Service::MarkMethodStreamed(11,
+        new ::grpc::internal::StreamedUnaryHandler< ::agones::dev::sdk::ListValue, ::agones::dev::sdk::Empty>(std::bind(&WithStreamedUnaryMethod_AppendListValue<BaseClass>::StreamedAppendListValue, this, std::placeholders::_1, std::placeholders::_2)));
+    }
+    ~WithStreamedUnaryMethod_AppendListValue() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable regular version of this method
+    ::grpc::Status AppendListValue(::grpc::ServerContext* context, const ::agones::dev::sdk::ListValue* request, ::agones::dev::sdk::Empty* response) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+    // replace default version of method with streamed unary
+    virtual ::grpc::Status StreamedAppendListValue(::grpc::ServerContext* context, ::grpc::ServerUnaryStreamer< ::agones::dev::sdk::ListValue,::agones::dev::sdk::Empty>* server_unary_streamer) = 0;
+  };
+  template <class BaseClass>
+  class WithStreamedUnaryMethod_DeleteListValue : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service *service) {}
+   public:
+    WithStreamedUnaryMethod_DeleteListValue() {
+      ::grpc::Service::MarkMethodStreamed(12,
+        new ::grpc::internal::StreamedUnaryHandler< ::agones::dev::sdk::ListValue, ::agones::dev::sdk::Empty>(std::bind(&WithStreamedUnaryMethod_DeleteListValue<BaseClass>::StreamedDeleteListValue, this, std::placeholders::_1, std::placeholders::_2)));
+    }
+    ~WithStreamedUnaryMethod_DeleteListValue() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable regular version of this method
+    ::grpc::Status DeleteListValue(::grpc::ServerContext* context, const ::agones::dev::sdk::ListValue* request, ::agones::dev::sdk::Empty* response) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+    // replace default version of method with streamed unary
+    virtual ::grpc::Status StreamedDeleteListValue(::grpc::ServerContext* context, ::grpc::ServerUnaryStreamer< ::agones::dev::sdk::ListValue,::agones::dev::sdk::Empty>* server_unary_streamer) = 0;
+  };
+  typedef WithStreamedUnaryMethod_Ready<WithStreamedUnaryMethod_Allocate<WithStreamedUnaryMethod_Shutdown<WithStreamedUnaryMethod_GetGameServer<WithStreamedUnaryMethod_SetLabel<WithStreamedUnaryMethod_SetAnnotation<WithStreamedUnaryMethod_Reserve<WithStreamedUnaryMethod_IncrementCounter<WithStreamedUnaryMethod_SetCounterCapacity<WithStreamedUnaryMethod_AppendListValue<WithStreamedUnaryMethod_DeleteListValue<Service > > > > > > > > > > > StreamedUnaryService;
   template <class BaseClass>
   class WithSplitStreamingMethod_WatchGameServer : public BaseClass {
    private:
@@ -1009,7 +1417,7 @@ class SDK final {
     virtual ::grpc::Status StreamedWatchGameServer(::grpc::ServerContext* context, ::grpc::ServerSplitStreamer< ::agones::dev::sdk::Empty,::agones::dev::sdk::GameServer>* server_split_streamer) = 0;
   };
   typedef WithSplitStreamingMethod_WatchGameServer<Service > SplitStreamedService;
-  typedef WithStreamedUnaryMethod_Ready<WithStreamedUnaryMethod_Allocate<WithStreamedUnaryMethod_Shutdown<WithStreamedUnaryMethod_GetGameServer<WithSplitStreamingMethod_WatchGameServer<WithStreamedUnaryMethod_SetLabel<WithStreamedUnaryMethod_SetAnnotation<WithStreamedUnaryMethod_Reserve<Service > > > > > > > > StreamedService;
+  typedef WithStreamedUnaryMethod_Ready<WithStreamedUnaryMethod_Allocate<WithStreamedUnaryMethod_Shutdown<WithStreamedUnaryMethod_GetGameServer<WithSplitStreamingMethod_WatchGameServer<WithStreamedUnaryMethod_SetLabel<WithStreamedUnaryMethod_SetAnnotation<WithStreamedUnaryMethod_Reserve<WithStreamedUnaryMethod_IncrementCounter<WithStreamedUnaryMethod_SetCounterCapacity<WithStreamedUnaryMethod_AppendListValue<WithStreamedUnaryMethod_DeleteListValue<Service > > > > > > > > > > > > StreamedService;
 };
 
 }  // namespace sdk
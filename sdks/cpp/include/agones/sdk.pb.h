@@ -57,7 +57,7 @@ namespace protobuf_sdk_2eproto {
 struct AGONES_EXPORT TableStruct {
   static const ::google::protobuf::internal::ParseTableField entries[];
   static const ::google::protobuf::internal::AuxillaryParseTableField aux[];
-  static const ::google::protobuf::internal::ParseTable schema[11];
+  static const ::google::protobuf::internal::ParseTable schema[18];
   static const ::google::protobuf::internal::FieldMetadata field_metadata[];
   static const ::google::protobuf::internal::SerializationTable serialization_table[];
   static const ::google::protobuf::uint32 offsets[];
@@ -67,6 +67,9 @@ void AGONES_EXPORT AddDescriptors();
 namespace agones {
 namespace dev {
 namespace sdk {
+class CounterUpdate;
+class CounterUpdateDefaultTypeInternal;
+AGONES_EXPORT extern CounterUpdateDefaultTypeInternal _CounterUpdate_default_instance_;
 class Duration;
 class DurationDefaultTypeInternal;
 AGONES_EXPORT extern DurationDefaultTypeInternal _Duration_default_instance_;
@@ -94,17 +97,36 @@ AGONES_EXPORT extern GameServer_Spec_HealthDefaultTypeInternal _GameServer_Spec_
 class GameServer_Status;
 class GameServer_StatusDefaultTypeInternal;
 AGONES_EXPORT extern GameServer_StatusDefaultTypeInternal _GameServer_Status_default_instance_;
+class GameServer_Status_Counter;
+class GameServer_Status_CounterDefaultTypeInternal;
+AGONES_EXPORT extern GameServer_Status_CounterDefaultTypeInternal _GameServer_Status_Counter_default_instance_;
+class GameServer_Status_CountersEntry_DoNotUse;
+class GameServer_Status_CountersEntry_DoNotUseDefaultTypeInternal;
+AGONES_EXPORT extern GameServer_Status_CountersEntry_DoNotUseDefaultTypeInternal _GameServer_Status_CountersEntry_DoNotUse_default_instance_;
+class GameServer_Status_Disruption;
+class GameServer_Status_DisruptionDefaultTypeInternal;
+AGONES_EXPORT extern GameServer_Status_DisruptionDefaultTypeInternal _GameServer_Status_Disruption_default_instance_;
+class GameServer_Status_List;
+class GameServer_Status_ListDefaultTypeInternal;
+AGONES_EXPORT extern GameServer_Status_ListDefaultTypeInternal _GameServer_Status_List_default_instance_;
+class GameServer_Status_ListsEntry_DoNotUse;
+class GameServer_Status_ListsEntry_DoNotUseDefaultTypeInternal;
+AGONES_EXPORT extern GameServer_Status_ListsEntry_DoNotUseDefaultTypeInternal _GameServer_Status_ListsEntry_DoNotUse_default_instance_;
 class GameServer_Status_Port;
 class GameServer_Status_PortDefaultTypeInternal;
 AGONES_EXPORT extern GameServer_Status_PortDefaultTypeInternal _GameServer_Status_Port_default_instance_;
 class KeyValue;
 class KeyValueDefaultTypeInternal;
 AGONES_EXPORT extern KeyValueDefaultTypeInternal _KeyValue_default_instance_;
+class ListValue;
+class ListValueDefaultTypeInternal;
+AGONES_EXPORT extern ListValueDefaultTypeInternal _ListValue_default_instance_;
 }  // namespace sdk
 }  // namespace dev
 }  // namespace agones
 namespace google {
 namespace protobuf {
+template<> AGONES_EXPORT ::agones::dev::sdk::CounterUpdate* Arena::CreateMaybeMessage<::agones::dev::sdk::CounterUpdate>(Arena*);
 template<> AGONES_EXPORT ::agones::dev::sdk::Duration* Arena::CreateMaybeMessage<::agones::dev::sdk::Duration>(Arena*);
 template<> AGONES_EXPORT ::agones::dev::sdk::Empty* Arena::CreateMaybeMessage<::agones::dev::sdk::Empty>(Arena*);
 template<> AGONES_EXPORT ::agones::dev::sdk::GameServer* Arena::CreateMaybeMessage<::agones::dev::sdk::GameServer>(Arena*);
@@ -114,8 +136,14 @@ template<> AGONES_EXPORT ::agones::dev::sdk::GameServer_ObjectMeta_LabelsEntry_D
 template<> AGONES_EXPORT ::agones::dev::sdk::GameServer_Spec* Arena::CreateMaybeMessage<::agones::dev::sdk::GameServer_Spec>(Arena*);
 template<> AGONES_EXPORT ::agones::dev::sdk::GameServer_Spec_Health* Arena::CreateMaybeMessage<::agones::dev::sdk::GameServer_Spec_Health>(Arena*);
 template<> AGONES_EXPORT ::agones::dev::sdk::GameServer_Status* Arena::CreateMaybeMessage<::agones::dev::sdk::GameServer_Status>(Arena*);
+template<> AGONES_EXPORT ::agones::dev::sdk::GameServer_Status_Counter* Arena::CreateMaybeMessage<::agones::dev::sdk::GameServer_Status_Counter>(Arena*);
+template<> AGONES_EXPORT ::agones::dev::sdk::GameServer_Status_CountersEntry_DoNotUse* Arena::CreateMaybeMessage<::agones::dev::sdk::GameServer_Status_CountersEntry_DoNotUse>(Arena*);
+template<> AGONES_EXPORT ::agones::dev::sdk::GameServer_Status_Disruption* Arena::CreateMaybeMessage<::agones::dev::sdk::GameServer_Status_Disruption>(Arena*);
+template<> AGONES_EXPORT ::agones::dev::sdk::GameServer_Status_List* Arena::CreateMaybeMessage<::agones::dev::sdk::GameServer_Status_List>(Arena*);
+template<> AGONES_EXPORT ::agones::dev::sdk::GameServer_Status_ListsEntry_DoNotUse* Arena::CreateMaybeMessage<::agones::dev::sdk::GameServer_Status_ListsEntry_DoNotUse>(Arena*);
 template<> AGONES_EXPORT ::agones::dev::sdk::GameServer_Status_Port* Arena::CreateMaybeMessage<::agones::dev::sdk::GameServer_Status_Port>(Arena*);
 template<> AGONES_EXPORT ::agones::dev::sdk::KeyValue* Arena::CreateMaybeMessage<::agones::dev::sdk::KeyValue>(Arena*);
+template<> AGONES_EXPORT ::agones::dev::sdk::ListValue* Arena::CreateMaybeMessage<::agones::dev::sdk::ListValue>(Arena*);
 }  // namespace protobuf
 }  // namespace google
 namespace agones {
@@ -449,6 +477,250 @@ class AGONES_EXPORT Duration : public ::google::protobuf::Message /* @@protoc_in
 };
 // -------------------------------------------------------------------
 
+class AGONES_EXPORT CounterUpdate : public ::google::protobuf::Message /* @@protoc_insertion_point(class_definition:agones.dev.sdk.CounterUpdate) */ {
+ public:
+  CounterUpdate();
+  virtual ~CounterUpdate();
+
+  CounterUpdate(const CounterUpdate& from);
+
+  inline CounterUpdate& operator=(const CounterUpdate& from) {
+    CopyFrom(from);
+    return *this;
+  }
+  #if LANG_CXX11
+  CounterUpdate(CounterUpdate&& from) noexcept
+    : CounterUpdate() {
+    *this = ::std::move(from);
+  }
+
+  inline CounterUpdate& operator=(CounterUpdate&& from) noexcept {
+    if (GetArenaNoVirtual() == from.GetArenaNoVirtual()) {
+      if (this != &from) InternalSwap(&from);
+    } else {
+      CopyFrom(from);
+    }
+    return *this;
+  }
+  #endif
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const CounterUpdate& default_instance();
+
+  static void InitAsDefaultInstance();  // FOR INTERNAL USE ONLY
+  static inline const CounterUpdate* internal_default_instance() {
+    return reinterpret_cast<const CounterUpdate*>(
+               &_CounterUpdate_default_instance_);
+  }
+  static constexpr int kIndexInFileMessages =
+    3;
+
+  void Swap(CounterUpdate* other);
+  friend void swap(CounterUpdate& a, CounterUpdate& b) {
+    a.Swap(&b);
+  }
+
+  // implements Message ----------------------------------------------
+
+  inline CounterUpdate* New() const final {
+    return CreateMaybeMessage<CounterUpdate>(NULL);
+  }
+
+  CounterUpdate* New(::google::protobuf::Arena* arena) const final {
+    return CreateMaybeMessage<CounterUpdate>(arena);
+  }
+  void CopyFrom(const ::google::protobuf::Message& from) final;
+  void MergeFrom(const ::google::protobuf::Message& from) final;
+  void CopyFrom(const CounterUpdate& from);
+  void MergeFrom(const CounterUpdate& from);
+  void Clear() final;
+  bool IsInitialized() const final;
+
+  size_t ByteSizeLong() const final;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input) final;
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const final;
+  ::google::protobuf::uint8* InternalSerializeWithCachedSizesToArray(
+      bool deterministic, ::google::protobuf::uint8* target) const final;
+  int GetCachedSize() const final { return _cached_size_.Get(); }
+
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const final;
+  void InternalSwap(CounterUpdate* other);
+  private:
+  inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
+    return NULL;
+  }
+  inline void* MaybeArenaPtr() const {
+    return NULL;
+  }
+  public:
+
+  ::google::protobuf::Metadata GetMetadata() const final;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // string name = 1;
+  void clear_name();
+  static const int kNameFieldNumber = 1;
+  const ::std::string& name() const;
+  void set_name(const ::std::string& value);
+  #if LANG_CXX11
+  void set_name(::std::string&& value);
+  #endif
+  void set_name(const char* value);
+  void set_name(const char* value, size_t size);
+  ::std::string* mutable_name();
+  ::std::string* release_name();
+  void set_allocated_name(::std::string* name);
+
+  // int64 amount = 2;
+  void clear_amount();
+  static const int kAmountFieldNumber = 2;
+  ::google::protobuf::int64 amount() const;
+  void set_amount(::google::protobuf::int64 value);
+
+  // @@protoc_insertion_point(class_scope:agones.dev.sdk.CounterUpdate)
+ private:
+
+  ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
+  ::google::protobuf::internal::ArenaStringPtr name_;
+  ::google::protobuf::int64 amount_;
+  mutable ::google::protobuf::internal::CachedSize _cached_size_;
+  friend struct ::protobuf_sdk_2eproto::TableStruct;
+};
+// -------------------------------------------------------------------
+
+class AGONES_EXPORT ListValue : public ::google::protobuf::Message /* @@protoc_insertion_point(class_definition:agones.dev.sdk.ListValue) */ {
+ public:
+  ListValue();
+  virtual ~ListValue();
+
+  ListValue(const ListValue& from);
+
+  inline ListValue& operator=(const ListValue& from) {
+    CopyFrom(from);
+    return *this;
+  }
+  #if LANG_CXX11
+  ListValue(ListValue&& from) noexcept
+    : ListValue() {
+    *this = ::std::move(from);
+  }
+
+  inline ListValue& operator=(ListValue&& from) noexcept {
+    if (GetArenaNoVirtual() == from.GetArenaNoVirtual()) {
+      if (this != &from) InternalSwap(&from);
+    } else {
+      CopyFrom(from);
+    }
+    return *this;
+  }
+  #endif
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const ListValue& default_instance();
+
+  static void InitAsDefaultInstance();  // FOR INTERNAL USE ONLY
+  static inline const ListValue* internal_default_instance() {
+    return reinterpret_cast<const ListValue*>(
+               &_ListValue_default_instance_);
+  }
+  static constexpr int kIndexInFileMessages =
+    4;
+
+  void Swap(ListValue* other);
+  friend void swap(ListValue& a, ListValue& b) {
+    a.Swap(&b);
+  }
+
+  // implements Message ----------------------------------------------
+
+  inline ListValue* New() const final {
+    return CreateMaybeMessage<ListValue>(NULL);
+  }
+
+  ListValue* New(::google::protobuf::Arena* arena) const final {
+    return CreateMaybeMessage<ListValue>(arena);
+  }
+  void CopyFrom(const ::google::protobuf::Message& from) final;
+  void MergeFrom(const ::google::protobuf::Message& from) final;
+  void CopyFrom(const ListValue& from);
+  void MergeFrom(const ListValue& from);
+  void Clear() final;
+  bool IsInitialized() const final;
+
+  size_t ByteSizeLong() const final;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input) final;
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const final;
+  ::google::protobuf::uint8* InternalSerializeWithCachedSizesToArray(
+      bool deterministic, ::google::protobuf::uint8* target) const final;
+  int GetCachedSize() const final { return _cached_size_.Get(); }
+
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const final;
+  void InternalSwap(ListValue* other);
+  private:
+  inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
+    return NULL;
+  }
+  inline void* MaybeArenaPtr() const {
+    return NULL;
+  }
+  public:
+
+  ::google::protobuf::Metadata GetMetadata() const final;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // string name = 1;
+  void clear_name();
+  static const int kNameFieldNumber = 1;
+  const ::std::string& name() const;
+  void set_name(const ::std::string& value);
+  #if LANG_CXX11
+  void set_name(::std::string&& value);
+  #endif
+  void set_name(const char* value);
+  void set_name(const char* value, size_t size);
+  ::std::string* mutable_name();
+  ::std::string* release_name();
+  void set_allocated_name(::std::string* name);
+
+  // string value = 2;
+  void clear_value();
+  static const int kValueFieldNumber = 2;
+  const ::std::string& value() const;
+  void set_value(const ::std::string& value);
+  #if LANG_CXX11
+  void set_value(::std::string&& value);
+  #endif
+  void set_value(const char* value);
+  void set_value(const char* value, size_t size);
+  ::std::string* mutable_value();
+  ::std::string* release_value();
+  void set_allocated_value(::std::string* value);
+
+  // @@protoc_insertion_point(class_scope:agones.dev.sdk.ListValue)
+ private:
+
+  ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
+  ::google::protobuf::internal::ArenaStringPtr name_;
+  ::google::protobuf::internal::ArenaStringPtr value_;
+  mutable ::google::protobuf::internal::CachedSize _cached_size_;
+  friend struct ::protobuf_sdk_2eproto::TableStruct;
+};
+// -------------------------------------------------------------------
+
 class GameServer_ObjectMeta_AnnotationsEntry_DoNotUse : public ::google::protobuf::internal::MapEntry<GameServer_ObjectMeta_AnnotationsEntry_DoNotUse, 
     ::std::string, ::std::string,
     ::google::protobuf::internal::WireFormatLite::TYPE_STRING,
@@ -526,7 +798,7 @@ class AGONES_EXPORT GameServer_ObjectMeta : public ::google::protobuf::Message /
                &_GameServer_ObjectMeta_default_instance_);
   }
   static constexpr int kIndexInFileMessages =
-    5;
+    7;
 
   void Swap(GameServer_ObjectMeta* other);
   friend void swap(GameServer_ObjectMeta& a, GameServer_ObjectMeta& b) {
@@ -734,7 +1006,7 @@ class AGONES_EXPORT GameServer_Spec_Health : public ::google::protobuf::Message
                &_GameServer_Spec_Health_default_instance_);
   }
   static constexpr int kIndexInFileMessages =
-    6;
+    8;
 
   void Swap(GameServer_Spec_Health* other);
   friend void swap(GameServer_Spec_Health& a, GameServer_Spec_Health& b) {
@@ -858,7 +1130,7 @@ class AGONES_EXPORT GameServer_Spec : public ::google::protobuf::Message /* @@pr
                &_GameServer_Spec_default_instance_);
   }
   static constexpr int kIndexInFileMessages =
-    7;
+    9;
 
   void Swap(GameServer_Spec* other);
   friend void swap(GameServer_Spec& a, GameServer_Spec& b) {
@@ -969,7 +1241,7 @@ class AGONES_EXPORT GameServer_Status_Port : public ::google::protobuf::Message
                &_GameServer_Status_Port_default_instance_);
   }
   static constexpr int kIndexInFileMessages =
-    8;
+    10;
 
   void Swap(GameServer_Status_Port* other);
   friend void swap(GameServer_Status_Port& a, GameServer_Status_Port& b) {
@@ -1052,24 +1324,24 @@ class AGONES_EXPORT GameServer_Status_Port : public ::google::protobuf::Message
 };
 // -------------------------------------------------------------------
 
-class AGONES_EXPORT GameServer_Status : public ::google::protobuf::Message /* @@protoc_insertion_point(class_definition:agones.dev.sdk.GameServer.Status) */ {
+class AGONES_EXPORT GameServer_Status_Counter : public ::google::protobuf::Message /* @@protoc_insertion_point(class_definition:agones.dev.sdk.GameServer.Status.Counter) */ {
  public:
-  GameServer_Status();
-  virtual ~GameServer_Status();
+  GameServer_Status_Counter();
+  virtual ~GameServer_Status_Counter();
 
-  GameServer_Status(const GameServer_Status& from);
+  GameServer_Status_Counter(const GameServer_Status_Counter& from);
 
-  inline GameServer_Status& operator=(const GameServer_Status& from) {
+  inline GameServer_Status_Counter& operator=(const GameServer_Status_Counter& from) {
     CopyFrom(from);
     return *this;
   }
   #if LANG_CXX11
-  GameServer_Status(GameServer_Status&& from) noexcept
-    : GameServer_Status() {
+  GameServer_Status_Counter(GameServer_Status_Counter&& from) noexcept
+    : GameServer_Status_Counter() {
     *this = ::std::move(from);
   }
 
-  inline GameServer_Status& operator=(GameServer_Status&& from) noexcept {
+  inline GameServer_Status_Counter& operator=(GameServer_Status_Counter&& from) noexcept {
     if (GetArenaNoVirtual() == from.GetArenaNoVirtual()) {
       if (this != &from) InternalSwap(&from);
     } else {
@@ -1079,34 +1351,34 @@ class AGONES_EXPORT GameServer_Status : public ::google::protobuf::Message /* @@
   }
   #endif
   static const ::google::protobuf::Descriptor* descriptor();
-  static const GameServer_Status& default_instance();
+  static const GameServer_Status_Counter& default_instance();
 
   static void InitAsDefaultInstance();  // FOR INTERNAL USE ONLY
-  static inline const GameServer_Status* internal_default_instance() {
-    return reinterpret_cast<const GameServer_Status*>(
-               &_GameServer_Status_default_instance_);
+  static inline const GameServer_Status_Counter* internal_default_instance() {
+    return reinterpret_cast<const GameServer_Status_Counter*>(
+               &_GameServer_Status_Counter_default_instance_);
   }
   static constexpr int kIndexInFileMessages =
-    9;
+    11;
 
-  void Swap(GameServer_Status* other);
-  friend void swap(GameServer_Status& a, GameServer_Status& b) {
+  void Swap(GameServer_Status_Counter* other);
+  friend void swap(GameServer_Status_Counter& a, GameServer_Status_Counter& b) {
     a.Swap(&b);
   }
 
   // implements Message ----------------------------------------------
 
-  inline GameServer_Status* New() const final {
-    return CreateMaybeMessage<GameServer_Status>(NULL);
+  inline GameServer_Status_Counter* New() const final {
+    return CreateMaybeMessage<GameServer_Status_Counter>(NULL);
   }
 
-  GameServer_Status* New(::google::protobuf::Arena* arena) const final {
-    return CreateMaybeMessage<GameServer_Status>(arena);
+  GameServer_Status_Counter* New(::google::protobuf::Arena* arena) const final {
+    return CreateMaybeMessage<GameServer_Status_Counter>(arena);
   }
   void CopyFrom(const ::google::protobuf::Message& from) final;
   void MergeFrom(const ::google::protobuf::Message& from) final;
-  void CopyFrom(const GameServer_Status& from);
-  void MergeFrom(const GameServer_Status& from);
+  void CopyFrom(const GameServer_Status_Counter& from);
+  void MergeFrom(const GameServer_Status_Counter& from);
   void Clear() final;
   bool IsInitialized() const final;
 
@@ -1123,7 +1395,7 @@ class AGONES_EXPORT GameServer_Status : public ::google::protobuf::Message /* @@
   void SharedCtor();
   void SharedDtor();
   void SetCachedSize(int size) const final;
-  void InternalSwap(GameServer_Status* other);
+  void InternalSwap(GameServer_Status_Counter* other);
   private:
   inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
     return NULL;
@@ -1137,57 +1409,514 @@ class AGONES_EXPORT GameServer_Status : public ::google::protobuf::Message /* @@
 
   // nested types ----------------------------------------------------
 
-  typedef GameServer_Status_Port Port;
-
   // accessors -------------------------------------------------------
 
-  // repeated .agones.dev.sdk.GameServer.Status.Port ports = 3;
-  int ports_size() const;
-  void clear_ports();
-  static const int kPortsFieldNumber = 3;
-  ::agones::dev::sdk::GameServer_Status_Port* mutable_ports(int index);
-  ::google::protobuf::RepeatedPtrField< ::agones::dev::sdk::GameServer_Status_Port >*
-      mutable_ports();
-  const ::agones::dev::sdk::GameServer_Status_Port& ports(int index) const;
-  ::agones::dev::sdk::GameServer_Status_Port* add_ports();
-  const ::google::protobuf::RepeatedPtrField< ::agones::dev::sdk::GameServer_Status_Port >&
-      ports() const;
+  // int64 count = 1;
+  void clear_count();
+  static const int kCountFieldNumber = 1;
+  ::google::protobuf::int64 count() const;
+  void set_count(::google::protobuf::int64 value);
 
-  // string state = 1;
-  void clear_state();
-  static const int kStateFieldNumber = 1;
-  const ::std::string& state() const;
-  void set_state(const ::std::string& value);
-  #if LANG_CXX11
-  void set_state(::std::string&& value);
-  #endif
-  void set_state(const char* value);
-  void set_state(const char* value, size_t size);
-  ::std::string* mutable_state();
-  ::std::string* release_state();
-  void set_allocated_state(::std::string* state);
+  // int64 capacity = 2;
+  void clear_capacity();
+  static const int kCapacityFieldNumber = 2;
+  ::google::protobuf::int64 capacity() const;
+  void set_capacity(::google::protobuf::int64 value);
 
-  // string address = 2;
-  void clear_address();
-  static const int kAddressFieldNumber = 2;
-  const ::std::string& address() const;
-  void set_address(const ::std::string& value);
-  #if LANG_CXX11
-  void set_address(::std::string&& value);
-  #endif
-  void set_address(const char* value);
-  void set_address(const char* value, size_t size);
+  // @@protoc_insertion_point(class_scope:agones.dev.sdk.GameServer.Status.Counter)
+ private:
+
+  ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
+  ::google::protobuf::int64 count_;
+  ::google::protobuf::int64 capacity_;
+  mutable ::google::protobuf::internal::CachedSize _cached_size_;
+  friend struct ::protobuf_sdk_2eproto::TableStruct;
+};
+// -------------------------------------------------------------------
+
+class AGONES_EXPORT GameServer_Status_List : public ::google::protobuf::Message /* @@protoc_insertion_point(class_definition:agones.dev.sdk.GameServer.Status.List) */ {
+ public:
+  GameServer_Status_List();
+  virtual ~GameServer_Status_List();
+
+  GameServer_Status_List(const GameServer_Status_List& from);
+
+  inline GameServer_Status_List& operator=(const GameServer_Status_List& from) {
+    CopyFrom(from);
+    return *this;
+  }
+  #if LANG_CXX11
+  GameServer_Status_List(GameServer_Status_List&& from) noexcept
+    : GameServer_Status_List() {
+    *this = ::std::move(from);
+  }
+
+  inline GameServer_Status_List& operator=(GameServer_Status_List&& from) noexcept {
+    if (GetArenaNoVirtual() == from.GetArenaNoVirtual()) {
+      if (this != &from) InternalSwap(&from);
+    } else {
+      CopyFrom(from);
+    }
+    return *this;
+  }
+  #endif
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const GameServer_Status_List& default_instance();
+
+  static void InitAsDefaultInstance();  // FOR INTERNAL USE ONLY
+  static inline const GameServer_Status_List* internal_default_instance() {
+    return reinterpret_cast<const GameServer_Status_List*>(
+               &_GameServer_Status_List_default_instance_);
+  }
+  static constexpr int kIndexInFileMessages =
+    12;
+
+  void Swap(GameServer_Status_List* other);
+  friend void swap(GameServer_Status_List& a, GameServer_Status_List& b) {
+    a.Swap(&b);
+  }
+
+  // implements Message ----------------------------------------------
+
+  inline GameServer_Status_List* New() const final {
+    return CreateMaybeMessage<GameServer_Status_List>(NULL);
+  }
+
+  GameServer_Status_List* New(::google::protobuf::Arena* arena) const final {
+    return CreateMaybeMessage<GameServer_Status_List>(arena);
+  }
+  void CopyFrom(const ::google::protobuf::Message& from) final;
+  void MergeFrom(const ::google::protobuf::Message& from) final;
+  void CopyFrom(const GameServer_Status_List& from);
+  void MergeFrom(const GameServer_Status_List& from);
+  void Clear() final;
+  bool IsInitialized() const final;
+
+  size_t ByteSizeLong() const final;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input) final;
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const final;
+  ::google::protobuf::uint8* InternalSerializeWithCachedSizesToArray(
+      bool deterministic, ::google::protobuf::uint8* target) const final;
+  int GetCachedSize() const final { return _cached_size_.Get(); }
+
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const final;
+  void InternalSwap(GameServer_Status_List* other);
+  private:
+  inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
+    return NULL;
+  }
+  inline void* MaybeArenaPtr() const {
+    return NULL;
+  }
+  public:
+
+  ::google::protobuf::Metadata GetMetadata() const final;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // repeated string values = 2;
+  int values_size() const;
+  void clear_values();
+  static const int kValuesFieldNumber = 2;
+  const ::std::string& values(int index) const;
+  ::std::string* mutable_values(int index);
+  void set_values(int index, const ::std::string& value);
+  #if LANG_CXX11
+  void set_values(int index, ::std::string&& value);
+  #endif
+  void set_values(int index, const char* value);
+  void set_values(int index, const char* value, size_t size);
+  ::std::string* add_values();
+  void add_values(const ::std::string& value);
+  #if LANG_CXX11
+  void add_values(::std::string&& value);
+  #endif
+  void add_values(const char* value);
+  void add_values(const char* value, size_t size);
+  const ::google::protobuf::RepeatedPtrField< ::std::string>& values() const;
+  ::google::protobuf::RepeatedPtrField< ::std::string>* mutable_values();
+
+  // int64 capacity = 1;
+  void clear_capacity();
+  static const int kCapacityFieldNumber = 1;
+  ::google::protobuf::int64 capacity() const;
+  void set_capacity(::google::protobuf::int64 value);
+
+  // @@protoc_insertion_point(class_scope:agones.dev.sdk.GameServer.Status.List)
+ private:
+
+  ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
+  ::google::protobuf::RepeatedPtrField< ::std::string> values_;
+  ::google::protobuf::int64 capacity_;
+  mutable ::google::protobuf::internal::CachedSize _cached_size_;
+  friend struct ::protobuf_sdk_2eproto::TableStruct;
+};
+// -------------------------------------------------------------------
+
+class AGONES_EXPORT GameServer_Status_Disruption : public ::google::protobuf::Message /* @@protoc_insertion_point(class_definition:agones.dev.sdk.GameServer.Status.Disruption) */ {
+ public:
+  GameServer_Status_Disruption();
+  virtual ~GameServer_Status_Disruption();
+
+  GameServer_Status_Disruption(const GameServer_Status_Disruption& from);
+
+  inline GameServer_Status_Disruption& operator=(const GameServer_Status_Disruption& from) {
+    CopyFrom(from);
+    return *this;
+  }
+  #if LANG_CXX11
+  GameServer_Status_Disruption(GameServer_Status_Disruption&& from) noexcept
+    : GameServer_Status_Disruption() {
+    *this = ::std::move(from);
+  }
+
+  inline GameServer_Status_Disruption& operator=(GameServer_Status_Disruption&& from) noexcept {
+    if (GetArenaNoVirtual() == from.GetArenaNoVirtual()) {
+      if (this != &from) InternalSwap(&from);
+    } else {
+      CopyFrom(from);
+    }
+    return *this;
+  }
+  #endif
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const GameServer_Status_Disruption& default_instance();
+
+  static void InitAsDefaultInstance();  // FOR INTERNAL USE ONLY
+  static inline const GameServer_Status_Disruption* internal_default_instance() {
+    return reinterpret_cast<const GameServer_Status_Disruption*>(
+               &_GameServer_Status_Disruption_default_instance_);
+  }
+  static constexpr int kIndexInFileMessages =
+    13;
+
+  void Swap(GameServer_Status_Disruption* other);
+  friend void swap(GameServer_Status_Disruption& a, GameServer_Status_Disruption& b) {
+    a.Swap(&b);
+  }
+
+  // implements Message ----------------------------------------------
+
+  inline GameServer_Status_Disruption* New() const final {
+    return CreateMaybeMessage<GameServer_Status_Disruption>(NULL);
+  }
+
+  GameServer_Status_Disruption* New(::google::protobuf::Arena* arena) const final {
+    return CreateMaybeMessage<GameServer_Status_Disruption>(arena);
+  }
+  void CopyFrom(const ::google::protobuf::Message& from) final;
+  void MergeFrom(const ::google::protobuf::Message& from) final;
+  void CopyFrom(const GameServer_Status_Disruption& from);
+  void MergeFrom(const GameServer_Status_Disruption& from);
+  void Clear() final;
+  bool IsInitialized() const final;
+
+  size_t ByteSizeLong() const final;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input) final;
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const final;
+  ::google::protobuf::uint8* InternalSerializeWithCachedSizesToArray(
+      bool deterministic, ::google::protobuf::uint8* target) const final;
+  int GetCachedSize() const final { return _cached_size_.Get(); }
+
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const final;
+  void InternalSwap(GameServer_Status_Disruption* other);
+  private:
+  inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
+    return NULL;
+  }
+  inline void* MaybeArenaPtr() const {
+    return NULL;
+  }
+  public:
+
+  ::google::protobuf::Metadata GetMetadata() const final;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // string reason = 1;
+  void clear_reason();
+  static const int kReasonFieldNumber = 1;
+  const ::std::string& reason() const;
+  void set_reason(const ::std::string& value);
+  #if LANG_CXX11
+  void set_reason(::std::string&& value);
+  #endif
+  void set_reason(const char* value);
+  void set_reason(const char* value, size_t size);
+  ::std::string* mutable_reason();
+  ::std::string* release_reason();
+  void set_allocated_reason(::std::string* reason);
+
+  // string message = 2;
+  void clear_message();
+  static const int kMessageFieldNumber = 2;
+  const ::std::string& message() const;
+  void set_message(const ::std::string& value);
+  #if LANG_CXX11
+  void set_message(::std::string&& value);
+  #endif
+  void set_message(const char* value);
+  void set_message(const char* value, size_t size);
+  ::std::string* mutable_message();
+  ::std::string* release_message();
+  void set_allocated_message(::std::string* message);
+
+  // int64 since = 3;
+  void clear_since();
+  static const int kSinceFieldNumber = 3;
+  ::google::protobuf::int64 since() const;
+  void set_since(::google::protobuf::int64 value);
+
+  // @@protoc_insertion_point(class_scope:agones.dev.sdk.GameServer.Status.Disruption)
+ private:
+
+  ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
+  ::google::protobuf::internal::ArenaStringPtr reason_;
+  ::google::protobuf::internal::ArenaStringPtr message_;
+  ::google::protobuf::int64 since_;
+  mutable ::google::protobuf::internal::CachedSize _cached_size_;
+  friend struct ::protobuf_sdk_2eproto::TableStruct;
+};
+// -------------------------------------------------------------------
+
+class GameServer_Status_CountersEntry_DoNotUse : public ::google::protobuf::internal::MapEntry<GameServer_Status_CountersEntry_DoNotUse, 
+    ::std::string, ::agones::dev::sdk::GameServer_Status_Counter,
+    ::google::protobuf::internal::WireFormatLite::TYPE_STRING,
+    ::google::protobuf::internal::WireFormatLite::TYPE_MESSAGE,
+    0 > {
+public:
+  typedef ::google::protobuf::internal::MapEntry<GameServer_Status_CountersEntry_DoNotUse, 
+    ::std::string, ::agones::dev::sdk::GameServer_Status_Counter,
+    ::google::protobuf::internal::WireFormatLite::TYPE_STRING,
+    ::google::protobuf::internal::WireFormatLite::TYPE_MESSAGE,
+    0 > SuperType;
+  GameServer_Status_CountersEntry_DoNotUse();
+  GameServer_Status_CountersEntry_DoNotUse(::google::protobuf::Arena* arena);
+  void MergeFrom(const GameServer_Status_CountersEntry_DoNotUse& other);
+  static const GameServer_Status_CountersEntry_DoNotUse* internal_default_instance() { return reinterpret_cast<const GameServer_Status_CountersEntry_DoNotUse*>(&_GameServer_Status_CountersEntry_DoNotUse_default_instance_); }
+  void MergeFrom(const ::google::protobuf::Message& other) final;
+  ::google::protobuf::Metadata GetMetadata() const;
+};
+
+// -------------------------------------------------------------------
+
+class GameServer_Status_ListsEntry_DoNotUse : public ::google::protobuf::internal::MapEntry<GameServer_Status_ListsEntry_DoNotUse, 
+    ::std::string, ::agones::dev::sdk::GameServer_Status_List,
+    ::google::protobuf::internal::WireFormatLite::TYPE_STRING,
+    ::google::protobuf::internal::WireFormatLite::TYPE_MESSAGE,
+    0 > {
+public:
+  typedef ::google::protobuf::internal::MapEntry<GameServer_Status_ListsEntry_DoNotUse, 
+    ::std::string, ::agones::dev::sdk::GameServer_Status_List,
+    ::google::protobuf::internal::WireFormatLite::TYPE_STRING,
+    ::google::protobuf::internal::WireFormatLite::TYPE_MESSAGE,
+    0 > SuperType;
+  GameServer_Status_ListsEntry_DoNotUse();
+  GameServer_Status_ListsEntry_DoNotUse(::google::protobuf::Arena* arena);
+  void MergeFrom(const GameServer_Status_ListsEntry_DoNotUse& other);
+  static const GameServer_Status_ListsEntry_DoNotUse* internal_default_instance() { return reinterpret_cast<const GameServer_Status_ListsEntry_DoNotUse*>(&_GameServer_Status_ListsEntry_DoNotUse_default_instance_); }
+  void MergeFrom(const ::google::protobuf::Message& other) final;
+  ::google::protobuf::Metadata GetMetadata() const;
+};
+
+// -------------------------------------------------------------------
+
+class AGONES_EXPORT GameServer_Status : public ::google::protobuf::Message /* @@protoc_insertion_point(class_definition:agones.dev.sdk.GameServer.Status) */ {
+ public:
+  GameServer_Status();
+  virtual ~GameServer_Status();
+
+  GameServer_Status(const GameServer_Status& from);
+
+  inline GameServer_Status& operator=(const GameServer_Status& from) {
+    CopyFrom(from);
+    return *this;
+  }
+  #if LANG_CXX11
+  GameServer_Status(GameServer_Status&& from) noexcept
+    : GameServer_Status() {
+    *this = ::std::move(from);
+  }
+
+  inline GameServer_Status& operator=(GameServer_Status&& from) noexcept {
+    if (GetArenaNoVirtual() == from.GetArenaNoVirtual()) {
+      if (this != &from) InternalSwap(&from);
+    } else {
+      CopyFrom(from);
+    }
+    return *this;
+  }
+  #endif
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const GameServer_Status& default_instance();
+
+  static void InitAsDefaultInstance();  // FOR INTERNAL USE ONLY
+  static inline const GameServer_Status* internal_default_instance() {
+    return reinterpret_cast<const GameServer_Status*>(
+               &_GameServer_Status_default_instance_);
+  }
+  static constexpr int kIndexInFileMessages =
+    16;
+
+  void Swap(GameServer_Status* other);
+  friend void swap(GameServer_Status& a, GameServer_Status& b) {
+    a.Swap(&b);
+  }
+
+  // implements Message ----------------------------------------------
+
+  inline GameServer_Status* New() const final {
+    return CreateMaybeMessage<GameServer_Status>(NULL);
+  }
+
+  GameServer_Status* New(::google::protobuf::Arena* arena) const final {
+    return CreateMaybeMessage<GameServer_Status>(arena);
+  }
+  void CopyFrom(const ::google::protobuf::Message& from) final;
+  void MergeFrom(const ::google::protobuf::Message& from) final;
+  void CopyFrom(const GameServer_Status& from);
+  void MergeFrom(const GameServer_Status& from);
+  void Clear() final;
+  bool IsInitialized() const final;
+
+  size_t ByteSizeLong() const final;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input) final;
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const final;
+  ::google::protobuf::uint8* InternalSerializeWithCachedSizesToArray(
+      bool deterministic, ::google::protobuf::uint8* target) const final;
+  int GetCachedSize() const final { return _cached_size_.Get(); }
+
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const final;
+  void InternalSwap(GameServer_Status* other);
+  private:
+  inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
+    return NULL;
+  }
+  inline void* MaybeArenaPtr() const {
+    return NULL;
+  }
+  public:
+
+  ::google::protobuf::Metadata GetMetadata() const final;
+
+  // nested types ----------------------------------------------------
+
+  typedef GameServer_Status_Port Port;
+  typedef GameServer_Status_Counter Counter;
+  typedef GameServer_Status_List List;
+  typedef GameServer_Status_Disruption Disruption;
+
+  // accessors -------------------------------------------------------
+
+  // repeated .agones.dev.sdk.GameServer.Status.Port ports = 3;
+  int ports_size() const;
+  void clear_ports();
+  static const int kPortsFieldNumber = 3;
+  ::agones::dev::sdk::GameServer_Status_Port* mutable_ports(int index);
+  ::google::protobuf::RepeatedPtrField< ::agones::dev::sdk::GameServer_Status_Port >*
+      mutable_ports();
+  const ::agones::dev::sdk::GameServer_Status_Port& ports(int index) const;
+  ::agones::dev::sdk::GameServer_Status_Port* add_ports();
+  const ::google::protobuf::RepeatedPtrField< ::agones::dev::sdk::GameServer_Status_Port >&
+      ports() const;
+
+  // map<string, .agones.dev.sdk.GameServer.Status.Counter> counters = 4;
+  int counters_size() const;
+  void clear_counters();
+  static const int kCountersFieldNumber = 4;
+  const ::google::protobuf::Map< ::std::string, ::agones::dev::sdk::GameServer_Status_Counter >&
+      counters() const;
+  ::google::protobuf::Map< ::std::string, ::agones::dev::sdk::GameServer_Status_Counter >*
+      mutable_counters();
+
+  // map<string, .agones.dev.sdk.GameServer.Status.List> lists = 5;
+  int lists_size() const;
+  void clear_lists();
+  static const int kListsFieldNumber = 5;
+  const ::google::protobuf::Map< ::std::string, ::agones::dev::sdk::GameServer_Status_List >&
+      lists() const;
+  ::google::protobuf::Map< ::std::string, ::agones::dev::sdk::GameServer_Status_List >*
+      mutable_lists();
+
+  // string state = 1;
+  void clear_state();
+  static const int kStateFieldNumber = 1;
+  const ::std::string& state() const;
+  void set_state(const ::std::string& value);
+  #if LANG_CXX11
+  void set_state(::std::string&& value);
+  #endif
+  void set_state(const char* value);
+  void set_state(const char* value, size_t size);
+  ::std::string* mutable_state();
+  ::std::string* release_state();
+  void set_allocated_state(::std::string* state);
+
+  // string address = 2;
+  void clear_address();
+  static const int kAddressFieldNumber = 2;
+  const ::std::string& address() const;
+  void set_address(const ::std::string& value);
+  #if LANG_CXX11
+  void set_address(::std::string&& value);
+  #endif
+  void set_address(const char* value);
+  void set_address(const char* value, size_t size);
   ::std::string* mutable_address();
   ::std::string* release_address();
   void set_allocated_address(::std::string* address);
 
+  // .agones.dev.sdk.GameServer.Status.Disruption disruption = 6;
+  bool has_disruption() const;
+  void clear_disruption();
+  static const int kDisruptionFieldNumber = 6;
+  private:
+  const ::agones::dev::sdk::GameServer_Status_Disruption& _internal_disruption() const;
+  public:
+  const ::agones::dev::sdk::GameServer_Status_Disruption& disruption() const;
+  ::agones::dev::sdk::GameServer_Status_Disruption* release_disruption();
+  ::agones::dev::sdk::GameServer_Status_Disruption* mutable_disruption();
+  void set_allocated_disruption(::agones::dev::sdk::GameServer_Status_Disruption* disruption);
+
   // @@protoc_insertion_point(class_scope:agones.dev.sdk.GameServer.Status)
  private:
 
   ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
   ::google::protobuf::RepeatedPtrField< ::agones::dev::sdk::GameServer_Status_Port > ports_;
+  ::google::protobuf::internal::MapField<
+      GameServer_Status_CountersEntry_DoNotUse,
+      ::std::string, ::agones::dev::sdk::GameServer_Status_Counter,
+      ::google::protobuf::internal::WireFormatLite::TYPE_STRING,
+      ::google::protobuf::internal::WireFormatLite::TYPE_MESSAGE,
+      0 > counters_;
+  ::google::protobuf::internal::MapField<
+      GameServer_Status_ListsEntry_DoNotUse,
+      ::std::string, ::agones::dev::sdk::GameServer_Status_List,
+      ::google::protobuf::internal::WireFormatLite::TYPE_STRING,
+      ::google::protobuf::internal::WireFormatLite::TYPE_MESSAGE,
+      0 > lists_;
   ::google::protobuf::internal::ArenaStringPtr state_;
   ::google::protobuf::internal::ArenaStringPtr address_;
+  ::agones::dev::sdk::GameServer_Status_Disruption* disruption_;
   mutable ::google::protobuf::internal::CachedSize _cached_size_;
   friend struct ::protobuf_sdk_2eproto::TableStruct;
 };
@@ -1228,7 +1957,7 @@ class AGONES_EXPORT GameServer : public ::google::protobuf::Message /* @@protoc_
                &_GameServer_default_instance_);
   }
   static constexpr int kIndexInFileMessages =
-    10;
+    17;
 
   void Swap(GameServer* other);
   friend void swap(GameServer& a, GameServer& b) {
@@ -1335,138 +2064,319 @@ class AGONES_EXPORT GameServer : public ::google::protobuf::Message /* @@protoc_
 
 // ===================================================================
 
-#ifdef __GNUC__
-  #pragma GCC diagnostic push
-  #pragma GCC diagnostic ignored "-Wstrict-aliasing"
-#endif  // __GNUC__
-// Empty
+#ifdef __GNUC__
+  #pragma GCC diagnostic push
+  #pragma GCC diagnostic ignored "-Wstrict-aliasing"
+#endif  // __GNUC__
+// Empty
+
+// -------------------------------------------------------------------
+
+// KeyValue
+
+// string key = 1;
+inline void KeyValue::clear_key() {
+  key_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline const ::std::string& KeyValue::key() const {
+  // @@protoc_insertion_point(field_get:agones.dev.sdk.KeyValue.key)
+  return key_.GetNoArena();
+}
+inline void KeyValue::set_key(const ::std::string& value) {
+  
+  key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:agones.dev.sdk.KeyValue.key)
+}
+#if LANG_CXX11
+inline void KeyValue::set_key(::std::string&& value) {
+  
+  key_.SetNoArena(
+    &::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::move(value));
+  // @@protoc_insertion_point(field_set_rvalue:agones.dev.sdk.KeyValue.key)
+}
+#endif
+inline void KeyValue::set_key(const char* value) {
+  GOOGLE_DCHECK(value != NULL);
+  
+  key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:agones.dev.sdk.KeyValue.key)
+}
+inline void KeyValue::set_key(const char* value, size_t size) {
+  
+  key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:agones.dev.sdk.KeyValue.key)
+}
+inline ::std::string* KeyValue::mutable_key() {
+  
+  // @@protoc_insertion_point(field_mutable:agones.dev.sdk.KeyValue.key)
+  return key_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline ::std::string* KeyValue::release_key() {
+  // @@protoc_insertion_point(field_release:agones.dev.sdk.KeyValue.key)
+  
+  return key_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline void KeyValue::set_allocated_key(::std::string* key) {
+  if (key != NULL) {
+    
+  } else {
+    
+  }
+  key_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), key);
+  // @@protoc_insertion_point(field_set_allocated:agones.dev.sdk.KeyValue.key)
+}
+
+// string value = 2;
+inline void KeyValue::clear_value() {
+  value_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline const ::std::string& KeyValue::value() const {
+  // @@protoc_insertion_point(field_get:agones.dev.sdk.KeyValue.value)
+  return value_.GetNoArena();
+}
+inline void KeyValue::set_value(const ::std::string& value) {
+  
+  value_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:agones.dev.sdk.KeyValue.value)
+}
+#if LANG_CXX11
+inline void KeyValue::set_value(::std::string&& value) {
+  
+  value_.SetNoArena(
+    &::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::move(value));
+  // @@protoc_insertion_point(field_set_rvalue:agones.dev.sdk.KeyValue.value)
+}
+#endif
+inline void KeyValue::set_value(const char* value) {
+  GOOGLE_DCHECK(value != NULL);
+  
+  value_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:agones.dev.sdk.KeyValue.value)
+}
+inline void KeyValue::set_value(const char* value, size_t size) {
+  
+  value_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:agones.dev.sdk.KeyValue.value)
+}
+inline ::std::string* KeyValue::mutable_value() {
+  
+  // @@protoc_insertion_point(field_mutable:agones.dev.sdk.KeyValue.value)
+  return value_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline ::std::string* KeyValue::release_value() {
+  // @@protoc_insertion_point(field_release:agones.dev.sdk.KeyValue.value)
+  
+  return value_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline void KeyValue::set_allocated_value(::std::string* value) {
+  if (value != NULL) {
+    
+  } else {
+    
+  }
+  value_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set_allocated:agones.dev.sdk.KeyValue.value)
+}
+
+// -------------------------------------------------------------------
+
+// Duration
+
+// int64 seconds = 1;
+inline void Duration::clear_seconds() {
+  seconds_ = GOOGLE_LONGLONG(0);
+}
+inline ::google::protobuf::int64 Duration::seconds() const {
+  // @@protoc_insertion_point(field_get:agones.dev.sdk.Duration.seconds)
+  return seconds_;
+}
+inline void Duration::set_seconds(::google::protobuf::int64 value) {
+  
+  seconds_ = value;
+  // @@protoc_insertion_point(field_set:agones.dev.sdk.Duration.seconds)
+}
+
+// -------------------------------------------------------------------
+
+// CounterUpdate
+
+// string name = 1;
+inline void CounterUpdate::clear_name() {
+  name_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline const ::std::string& CounterUpdate::name() const {
+  // @@protoc_insertion_point(field_get:agones.dev.sdk.CounterUpdate.name)
+  return name_.GetNoArena();
+}
+inline void CounterUpdate::set_name(const ::std::string& value) {
+  
+  name_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:agones.dev.sdk.CounterUpdate.name)
+}
+#if LANG_CXX11
+inline void CounterUpdate::set_name(::std::string&& value) {
+  
+  name_.SetNoArena(
+    &::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::move(value));
+  // @@protoc_insertion_point(field_set_rvalue:agones.dev.sdk.CounterUpdate.name)
+}
+#endif
+inline void CounterUpdate::set_name(const char* value) {
+  GOOGLE_DCHECK(value != NULL);
+  
+  name_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:agones.dev.sdk.CounterUpdate.name)
+}
+inline void CounterUpdate::set_name(const char* value, size_t size) {
+  
+  name_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:agones.dev.sdk.CounterUpdate.name)
+}
+inline ::std::string* CounterUpdate::mutable_name() {
+  
+  // @@protoc_insertion_point(field_mutable:agones.dev.sdk.CounterUpdate.name)
+  return name_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline ::std::string* CounterUpdate::release_name() {
+  // @@protoc_insertion_point(field_release:agones.dev.sdk.CounterUpdate.name)
+  
+  return name_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline void CounterUpdate::set_allocated_name(::std::string* name) {
+  if (name != NULL) {
+    
+  } else {
+    
+  }
+  name_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), name);
+  // @@protoc_insertion_point(field_set_allocated:agones.dev.sdk.CounterUpdate.name)
+}
+
+// int64 amount = 2;
+inline void CounterUpdate::clear_amount() {
+  amount_ = GOOGLE_LONGLONG(0);
+}
+inline ::google::protobuf::int64 CounterUpdate::amount() const {
+  // @@protoc_insertion_point(field_get:agones.dev.sdk.CounterUpdate.amount)
+  return amount_;
+}
+inline void CounterUpdate::set_amount(::google::protobuf::int64 value) {
+  
+  amount_ = value;
+  // @@protoc_insertion_point(field_set:agones.dev.sdk.CounterUpdate.amount)
+}
 
 // -------------------------------------------------------------------
 
-// KeyValue
+// ListValue
 
-// string key = 1;
-inline void KeyValue::clear_key() {
-  key_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+// string name = 1;
+inline void ListValue::clear_name() {
+  name_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
 }
-inline const ::std::string& KeyValue::key() const {
-  // @@protoc_insertion_point(field_get:agones.dev.sdk.KeyValue.key)
-  return key_.GetNoArena();
+inline const ::std::string& ListValue::name() const {
+  // @@protoc_insertion_point(field_get:agones.dev.sdk.ListValue.name)
+  return name_.GetNoArena();
 }
-inline void KeyValue::set_key(const ::std::string& value) {
+inline void ListValue::set_name(const ::std::string& value) {
   
-  key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
-  // @@protoc_insertion_point(field_set:agones.dev.sdk.KeyValue.key)
+  name_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:agones.dev.sdk.ListValue.name)
 }
 #if LANG_CXX11
-inline void KeyValue::set_key(::std::string&& value) {
+inline void ListValue::set_name(::std::string&& value) {
   
-  key_.SetNoArena(
+  name_.SetNoArena(
     &::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::move(value));
-  // @@protoc_insertion_point(field_set_rvalue:agones.dev.sdk.KeyValue.key)
+  // @@protoc_insertion_point(field_set_rvalue:agones.dev.sdk.ListValue.name)
 }
 #endif
-inline void KeyValue::set_key(const char* value) {
+inline void ListValue::set_name(const char* value) {
   GOOGLE_DCHECK(value != NULL);
   
-  key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
-  // @@protoc_insertion_point(field_set_char:agones.dev.sdk.KeyValue.key)
+  name_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:agones.dev.sdk.ListValue.name)
 }
-inline void KeyValue::set_key(const char* value, size_t size) {
+inline void ListValue::set_name(const char* value, size_t size) {
   
-  key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+  name_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
       ::std::string(reinterpret_cast<const char*>(value), size));
-  // @@protoc_insertion_point(field_set_pointer:agones.dev.sdk.KeyValue.key)
+  // @@protoc_insertion_point(field_set_pointer:agones.dev.sdk.ListValue.name)
 }
-inline ::std::string* KeyValue::mutable_key() {
+inline ::std::string* ListValue::mutable_name() {
   
-  // @@protoc_insertion_point(field_mutable:agones.dev.sdk.KeyValue.key)
-  return key_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  // @@protoc_insertion_point(field_mutable:agones.dev.sdk.ListValue.name)
+  return name_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
 }
-inline ::std::string* KeyValue::release_key() {
-  // @@protoc_insertion_point(field_release:agones.dev.sdk.KeyValue.key)
+inline ::std::string* ListValue::release_name() {
+  // @@protoc_insertion_point(field_release:agones.dev.sdk.ListValue.name)
   
-  return key_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  return name_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
 }
-inline void KeyValue::set_allocated_key(::std::string* key) {
-  if (key != NULL) {
+inline void ListValue::set_allocated_name(::std::string* name) {
+  if (name != NULL) {
     
   } else {
     
   }
-  key_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), key);
-  // @@protoc_insertion_point(field_set_allocated:agones.dev.sdk.KeyValue.key)
+  name_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), name);
+  // @@protoc_insertion_point(field_set_allocated:agones.dev.sdk.ListValue.name)
 }
 
 // string value = 2;
-inline void KeyValue::clear_value() {
+inline void ListValue::clear_value() {
   value_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
 }
-inline const ::std::string& KeyValue::value() const {
-  // @@protoc_insertion_point(field_get:agones.dev.sdk.KeyValue.value)
+inline const ::std::string& ListValue::value() const {
+  // @@protoc_insertion_point(field_get:agones.dev.sdk.ListValue.value)
   return value_.GetNoArena();
 }
-inline void KeyValue::set_value(const ::std::string& value) {
+inline void ListValue::set_value(const ::std::string& value) {
   
   value_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
-  // @@protoc_insertion_point(field_set:agones.dev.sdk.KeyValue.value)
+  // @@protoc_insertion_point(field_set:agones.dev.sdk.ListValue.value)
 }
 #if LANG_CXX11
-inline void KeyValue::set_value(::std::string&& value) {
+inline void ListValue::set_value(::std::string&& value) {
   
   value_.SetNoArena(
     &::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::move(value));
-  // @@protoc_insertion_point(field_set_rvalue:agones.dev.sdk.KeyValue.value)
+  // @@protoc_insertion_point(field_set_rvalue:agones.dev.sdk.ListValue.value)
 }
 #endif
-inline void KeyValue::set_value(const char* value) {
+inline void ListValue::set_value(const char* value) {
   GOOGLE_DCHECK(value != NULL);
   
   value_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
-  // @@protoc_insertion_point(field_set_char:agones.dev.sdk.KeyValue.value)
+  // @@protoc_insertion_point(field_set_char:agones.dev.sdk.ListValue.value)
 }
-inline void KeyValue::set_value(const char* value, size_t size) {
+inline void ListValue::set_value(const char* value, size_t size) {
   
   value_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
       ::std::string(reinterpret_cast<const char*>(value), size));
-  // @@protoc_insertion_point(field_set_pointer:agones.dev.sdk.KeyValue.value)
+  // @@protoc_insertion_point(field_set_pointer:agones.dev.sdk.ListValue.value)
 }
-inline ::std::string* KeyValue::mutable_value() {
+inline ::std::string* ListValue::mutable_value() {
   
-  // @@protoc_insertion_point(field_mutable:agones.dev.sdk.KeyValue.value)
+  // @@protoc_insertion_point(field_mutable:agones.dev.sdk.ListValue.value)
   return value_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
 }
-inline ::std::string* KeyValue::release_value() {
-  // @@protoc_insertion_point(field_release:agones.dev.sdk.KeyValue.value)
+inline ::std::string* ListValue::release_value() {
+  // @@protoc_insertion_point(field_release:agones.dev.sdk.ListValue.value)
   
   return value_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
 }
-inline void KeyValue::set_allocated_value(::std::string* value) {
+inline void ListValue::set_allocated_value(::std::string* value) {
   if (value != NULL) {
     
   } else {
     
   }
   value_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
-  // @@protoc_insertion_point(field_set_allocated:agones.dev.sdk.KeyValue.value)
-}
-
-// -------------------------------------------------------------------
-
-// Duration
-
-// int64 seconds = 1;
-inline void Duration::clear_seconds() {
-  seconds_ = GOOGLE_LONGLONG(0);
-}
-inline ::google::protobuf::int64 Duration::seconds() const {
-  // @@protoc_insertion_point(field_get:agones.dev.sdk.Duration.seconds)
-  return seconds_;
-}
-inline void Duration::set_seconds(::google::protobuf::int64 value) {
-  
-  seconds_ = value;
-  // @@protoc_insertion_point(field_set:agones.dev.sdk.Duration.seconds)
+  // @@protoc_insertion_point(field_set_allocated:agones.dev.sdk.ListValue.value)
 }
 
 // -------------------------------------------------------------------
@@ -1958,6 +2868,253 @@ inline void GameServer_Status_Port::set_port(::google::protobuf::int32 value) {
 
 // -------------------------------------------------------------------
 
+// GameServer_Status_Counter
+
+// int64 count = 1;
+inline void GameServer_Status_Counter::clear_count() {
+  count_ = GOOGLE_LONGLONG(0);
+}
+inline ::google::protobuf::int64 GameServer_Status_Counter::count() const {
+  // @@protoc_insertion_point(field_get:agones.dev.sdk.GameServer.Status.Counter.count)
+  return count_;
+}
+inline void GameServer_Status_Counter::set_count(::google::protobuf::int64 value) {
+  
+  count_ = value;
+  // @@protoc_insertion_point(field_set:agones.dev.sdk.GameServer.Status.Counter.count)
+}
+
+// int64 capacity = 2;
+inline void GameServer_Status_Counter::clear_capacity() {
+  capacity_ = GOOGLE_LONGLONG(0);
+}
+inline ::google::protobuf::int64 GameServer_Status_Counter::capacity() const {
+  // @@protoc_insertion_point(field_get:agones.dev.sdk.GameServer.Status.Counter.capacity)
+  return capacity_;
+}
+inline void GameServer_Status_Counter::set_capacity(::google::protobuf::int64 value) {
+  
+  capacity_ = value;
+  // @@protoc_insertion_point(field_set:agones.dev.sdk.GameServer.Status.Counter.capacity)
+}
+
+// -------------------------------------------------------------------
+
+// GameServer_Status_List
+
+// int64 capacity = 1;
+inline void GameServer_Status_List::clear_capacity() {
+  capacity_ = GOOGLE_LONGLONG(0);
+}
+inline ::google::protobuf::int64 GameServer_Status_List::capacity() const {
+  // @@protoc_insertion_point(field_get:agones.dev.sdk.GameServer.Status.List.capacity)
+  return capacity_;
+}
+inline void GameServer_Status_List::set_capacity(::google::protobuf::int64 value) {
+  
+  capacity_ = value;
+  // @@protoc_insertion_point(field_set:agones.dev.sdk.GameServer.Status.List.capacity)
+}
+
+// repeated string values = 2;
+inline int GameServer_Status_List::values_size() const {
+  return values_.size();
+}
+inline void GameServer_Status_List::clear_values() {
+  values_.Clear();
+}
+inline const ::std::string& GameServer_Status_List::values(int index) const {
+  // @@protoc_insertion_point(field_get:agones.dev.sdk.GameServer.Status.List.values)
+  return values_.Get(index);
+}
+inline ::std::string* GameServer_Status_List::mutable_values(int index) {
+  // @@protoc_insertion_point(field_mutable:agones.dev.sdk.GameServer.Status.List.values)
+  return values_.Mutable(index);
+}
+inline void GameServer_Status_List::set_values(int index, const ::std::string& value) {
+  // @@protoc_insertion_point(field_set:agones.dev.sdk.GameServer.Status.List.values)
+  values_.Mutable(index)->assign(value);
+}
+#if LANG_CXX11
+inline void GameServer_Status_List::set_values(int index, ::std::string&& value) {
+  // @@protoc_insertion_point(field_set:agones.dev.sdk.GameServer.Status.List.values)
+  values_.Mutable(index)->assign(std::move(value));
+}
+#endif
+inline void GameServer_Status_List::set_values(int index, const char* value) {
+  GOOGLE_DCHECK(value != NULL);
+  values_.Mutable(index)->assign(value);
+  // @@protoc_insertion_point(field_set_char:agones.dev.sdk.GameServer.Status.List.values)
+}
+inline void GameServer_Status_List::set_values(int index, const char* value, size_t size) {
+  values_.Mutable(index)->assign(
+    reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:agones.dev.sdk.GameServer.Status.List.values)
+}
+inline ::std::string* GameServer_Status_List::add_values() {
+  // @@protoc_insertion_point(field_add_mutable:agones.dev.sdk.GameServer.Status.List.values)
+  return values_.Add();
+}
+inline void GameServer_Status_List::add_values(const ::std::string& value) {
+  values_.Add()->assign(value);
+  // @@protoc_insertion_point(field_add:agones.dev.sdk.GameServer.Status.List.values)
+}
+#if LANG_CXX11
+inline void GameServer_Status_List::add_values(::std::string&& value) {
+  values_.Add(std::move(value));
+  // @@protoc_insertion_point(field_add:agones.dev.sdk.GameServer.Status.List.values)
+}
+#endif
+inline void GameServer_Status_List::add_values(const char* value) {
+  GOOGLE_DCHECK(value != NULL);
+  values_.Add()->assign(value);
+  // @@protoc_insertion_point(field_add_char:agones.dev.sdk.GameServer.Status.List.values)
+}
+inline void GameServer_Status_List::add_values(const char* value, size_t size) {
+  values_.Add()->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_add_pointer:agones.dev.sdk.GameServer.Status.List.values)
+}
+inline const ::google::protobuf::RepeatedPtrField< ::std::string>&
+GameServer_Status_List::values() const {
+  // @@protoc_insertion_point(field_list:agones.dev.sdk.GameServer.Status.List.values)
+  return values_;
+}
+inline ::google::protobuf::RepeatedPtrField< ::std::string>*
+GameServer_Status_List::mutable_values() {
+  // @@protoc_insertion_point(field_mutable_list:agones.dev.sdk.GameServer.Status.List.values)
+  return &values_;
+}
+
+// -------------------------------------------------------------------
+
+// GameServer_Status_Disruption
+
+// string reason = 1;
+inline void GameServer_Status_Disruption::clear_reason() {
+  reason_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline const ::std::string& GameServer_Status_Disruption::reason() const {
+  // @@protoc_insertion_point(field_get:agones.dev.sdk.GameServer.Status.Disruption.reason)
+  return reason_.GetNoArena();
+}
+inline void GameServer_Status_Disruption::set_reason(const ::std::string& value) {
+  
+  reason_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:agones.dev.sdk.GameServer.Status.Disruption.reason)
+}
+#if LANG_CXX11
+inline void GameServer_Status_Disruption::set_reason(::std::string&& value) {
+  
+  reason_.SetNoArena(
+    &::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::move(value));
+  // @@protoc_insertion_point(field_set_rvalue:agones.dev.sdk.GameServer.Status.Disruption.reason)
+}
+#endif
+inline void GameServer_Status_Disruption::set_reason(const char* value) {
+  GOOGLE_DCHECK(value != NULL);
+  
+  reason_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:agones.dev.sdk.GameServer.Status.Disruption.reason)
+}
+inline void GameServer_Status_Disruption::set_reason(const char* value, size_t size) {
+  
+  reason_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:agones.dev.sdk.GameServer.Status.Disruption.reason)
+}
+inline ::std::string* GameServer_Status_Disruption::mutable_reason() {
+  
+  // @@protoc_insertion_point(field_mutable:agones.dev.sdk.GameServer.Status.Disruption.reason)
+  return reason_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline ::std::string* GameServer_Status_Disruption::release_reason() {
+  // @@protoc_insertion_point(field_release:agones.dev.sdk.GameServer.Status.Disruption.reason)
+  
+  return reason_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline void GameServer_Status_Disruption::set_allocated_reason(::std::string* reason) {
+  if (reason != NULL) {
+    
+  } else {
+    
+  }
+  reason_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), reason);
+  // @@protoc_insertion_point(field_set_allocated:agones.dev.sdk.GameServer.Status.Disruption.reason)
+}
+
+// string message = 2;
+inline void GameServer_Status_Disruption::clear_message() {
+  message_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline const ::std::string& GameServer_Status_Disruption::message() const {
+  // @@protoc_insertion_point(field_get:agones.dev.sdk.GameServer.Status.Disruption.message)
+  return message_.GetNoArena();
+}
+inline void GameServer_Status_Disruption::set_message(const ::std::string& value) {
+  
+  message_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:agones.dev.sdk.GameServer.Status.Disruption.message)
+}
+#if LANG_CXX11
+inline void GameServer_Status_Disruption::set_message(::std::string&& value) {
+  
+  message_.SetNoArena(
+    &::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::move(value));
+  // @@protoc_insertion_point(field_set_rvalue:agones.dev.sdk.GameServer.Status.Disruption.message)
+}
+#endif
+inline void GameServer_Status_Disruption::set_message(const char* value) {
+  GOOGLE_DCHECK(value != NULL);
+  
+  message_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:agones.dev.sdk.GameServer.Status.Disruption.message)
+}
+inline void GameServer_Status_Disruption::set_message(const char* value, size_t size) {
+  
+  message_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:agones.dev.sdk.GameServer.Status.Disruption.message)
+}
+inline ::std::string* GameServer_Status_Disruption::mutable_message() {
+  
+  // @@protoc_insertion_point(field_mutable:agones.dev.sdk.GameServer.Status.Disruption.message)
+  return message_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline ::std::string* GameServer_Status_Disruption::release_message() {
+  // @@protoc_insertion_point(field_release:agones.dev.sdk.GameServer.Status.Disruption.message)
+  
+  return message_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline void GameServer_Status_Disruption::set_allocated_message(::std::string* message) {
+  if (message != NULL) {
+    
+  } else {
+    
+  }
+  message_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), message);
+  // @@protoc_insertion_point(field_set_allocated:agones.dev.sdk.GameServer.Status.Disruption.message)
+}
+
+// int64 since = 3;
+inline void GameServer_Status_Disruption::clear_since() {
+  since_ = GOOGLE_LONGLONG(0);
+}
+inline ::google::protobuf::int64 GameServer_Status_Disruption::since() const {
+  // @@protoc_insertion_point(field_get:agones.dev.sdk.GameServer.Status.Disruption.since)
+  return since_;
+}
+inline void GameServer_Status_Disruption::set_since(::google::protobuf::int64 value) {
+  
+  since_ = value;
+  // @@protoc_insertion_point(field_set:agones.dev.sdk.GameServer.Status.Disruption.since)
+}
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
 // GameServer_Status
 
 // string state = 1;
@@ -2096,6 +3253,96 @@ GameServer_Status::ports() const {
   return ports_;
 }
 
+// map<string, .agones.dev.sdk.GameServer.Status.Counter> counters = 4;
+inline int GameServer_Status::counters_size() const {
+  return counters_.size();
+}
+inline void GameServer_Status::clear_counters() {
+  counters_.Clear();
+}
+inline const ::google::protobuf::Map< ::std::string, ::agones::dev::sdk::GameServer_Status_Counter >&
+GameServer_Status::counters() const {
+  // @@protoc_insertion_point(field_map:agones.dev.sdk.GameServer.Status.counters)
+  return counters_.GetMap();
+}
+inline ::google::protobuf::Map< ::std::string, ::agones::dev::sdk::GameServer_Status_Counter >*
+GameServer_Status::mutable_counters() {
+  // @@protoc_insertion_point(field_mutable_map:agones.dev.sdk.GameServer.Status.counters)
+  return counters_.MutableMap();
+}
+
+// map<string, .agones.dev.sdk.GameServer.Status.List> lists = 5;
+inline int GameServer_Status::lists_size() const {
+  return lists_.size();
+}
+inline void GameServer_Status::clear_lists() {
+  lists_.Clear();
+}
+inline const ::google::protobuf::Map< ::std::string, ::agones::dev::sdk::GameServer_Status_List >&
+GameServer_Status::lists() const {
+  // @@protoc_insertion_point(field_map:agones.dev.sdk.GameServer.Status.lists)
+  return lists_.GetMap();
+}
+inline ::google::protobuf::Map< ::std::string, ::agones::dev::sdk::GameServer_Status_List >*
+GameServer_Status::mutable_lists() {
+  // @@protoc_insertion_point(field_mutable_map:agones.dev.sdk.GameServer.Status.lists)
+  return lists_.MutableMap();
+}
+
+// .agones.dev.sdk.GameServer.Status.Disruption disruption = 6;
+inline bool GameServer_Status::has_disruption() const {
+  return this != internal_default_instance() && disruption_ != NULL;
+}
+inline void GameServer_Status::clear_disruption() {
+  if (GetArenaNoVirtual() == NULL && disruption_ != NULL) {
+    delete disruption_;
+  }
+  disruption_ = NULL;
+}
+inline const ::agones::dev::sdk::GameServer_Status_Disruption& GameServer_Status::_internal_disruption() const {
+  return *disruption_;
+}
+inline const ::agones::dev::sdk::GameServer_Status_Disruption& GameServer_Status::disruption() const {
+  const ::agones::dev::sdk::GameServer_Status_Disruption* p = disruption_;
+  // @@protoc_insertion_point(field_get:agones.dev.sdk.GameServer.Status.disruption)
+  return p != NULL ? *p : *reinterpret_cast<const ::agones::dev::sdk::GameServer_Status_Disruption*>(
+      &::agones::dev::sdk::_GameServer_Status_Disruption_default_instance_);
+}
+inline ::agones::dev::sdk::GameServer_Status_Disruption* GameServer_Status::release_disruption() {
+  // @@protoc_insertion_point(field_release:agones.dev.sdk.GameServer.Status.disruption)
+  
+  ::agones::dev::sdk::GameServer_Status_Disruption* temp = disruption_;
+  disruption_ = NULL;
+  return temp;
+}
+inline ::agones::dev::sdk::GameServer_Status_Disruption* GameServer_Status::mutable_disruption() {
+  
+  if (disruption_ == NULL) {
+    auto* p = CreateMaybeMessage<::agones::dev::sdk::GameServer_Status_Disruption>(GetArenaNoVirtual());
+    disruption_ = p;
+  }
+  // @@protoc_insertion_point(field_mutable:agones.dev.sdk.GameServer.Status.disruption)
+  return disruption_;
+}
+inline void GameServer_Status::set_allocated_disruption(::agones::dev::sdk::GameServer_Status_Disruption* disruption) {
+  ::google::protobuf::Arena* message_arena = GetArenaNoVirtual();
+  if (message_arena == NULL) {
+    delete disruption_;
+  }
+  if (disruption) {
+    ::google::protobuf::Arena* submessage_arena = NULL;
+    if (message_arena != submessage_arena) {
+      disruption = ::google::protobuf::internal::GetOwnedMessage(
+          message_arena, disruption, submessage_arena);
+    }
+    
+  } else {
+    
+  }
+  disruption_ = disruption;
+  // @@protoc_insertion_point(field_set_allocated:agones.dev.sdk.GameServer.Status.disruption)
+}
+
 // -------------------------------------------------------------------
 
 // GameServer
@@ -2285,6 +3532,20 @@ inline void GameServer::set_allocated_status(::agones::dev::sdk::GameServer_Stat
 
 // -------------------------------------------------------------------
 
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
 
 // @@protoc_insertion_point(namespace_scope)
 
@@ -44,6 +44,10 @@ static const char* SDK_method_names[] = {
   "/agones.dev.sdk.SDK/SetLabel",
   "/agones.dev.sdk.SDK/SetAnnotation",
   "/agones.dev.sdk.SDK/Reserve",
+  "/agones.dev.sdk.SDK/IncrementCounter",
+  "/agones.dev.sdk.SDK/SetCounterCapacity",
+  "/agones.dev.sdk.SDK/AppendListValue",
+  "/agones.dev.sdk.SDK/DeleteListValue",
 };
 
 std::unique_ptr< SDK::Stub> SDK::NewStub(const std::shared_ptr< ::grpc::ChannelInterface>& channel, const ::grpc::StubOptions& options) {
@@ -62,6 +66,10 @@ SDK::Stub::Stub(const std::shared_ptr< ::grpc::ChannelInterface>& channel)
   , rpcmethod_SetLabel_(SDK_method_names[6], ::grpc::internal::RpcMethod::NORMAL_RPC, channel)
   , rpcmethod_SetAnnotation_(SDK_method_names[7], ::grpc::internal::RpcMethod::NORMAL_RPC, channel)
   , rpcmethod_Reserve_(SDK_method_names[8], ::grpc::internal::RpcMethod::NORMAL_RPC, channel)
+  , rpcmethod_IncrementCounter_(SDK_method_names[9], ::grpc::internal::RpcMethod::NORMAL_RPC, channel)
+  , rpcmethod_SetCounterCapacity_(SDK_method_names[10], ::grpc::internal::RpcMethod::NORMAL_RPC, channel)
+  , rpcmethod_AppendListValue_(SDK_method_names[11], ::grpc::internal::RpcMethod::NORMAL_RPC, channel)
+  , rpcmethod_DeleteListValue_(SDK_method_names[12], ::grpc::internal::RpcMethod::NORMAL_RPC, channel)
   {}
 
 ::grpc::Status SDK::Stub::Ready(::grpc::ClientContext* context, const ::agones::dev::sdk::Empty& request, ::agones::dev::sdk::Empty* response) {
@@ -200,6 +208,70 @@ void SDK::Stub::experimental_async::Reserve(::grpc::ClientContext* context, cons
   return ::grpc::internal::ClientAsyncResponseReaderFactory< ::agones::dev::sdk::Empty>::Create(channel_.get(), cq, rpcmethod_Reserve_, context, request, false);
 }
 
+::grpc::Status SDK::Stub::IncrementCounter(::grpc::ClientContext* context, const ::agones::dev::sdk::CounterUpdate& request, ::agones::dev::sdk::Empty* response) {
+  return ::grpc::internal::BlockingUnaryCall(channel_.get(), rpcmethod_IncrementCounter_, context, request, response);
+}
+
+void SDK::Stub::experimental_async::IncrementCounter(::grpc::ClientContext* context, const ::agones::dev::sdk::CounterUpdate* request, ::agones::dev::sdk::Empty* response, std::function<void(::grpc::Status)> f) {
+  return ::grpc::internal::CallbackUnaryCall(stub_->channel_.get(), stub_->rpcmethod_IncrementCounter_, context, request, response, std::move(f));
+}
+
+::grpc::ClientAsyncResponseReader< ::agones::dev::sdk::Empty>* SDK::Stub::AsyncIncrementCounterRaw(::grpc::ClientContext* context, const ::agones::dev::sdk::CounterUpdate& request, ::grpc::CompletionQueue* cq) {
+  return ::grpc::internal::ClientAsyncResponseReaderFactory< ::agones::dev::sdk::Empty>::Create(channel_.get(), cq, rpcmethod_IncrementCounter_, context, request, true);
+}
+
+::grpc::ClientAsyncResponseReader< ::agones::dev::sdk::Empty>* SDK::Stub::PrepareAsyncIncrementCounterRaw(::grpc::ClientContext* context, const ::agones::dev::sdk::CounterUpdate& request, ::grpc::CompletionQueue* cq) {
+  return ::grpc::internal::ClientAsyncResponseReaderFactory< ::agones::dev::sdk::Empty>::Create(channel_.get(), cq, rpcmethod_IncrementCounter_, context, request, false);
+}
+
+::grpc::Status SDK::Stub::SetCounterCapacity(::grpc::ClientContext* context, const ::agones::dev::sdk::CounterUpdate& request, ::agones::dev::sdk::Empty* response) {
+  return ::grpc::internal::BlockingUnaryCall(channel_.get(), rpcmethod_SetCounterCapacity_, context, request, response);
+}
+
+void SDK::Stub::experimental_async::SetCounterCapacity(::grpc::ClientContext* context, const ::agones::dev::sdk::CounterUpdate* request, ::agones::dev::sdk::Empty* response, std::function<void(::grpc::Status)> f) {
+  return ::grpc::internal::CallbackUnaryCall(stub_->channel_.get(), stub_->rpcmethod_SetCounterCapacity_, context, request, response, std::move(f));
+}
+
+::grpc::ClientAsyncResponseReader< ::agones::dev::sdk::Empty>* SDK::Stub::AsyncSetCounterCapacityRaw(::grpc::ClientContext* context, const ::agones::dev::sdk::CounterUpdate& request, ::grpc::CompletionQueue* cq) {
+  return ::grpc::internal::ClientAsyncResponseReaderFactory< ::agones::dev::sdk::Empty>::Create(channel_.get(), cq, rpcmethod_SetCounterCapacity_, context, request, true);
+}
+
+::grpc::ClientAsyncResponseReader< ::agones::dev::sdk::Empty>* SDK::Stub::PrepareAsyncSetCounterCapacityRaw(::grpc::ClientContext* context, const ::agones::dev::sdk::CounterUpdate& request, ::grpc::CompletionQueue* cq) {
+  return ::grpc::internal::ClientAsyncResponseReaderFactory< ::agones::dev::sdk::Empty>::Create(channel_.get(), cq, rpcmethod_SetCounterCapacity_, context, request, false);
+}
+
+::grpc::Status SDK::Stub::AppendListValue(::grpc::ClientContext* context, const ::agones::dev::sdk::ListValue& request, ::agones::dev::sdk::Empty* response) {
+  return ::grpc::internal::BlockingUnaryCall(channel_.get(), rpcmethod_AppendListValue_, context, request, response);
+}
+
+void SDK::Stub::experimental_async::AppendListValue(::grpc::ClientContext* context, const ::agones::dev::sdk::ListValue* request, ::agones::dev::sdk::Empty* response, std::function<void(::grpc::Status)> f) {
+  return ::grpc::internal::CallbackUnaryCall(stub_->channel_.get(), stub_->rpcmethod_AppendListValue_, context, request, response, std::move(f));
+}
+
+::grpc::ClientAsyncResponseReader< ::agones::dev::sdk::Empty>* SDK::Stub::AsyncAppendListValueRaw(::grpc::ClientContext* context, const ::agones::dev::sdk::ListValue& request, ::grpc::CompletionQueue* cq) {
+  return ::grpc::internal::ClientAsyncResponseReaderFactory< ::agones::dev::sdk::Empty>::Create(channel_.get(), cq, rpcmethod_AppendListValue_, context, request, true);
+}
+
+::grpc::ClientAsyncResponseReader< ::agones::dev::sdk::Empty>* SDK::Stub::PrepareAsyncAppendListValueRaw(::grpc::ClientContext* context, const ::agones::dev::sdk::ListValue& request, ::grpc::CompletionQueue* cq) {
+  return ::grpc::internal::ClientAsyncResponseReaderFactory< ::agones::dev::sdk::Empty>::Create(channel_.get(), cq, rpcmethod_AppendListValue_, context, request, false);
+}
+
+::grpc::Status SDK::Stub::DeleteListValue(::grpc::ClientContext* context, const ::agones::dev::sdk::ListValue& request, ::agones::dev::sdk::Empty* response) {
+  return ::grpc::internal::BlockingUnaryCall(channel_.get(), rpcmethod_DeleteListValue_, context, request, response);
+}
+
+void SDK::Stub::experimental_async::DeleteListValue(::grpc::ClientContext* context, const ::agones::dev::sdk::ListValue* request, ::agones::dev::sdk::Empty* response, std::function<void(::grpc::Status)> f) {
+  return ::grpc::internal::CallbackUnaryCall(stub_->channel_.get(), stub_->rpcmethod_DeleteListValue_, context, request, response, std::move(f));
+}
+
+::grpc::ClientAsyncResponseReader< ::agones::dev::sdk::Empty>* SDK::Stub::AsyncDeleteListValueRaw(::grpc::ClientContext* context, const ::agones::dev::sdk::ListValue& request, ::grpc::CompletionQueue* cq) {
+  return ::grpc::internal::ClientAsyncResponseReaderFactory< ::agones::dev::sdk::Empty>::Create(channel_.get(), cq, rpcmethod_DeleteListValue_, context, request, true);
+}
+
+::grpc::ClientAsyncResponseReader< ::agones::dev::sdk::Empty>* SDK::Stub::PrepareAsyncDeleteListValueRaw(::grpc::ClientContext* context, const ::agones::dev::sdk::ListValue& request, ::grpc::CompletionQueue* cq) {
+  return ::grpc::internal::ClientAsyncResponseReaderFactory< ::agones::dev::sdk::Empty>::Create(channel_.get(), cq, rpcmethod_DeleteListValue_, context, request, false);
+}
+
 SDK::Service::Service() {
   AddMethod(new ::grpc::internal::RpcServiceMethod(
       SDK_method_names[0],
@@ -246,6 +318,26 @@ SDK::Service::Service() {
       ::grpc::internal::RpcMethod::NORMAL_RPC,
       new ::grpc::internal::RpcMethodHandler< SDK::Service, ::agones::dev::sdk::Duration, ::agones::dev::sdk::Empty>(
           std::mem_fn(&SDK::Service::Reserve), this)));
+  AddMethod(new ::grpc::internal::RpcServiceMethod(
+      SDK_method_names[9],
+      ::grpc::internal::RpcMethod::NORMAL_RPC,
+      new ::grpc::internal::RpcMethodHandler< SDK::Service, ::agones::dev::sdk::CounterUpdate, ::agones::dev::sdk::Empty>(
+          std::mem_fn(&SDK::Service::IncrementCounter), this)));
+  AddMethod(new ::grpc::internal::RpcServiceMethod(
+      SDK_method_names[10],
+      ::grpc::internal::RpcMethod::NORMAL_RPC,
+      new ::grpc::internal::RpcMethodHandler< SDK::Service, ::agones::dev::sdk::CounterUpdate, ::agones::dev::sdk::Empty>(
+          std::mem_fn(&SDK::Service::SetCounterCapacity), this)));
+  AddMethod(new ::grpc::internal::RpcServiceMethod(
+      SDK_method_names[11],
+      ::grpc::internal::RpcMethod::NORMAL_RPC,
+      new ::grpc::internal::RpcMethodHandler< SDK::Service, ::agones::dev::sdk::ListValue, ::agones::dev::sdk::Empty>(
+          std::mem_fn(&SDK::Service::AppendListValue), this)));
+  AddMethod(new ::grpc::internal::RpcServiceMethod(
+      SDK_method_names[12],
+      ::grpc::internal::RpcMethod::NORMAL_RPC,
+      new ::grpc::internal::RpcMethodHandler< SDK::Service, ::agones::dev::sdk::ListValue, ::agones::dev::sdk::Empty>(
+          std::mem_fn(&SDK::Service::DeleteListValue), this)));
 }
 
 SDK::Service::~Service() {
@@ -314,6 +406,34 @@ SDK::Service::~Service() {
   return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
 }
 
+::grpc::Status SDK::Service::IncrementCounter(::grpc::ServerContext* context, const ::agones::dev::sdk::CounterUpdate* request, ::agones::dev::sdk::Empty* response) {
+  (void) context;
+  (void) request;
+  (void) response;
+  return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+}
+
+::grpc::Status SDK::Service::SetCounterCapacity(::grpc::ServerContext* context, const ::agones::dev::sdk::CounterUpdate* request, ::agones::dev::sdk::Empty* response) {
+  (void) context;
+  (void) request;
+  (void) response;
+  return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+}
+
+::grpc::Status SDK::Service::AppendListValue(::grpc::ServerContext* context, const ::agones::dev::sdk::ListValue* request, ::agones::dev::sdk::Empty* response) {
+  (void) context;
+  (void) request;
+  (void) response;
+  return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+}
+
+::grpc::Status SDK::Service::DeleteListValue(::grpc::ServerContext* context, const ::agones::dev::sdk::ListValue* request, ::agones::dev::sdk::Empty* response) {
+  (void) context;
+  (void) request;
+  (void) response;
+  return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+}
+
 
 }  // namespace agones
 }  // namespace dev
@@ -38,10 +38,15 @@ namespace protobuf_sdk_2eproto {
 extern PROTOBUF_INTERNAL_EXPORT_protobuf_sdk_2eproto ::google::protobuf::internal::SCCInfo<0> scc_info_GameServer_ObjectMeta_AnnotationsEntry_DoNotUse;
 extern PROTOBUF_INTERNAL_EXPORT_protobuf_sdk_2eproto ::google::protobuf::internal::SCCInfo<0> scc_info_GameServer_ObjectMeta_LabelsEntry_DoNotUse;
 extern PROTOBUF_INTERNAL_EXPORT_protobuf_sdk_2eproto ::google::protobuf::internal::SCCInfo<0> scc_info_GameServer_Spec_Health;
+extern PROTOBUF_INTERNAL_EXPORT_protobuf_sdk_2eproto ::google::protobuf::internal::SCCInfo<0> scc_info_GameServer_Status_Counter;
+extern PROTOBUF_INTERNAL_EXPORT_protobuf_sdk_2eproto ::google::protobuf::internal::SCCInfo<0> scc_info_GameServer_Status_Disruption;
+extern PROTOBUF_INTERNAL_EXPORT_protobuf_sdk_2eproto ::google::protobuf::internal::SCCInfo<0> scc_info_GameServer_Status_List;
 extern PROTOBUF_INTERNAL_EXPORT_protobuf_sdk_2eproto ::google::protobuf::internal::SCCInfo<0> scc_info_GameServer_Status_Port;
 extern PROTOBUF_INTERNAL_EXPORT_protobuf_sdk_2eproto ::google::protobuf::internal::SCCInfo<1> scc_info_GameServer_Spec;
-extern PROTOBUF_INTERNAL_EXPORT_protobuf_sdk_2eproto ::google::protobuf::internal::SCCInfo<1> scc_info_GameServer_Status;
+extern PROTOBUF_INTERNAL_EXPORT_protobuf_sdk_2eproto ::google::protobuf::internal::SCCInfo<1> scc_info_GameServer_Status_CountersEntry_DoNotUse;
+extern PROTOBUF_INTERNAL_EXPORT_protobuf_sdk_2eproto ::google::protobuf::internal::SCCInfo<1> scc_info_GameServer_Status_ListsEntry_DoNotUse;
 extern PROTOBUF_INTERNAL_EXPORT_protobuf_sdk_2eproto ::google::protobuf::internal::SCCInfo<2> scc_info_GameServer_ObjectMeta;
+extern PROTOBUF_INTERNAL_EXPORT_protobuf_sdk_2eproto ::google::protobuf::internal::SCCInfo<4> scc_info_GameServer_Status;
 }  // namespace protobuf_sdk_2eproto
 namespace agones {
 namespace dev {
@@ -61,6 +66,16 @@ class DurationDefaultTypeInternal {
   ::google::protobuf::internal::ExplicitlyConstructed<Duration>
       _instance;
 } _Duration_default_instance_;
+class CounterUpdateDefaultTypeInternal {
+ public:
+  ::google::protobuf::internal::ExplicitlyConstructed<CounterUpdate>
+      _instance;
+} _CounterUpdate_default_instance_;
+class ListValueDefaultTypeInternal {
+ public:
+  ::google::protobuf::internal::ExplicitlyConstructed<ListValue>
+      _instance;
+} _ListValue_default_instance_;
 class GameServer_ObjectMeta_AnnotationsEntry_DoNotUseDefaultTypeInternal {
  public:
   ::google::protobuf::internal::ExplicitlyConstructed<GameServer_ObjectMeta_AnnotationsEntry_DoNotUse>
@@ -91,6 +106,31 @@ class GameServer_Status_PortDefaultTypeInternal {
   ::google::protobuf::internal::ExplicitlyConstructed<GameServer_Status_Port>
       _instance;
 } _GameServer_Status_Port_default_instance_;
+class GameServer_Status_CounterDefaultTypeInternal {
+ public:
+  ::google::protobuf::internal::ExplicitlyConstructed<GameServer_Status_Counter>
+      _instance;
+} _GameServer_Status_Counter_default_instance_;
+class GameServer_Status_ListDefaultTypeInternal {
+ public:
+  ::google::protobuf::internal::ExplicitlyConstructed<GameServer_Status_List>
+      _instance;
+} _GameServer_Status_List_default_instance_;
+class GameServer_Status_DisruptionDefaultTypeInternal {
+ public:
+  ::google::protobuf::internal::ExplicitlyConstructed<GameServer_Status_Disruption>
+      _instance;
+} _GameServer_Status_Disruption_default_instance_;
+class GameServer_Status_CountersEntry_DoNotUseDefaultTypeInternal {
+ public:
+  ::google::protobuf::internal::ExplicitlyConstructed<GameServer_Status_CountersEntry_DoNotUse>
+      _instance;
+} _GameServer_Status_CountersEntry_DoNotUse_default_instance_;
+class GameServer_Status_ListsEntry_DoNotUseDefaultTypeInternal {
+ public:
+  ::google::protobuf::internal::ExplicitlyConstructed<GameServer_Status_ListsEntry_DoNotUse>
+      _instance;
+} _GameServer_Status_ListsEntry_DoNotUse_default_instance_;
 class GameServer_StatusDefaultTypeInternal {
  public:
   ::google::protobuf::internal::ExplicitlyConstructed<GameServer_Status>
@@ -147,6 +187,34 @@ static void InitDefaultsDuration() {
 AGONES_EXPORT ::google::protobuf::internal::SCCInfo<0> scc_info_Duration =
     {{ATOMIC_VAR_INIT(::google::protobuf::internal::SCCInfoBase::kUninitialized), 0, InitDefaultsDuration}, {}};
 
+static void InitDefaultsCounterUpdate() {
+  GOOGLE_PROTOBUF_VERIFY_VERSION;
+
+  {
+    void* ptr = &::agones::dev::sdk::_CounterUpdate_default_instance_;
+    new (ptr) ::agones::dev::sdk::CounterUpdate();
+    ::google::protobuf::internal::OnShutdownDestroyMessage(ptr);
+  }
+  ::agones::dev::sdk::CounterUpdate::InitAsDefaultInstance();
+}
+
+AGONES_EXPORT ::google::protobuf::internal::SCCInfo<0> scc_info_CounterUpdate =
+    {{ATOMIC_VAR_INIT(::google::protobuf::internal::SCCInfoBase::kUninitialized), 0, InitDefaultsCounterUpdate}, {}};
+
+static void InitDefaultsListValue() {
+  GOOGLE_PROTOBUF_VERIFY_VERSION;
+
+  {
+    void* ptr = &::agones::dev::sdk::_ListValue_default_instance_;
+    new (ptr) ::agones::dev::sdk::ListValue();
+    ::google::protobuf::internal::OnShutdownDestroyMessage(ptr);
+  }
+  ::agones::dev::sdk::ListValue::InitAsDefaultInstance();
+}
+
+AGONES_EXPORT ::google::protobuf::internal::SCCInfo<0> scc_info_ListValue =
+    {{ATOMIC_VAR_INIT(::google::protobuf::internal::SCCInfoBase::kUninitialized), 0, InitDefaultsListValue}, {}};
+
 static void InitDefaultsGameServer_ObjectMeta_AnnotationsEntry_DoNotUse() {
   GOOGLE_PROTOBUF_VERIFY_VERSION;
 
@@ -232,6 +300,76 @@ static void InitDefaultsGameServer_Status_Port() {
 AGONES_EXPORT ::google::protobuf::internal::SCCInfo<0> scc_info_GameServer_Status_Port =
     {{ATOMIC_VAR_INIT(::google::protobuf::internal::SCCInfoBase::kUninitialized), 0, InitDefaultsGameServer_Status_Port}, {}};
 
+static void InitDefaultsGameServer_Status_Counter() {
+  GOOGLE_PROTOBUF_VERIFY_VERSION;
+
+  {
+    void* ptr = &::agones::dev::sdk::_GameServer_Status_Counter_default_instance_;
+    new (ptr) ::agones::dev::sdk::GameServer_Status_Counter();
+    ::google::protobuf::internal::OnShutdownDestroyMessage(ptr);
+  }
+  ::agones::dev::sdk::GameServer_Status_Counter::InitAsDefaultInstance();
+}
+
+AGONES_EXPORT ::google::protobuf::internal::SCCInfo<0> scc_info_GameServer_Status_Counter =
+    {{ATOMIC_VAR_INIT(::google::protobuf::internal::SCCInfoBase::kUninitialized), 0, InitDefaultsGameServer_Status_Counter}, {}};
+
+static void InitDefaultsGameServer_Status_List() {
+  GOOGLE_PROTOBUF_VERIFY_VERSION;
+
+  {
+    void* ptr = &::agones::dev::sdk::_GameServer_Status_List_default_instance_;
+    new (ptr) ::agones::dev::sdk::GameServer_Status_List();
+    ::google::protobuf::internal::OnShutdownDestroyMessage(ptr);
+  }
+  ::agones::dev::sdk::GameServer_Status_List::InitAsDefaultInstance();
+}
+
+AGONES_EXPORT ::google::protobuf::internal::SCCInfo<0> scc_info_GameServer_Status_List =
+    {{ATOMIC_VAR_INIT(::google::protobuf::internal::SCCInfoBase::kUninitialized), 0, InitDefaultsGameServer_Status_List}, {}};
+
+static void InitDefaultsGameServer_Status_Disruption() {
+  GOOGLE_PROTOBUF_VERIFY_VERSION;
+
+  {
+    void* ptr = &::agones::dev::sdk::_GameServer_Status_Disruption_default_instance_;
+    new (ptr) ::agones::dev::sdk::GameServer_Status_Disruption();
+    ::google::protobuf::internal::OnShutdownDestroyMessage(ptr);
+  }
+  ::agones::dev::sdk::GameServer_Status_Disruption::InitAsDefaultInstance();
+}
+
+AGONES_EXPORT ::google::protobuf::internal::SCCInfo<0> scc_info_GameServer_Status_Disruption =
+    {{ATOMIC_VAR_INIT(::google::protobuf::internal::SCCInfoBase::kUninitialized), 0, InitDefaultsGameServer_Status_Disruption}, {}};
+
+static void InitDefaultsGameServer_Status_CountersEntry_DoNotUse() {
+  GOOGLE_PROTOBUF_VERIFY_VERSION;
+
+  {
+    void* ptr = &::agones::dev::sdk::_GameServer_Status_CountersEntry_DoNotUse_default_instance_;
+    new (ptr) ::agones::dev::sdk::GameServer_Status_CountersEntry_DoNotUse();
+  }
+  ::agones::dev::sdk::GameServer_Status_CountersEntry_DoNotUse::InitAsDefaultInstance();
+}
+
+AGONES_EXPORT ::google::protobuf::internal::SCCInfo<1> scc_info_GameServer_Status_CountersEntry_DoNotUse =
+    {{ATOMIC_VAR_INIT(::google::protobuf::internal::SCCInfoBase::kUninitialized), 1, InitDefaultsGameServer_Status_CountersEntry_DoNotUse}, {
+      &protobuf_sdk_2eproto::scc_info_GameServer_Status_Counter.base,}};
+
+static void InitDefaultsGameServer_Status_ListsEntry_DoNotUse() {
+  GOOGLE_PROTOBUF_VERIFY_VERSION;
+
+  {
+    void* ptr = &::agones::dev::sdk::_GameServer_Status_ListsEntry_DoNotUse_default_instance_;
+    new (ptr) ::agones::dev::sdk::GameServer_Status_ListsEntry_DoNotUse();
+  }
+  ::agones::dev::sdk::GameServer_Status_ListsEntry_DoNotUse::InitAsDefaultInstance();
+}
+
+AGONES_EXPORT ::google::protobuf::internal::SCCInfo<1> scc_info_GameServer_Status_ListsEntry_DoNotUse =
+    {{ATOMIC_VAR_INIT(::google::protobuf::internal::SCCInfoBase::kUninitialized), 1, InitDefaultsGameServer_Status_ListsEntry_DoNotUse}, {
+      &protobuf_sdk_2eproto::scc_info_GameServer_Status_List.base,}};
+
 static void InitDefaultsGameServer_Status() {
   GOOGLE_PROTOBUF_VERIFY_VERSION;
 
@@ -243,9 +381,12 @@ static void InitDefaultsGameServer_Status() {
   ::agones::dev::sdk::GameServer_Status::InitAsDefaultInstance();
 }
 
-AGONES_EXPORT ::google::protobuf::internal::SCCInfo<1> scc_info_GameServer_Status =
-    {{ATOMIC_VAR_INIT(::google::protobuf::internal::SCCInfoBase::kUninitialized), 1, InitDefaultsGameServer_Status}, {
-      &protobuf_sdk_2eproto::scc_info_GameServer_Status_Port.base,}};
+AGONES_EXPORT ::google::protobuf::internal::SCCInfo<4> scc_info_GameServer_Status =
+    {{ATOMIC_VAR_INIT(::google::protobuf::internal::SCCInfoBase::kUninitialized), 4, InitDefaultsGameServer_Status}, {
+      &protobuf_sdk_2eproto::scc_info_GameServer_Status_Port.base,
+      &protobuf_sdk_2eproto::scc_info_GameServer_Status_CountersEntry_DoNotUse.base,
+      &protobuf_sdk_2eproto::scc_info_GameServer_Status_ListsEntry_DoNotUse.base,
+      &protobuf_sdk_2eproto::scc_info_GameServer_Status_Disruption.base,}};
 
 static void InitDefaultsGameServer() {
   GOOGLE_PROTOBUF_VERIFY_VERSION;
@@ -268,17 +409,24 @@ void InitDefaults() {
   ::google::protobuf::internal::InitSCC(&scc_info_Empty.base);
   ::google::protobuf::internal::InitSCC(&scc_info_KeyValue.base);
   ::google::protobuf::internal::InitSCC(&scc_info_Duration.base);
+  ::google::protobuf::internal::InitSCC(&scc_info_CounterUpdate.base);
+  ::google::protobuf::internal::InitSCC(&scc_info_ListValue.base);
   ::google::protobuf::internal::InitSCC(&scc_info_GameServer_ObjectMeta_AnnotationsEntry_DoNotUse.base);
   ::google::protobuf::internal::InitSCC(&scc_info_GameServer_ObjectMeta_LabelsEntry_DoNotUse.base);
   ::google::protobuf::internal::InitSCC(&scc_info_GameServer_ObjectMeta.base);
   ::google::protobuf::internal::InitSCC(&scc_info_GameServer_Spec_Health.base);
   ::google::protobuf::internal::InitSCC(&scc_info_GameServer_Spec.base);
   ::google::protobuf::internal::InitSCC(&scc_info_GameServer_Status_Port.base);
+  ::google::protobuf::internal::InitSCC(&scc_info_GameServer_Status_Counter.base);
+  ::google::protobuf::internal::InitSCC(&scc_info_GameServer_Status_List.base);
+  ::google::protobuf::internal::InitSCC(&scc_info_GameServer_Status_Disruption.base);
+  ::google::protobuf::internal::InitSCC(&scc_info_GameServer_Status_CountersEntry_DoNotUse.base);
+  ::google::protobuf::internal::InitSCC(&scc_info_GameServer_Status_ListsEntry_DoNotUse.base);
   ::google::protobuf::internal::InitSCC(&scc_info_GameServer_Status.base);
   ::google::protobuf::internal::InitSCC(&scc_info_GameServer.base);
 }
 
-::google::protobuf::Metadata file_level_metadata[11];
+::google::protobuf::Metadata file_level_metadata[18];
 
 const ::google::protobuf::uint32 TableStruct::offsets[] GOOGLE_PROTOBUF_ATTRIBUTE_SECTION_VARIABLE(protodesc_cold) = {
   ~0u,  // no _has_bits_
@@ -299,6 +447,20 @@ const ::google::protobuf::uint32 TableStruct::offsets[] GOOGLE_PROTOBUF_ATTRIBUT
   ~0u,  // no _oneof_case_
   ~0u,  // no _weak_field_map_
   GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(::agones::dev::sdk::Duration, seconds_),
+  ~0u,  // no _has_bits_
+  GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(::agones::dev::sdk::CounterUpdate, _internal_metadata_),
+  ~0u,  // no _extensions_
+  ~0u,  // no _oneof_case_
+  ~0u,  // no _weak_field_map_
+  GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(::agones::dev::sdk::CounterUpdate, name_),
+  GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(::agones::dev::sdk::CounterUpdate, amount_),
+  ~0u,  // no _has_bits_
+  GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(::agones::dev::sdk::ListValue, _internal_metadata_),
+  ~0u,  // no _extensions_
+  ~0u,  // no _oneof_case_
+  ~0u,  // no _weak_field_map_
+  GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(::agones::dev::sdk::ListValue, name_),
+  GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(::agones::dev::sdk::ListValue, value_),
   GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(::agones::dev::sdk::GameServer_ObjectMeta_AnnotationsEntry_DoNotUse, _has_bits_),
   GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(::agones::dev::sdk::GameServer_ObjectMeta_AnnotationsEntry_DoNotUse, _internal_metadata_),
   ~0u,  // no _extensions_
@@ -354,6 +516,46 @@ const ::google::protobuf::uint32 TableStruct::offsets[] GOOGLE_PROTOBUF_ATTRIBUT
   GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(::agones::dev::sdk::GameServer_Status_Port, name_),
   GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(::agones::dev::sdk::GameServer_Status_Port, port_),
   ~0u,  // no _has_bits_
+  GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(::agones::dev::sdk::GameServer_Status_Counter, _internal_metadata_),
+  ~0u,  // no _extensions_
+  ~0u,  // no _oneof_case_
+  ~0u,  // no _weak_field_map_
+  GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(::agones::dev::sdk::GameServer_Status_Counter, count_),
+  GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(::agones::dev::sdk::GameServer_Status_Counter, capacity_),
+  ~0u,  // no _has_bits_
+  GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(::agones::dev::sdk::GameServer_Status_List, _internal_metadata_),
+  ~0u,  // no _extensions_
+  ~0u,  // no _oneof_case_
+  ~0u,  // no _weak_field_map_
+  GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(::agones::dev::sdk::GameServer_Status_List, capacity_),
+  GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(::agones::dev::sdk::GameServer_Status_List, values_),
+  ~0u,  // no _has_bits_
+  GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(::agones::dev::sdk::GameServer_Status_Disruption, _internal_metadata_),
+  ~0u,  // no _extensions_
+  ~0u,  // no _oneof_case_
+  ~0u,  // no _weak_field_map_
+  GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(::agones::dev::sdk::GameServer_Status_Disruption, reason_),
+  GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(::agones::dev::sdk::GameServer_Status_Disruption, message_),
+  GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(::agones::dev::sdk::GameServer_Status_Disruption, since_),
+  GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(::agones::dev::sdk::GameServer_Status_CountersEntry_DoNotUse, _has_bits_),
+  GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(::agones::dev::sdk::GameServer_Status_CountersEntry_DoNotUse, _internal_metadata_),
+  ~0u,  // no _extensions_
+  ~0u,  // no _oneof_case_
+  ~0u,  // no _weak_field_map_
+  GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(::agones::dev::sdk::GameServer_Status_CountersEntry_DoNotUse, key_),
+  GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(::agones::dev::sdk::GameServer_Status_CountersEntry_DoNotUse, value_),
+  0,
+  1,
+  GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(::agones::dev::sdk::GameServer_Status_ListsEntry_DoNotUse, _has_bits_),
+  GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(::agones::dev::sdk::GameServer_Status_ListsEntry_DoNotUse, _internal_metadata_),
+  ~0u,  // no _extensions_
+  ~0u,  // no _oneof_case_
+  ~0u,  // no _weak_field_map_
+  GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(::agones::dev::sdk::GameServer_Status_ListsEntry_DoNotUse, key_),
+  GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(::agones::dev::sdk::GameServer_Status_ListsEntry_DoNotUse, value_),
+  0,
+  1,
+  ~0u,  // no _has_bits_
   GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(::agones::dev::sdk::GameServer_Status, _internal_metadata_),
   ~0u,  // no _extensions_
   ~0u,  // no _oneof_case_
@@ -361,6 +563,9 @@ const ::google::protobuf::uint32 TableStruct::offsets[] GOOGLE_PROTOBUF_ATTRIBUT
   GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(::agones::dev::sdk::GameServer_Status, state_),
   GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(::agones::dev::sdk::GameServer_Status, address_),
   GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(::agones::dev::sdk::GameServer_Status, ports_),
+  GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(::agones::dev::sdk::GameServer_Status, counters_),
+  GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(::agones::dev::sdk::GameServer_Status, lists_),
+  GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(::agones::dev::sdk::GameServer_Status, disruption_),
   ~0u,  // no _has_bits_
   GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(::agones::dev::sdk::GameServer, _internal_metadata_),
   ~0u,  // no _extensions_
@@ -374,26 +579,40 @@ static const ::google::protobuf::internal::MigrationSchema schemas[] GOOGLE_PROT
   { 0, -1, sizeof(::agones::dev::sdk::Empty)},
   { 5, -1, sizeof(::agones::dev::sdk::KeyValue)},
   { 12, -1, sizeof(::agones::dev::sdk::Duration)},
-  { 18, 25, sizeof(::agones::dev::sdk::GameServer_ObjectMeta_AnnotationsEntry_DoNotUse)},
-  { 27, 34, sizeof(::agones::dev::sdk::GameServer_ObjectMeta_LabelsEntry_DoNotUse)},
-  { 36, -1, sizeof(::agones::dev::sdk::GameServer_ObjectMeta)},
-  { 50, -1, sizeof(::agones::dev::sdk::GameServer_Spec_Health)},
-  { 59, -1, sizeof(::agones::dev::sdk::GameServer_Spec)},
-  { 65, -1, sizeof(::agones::dev::sdk::GameServer_Status_Port)},
-  { 72, -1, sizeof(::agones::dev::sdk::GameServer_Status)},
-  { 80, -1, sizeof(::agones::dev::sdk::GameServer)},
+  { 18, -1, sizeof(::agones::dev::sdk::CounterUpdate)},
+  { 25, -1, sizeof(::agones::dev::sdk::ListValue)},
+  { 32, 39, sizeof(::agones::dev::sdk::GameServer_ObjectMeta_AnnotationsEntry_DoNotUse)},
+  { 41, 48, sizeof(::agones::dev::sdk::GameServer_ObjectMeta_LabelsEntry_DoNotUse)},
+  { 50, -1, sizeof(::agones::dev::sdk::GameServer_ObjectMeta)},
+  { 64, -1, sizeof(::agones::dev::sdk::GameServer_Spec_Health)},
+  { 73, -1, sizeof(::agones::dev::sdk::GameServer_Spec)},
+  { 79, -1, sizeof(::agones::dev::sdk::GameServer_Status_Port)},
+  { 86, -1, sizeof(::agones::dev::sdk::GameServer_Status_Counter)},
+  { 93, -1, sizeof(::agones::dev::sdk::GameServer_Status_List)},
+  { 100, -1, sizeof(::agones::dev::sdk::GameServer_Status_Disruption)},
+  { 108, 115, sizeof(::agones::dev::sdk::GameServer_Status_CountersEntry_DoNotUse)},
+  { 117, 124, sizeof(::agones::dev::sdk::GameServer_Status_ListsEntry_DoNotUse)},
+  { 126, -1, sizeof(::agones::dev::sdk::GameServer_Status)},
+  { 137, -1, sizeof(::agones::dev::sdk::GameServer)},
 };
 
 static ::google::protobuf::Message const * const file_default_instances[] = {
   reinterpret_cast<const ::google::protobuf::Message*>(&::agones::dev::sdk::_Empty_default_instance_),
   reinterpret_cast<const ::google::protobuf::Message*>(&::agones::dev::sdk::_KeyValue_default_instance_),
   reinterpret_cast<const ::google::protobuf::Message*>(&::agones::dev::sdk::_Duration_default_instance_),
+  reinterpret_cast<const ::google::protobuf::Message*>(&::agones::dev::sdk::_CounterUpdate_default_instance_),
+  reinterpret_cast<const ::google::protobuf::Message*>(&::agones::dev::sdk::_ListValue_default_instance_),
   reinterpret_cast<const ::google::protobuf::Message*>(&::agones::dev::sdk::_GameServer_ObjectMeta_AnnotationsEntry_DoNotUse_default_instance_),
   reinterpret_cast<const ::google::protobuf::Message*>(&::agones::dev::sdk::_GameServer_ObjectMeta_LabelsEntry_DoNotUse_default_instance_),
   reinterpret_cast<const ::google::protobuf::Message*>(&::agones::dev::sdk::_GameServer_ObjectMeta_default_instance_),
   reinterpret_cast<const ::google::protobuf::Message*>(&::agones::dev::sdk::_GameServer_Spec_Health_default_instance_),
   reinterpret_cast<const ::google::protobuf::Message*>(&::agones::dev::sdk::_GameServer_Spec_default_instance_),
   reinterpret_cast<const ::google::protobuf::Message*>(&::agones::dev::sdk::_GameServer_Status_Port_default_instance_),
+  reinterpret_cast<const ::google::protobuf::Message*>(&::agones::dev::sdk::_GameServer_Status_Counter_default_instance_),
+  reinterpret_cast<const ::google::protobuf::Message*>(&::agones::dev::sdk::_GameServer_Status_List_default_instance_),
+  reinterpret_cast<const ::google::protobuf::Message*>(&::agones::dev::sdk::_GameServer_Status_Disruption_default_instance_),
+  reinterpret_cast<const ::google::protobuf::Message*>(&::agones::dev::sdk::_GameServer_Status_CountersEntry_DoNotUse_default_instance_),
+  reinterpret_cast<const ::google::protobuf::Message*>(&::agones::dev::sdk::_GameServer_Status_ListsEntry_DoNotUse_default_instance_),
   reinterpret_cast<const ::google::protobuf::Message*>(&::agones::dev::sdk::_GameServer_Status_default_instance_),
   reinterpret_cast<const ::google::protobuf::Message*>(&::agones::dev::sdk::_GameServer_default_instance_),
 };
@@ -413,7 +632,7 @@ void protobuf_AssignDescriptorsOnce() {
 void protobuf_RegisterTypes(const ::std::string&) GOOGLE_PROTOBUF_ATTRIBUTE_COLD;
 void protobuf_RegisterTypes(const ::std::string&) {
   protobuf_AssignDescriptorsOnce();
-  ::google::protobuf::internal::RegisterAllTypes(file_level_metadata, 11);
+  ::google::protobuf::internal::RegisterAllTypes(file_level_metadata, 18);
 }
 
 void AddDescriptorsImpl() {
@@ -422,52 +641,77 @@ void AddDescriptorsImpl() {
       "\n\tsdk.proto\022\016agones.dev.sdk\032\034google/api/"
       "annotations.proto\"\007\n\005Empty\"&\n\010KeyValue\022\013"
       "\n\003key\030\001 \001(\t\022\r\n\005value\030\002 \001(\t\"\033\n\010Duration\022\017"
-      "\n\007seconds\030\001 \001(\003\"\365\006\n\nGameServer\022:\n\013object"
-      "_meta\030\001 \001(\0132%.agones.dev.sdk.GameServer."
-      "ObjectMeta\022-\n\004spec\030\002 \001(\0132\037.agones.dev.sd"
-      "k.GameServer.Spec\0221\n\006status\030\003 \001(\0132!.agon"
-      "es.dev.sdk.GameServer.Status\032\223\003\n\nObjectM"
-      "eta\022\014\n\004name\030\001 \001(\t\022\021\n\tnamespace\030\002 \001(\t\022\013\n\003"
-      "uid\030\003 \001(\t\022\030\n\020resource_version\030\004 \001(\t\022\022\n\ng"
-      "eneration\030\005 \001(\003\022\032\n\022creation_timestamp\030\006 "
-      "\001(\003\022\032\n\022deletion_timestamp\030\007 \001(\003\022K\n\013annot"
-      "ations\030\010 \003(\01326.agones.dev.sdk.GameServer"
-      ".ObjectMeta.AnnotationsEntry\022A\n\006labels\030\t"
-      " \003(\01321.agones.dev.sdk.GameServer.ObjectM"
-      "eta.LabelsEntry\0322\n\020AnnotationsEntry\022\013\n\003k"
-      "ey\030\001 \001(\t\022\r\n\005value\030\002 \001(\t:\0028\001\032-\n\013LabelsEnt"
-      "ry\022\013\n\003key\030\001 \001(\t\022\r\n\005value\030\002 \001(\t:\0028\001\032\254\001\n\004S"
-      "pec\0226\n\006health\030\001 \001(\0132&.agones.dev.sdk.Gam"
-      "eServer.Spec.Health\032l\n\006Health\022\020\n\010disable"
-      "d\030\001 \001(\010\022\026\n\016period_seconds\030\002 \001(\005\022\031\n\021failu"
-      "re_threshold\030\003 \001(\005\022\035\n\025initial_delay_seco"
-      "nds\030\004 \001(\005\032\203\001\n\006Status\022\r\n\005state\030\001 \001(\t\022\017\n\007a"
-      "ddress\030\002 \001(\t\0225\n\005ports\030\003 \003(\0132&.agones.dev"
-      ".sdk.GameServer.Status.Port\032\"\n\004Port\022\014\n\004n"
-      "ame\030\001 \001(\t\022\014\n\004port\030\002 \001(\0052\206\006\n\003SDK\022H\n\005Ready"
-      "\022\025.agones.dev.sdk.Empty\032\025.agones.dev.sdk"
-      ".Empty\"\021\202\323\344\223\002\013\"\006/ready:\001*\022N\n\010Allocate\022\025."
-      "agones.dev.sdk.Empty\032\025.agones.dev.sdk.Em"
-      "pty\"\024\202\323\344\223\002\016\"\t/allocate:\001*\022N\n\010Shutdown\022\025."
-      "agones.dev.sdk.Empty\032\025.agones.dev.sdk.Em"
-      "pty\"\024\202\323\344\223\002\016\"\t/shutdown:\001*\022L\n\006Health\022\025.ag"
-      "ones.dev.sdk.Empty\032\025.agones.dev.sdk.Empt"
-      "y\"\022\202\323\344\223\002\014\"\007/health:\001*(\001\022W\n\rGetGameServer"
-      "\022\025.agones.dev.sdk.Empty\032\032.agones.dev.sdk"
-      ".GameServer\"\023\202\323\344\223\002\r\022\013/gameserver\022a\n\017Watc"
-      "hGameServer\022\025.agones.dev.sdk.Empty\032\032.ago"
-      "nes.dev.sdk.GameServer\"\031\202\323\344\223\002\023\022\021/watch/g"
-      "ameserver0\001\022W\n\010SetLabel\022\030.agones.dev.sdk"
-      ".KeyValue\032\025.agones.dev.sdk.Empty\"\032\202\323\344\223\002\024"
-      "\032\017/metadata/label:\001*\022a\n\rSetAnnotation\022\030."
-      "agones.dev.sdk.KeyValue\032\025.agones.dev.sdk"
-      ".Empty\"\037\202\323\344\223\002\031\032\024/metadata/annotation:\001*\022"
-      "O\n\007Reserve\022\030.agones.dev.sdk.Duration\032\025.a"
-      "gones.dev.sdk.Empty\"\023\202\323\344\223\002\r\"\010/reserve:\001*"
-      "B\005Z\003sdkb\006proto3"
+      "\n\007seconds\030\001 \001(\003\"-\n\rCounterUpdate\022\014\n\004name"
+      "\030\001 \001(\t\022\016\n\006amount\030\002 \001(\003\"(\n\tListValue\022\014\n\004n"
+      "ame\030\001 \001(\t\022\r\n\005value\030\002 \001(\t\"\375\n\n\nGameServer\022"
+      ":\n\013object_meta\030\001 \001(\0132%.agones.dev.sdk.Ga"
+      "meServer.ObjectMeta\022-\n\004spec\030\002 \001(\0132\037.agon"
+      "es.dev.sdk.GameServer.Spec\0221\n\006status\030\003 \001"
+      "(\0132!.agones.dev.sdk.GameServer.Status\032\223\003"
+      "\n\nObjectMeta\022\014\n\004name\030\001 \001(\t\022\021\n\tnamespace\030"
+      "\002 \001(\t\022\013\n\003uid\030\003 \001(\t\022\030\n\020resource_version\030\004"
+      " \001(\t\022\022\n\ngeneration\030\005 \001(\003\022\032\n\022creation_tim"
+      "estamp\030\006 \001(\003\022\032\n\022deletion_timestamp\030\007 \001(\003"
+      "\022K\n\013annotations\030\010 \003(\01326.agones.dev.sdk.G"
+      "ameServer.ObjectMeta.AnnotationsEntry\022A\n"
+      "\006labels\030\t \003(\01321.agones.dev.sdk.GameServe"
+      "r.ObjectMeta.LabelsEntry\0322\n\020AnnotationsE"
+      "ntry\022\013\n\003key\030\001 \001(\t\022\r\n\005value\030\002 \001(\t:\0028\001\032-\n\013"
+      "LabelsEntry\022\013\n\003key\030\001 \001(\t\022\r\n\005value\030\002 \001(\t:"
+      "\0028\001\032\254\001\n\004Spec\0226\n\006health\030\001 \001(\0132&.agones.de"
+      "v.sdk.GameServer.Spec.Health\032l\n\006Health\022\020"
+      "\n\010disabled\030\001 \001(\010\022\026\n\016period_seconds\030\002 \001(\005"
+      "\022\031\n\021failure_threshold\030\003 \001(\005\022\035\n\025initial_d"
+      "elay_seconds\030\004 \001(\005\032\213\005\n\006Status\022\r\n\005state\030\001"
+      " \001(\t\022\017\n\007address\030\002 \001(\t\0225\n\005ports\030\003 \003(\0132&.a"
+      "gones.dev.sdk.GameServer.Status.Port\022A\n\010"
+      "counters\030\004 \003(\0132/.agones.dev.sdk.GameServ"
+      "er.Status.CountersEntry\022;\n\005lists\030\005 \003(\0132,"
+      ".agones.dev.sdk.GameServer.Status.ListsE"
+      "ntry\022@\n\ndisruption\030\006 \001(\0132,.agones.dev.sd"
+      "k.GameServer.Status.Disruption\032\"\n\004Port\022\014"
+      "\n\004name\030\001 \001(\t\022\014\n\004port\030\002 \001(\005\032*\n\007Counter\022\r\n"
+      "\005count\030\001 \001(\003\022\020\n\010capacity\030\002 \001(\003\032(\n\004List\022\020"
+      "\n\010capacity\030\001 \001(\003\022\016\n\006values\030\002 \003(\t\032<\n\nDisr"
+      "uption\022\016\n\006reason\030\001 \001(\t\022\017\n\007message\030\002 \001(\t\022"
+      "\r\n\005since\030\003 \001(\003\032Z\n\rCountersEntry\022\013\n\003key\030\001"
+      " \001(\t\0228\n\005value\030\002 \001(\0132).agones.dev.sdk.Gam"
+      "eServer.Status.Counter:\0028\001\032T\n\nListsEntry"
+      "\022\013\n\003key\030\001 \001(\t\0225\n\005value\030\002 \001(\0132&.agones.de"
+      "v.sdk.GameServer.Status.List:\0028\0012\225\t\n\003SDK"
+      "\022H\n\005Ready\022\025.agones.dev.sdk.Empty\032\025.agone"
+      "s.dev.sdk.Empty\"\021\202\323\344\223\002\013\"\006/ready:\001*\022N\n\010Al"
+      "locate\022\025.agones.dev.sdk.Empty\032\025.agones.d"
+      "ev.sdk.Empty\"\024\202\323\344\223\002\016\"\t/allocate:\001*\022N\n\010Sh"
+      "utdown\022\025.agones.dev.sdk.Empty\032\025.agones.d"
+      "ev.sdk.Empty\"\024\202\323\344\223\002\016\"\t/shutdown:\001*\022L\n\006He"
+      "alth\022\025.agones.dev.sdk.Empty\032\025.agones.dev"
+      ".sdk.Empty\"\022\202\323\344\223\002\014\"\007/health:\001*(\001\022W\n\rGetG"
+      "ameServer\022\025.agones.dev.sdk.Empty\032\032.agone"
+      "s.dev.sdk.GameServer\"\023\202\323\344\223\002\r\022\013/gameserve"
+      "r\022a\n\017WatchGameServer\022\025.agones.dev.sdk.Em"
+      "pty\032\032.agones.dev.sdk.GameServer\"\031\202\323\344\223\002\023\022"
+      "\021/watch/gameserver0\001\022W\n\010SetLabel\022\030.agone"
+      "s.dev.sdk.KeyValue\032\025.agones.dev.sdk.Empt"
+      "y\"\032\202\323\344\223\002\024\032\017/metadata/label:\001*\022a\n\rSetAnno"
+      "tation\022\030.agones.dev.sdk.KeyValue\032\025.agone"
+      "s.dev.sdk.Empty\"\037\202\323\344\223\002\031\032\024/metadata/annot"
+      "ation:\001*\022O\n\007Reserve\022\030.agones.dev.sdk.Dur"
+      "ation\032\025.agones.dev.sdk.Empty\"\023\202\323\344\223\002\r\"\010/r"
+      "eserve:\001*\022g\n\020IncrementCounter\022\035.agones.d"
+      "ev.sdk.CounterUpdate\032\025.agones.dev.sdk.Em"
+      "pty\"\035\202\323\344\223\002\027\"\022/counter/increment:\001*\022h\n\022Se"
+      "tCounterCapacity\022\035.agones.dev.sdk.Counte"
+      "rUpdate\032\025.agones.dev.sdk.Empty\"\034\202\323\344\223\002\026\032\021"
+      "/counter/capacity:\001*\022\\\n\017AppendListValue\022"
+      "\031.agones.dev.sdk.ListValue\032\025.agones.dev."
+      "sdk.Empty\"\027\202\323\344\223\002\021\"\014/list/append:\001*\022\\\n\017De"
+      "leteListValue\022\031.agones.dev.sdk.ListValue"
+      "\032\025.agones.dev.sdk.Empty\"\027\202\323\344\223\002\021\"\014/list/d"
+      "elete:\001*B\005Z\003sdkb\006proto3"
   };
   ::google::protobuf::DescriptorPool::InternalAddGeneratedFile(
-      descriptor, 1815);
+      descriptor, 2823);
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedFile(
     "sdk.proto", &protobuf_RegisterTypes);
   ::protobuf_google_2fapi_2fannotations_2eproto::AddDescriptors();
@@ -1192,148 +1436,76 @@ void Duration::InternalSwap(Duration* other) {
 
 // ===================================================================
 
-GameServer_ObjectMeta_AnnotationsEntry_DoNotUse::GameServer_ObjectMeta_AnnotationsEntry_DoNotUse() {}
-GameServer_ObjectMeta_AnnotationsEntry_DoNotUse::GameServer_ObjectMeta_AnnotationsEntry_DoNotUse(::google::protobuf::Arena* arena) : SuperType(arena) {}
-void GameServer_ObjectMeta_AnnotationsEntry_DoNotUse::MergeFrom(const GameServer_ObjectMeta_AnnotationsEntry_DoNotUse& other) {
-  MergeFromInternal(other);
-}
-::google::protobuf::Metadata GameServer_ObjectMeta_AnnotationsEntry_DoNotUse::GetMetadata() const {
-  ::protobuf_sdk_2eproto::protobuf_AssignDescriptorsOnce();
-  return ::protobuf_sdk_2eproto::file_level_metadata[3];
-}
-void GameServer_ObjectMeta_AnnotationsEntry_DoNotUse::MergeFrom(
-    const ::google::protobuf::Message& other) {
-  ::google::protobuf::Message::MergeFrom(other);
-}
-
-
-// ===================================================================
-
-GameServer_ObjectMeta_LabelsEntry_DoNotUse::GameServer_ObjectMeta_LabelsEntry_DoNotUse() {}
-GameServer_ObjectMeta_LabelsEntry_DoNotUse::GameServer_ObjectMeta_LabelsEntry_DoNotUse(::google::protobuf::Arena* arena) : SuperType(arena) {}
-void GameServer_ObjectMeta_LabelsEntry_DoNotUse::MergeFrom(const GameServer_ObjectMeta_LabelsEntry_DoNotUse& other) {
-  MergeFromInternal(other);
-}
-::google::protobuf::Metadata GameServer_ObjectMeta_LabelsEntry_DoNotUse::GetMetadata() const {
-  ::protobuf_sdk_2eproto::protobuf_AssignDescriptorsOnce();
-  return ::protobuf_sdk_2eproto::file_level_metadata[4];
-}
-void GameServer_ObjectMeta_LabelsEntry_DoNotUse::MergeFrom(
-    const ::google::protobuf::Message& other) {
-  ::google::protobuf::Message::MergeFrom(other);
-}
-
-
-// ===================================================================
-
-void GameServer_ObjectMeta::InitAsDefaultInstance() {
+void CounterUpdate::InitAsDefaultInstance() {
 }
 #if !defined(_MSC_VER) || _MSC_VER >= 1900
-const int GameServer_ObjectMeta::kNameFieldNumber;
-const int GameServer_ObjectMeta::kNamespaceFieldNumber;
-const int GameServer_ObjectMeta::kUidFieldNumber;
-const int GameServer_ObjectMeta::kResourceVersionFieldNumber;
-const int GameServer_ObjectMeta::kGenerationFieldNumber;
-const int GameServer_ObjectMeta::kCreationTimestampFieldNumber;
-const int GameServer_ObjectMeta::kDeletionTimestampFieldNumber;
-const int GameServer_ObjectMeta::kAnnotationsFieldNumber;
-const int GameServer_ObjectMeta::kLabelsFieldNumber;
+const int CounterUpdate::kNameFieldNumber;
+const int CounterUpdate::kAmountFieldNumber;
 #endif  // !defined(_MSC_VER) || _MSC_VER >= 1900
 
-GameServer_ObjectMeta::GameServer_ObjectMeta()
+CounterUpdate::CounterUpdate()
   : ::google::protobuf::Message(), _internal_metadata_(NULL) {
   ::google::protobuf::internal::InitSCC(
-      &protobuf_sdk_2eproto::scc_info_GameServer_ObjectMeta.base);
+      &protobuf_sdk_2eproto::scc_info_CounterUpdate.base);
   SharedCtor();
-  // @@protoc_insertion_point(constructor:agones.dev.sdk.GameServer.ObjectMeta)
+  // @@protoc_insertion_point(constructor:agones.dev.sdk.CounterUpdate)
 }
-GameServer_ObjectMeta::GameServer_ObjectMeta(const GameServer_ObjectMeta& from)
+CounterUpdate::CounterUpdate(const CounterUpdate& from)
   : ::google::protobuf::Message(),
       _internal_metadata_(NULL) {
   _internal_metadata_.MergeFrom(from._internal_metadata_);
-  annotations_.MergeFrom(from.annotations_);
-  labels_.MergeFrom(from.labels_);
   name_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
   if (from.name().size() > 0) {
     name_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.name_);
   }
-  namespace__.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-  if (from.namespace_().size() > 0) {
-    namespace__.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.namespace__);
-  }
-  uid_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-  if (from.uid().size() > 0) {
-    uid_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.uid_);
-  }
-  resource_version_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-  if (from.resource_version().size() > 0) {
-    resource_version_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.resource_version_);
-  }
-  ::memcpy(&generation_, &from.generation_,
-    static_cast<size_t>(reinterpret_cast<char*>(&deletion_timestamp_) -
-    reinterpret_cast<char*>(&generation_)) + sizeof(deletion_timestamp_));
-  // @@protoc_insertion_point(copy_constructor:agones.dev.sdk.GameServer.ObjectMeta)
+  amount_ = from.amount_;
+  // @@protoc_insertion_point(copy_constructor:agones.dev.sdk.CounterUpdate)
 }
 
-void GameServer_ObjectMeta::SharedCtor() {
+void CounterUpdate::SharedCtor() {
   name_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-  namespace__.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-  uid_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-  resource_version_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-  ::memset(&generation_, 0, static_cast<size_t>(
-      reinterpret_cast<char*>(&deletion_timestamp_) -
-      reinterpret_cast<char*>(&generation_)) + sizeof(deletion_timestamp_));
+  amount_ = GOOGLE_LONGLONG(0);
 }
 
-GameServer_ObjectMeta::~GameServer_ObjectMeta() {
-  // @@protoc_insertion_point(destructor:agones.dev.sdk.GameServer.ObjectMeta)
+CounterUpdate::~CounterUpdate() {
+  // @@protoc_insertion_point(destructor:agones.dev.sdk.CounterUpdate)
   SharedDtor();
 }
 
-void GameServer_ObjectMeta::SharedDtor() {
+void CounterUpdate::SharedDtor() {
   name_.DestroyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-  namespace__.DestroyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-  uid_.DestroyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-  resource_version_.DestroyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
 }
 
-void GameServer_ObjectMeta::SetCachedSize(int size) const {
+void CounterUpdate::SetCachedSize(int size) const {
   _cached_size_.Set(size);
 }
-const ::google::protobuf::Descriptor* GameServer_ObjectMeta::descriptor() {
+const ::google::protobuf::Descriptor* CounterUpdate::descriptor() {
   ::protobuf_sdk_2eproto::protobuf_AssignDescriptorsOnce();
   return ::protobuf_sdk_2eproto::file_level_metadata[kIndexInFileMessages].descriptor;
 }
 
-const GameServer_ObjectMeta& GameServer_ObjectMeta::default_instance() {
-  ::google::protobuf::internal::InitSCC(&protobuf_sdk_2eproto::scc_info_GameServer_ObjectMeta.base);
+const CounterUpdate& CounterUpdate::default_instance() {
+  ::google::protobuf::internal::InitSCC(&protobuf_sdk_2eproto::scc_info_CounterUpdate.base);
   return *internal_default_instance();
 }
 
 
-void GameServer_ObjectMeta::Clear() {
-// @@protoc_insertion_point(message_clear_start:agones.dev.sdk.GameServer.ObjectMeta)
+void CounterUpdate::Clear() {
+// @@protoc_insertion_point(message_clear_start:agones.dev.sdk.CounterUpdate)
   ::google::protobuf::uint32 cached_has_bits = 0;
   // Prevent compiler warnings about cached_has_bits being unused
   (void) cached_has_bits;
 
-  annotations_.Clear();
-  labels_.Clear();
   name_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-  namespace__.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-  uid_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-  resource_version_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-  ::memset(&generation_, 0, static_cast<size_t>(
-      reinterpret_cast<char*>(&deletion_timestamp_) -
-      reinterpret_cast<char*>(&generation_)) + sizeof(deletion_timestamp_));
+  amount_ = GOOGLE_LONGLONG(0);
   _internal_metadata_.Clear();
 }
 
-bool GameServer_ObjectMeta::MergePartialFromCodedStream(
+bool CounterUpdate::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!GOOGLE_PREDICT_TRUE(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:agones.dev.sdk.GameServer.ObjectMeta)
+  // @@protoc_insertion_point(parse_start:agones.dev.sdk.CounterUpdate)
   for (;;) {
     ::std::pair<::google::protobuf::uint32, bool> p = input->ReadTagWithCutoffNoLastTag(127u);
     tag = p.first;
@@ -1348,151 +1520,308 @@ bool GameServer_ObjectMeta::MergePartialFromCodedStream(
           DO_(::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
             this->name().data(), static_cast<int>(this->name().length()),
             ::google::protobuf::internal::WireFormatLite::PARSE,
-            "agones.dev.sdk.GameServer.ObjectMeta.name"));
+            "agones.dev.sdk.CounterUpdate.name"));
         } else {
           goto handle_unusual;
         }
         break;
       }
 
-      // string namespace = 2;
+      // int64 amount = 2;
       case 2: {
         if (static_cast< ::google::protobuf::uint8>(tag) ==
-            static_cast< ::google::protobuf::uint8>(18u /* 18 & 0xFF */)) {
-          DO_(::google::protobuf::internal::WireFormatLite::ReadString(
-                input, this->mutable_namespace_()));
-          DO_(::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
-            this->namespace_().data(), static_cast<int>(this->namespace_().length()),
-            ::google::protobuf::internal::WireFormatLite::PARSE,
-            "agones.dev.sdk.GameServer.ObjectMeta.namespace"));
-        } else {
-          goto handle_unusual;
-        }
-        break;
-      }
+            static_cast< ::google::protobuf::uint8>(16u /* 16 & 0xFF */)) {
 
-      // string uid = 3;
-      case 3: {
-        if (static_cast< ::google::protobuf::uint8>(tag) ==
-            static_cast< ::google::protobuf::uint8>(26u /* 26 & 0xFF */)) {
-          DO_(::google::protobuf::internal::WireFormatLite::ReadString(
-                input, this->mutable_uid()));
-          DO_(::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
-            this->uid().data(), static_cast<int>(this->uid().length()),
-            ::google::protobuf::internal::WireFormatLite::PARSE,
-            "agones.dev.sdk.GameServer.ObjectMeta.uid"));
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &amount_)));
         } else {
           goto handle_unusual;
         }
         break;
       }
 
-      // string resource_version = 4;
-      case 4: {
-        if (static_cast< ::google::protobuf::uint8>(tag) ==
-            static_cast< ::google::protobuf::uint8>(34u /* 34 & 0xFF */)) {
-          DO_(::google::protobuf::internal::WireFormatLite::ReadString(
-                input, this->mutable_resource_version()));
-          DO_(::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
-            this->resource_version().data(), static_cast<int>(this->resource_version().length()),
-            ::google::protobuf::internal::WireFormatLite::PARSE,
-            "agones.dev.sdk.GameServer.ObjectMeta.resource_version"));
-        } else {
-          goto handle_unusual;
+      default: {
+      handle_unusual:
+        if (tag == 0) {
+          goto success;
         }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, _internal_metadata_.mutable_unknown_fields()));
         break;
       }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:agones.dev.sdk.CounterUpdate)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:agones.dev.sdk.CounterUpdate)
+  return false;
+#undef DO_
+}
 
-      // int64 generation = 5;
-      case 5: {
-        if (static_cast< ::google::protobuf::uint8>(tag) ==
-            static_cast< ::google::protobuf::uint8>(40u /* 40 & 0xFF */)) {
+void CounterUpdate::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:agones.dev.sdk.CounterUpdate)
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  (void) cached_has_bits;
 
-          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
-                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
-                 input, &generation_)));
-        } else {
-          goto handle_unusual;
-        }
-        break;
-      }
+  // string name = 1;
+  if (this->name().size() > 0) {
+    ::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+      this->name().data(), static_cast<int>(this->name().length()),
+      ::google::protobuf::internal::WireFormatLite::SERIALIZE,
+      "agones.dev.sdk.CounterUpdate.name");
+    ::google::protobuf::internal::WireFormatLite::WriteStringMaybeAliased(
+      1, this->name(), output);
+  }
 
-      // int64 creation_timestamp = 6;
-      case 6: {
-        if (static_cast< ::google::protobuf::uint8>(tag) ==
-            static_cast< ::google::protobuf::uint8>(48u /* 48 & 0xFF */)) {
+  // int64 amount = 2;
+  if (this->amount() != 0) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(2, this->amount(), output);
+  }
 
-          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
-                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
-                 input, &creation_timestamp_)));
-        } else {
-          goto handle_unusual;
-        }
-        break;
-      }
+  if ((_internal_metadata_.have_unknown_fields() &&  ::google::protobuf::internal::GetProto3PreserveUnknownsDefault())) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        (::google::protobuf::internal::GetProto3PreserveUnknownsDefault()   ? _internal_metadata_.unknown_fields()   : _internal_metadata_.default_instance()), output);
+  }
+  // @@protoc_insertion_point(serialize_end:agones.dev.sdk.CounterUpdate)
+}
 
-      // int64 deletion_timestamp = 7;
-      case 7: {
-        if (static_cast< ::google::protobuf::uint8>(tag) ==
-            static_cast< ::google::protobuf::uint8>(56u /* 56 & 0xFF */)) {
+::google::protobuf::uint8* CounterUpdate::InternalSerializeWithCachedSizesToArray(
+    bool deterministic, ::google::protobuf::uint8* target) const {
+  (void)deterministic; // Unused
+  // @@protoc_insertion_point(serialize_to_array_start:agones.dev.sdk.CounterUpdate)
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  (void) cached_has_bits;
 
-          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
-                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
-                 input, &deletion_timestamp_)));
-        } else {
-          goto handle_unusual;
-        }
-        break;
-      }
+  // string name = 1;
+  if (this->name().size() > 0) {
+    ::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+      this->name().data(), static_cast<int>(this->name().length()),
+      ::google::protobuf::internal::WireFormatLite::SERIALIZE,
+      "agones.dev.sdk.CounterUpdate.name");
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteStringToArray(
+        1, this->name(), target);
+  }
 
-      // map<string, string> annotations = 8;
-      case 8: {
+  // int64 amount = 2;
+  if (this->amount() != 0) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(2, this->amount(), target);
+  }
+
+  if ((_internal_metadata_.have_unknown_fields() &&  ::google::protobuf::internal::GetProto3PreserveUnknownsDefault())) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        (::google::protobuf::internal::GetProto3PreserveUnknownsDefault()   ? _internal_metadata_.unknown_fields()   : _internal_metadata_.default_instance()), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:agones.dev.sdk.CounterUpdate)
+  return target;
+}
+
+size_t CounterUpdate::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:agones.dev.sdk.CounterUpdate)
+  size_t total_size = 0;
+
+  if ((_internal_metadata_.have_unknown_fields() &&  ::google::protobuf::internal::GetProto3PreserveUnknownsDefault())) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        (::google::protobuf::internal::GetProto3PreserveUnknownsDefault()   ? _internal_metadata_.unknown_fields()   : _internal_metadata_.default_instance()));
+  }
+  // string name = 1;
+  if (this->name().size() > 0) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::StringSize(
+        this->name());
+  }
+
+  // int64 amount = 2;
+  if (this->amount() != 0) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::Int64Size(
+        this->amount());
+  }
+
+  int cached_size = ::google::protobuf::internal::ToCachedSize(total_size);
+  SetCachedSize(cached_size);
+  return total_size;
+}
+
+void CounterUpdate::MergeFrom(const ::google::protobuf::Message& from) {
+// @@protoc_insertion_point(generalized_merge_from_start:agones.dev.sdk.CounterUpdate)
+  GOOGLE_DCHECK_NE(&from, this);
+  const CounterUpdate* source =
+      ::google::protobuf::internal::DynamicCastToGenerated<const CounterUpdate>(
+          &from);
+  if (source == NULL) {
+  // @@protoc_insertion_point(generalized_merge_from_cast_fail:agones.dev.sdk.CounterUpdate)
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+  // @@protoc_insertion_point(generalized_merge_from_cast_success:agones.dev.sdk.CounterUpdate)
+    MergeFrom(*source);
+  }
+}
+
+void CounterUpdate::MergeFrom(const CounterUpdate& from) {
+// @@protoc_insertion_point(class_specific_merge_from_start:agones.dev.sdk.CounterUpdate)
+  GOOGLE_DCHECK_NE(&from, this);
+  _internal_metadata_.MergeFrom(from._internal_metadata_);
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  if (from.name().size() > 0) {
+
+    name_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.name_);
+  }
+  if (from.amount() != 0) {
+    set_amount(from.amount());
+  }
+}
+
+void CounterUpdate::CopyFrom(const ::google::protobuf::Message& from) {
+// @@protoc_insertion_point(generalized_copy_from_start:agones.dev.sdk.CounterUpdate)
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void CounterUpdate::CopyFrom(const CounterUpdate& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:agones.dev.sdk.CounterUpdate)
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool CounterUpdate::IsInitialized() const {
+  return true;
+}
+
+void CounterUpdate::Swap(CounterUpdate* other) {
+  if (other == this) return;
+  InternalSwap(other);
+}
+void CounterUpdate::InternalSwap(CounterUpdate* other) {
+  using std::swap;
+  name_.Swap(&other->name_, &::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+    GetArenaNoVirtual());
+  swap(amount_, other->amount_);
+  _internal_metadata_.Swap(&other->_internal_metadata_);
+}
+
+::google::protobuf::Metadata CounterUpdate::GetMetadata() const {
+  protobuf_sdk_2eproto::protobuf_AssignDescriptorsOnce();
+  return ::protobuf_sdk_2eproto::file_level_metadata[kIndexInFileMessages];
+}
+
+
+// ===================================================================
+
+void ListValue::InitAsDefaultInstance() {
+}
+#if !defined(_MSC_VER) || _MSC_VER >= 1900
+const int ListValue::kNameFieldNumber;
+const int ListValue::kValueFieldNumber;
+#endif  // !defined(_MSC_VER) || _MSC_VER >= 1900
+
+ListValue::ListValue()
+  : ::google::protobuf::Message(), _internal_metadata_(NULL) {
+  ::google::protobuf::internal::InitSCC(
+      &protobuf_sdk_2eproto::scc_info_ListValue.base);
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:agones.dev.sdk.ListValue)
+}
+ListValue::ListValue(const ListValue& from)
+  : ::google::protobuf::Message(),
+      _internal_metadata_(NULL) {
+  _internal_metadata_.MergeFrom(from._internal_metadata_);
+  name_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  if (from.name().size() > 0) {
+    name_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.name_);
+  }
+  value_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  if (from.value().size() > 0) {
+    value_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.value_);
+  }
+  // @@protoc_insertion_point(copy_constructor:agones.dev.sdk.ListValue)
+}
+
+void ListValue::SharedCtor() {
+  name_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  value_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+
+ListValue::~ListValue() {
+  // @@protoc_insertion_point(destructor:agones.dev.sdk.ListValue)
+  SharedDtor();
+}
+
+void ListValue::SharedDtor() {
+  name_.DestroyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  value_.DestroyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+
+void ListValue::SetCachedSize(int size) const {
+  _cached_size_.Set(size);
+}
+const ::google::protobuf::Descriptor* ListValue::descriptor() {
+  ::protobuf_sdk_2eproto::protobuf_AssignDescriptorsOnce();
+  return ::protobuf_sdk_2eproto::file_level_metadata[kIndexInFileMessages].descriptor;
+}
+
+const ListValue& ListValue::default_instance() {
+  ::google::protobuf::internal::InitSCC(&protobuf_sdk_2eproto::scc_info_ListValue.base);
+  return *internal_default_instance();
+}
+
+
+void ListValue::Clear() {
+// @@protoc_insertion_point(message_clear_start:agones.dev.sdk.ListValue)
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  name_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  value_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  _internal_metadata_.Clear();
+}
+
+bool ListValue::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!GOOGLE_PREDICT_TRUE(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:agones.dev.sdk.ListValue)
+  for (;;) {
+    ::std::pair<::google::protobuf::uint32, bool> p = input->ReadTagWithCutoffNoLastTag(127u);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // string name = 1;
+      case 1: {
         if (static_cast< ::google::protobuf::uint8>(tag) ==
-            static_cast< ::google::protobuf::uint8>(66u /* 66 & 0xFF */)) {
-          GameServer_ObjectMeta_AnnotationsEntry_DoNotUse::Parser< ::google::protobuf::internal::MapField<
-              GameServer_ObjectMeta_AnnotationsEntry_DoNotUse,
-              ::std::string, ::std::string,
-              ::google::protobuf::internal::WireFormatLite::TYPE_STRING,
-              ::google::protobuf::internal::WireFormatLite::TYPE_STRING,
-              0 >,
-            ::google::protobuf::Map< ::std::string, ::std::string > > parser(&annotations_);
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-              input, &parser));
-          DO_(::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
-            parser.key().data(), static_cast<int>(parser.key().length()),
-            ::google::protobuf::internal::WireFormatLite::PARSE,
-            "agones.dev.sdk.GameServer.ObjectMeta.AnnotationsEntry.key"));
+            static_cast< ::google::protobuf::uint8>(10u /* 10 & 0xFF */)) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadString(
+                input, this->mutable_name()));
           DO_(::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
-            parser.value().data(), static_cast<int>(parser.value().length()),
+            this->name().data(), static_cast<int>(this->name().length()),
             ::google::protobuf::internal::WireFormatLite::PARSE,
-            "agones.dev.sdk.GameServer.ObjectMeta.AnnotationsEntry.value"));
+            "agones.dev.sdk.ListValue.name"));
         } else {
           goto handle_unusual;
         }
         break;
       }
 
-      // map<string, string> labels = 9;
-      case 9: {
+      // string value = 2;
+      case 2: {
         if (static_cast< ::google::protobuf::uint8>(tag) ==
-            static_cast< ::google::protobuf::uint8>(74u /* 74 & 0xFF */)) {
-          GameServer_ObjectMeta_LabelsEntry_DoNotUse::Parser< ::google::protobuf::internal::MapField<
-              GameServer_ObjectMeta_LabelsEntry_DoNotUse,
-              ::std::string, ::std::string,
-              ::google::protobuf::internal::WireFormatLite::TYPE_STRING,
-              ::google::protobuf::internal::WireFormatLite::TYPE_STRING,
-              0 >,
-            ::google::protobuf::Map< ::std::string, ::std::string > > parser(&labels_);
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-              input, &parser));
-          DO_(::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
-            parser.key().data(), static_cast<int>(parser.key().length()),
-            ::google::protobuf::internal::WireFormatLite::PARSE,
-            "agones.dev.sdk.GameServer.ObjectMeta.LabelsEntry.key"));
+            static_cast< ::google::protobuf::uint8>(18u /* 18 & 0xFF */)) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadString(
+                input, this->mutable_value()));
           DO_(::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
-            parser.value().data(), static_cast<int>(parser.value().length()),
+            this->value().data(), static_cast<int>(this->value().length()),
             ::google::protobuf::internal::WireFormatLite::PARSE,
-            "agones.dev.sdk.GameServer.ObjectMeta.LabelsEntry.value"));
+            "agones.dev.sdk.ListValue.value"));
         } else {
           goto handle_unusual;
         }
@@ -1511,17 +1840,17 @@ bool GameServer_ObjectMeta::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:agones.dev.sdk.GameServer.ObjectMeta)
+  // @@protoc_insertion_point(parse_success:agones.dev.sdk.ListValue)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:agones.dev.sdk.GameServer.ObjectMeta)
+  // @@protoc_insertion_point(parse_failure:agones.dev.sdk.ListValue)
   return false;
 #undef DO_
 }
 
-void GameServer_ObjectMeta::SerializeWithCachedSizes(
+void ListValue::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:agones.dev.sdk.GameServer.ObjectMeta)
+  // @@protoc_insertion_point(serialize_start:agones.dev.sdk.ListValue)
   ::google::protobuf::uint32 cached_has_bits = 0;
   (void) cached_has_bits;
 
@@ -1530,173 +1859,32 @@ void GameServer_ObjectMeta::SerializeWithCachedSizes(
     ::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
       this->name().data(), static_cast<int>(this->name().length()),
       ::google::protobuf::internal::WireFormatLite::SERIALIZE,
-      "agones.dev.sdk.GameServer.ObjectMeta.name");
+      "agones.dev.sdk.ListValue.name");
     ::google::protobuf::internal::WireFormatLite::WriteStringMaybeAliased(
       1, this->name(), output);
   }
 
-  // string namespace = 2;
-  if (this->namespace_().size() > 0) {
+  // string value = 2;
+  if (this->value().size() > 0) {
     ::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
-      this->namespace_().data(), static_cast<int>(this->namespace_().length()),
+      this->value().data(), static_cast<int>(this->value().length()),
       ::google::protobuf::internal::WireFormatLite::SERIALIZE,
-      "agones.dev.sdk.GameServer.ObjectMeta.namespace");
+      "agones.dev.sdk.ListValue.value");
     ::google::protobuf::internal::WireFormatLite::WriteStringMaybeAliased(
-      2, this->namespace_(), output);
-  }
-
-  // string uid = 3;
-  if (this->uid().size() > 0) {
-    ::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
-      this->uid().data(), static_cast<int>(this->uid().length()),
-      ::google::protobuf::internal::WireFormatLite::SERIALIZE,
-      "agones.dev.sdk.GameServer.ObjectMeta.uid");
-    ::google::protobuf::internal::WireFormatLite::WriteStringMaybeAliased(
-      3, this->uid(), output);
-  }
-
-  // string resource_version = 4;
-  if (this->resource_version().size() > 0) {
-    ::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
-      this->resource_version().data(), static_cast<int>(this->resource_version().length()),
-      ::google::protobuf::internal::WireFormatLite::SERIALIZE,
-      "agones.dev.sdk.GameServer.ObjectMeta.resource_version");
-    ::google::protobuf::internal::WireFormatLite::WriteStringMaybeAliased(
-      4, this->resource_version(), output);
-  }
-
-  // int64 generation = 5;
-  if (this->generation() != 0) {
-    ::google::protobuf::internal::WireFormatLite::WriteInt64(5, this->generation(), output);
-  }
-
-  // int64 creation_timestamp = 6;
-  if (this->creation_timestamp() != 0) {
-    ::google::protobuf::internal::WireFormatLite::WriteInt64(6, this->creation_timestamp(), output);
-  }
-
-  // int64 deletion_timestamp = 7;
-  if (this->deletion_timestamp() != 0) {
-    ::google::protobuf::internal::WireFormatLite::WriteInt64(7, this->deletion_timestamp(), output);
-  }
-
-  // map<string, string> annotations = 8;
-  if (!this->annotations().empty()) {
-    typedef ::google::protobuf::Map< ::std::string, ::std::string >::const_pointer
-        ConstPtr;
-    typedef ConstPtr SortItem;
-    typedef ::google::protobuf::internal::CompareByDerefFirst<SortItem> Less;
-    struct Utf8Check {
-      static void Check(ConstPtr p) {
-        ::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
-          p->first.data(), static_cast<int>(p->first.length()),
-          ::google::protobuf::internal::WireFormatLite::SERIALIZE,
-          "agones.dev.sdk.GameServer.ObjectMeta.AnnotationsEntry.key");
-        ::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
-          p->second.data(), static_cast<int>(p->second.length()),
-          ::google::protobuf::internal::WireFormatLite::SERIALIZE,
-          "agones.dev.sdk.GameServer.ObjectMeta.AnnotationsEntry.value");
-      }
-    };
-
-    if (output->IsSerializationDeterministic() &&
-        this->annotations().size() > 1) {
-      ::std::unique_ptr<SortItem[]> items(
-          new SortItem[this->annotations().size()]);
-      typedef ::google::protobuf::Map< ::std::string, ::std::string >::size_type size_type;
-      size_type n = 0;
-      for (::google::protobuf::Map< ::std::string, ::std::string >::const_iterator
-          it = this->annotations().begin();
-          it != this->annotations().end(); ++it, ++n) {
-        items[static_cast<ptrdiff_t>(n)] = SortItem(&*it);
-      }
-      ::std::sort(&items[0], &items[static_cast<ptrdiff_t>(n)], Less());
-      ::std::unique_ptr<GameServer_ObjectMeta_AnnotationsEntry_DoNotUse> entry;
-      for (size_type i = 0; i < n; i++) {
-        entry.reset(annotations_.NewEntryWrapper(
-            items[static_cast<ptrdiff_t>(i)]->first, items[static_cast<ptrdiff_t>(i)]->second));
-        ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-            8, *entry, output);
-        Utf8Check::Check(items[static_cast<ptrdiff_t>(i)]);
-      }
-    } else {
-      ::std::unique_ptr<GameServer_ObjectMeta_AnnotationsEntry_DoNotUse> entry;
-      for (::google::protobuf::Map< ::std::string, ::std::string >::const_iterator
-          it = this->annotations().begin();
-          it != this->annotations().end(); ++it) {
-        entry.reset(annotations_.NewEntryWrapper(
-            it->first, it->second));
-        ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-            8, *entry, output);
-        Utf8Check::Check(&*it);
-      }
-    }
-  }
-
-  // map<string, string> labels = 9;
-  if (!this->labels().empty()) {
-    typedef ::google::protobuf::Map< ::std::string, ::std::string >::const_pointer
-        ConstPtr;
-    typedef ConstPtr SortItem;
-    typedef ::google::protobuf::internal::CompareByDerefFirst<SortItem> Less;
-    struct Utf8Check {
-      static void Check(ConstPtr p) {
-        ::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
-          p->first.data(), static_cast<int>(p->first.length()),
-          ::google::protobuf::internal::WireFormatLite::SERIALIZE,
-          "agones.dev.sdk.GameServer.ObjectMeta.LabelsEntry.key");
-        ::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
-          p->second.data(), static_cast<int>(p->second.length()),
-          ::google::protobuf::internal::WireFormatLite::SERIALIZE,
-          "agones.dev.sdk.GameServer.ObjectMeta.LabelsEntry.value");
-      }
-    };
-
-    if (output->IsSerializationDeterministic() &&
-        this->labels().size() > 1) {
-      ::std::unique_ptr<SortItem[]> items(
-          new SortItem[this->labels().size()]);
-      typedef ::google::protobuf::Map< ::std::string, ::std::string >::size_type size_type;
-      size_type n = 0;
-      for (::google::protobuf::Map< ::std::string, ::std::string >::const_iterator
-          it = this->labels().begin();
-          it != this->labels().end(); ++it, ++n) {
-        items[static_cast<ptrdiff_t>(n)] = SortItem(&*it);
-      }
-      ::std::sort(&items[0], &items[static_cast<ptrdiff_t>(n)], Less());
-      ::std::unique_ptr<GameServer_ObjectMeta_LabelsEntry_DoNotUse> entry;
-      for (size_type i = 0; i < n; i++) {
-        entry.reset(labels_.NewEntryWrapper(
-            items[static_cast<ptrdiff_t>(i)]->first, items[static_cast<ptrdiff_t>(i)]->second));
-        ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-            9, *entry, output);
-        Utf8Check::Check(items[static_cast<ptrdiff_t>(i)]);
-      }
-    } else {
-      ::std::unique_ptr<GameServer_ObjectMeta_LabelsEntry_DoNotUse> entry;
-      for (::google::protobuf::Map< ::std::string, ::std::string >::const_iterator
-          it = this->labels().begin();
-          it != this->labels().end(); ++it) {
-        entry.reset(labels_.NewEntryWrapper(
-            it->first, it->second));
-        ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-            9, *entry, output);
-        Utf8Check::Check(&*it);
-      }
-    }
+      2, this->value(), output);
   }
 
   if ((_internal_metadata_.have_unknown_fields() &&  ::google::protobuf::internal::GetProto3PreserveUnknownsDefault())) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         (::google::protobuf::internal::GetProto3PreserveUnknownsDefault()   ? _internal_metadata_.unknown_fields()   : _internal_metadata_.default_instance()), output);
   }
-  // @@protoc_insertion_point(serialize_end:agones.dev.sdk.GameServer.ObjectMeta)
+  // @@protoc_insertion_point(serialize_end:agones.dev.sdk.ListValue)
 }
 
-::google::protobuf::uint8* GameServer_ObjectMeta::InternalSerializeWithCachedSizesToArray(
+::google::protobuf::uint8* ListValue::InternalSerializeWithCachedSizesToArray(
     bool deterministic, ::google::protobuf::uint8* target) const {
   (void)deterministic; // Unused
-  // @@protoc_insertion_point(serialize_to_array_start:agones.dev.sdk.GameServer.ObjectMeta)
+  // @@protoc_insertion_point(serialize_to_array_start:agones.dev.sdk.ListValue)
   ::google::protobuf::uint32 cached_has_bits = 0;
   (void) cached_has_bits;
 
@@ -1705,184 +1893,1764 @@ void GameServer_ObjectMeta::SerializeWithCachedSizes(
     ::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
       this->name().data(), static_cast<int>(this->name().length()),
       ::google::protobuf::internal::WireFormatLite::SERIALIZE,
-      "agones.dev.sdk.GameServer.ObjectMeta.name");
+      "agones.dev.sdk.ListValue.name");
     target =
       ::google::protobuf::internal::WireFormatLite::WriteStringToArray(
         1, this->name(), target);
   }
 
-  // string namespace = 2;
-  if (this->namespace_().size() > 0) {
+  // string value = 2;
+  if (this->value().size() > 0) {
     ::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
-      this->namespace_().data(), static_cast<int>(this->namespace_().length()),
+      this->value().data(), static_cast<int>(this->value().length()),
       ::google::protobuf::internal::WireFormatLite::SERIALIZE,
-      "agones.dev.sdk.GameServer.ObjectMeta.namespace");
+      "agones.dev.sdk.ListValue.value");
     target =
       ::google::protobuf::internal::WireFormatLite::WriteStringToArray(
-        2, this->namespace_(), target);
+        2, this->value(), target);
   }
 
-  // string uid = 3;
-  if (this->uid().size() > 0) {
-    ::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
-      this->uid().data(), static_cast<int>(this->uid().length()),
-      ::google::protobuf::internal::WireFormatLite::SERIALIZE,
-      "agones.dev.sdk.GameServer.ObjectMeta.uid");
-    target =
-      ::google::protobuf::internal::WireFormatLite::WriteStringToArray(
-        3, this->uid(), target);
+  if ((_internal_metadata_.have_unknown_fields() &&  ::google::protobuf::internal::GetProto3PreserveUnknownsDefault())) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        (::google::protobuf::internal::GetProto3PreserveUnknownsDefault()   ? _internal_metadata_.unknown_fields()   : _internal_metadata_.default_instance()), target);
   }
+  // @@protoc_insertion_point(serialize_to_array_end:agones.dev.sdk.ListValue)
+  return target;
+}
 
-  // string resource_version = 4;
-  if (this->resource_version().size() > 0) {
-    ::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
-      this->resource_version().data(), static_cast<int>(this->resource_version().length()),
-      ::google::protobuf::internal::WireFormatLite::SERIALIZE,
-      "agones.dev.sdk.GameServer.ObjectMeta.resource_version");
-    target =
-      ::google::protobuf::internal::WireFormatLite::WriteStringToArray(
-        4, this->resource_version(), target);
+size_t ListValue::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:agones.dev.sdk.ListValue)
+  size_t total_size = 0;
+
+  if ((_internal_metadata_.have_unknown_fields() &&  ::google::protobuf::internal::GetProto3PreserveUnknownsDefault())) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        (::google::protobuf::internal::GetProto3PreserveUnknownsDefault()   ? _internal_metadata_.unknown_fields()   : _internal_metadata_.default_instance()));
+  }
+  // string name = 1;
+  if (this->name().size() > 0) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::StringSize(
+        this->name());
   }
 
-  // int64 generation = 5;
-  if (this->generation() != 0) {
-    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(5, this->generation(), target);
+  // string value = 2;
+  if (this->value().size() > 0) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::StringSize(
+        this->value());
   }
 
-  // int64 creation_timestamp = 6;
-  if (this->creation_timestamp() != 0) {
-    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(6, this->creation_timestamp(), target);
+  int cached_size = ::google::protobuf::internal::ToCachedSize(total_size);
+  SetCachedSize(cached_size);
+  return total_size;
+}
+
+void ListValue::MergeFrom(const ::google::protobuf::Message& from) {
+// @@protoc_insertion_point(generalized_merge_from_start:agones.dev.sdk.ListValue)
+  GOOGLE_DCHECK_NE(&from, this);
+  const ListValue* source =
+      ::google::protobuf::internal::DynamicCastToGenerated<const ListValue>(
+          &from);
+  if (source == NULL) {
+  // @@protoc_insertion_point(generalized_merge_from_cast_fail:agones.dev.sdk.ListValue)
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+  // @@protoc_insertion_point(generalized_merge_from_cast_success:agones.dev.sdk.ListValue)
+    MergeFrom(*source);
   }
+}
 
-  // int64 deletion_timestamp = 7;
-  if (this->deletion_timestamp() != 0) {
-    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(7, this->deletion_timestamp(), target);
+void ListValue::MergeFrom(const ListValue& from) {
+// @@protoc_insertion_point(class_specific_merge_from_start:agones.dev.sdk.ListValue)
+  GOOGLE_DCHECK_NE(&from, this);
+  _internal_metadata_.MergeFrom(from._internal_metadata_);
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  if (from.name().size() > 0) {
+
+    name_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.name_);
   }
+  if (from.value().size() > 0) {
 
-  // map<string, string> annotations = 8;
-  if (!this->annotations().empty()) {
-    typedef ::google::protobuf::Map< ::std::string, ::std::string >::const_pointer
-        ConstPtr;
-    typedef ConstPtr SortItem;
-    typedef ::google::protobuf::internal::CompareByDerefFirst<SortItem> Less;
-    struct Utf8Check {
-      static void Check(ConstPtr p) {
-        ::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
-          p->first.data(), static_cast<int>(p->first.length()),
-          ::google::protobuf::internal::WireFormatLite::SERIALIZE,
-          "agones.dev.sdk.GameServer.ObjectMeta.AnnotationsEntry.key");
-        ::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
-          p->second.data(), static_cast<int>(p->second.length()),
-          ::google::protobuf::internal::WireFormatLite::SERIALIZE,
-          "agones.dev.sdk.GameServer.ObjectMeta.AnnotationsEntry.value");
-      }
-    };
+    value_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.value_);
+  }
+}
 
-    if (deterministic &&
-        this->annotations().size() > 1) {
-      ::std::unique_ptr<SortItem[]> items(
-          new SortItem[this->annotations().size()]);
-      typedef ::google::protobuf::Map< ::std::string, ::std::string >::size_type size_type;
-      size_type n = 0;
-      for (::google::protobuf::Map< ::std::string, ::std::string >::const_iterator
-          it = this->annotations().begin();
-          it != this->annotations().end(); ++it, ++n) {
-        items[static_cast<ptrdiff_t>(n)] = SortItem(&*it);
+void ListValue::CopyFrom(const ::google::protobuf::Message& from) {
+// @@protoc_insertion_point(generalized_copy_from_start:agones.dev.sdk.ListValue)
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void ListValue::CopyFrom(const ListValue& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:agones.dev.sdk.ListValue)
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool ListValue::IsInitialized() const {
+  return true;
+}
+
+void ListValue::Swap(ListValue* other) {
+  if (other == this) return;
+  InternalSwap(other);
+}
+void ListValue::InternalSwap(ListValue* other) {
+  using std::swap;
+  name_.Swap(&other->name_, &::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+    GetArenaNoVirtual());
+  value_.Swap(&other->value_, &::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+    GetArenaNoVirtual());
+  _internal_metadata_.Swap(&other->_internal_metadata_);
+}
+
+::google::protobuf::Metadata ListValue::GetMetadata() const {
+  protobuf_sdk_2eproto::protobuf_AssignDescriptorsOnce();
+  return ::protobuf_sdk_2eproto::file_level_metadata[kIndexInFileMessages];
+}
+
+
+// ===================================================================
+
+GameServer_ObjectMeta_AnnotationsEntry_DoNotUse::GameServer_ObjectMeta_AnnotationsEntry_DoNotUse() {}
+GameServer_ObjectMeta_AnnotationsEntry_DoNotUse::GameServer_ObjectMeta_AnnotationsEntry_DoNotUse(::google::protobuf::Arena* arena) : SuperType(arena) {}
+void GameServer_ObjectMeta_AnnotationsEntry_DoNotUse::MergeFrom(const GameServer_ObjectMeta_AnnotationsEntry_DoNotUse& other) {
+  MergeFromInternal(other);
+}
+::google::protobuf::Metadata GameServer_ObjectMeta_AnnotationsEntry_DoNotUse::GetMetadata() const {
+  ::protobuf_sdk_2eproto::protobuf_AssignDescriptorsOnce();
+  return ::protobuf_sdk_2eproto::file_level_metadata[5];
+}
+void GameServer_ObjectMeta_AnnotationsEntry_DoNotUse::MergeFrom(
+    const ::google::protobuf::Message& other) {
+  ::google::protobuf::Message::MergeFrom(other);
+}
+
+
+// ===================================================================
+
+GameServer_ObjectMeta_LabelsEntry_DoNotUse::GameServer_ObjectMeta_LabelsEntry_DoNotUse() {}
+GameServer_ObjectMeta_LabelsEntry_DoNotUse::GameServer_ObjectMeta_LabelsEntry_DoNotUse(::google::protobuf::Arena* arena) : SuperType(arena) {}
+void GameServer_ObjectMeta_LabelsEntry_DoNotUse::MergeFrom(const GameServer_ObjectMeta_LabelsEntry_DoNotUse& other) {
+  MergeFromInternal(other);
+}
+::google::protobuf::Metadata GameServer_ObjectMeta_LabelsEntry_DoNotUse::GetMetadata() const {
+  ::protobuf_sdk_2eproto::protobuf_AssignDescriptorsOnce();
+  return ::protobuf_sdk_2eproto::file_level_metadata[6];
+}
+void GameServer_ObjectMeta_LabelsEntry_DoNotUse::MergeFrom(
+    const ::google::protobuf::Message& other) {
+  ::google::protobuf::Message::MergeFrom(other);
+}
+
+
+// ===================================================================
+
+void GameServer_ObjectMeta::InitAsDefaultInstance() {
+}
+#if !defined(_MSC_VER) || _MSC_VER >= 1900
+const int GameServer_ObjectMeta::kNameFieldNumber;
+const int GameServer_ObjectMeta::kNamespaceFieldNumber;
+const int GameServer_ObjectMeta::kUidFieldNumber;
+const int GameServer_ObjectMeta::kResourceVersionFieldNumber;
+const int GameServer_ObjectMeta::kGenerationFieldNumber;
+const int GameServer_ObjectMeta::kCreationTimestampFieldNumber;
+const int GameServer_ObjectMeta::kDeletionTimestampFieldNumber;
+const int GameServer_ObjectMeta::kAnnotationsFieldNumber;
+const int GameServer_ObjectMeta::kLabelsFieldNumber;
+#endif  // !defined(_MSC_VER) || _MSC_VER >= 1900
+
+GameServer_ObjectMeta::GameServer_ObjectMeta()
+  : ::google::protobuf::Message(), _internal_metadata_(NULL) {
+  ::google::protobuf::internal::InitSCC(
+      &protobuf_sdk_2eproto::scc_info_GameServer_ObjectMeta.base);
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:agones.dev.sdk.GameServer.ObjectMeta)
+}
+GameServer_ObjectMeta::GameServer_ObjectMeta(const GameServer_ObjectMeta& from)
+  : ::google::protobuf::Message(),
+      _internal_metadata_(NULL) {
+  _internal_metadata_.MergeFrom(from._internal_metadata_);
+  annotations_.MergeFrom(from.annotations_);
+  labels_.MergeFrom(from.labels_);
+  name_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  if (from.name().size() > 0) {
+    name_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.name_);
+  }
+  namespace__.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  if (from.namespace_().size() > 0) {
+    namespace__.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.namespace__);
+  }
+  uid_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  if (from.uid().size() > 0) {
+    uid_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.uid_);
+  }
+  resource_version_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  if (from.resource_version().size() > 0) {
+    resource_version_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.resource_version_);
+  }
+  ::memcpy(&generation_, &from.generation_,
+    static_cast<size_t>(reinterpret_cast<char*>(&deletion_timestamp_) -
+    reinterpret_cast<char*>(&generation_)) + sizeof(deletion_timestamp_));
+  // @@protoc_insertion_point(copy_constructor:agones.dev.sdk.GameServer.ObjectMeta)
+}
+
+void GameServer_ObjectMeta::SharedCtor() {
+  name_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  namespace__.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  uid_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  resource_version_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  ::memset(&generation_, 0, static_cast<size_t>(
+      reinterpret_cast<char*>(&deletion_timestamp_) -
+      reinterpret_cast<char*>(&generation_)) + sizeof(deletion_timestamp_));
+}
+
+GameServer_ObjectMeta::~GameServer_ObjectMeta() {
+  // @@protoc_insertion_point(destructor:agones.dev.sdk.GameServer.ObjectMeta)
+  SharedDtor();
+}
+
+void GameServer_ObjectMeta::SharedDtor() {
+  name_.DestroyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  namespace__.DestroyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  uid_.DestroyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  resource_version_.DestroyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+
+void GameServer_ObjectMeta::SetCachedSize(int size) const {
+  _cached_size_.Set(size);
+}
+const ::google::protobuf::Descriptor* GameServer_ObjectMeta::descriptor() {
+  ::protobuf_sdk_2eproto::protobuf_AssignDescriptorsOnce();
+  return ::protobuf_sdk_2eproto::file_level_metadata[kIndexInFileMessages].descriptor;
+}
+
+const GameServer_ObjectMeta& GameServer_ObjectMeta::default_instance() {
+  ::google::protobuf::internal::InitSCC(&protobuf_sdk_2eproto::scc_info_GameServer_ObjectMeta.base);
+  return *internal_default_instance();
+}
+
+
+void GameServer_ObjectMeta::Clear() {
+// @@protoc_insertion_point(message_clear_start:agones.dev.sdk.GameServer.ObjectMeta)
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  annotations_.Clear();
+  labels_.Clear();
+  name_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  namespace__.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  uid_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  resource_version_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  ::memset(&generation_, 0, static_cast<size_t>(
+      reinterpret_cast<char*>(&deletion_timestamp_) -
+      reinterpret_cast<char*>(&generation_)) + sizeof(deletion_timestamp_));
+  _internal_metadata_.Clear();
+}
+
+bool GameServer_ObjectMeta::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!GOOGLE_PREDICT_TRUE(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:agones.dev.sdk.GameServer.ObjectMeta)
+  for (;;) {
+    ::std::pair<::google::protobuf::uint32, bool> p = input->ReadTagWithCutoffNoLastTag(127u);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // string name = 1;
+      case 1: {
+        if (static_cast< ::google::protobuf::uint8>(tag) ==
+            static_cast< ::google::protobuf::uint8>(10u /* 10 & 0xFF */)) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadString(
+                input, this->mutable_name()));
+          DO_(::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+            this->name().data(), static_cast<int>(this->name().length()),
+            ::google::protobuf::internal::WireFormatLite::PARSE,
+            "agones.dev.sdk.GameServer.ObjectMeta.name"));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      // string namespace = 2;
+      case 2: {
+        if (static_cast< ::google::protobuf::uint8>(tag) ==
+            static_cast< ::google::protobuf::uint8>(18u /* 18 & 0xFF */)) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadString(
+                input, this->mutable_namespace_()));
+          DO_(::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+            this->namespace_().data(), static_cast<int>(this->namespace_().length()),
+            ::google::protobuf::internal::WireFormatLite::PARSE,
+            "agones.dev.sdk.GameServer.ObjectMeta.namespace"));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      // string uid = 3;
+      case 3: {
+        if (static_cast< ::google::protobuf::uint8>(tag) ==
+            static_cast< ::google::protobuf::uint8>(26u /* 26 & 0xFF */)) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadString(
+                input, this->mutable_uid()));
+          DO_(::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+            this->uid().data(), static_cast<int>(this->uid().length()),
+            ::google::protobuf::internal::WireFormatLite::PARSE,
+            "agones.dev.sdk.GameServer.ObjectMeta.uid"));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      // string resource_version = 4;
+      case 4: {
+        if (static_cast< ::google::protobuf::uint8>(tag) ==
+            static_cast< ::google::protobuf::uint8>(34u /* 34 & 0xFF */)) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadString(
+                input, this->mutable_resource_version()));
+          DO_(::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+            this->resource_version().data(), static_cast<int>(this->resource_version().length()),
+            ::google::protobuf::internal::WireFormatLite::PARSE,
+            "agones.dev.sdk.GameServer.ObjectMeta.resource_version"));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      // int64 generation = 5;
+      case 5: {
+        if (static_cast< ::google::protobuf::uint8>(tag) ==
+            static_cast< ::google::protobuf::uint8>(40u /* 40 & 0xFF */)) {
+
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &generation_)));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      // int64 creation_timestamp = 6;
+      case 6: {
+        if (static_cast< ::google::protobuf::uint8>(tag) ==
+            static_cast< ::google::protobuf::uint8>(48u /* 48 & 0xFF */)) {
+
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &creation_timestamp_)));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      // int64 deletion_timestamp = 7;
+      case 7: {
+        if (static_cast< ::google::protobuf::uint8>(tag) ==
+            static_cast< ::google::protobuf::uint8>(56u /* 56 & 0xFF */)) {
+
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &deletion_timestamp_)));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      // map<string, string> annotations = 8;
+      case 8: {
+        if (static_cast< ::google::protobuf::uint8>(tag) ==
+            static_cast< ::google::protobuf::uint8>(66u /* 66 & 0xFF */)) {
+          GameServer_ObjectMeta_AnnotationsEntry_DoNotUse::Parser< ::google::protobuf::internal::MapField<
+              GameServer_ObjectMeta_AnnotationsEntry_DoNotUse,
+              ::std::string, ::std::string,
+              ::google::protobuf::internal::WireFormatLite::TYPE_STRING,
+              ::google::protobuf::internal::WireFormatLite::TYPE_STRING,
+              0 >,
+            ::google::protobuf::Map< ::std::string, ::std::string > > parser(&annotations_);
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+              input, &parser));
+          DO_(::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+            parser.key().data(), static_cast<int>(parser.key().length()),
+            ::google::protobuf::internal::WireFormatLite::PARSE,
+            "agones.dev.sdk.GameServer.ObjectMeta.AnnotationsEntry.key"));
+          DO_(::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+            parser.value().data(), static_cast<int>(parser.value().length()),
+            ::google::protobuf::internal::WireFormatLite::PARSE,
+            "agones.dev.sdk.GameServer.ObjectMeta.AnnotationsEntry.value"));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      // map<string, string> labels = 9;
+      case 9: {
+        if (static_cast< ::google::protobuf::uint8>(tag) ==
+            static_cast< ::google::protobuf::uint8>(74u /* 74 & 0xFF */)) {
+          GameServer_ObjectMeta_LabelsEntry_DoNotUse::Parser< ::google::protobuf::internal::MapField<
+              GameServer_ObjectMeta_LabelsEntry_DoNotUse,
+              ::std::string, ::std::string,
+              ::google::protobuf::internal::WireFormatLite::TYPE_STRING,
+              ::google::protobuf::internal::WireFormatLite::TYPE_STRING,
+              0 >,
+            ::google::protobuf::Map< ::std::string, ::std::string > > parser(&labels_);
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+              input, &parser));
+          DO_(::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+            parser.key().data(), static_cast<int>(parser.key().length()),
+            ::google::protobuf::internal::WireFormatLite::PARSE,
+            "agones.dev.sdk.GameServer.ObjectMeta.LabelsEntry.key"));
+          DO_(::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+            parser.value().data(), static_cast<int>(parser.value().length()),
+            ::google::protobuf::internal::WireFormatLite::PARSE,
+            "agones.dev.sdk.GameServer.ObjectMeta.LabelsEntry.value"));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, _internal_metadata_.mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:agones.dev.sdk.GameServer.ObjectMeta)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:agones.dev.sdk.GameServer.ObjectMeta)
+  return false;
+#undef DO_
+}
+
+void GameServer_ObjectMeta::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:agones.dev.sdk.GameServer.ObjectMeta)
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  // string name = 1;
+  if (this->name().size() > 0) {
+    ::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+      this->name().data(), static_cast<int>(this->name().length()),
+      ::google::protobuf::internal::WireFormatLite::SERIALIZE,
+      "agones.dev.sdk.GameServer.ObjectMeta.name");
+    ::google::protobuf::internal::WireFormatLite::WriteStringMaybeAliased(
+      1, this->name(), output);
+  }
+
+  // string namespace = 2;
+  if (this->namespace_().size() > 0) {
+    ::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+      this->namespace_().data(), static_cast<int>(this->namespace_().length()),
+      ::google::protobuf::internal::WireFormatLite::SERIALIZE,
+      "agones.dev.sdk.GameServer.ObjectMeta.namespace");
+    ::google::protobuf::internal::WireFormatLite::WriteStringMaybeAliased(
+      2, this->namespace_(), output);
+  }
+
+  // string uid = 3;
+  if (this->uid().size() > 0) {
+    ::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+      this->uid().data(), static_cast<int>(this->uid().length()),
+      ::google::protobuf::internal::WireFormatLite::SERIALIZE,
+      "agones.dev.sdk.GameServer.ObjectMeta.uid");
+    ::google::protobuf::internal::WireFormatLite::WriteStringMaybeAliased(
+      3, this->uid(), output);
+  }
+
+  // string resource_version = 4;
+  if (this->resource_version().size() > 0) {
+    ::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+      this->resource_version().data(), static_cast<int>(this->resource_version().length()),
+      ::google::protobuf::internal::WireFormatLite::SERIALIZE,
+      "agones.dev.sdk.GameServer.ObjectMeta.resource_version");
+    ::google::protobuf::internal::WireFormatLite::WriteStringMaybeAliased(
+      4, this->resource_version(), output);
+  }
+
+  // int64 generation = 5;
+  if (this->generation() != 0) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(5, this->generation(), output);
+  }
+
+  // int64 creation_timestamp = 6;
+  if (this->creation_timestamp() != 0) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(6, this->creation_timestamp(), output);
+  }
+
+  // int64 deletion_timestamp = 7;
+  if (this->deletion_timestamp() != 0) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(7, this->deletion_timestamp(), output);
+  }
+
+  // map<string, string> annotations = 8;
+  if (!this->annotations().empty()) {
+    typedef ::google::protobuf::Map< ::std::string, ::std::string >::const_pointer
+        ConstPtr;
+    typedef ConstPtr SortItem;
+    typedef ::google::protobuf::internal::CompareByDerefFirst<SortItem> Less;
+    struct Utf8Check {
+      static void Check(ConstPtr p) {
+        ::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+          p->first.data(), static_cast<int>(p->first.length()),
+          ::google::protobuf::internal::WireFormatLite::SERIALIZE,
+          "agones.dev.sdk.GameServer.ObjectMeta.AnnotationsEntry.key");
+        ::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+          p->second.data(), static_cast<int>(p->second.length()),
+          ::google::protobuf::internal::WireFormatLite::SERIALIZE,
+          "agones.dev.sdk.GameServer.ObjectMeta.AnnotationsEntry.value");
+      }
+    };
+
+    if (output->IsSerializationDeterministic() &&
+        this->annotations().size() > 1) {
+      ::std::unique_ptr<SortItem[]> items(
+          new SortItem[this->annotations().size()]);
+      typedef ::google::protobuf::Map< ::std::string, ::std::string >::size_type size_type;
+      size_type n = 0;
+      for (::google::protobuf::Map< ::std::string, ::std::string >::const_iterator
+          it = this->annotations().begin();
+          it != this->annotations().end(); ++it, ++n) {
+        items[static_cast<ptrdiff_t>(n)] = SortItem(&*it);
+      }
+      ::std::sort(&items[0], &items[static_cast<ptrdiff_t>(n)], Less());
+      ::std::unique_ptr<GameServer_ObjectMeta_AnnotationsEntry_DoNotUse> entry;
+      for (size_type i = 0; i < n; i++) {
+        entry.reset(annotations_.NewEntryWrapper(
+            items[static_cast<ptrdiff_t>(i)]->first, items[static_cast<ptrdiff_t>(i)]->second));
+        ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+            8, *entry, output);
+        Utf8Check::Check(items[static_cast<ptrdiff_t>(i)]);
+      }
+    } else {
+      ::std::unique_ptr<GameServer_ObjectMeta_AnnotationsEntry_DoNotUse> entry;
+      for (::google::protobuf::Map< ::std::string, ::std::string >::const_iterator
+          it = this->annotations().begin();
+          it != this->annotations().end(); ++it) {
+        entry.reset(annotations_.NewEntryWrapper(
+            it->first, it->second));
+        ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+            8, *entry, output);
+        Utf8Check::Check(&*it);
+      }
+    }
+  }
+
+  // map<string, string> labels = 9;
+  if (!this->labels().empty()) {
+    typedef ::google::protobuf::Map< ::std::string, ::std::string >::const_pointer
+        ConstPtr;
+    typedef ConstPtr SortItem;
+    typedef ::google::protobuf::internal::CompareByDerefFirst<SortItem> Less;
+    struct Utf8Check {
+      static void Check(ConstPtr p) {
+        ::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+          p->first.data(), static_cast<int>(p->first.length()),
+          ::google::protobuf::internal::WireFormatLite::SERIALIZE,
+          "agones.dev.sdk.GameServer.ObjectMeta.LabelsEntry.key");
+        ::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+          p->second.data(), static_cast<int>(p->second.length()),
+          ::google::protobuf::internal::WireFormatLite::SERIALIZE,
+          "agones.dev.sdk.GameServer.ObjectMeta.LabelsEntry.value");
+      }
+    };
+
+    if (output->IsSerializationDeterministic() &&
+        this->labels().size() > 1) {
+      ::std::unique_ptr<SortItem[]> items(
+          new SortItem[this->labels().size()]);
+      typedef ::google::protobuf::Map< ::std::string, ::std::string >::size_type size_type;
+      size_type n = 0;
+      for (::google::protobuf::Map< ::std::string, ::std::string >::const_iterator
+          it = this->labels().begin();
+          it != this->labels().end(); ++it, ++n) {
+        items[static_cast<ptrdiff_t>(n)] = SortItem(&*it);
+      }
+      ::std::sort(&items[0], &items[static_cast<ptrdiff_t>(n)], Less());
+      ::std::unique_ptr<GameServer_ObjectMeta_LabelsEntry_DoNotUse> entry;
+      for (size_type i = 0; i < n; i++) {
+        entry.reset(labels_.NewEntryWrapper(
+            items[static_cast<ptrdiff_t>(i)]->first, items[static_cast<ptrdiff_t>(i)]->second));
+        ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+            9, *entry, output);
+        Utf8Check::Check(items[static_cast<ptrdiff_t>(i)]);
+      }
+    } else {
+      ::std::unique_ptr<GameServer_ObjectMeta_LabelsEntry_DoNotUse> entry;
+      for (::google::protobuf::Map< ::std::string, ::std::string >::const_iterator
+          it = this->labels().begin();
+          it != this->labels().end(); ++it) {
+        entry.reset(labels_.NewEntryWrapper(
+            it->first, it->second));
+        ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+            9, *entry, output);
+        Utf8Check::Check(&*it);
+      }
+    }
+  }
+
+  if ((_internal_metadata_.have_unknown_fields() &&  ::google::protobuf::internal::GetProto3PreserveUnknownsDefault())) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        (::google::protobuf::internal::GetProto3PreserveUnknownsDefault()   ? _internal_metadata_.unknown_fields()   : _internal_metadata_.default_instance()), output);
+  }
+  // @@protoc_insertion_point(serialize_end:agones.dev.sdk.GameServer.ObjectMeta)
+}
+
+::google::protobuf::uint8* GameServer_ObjectMeta::InternalSerializeWithCachedSizesToArray(
+    bool deterministic, ::google::protobuf::uint8* target) const {
+  (void)deterministic; // Unused
+  // @@protoc_insertion_point(serialize_to_array_start:agones.dev.sdk.GameServer.ObjectMeta)
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  // string name = 1;
+  if (this->name().size() > 0) {
+    ::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+      this->name().data(), static_cast<int>(this->name().length()),
+      ::google::protobuf::internal::WireFormatLite::SERIALIZE,
+      "agones.dev.sdk.GameServer.ObjectMeta.name");
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteStringToArray(
+        1, this->name(), target);
+  }
+
+  // string namespace = 2;
+  if (this->namespace_().size() > 0) {
+    ::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+      this->namespace_().data(), static_cast<int>(this->namespace_().length()),
+      ::google::protobuf::internal::WireFormatLite::SERIALIZE,
+      "agones.dev.sdk.GameServer.ObjectMeta.namespace");
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteStringToArray(
+        2, this->namespace_(), target);
+  }
+
+  // string uid = 3;
+  if (this->uid().size() > 0) {
+    ::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+      this->uid().data(), static_cast<int>(this->uid().length()),
+      ::google::protobuf::internal::WireFormatLite::SERIALIZE,
+      "agones.dev.sdk.GameServer.ObjectMeta.uid");
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteStringToArray(
+        3, this->uid(), target);
+  }
+
+  // string resource_version = 4;
+  if (this->resource_version().size() > 0) {
+    ::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+      this->resource_version().data(), static_cast<int>(this->resource_version().length()),
+      ::google::protobuf::internal::WireFormatLite::SERIALIZE,
+      "agones.dev.sdk.GameServer.ObjectMeta.resource_version");
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteStringToArray(
+        4, this->resource_version(), target);
+  }
+
+  // int64 generation = 5;
+  if (this->generation() != 0) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(5, this->generation(), target);
+  }
+
+  // int64 creation_timestamp = 6;
+  if (this->creation_timestamp() != 0) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(6, this->creation_timestamp(), target);
+  }
+
+  // int64 deletion_timestamp = 7;
+  if (this->deletion_timestamp() != 0) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(7, this->deletion_timestamp(), target);
+  }
+
+  // map<string, string> annotations = 8;
+  if (!this->annotations().empty()) {
+    typedef ::google::protobuf::Map< ::std::string, ::std::string >::const_pointer
+        ConstPtr;
+    typedef ConstPtr SortItem;
+    typedef ::google::protobuf::internal::CompareByDerefFirst<SortItem> Less;
+    struct Utf8Check {
+      static void Check(ConstPtr p) {
+        ::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+          p->first.data(), static_cast<int>(p->first.length()),
+          ::google::protobuf::internal::WireFormatLite::SERIALIZE,
+          "agones.dev.sdk.GameServer.ObjectMeta.AnnotationsEntry.key");
+        ::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+          p->second.data(), static_cast<int>(p->second.length()),
+          ::google::protobuf::internal::WireFormatLite::SERIALIZE,
+          "agones.dev.sdk.GameServer.ObjectMeta.AnnotationsEntry.value");
+      }
+    };
+
+    if (deterministic &&
+        this->annotations().size() > 1) {
+      ::std::unique_ptr<SortItem[]> items(
+          new SortItem[this->annotations().size()]);
+      typedef ::google::protobuf::Map< ::std::string, ::std::string >::size_type size_type;
+      size_type n = 0;
+      for (::google::protobuf::Map< ::std::string, ::std::string >::const_iterator
+          it = this->annotations().begin();
+          it != this->annotations().end(); ++it, ++n) {
+        items[static_cast<ptrdiff_t>(n)] = SortItem(&*it);
+      }
+      ::std::sort(&items[0], &items[static_cast<ptrdiff_t>(n)], Less());
+      ::std::unique_ptr<GameServer_ObjectMeta_AnnotationsEntry_DoNotUse> entry;
+      for (size_type i = 0; i < n; i++) {
+        entry.reset(annotations_.NewEntryWrapper(
+            items[static_cast<ptrdiff_t>(i)]->first, items[static_cast<ptrdiff_t>(i)]->second));
+        target = ::google::protobuf::internal::WireFormatLite::
+                   InternalWriteMessageNoVirtualToArray(
+                       8, *entry, deterministic, target);
+;
+        Utf8Check::Check(items[static_cast<ptrdiff_t>(i)]);
+      }
+    } else {
+      ::std::unique_ptr<GameServer_ObjectMeta_AnnotationsEntry_DoNotUse> entry;
+      for (::google::protobuf::Map< ::std::string, ::std::string >::const_iterator
+          it = this->annotations().begin();
+          it != this->annotations().end(); ++it) {
+        entry.reset(annotations_.NewEntryWrapper(
+            it->first, it->second));
+        target = ::google::protobuf::internal::WireFormatLite::
+                   InternalWriteMessageNoVirtualToArray(
+                       8, *entry, deterministic, target);
+;
+        Utf8Check::Check(&*it);
+      }
+    }
+  }
+
+  // map<string, string> labels = 9;
+  if (!this->labels().empty()) {
+    typedef ::google::protobuf::Map< ::std::string, ::std::string >::const_pointer
+        ConstPtr;
+    typedef ConstPtr SortItem;
+    typedef ::google::protobuf::internal::CompareByDerefFirst<SortItem> Less;
+    struct Utf8Check {
+      static void Check(ConstPtr p) {
+        ::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+          p->first.data(), static_cast<int>(p->first.length()),
+          ::google::protobuf::internal::WireFormatLite::SERIALIZE,
+          "agones.dev.sdk.GameServer.ObjectMeta.LabelsEntry.key");
+        ::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+          p->second.data(), static_cast<int>(p->second.length()),
+          ::google::protobuf::internal::WireFormatLite::SERIALIZE,
+          "agones.dev.sdk.GameServer.ObjectMeta.LabelsEntry.value");
+      }
+    };
+
+    if (deterministic &&
+        this->labels().size() > 1) {
+      ::std::unique_ptr<SortItem[]> items(
+          new SortItem[this->labels().size()]);
+      typedef ::google::protobuf::Map< ::std::string, ::std::string >::size_type size_type;
+      size_type n = 0;
+      for (::google::protobuf::Map< ::std::string, ::std::string >::const_iterator
+          it = this->labels().begin();
+          it != this->labels().end(); ++it, ++n) {
+        items[static_cast<ptrdiff_t>(n)] = SortItem(&*it);
+      }
+      ::std::sort(&items[0], &items[static_cast<ptrdiff_t>(n)], Less());
+      ::std::unique_ptr<GameServer_ObjectMeta_LabelsEntry_DoNotUse> entry;
+      for (size_type i = 0; i < n; i++) {
+        entry.reset(labels_.NewEntryWrapper(
+            items[static_cast<ptrdiff_t>(i)]->first, items[static_cast<ptrdiff_t>(i)]->second));
+        target = ::google::protobuf::internal::WireFormatLite::
+                   InternalWriteMessageNoVirtualToArray(
+                       9, *entry, deterministic, target);
+;
+        Utf8Check::Check(items[static_cast<ptrdiff_t>(i)]);
+      }
+    } else {
+      ::std::unique_ptr<GameServer_ObjectMeta_LabelsEntry_DoNotUse> entry;
+      for (::google::protobuf::Map< ::std::string, ::std::string >::const_iterator
+          it = this->labels().begin();
+          it != this->labels().end(); ++it) {
+        entry.reset(labels_.NewEntryWrapper(
+            it->first, it->second));
+        target = ::google::protobuf::internal::WireFormatLite::
+                   InternalWriteMessageNoVirtualToArray(
+                       9, *entry, deterministic, target);
+;
+        Utf8Check::Check(&*it);
+      }
+    }
+  }
+
+  if ((_internal_metadata_.have_unknown_fields() &&  ::google::protobuf::internal::GetProto3PreserveUnknownsDefault())) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        (::google::protobuf::internal::GetProto3PreserveUnknownsDefault()   ? _internal_metadata_.unknown_fields()   : _internal_metadata_.default_instance()), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:agones.dev.sdk.GameServer.ObjectMeta)
+  return target;
+}
+
+size_t GameServer_ObjectMeta::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:agones.dev.sdk.GameServer.ObjectMeta)
+  size_t total_size = 0;
+
+  if ((_internal_metadata_.have_unknown_fields() &&  ::google::protobuf::internal::GetProto3PreserveUnknownsDefault())) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        (::google::protobuf::internal::GetProto3PreserveUnknownsDefault()   ? _internal_metadata_.unknown_fields()   : _internal_metadata_.default_instance()));
+  }
+  // map<string, string> annotations = 8;
+  total_size += 1 *
+      ::google::protobuf::internal::FromIntSize(this->annotations_size());
+  {
+    ::std::unique_ptr<GameServer_ObjectMeta_AnnotationsEntry_DoNotUse> entry;
+    for (::google::protobuf::Map< ::std::string, ::std::string >::const_iterator
+        it = this->annotations().begin();
+        it != this->annotations().end(); ++it) {
+      entry.reset(annotations_.NewEntryWrapper(it->first, it->second));
+      total_size += ::google::protobuf::internal::WireFormatLite::
+          MessageSizeNoVirtual(*entry);
+    }
+  }
+
+  // map<string, string> labels = 9;
+  total_size += 1 *
+      ::google::protobuf::internal::FromIntSize(this->labels_size());
+  {
+    ::std::unique_ptr<GameServer_ObjectMeta_LabelsEntry_DoNotUse> entry;
+    for (::google::protobuf::Map< ::std::string, ::std::string >::const_iterator
+        it = this->labels().begin();
+        it != this->labels().end(); ++it) {
+      entry.reset(labels_.NewEntryWrapper(it->first, it->second));
+      total_size += ::google::protobuf::internal::WireFormatLite::
+          MessageSizeNoVirtual(*entry);
+    }
+  }
+
+  // string name = 1;
+  if (this->name().size() > 0) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::StringSize(
+        this->name());
+  }
+
+  // string namespace = 2;
+  if (this->namespace_().size() > 0) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::StringSize(
+        this->namespace_());
+  }
+
+  // string uid = 3;
+  if (this->uid().size() > 0) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::StringSize(
+        this->uid());
+  }
+
+  // string resource_version = 4;
+  if (this->resource_version().size() > 0) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::StringSize(
+        this->resource_version());
+  }
+
+  // int64 generation = 5;
+  if (this->generation() != 0) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::Int64Size(
+        this->generation());
+  }
+
+  // int64 creation_timestamp = 6;
+  if (this->creation_timestamp() != 0) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::Int64Size(
+        this->creation_timestamp());
+  }
+
+  // int64 deletion_timestamp = 7;
+  if (this->deletion_timestamp() != 0) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::Int64Size(
+        this->deletion_timestamp());
+  }
+
+  int cached_size = ::google::protobuf::internal::ToCachedSize(total_size);
+  SetCachedSize(cached_size);
+  return total_size;
+}
+
+void GameServer_ObjectMeta::MergeFrom(const ::google::protobuf::Message& from) {
+// @@protoc_insertion_point(generalized_merge_from_start:agones.dev.sdk.GameServer.ObjectMeta)
+  GOOGLE_DCHECK_NE(&from, this);
+  const GameServer_ObjectMeta* source =
+      ::google::protobuf::internal::DynamicCastToGenerated<const GameServer_ObjectMeta>(
+          &from);
+  if (source == NULL) {
+  // @@protoc_insertion_point(generalized_merge_from_cast_fail:agones.dev.sdk.GameServer.ObjectMeta)
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+  // @@protoc_insertion_point(generalized_merge_from_cast_success:agones.dev.sdk.GameServer.ObjectMeta)
+    MergeFrom(*source);
+  }
+}
+
+void GameServer_ObjectMeta::MergeFrom(const GameServer_ObjectMeta& from) {
+// @@protoc_insertion_point(class_specific_merge_from_start:agones.dev.sdk.GameServer.ObjectMeta)
+  GOOGLE_DCHECK_NE(&from, this);
+  _internal_metadata_.MergeFrom(from._internal_metadata_);
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  annotations_.MergeFrom(from.annotations_);
+  labels_.MergeFrom(from.labels_);
+  if (from.name().size() > 0) {
+
+    name_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.name_);
+  }
+  if (from.namespace_().size() > 0) {
+
+    namespace__.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.namespace__);
+  }
+  if (from.uid().size() > 0) {
+
+    uid_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.uid_);
+  }
+  if (from.resource_version().size() > 0) {
+
+    resource_version_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.resource_version_);
+  }
+  if (from.generation() != 0) {
+    set_generation(from.generation());
+  }
+  if (from.creation_timestamp() != 0) {
+    set_creation_timestamp(from.creation_timestamp());
+  }
+  if (from.deletion_timestamp() != 0) {
+    set_deletion_timestamp(from.deletion_timestamp());
+  }
+}
+
+void GameServer_ObjectMeta::CopyFrom(const ::google::protobuf::Message& from) {
+// @@protoc_insertion_point(generalized_copy_from_start:agones.dev.sdk.GameServer.ObjectMeta)
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void GameServer_ObjectMeta::CopyFrom(const GameServer_ObjectMeta& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:agones.dev.sdk.GameServer.ObjectMeta)
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool GameServer_ObjectMeta::IsInitialized() const {
+  return true;
+}
+
+void GameServer_ObjectMeta::Swap(GameServer_ObjectMeta* other) {
+  if (other == this) return;
+  InternalSwap(other);
+}
+void GameServer_ObjectMeta::InternalSwap(GameServer_ObjectMeta* other) {
+  using std::swap;
+  annotations_.Swap(&other->annotations_);
+  labels_.Swap(&other->labels_);
+  name_.Swap(&other->name_, &::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+    GetArenaNoVirtual());
+  namespace__.Swap(&other->namespace__, &::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+    GetArenaNoVirtual());
+  uid_.Swap(&other->uid_, &::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+    GetArenaNoVirtual());
+  resource_version_.Swap(&other->resource_version_, &::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+    GetArenaNoVirtual());
+  swap(generation_, other->generation_);
+  swap(creation_timestamp_, other->creation_timestamp_);
+  swap(deletion_timestamp_, other->deletion_timestamp_);
+  _internal_metadata_.Swap(&other->_internal_metadata_);
+}
+
+::google::protobuf::Metadata GameServer_ObjectMeta::GetMetadata() const {
+  protobuf_sdk_2eproto::protobuf_AssignDescriptorsOnce();
+  return ::protobuf_sdk_2eproto::file_level_metadata[kIndexInFileMessages];
+}
+
+
+// ===================================================================
+
+void GameServer_Spec_Health::InitAsDefaultInstance() {
+}
+#if !defined(_MSC_VER) || _MSC_VER >= 1900
+const int GameServer_Spec_Health::kDisabledFieldNumber;
+const int GameServer_Spec_Health::kPeriodSecondsFieldNumber;
+const int GameServer_Spec_Health::kFailureThresholdFieldNumber;
+const int GameServer_Spec_Health::kInitialDelaySecondsFieldNumber;
+#endif  // !defined(_MSC_VER) || _MSC_VER >= 1900
+
+GameServer_Spec_Health::GameServer_Spec_Health()
+  : ::google::protobuf::Message(), _internal_metadata_(NULL) {
+  ::google::protobuf::internal::InitSCC(
+      &protobuf_sdk_2eproto::scc_info_GameServer_Spec_Health.base);
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:agones.dev.sdk.GameServer.Spec.Health)
+}
+GameServer_Spec_Health::GameServer_Spec_Health(const GameServer_Spec_Health& from)
+  : ::google::protobuf::Message(),
+      _internal_metadata_(NULL) {
+  _internal_metadata_.MergeFrom(from._internal_metadata_);
+  ::memcpy(&disabled_, &from.disabled_,
+    static_cast<size_t>(reinterpret_cast<char*>(&initial_delay_seconds_) -
+    reinterpret_cast<char*>(&disabled_)) + sizeof(initial_delay_seconds_));
+  // @@protoc_insertion_point(copy_constructor:agones.dev.sdk.GameServer.Spec.Health)
+}
+
+void GameServer_Spec_Health::SharedCtor() {
+  ::memset(&disabled_, 0, static_cast<size_t>(
+      reinterpret_cast<char*>(&initial_delay_seconds_) -
+      reinterpret_cast<char*>(&disabled_)) + sizeof(initial_delay_seconds_));
+}
+
+GameServer_Spec_Health::~GameServer_Spec_Health() {
+  // @@protoc_insertion_point(destructor:agones.dev.sdk.GameServer.Spec.Health)
+  SharedDtor();
+}
+
+void GameServer_Spec_Health::SharedDtor() {
+}
+
+void GameServer_Spec_Health::SetCachedSize(int size) const {
+  _cached_size_.Set(size);
+}
+const ::google::protobuf::Descriptor* GameServer_Spec_Health::descriptor() {
+  ::protobuf_sdk_2eproto::protobuf_AssignDescriptorsOnce();
+  return ::protobuf_sdk_2eproto::file_level_metadata[kIndexInFileMessages].descriptor;
+}
+
+const GameServer_Spec_Health& GameServer_Spec_Health::default_instance() {
+  ::google::protobuf::internal::InitSCC(&protobuf_sdk_2eproto::scc_info_GameServer_Spec_Health.base);
+  return *internal_default_instance();
+}
+
+
+void GameServer_Spec_Health::Clear() {
+// @@protoc_insertion_point(message_clear_start:agones.dev.sdk.GameServer.Spec.Health)
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  ::memset(&disabled_, 0, static_cast<size_t>(
+      reinterpret_cast<char*>(&initial_delay_seconds_) -
+      reinterpret_cast<char*>(&disabled_)) + sizeof(initial_delay_seconds_));
+  _internal_metadata_.Clear();
+}
+
+bool GameServer_Spec_Health::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!GOOGLE_PREDICT_TRUE(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:agones.dev.sdk.GameServer.Spec.Health)
+  for (;;) {
+    ::std::pair<::google::protobuf::uint32, bool> p = input->ReadTagWithCutoffNoLastTag(127u);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // bool disabled = 1;
+      case 1: {
+        if (static_cast< ::google::protobuf::uint8>(tag) ==
+            static_cast< ::google::protobuf::uint8>(8u /* 8 & 0xFF */)) {
+
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   bool, ::google::protobuf::internal::WireFormatLite::TYPE_BOOL>(
+                 input, &disabled_)));
+        } else {
+          goto handle_unusual;
+        }
+        break;
       }
-      ::std::sort(&items[0], &items[static_cast<ptrdiff_t>(n)], Less());
-      ::std::unique_ptr<GameServer_ObjectMeta_AnnotationsEntry_DoNotUse> entry;
-      for (size_type i = 0; i < n; i++) {
-        entry.reset(annotations_.NewEntryWrapper(
-            items[static_cast<ptrdiff_t>(i)]->first, items[static_cast<ptrdiff_t>(i)]->second));
-        target = ::google::protobuf::internal::WireFormatLite::
-                   InternalWriteMessageNoVirtualToArray(
-                       8, *entry, deterministic, target);
-;
-        Utf8Check::Check(items[static_cast<ptrdiff_t>(i)]);
+
+      // int32 period_seconds = 2;
+      case 2: {
+        if (static_cast< ::google::protobuf::uint8>(tag) ==
+            static_cast< ::google::protobuf::uint8>(16u /* 16 & 0xFF */)) {
+
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int32, ::google::protobuf::internal::WireFormatLite::TYPE_INT32>(
+                 input, &period_seconds_)));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      // int32 failure_threshold = 3;
+      case 3: {
+        if (static_cast< ::google::protobuf::uint8>(tag) ==
+            static_cast< ::google::protobuf::uint8>(24u /* 24 & 0xFF */)) {
+
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int32, ::google::protobuf::internal::WireFormatLite::TYPE_INT32>(
+                 input, &failure_threshold_)));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      // int32 initial_delay_seconds = 4;
+      case 4: {
+        if (static_cast< ::google::protobuf::uint8>(tag) ==
+            static_cast< ::google::protobuf::uint8>(32u /* 32 & 0xFF */)) {
+
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int32, ::google::protobuf::internal::WireFormatLite::TYPE_INT32>(
+                 input, &initial_delay_seconds_)));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, _internal_metadata_.mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:agones.dev.sdk.GameServer.Spec.Health)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:agones.dev.sdk.GameServer.Spec.Health)
+  return false;
+#undef DO_
+}
+
+void GameServer_Spec_Health::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:agones.dev.sdk.GameServer.Spec.Health)
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  // bool disabled = 1;
+  if (this->disabled() != 0) {
+    ::google::protobuf::internal::WireFormatLite::WriteBool(1, this->disabled(), output);
+  }
+
+  // int32 period_seconds = 2;
+  if (this->period_seconds() != 0) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt32(2, this->period_seconds(), output);
+  }
+
+  // int32 failure_threshold = 3;
+  if (this->failure_threshold() != 0) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt32(3, this->failure_threshold(), output);
+  }
+
+  // int32 initial_delay_seconds = 4;
+  if (this->initial_delay_seconds() != 0) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt32(4, this->initial_delay_seconds(), output);
+  }
+
+  if ((_internal_metadata_.have_unknown_fields() &&  ::google::protobuf::internal::GetProto3PreserveUnknownsDefault())) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        (::google::protobuf::internal::GetProto3PreserveUnknownsDefault()   ? _internal_metadata_.unknown_fields()   : _internal_metadata_.default_instance()), output);
+  }
+  // @@protoc_insertion_point(serialize_end:agones.dev.sdk.GameServer.Spec.Health)
+}
+
+::google::protobuf::uint8* GameServer_Spec_Health::InternalSerializeWithCachedSizesToArray(
+    bool deterministic, ::google::protobuf::uint8* target) const {
+  (void)deterministic; // Unused
+  // @@protoc_insertion_point(serialize_to_array_start:agones.dev.sdk.GameServer.Spec.Health)
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  // bool disabled = 1;
+  if (this->disabled() != 0) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteBoolToArray(1, this->disabled(), target);
+  }
+
+  // int32 period_seconds = 2;
+  if (this->period_seconds() != 0) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt32ToArray(2, this->period_seconds(), target);
+  }
+
+  // int32 failure_threshold = 3;
+  if (this->failure_threshold() != 0) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt32ToArray(3, this->failure_threshold(), target);
+  }
+
+  // int32 initial_delay_seconds = 4;
+  if (this->initial_delay_seconds() != 0) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt32ToArray(4, this->initial_delay_seconds(), target);
+  }
+
+  if ((_internal_metadata_.have_unknown_fields() &&  ::google::protobuf::internal::GetProto3PreserveUnknownsDefault())) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        (::google::protobuf::internal::GetProto3PreserveUnknownsDefault()   ? _internal_metadata_.unknown_fields()   : _internal_metadata_.default_instance()), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:agones.dev.sdk.GameServer.Spec.Health)
+  return target;
+}
+
+size_t GameServer_Spec_Health::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:agones.dev.sdk.GameServer.Spec.Health)
+  size_t total_size = 0;
+
+  if ((_internal_metadata_.have_unknown_fields() &&  ::google::protobuf::internal::GetProto3PreserveUnknownsDefault())) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        (::google::protobuf::internal::GetProto3PreserveUnknownsDefault()   ? _internal_metadata_.unknown_fields()   : _internal_metadata_.default_instance()));
+  }
+  // bool disabled = 1;
+  if (this->disabled() != 0) {
+    total_size += 1 + 1;
+  }
+
+  // int32 period_seconds = 2;
+  if (this->period_seconds() != 0) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::Int32Size(
+        this->period_seconds());
+  }
+
+  // int32 failure_threshold = 3;
+  if (this->failure_threshold() != 0) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::Int32Size(
+        this->failure_threshold());
+  }
+
+  // int32 initial_delay_seconds = 4;
+  if (this->initial_delay_seconds() != 0) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::Int32Size(
+        this->initial_delay_seconds());
+  }
+
+  int cached_size = ::google::protobuf::internal::ToCachedSize(total_size);
+  SetCachedSize(cached_size);
+  return total_size;
+}
+
+void GameServer_Spec_Health::MergeFrom(const ::google::protobuf::Message& from) {
+// @@protoc_insertion_point(generalized_merge_from_start:agones.dev.sdk.GameServer.Spec.Health)
+  GOOGLE_DCHECK_NE(&from, this);
+  const GameServer_Spec_Health* source =
+      ::google::protobuf::internal::DynamicCastToGenerated<const GameServer_Spec_Health>(
+          &from);
+  if (source == NULL) {
+  // @@protoc_insertion_point(generalized_merge_from_cast_fail:agones.dev.sdk.GameServer.Spec.Health)
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+  // @@protoc_insertion_point(generalized_merge_from_cast_success:agones.dev.sdk.GameServer.Spec.Health)
+    MergeFrom(*source);
+  }
+}
+
+void GameServer_Spec_Health::MergeFrom(const GameServer_Spec_Health& from) {
+// @@protoc_insertion_point(class_specific_merge_from_start:agones.dev.sdk.GameServer.Spec.Health)
+  GOOGLE_DCHECK_NE(&from, this);
+  _internal_metadata_.MergeFrom(from._internal_metadata_);
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  if (from.disabled() != 0) {
+    set_disabled(from.disabled());
+  }
+  if (from.period_seconds() != 0) {
+    set_period_seconds(from.period_seconds());
+  }
+  if (from.failure_threshold() != 0) {
+    set_failure_threshold(from.failure_threshold());
+  }
+  if (from.initial_delay_seconds() != 0) {
+    set_initial_delay_seconds(from.initial_delay_seconds());
+  }
+}
+
+void GameServer_Spec_Health::CopyFrom(const ::google::protobuf::Message& from) {
+// @@protoc_insertion_point(generalized_copy_from_start:agones.dev.sdk.GameServer.Spec.Health)
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void GameServer_Spec_Health::CopyFrom(const GameServer_Spec_Health& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:agones.dev.sdk.GameServer.Spec.Health)
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool GameServer_Spec_Health::IsInitialized() const {
+  return true;
+}
+
+void GameServer_Spec_Health::Swap(GameServer_Spec_Health* other) {
+  if (other == this) return;
+  InternalSwap(other);
+}
+void GameServer_Spec_Health::InternalSwap(GameServer_Spec_Health* other) {
+  using std::swap;
+  swap(disabled_, other->disabled_);
+  swap(period_seconds_, other->period_seconds_);
+  swap(failure_threshold_, other->failure_threshold_);
+  swap(initial_delay_seconds_, other->initial_delay_seconds_);
+  _internal_metadata_.Swap(&other->_internal_metadata_);
+}
+
+::google::protobuf::Metadata GameServer_Spec_Health::GetMetadata() const {
+  protobuf_sdk_2eproto::protobuf_AssignDescriptorsOnce();
+  return ::protobuf_sdk_2eproto::file_level_metadata[kIndexInFileMessages];
+}
+
+
+// ===================================================================
+
+void GameServer_Spec::InitAsDefaultInstance() {
+  ::agones::dev::sdk::_GameServer_Spec_default_instance_._instance.get_mutable()->health_ = const_cast< ::agones::dev::sdk::GameServer_Spec_Health*>(
+      ::agones::dev::sdk::GameServer_Spec_Health::internal_default_instance());
+}
+#if !defined(_MSC_VER) || _MSC_VER >= 1900
+const int GameServer_Spec::kHealthFieldNumber;
+#endif  // !defined(_MSC_VER) || _MSC_VER >= 1900
+
+GameServer_Spec::GameServer_Spec()
+  : ::google::protobuf::Message(), _internal_metadata_(NULL) {
+  ::google::protobuf::internal::InitSCC(
+      &protobuf_sdk_2eproto::scc_info_GameServer_Spec.base);
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:agones.dev.sdk.GameServer.Spec)
+}
+GameServer_Spec::GameServer_Spec(const GameServer_Spec& from)
+  : ::google::protobuf::Message(),
+      _internal_metadata_(NULL) {
+  _internal_metadata_.MergeFrom(from._internal_metadata_);
+  if (from.has_health()) {
+    health_ = new ::agones::dev::sdk::GameServer_Spec_Health(*from.health_);
+  } else {
+    health_ = NULL;
+  }
+  // @@protoc_insertion_point(copy_constructor:agones.dev.sdk.GameServer.Spec)
+}
+
+void GameServer_Spec::SharedCtor() {
+  health_ = NULL;
+}
+
+GameServer_Spec::~GameServer_Spec() {
+  // @@protoc_insertion_point(destructor:agones.dev.sdk.GameServer.Spec)
+  SharedDtor();
+}
+
+void GameServer_Spec::SharedDtor() {
+  if (this != internal_default_instance()) delete health_;
+}
+
+void GameServer_Spec::SetCachedSize(int size) const {
+  _cached_size_.Set(size);
+}
+const ::google::protobuf::Descriptor* GameServer_Spec::descriptor() {
+  ::protobuf_sdk_2eproto::protobuf_AssignDescriptorsOnce();
+  return ::protobuf_sdk_2eproto::file_level_metadata[kIndexInFileMessages].descriptor;
+}
+
+const GameServer_Spec& GameServer_Spec::default_instance() {
+  ::google::protobuf::internal::InitSCC(&protobuf_sdk_2eproto::scc_info_GameServer_Spec.base);
+  return *internal_default_instance();
+}
+
+
+void GameServer_Spec::Clear() {
+// @@protoc_insertion_point(message_clear_start:agones.dev.sdk.GameServer.Spec)
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  if (GetArenaNoVirtual() == NULL && health_ != NULL) {
+    delete health_;
+  }
+  health_ = NULL;
+  _internal_metadata_.Clear();
+}
+
+bool GameServer_Spec::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!GOOGLE_PREDICT_TRUE(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:agones.dev.sdk.GameServer.Spec)
+  for (;;) {
+    ::std::pair<::google::protobuf::uint32, bool> p = input->ReadTagWithCutoffNoLastTag(127u);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // .agones.dev.sdk.GameServer.Spec.Health health = 1;
+      case 1: {
+        if (static_cast< ::google::protobuf::uint8>(tag) ==
+            static_cast< ::google::protobuf::uint8>(10u /* 10 & 0xFF */)) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessage(
+               input, mutable_health()));
+        } else {
+          goto handle_unusual;
+        }
+        break;
       }
-    } else {
-      ::std::unique_ptr<GameServer_ObjectMeta_AnnotationsEntry_DoNotUse> entry;
-      for (::google::protobuf::Map< ::std::string, ::std::string >::const_iterator
-          it = this->annotations().begin();
-          it != this->annotations().end(); ++it) {
-        entry.reset(annotations_.NewEntryWrapper(
-            it->first, it->second));
-        target = ::google::protobuf::internal::WireFormatLite::
-                   InternalWriteMessageNoVirtualToArray(
-                       8, *entry, deterministic, target);
-;
-        Utf8Check::Check(&*it);
+
+      default: {
+      handle_unusual:
+        if (tag == 0) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, _internal_metadata_.mutable_unknown_fields()));
+        break;
       }
     }
   }
+success:
+  // @@protoc_insertion_point(parse_success:agones.dev.sdk.GameServer.Spec)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:agones.dev.sdk.GameServer.Spec)
+  return false;
+#undef DO_
+}
+
+void GameServer_Spec::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:agones.dev.sdk.GameServer.Spec)
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  // .agones.dev.sdk.GameServer.Spec.Health health = 1;
+  if (this->has_health()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, this->_internal_health(), output);
+  }
+
+  if ((_internal_metadata_.have_unknown_fields() &&  ::google::protobuf::internal::GetProto3PreserveUnknownsDefault())) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        (::google::protobuf::internal::GetProto3PreserveUnknownsDefault()   ? _internal_metadata_.unknown_fields()   : _internal_metadata_.default_instance()), output);
+  }
+  // @@protoc_insertion_point(serialize_end:agones.dev.sdk.GameServer.Spec)
+}
+
+::google::protobuf::uint8* GameServer_Spec::InternalSerializeWithCachedSizesToArray(
+    bool deterministic, ::google::protobuf::uint8* target) const {
+  (void)deterministic; // Unused
+  // @@protoc_insertion_point(serialize_to_array_start:agones.dev.sdk.GameServer.Spec)
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  // .agones.dev.sdk.GameServer.Spec.Health health = 1;
+  if (this->has_health()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      InternalWriteMessageToArray(
+        1, this->_internal_health(), deterministic, target);
+  }
+
+  if ((_internal_metadata_.have_unknown_fields() &&  ::google::protobuf::internal::GetProto3PreserveUnknownsDefault())) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        (::google::protobuf::internal::GetProto3PreserveUnknownsDefault()   ? _internal_metadata_.unknown_fields()   : _internal_metadata_.default_instance()), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:agones.dev.sdk.GameServer.Spec)
+  return target;
+}
+
+size_t GameServer_Spec::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:agones.dev.sdk.GameServer.Spec)
+  size_t total_size = 0;
+
+  if ((_internal_metadata_.have_unknown_fields() &&  ::google::protobuf::internal::GetProto3PreserveUnknownsDefault())) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        (::google::protobuf::internal::GetProto3PreserveUnknownsDefault()   ? _internal_metadata_.unknown_fields()   : _internal_metadata_.default_instance()));
+  }
+  // .agones.dev.sdk.GameServer.Spec.Health health = 1;
+  if (this->has_health()) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::MessageSize(
+        *health_);
+  }
+
+  int cached_size = ::google::protobuf::internal::ToCachedSize(total_size);
+  SetCachedSize(cached_size);
+  return total_size;
+}
+
+void GameServer_Spec::MergeFrom(const ::google::protobuf::Message& from) {
+// @@protoc_insertion_point(generalized_merge_from_start:agones.dev.sdk.GameServer.Spec)
+  GOOGLE_DCHECK_NE(&from, this);
+  const GameServer_Spec* source =
+      ::google::protobuf::internal::DynamicCastToGenerated<const GameServer_Spec>(
+          &from);
+  if (source == NULL) {
+  // @@protoc_insertion_point(generalized_merge_from_cast_fail:agones.dev.sdk.GameServer.Spec)
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+  // @@protoc_insertion_point(generalized_merge_from_cast_success:agones.dev.sdk.GameServer.Spec)
+    MergeFrom(*source);
+  }
+}
+
+void GameServer_Spec::MergeFrom(const GameServer_Spec& from) {
+// @@protoc_insertion_point(class_specific_merge_from_start:agones.dev.sdk.GameServer.Spec)
+  GOOGLE_DCHECK_NE(&from, this);
+  _internal_metadata_.MergeFrom(from._internal_metadata_);
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  if (from.has_health()) {
+    mutable_health()->::agones::dev::sdk::GameServer_Spec_Health::MergeFrom(from.health());
+  }
+}
 
-  // map<string, string> labels = 9;
-  if (!this->labels().empty()) {
-    typedef ::google::protobuf::Map< ::std::string, ::std::string >::const_pointer
-        ConstPtr;
-    typedef ConstPtr SortItem;
-    typedef ::google::protobuf::internal::CompareByDerefFirst<SortItem> Less;
-    struct Utf8Check {
-      static void Check(ConstPtr p) {
-        ::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
-          p->first.data(), static_cast<int>(p->first.length()),
-          ::google::protobuf::internal::WireFormatLite::SERIALIZE,
-          "agones.dev.sdk.GameServer.ObjectMeta.LabelsEntry.key");
-        ::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
-          p->second.data(), static_cast<int>(p->second.length()),
-          ::google::protobuf::internal::WireFormatLite::SERIALIZE,
-          "agones.dev.sdk.GameServer.ObjectMeta.LabelsEntry.value");
-      }
-    };
+void GameServer_Spec::CopyFrom(const ::google::protobuf::Message& from) {
+// @@protoc_insertion_point(generalized_copy_from_start:agones.dev.sdk.GameServer.Spec)
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
 
-    if (deterministic &&
-        this->labels().size() > 1) {
-      ::std::unique_ptr<SortItem[]> items(
-          new SortItem[this->labels().size()]);
-      typedef ::google::protobuf::Map< ::std::string, ::std::string >::size_type size_type;
-      size_type n = 0;
-      for (::google::protobuf::Map< ::std::string, ::std::string >::const_iterator
-          it = this->labels().begin();
-          it != this->labels().end(); ++it, ++n) {
-        items[static_cast<ptrdiff_t>(n)] = SortItem(&*it);
+void GameServer_Spec::CopyFrom(const GameServer_Spec& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:agones.dev.sdk.GameServer.Spec)
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool GameServer_Spec::IsInitialized() const {
+  return true;
+}
+
+void GameServer_Spec::Swap(GameServer_Spec* other) {
+  if (other == this) return;
+  InternalSwap(other);
+}
+void GameServer_Spec::InternalSwap(GameServer_Spec* other) {
+  using std::swap;
+  swap(health_, other->health_);
+  _internal_metadata_.Swap(&other->_internal_metadata_);
+}
+
+::google::protobuf::Metadata GameServer_Spec::GetMetadata() const {
+  protobuf_sdk_2eproto::protobuf_AssignDescriptorsOnce();
+  return ::protobuf_sdk_2eproto::file_level_metadata[kIndexInFileMessages];
+}
+
+
+// ===================================================================
+
+void GameServer_Status_Port::InitAsDefaultInstance() {
+}
+#if !defined(_MSC_VER) || _MSC_VER >= 1900
+const int GameServer_Status_Port::kNameFieldNumber;
+const int GameServer_Status_Port::kPortFieldNumber;
+#endif  // !defined(_MSC_VER) || _MSC_VER >= 1900
+
+GameServer_Status_Port::GameServer_Status_Port()
+  : ::google::protobuf::Message(), _internal_metadata_(NULL) {
+  ::google::protobuf::internal::InitSCC(
+      &protobuf_sdk_2eproto::scc_info_GameServer_Status_Port.base);
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:agones.dev.sdk.GameServer.Status.Port)
+}
+GameServer_Status_Port::GameServer_Status_Port(const GameServer_Status_Port& from)
+  : ::google::protobuf::Message(),
+      _internal_metadata_(NULL) {
+  _internal_metadata_.MergeFrom(from._internal_metadata_);
+  name_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  if (from.name().size() > 0) {
+    name_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.name_);
+  }
+  port_ = from.port_;
+  // @@protoc_insertion_point(copy_constructor:agones.dev.sdk.GameServer.Status.Port)
+}
+
+void GameServer_Status_Port::SharedCtor() {
+  name_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  port_ = 0;
+}
+
+GameServer_Status_Port::~GameServer_Status_Port() {
+  // @@protoc_insertion_point(destructor:agones.dev.sdk.GameServer.Status.Port)
+  SharedDtor();
+}
+
+void GameServer_Status_Port::SharedDtor() {
+  name_.DestroyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+
+void GameServer_Status_Port::SetCachedSize(int size) const {
+  _cached_size_.Set(size);
+}
+const ::google::protobuf::Descriptor* GameServer_Status_Port::descriptor() {
+  ::protobuf_sdk_2eproto::protobuf_AssignDescriptorsOnce();
+  return ::protobuf_sdk_2eproto::file_level_metadata[kIndexInFileMessages].descriptor;
+}
+
+const GameServer_Status_Port& GameServer_Status_Port::default_instance() {
+  ::google::protobuf::internal::InitSCC(&protobuf_sdk_2eproto::scc_info_GameServer_Status_Port.base);
+  return *internal_default_instance();
+}
+
+
+void GameServer_Status_Port::Clear() {
+// @@protoc_insertion_point(message_clear_start:agones.dev.sdk.GameServer.Status.Port)
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  name_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  port_ = 0;
+  _internal_metadata_.Clear();
+}
+
+bool GameServer_Status_Port::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!GOOGLE_PREDICT_TRUE(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:agones.dev.sdk.GameServer.Status.Port)
+  for (;;) {
+    ::std::pair<::google::protobuf::uint32, bool> p = input->ReadTagWithCutoffNoLastTag(127u);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // string name = 1;
+      case 1: {
+        if (static_cast< ::google::protobuf::uint8>(tag) ==
+            static_cast< ::google::protobuf::uint8>(10u /* 10 & 0xFF */)) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadString(
+                input, this->mutable_name()));
+          DO_(::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+            this->name().data(), static_cast<int>(this->name().length()),
+            ::google::protobuf::internal::WireFormatLite::PARSE,
+            "agones.dev.sdk.GameServer.Status.Port.name"));
+        } else {
+          goto handle_unusual;
+        }
+        break;
       }
-      ::std::sort(&items[0], &items[static_cast<ptrdiff_t>(n)], Less());
-      ::std::unique_ptr<GameServer_ObjectMeta_LabelsEntry_DoNotUse> entry;
-      for (size_type i = 0; i < n; i++) {
-        entry.reset(labels_.NewEntryWrapper(
-            items[static_cast<ptrdiff_t>(i)]->first, items[static_cast<ptrdiff_t>(i)]->second));
-        target = ::google::protobuf::internal::WireFormatLite::
-                   InternalWriteMessageNoVirtualToArray(
-                       9, *entry, deterministic, target);
-;
-        Utf8Check::Check(items[static_cast<ptrdiff_t>(i)]);
+
+      // int32 port = 2;
+      case 2: {
+        if (static_cast< ::google::protobuf::uint8>(tag) ==
+            static_cast< ::google::protobuf::uint8>(16u /* 16 & 0xFF */)) {
+
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int32, ::google::protobuf::internal::WireFormatLite::TYPE_INT32>(
+                 input, &port_)));
+        } else {
+          goto handle_unusual;
+        }
+        break;
       }
-    } else {
-      ::std::unique_ptr<GameServer_ObjectMeta_LabelsEntry_DoNotUse> entry;
-      for (::google::protobuf::Map< ::std::string, ::std::string >::const_iterator
-          it = this->labels().begin();
-          it != this->labels().end(); ++it) {
-        entry.reset(labels_.NewEntryWrapper(
-            it->first, it->second));
-        target = ::google::protobuf::internal::WireFormatLite::
-                   InternalWriteMessageNoVirtualToArray(
-                       9, *entry, deterministic, target);
-;
-        Utf8Check::Check(&*it);
+
+      default: {
+      handle_unusual:
+        if (tag == 0) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, _internal_metadata_.mutable_unknown_fields()));
+        break;
       }
     }
   }
+success:
+  // @@protoc_insertion_point(parse_success:agones.dev.sdk.GameServer.Status.Port)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:agones.dev.sdk.GameServer.Status.Port)
+  return false;
+#undef DO_
+}
+
+void GameServer_Status_Port::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:agones.dev.sdk.GameServer.Status.Port)
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  // string name = 1;
+  if (this->name().size() > 0) {
+    ::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+      this->name().data(), static_cast<int>(this->name().length()),
+      ::google::protobuf::internal::WireFormatLite::SERIALIZE,
+      "agones.dev.sdk.GameServer.Status.Port.name");
+    ::google::protobuf::internal::WireFormatLite::WriteStringMaybeAliased(
+      1, this->name(), output);
+  }
+
+  // int32 port = 2;
+  if (this->port() != 0) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt32(2, this->port(), output);
+  }
+
+  if ((_internal_metadata_.have_unknown_fields() &&  ::google::protobuf::internal::GetProto3PreserveUnknownsDefault())) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        (::google::protobuf::internal::GetProto3PreserveUnknownsDefault()   ? _internal_metadata_.unknown_fields()   : _internal_metadata_.default_instance()), output);
+  }
+  // @@protoc_insertion_point(serialize_end:agones.dev.sdk.GameServer.Status.Port)
+}
+
+::google::protobuf::uint8* GameServer_Status_Port::InternalSerializeWithCachedSizesToArray(
+    bool deterministic, ::google::protobuf::uint8* target) const {
+  (void)deterministic; // Unused
+  // @@protoc_insertion_point(serialize_to_array_start:agones.dev.sdk.GameServer.Status.Port)
+  ::google::protobuf::uint32 cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  // string name = 1;
+  if (this->name().size() > 0) {
+    ::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+      this->name().data(), static_cast<int>(this->name().length()),
+      ::google::protobuf::internal::WireFormatLite::SERIALIZE,
+      "agones.dev.sdk.GameServer.Status.Port.name");
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteStringToArray(
+        1, this->name(), target);
+  }
+
+  // int32 port = 2;
+  if (this->port() != 0) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt32ToArray(2, this->port(), target);
+  }
 
   if ((_internal_metadata_.have_unknown_fields() &&  ::google::protobuf::internal::GetProto3PreserveUnknownsDefault())) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         (::google::protobuf::internal::GetProto3PreserveUnknownsDefault()   ? _internal_metadata_.unknown_fields()   : _internal_metadata_.default_instance()), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:agones.dev.sdk.GameServer.ObjectMeta)
+  // @@protoc_insertion_point(serialize_to_array_end:agones.dev.sdk.GameServer.Status.Port)
   return target;
 }
 
-size_t GameServer_ObjectMeta::ByteSizeLong() const {
-// @@protoc_insertion_point(message_byte_size_start:agones.dev.sdk.GameServer.ObjectMeta)
+size_t GameServer_Status_Port::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:agones.dev.sdk.GameServer.Status.Port)
   size_t total_size = 0;
 
   if ((_internal_metadata_.have_unknown_fields() &&  ::google::protobuf::internal::GetProto3PreserveUnknownsDefault())) {
@@ -1890,34 +3658,6 @@ size_t GameServer_ObjectMeta::ByteSizeLong() const {
       ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
         (::google::protobuf::internal::GetProto3PreserveUnknownsDefault()   ? _internal_metadata_.unknown_fields()   : _internal_metadata_.default_instance()));
   }
-  // map<string, string> annotations = 8;
-  total_size += 1 *
-      ::google::protobuf::internal::FromIntSize(this->annotations_size());
-  {
-    ::std::unique_ptr<GameServer_ObjectMeta_AnnotationsEntry_DoNotUse> entry;
-    for (::google::protobuf::Map< ::std::string, ::std::string >::const_iterator
-        it = this->annotations().begin();
-        it != this->annotations().end(); ++it) {
-      entry.reset(annotations_.NewEntryWrapper(it->first, it->second));
-      total_size += ::google::protobuf::internal::WireFormatLite::
-          MessageSizeNoVirtual(*entry);
-    }
-  }
-
-  // map<string, string> labels = 9;
-  total_size += 1 *
-      ::google::protobuf::internal::FromIntSize(this->labels_size());
-  {
-    ::std::unique_ptr<GameServer_ObjectMeta_LabelsEntry_DoNotUse> entry;
-    for (::google::protobuf::Map< ::std::string, ::std::string >::const_iterator
-        it = this->labels().begin();
-        it != this->labels().end(); ++it) {
-      entry.reset(labels_.NewEntryWrapper(it->first, it->second));
-      total_size += ::google::protobuf::internal::WireFormatLite::
-          MessageSizeNoVirtual(*entry);
-    }
-  }
-
   // string name = 1;
   if (this->name().size() > 0) {
     total_size += 1 +
@@ -1925,46 +3665,11 @@ size_t GameServer_ObjectMeta::ByteSizeLong() const {
         this->name());
   }
 
-  // string namespace = 2;
-  if (this->namespace_().size() > 0) {
-    total_size += 1 +
-      ::google::protobuf::internal::WireFormatLite::StringSize(
-        this->namespace_());
-  }
-
-  // string uid = 3;
-  if (this->uid().size() > 0) {
-    total_size += 1 +
-      ::google::protobuf::internal::WireFormatLite::StringSize(
-        this->uid());
-  }
-
-  // string resource_version = 4;
-  if (this->resource_version().size() > 0) {
-    total_size += 1 +
-      ::google::protobuf::internal::WireFormatLite::StringSize(
-        this->resource_version());
-  }
-
-  // int64 generation = 5;
-  if (this->generation() != 0) {
-    total_size += 1 +
-      ::google::protobuf::internal::WireFormatLite::Int64Size(
-        this->generation());
-  }
-
-  // int64 creation_timestamp = 6;
-  if (this->creation_timestamp() != 0) {
-    total_size += 1 +
-      ::google::protobuf::internal::WireFormatLite::Int64Size(
-        this->creation_timestamp());
-  }
-
-  // int64 deletion_timestamp = 7;
-  if (this->deletion_timestamp() != 0) {
+  // int32 port = 2;
+  if (this->port() != 0) {
     total_size += 1 +
-      ::google::protobuf::internal::WireFormatLite::Int64Size(
-        this->deletion_timestamp());
+      ::google::protobuf::internal::WireFormatLite::Int32Size(
+        this->port());
   }
 
   int cached_size = ::google::protobuf::internal::ToCachedSize(total_size);
@@ -1972,98 +3677,68 @@ size_t GameServer_ObjectMeta::ByteSizeLong() const {
   return total_size;
 }
 
-void GameServer_ObjectMeta::MergeFrom(const ::google::protobuf::Message& from) {
-// @@protoc_insertion_point(generalized_merge_from_start:agones.dev.sdk.GameServer.ObjectMeta)
+void GameServer_Status_Port::MergeFrom(const ::google::protobuf::Message& from) {
+// @@protoc_insertion_point(generalized_merge_from_start:agones.dev.sdk.GameServer.Status.Port)
   GOOGLE_DCHECK_NE(&from, this);
-  const GameServer_ObjectMeta* source =
-      ::google::protobuf::internal::DynamicCastToGenerated<const GameServer_ObjectMeta>(
+  const GameServer_Status_Port* source =
+      ::google::protobuf::internal::DynamicCastToGenerated<const GameServer_Status_Port>(
           &from);
   if (source == NULL) {
-  // @@protoc_insertion_point(generalized_merge_from_cast_fail:agones.dev.sdk.GameServer.ObjectMeta)
+  // @@protoc_insertion_point(generalized_merge_from_cast_fail:agones.dev.sdk.GameServer.Status.Port)
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
   } else {
-  // @@protoc_insertion_point(generalized_merge_from_cast_success:agones.dev.sdk.GameServer.ObjectMeta)
+  // @@protoc_insertion_point(generalized_merge_from_cast_success:agones.dev.sdk.GameServer.Status.Port)
     MergeFrom(*source);
   }
 }
 
-void GameServer_ObjectMeta::MergeFrom(const GameServer_ObjectMeta& from) {
-// @@protoc_insertion_point(class_specific_merge_from_start:agones.dev.sdk.GameServer.ObjectMeta)
+void GameServer_Status_Port::MergeFrom(const GameServer_Status_Port& from) {
+// @@protoc_insertion_point(class_specific_merge_from_start:agones.dev.sdk.GameServer.Status.Port)
   GOOGLE_DCHECK_NE(&from, this);
   _internal_metadata_.MergeFrom(from._internal_metadata_);
   ::google::protobuf::uint32 cached_has_bits = 0;
   (void) cached_has_bits;
 
-  annotations_.MergeFrom(from.annotations_);
-  labels_.MergeFrom(from.labels_);
   if (from.name().size() > 0) {
 
     name_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.name_);
   }
-  if (from.namespace_().size() > 0) {
-
-    namespace__.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.namespace__);
-  }
-  if (from.uid().size() > 0) {
-
-    uid_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.uid_);
-  }
-  if (from.resource_version().size() > 0) {
-
-    resource_version_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.resource_version_);
-  }
-  if (from.generation() != 0) {
-    set_generation(from.generation());
-  }
-  if (from.creation_timestamp() != 0) {
-    set_creation_timestamp(from.creation_timestamp());
-  }
-  if (from.deletion_timestamp() != 0) {
-    set_deletion_timestamp(from.deletion_timestamp());
+  if (from.port() != 0) {
+    set_port(from.port());
   }
 }
 
-void GameServer_ObjectMeta::CopyFrom(const ::google::protobuf::Message& from) {
-// @@protoc_insertion_point(generalized_copy_from_start:agones.dev.sdk.GameServer.ObjectMeta)
+void GameServer_Status_Port::CopyFrom(const ::google::protobuf::Message& from) {
+// @@protoc_insertion_point(generalized_copy_from_start:agones.dev.sdk.GameServer.Status.Port)
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void GameServer_ObjectMeta::CopyFrom(const GameServer_ObjectMeta& from) {
-// @@protoc_insertion_point(class_specific_copy_from_start:agones.dev.sdk.GameServer.ObjectMeta)
+void GameServer_Status_Port::CopyFrom(const GameServer_Status_Port& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:agones.dev.sdk.GameServer.Status.Port)
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool GameServer_ObjectMeta::IsInitialized() const {
+bool GameServer_Status_Port::IsInitialized() const {
   return true;
 }
 
-void GameServer_ObjectMeta::Swap(GameServer_ObjectMeta* other) {
+void GameServer_Status_Port::Swap(GameServer_Status_Port* other) {
   if (other == this) return;
   InternalSwap(other);
 }
-void GameServer_ObjectMeta::InternalSwap(GameServer_ObjectMeta* other) {
+void GameServer_Status_Port::InternalSwap(GameServer_Status_Port* other) {
   using std::swap;
-  annotations_.Swap(&other->annotations_);
-  labels_.Swap(&other->labels_);
   name_.Swap(&other->name_, &::google::protobuf::internal::GetEmptyStringAlreadyInited(),
     GetArenaNoVirtual());
-  namespace__.Swap(&other->namespace__, &::google::protobuf::internal::GetEmptyStringAlreadyInited(),
-    GetArenaNoVirtual());
-  uid_.Swap(&other->uid_, &::google::protobuf::internal::GetEmptyStringAlreadyInited(),
-    GetArenaNoVirtual());
-  resource_version_.Swap(&other->resource_version_, &::google::protobuf::internal::GetEmptyStringAlreadyInited(),
-    GetArenaNoVirtual());
-  swap(generation_, other->generation_);
-  swap(creation_timestamp_, other->creation_timestamp_);
-  swap(deletion_timestamp_, other->deletion_timestamp_);
+  swap(port_, other->port_);
   _internal_metadata_.Swap(&other->_internal_metadata_);
 }
 
-::google::protobuf::Metadata GameServer_ObjectMeta::GetMetadata() const {
+::google::protobuf::Metadata GameServer_Status_Port::GetMetadata() const {
   protobuf_sdk_2eproto::protobuf_AssignDescriptorsOnce();
   return ::protobuf_sdk_2eproto::file_level_metadata[kIndexInFileMessages];
 }
@@ -2071,132 +3746,102 @@ void GameServer_ObjectMeta::InternalSwap(GameServer_ObjectMeta* other) {
 
 // ===================================================================
 
-void GameServer_Spec_Health::InitAsDefaultInstance() {
+void GameServer_Status_Counter::InitAsDefaultInstance() {
 }
 #if !defined(_MSC_VER) || _MSC_VER >= 1900
-const int GameServer_Spec_Health::kDisabledFieldNumber;
-const int GameServer_Spec_Health::kPeriodSecondsFieldNumber;
-const int GameServer_Spec_Health::kFailureThresholdFieldNumber;
-const int GameServer_Spec_Health::kInitialDelaySecondsFieldNumber;
+const int GameServer_Status_Counter::kCountFieldNumber;
+const int GameServer_Status_Counter::kCapacityFieldNumber;
 #endif  // !defined(_MSC_VER) || _MSC_VER >= 1900
 
-GameServer_Spec_Health::GameServer_Spec_Health()
+GameServer_Status_Counter::GameServer_Status_Counter()
   : ::google::protobuf::Message(), _internal_metadata_(NULL) {
   ::google::protobuf::internal::InitSCC(
-      &protobuf_sdk_2eproto::scc_info_GameServer_Spec_Health.base);
+      &protobuf_sdk_2eproto::scc_info_GameServer_Status_Counter.base);
   SharedCtor();
-  // @@protoc_insertion_point(constructor:agones.dev.sdk.GameServer.Spec.Health)
+  // @@protoc_insertion_point(constructor:agones.dev.sdk.GameServer.Status.Counter)
 }
-GameServer_Spec_Health::GameServer_Spec_Health(const GameServer_Spec_Health& from)
+GameServer_Status_Counter::GameServer_Status_Counter(const GameServer_Status_Counter& from)
   : ::google::protobuf::Message(),
       _internal_metadata_(NULL) {
   _internal_metadata_.MergeFrom(from._internal_metadata_);
-  ::memcpy(&disabled_, &from.disabled_,
-    static_cast<size_t>(reinterpret_cast<char*>(&initial_delay_seconds_) -
-    reinterpret_cast<char*>(&disabled_)) + sizeof(initial_delay_seconds_));
-  // @@protoc_insertion_point(copy_constructor:agones.dev.sdk.GameServer.Spec.Health)
+  ::memcpy(&count_, &from.count_,
+    static_cast<size_t>(reinterpret_cast<char*>(&capacity_) -
+    reinterpret_cast<char*>(&count_)) + sizeof(capacity_));
+  // @@protoc_insertion_point(copy_constructor:agones.dev.sdk.GameServer.Status.Counter)
 }
 
-void GameServer_Spec_Health::SharedCtor() {
-  ::memset(&disabled_, 0, static_cast<size_t>(
-      reinterpret_cast<char*>(&initial_delay_seconds_) -
-      reinterpret_cast<char*>(&disabled_)) + sizeof(initial_delay_seconds_));
+void GameServer_Status_Counter::SharedCtor() {
+  ::memset(&count_, 0, static_cast<size_t>(
+      reinterpret_cast<char*>(&capacity_) -
+      reinterpret_cast<char*>(&count_)) + sizeof(capacity_));
 }
 
-GameServer_Spec_Health::~GameServer_Spec_Health() {
-  // @@protoc_insertion_point(destructor:agones.dev.sdk.GameServer.Spec.Health)
+GameServer_Status_Counter::~GameServer_Status_Counter() {
+  // @@protoc_insertion_point(destructor:agones.dev.sdk.GameServer.Status.Counter)
   SharedDtor();
 }
 
-void GameServer_Spec_Health::SharedDtor() {
+void GameServer_Status_Counter::SharedDtor() {
 }
 
-void GameServer_Spec_Health::SetCachedSize(int size) const {
+void GameServer_Status_Counter::SetCachedSize(int size) const {
   _cached_size_.Set(size);
 }
-const ::google::protobuf::Descriptor* GameServer_Spec_Health::descriptor() {
+const ::google::protobuf::Descriptor* GameServer_Status_Counter::descriptor() {
   ::protobuf_sdk_2eproto::protobuf_AssignDescriptorsOnce();
   return ::protobuf_sdk_2eproto::file_level_metadata[kIndexInFileMessages].descriptor;
 }
 
-const GameServer_Spec_Health& GameServer_Spec_Health::default_instance() {
-  ::google::protobuf::internal::InitSCC(&protobuf_sdk_2eproto::scc_info_GameServer_Spec_Health.base);
+const GameServer_Status_Counter& GameServer_Status_Counter::default_instance() {
+  ::google::protobuf::internal::InitSCC(&protobuf_sdk_2eproto::scc_info_GameServer_Status_Counter.base);
   return *internal_default_instance();
 }
 
 
-void GameServer_Spec_Health::Clear() {
-// @@protoc_insertion_point(message_clear_start:agones.dev.sdk.GameServer.Spec.Health)
+void GameServer_Status_Counter::Clear() {
+// @@protoc_insertion_point(message_clear_start:agones.dev.sdk.GameServer.Status.Counter)
   ::google::protobuf::uint32 cached_has_bits = 0;
   // Prevent compiler warnings about cached_has_bits being unused
   (void) cached_has_bits;
 
-  ::memset(&disabled_, 0, static_cast<size_t>(
-      reinterpret_cast<char*>(&initial_delay_seconds_) -
-      reinterpret_cast<char*>(&disabled_)) + sizeof(initial_delay_seconds_));
+  ::memset(&count_, 0, static_cast<size_t>(
+      reinterpret_cast<char*>(&capacity_) -
+      reinterpret_cast<char*>(&count_)) + sizeof(capacity_));
   _internal_metadata_.Clear();
 }
 
-bool GameServer_Spec_Health::MergePartialFromCodedStream(
+bool GameServer_Status_Counter::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!GOOGLE_PREDICT_TRUE(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:agones.dev.sdk.GameServer.Spec.Health)
+  // @@protoc_insertion_point(parse_start:agones.dev.sdk.GameServer.Status.Counter)
   for (;;) {
     ::std::pair<::google::protobuf::uint32, bool> p = input->ReadTagWithCutoffNoLastTag(127u);
     tag = p.first;
     if (!p.second) goto handle_unusual;
     switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
-      // bool disabled = 1;
+      // int64 count = 1;
       case 1: {
         if (static_cast< ::google::protobuf::uint8>(tag) ==
             static_cast< ::google::protobuf::uint8>(8u /* 8 & 0xFF */)) {
 
           DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
-                   bool, ::google::protobuf::internal::WireFormatLite::TYPE_BOOL>(
-                 input, &disabled_)));
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &count_)));
         } else {
           goto handle_unusual;
         }
         break;
       }
 
-      // int32 period_seconds = 2;
+      // int64 capacity = 2;
       case 2: {
         if (static_cast< ::google::protobuf::uint8>(tag) ==
             static_cast< ::google::protobuf::uint8>(16u /* 16 & 0xFF */)) {
 
           DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
-                   ::google::protobuf::int32, ::google::protobuf::internal::WireFormatLite::TYPE_INT32>(
-                 input, &period_seconds_)));
-        } else {
-          goto handle_unusual;
-        }
-        break;
-      }
-
-      // int32 failure_threshold = 3;
-      case 3: {
-        if (static_cast< ::google::protobuf::uint8>(tag) ==
-            static_cast< ::google::protobuf::uint8>(24u /* 24 & 0xFF */)) {
-
-          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
-                   ::google::protobuf::int32, ::google::protobuf::internal::WireFormatLite::TYPE_INT32>(
-                 input, &failure_threshold_)));
-        } else {
-          goto handle_unusual;
-        }
-        break;
-      }
-
-      // int32 initial_delay_seconds = 4;
-      case 4: {
-        if (static_cast< ::google::protobuf::uint8>(tag) ==
-            static_cast< ::google::protobuf::uint8>(32u /* 32 & 0xFF */)) {
-
-          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
-                   ::google::protobuf::int32, ::google::protobuf::internal::WireFormatLite::TYPE_INT32>(
-                 input, &initial_delay_seconds_)));
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &capacity_)));
         } else {
           goto handle_unusual;
         }
@@ -2215,84 +3860,64 @@ bool GameServer_Spec_Health::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:agones.dev.sdk.GameServer.Spec.Health)
+  // @@protoc_insertion_point(parse_success:agones.dev.sdk.GameServer.Status.Counter)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:agones.dev.sdk.GameServer.Spec.Health)
+  // @@protoc_insertion_point(parse_failure:agones.dev.sdk.GameServer.Status.Counter)
   return false;
 #undef DO_
 }
 
-void GameServer_Spec_Health::SerializeWithCachedSizes(
+void GameServer_Status_Counter::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:agones.dev.sdk.GameServer.Spec.Health)
+  // @@protoc_insertion_point(serialize_start:agones.dev.sdk.GameServer.Status.Counter)
   ::google::protobuf::uint32 cached_has_bits = 0;
   (void) cached_has_bits;
 
-  // bool disabled = 1;
-  if (this->disabled() != 0) {
-    ::google::protobuf::internal::WireFormatLite::WriteBool(1, this->disabled(), output);
-  }
-
-  // int32 period_seconds = 2;
-  if (this->period_seconds() != 0) {
-    ::google::protobuf::internal::WireFormatLite::WriteInt32(2, this->period_seconds(), output);
-  }
-
-  // int32 failure_threshold = 3;
-  if (this->failure_threshold() != 0) {
-    ::google::protobuf::internal::WireFormatLite::WriteInt32(3, this->failure_threshold(), output);
+  // int64 count = 1;
+  if (this->count() != 0) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(1, this->count(), output);
   }
 
-  // int32 initial_delay_seconds = 4;
-  if (this->initial_delay_seconds() != 0) {
-    ::google::protobuf::internal::WireFormatLite::WriteInt32(4, this->initial_delay_seconds(), output);
+  // int64 capacity = 2;
+  if (this->capacity() != 0) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(2, this->capacity(), output);
   }
 
   if ((_internal_metadata_.have_unknown_fields() &&  ::google::protobuf::internal::GetProto3PreserveUnknownsDefault())) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         (::google::protobuf::internal::GetProto3PreserveUnknownsDefault()   ? _internal_metadata_.unknown_fields()   : _internal_metadata_.default_instance()), output);
   }
-  // @@protoc_insertion_point(serialize_end:agones.dev.sdk.GameServer.Spec.Health)
+  // @@protoc_insertion_point(serialize_end:agones.dev.sdk.GameServer.Status.Counter)
 }
 
-::google::protobuf::uint8* GameServer_Spec_Health::InternalSerializeWithCachedSizesToArray(
+::google::protobuf::uint8* GameServer_Status_Counter::InternalSerializeWithCachedSizesToArray(
     bool deterministic, ::google::protobuf::uint8* target) const {
   (void)deterministic; // Unused
-  // @@protoc_insertion_point(serialize_to_array_start:agones.dev.sdk.GameServer.Spec.Health)
+  // @@protoc_insertion_point(serialize_to_array_start:agones.dev.sdk.GameServer.Status.Counter)
   ::google::protobuf::uint32 cached_has_bits = 0;
   (void) cached_has_bits;
 
-  // bool disabled = 1;
-  if (this->disabled() != 0) {
-    target = ::google::protobuf::internal::WireFormatLite::WriteBoolToArray(1, this->disabled(), target);
-  }
-
-  // int32 period_seconds = 2;
-  if (this->period_seconds() != 0) {
-    target = ::google::protobuf::internal::WireFormatLite::WriteInt32ToArray(2, this->period_seconds(), target);
-  }
-
-  // int32 failure_threshold = 3;
-  if (this->failure_threshold() != 0) {
-    target = ::google::protobuf::internal::WireFormatLite::WriteInt32ToArray(3, this->failure_threshold(), target);
+  // int64 count = 1;
+  if (this->count() != 0) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(1, this->count(), target);
   }
 
-  // int32 initial_delay_seconds = 4;
-  if (this->initial_delay_seconds() != 0) {
-    target = ::google::protobuf::internal::WireFormatLite::WriteInt32ToArray(4, this->initial_delay_seconds(), target);
+  // int64 capacity = 2;
+  if (this->capacity() != 0) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(2, this->capacity(), target);
   }
 
   if ((_internal_metadata_.have_unknown_fields() &&  ::google::protobuf::internal::GetProto3PreserveUnknownsDefault())) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         (::google::protobuf::internal::GetProto3PreserveUnknownsDefault()   ? _internal_metadata_.unknown_fields()   : _internal_metadata_.default_instance()), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:agones.dev.sdk.GameServer.Spec.Health)
+  // @@protoc_insertion_point(serialize_to_array_end:agones.dev.sdk.GameServer.Status.Counter)
   return target;
 }
-
-size_t GameServer_Spec_Health::ByteSizeLong() const {
-// @@protoc_insertion_point(message_byte_size_start:agones.dev.sdk.GameServer.Spec.Health)
+
+size_t GameServer_Status_Counter::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:agones.dev.sdk.GameServer.Status.Counter)
   size_t total_size = 0;
 
   if ((_internal_metadata_.have_unknown_fields() &&  ::google::protobuf::internal::GetProto3PreserveUnknownsDefault())) {
@@ -2300,30 +3925,18 @@ size_t GameServer_Spec_Health::ByteSizeLong() const {
       ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
         (::google::protobuf::internal::GetProto3PreserveUnknownsDefault()   ? _internal_metadata_.unknown_fields()   : _internal_metadata_.default_instance()));
   }
-  // bool disabled = 1;
-  if (this->disabled() != 0) {
-    total_size += 1 + 1;
-  }
-
-  // int32 period_seconds = 2;
-  if (this->period_seconds() != 0) {
-    total_size += 1 +
-      ::google::protobuf::internal::WireFormatLite::Int32Size(
-        this->period_seconds());
-  }
-
-  // int32 failure_threshold = 3;
-  if (this->failure_threshold() != 0) {
+  // int64 count = 1;
+  if (this->count() != 0) {
     total_size += 1 +
-      ::google::protobuf::internal::WireFormatLite::Int32Size(
-        this->failure_threshold());
+      ::google::protobuf::internal::WireFormatLite::Int64Size(
+        this->count());
   }
 
-  // int32 initial_delay_seconds = 4;
-  if (this->initial_delay_seconds() != 0) {
+  // int64 capacity = 2;
+  if (this->capacity() != 0) {
     total_size += 1 +
-      ::google::protobuf::internal::WireFormatLite::Int32Size(
-        this->initial_delay_seconds());
+      ::google::protobuf::internal::WireFormatLite::Int64Size(
+        this->capacity());
   }
 
   int cached_size = ::google::protobuf::internal::ToCachedSize(total_size);
@@ -2331,74 +3944,66 @@ size_t GameServer_Spec_Health::ByteSizeLong() const {
   return total_size;
 }
 
-void GameServer_Spec_Health::MergeFrom(const ::google::protobuf::Message& from) {
-// @@protoc_insertion_point(generalized_merge_from_start:agones.dev.sdk.GameServer.Spec.Health)
+void GameServer_Status_Counter::MergeFrom(const ::google::protobuf::Message& from) {
+// @@protoc_insertion_point(generalized_merge_from_start:agones.dev.sdk.GameServer.Status.Counter)
   GOOGLE_DCHECK_NE(&from, this);
-  const GameServer_Spec_Health* source =
-      ::google::protobuf::internal::DynamicCastToGenerated<const GameServer_Spec_Health>(
+  const GameServer_Status_Counter* source =
+      ::google::protobuf::internal::DynamicCastToGenerated<const GameServer_Status_Counter>(
           &from);
   if (source == NULL) {
-  // @@protoc_insertion_point(generalized_merge_from_cast_fail:agones.dev.sdk.GameServer.Spec.Health)
+  // @@protoc_insertion_point(generalized_merge_from_cast_fail:agones.dev.sdk.GameServer.Status.Counter)
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
   } else {
-  // @@protoc_insertion_point(generalized_merge_from_cast_success:agones.dev.sdk.GameServer.Spec.Health)
+  // @@protoc_insertion_point(generalized_merge_from_cast_success:agones.dev.sdk.GameServer.Status.Counter)
     MergeFrom(*source);
   }
 }
 
-void GameServer_Spec_Health::MergeFrom(const GameServer_Spec_Health& from) {
-// @@protoc_insertion_point(class_specific_merge_from_start:agones.dev.sdk.GameServer.Spec.Health)
+void GameServer_Status_Counter::MergeFrom(const GameServer_Status_Counter& from) {
+// @@protoc_insertion_point(class_specific_merge_from_start:agones.dev.sdk.GameServer.Status.Counter)
   GOOGLE_DCHECK_NE(&from, this);
   _internal_metadata_.MergeFrom(from._internal_metadata_);
   ::google::protobuf::uint32 cached_has_bits = 0;
   (void) cached_has_bits;
 
-  if (from.disabled() != 0) {
-    set_disabled(from.disabled());
-  }
-  if (from.period_seconds() != 0) {
-    set_period_seconds(from.period_seconds());
+  if (from.count() != 0) {
+    set_count(from.count());
   }
-  if (from.failure_threshold() != 0) {
-    set_failure_threshold(from.failure_threshold());
-  }
-  if (from.initial_delay_seconds() != 0) {
-    set_initial_delay_seconds(from.initial_delay_seconds());
+  if (from.capacity() != 0) {
+    set_capacity(from.capacity());
   }
 }
 
-void GameServer_Spec_Health::CopyFrom(const ::google::protobuf::Message& from) {
-// @@protoc_insertion_point(generalized_copy_from_start:agones.dev.sdk.GameServer.Spec.Health)
+void GameServer_Status_Counter::CopyFrom(const ::google::protobuf::Message& from) {
+// @@protoc_insertion_point(generalized_copy_from_start:agones.dev.sdk.GameServer.Status.Counter)
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void GameServer_Spec_Health::CopyFrom(const GameServer_Spec_Health& from) {
-// @@protoc_insertion_point(class_specific_copy_from_start:agones.dev.sdk.GameServer.Spec.Health)
+void GameServer_Status_Counter::CopyFrom(const GameServer_Status_Counter& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:agones.dev.sdk.GameServer.Status.Counter)
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool GameServer_Spec_Health::IsInitialized() const {
+bool GameServer_Status_Counter::IsInitialized() const {
   return true;
 }
 
-void GameServer_Spec_Health::Swap(GameServer_Spec_Health* other) {
+void GameServer_Status_Counter::Swap(GameServer_Status_Counter* other) {
   if (other == this) return;
   InternalSwap(other);
 }
-void GameServer_Spec_Health::InternalSwap(GameServer_Spec_Health* other) {
+void GameServer_Status_Counter::InternalSwap(GameServer_Status_Counter* other) {
   using std::swap;
-  swap(disabled_, other->disabled_);
-  swap(period_seconds_, other->period_seconds_);
-  swap(failure_threshold_, other->failure_threshold_);
-  swap(initial_delay_seconds_, other->initial_delay_seconds_);
+  swap(count_, other->count_);
+  swap(capacity_, other->capacity_);
   _internal_metadata_.Swap(&other->_internal_metadata_);
 }
 
-::google::protobuf::Metadata GameServer_Spec_Health::GetMetadata() const {
+::google::protobuf::Metadata GameServer_Status_Counter::GetMetadata() const {
   protobuf_sdk_2eproto::protobuf_AssignDescriptorsOnce();
   return ::protobuf_sdk_2eproto::file_level_metadata[kIndexInFileMessages];
 }
@@ -2406,89 +4011,101 @@ void GameServer_Spec_Health::InternalSwap(GameServer_Spec_Health* other) {
 
 // ===================================================================
 
-void GameServer_Spec::InitAsDefaultInstance() {
-  ::agones::dev::sdk::_GameServer_Spec_default_instance_._instance.get_mutable()->health_ = const_cast< ::agones::dev::sdk::GameServer_Spec_Health*>(
-      ::agones::dev::sdk::GameServer_Spec_Health::internal_default_instance());
+void GameServer_Status_List::InitAsDefaultInstance() {
 }
 #if !defined(_MSC_VER) || _MSC_VER >= 1900
-const int GameServer_Spec::kHealthFieldNumber;
+const int GameServer_Status_List::kCapacityFieldNumber;
+const int GameServer_Status_List::kValuesFieldNumber;
 #endif  // !defined(_MSC_VER) || _MSC_VER >= 1900
 
-GameServer_Spec::GameServer_Spec()
+GameServer_Status_List::GameServer_Status_List()
   : ::google::protobuf::Message(), _internal_metadata_(NULL) {
   ::google::protobuf::internal::InitSCC(
-      &protobuf_sdk_2eproto::scc_info_GameServer_Spec.base);
+      &protobuf_sdk_2eproto::scc_info_GameServer_Status_List.base);
   SharedCtor();
-  // @@protoc_insertion_point(constructor:agones.dev.sdk.GameServer.Spec)
+  // @@protoc_insertion_point(constructor:agones.dev.sdk.GameServer.Status.List)
 }
-GameServer_Spec::GameServer_Spec(const GameServer_Spec& from)
+GameServer_Status_List::GameServer_Status_List(const GameServer_Status_List& from)
   : ::google::protobuf::Message(),
-      _internal_metadata_(NULL) {
+      _internal_metadata_(NULL),
+      values_(from.values_) {
   _internal_metadata_.MergeFrom(from._internal_metadata_);
-  if (from.has_health()) {
-    health_ = new ::agones::dev::sdk::GameServer_Spec_Health(*from.health_);
-  } else {
-    health_ = NULL;
-  }
-  // @@protoc_insertion_point(copy_constructor:agones.dev.sdk.GameServer.Spec)
+  capacity_ = from.capacity_;
+  // @@protoc_insertion_point(copy_constructor:agones.dev.sdk.GameServer.Status.List)
 }
 
-void GameServer_Spec::SharedCtor() {
-  health_ = NULL;
+void GameServer_Status_List::SharedCtor() {
+  capacity_ = GOOGLE_LONGLONG(0);
 }
 
-GameServer_Spec::~GameServer_Spec() {
-  // @@protoc_insertion_point(destructor:agones.dev.sdk.GameServer.Spec)
+GameServer_Status_List::~GameServer_Status_List() {
+  // @@protoc_insertion_point(destructor:agones.dev.sdk.GameServer.Status.List)
   SharedDtor();
 }
 
-void GameServer_Spec::SharedDtor() {
-  if (this != internal_default_instance()) delete health_;
+void GameServer_Status_List::SharedDtor() {
 }
 
-void GameServer_Spec::SetCachedSize(int size) const {
+void GameServer_Status_List::SetCachedSize(int size) const {
   _cached_size_.Set(size);
 }
-const ::google::protobuf::Descriptor* GameServer_Spec::descriptor() {
+const ::google::protobuf::Descriptor* GameServer_Status_List::descriptor() {
   ::protobuf_sdk_2eproto::protobuf_AssignDescriptorsOnce();
   return ::protobuf_sdk_2eproto::file_level_metadata[kIndexInFileMessages].descriptor;
 }
 
-const GameServer_Spec& GameServer_Spec::default_instance() {
-  ::google::protobuf::internal::InitSCC(&protobuf_sdk_2eproto::scc_info_GameServer_Spec.base);
+const GameServer_Status_List& GameServer_Status_List::default_instance() {
+  ::google::protobuf::internal::InitSCC(&protobuf_sdk_2eproto::scc_info_GameServer_Status_List.base);
   return *internal_default_instance();
 }
 
 
-void GameServer_Spec::Clear() {
-// @@protoc_insertion_point(message_clear_start:agones.dev.sdk.GameServer.Spec)
+void GameServer_Status_List::Clear() {
+// @@protoc_insertion_point(message_clear_start:agones.dev.sdk.GameServer.Status.List)
   ::google::protobuf::uint32 cached_has_bits = 0;
   // Prevent compiler warnings about cached_has_bits being unused
   (void) cached_has_bits;
 
-  if (GetArenaNoVirtual() == NULL && health_ != NULL) {
-    delete health_;
-  }
-  health_ = NULL;
+  values_.Clear();
+  capacity_ = GOOGLE_LONGLONG(0);
   _internal_metadata_.Clear();
 }
 
-bool GameServer_Spec::MergePartialFromCodedStream(
+bool GameServer_Status_List::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!GOOGLE_PREDICT_TRUE(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:agones.dev.sdk.GameServer.Spec)
+  // @@protoc_insertion_point(parse_start:agones.dev.sdk.GameServer.Status.List)
   for (;;) {
     ::std::pair<::google::protobuf::uint32, bool> p = input->ReadTagWithCutoffNoLastTag(127u);
     tag = p.first;
     if (!p.second) goto handle_unusual;
     switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
-      // .agones.dev.sdk.GameServer.Spec.Health health = 1;
+      // int64 capacity = 1;
       case 1: {
         if (static_cast< ::google::protobuf::uint8>(tag) ==
-            static_cast< ::google::protobuf::uint8>(10u /* 10 & 0xFF */)) {
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessage(
-               input, mutable_health()));
+            static_cast< ::google::protobuf::uint8>(8u /* 8 & 0xFF */)) {
+
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &capacity_)));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      // repeated string values = 2;
+      case 2: {
+        if (static_cast< ::google::protobuf::uint8>(tag) ==
+            static_cast< ::google::protobuf::uint8>(18u /* 18 & 0xFF */)) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadString(
+                input, this->add_values()));
+          DO_(::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+            this->values(this->values_size() - 1).data(),
+            static_cast<int>(this->values(this->values_size() - 1).length()),
+            ::google::protobuf::internal::WireFormatLite::PARSE,
+            "agones.dev.sdk.GameServer.Status.List.values"));
         } else {
           goto handle_unusual;
         }
@@ -2507,57 +4124,74 @@ bool GameServer_Spec::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:agones.dev.sdk.GameServer.Spec)
+  // @@protoc_insertion_point(parse_success:agones.dev.sdk.GameServer.Status.List)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:agones.dev.sdk.GameServer.Spec)
+  // @@protoc_insertion_point(parse_failure:agones.dev.sdk.GameServer.Status.List)
   return false;
 #undef DO_
 }
 
-void GameServer_Spec::SerializeWithCachedSizes(
+void GameServer_Status_List::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:agones.dev.sdk.GameServer.Spec)
+  // @@protoc_insertion_point(serialize_start:agones.dev.sdk.GameServer.Status.List)
   ::google::protobuf::uint32 cached_has_bits = 0;
   (void) cached_has_bits;
 
-  // .agones.dev.sdk.GameServer.Spec.Health health = 1;
-  if (this->has_health()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      1, this->_internal_health(), output);
+  // int64 capacity = 1;
+  if (this->capacity() != 0) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(1, this->capacity(), output);
+  }
+
+  // repeated string values = 2;
+  for (int i = 0, n = this->values_size(); i < n; i++) {
+    ::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+      this->values(i).data(), static_cast<int>(this->values(i).length()),
+      ::google::protobuf::internal::WireFormatLite::SERIALIZE,
+      "agones.dev.sdk.GameServer.Status.List.values");
+    ::google::protobuf::internal::WireFormatLite::WriteString(
+      2, this->values(i), output);
   }
 
   if ((_internal_metadata_.have_unknown_fields() &&  ::google::protobuf::internal::GetProto3PreserveUnknownsDefault())) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         (::google::protobuf::internal::GetProto3PreserveUnknownsDefault()   ? _internal_metadata_.unknown_fields()   : _internal_metadata_.default_instance()), output);
   }
-  // @@protoc_insertion_point(serialize_end:agones.dev.sdk.GameServer.Spec)
+  // @@protoc_insertion_point(serialize_end:agones.dev.sdk.GameServer.Status.List)
 }
 
-::google::protobuf::uint8* GameServer_Spec::InternalSerializeWithCachedSizesToArray(
+::google::protobuf::uint8* GameServer_Status_List::InternalSerializeWithCachedSizesToArray(
     bool deterministic, ::google::protobuf::uint8* target) const {
   (void)deterministic; // Unused
-  // @@protoc_insertion_point(serialize_to_array_start:agones.dev.sdk.GameServer.Spec)
+  // @@protoc_insertion_point(serialize_to_array_start:agones.dev.sdk.GameServer.Status.List)
   ::google::protobuf::uint32 cached_has_bits = 0;
   (void) cached_has_bits;
 
-  // .agones.dev.sdk.GameServer.Spec.Health health = 1;
-  if (this->has_health()) {
+  // int64 capacity = 1;
+  if (this->capacity() != 0) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(1, this->capacity(), target);
+  }
+
+  // repeated string values = 2;
+  for (int i = 0, n = this->values_size(); i < n; i++) {
+    ::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+      this->values(i).data(), static_cast<int>(this->values(i).length()),
+      ::google::protobuf::internal::WireFormatLite::SERIALIZE,
+      "agones.dev.sdk.GameServer.Status.List.values");
     target = ::google::protobuf::internal::WireFormatLite::
-      InternalWriteMessageToArray(
-        1, this->_internal_health(), deterministic, target);
+      WriteStringToArray(2, this->values(i), target);
   }
 
   if ((_internal_metadata_.have_unknown_fields() &&  ::google::protobuf::internal::GetProto3PreserveUnknownsDefault())) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         (::google::protobuf::internal::GetProto3PreserveUnknownsDefault()   ? _internal_metadata_.unknown_fields()   : _internal_metadata_.default_instance()), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:agones.dev.sdk.GameServer.Spec)
+  // @@protoc_insertion_point(serialize_to_array_end:agones.dev.sdk.GameServer.Status.List)
   return target;
 }
 
-size_t GameServer_Spec::ByteSizeLong() const {
-// @@protoc_insertion_point(message_byte_size_start:agones.dev.sdk.GameServer.Spec)
+size_t GameServer_Status_List::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:agones.dev.sdk.GameServer.Status.List)
   size_t total_size = 0;
 
   if ((_internal_metadata_.have_unknown_fields() &&  ::google::protobuf::internal::GetProto3PreserveUnknownsDefault())) {
@@ -2565,11 +4199,19 @@ size_t GameServer_Spec::ByteSizeLong() const {
       ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
         (::google::protobuf::internal::GetProto3PreserveUnknownsDefault()   ? _internal_metadata_.unknown_fields()   : _internal_metadata_.default_instance()));
   }
-  // .agones.dev.sdk.GameServer.Spec.Health health = 1;
-  if (this->has_health()) {
+  // repeated string values = 2;
+  total_size += 1 *
+      ::google::protobuf::internal::FromIntSize(this->values_size());
+  for (int i = 0, n = this->values_size(); i < n; i++) {
+    total_size += ::google::protobuf::internal::WireFormatLite::StringSize(
+      this->values(i));
+  }
+
+  // int64 capacity = 1;
+  if (this->capacity() != 0) {
     total_size += 1 +
-      ::google::protobuf::internal::WireFormatLite::MessageSize(
-        *health_);
+      ::google::protobuf::internal::WireFormatLite::Int64Size(
+        this->capacity());
   }
 
   int cached_size = ::google::protobuf::internal::ToCachedSize(total_size);
@@ -2577,62 +4219,64 @@ size_t GameServer_Spec::ByteSizeLong() const {
   return total_size;
 }
 
-void GameServer_Spec::MergeFrom(const ::google::protobuf::Message& from) {
-// @@protoc_insertion_point(generalized_merge_from_start:agones.dev.sdk.GameServer.Spec)
+void GameServer_Status_List::MergeFrom(const ::google::protobuf::Message& from) {
+// @@protoc_insertion_point(generalized_merge_from_start:agones.dev.sdk.GameServer.Status.List)
   GOOGLE_DCHECK_NE(&from, this);
-  const GameServer_Spec* source =
-      ::google::protobuf::internal::DynamicCastToGenerated<const GameServer_Spec>(
+  const GameServer_Status_List* source =
+      ::google::protobuf::internal::DynamicCastToGenerated<const GameServer_Status_List>(
           &from);
   if (source == NULL) {
-  // @@protoc_insertion_point(generalized_merge_from_cast_fail:agones.dev.sdk.GameServer.Spec)
+  // @@protoc_insertion_point(generalized_merge_from_cast_fail:agones.dev.sdk.GameServer.Status.List)
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
   } else {
-  // @@protoc_insertion_point(generalized_merge_from_cast_success:agones.dev.sdk.GameServer.Spec)
+  // @@protoc_insertion_point(generalized_merge_from_cast_success:agones.dev.sdk.GameServer.Status.List)
     MergeFrom(*source);
   }
 }
 
-void GameServer_Spec::MergeFrom(const GameServer_Spec& from) {
-// @@protoc_insertion_point(class_specific_merge_from_start:agones.dev.sdk.GameServer.Spec)
+void GameServer_Status_List::MergeFrom(const GameServer_Status_List& from) {
+// @@protoc_insertion_point(class_specific_merge_from_start:agones.dev.sdk.GameServer.Status.List)
   GOOGLE_DCHECK_NE(&from, this);
   _internal_metadata_.MergeFrom(from._internal_metadata_);
   ::google::protobuf::uint32 cached_has_bits = 0;
   (void) cached_has_bits;
 
-  if (from.has_health()) {
-    mutable_health()->::agones::dev::sdk::GameServer_Spec_Health::MergeFrom(from.health());
+  values_.MergeFrom(from.values_);
+  if (from.capacity() != 0) {
+    set_capacity(from.capacity());
   }
 }
 
-void GameServer_Spec::CopyFrom(const ::google::protobuf::Message& from) {
-// @@protoc_insertion_point(generalized_copy_from_start:agones.dev.sdk.GameServer.Spec)
+void GameServer_Status_List::CopyFrom(const ::google::protobuf::Message& from) {
+// @@protoc_insertion_point(generalized_copy_from_start:agones.dev.sdk.GameServer.Status.List)
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void GameServer_Spec::CopyFrom(const GameServer_Spec& from) {
-// @@protoc_insertion_point(class_specific_copy_from_start:agones.dev.sdk.GameServer.Spec)
+void GameServer_Status_List::CopyFrom(const GameServer_Status_List& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:agones.dev.sdk.GameServer.Status.List)
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool GameServer_Spec::IsInitialized() const {
+bool GameServer_Status_List::IsInitialized() const {
   return true;
 }
 
-void GameServer_Spec::Swap(GameServer_Spec* other) {
+void GameServer_Status_List::Swap(GameServer_Status_List* other) {
   if (other == this) return;
   InternalSwap(other);
 }
-void GameServer_Spec::InternalSwap(GameServer_Spec* other) {
+void GameServer_Status_List::InternalSwap(GameServer_Status_List* other) {
   using std::swap;
-  swap(health_, other->health_);
+  values_.InternalSwap(CastToBase(&other->values_));
+  swap(capacity_, other->capacity_);
   _internal_metadata_.Swap(&other->_internal_metadata_);
 }
 
-::google::protobuf::Metadata GameServer_Spec::GetMetadata() const {
+::google::protobuf::Metadata GameServer_Status_List::GetMetadata() const {
   protobuf_sdk_2eproto::protobuf_AssignDescriptorsOnce();
   return ::protobuf_sdk_2eproto::file_level_metadata[kIndexInFileMessages];
 }
@@ -2640,105 +4284,129 @@ void GameServer_Spec::InternalSwap(GameServer_Spec* other) {
 
 // ===================================================================
 
-void GameServer_Status_Port::InitAsDefaultInstance() {
+void GameServer_Status_Disruption::InitAsDefaultInstance() {
 }
 #if !defined(_MSC_VER) || _MSC_VER >= 1900
-const int GameServer_Status_Port::kNameFieldNumber;
-const int GameServer_Status_Port::kPortFieldNumber;
+const int GameServer_Status_Disruption::kReasonFieldNumber;
+const int GameServer_Status_Disruption::kMessageFieldNumber;
+const int GameServer_Status_Disruption::kSinceFieldNumber;
 #endif  // !defined(_MSC_VER) || _MSC_VER >= 1900
 
-GameServer_Status_Port::GameServer_Status_Port()
+GameServer_Status_Disruption::GameServer_Status_Disruption()
   : ::google::protobuf::Message(), _internal_metadata_(NULL) {
   ::google::protobuf::internal::InitSCC(
-      &protobuf_sdk_2eproto::scc_info_GameServer_Status_Port.base);
+      &protobuf_sdk_2eproto::scc_info_GameServer_Status_Disruption.base);
   SharedCtor();
-  // @@protoc_insertion_point(constructor:agones.dev.sdk.GameServer.Status.Port)
+  // @@protoc_insertion_point(constructor:agones.dev.sdk.GameServer.Status.Disruption)
 }
-GameServer_Status_Port::GameServer_Status_Port(const GameServer_Status_Port& from)
+GameServer_Status_Disruption::GameServer_Status_Disruption(const GameServer_Status_Disruption& from)
   : ::google::protobuf::Message(),
       _internal_metadata_(NULL) {
   _internal_metadata_.MergeFrom(from._internal_metadata_);
-  name_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-  if (from.name().size() > 0) {
-    name_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.name_);
+  reason_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  if (from.reason().size() > 0) {
+    reason_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.reason_);
   }
-  port_ = from.port_;
-  // @@protoc_insertion_point(copy_constructor:agones.dev.sdk.GameServer.Status.Port)
+  message_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  if (from.message().size() > 0) {
+    message_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.message_);
+  }
+  since_ = from.since_;
+  // @@protoc_insertion_point(copy_constructor:agones.dev.sdk.GameServer.Status.Disruption)
 }
 
-void GameServer_Status_Port::SharedCtor() {
-  name_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-  port_ = 0;
+void GameServer_Status_Disruption::SharedCtor() {
+  reason_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  message_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  since_ = GOOGLE_LONGLONG(0);
 }
 
-GameServer_Status_Port::~GameServer_Status_Port() {
-  // @@protoc_insertion_point(destructor:agones.dev.sdk.GameServer.Status.Port)
+GameServer_Status_Disruption::~GameServer_Status_Disruption() {
+  // @@protoc_insertion_point(destructor:agones.dev.sdk.GameServer.Status.Disruption)
   SharedDtor();
 }
 
-void GameServer_Status_Port::SharedDtor() {
-  name_.DestroyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+void GameServer_Status_Disruption::SharedDtor() {
+  reason_.DestroyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  message_.DestroyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
 }
 
-void GameServer_Status_Port::SetCachedSize(int size) const {
+void GameServer_Status_Disruption::SetCachedSize(int size) const {
   _cached_size_.Set(size);
 }
-const ::google::protobuf::Descriptor* GameServer_Status_Port::descriptor() {
+const ::google::protobuf::Descriptor* GameServer_Status_Disruption::descriptor() {
   ::protobuf_sdk_2eproto::protobuf_AssignDescriptorsOnce();
   return ::protobuf_sdk_2eproto::file_level_metadata[kIndexInFileMessages].descriptor;
 }
 
-const GameServer_Status_Port& GameServer_Status_Port::default_instance() {
-  ::google::protobuf::internal::InitSCC(&protobuf_sdk_2eproto::scc_info_GameServer_Status_Port.base);
+const GameServer_Status_Disruption& GameServer_Status_Disruption::default_instance() {
+  ::google::protobuf::internal::InitSCC(&protobuf_sdk_2eproto::scc_info_GameServer_Status_Disruption.base);
   return *internal_default_instance();
 }
 
 
-void GameServer_Status_Port::Clear() {
-// @@protoc_insertion_point(message_clear_start:agones.dev.sdk.GameServer.Status.Port)
+void GameServer_Status_Disruption::Clear() {
+// @@protoc_insertion_point(message_clear_start:agones.dev.sdk.GameServer.Status.Disruption)
   ::google::protobuf::uint32 cached_has_bits = 0;
   // Prevent compiler warnings about cached_has_bits being unused
   (void) cached_has_bits;
 
-  name_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-  port_ = 0;
+  reason_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  message_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  since_ = GOOGLE_LONGLONG(0);
   _internal_metadata_.Clear();
 }
 
-bool GameServer_Status_Port::MergePartialFromCodedStream(
+bool GameServer_Status_Disruption::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!GOOGLE_PREDICT_TRUE(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:agones.dev.sdk.GameServer.Status.Port)
+  // @@protoc_insertion_point(parse_start:agones.dev.sdk.GameServer.Status.Disruption)
   for (;;) {
     ::std::pair<::google::protobuf::uint32, bool> p = input->ReadTagWithCutoffNoLastTag(127u);
     tag = p.first;
     if (!p.second) goto handle_unusual;
     switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
-      // string name = 1;
+      // string reason = 1;
       case 1: {
         if (static_cast< ::google::protobuf::uint8>(tag) ==
             static_cast< ::google::protobuf::uint8>(10u /* 10 & 0xFF */)) {
           DO_(::google::protobuf::internal::WireFormatLite::ReadString(
-                input, this->mutable_name()));
+                input, this->mutable_reason()));
           DO_(::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
-            this->name().data(), static_cast<int>(this->name().length()),
+            this->reason().data(), static_cast<int>(this->reason().length()),
             ::google::protobuf::internal::WireFormatLite::PARSE,
-            "agones.dev.sdk.GameServer.Status.Port.name"));
+            "agones.dev.sdk.GameServer.Status.Disruption.reason"));
         } else {
           goto handle_unusual;
         }
         break;
       }
 
-      // int32 port = 2;
+      // string message = 2;
       case 2: {
         if (static_cast< ::google::protobuf::uint8>(tag) ==
-            static_cast< ::google::protobuf::uint8>(16u /* 16 & 0xFF */)) {
+            static_cast< ::google::protobuf::uint8>(18u /* 18 & 0xFF */)) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadString(
+                input, this->mutable_message()));
+          DO_(::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+            this->message().data(), static_cast<int>(this->message().length()),
+            ::google::protobuf::internal::WireFormatLite::PARSE,
+            "agones.dev.sdk.GameServer.Status.Disruption.message"));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      // int64 since = 3;
+      case 3: {
+        if (static_cast< ::google::protobuf::uint8>(tag) ==
+            static_cast< ::google::protobuf::uint8>(24u /* 24 & 0xFF */)) {
 
           DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
-                   ::google::protobuf::int32, ::google::protobuf::internal::WireFormatLite::TYPE_INT32>(
-                 input, &port_)));
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &since_)));
         } else {
           goto handle_unusual;
         }
@@ -2757,75 +4425,96 @@ bool GameServer_Status_Port::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:agones.dev.sdk.GameServer.Status.Port)
+  // @@protoc_insertion_point(parse_success:agones.dev.sdk.GameServer.Status.Disruption)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:agones.dev.sdk.GameServer.Status.Port)
+  // @@protoc_insertion_point(parse_failure:agones.dev.sdk.GameServer.Status.Disruption)
   return false;
 #undef DO_
 }
 
-void GameServer_Status_Port::SerializeWithCachedSizes(
+void GameServer_Status_Disruption::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:agones.dev.sdk.GameServer.Status.Port)
+  // @@protoc_insertion_point(serialize_start:agones.dev.sdk.GameServer.Status.Disruption)
   ::google::protobuf::uint32 cached_has_bits = 0;
   (void) cached_has_bits;
 
-  // string name = 1;
-  if (this->name().size() > 0) {
+  // string reason = 1;
+  if (this->reason().size() > 0) {
     ::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
-      this->name().data(), static_cast<int>(this->name().length()),
+      this->reason().data(), static_cast<int>(this->reason().length()),
       ::google::protobuf::internal::WireFormatLite::SERIALIZE,
-      "agones.dev.sdk.GameServer.Status.Port.name");
+      "agones.dev.sdk.GameServer.Status.Disruption.reason");
     ::google::protobuf::internal::WireFormatLite::WriteStringMaybeAliased(
-      1, this->name(), output);
+      1, this->reason(), output);
   }
 
-  // int32 port = 2;
-  if (this->port() != 0) {
-    ::google::protobuf::internal::WireFormatLite::WriteInt32(2, this->port(), output);
+  // string message = 2;
+  if (this->message().size() > 0) {
+    ::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+      this->message().data(), static_cast<int>(this->message().length()),
+      ::google::protobuf::internal::WireFormatLite::SERIALIZE,
+      "agones.dev.sdk.GameServer.Status.Disruption.message");
+    ::google::protobuf::internal::WireFormatLite::WriteStringMaybeAliased(
+      2, this->message(), output);
+  }
+
+  // int64 since = 3;
+  if (this->since() != 0) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(3, this->since(), output);
   }
 
   if ((_internal_metadata_.have_unknown_fields() &&  ::google::protobuf::internal::GetProto3PreserveUnknownsDefault())) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         (::google::protobuf::internal::GetProto3PreserveUnknownsDefault()   ? _internal_metadata_.unknown_fields()   : _internal_metadata_.default_instance()), output);
   }
-  // @@protoc_insertion_point(serialize_end:agones.dev.sdk.GameServer.Status.Port)
+  // @@protoc_insertion_point(serialize_end:agones.dev.sdk.GameServer.Status.Disruption)
 }
 
-::google::protobuf::uint8* GameServer_Status_Port::InternalSerializeWithCachedSizesToArray(
+::google::protobuf::uint8* GameServer_Status_Disruption::InternalSerializeWithCachedSizesToArray(
     bool deterministic, ::google::protobuf::uint8* target) const {
   (void)deterministic; // Unused
-  // @@protoc_insertion_point(serialize_to_array_start:agones.dev.sdk.GameServer.Status.Port)
+  // @@protoc_insertion_point(serialize_to_array_start:agones.dev.sdk.GameServer.Status.Disruption)
   ::google::protobuf::uint32 cached_has_bits = 0;
   (void) cached_has_bits;
 
-  // string name = 1;
-  if (this->name().size() > 0) {
+  // string reason = 1;
+  if (this->reason().size() > 0) {
     ::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
-      this->name().data(), static_cast<int>(this->name().length()),
+      this->reason().data(), static_cast<int>(this->reason().length()),
       ::google::protobuf::internal::WireFormatLite::SERIALIZE,
-      "agones.dev.sdk.GameServer.Status.Port.name");
+      "agones.dev.sdk.GameServer.Status.Disruption.reason");
     target =
       ::google::protobuf::internal::WireFormatLite::WriteStringToArray(
-        1, this->name(), target);
+        1, this->reason(), target);
   }
 
-  // int32 port = 2;
-  if (this->port() != 0) {
-    target = ::google::protobuf::internal::WireFormatLite::WriteInt32ToArray(2, this->port(), target);
+  // string message = 2;
+  if (this->message().size() > 0) {
+    ::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+      this->message().data(), static_cast<int>(this->message().length()),
+      ::google::protobuf::internal::WireFormatLite::SERIALIZE,
+      "agones.dev.sdk.GameServer.Status.Disruption.message");
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteStringToArray(
+        2, this->message(), target);
+  }
+
+  // int64 since = 3;
+  if (this->since() != 0) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(3, this->since(), target);
   }
 
   if ((_internal_metadata_.have_unknown_fields() &&  ::google::protobuf::internal::GetProto3PreserveUnknownsDefault())) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         (::google::protobuf::internal::GetProto3PreserveUnknownsDefault()   ? _internal_metadata_.unknown_fields()   : _internal_metadata_.default_instance()), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:agones.dev.sdk.GameServer.Status.Port)
+  // @@protoc_insertion_point(serialize_to_array_end:agones.dev.sdk.GameServer.Status.Disruption)
   return target;
 }
 
-size_t GameServer_Status_Port::ByteSizeLong() const {
-// @@protoc_insertion_point(message_byte_size_start:agones.dev.sdk.GameServer.Status.Port)
+size_t GameServer_Status_Disruption::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:agones.dev.sdk.GameServer.Status.Disruption)
   size_t total_size = 0;
 
   if ((_internal_metadata_.have_unknown_fields() &&  ::google::protobuf::internal::GetProto3PreserveUnknownsDefault())) {
@@ -2833,18 +4522,25 @@ size_t GameServer_Status_Port::ByteSizeLong() const {
       ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
         (::google::protobuf::internal::GetProto3PreserveUnknownsDefault()   ? _internal_metadata_.unknown_fields()   : _internal_metadata_.default_instance()));
   }
-  // string name = 1;
-  if (this->name().size() > 0) {
+  // string reason = 1;
+  if (this->reason().size() > 0) {
     total_size += 1 +
       ::google::protobuf::internal::WireFormatLite::StringSize(
-        this->name());
+        this->reason());
+  }
+
+  // string message = 2;
+  if (this->message().size() > 0) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::StringSize(
+        this->message());
   }
 
-  // int32 port = 2;
-  if (this->port() != 0) {
+  // int64 since = 3;
+  if (this->since() != 0) {
     total_size += 1 +
-      ::google::protobuf::internal::WireFormatLite::Int32Size(
-        this->port());
+      ::google::protobuf::internal::WireFormatLite::Int64Size(
+        this->since());
   }
 
   int cached_size = ::google::protobuf::internal::ToCachedSize(total_size);
@@ -2852,81 +4548,126 @@ size_t GameServer_Status_Port::ByteSizeLong() const {
   return total_size;
 }
 
-void GameServer_Status_Port::MergeFrom(const ::google::protobuf::Message& from) {
-// @@protoc_insertion_point(generalized_merge_from_start:agones.dev.sdk.GameServer.Status.Port)
+void GameServer_Status_Disruption::MergeFrom(const ::google::protobuf::Message& from) {
+// @@protoc_insertion_point(generalized_merge_from_start:agones.dev.sdk.GameServer.Status.Disruption)
   GOOGLE_DCHECK_NE(&from, this);
-  const GameServer_Status_Port* source =
-      ::google::protobuf::internal::DynamicCastToGenerated<const GameServer_Status_Port>(
+  const GameServer_Status_Disruption* source =
+      ::google::protobuf::internal::DynamicCastToGenerated<const GameServer_Status_Disruption>(
           &from);
   if (source == NULL) {
-  // @@protoc_insertion_point(generalized_merge_from_cast_fail:agones.dev.sdk.GameServer.Status.Port)
+  // @@protoc_insertion_point(generalized_merge_from_cast_fail:agones.dev.sdk.GameServer.Status.Disruption)
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
   } else {
-  // @@protoc_insertion_point(generalized_merge_from_cast_success:agones.dev.sdk.GameServer.Status.Port)
+  // @@protoc_insertion_point(generalized_merge_from_cast_success:agones.dev.sdk.GameServer.Status.Disruption)
     MergeFrom(*source);
   }
 }
 
-void GameServer_Status_Port::MergeFrom(const GameServer_Status_Port& from) {
-// @@protoc_insertion_point(class_specific_merge_from_start:agones.dev.sdk.GameServer.Status.Port)
+void GameServer_Status_Disruption::MergeFrom(const GameServer_Status_Disruption& from) {
+// @@protoc_insertion_point(class_specific_merge_from_start:agones.dev.sdk.GameServer.Status.Disruption)
   GOOGLE_DCHECK_NE(&from, this);
   _internal_metadata_.MergeFrom(from._internal_metadata_);
   ::google::protobuf::uint32 cached_has_bits = 0;
   (void) cached_has_bits;
 
-  if (from.name().size() > 0) {
+  if (from.reason().size() > 0) {
 
-    name_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.name_);
+    reason_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.reason_);
   }
-  if (from.port() != 0) {
-    set_port(from.port());
+  if (from.message().size() > 0) {
+
+    message_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.message_);
+  }
+  if (from.since() != 0) {
+    set_since(from.since());
   }
 }
 
-void GameServer_Status_Port::CopyFrom(const ::google::protobuf::Message& from) {
-// @@protoc_insertion_point(generalized_copy_from_start:agones.dev.sdk.GameServer.Status.Port)
+void GameServer_Status_Disruption::CopyFrom(const ::google::protobuf::Message& from) {
+// @@protoc_insertion_point(generalized_copy_from_start:agones.dev.sdk.GameServer.Status.Disruption)
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void GameServer_Status_Port::CopyFrom(const GameServer_Status_Port& from) {
-// @@protoc_insertion_point(class_specific_copy_from_start:agones.dev.sdk.GameServer.Status.Port)
+void GameServer_Status_Disruption::CopyFrom(const GameServer_Status_Disruption& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:agones.dev.sdk.GameServer.Status.Disruption)
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool GameServer_Status_Port::IsInitialized() const {
+bool GameServer_Status_Disruption::IsInitialized() const {
   return true;
 }
 
-void GameServer_Status_Port::Swap(GameServer_Status_Port* other) {
+void GameServer_Status_Disruption::Swap(GameServer_Status_Disruption* other) {
   if (other == this) return;
   InternalSwap(other);
 }
-void GameServer_Status_Port::InternalSwap(GameServer_Status_Port* other) {
+void GameServer_Status_Disruption::InternalSwap(GameServer_Status_Disruption* other) {
   using std::swap;
-  name_.Swap(&other->name_, &::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+  reason_.Swap(&other->reason_, &::google::protobuf::internal::GetEmptyStringAlreadyInited(),
     GetArenaNoVirtual());
-  swap(port_, other->port_);
+  message_.Swap(&other->message_, &::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+    GetArenaNoVirtual());
+  swap(since_, other->since_);
   _internal_metadata_.Swap(&other->_internal_metadata_);
 }
 
-::google::protobuf::Metadata GameServer_Status_Port::GetMetadata() const {
+::google::protobuf::Metadata GameServer_Status_Disruption::GetMetadata() const {
   protobuf_sdk_2eproto::protobuf_AssignDescriptorsOnce();
   return ::protobuf_sdk_2eproto::file_level_metadata[kIndexInFileMessages];
 }
 
 
+// ===================================================================
+
+GameServer_Status_CountersEntry_DoNotUse::GameServer_Status_CountersEntry_DoNotUse() {}
+GameServer_Status_CountersEntry_DoNotUse::GameServer_Status_CountersEntry_DoNotUse(::google::protobuf::Arena* arena) : SuperType(arena) {}
+void GameServer_Status_CountersEntry_DoNotUse::MergeFrom(const GameServer_Status_CountersEntry_DoNotUse& other) {
+  MergeFromInternal(other);
+}
+::google::protobuf::Metadata GameServer_Status_CountersEntry_DoNotUse::GetMetadata() const {
+  ::protobuf_sdk_2eproto::protobuf_AssignDescriptorsOnce();
+  return ::protobuf_sdk_2eproto::file_level_metadata[14];
+}
+void GameServer_Status_CountersEntry_DoNotUse::MergeFrom(
+    const ::google::protobuf::Message& other) {
+  ::google::protobuf::Message::MergeFrom(other);
+}
+
+
+// ===================================================================
+
+GameServer_Status_ListsEntry_DoNotUse::GameServer_Status_ListsEntry_DoNotUse() {}
+GameServer_Status_ListsEntry_DoNotUse::GameServer_Status_ListsEntry_DoNotUse(::google::protobuf::Arena* arena) : SuperType(arena) {}
+void GameServer_Status_ListsEntry_DoNotUse::MergeFrom(const GameServer_Status_ListsEntry_DoNotUse& other) {
+  MergeFromInternal(other);
+}
+::google::protobuf::Metadata GameServer_Status_ListsEntry_DoNotUse::GetMetadata() const {
+  ::protobuf_sdk_2eproto::protobuf_AssignDescriptorsOnce();
+  return ::protobuf_sdk_2eproto::file_level_metadata[15];
+}
+void GameServer_Status_ListsEntry_DoNotUse::MergeFrom(
+    const ::google::protobuf::Message& other) {
+  ::google::protobuf::Message::MergeFrom(other);
+}
+
+
 // ===================================================================
 
 void GameServer_Status::InitAsDefaultInstance() {
+  ::agones::dev::sdk::_GameServer_Status_default_instance_._instance.get_mutable()->disruption_ = const_cast< ::agones::dev::sdk::GameServer_Status_Disruption*>(
+      ::agones::dev::sdk::GameServer_Status_Disruption::internal_default_instance());
 }
 #if !defined(_MSC_VER) || _MSC_VER >= 1900
 const int GameServer_Status::kStateFieldNumber;
 const int GameServer_Status::kAddressFieldNumber;
 const int GameServer_Status::kPortsFieldNumber;
+const int GameServer_Status::kCountersFieldNumber;
+const int GameServer_Status::kListsFieldNumber;
+const int GameServer_Status::kDisruptionFieldNumber;
 #endif  // !defined(_MSC_VER) || _MSC_VER >= 1900
 
 GameServer_Status::GameServer_Status()
@@ -2941,6 +4682,8 @@ GameServer_Status::GameServer_Status(const GameServer_Status& from)
       _internal_metadata_(NULL),
       ports_(from.ports_) {
   _internal_metadata_.MergeFrom(from._internal_metadata_);
+  counters_.MergeFrom(from.counters_);
+  lists_.MergeFrom(from.lists_);
   state_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
   if (from.state().size() > 0) {
     state_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.state_);
@@ -2949,12 +4692,18 @@ GameServer_Status::GameServer_Status(const GameServer_Status& from)
   if (from.address().size() > 0) {
     address_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.address_);
   }
+  if (from.has_disruption()) {
+    disruption_ = new ::agones::dev::sdk::GameServer_Status_Disruption(*from.disruption_);
+  } else {
+    disruption_ = NULL;
+  }
   // @@protoc_insertion_point(copy_constructor:agones.dev.sdk.GameServer.Status)
 }
 
 void GameServer_Status::SharedCtor() {
   state_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
   address_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  disruption_ = NULL;
 }
 
 GameServer_Status::~GameServer_Status() {
@@ -2965,6 +4714,7 @@ GameServer_Status::~GameServer_Status() {
 void GameServer_Status::SharedDtor() {
   state_.DestroyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
   address_.DestroyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  if (this != internal_default_instance()) delete disruption_;
 }
 
 void GameServer_Status::SetCachedSize(int size) const {
@@ -2988,8 +4738,14 @@ void GameServer_Status::Clear() {
   (void) cached_has_bits;
 
   ports_.Clear();
+  counters_.Clear();
+  lists_.Clear();
   state_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
   address_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  if (GetArenaNoVirtual() == NULL && disruption_ != NULL) {
+    delete disruption_;
+  }
+  disruption_ = NULL;
   _internal_metadata_.Clear();
 }
 
@@ -3047,6 +4803,64 @@ bool GameServer_Status::MergePartialFromCodedStream(
         break;
       }
 
+      // map<string, .agones.dev.sdk.GameServer.Status.Counter> counters = 4;
+      case 4: {
+        if (static_cast< ::google::protobuf::uint8>(tag) ==
+            static_cast< ::google::protobuf::uint8>(34u /* 34 & 0xFF */)) {
+          GameServer_Status_CountersEntry_DoNotUse::Parser< ::google::protobuf::internal::MapField<
+              GameServer_Status_CountersEntry_DoNotUse,
+              ::std::string, ::agones::dev::sdk::GameServer_Status_Counter,
+              ::google::protobuf::internal::WireFormatLite::TYPE_STRING,
+              ::google::protobuf::internal::WireFormatLite::TYPE_MESSAGE,
+              0 >,
+            ::google::protobuf::Map< ::std::string, ::agones::dev::sdk::GameServer_Status_Counter > > parser(&counters_);
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+              input, &parser));
+          DO_(::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+            parser.key().data(), static_cast<int>(parser.key().length()),
+            ::google::protobuf::internal::WireFormatLite::PARSE,
+            "agones.dev.sdk.GameServer.Status.CountersEntry.key"));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      // map<string, .agones.dev.sdk.GameServer.Status.List> lists = 5;
+      case 5: {
+        if (static_cast< ::google::protobuf::uint8>(tag) ==
+            static_cast< ::google::protobuf::uint8>(42u /* 42 & 0xFF */)) {
+          GameServer_Status_ListsEntry_DoNotUse::Parser< ::google::protobuf::internal::MapField<
+              GameServer_Status_ListsEntry_DoNotUse,
+              ::std::string, ::agones::dev::sdk::GameServer_Status_List,
+              ::google::protobuf::internal::WireFormatLite::TYPE_STRING,
+              ::google::protobuf::internal::WireFormatLite::TYPE_MESSAGE,
+              0 >,
+            ::google::protobuf::Map< ::std::string, ::agones::dev::sdk::GameServer_Status_List > > parser(&lists_);
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+              input, &parser));
+          DO_(::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+            parser.key().data(), static_cast<int>(parser.key().length()),
+            ::google::protobuf::internal::WireFormatLite::PARSE,
+            "agones.dev.sdk.GameServer.Status.ListsEntry.key"));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      // .agones.dev.sdk.GameServer.Status.Disruption disruption = 6;
+      case 6: {
+        if (static_cast< ::google::protobuf::uint8>(tag) ==
+            static_cast< ::google::protobuf::uint8>(50u /* 50 & 0xFF */)) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessage(
+               input, mutable_disruption()));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
       default: {
       handle_unusual:
         if (tag == 0) {
@@ -3102,6 +4916,110 @@ void GameServer_Status::SerializeWithCachedSizes(
       output);
   }
 
+  // map<string, .agones.dev.sdk.GameServer.Status.Counter> counters = 4;
+  if (!this->counters().empty()) {
+    typedef ::google::protobuf::Map< ::std::string, ::agones::dev::sdk::GameServer_Status_Counter >::const_pointer
+        ConstPtr;
+    typedef ConstPtr SortItem;
+    typedef ::google::protobuf::internal::CompareByDerefFirst<SortItem> Less;
+    struct Utf8Check {
+      static void Check(ConstPtr p) {
+        ::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+          p->first.data(), static_cast<int>(p->first.length()),
+          ::google::protobuf::internal::WireFormatLite::SERIALIZE,
+          "agones.dev.sdk.GameServer.Status.CountersEntry.key");
+      }
+    };
+
+    if (output->IsSerializationDeterministic() &&
+        this->counters().size() > 1) {
+      ::std::unique_ptr<SortItem[]> items(
+          new SortItem[this->counters().size()]);
+      typedef ::google::protobuf::Map< ::std::string, ::agones::dev::sdk::GameServer_Status_Counter >::size_type size_type;
+      size_type n = 0;
+      for (::google::protobuf::Map< ::std::string, ::agones::dev::sdk::GameServer_Status_Counter >::const_iterator
+          it = this->counters().begin();
+          it != this->counters().end(); ++it, ++n) {
+        items[static_cast<ptrdiff_t>(n)] = SortItem(&*it);
+      }
+      ::std::sort(&items[0], &items[static_cast<ptrdiff_t>(n)], Less());
+      ::std::unique_ptr<GameServer_Status_CountersEntry_DoNotUse> entry;
+      for (size_type i = 0; i < n; i++) {
+        entry.reset(counters_.NewEntryWrapper(
+            items[static_cast<ptrdiff_t>(i)]->first, items[static_cast<ptrdiff_t>(i)]->second));
+        ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+            4, *entry, output);
+        Utf8Check::Check(items[static_cast<ptrdiff_t>(i)]);
+      }
+    } else {
+      ::std::unique_ptr<GameServer_Status_CountersEntry_DoNotUse> entry;
+      for (::google::protobuf::Map< ::std::string, ::agones::dev::sdk::GameServer_Status_Counter >::const_iterator
+          it = this->counters().begin();
+          it != this->counters().end(); ++it) {
+        entry.reset(counters_.NewEntryWrapper(
+            it->first, it->second));
+        ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+            4, *entry, output);
+        Utf8Check::Check(&*it);
+      }
+    }
+  }
+
+  // map<string, .agones.dev.sdk.GameServer.Status.List> lists = 5;
+  if (!this->lists().empty()) {
+    typedef ::google::protobuf::Map< ::std::string, ::agones::dev::sdk::GameServer_Status_List >::const_pointer
+        ConstPtr;
+    typedef ConstPtr SortItem;
+    typedef ::google::protobuf::internal::CompareByDerefFirst<SortItem> Less;
+    struct Utf8Check {
+      static void Check(ConstPtr p) {
+        ::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+          p->first.data(), static_cast<int>(p->first.length()),
+          ::google::protobuf::internal::WireFormatLite::SERIALIZE,
+          "agones.dev.sdk.GameServer.Status.ListsEntry.key");
+      }
+    };
+
+    if (output->IsSerializationDeterministic() &&
+        this->lists().size() > 1) {
+      ::std::unique_ptr<SortItem[]> items(
+          new SortItem[this->lists().size()]);
+      typedef ::google::protobuf::Map< ::std::string, ::agones::dev::sdk::GameServer_Status_List >::size_type size_type;
+      size_type n = 0;
+      for (::google::protobuf::Map< ::std::string, ::agones::dev::sdk::GameServer_Status_List >::const_iterator
+          it = this->lists().begin();
+          it != this->lists().end(); ++it, ++n) {
+        items[static_cast<ptrdiff_t>(n)] = SortItem(&*it);
+      }
+      ::std::sort(&items[0], &items[static_cast<ptrdiff_t>(n)], Less());
+      ::std::unique_ptr<GameServer_Status_ListsEntry_DoNotUse> entry;
+      for (size_type i = 0; i < n; i++) {
+        entry.reset(lists_.NewEntryWrapper(
+            items[static_cast<ptrdiff_t>(i)]->first, items[static_cast<ptrdiff_t>(i)]->second));
+        ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+            5, *entry, output);
+        Utf8Check::Check(items[static_cast<ptrdiff_t>(i)]);
+      }
+    } else {
+      ::std::unique_ptr<GameServer_Status_ListsEntry_DoNotUse> entry;
+      for (::google::protobuf::Map< ::std::string, ::agones::dev::sdk::GameServer_Status_List >::const_iterator
+          it = this->lists().begin();
+          it != this->lists().end(); ++it) {
+        entry.reset(lists_.NewEntryWrapper(
+            it->first, it->second));
+        ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+            5, *entry, output);
+        Utf8Check::Check(&*it);
+      }
+    }
+  }
+
+  // .agones.dev.sdk.GameServer.Status.Disruption disruption = 6;
+  if (this->has_disruption()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      6, this->_internal_disruption(), output);
+  }
+
   if ((_internal_metadata_.have_unknown_fields() &&  ::google::protobuf::internal::GetProto3PreserveUnknownsDefault())) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         (::google::protobuf::internal::GetProto3PreserveUnknownsDefault()   ? _internal_metadata_.unknown_fields()   : _internal_metadata_.default_instance()), output);
@@ -3146,6 +5064,119 @@ void GameServer_Status::SerializeWithCachedSizes(
         3, this->ports(static_cast<int>(i)), deterministic, target);
   }
 
+  // map<string, .agones.dev.sdk.GameServer.Status.Counter> counters = 4;
+  if (!this->counters().empty()) {
+    typedef ::google::protobuf::Map< ::std::string, ::agones::dev::sdk::GameServer_Status_Counter >::const_pointer
+        ConstPtr;
+    typedef ConstPtr SortItem;
+    typedef ::google::protobuf::internal::CompareByDerefFirst<SortItem> Less;
+    struct Utf8Check {
+      static void Check(ConstPtr p) {
+        ::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+          p->first.data(), static_cast<int>(p->first.length()),
+          ::google::protobuf::internal::WireFormatLite::SERIALIZE,
+          "agones.dev.sdk.GameServer.Status.CountersEntry.key");
+      }
+    };
+
+    if (deterministic &&
+        this->counters().size() > 1) {
+      ::std::unique_ptr<SortItem[]> items(
+          new SortItem[this->counters().size()]);
+      typedef ::google::protobuf::Map< ::std::string, ::agones::dev::sdk::GameServer_Status_Counter >::size_type size_type;
+      size_type n = 0;
+      for (::google::protobuf::Map< ::std::string, ::agones::dev::sdk::GameServer_Status_Counter >::const_iterator
+          it = this->counters().begin();
+          it != this->counters().end(); ++it, ++n) {
+        items[static_cast<ptrdiff_t>(n)] = SortItem(&*it);
+      }
+      ::std::sort(&items[0], &items[static_cast<ptrdiff_t>(n)], Less());
+      ::std::unique_ptr<GameServer_Status_CountersEntry_DoNotUse> entry;
+      for (size_type i = 0; i < n; i++) {
+        entry.reset(counters_.NewEntryWrapper(
+            items[static_cast<ptrdiff_t>(i)]->first, items[static_cast<ptrdiff_t>(i)]->second));
+        target = ::google::protobuf::internal::WireFormatLite::
+                   InternalWriteMessageNoVirtualToArray(
+                       4, *entry, deterministic, target);
+;
+        Utf8Check::Check(items[static_cast<ptrdiff_t>(i)]);
+      }
+    } else {
+      ::std::unique_ptr<GameServer_Status_CountersEntry_DoNotUse> entry;
+      for (::google::protobuf::Map< ::std::string, ::agones::dev::sdk::GameServer_Status_Counter >::const_iterator
+          it = this->counters().begin();
+          it != this->counters().end(); ++it) {
+        entry.reset(counters_.NewEntryWrapper(
+            it->first, it->second));
+        target = ::google::protobuf::internal::WireFormatLite::
+                   InternalWriteMessageNoVirtualToArray(
+                       4, *entry, deterministic, target);
+;
+        Utf8Check::Check(&*it);
+      }
+    }
+  }
+
+  // map<string, .agones.dev.sdk.GameServer.Status.List> lists = 5;
+  if (!this->lists().empty()) {
+    typedef ::google::protobuf::Map< ::std::string, ::agones::dev::sdk::GameServer_Status_List >::const_pointer
+        ConstPtr;
+    typedef ConstPtr SortItem;
+    typedef ::google::protobuf::internal::CompareByDerefFirst<SortItem> Less;
+    struct Utf8Check {
+      static void Check(ConstPtr p) {
+        ::google::protobuf::internal::WireFormatLite::VerifyUtf8String(
+          p->first.data(), static_cast<int>(p->first.length()),
+          ::google::protobuf::internal::WireFormatLite::SERIALIZE,
+          "agones.dev.sdk.GameServer.Status.ListsEntry.key");
+      }
+    };
+
+    if (deterministic &&
+        this->lists().size() > 1) {
+      ::std::unique_ptr<SortItem[]> items(
+          new SortItem[this->lists().size()]);
+      typedef ::google::protobuf::Map< ::std::string, ::agones::dev::sdk::GameServer_Status_List >::size_type size_type;
+      size_type n = 0;
+      for (::google::protobuf::Map< ::std::string, ::agones::dev::sdk::GameServer_Status_List >::const_iterator
+          it = this->lists().begin();
+          it != this->lists().end(); ++it, ++n) {
+        items[static_cast<ptrdiff_t>(n)] = SortItem(&*it);
+      }
+      ::std::sort(&items[0], &items[static_cast<ptrdiff_t>(n)], Less());
+      ::std::unique_ptr<GameServer_Status_ListsEntry_DoNotUse> entry;
+      for (size_type i = 0; i < n; i++) {
+        entry.reset(lists_.NewEntryWrapper(
+            items[static_cast<ptrdiff_t>(i)]->first, items[static_cast<ptrdiff_t>(i)]->second));
+        target = ::google::protobuf::internal::WireFormatLite::
+                   InternalWriteMessageNoVirtualToArray(
+                       5, *entry, deterministic, target);
+;
+        Utf8Check::Check(items[static_cast<ptrdiff_t>(i)]);
+      }
+    } else {
+      ::std::unique_ptr<GameServer_Status_ListsEntry_DoNotUse> entry;
+      for (::google::protobuf::Map< ::std::string, ::agones::dev::sdk::GameServer_Status_List >::const_iterator
+          it = this->lists().begin();
+          it != this->lists().end(); ++it) {
+        entry.reset(lists_.NewEntryWrapper(
+            it->first, it->second));
+        target = ::google::protobuf::internal::WireFormatLite::
+                   InternalWriteMessageNoVirtualToArray(
+                       5, *entry, deterministic, target);
+;
+        Utf8Check::Check(&*it);
+      }
+    }
+  }
+
+  // .agones.dev.sdk.GameServer.Status.Disruption disruption = 6;
+  if (this->has_disruption()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      InternalWriteMessageToArray(
+        6, this->_internal_disruption(), deterministic, target);
+  }
+
   if ((_internal_metadata_.have_unknown_fields() &&  ::google::protobuf::internal::GetProto3PreserveUnknownsDefault())) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         (::google::protobuf::internal::GetProto3PreserveUnknownsDefault()   ? _internal_metadata_.unknown_fields()   : _internal_metadata_.default_instance()), target);
@@ -3174,6 +5205,34 @@ size_t GameServer_Status::ByteSizeLong() const {
     }
   }
 
+  // map<string, .agones.dev.sdk.GameServer.Status.Counter> counters = 4;
+  total_size += 1 *
+      ::google::protobuf::internal::FromIntSize(this->counters_size());
+  {
+    ::std::unique_ptr<GameServer_Status_CountersEntry_DoNotUse> entry;
+    for (::google::protobuf::Map< ::std::string, ::agones::dev::sdk::GameServer_Status_Counter >::const_iterator
+        it = this->counters().begin();
+        it != this->counters().end(); ++it) {
+      entry.reset(counters_.NewEntryWrapper(it->first, it->second));
+      total_size += ::google::protobuf::internal::WireFormatLite::
+          MessageSizeNoVirtual(*entry);
+    }
+  }
+
+  // map<string, .agones.dev.sdk.GameServer.Status.List> lists = 5;
+  total_size += 1 *
+      ::google::protobuf::internal::FromIntSize(this->lists_size());
+  {
+    ::std::unique_ptr<GameServer_Status_ListsEntry_DoNotUse> entry;
+    for (::google::protobuf::Map< ::std::string, ::agones::dev::sdk::GameServer_Status_List >::const_iterator
+        it = this->lists().begin();
+        it != this->lists().end(); ++it) {
+      entry.reset(lists_.NewEntryWrapper(it->first, it->second));
+      total_size += ::google::protobuf::internal::WireFormatLite::
+          MessageSizeNoVirtual(*entry);
+    }
+  }
+
   // string state = 1;
   if (this->state().size() > 0) {
     total_size += 1 +
@@ -3188,6 +5247,13 @@ size_t GameServer_Status::ByteSizeLong() const {
         this->address());
   }
 
+  // .agones.dev.sdk.GameServer.Status.Disruption disruption = 6;
+  if (this->has_disruption()) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::MessageSize(
+        *disruption_);
+  }
+
   int cached_size = ::google::protobuf::internal::ToCachedSize(total_size);
   SetCachedSize(cached_size);
   return total_size;
@@ -3216,6 +5282,8 @@ void GameServer_Status::MergeFrom(const GameServer_Status& from) {
   (void) cached_has_bits;
 
   ports_.MergeFrom(from.ports_);
+  counters_.MergeFrom(from.counters_);
+  lists_.MergeFrom(from.lists_);
   if (from.state().size() > 0) {
 
     state_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.state_);
@@ -3224,6 +5292,9 @@ void GameServer_Status::MergeFrom(const GameServer_Status& from) {
 
     address_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.address_);
   }
+  if (from.has_disruption()) {
+    mutable_disruption()->::agones::dev::sdk::GameServer_Status_Disruption::MergeFrom(from.disruption());
+  }
 }
 
 void GameServer_Status::CopyFrom(const ::google::protobuf::Message& from) {
@@ -3251,10 +5322,13 @@ void GameServer_Status::Swap(GameServer_Status* other) {
 void GameServer_Status::InternalSwap(GameServer_Status* other) {
   using std::swap;
   CastToBase(&ports_)->InternalSwap(CastToBase(&other->ports_));
+  counters_.Swap(&other->counters_);
+  lists_.Swap(&other->lists_);
   state_.Swap(&other->state_, &::google::protobuf::internal::GetEmptyStringAlreadyInited(),
     GetArenaNoVirtual());
   address_.Swap(&other->address_, &::google::protobuf::internal::GetEmptyStringAlreadyInited(),
     GetArenaNoVirtual());
+  swap(disruption_, other->disruption_);
   _internal_metadata_.Swap(&other->_internal_metadata_);
 }
 
@@ -3613,6 +5687,12 @@ template<> GOOGLE_PROTOBUF_ATTRIBUTE_NOINLINE ::agones::dev::sdk::KeyValue* Aren
 template<> GOOGLE_PROTOBUF_ATTRIBUTE_NOINLINE ::agones::dev::sdk::Duration* Arena::CreateMaybeMessage< ::agones::dev::sdk::Duration >(Arena* arena) {
   return Arena::CreateInternal< ::agones::dev::sdk::Duration >(arena);
 }
+template<> GOOGLE_PROTOBUF_ATTRIBUTE_NOINLINE ::agones::dev::sdk::CounterUpdate* Arena::CreateMaybeMessage< ::agones::dev::sdk::CounterUpdate >(Arena* arena) {
+  return Arena::CreateInternal< ::agones::dev::sdk::CounterUpdate >(arena);
+}
+template<> GOOGLE_PROTOBUF_ATTRIBUTE_NOINLINE ::agones::dev::sdk::ListValue* Arena::CreateMaybeMessage< ::agones::dev::sdk::ListValue >(Arena* arena) {
+  return Arena::CreateInternal< ::agones::dev::sdk::ListValue >(arena);
+}
 template<> GOOGLE_PROTOBUF_ATTRIBUTE_NOINLINE ::agones::dev::sdk::GameServer_ObjectMeta_AnnotationsEntry_DoNotUse* Arena::CreateMaybeMessage< ::agones::dev::sdk::GameServer_ObjectMeta_AnnotationsEntry_DoNotUse >(Arena* arena) {
   return Arena::CreateInternal< ::agones::dev::sdk::GameServer_ObjectMeta_AnnotationsEntry_DoNotUse >(arena);
 }
@@ -3631,6 +5711,21 @@ template<> GOOGLE_PROTOBUF_ATTRIBUTE_NOINLINE ::agones::dev::sdk::GameServer_Spe
 template<> GOOGLE_PROTOBUF_ATTRIBUTE_NOINLINE ::agones::dev::sdk::GameServer_Status_Port* Arena::CreateMaybeMessage< ::agones::dev::sdk::GameServer_Status_Port >(Arena* arena) {
   return Arena::CreateInternal< ::agones::dev::sdk::GameServer_Status_Port >(arena);
 }
+template<> GOOGLE_PROTOBUF_ATTRIBUTE_NOINLINE ::agones::dev::sdk::GameServer_Status_Counter* Arena::CreateMaybeMessage< ::agones::dev::sdk::GameServer_Status_Counter >(Arena* arena) {
+  return Arena::CreateInternal< ::agones::dev::sdk::GameServer_Status_Counter >(arena);
+}
+template<> GOOGLE_PROTOBUF_ATTRIBUTE_NOINLINE ::agones::dev::sdk::GameServer_Status_List* Arena::CreateMaybeMessage< ::agones::dev::sdk::GameServer_Status_List >(Arena* arena) {
+  return Arena::CreateInternal< ::agones::dev::sdk::GameServer_Status_List >(arena);
+}
+template<> GOOGLE_PROTOBUF_ATTRIBUTE_NOINLINE ::agones::dev::sdk::GameServer_Status_Disruption* Arena::CreateMaybeMessage< ::agones::dev::sdk::GameServer_Status_Disruption >(Arena* arena) {
+  return Arena::CreateInternal< ::agones::dev::sdk::GameServer_Status_Disruption >(arena);
+}
+template<> GOOGLE_PROTOBUF_ATTRIBUTE_NOINLINE ::agones::dev::sdk::GameServer_Status_CountersEntry_DoNotUse* Arena::CreateMaybeMessage< ::agones::dev::sdk::GameServer_Status_CountersEntry_DoNotUse >(Arena* arena) {
+  return Arena::CreateInternal< ::agones::dev::sdk::GameServer_Status_CountersEntry_DoNotUse >(arena);
+}
+template<> GOOGLE_PROTOBUF_ATTRIBUTE_NOINLINE ::agones::dev::sdk::GameServer_Status_ListsEntry_DoNotUse* Arena::CreateMaybeMessage< ::agones::dev::sdk::GameServer_Status_ListsEntry_DoNotUse >(Arena* arena) {
+  return Arena::CreateInternal< ::agones::dev::sdk::GameServer_Status_ListsEntry_DoNotUse >(arena);
+}
 template<> GOOGLE_PROTOBUF_ATTRIBUTE_NOINLINE ::agones::dev::sdk::GameServer_Status* Arena::CreateMaybeMessage< ::agones::dev::sdk::GameServer_Status >(Arena* arena) {
   return Arena::CreateInternal< ::agones::dev::sdk::GameServer_Status >(arena);
 }
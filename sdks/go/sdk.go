@@ -18,8 +18,10 @@ package sdk
 import (
 	"fmt"
 	"io"
+	"io/ioutil"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"agones.dev/agones/pkg/sdk"
@@ -62,6 +64,35 @@ func port() int {
 	return p
 }
 
+// tokenCredentials sends the token of the SDK server as a bearer token
+// with every request, over the insecure connection to localhost
+type tokenCredentials string
+
+// GetRequestMetadata returns the authorization metadata of the token
+func (t tokenCredentials) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + string(t)}, nil
+}
+
+// RequireTransportSecurity returns false, as the SDK server is only reachable from within the Pod
+func (t tokenCredentials) RequireTransportSecurity() bool {
+	return false
+}
+
+// dialOptions returns the options to connect to the SDK server, with the token in the
+// file of the AGONES_SDK_TOKEN_FILE environment variable, when the SDK server requires one
+func dialOptions() ([]grpc.DialOption, error) {
+	opts := []grpc.DialOption{grpc.WithBlock(), grpc.WithInsecure()}
+	file := os.Getenv("AGONES_SDK_TOKEN_FILE")
+	if file == "" {
+		return opts, nil
+	}
+	b, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read the SDK server token")
+	}
+	return append(opts, grpc.WithPerRPCCredentials(tokenCredentials(strings.TrimSpace(string(b))))), nil
+}
+
 // NewSDK starts a new SDK instance, and connects to
 // localhost on port 59357. Blocks until connection and handshake are made.
 // Times out after 30 seconds.
@@ -70,10 +101,14 @@ func NewSDK() (*SDK, error) {
 	s := &SDK{
 		ctx: context.Background(),
 	}
+	opts, err := dialOptions()
+	if err != nil {
+		return s, err
+	}
 	// block for at least 30 seconds
 	ctx, cancel := context.WithTimeout(s.ctx, 30*time.Second)
 	defer cancel()
-	conn, err := grpc.DialContext(ctx, addr, grpc.WithBlock(), grpc.WithInsecure())
+	conn, err := grpc.DialContext(ctx, addr, opts...)
 	if err != nil {
 		return s, errors.Wrapf(err, "could not connect to %s", addr)
 	}
@@ -132,6 +167,31 @@ func (s *SDK) SetAnnotation(key, value string) error {
 	return errors.Wrap(err, "could not set annotation")
 }
 
+// IncrementCounter adds amount to the count of the named Counter on the `GameServer`.
+// A negative amount decrements the Counter.
+func (s *SDK) IncrementCounter(name string, amount int64) error {
+	_, err := s.client.IncrementCounter(s.ctx, &sdk.CounterUpdate{Name: name, Amount: amount})
+	return errors.Wrap(err, "could not increment counter")
+}
+
+// SetCounterCapacity sets the capacity of the named Counter on the `GameServer`
+func (s *SDK) SetCounterCapacity(name string, capacity int64) error {
+	_, err := s.client.SetCounterCapacity(s.ctx, &sdk.CounterUpdate{Name: name, Amount: capacity})
+	return errors.Wrap(err, "could not set counter capacity")
+}
+
+// AppendListValue appends a value to the named List on the `GameServer`
+func (s *SDK) AppendListValue(name, value string) error {
+	_, err := s.client.AppendListValue(s.ctx, &sdk.ListValue{Name: name, Value: value})
+	return errors.Wrap(err, "could not append list value")
+}
+
+// DeleteListValue removes a value from the named List on the `GameServer`
+func (s *SDK) DeleteListValue(name, value string) error {
+	_, err := s.client.DeleteListValue(s.ctx, &sdk.ListValue{Name: name, Value: value})
+	return errors.Wrap(err, "could not delete list value")
+}
+
 // GameServer retrieve the GameServer details
 func (s *SDK) GameServer() (*sdk.GameServer, error) {
 	gs, err := s.client.GetGameServer(s.ctx, &sdk.Empty{})
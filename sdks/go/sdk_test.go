@@ -15,6 +15,9 @@
 package sdk
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -127,6 +130,75 @@ func TestSDKSetAnnotation(t *testing.T) {
 	assert.Equal(t, expected, sm.annotations["foo"])
 }
 
+func TestSDKCounters(t *testing.T) {
+	t.Parallel()
+	sm := &sdkMock{
+		counters:   map[string]int64{},
+		capacities: map[string]int64{},
+	}
+	s := SDK{
+		ctx:    context.Background(),
+		client: sm,
+	}
+
+	err := s.SetCounterCapacity("players", 10)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 10, sm.capacities["players"])
+
+	err = s.IncrementCounter("players", 3)
+	assert.NoError(t, err)
+	err = s.IncrementCounter("players", -1)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 2, sm.counters["players"])
+}
+
+func TestSDKLists(t *testing.T) {
+	t.Parallel()
+	sm := &sdkMock{
+		lists: map[string][]string{},
+	}
+	s := SDK{
+		ctx:    context.Background(),
+		client: sm,
+	}
+
+	err := s.AppendListValue("players", "alice")
+	assert.NoError(t, err)
+	err = s.AppendListValue("players", "bob")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"alice", "bob"}, sm.lists["players"])
+
+	err = s.DeleteListValue("players", "alice")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"bob"}, sm.lists["players"])
+}
+
+func TestSDKDialOptions(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sdk-token")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)                    // nolint: errcheck
+	defer os.Unsetenv("AGONES_SDK_TOKEN_FILE") // nolint: errcheck
+
+	opts, err := dialOptions()
+	assert.NoError(t, err)
+	assert.Len(t, opts, 2)
+
+	file := filepath.Join(dir, "token")
+	assert.NoError(t, ioutil.WriteFile(file, []byte("secret\n"), 0600))
+	assert.NoError(t, os.Setenv("AGONES_SDK_TOKEN_FILE", file))
+	opts, err = dialOptions()
+	assert.NoError(t, err)
+	assert.Len(t, opts, 3)
+
+	assert.NoError(t, os.Setenv("AGONES_SDK_TOKEN_FILE", filepath.Join(dir, "missing")))
+	_, err = dialOptions()
+	assert.Error(t, err)
+
+	md, err := tokenCredentials("secret").GetRequestMetadata(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"authorization": "Bearer secret"}, md)
+}
+
 var _ sdk.SDKClient = &sdkMock{}
 var _ sdk.SDK_HealthClient = &healthMock{}
 var _ sdk.SDK_WatchGameServerClient = &watchMock{}
@@ -140,6 +212,9 @@ type sdkMock struct {
 	wm          *watchMock
 	labels      map[string]string
 	annotations map[string]string
+	counters    map[string]int64
+	capacities  map[string]int64
+	lists       map[string][]string
 }
 
 func (m *sdkMock) SetLabel(ctx context.Context, in *sdk.KeyValue, opts ...grpc.CallOption) (*sdk.Empty, error) {
@@ -152,6 +227,32 @@ func (m *sdkMock) SetAnnotation(ctx context.Context, in *sdk.KeyValue, opts ...g
 	return &sdk.Empty{}, nil
 }
 
+func (m *sdkMock) IncrementCounter(ctx context.Context, in *sdk.CounterUpdate, opts ...grpc.CallOption) (*sdk.Empty, error) {
+	m.counters[in.Name] += in.Amount
+	return &sdk.Empty{}, nil
+}
+
+func (m *sdkMock) SetCounterCapacity(ctx context.Context, in *sdk.CounterUpdate, opts ...grpc.CallOption) (*sdk.Empty, error) {
+	m.capacities[in.Name] = in.Amount
+	return &sdk.Empty{}, nil
+}
+
+func (m *sdkMock) AppendListValue(ctx context.Context, in *sdk.ListValue, opts ...grpc.CallOption) (*sdk.Empty, error) {
+	m.lists[in.Name] = append(m.lists[in.Name], in.Value)
+	return &sdk.Empty{}, nil
+}
+
+func (m *sdkMock) DeleteListValue(ctx context.Context, in *sdk.ListValue, opts ...grpc.CallOption) (*sdk.Empty, error) {
+	values := m.lists[in.Name][:0]
+	for _, v := range m.lists[in.Name] {
+		if v != in.Value {
+			values = append(values, v)
+		}
+	}
+	m.lists[in.Name] = values
+	return &sdk.Empty{}, nil
+}
+
 func (m *sdkMock) WatchGameServer(ctx context.Context, in *sdk.Empty, opts ...grpc.CallOption) (sdk.SDK_WatchGameServerClient, error) {
 	return m.wm, nil
 }
@@ -20,6 +20,17 @@ var grpc = require('grpc');
 var sdk_pb = require('./sdk_pb.js');
 var google_api_annotations_pb = require('./google/api/annotations_pb.js');
 
+function serialize_agones_dev_sdk_CounterUpdate(arg) {
+  if (!(arg instanceof sdk_pb.CounterUpdate)) {
+    throw new Error('Expected argument of type agones.dev.sdk.CounterUpdate');
+  }
+  return new Buffer(arg.serializeBinary());
+}
+
+function deserialize_agones_dev_sdk_CounterUpdate(buffer_arg) {
+  return sdk_pb.CounterUpdate.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_agones_dev_sdk_Duration(arg) {
   if (!(arg instanceof sdk_pb.Duration)) {
     throw new Error('Expected argument of type agones.dev.sdk.Duration');
@@ -64,6 +75,17 @@ function deserialize_agones_dev_sdk_KeyValue(buffer_arg) {
   return sdk_pb.KeyValue.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_agones_dev_sdk_ListValue(arg) {
+  if (!(arg instanceof sdk_pb.ListValue)) {
+    throw new Error('Expected argument of type agones.dev.sdk.ListValue');
+  }
+  return new Buffer(arg.serializeBinary());
+}
+
+function deserialize_agones_dev_sdk_ListValue(buffer_arg) {
+  return sdk_pb.ListValue.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 
 // SDK service to be used in the GameServer SDK to the Pod Sidecar
 var SDKService = exports.SDKService = {
@@ -175,6 +197,54 @@ var SDKService = exports.SDKService = {
     responseSerialize: serialize_agones_dev_sdk_Empty,
     responseDeserialize: deserialize_agones_dev_sdk_Empty,
   },
+  // Increments the count of a Counter on the backing GameServer. A negative amount decrements it.
+  incrementCounter: {
+    path: '/agones.dev.sdk.SDK/IncrementCounter',
+    requestStream: false,
+    responseStream: false,
+    requestType: sdk_pb.CounterUpdate,
+    responseType: sdk_pb.Empty,
+    requestSerialize: serialize_agones_dev_sdk_CounterUpdate,
+    requestDeserialize: deserialize_agones_dev_sdk_CounterUpdate,
+    responseSerialize: serialize_agones_dev_sdk_Empty,
+    responseDeserialize: deserialize_agones_dev_sdk_Empty,
+  },
+  // Sets the capacity of a Counter on the backing GameServer
+  setCounterCapacity: {
+    path: '/agones.dev.sdk.SDK/SetCounterCapacity',
+    requestStream: false,
+    responseStream: false,
+    requestType: sdk_pb.CounterUpdate,
+    responseType: sdk_pb.Empty,
+    requestSerialize: serialize_agones_dev_sdk_CounterUpdate,
+    requestDeserialize: deserialize_agones_dev_sdk_CounterUpdate,
+    responseSerialize: serialize_agones_dev_sdk_Empty,
+    responseDeserialize: deserialize_agones_dev_sdk_Empty,
+  },
+  // Appends a value to a List on the backing GameServer
+  appendListValue: {
+    path: '/agones.dev.sdk.SDK/AppendListValue',
+    requestStream: false,
+    responseStream: false,
+    requestType: sdk_pb.ListValue,
+    responseType: sdk_pb.Empty,
+    requestSerialize: serialize_agones_dev_sdk_ListValue,
+    requestDeserialize: deserialize_agones_dev_sdk_ListValue,
+    responseSerialize: serialize_agones_dev_sdk_Empty,
+    responseDeserialize: deserialize_agones_dev_sdk_Empty,
+  },
+  // Removes a value from a List on the backing GameServer
+  deleteListValue: {
+    path: '/agones.dev.sdk.SDK/DeleteListValue',
+    requestStream: false,
+    responseStream: false,
+    requestType: sdk_pb.ListValue,
+    responseType: sdk_pb.Empty,
+    requestSerialize: serialize_agones_dev_sdk_ListValue,
+    requestDeserialize: deserialize_agones_dev_sdk_ListValue,
+    responseSerialize: serialize_agones_dev_sdk_Empty,
+    responseDeserialize: deserialize_agones_dev_sdk_Empty,
+  },
 };
 
 exports.SDKClient = grpc.makeGenericClientConstructor(SDKService);
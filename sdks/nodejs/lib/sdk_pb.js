@@ -27,6 +27,7 @@ var goog = jspb;
 var global = Function('return this')();
 
 var google_api_annotations_pb = require('./google/api/annotations_pb.js');
+goog.exportSymbol('proto.agones.dev.sdk.CounterUpdate', null, global);
 goog.exportSymbol('proto.agones.dev.sdk.Duration', null, global);
 goog.exportSymbol('proto.agones.dev.sdk.Empty', null, global);
 goog.exportSymbol('proto.agones.dev.sdk.GameServer', null, global);
@@ -34,8 +35,12 @@ goog.exportSymbol('proto.agones.dev.sdk.GameServer.ObjectMeta', null, global);
 goog.exportSymbol('proto.agones.dev.sdk.GameServer.Spec', null, global);
 goog.exportSymbol('proto.agones.dev.sdk.GameServer.Spec.Health', null, global);
 goog.exportSymbol('proto.agones.dev.sdk.GameServer.Status', null, global);
+goog.exportSymbol('proto.agones.dev.sdk.GameServer.Status.Counter', null, global);
+goog.exportSymbol('proto.agones.dev.sdk.GameServer.Status.Disruption', null, global);
+goog.exportSymbol('proto.agones.dev.sdk.GameServer.Status.List', null, global);
 goog.exportSymbol('proto.agones.dev.sdk.GameServer.Status.Port', null, global);
 goog.exportSymbol('proto.agones.dev.sdk.KeyValue', null, global);
+goog.exportSymbol('proto.agones.dev.sdk.ListValue', null, global);
 
 /**
  * Generated by JsPbCodeGenerator.
@@ -474,12 +479,12 @@ proto.agones.dev.sdk.Duration.prototype.setSeconds = function(value) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.agones.dev.sdk.GameServer = function(opt_data) {
+proto.agones.dev.sdk.CounterUpdate = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.agones.dev.sdk.GameServer, jspb.Message);
+goog.inherits(proto.agones.dev.sdk.CounterUpdate, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
-  proto.agones.dev.sdk.GameServer.displayName = 'proto.agones.dev.sdk.GameServer';
+  proto.agones.dev.sdk.CounterUpdate.displayName = 'proto.agones.dev.sdk.CounterUpdate';
 }
 
 
@@ -494,8 +499,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     for transitional soy proto support: http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.agones.dev.sdk.GameServer.prototype.toObject = function(opt_includeInstance) {
-  return proto.agones.dev.sdk.GameServer.toObject(opt_includeInstance, this);
+proto.agones.dev.sdk.CounterUpdate.prototype.toObject = function(opt_includeInstance) {
+  return proto.agones.dev.sdk.CounterUpdate.toObject(opt_includeInstance, this);
 };
 
 
@@ -504,15 +509,14 @@ proto.agones.dev.sdk.GameServer.prototype.toObject = function(opt_includeInstanc
  * @param {boolean|undefined} includeInstance Whether to include the JSPB
  *     instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.agones.dev.sdk.GameServer} msg The msg instance to transform.
+ * @param {!proto.agones.dev.sdk.CounterUpdate} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.agones.dev.sdk.GameServer.toObject = function(includeInstance, msg) {
+proto.agones.dev.sdk.CounterUpdate.toObject = function(includeInstance, msg) {
   var f, obj = {
-    objectMeta: (f = msg.getObjectMeta()) && proto.agones.dev.sdk.GameServer.ObjectMeta.toObject(includeInstance, f),
-    spec: (f = msg.getSpec()) && proto.agones.dev.sdk.GameServer.Spec.toObject(includeInstance, f),
-    status: (f = msg.getStatus()) && proto.agones.dev.sdk.GameServer.Status.toObject(includeInstance, f)
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    amount: jspb.Message.getFieldWithDefault(msg, 2, 0)
   };
 
   if (includeInstance) {
@@ -526,23 +530,23 @@ proto.agones.dev.sdk.GameServer.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.agones.dev.sdk.GameServer}
+ * @return {!proto.agones.dev.sdk.CounterUpdate}
  */
-proto.agones.dev.sdk.GameServer.deserializeBinary = function(bytes) {
+proto.agones.dev.sdk.CounterUpdate.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.agones.dev.sdk.GameServer;
-  return proto.agones.dev.sdk.GameServer.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.agones.dev.sdk.CounterUpdate;
+  return proto.agones.dev.sdk.CounterUpdate.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.agones.dev.sdk.GameServer} msg The message object to deserialize into.
+ * @param {!proto.agones.dev.sdk.CounterUpdate} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.agones.dev.sdk.GameServer}
+ * @return {!proto.agones.dev.sdk.CounterUpdate}
  */
-proto.agones.dev.sdk.GameServer.deserializeBinaryFromReader = function(msg, reader) {
+proto.agones.dev.sdk.CounterUpdate.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -550,19 +554,12 @@ proto.agones.dev.sdk.GameServer.deserializeBinaryFromReader = function(msg, read
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.agones.dev.sdk.GameServer.ObjectMeta;
-      reader.readMessage(value,proto.agones.dev.sdk.GameServer.ObjectMeta.deserializeBinaryFromReader);
-      msg.setObjectMeta(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
       break;
     case 2:
-      var value = new proto.agones.dev.sdk.GameServer.Spec;
-      reader.readMessage(value,proto.agones.dev.sdk.GameServer.Spec.deserializeBinaryFromReader);
-      msg.setSpec(value);
-      break;
-    case 3:
-      var value = new proto.agones.dev.sdk.GameServer.Status;
-      reader.readMessage(value,proto.agones.dev.sdk.GameServer.Status.deserializeBinaryFromReader);
-      msg.setStatus(value);
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setAmount(value);
       break;
     default:
       reader.skipField();
@@ -577,9 +574,9 @@ proto.agones.dev.sdk.GameServer.deserializeBinaryFromReader = function(msg, read
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.agones.dev.sdk.GameServer.prototype.serializeBinary = function() {
+proto.agones.dev.sdk.CounterUpdate.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.agones.dev.sdk.GameServer.serializeBinaryToWriter(this, writer);
+  proto.agones.dev.sdk.CounterUpdate.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -587,39 +584,59 @@ proto.agones.dev.sdk.GameServer.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.agones.dev.sdk.GameServer} message
+ * @param {!proto.agones.dev.sdk.CounterUpdate} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.agones.dev.sdk.GameServer.serializeBinaryToWriter = function(message, writer) {
+proto.agones.dev.sdk.CounterUpdate.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getObjectMeta();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
       1,
-      f,
-      proto.agones.dev.sdk.GameServer.ObjectMeta.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getSpec();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getAmount();
+  if (f !== 0) {
+    writer.writeInt64(
       2,
-      f,
-      proto.agones.dev.sdk.GameServer.Spec.serializeBinaryToWriter
-    );
-  }
-  f = message.getStatus();
-  if (f != null) {
-    writer.writeMessage(
-      3,
-      f,
-      proto.agones.dev.sdk.GameServer.Status.serializeBinaryToWriter
+      f
     );
   }
 };
 
 
+/**
+ * optional string name = 1;
+ * @return {string}
+ */
+proto.agones.dev.sdk.CounterUpdate.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/** @param {string} value */
+proto.agones.dev.sdk.CounterUpdate.prototype.setName = function(value) {
+  jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional int64 amount = 2;
+ * @return {number}
+ */
+proto.agones.dev.sdk.CounterUpdate.prototype.getAmount = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/** @param {number} value */
+proto.agones.dev.sdk.CounterUpdate.prototype.setAmount = function(value) {
+  jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
 
 /**
  * Generated by JsPbCodeGenerator.
@@ -631,12 +648,12 @@ proto.agones.dev.sdk.GameServer.serializeBinaryToWriter = function(message, writ
  * @extends {jspb.Message}
  * @constructor
  */
-proto.agones.dev.sdk.GameServer.ObjectMeta = function(opt_data) {
+proto.agones.dev.sdk.ListValue = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.agones.dev.sdk.GameServer.ObjectMeta, jspb.Message);
+goog.inherits(proto.agones.dev.sdk.ListValue, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
-  proto.agones.dev.sdk.GameServer.ObjectMeta.displayName = 'proto.agones.dev.sdk.GameServer.ObjectMeta';
+  proto.agones.dev.sdk.ListValue.displayName = 'proto.agones.dev.sdk.ListValue';
 }
 
 
@@ -651,8 +668,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     for transitional soy proto support: http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.agones.dev.sdk.GameServer.ObjectMeta.prototype.toObject = function(opt_includeInstance) {
-  return proto.agones.dev.sdk.GameServer.ObjectMeta.toObject(opt_includeInstance, this);
+proto.agones.dev.sdk.ListValue.prototype.toObject = function(opt_includeInstance) {
+  return proto.agones.dev.sdk.ListValue.toObject(opt_includeInstance, this);
 };
 
 
@@ -661,21 +678,14 @@ proto.agones.dev.sdk.GameServer.ObjectMeta.prototype.toObject = function(opt_inc
  * @param {boolean|undefined} includeInstance Whether to include the JSPB
  *     instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.agones.dev.sdk.GameServer.ObjectMeta} msg The msg instance to transform.
+ * @param {!proto.agones.dev.sdk.ListValue} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.agones.dev.sdk.GameServer.ObjectMeta.toObject = function(includeInstance, msg) {
+proto.agones.dev.sdk.ListValue.toObject = function(includeInstance, msg) {
   var f, obj = {
     name: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    namespace: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    uid: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    resourceVersion: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    generation: jspb.Message.getFieldWithDefault(msg, 5, 0),
-    creationTimestamp: jspb.Message.getFieldWithDefault(msg, 6, 0),
-    deletionTimestamp: jspb.Message.getFieldWithDefault(msg, 7, 0),
-    annotationsMap: (f = msg.getAnnotationsMap()) ? f.toObject(includeInstance, undefined) : [],
-    labelsMap: (f = msg.getLabelsMap()) ? f.toObject(includeInstance, undefined) : []
+    value: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -689,23 +699,23 @@ proto.agones.dev.sdk.GameServer.ObjectMeta.toObject = function(includeInstance,
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.agones.dev.sdk.GameServer.ObjectMeta}
+ * @return {!proto.agones.dev.sdk.ListValue}
  */
-proto.agones.dev.sdk.GameServer.ObjectMeta.deserializeBinary = function(bytes) {
+proto.agones.dev.sdk.ListValue.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.agones.dev.sdk.GameServer.ObjectMeta;
-  return proto.agones.dev.sdk.GameServer.ObjectMeta.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.agones.dev.sdk.ListValue;
+  return proto.agones.dev.sdk.ListValue.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.agones.dev.sdk.GameServer.ObjectMeta} msg The message object to deserialize into.
+ * @param {!proto.agones.dev.sdk.ListValue} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.agones.dev.sdk.GameServer.ObjectMeta}
+ * @return {!proto.agones.dev.sdk.ListValue}
  */
-proto.agones.dev.sdk.GameServer.ObjectMeta.deserializeBinaryFromReader = function(msg, reader) {
+proto.agones.dev.sdk.ListValue.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -718,39 +728,7 @@ proto.agones.dev.sdk.GameServer.ObjectMeta.deserializeBinaryFromReader = functio
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setNamespace(value);
-      break;
-    case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setUid(value);
-      break;
-    case 4:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setResourceVersion(value);
-      break;
-    case 5:
-      var value = /** @type {number} */ (reader.readInt64());
-      msg.setGeneration(value);
-      break;
-    case 6:
-      var value = /** @type {number} */ (reader.readInt64());
-      msg.setCreationTimestamp(value);
-      break;
-    case 7:
-      var value = /** @type {number} */ (reader.readInt64());
-      msg.setDeletionTimestamp(value);
-      break;
-    case 8:
-      var value = msg.getAnnotationsMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "");
-         });
-      break;
-    case 9:
-      var value = msg.getLabelsMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "");
-         });
+      msg.setValue(value);
       break;
     default:
       reader.skipField();
@@ -765,9 +743,9 @@ proto.agones.dev.sdk.GameServer.ObjectMeta.deserializeBinaryFromReader = functio
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.agones.dev.sdk.GameServer.ObjectMeta.prototype.serializeBinary = function() {
+proto.agones.dev.sdk.ListValue.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.agones.dev.sdk.GameServer.ObjectMeta.serializeBinaryToWriter(this, writer);
+  proto.agones.dev.sdk.ListValue.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -775,11 +753,11 @@ proto.agones.dev.sdk.GameServer.ObjectMeta.prototype.serializeBinary = function(
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.agones.dev.sdk.GameServer.ObjectMeta} message
+ * @param {!proto.agones.dev.sdk.ListValue} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.agones.dev.sdk.GameServer.ObjectMeta.serializeBinaryToWriter = function(message, writer) {
+proto.agones.dev.sdk.ListValue.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getName();
   if (f.length > 0) {
@@ -788,56 +766,13 @@ proto.agones.dev.sdk.GameServer.ObjectMeta.serializeBinaryToWriter = function(me
       f
     );
   }
-  f = message.getNamespace();
+  f = message.getValue();
   if (f.length > 0) {
     writer.writeString(
       2,
       f
     );
   }
-  f = message.getUid();
-  if (f.length > 0) {
-    writer.writeString(
-      3,
-      f
-    );
-  }
-  f = message.getResourceVersion();
-  if (f.length > 0) {
-    writer.writeString(
-      4,
-      f
-    );
-  }
-  f = message.getGeneration();
-  if (f !== 0) {
-    writer.writeInt64(
-      5,
-      f
-    );
-  }
-  f = message.getCreationTimestamp();
-  if (f !== 0) {
-    writer.writeInt64(
-      6,
-      f
-    );
-  }
-  f = message.getDeletionTimestamp();
-  if (f !== 0) {
-    writer.writeInt64(
-      7,
-      f
-    );
-  }
-  f = message.getAnnotationsMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(8, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
-  }
-  f = message.getLabelsMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(9, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
-  }
 };
 
 
@@ -845,53 +780,461 @@ proto.agones.dev.sdk.GameServer.ObjectMeta.serializeBinaryToWriter = function(me
  * optional string name = 1;
  * @return {string}
  */
-proto.agones.dev.sdk.GameServer.ObjectMeta.prototype.getName = function() {
+proto.agones.dev.sdk.ListValue.prototype.getName = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /** @param {string} value */
-proto.agones.dev.sdk.GameServer.ObjectMeta.prototype.setName = function(value) {
+proto.agones.dev.sdk.ListValue.prototype.setName = function(value) {
   jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string namespace = 2;
+ * optional string value = 2;
  * @return {string}
  */
-proto.agones.dev.sdk.GameServer.ObjectMeta.prototype.getNamespace = function() {
+proto.agones.dev.sdk.ListValue.prototype.getValue = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /** @param {string} value */
-proto.agones.dev.sdk.GameServer.ObjectMeta.prototype.setNamespace = function(value) {
+proto.agones.dev.sdk.ListValue.prototype.setValue = function(value) {
   jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
+
 /**
- * optional string uid = 3;
- * @return {string}
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.agones.dev.sdk.GameServer.ObjectMeta.prototype.getUid = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.agones.dev.sdk.GameServer = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
+goog.inherits(proto.agones.dev.sdk.GameServer, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  proto.agones.dev.sdk.GameServer.displayName = 'proto.agones.dev.sdk.GameServer';
+}
 
 
-/** @param {string} value */
-proto.agones.dev.sdk.GameServer.ObjectMeta.prototype.setUid = function(value) {
-  jspb.Message.setProto3StringField(this, 3, value);
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto suitable for use in Soy templates.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     com.google.apps.jspb.JsClassTemplate.JS_RESERVED_WORDS.
+ * @param {boolean=} opt_includeInstance Whether to include the JSPB instance
+ *     for transitional soy proto support: http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.agones.dev.sdk.GameServer.prototype.toObject = function(opt_includeInstance) {
+  return proto.agones.dev.sdk.GameServer.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * optional string resource_version = 4;
- * @return {string}
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Whether to include the JSPB
+ *     instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.agones.dev.sdk.GameServer} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.agones.dev.sdk.GameServer.ObjectMeta.prototype.getResourceVersion = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+proto.agones.dev.sdk.GameServer.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    objectMeta: (f = msg.getObjectMeta()) && proto.agones.dev.sdk.GameServer.ObjectMeta.toObject(includeInstance, f),
+    spec: (f = msg.getSpec()) && proto.agones.dev.sdk.GameServer.Spec.toObject(includeInstance, f),
+    status: (f = msg.getStatus()) && proto.agones.dev.sdk.GameServer.Status.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.agones.dev.sdk.GameServer}
+ */
+proto.agones.dev.sdk.GameServer.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.agones.dev.sdk.GameServer;
+  return proto.agones.dev.sdk.GameServer.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.agones.dev.sdk.GameServer} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.agones.dev.sdk.GameServer}
+ */
+proto.agones.dev.sdk.GameServer.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.agones.dev.sdk.GameServer.ObjectMeta;
+      reader.readMessage(value,proto.agones.dev.sdk.GameServer.ObjectMeta.deserializeBinaryFromReader);
+      msg.setObjectMeta(value);
+      break;
+    case 2:
+      var value = new proto.agones.dev.sdk.GameServer.Spec;
+      reader.readMessage(value,proto.agones.dev.sdk.GameServer.Spec.deserializeBinaryFromReader);
+      msg.setSpec(value);
+      break;
+    case 3:
+      var value = new proto.agones.dev.sdk.GameServer.Status;
+      reader.readMessage(value,proto.agones.dev.sdk.GameServer.Status.deserializeBinaryFromReader);
+      msg.setStatus(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.agones.dev.sdk.GameServer.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.agones.dev.sdk.GameServer.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.agones.dev.sdk.GameServer} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.agones.dev.sdk.GameServer.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getObjectMeta();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.agones.dev.sdk.GameServer.ObjectMeta.serializeBinaryToWriter
+    );
+  }
+  f = message.getSpec();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      proto.agones.dev.sdk.GameServer.Spec.serializeBinaryToWriter
+    );
+  }
+  f = message.getStatus();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      proto.agones.dev.sdk.GameServer.Status.serializeBinaryToWriter
+    );
+  }
+};
+
+
+
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.agones.dev.sdk.GameServer.ObjectMeta = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.agones.dev.sdk.GameServer.ObjectMeta, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  proto.agones.dev.sdk.GameServer.ObjectMeta.displayName = 'proto.agones.dev.sdk.GameServer.ObjectMeta';
+}
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto suitable for use in Soy templates.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     com.google.apps.jspb.JsClassTemplate.JS_RESERVED_WORDS.
+ * @param {boolean=} opt_includeInstance Whether to include the JSPB instance
+ *     for transitional soy proto support: http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.agones.dev.sdk.GameServer.ObjectMeta.prototype.toObject = function(opt_includeInstance) {
+  return proto.agones.dev.sdk.GameServer.ObjectMeta.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Whether to include the JSPB
+ *     instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.agones.dev.sdk.GameServer.ObjectMeta} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.agones.dev.sdk.GameServer.ObjectMeta.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    namespace: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    uid: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    resourceVersion: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    generation: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    creationTimestamp: jspb.Message.getFieldWithDefault(msg, 6, 0),
+    deletionTimestamp: jspb.Message.getFieldWithDefault(msg, 7, 0),
+    annotationsMap: (f = msg.getAnnotationsMap()) ? f.toObject(includeInstance, undefined) : [],
+    labelsMap: (f = msg.getLabelsMap()) ? f.toObject(includeInstance, undefined) : []
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.agones.dev.sdk.GameServer.ObjectMeta}
+ */
+proto.agones.dev.sdk.GameServer.ObjectMeta.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.agones.dev.sdk.GameServer.ObjectMeta;
+  return proto.agones.dev.sdk.GameServer.ObjectMeta.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.agones.dev.sdk.GameServer.ObjectMeta} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.agones.dev.sdk.GameServer.ObjectMeta}
+ */
+proto.agones.dev.sdk.GameServer.ObjectMeta.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setNamespace(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUid(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setResourceVersion(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setGeneration(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setCreationTimestamp(value);
+      break;
+    case 7:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setDeletionTimestamp(value);
+      break;
+    case 8:
+      var value = msg.getAnnotationsMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "");
+         });
+      break;
+    case 9:
+      var value = msg.getLabelsMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "");
+         });
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.agones.dev.sdk.GameServer.ObjectMeta.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.agones.dev.sdk.GameServer.ObjectMeta.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.agones.dev.sdk.GameServer.ObjectMeta} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.agones.dev.sdk.GameServer.ObjectMeta.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getNamespace();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getUid();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getResourceVersion();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getGeneration();
+  if (f !== 0) {
+    writer.writeInt64(
+      5,
+      f
+    );
+  }
+  f = message.getCreationTimestamp();
+  if (f !== 0) {
+    writer.writeInt64(
+      6,
+      f
+    );
+  }
+  f = message.getDeletionTimestamp();
+  if (f !== 0) {
+    writer.writeInt64(
+      7,
+      f
+    );
+  }
+  f = message.getAnnotationsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(8, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  }
+  f = message.getLabelsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(9, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  }
+};
+
+
+/**
+ * optional string name = 1;
+ * @return {string}
+ */
+proto.agones.dev.sdk.GameServer.ObjectMeta.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/** @param {string} value */
+proto.agones.dev.sdk.GameServer.ObjectMeta.prototype.setName = function(value) {
+  jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string namespace = 2;
+ * @return {string}
+ */
+proto.agones.dev.sdk.GameServer.ObjectMeta.prototype.getNamespace = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/** @param {string} value */
+proto.agones.dev.sdk.GameServer.ObjectMeta.prototype.setNamespace = function(value) {
+  jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string uid = 3;
+ * @return {string}
+ */
+proto.agones.dev.sdk.GameServer.ObjectMeta.prototype.getUid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/** @param {string} value */
+proto.agones.dev.sdk.GameServer.ObjectMeta.prototype.setUid = function(value) {
+  jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string resource_version = 4;
+ * @return {string}
+ */
+proto.agones.dev.sdk.GameServer.ObjectMeta.prototype.getResourceVersion = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
 
 
@@ -902,83 +1245,664 @@ proto.agones.dev.sdk.GameServer.ObjectMeta.prototype.setResourceVersion = functi
 
 
 /**
- * optional int64 generation = 5;
+ * optional int64 generation = 5;
+ * @return {number}
+ */
+proto.agones.dev.sdk.GameServer.ObjectMeta.prototype.getGeneration = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/** @param {number} value */
+proto.agones.dev.sdk.GameServer.ObjectMeta.prototype.setGeneration = function(value) {
+  jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+/**
+ * optional int64 creation_timestamp = 6;
+ * @return {number}
+ */
+proto.agones.dev.sdk.GameServer.ObjectMeta.prototype.getCreationTimestamp = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+};
+
+
+/** @param {number} value */
+proto.agones.dev.sdk.GameServer.ObjectMeta.prototype.setCreationTimestamp = function(value) {
+  jspb.Message.setProto3IntField(this, 6, value);
+};
+
+
+/**
+ * optional int64 deletion_timestamp = 7;
+ * @return {number}
+ */
+proto.agones.dev.sdk.GameServer.ObjectMeta.prototype.getDeletionTimestamp = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+};
+
+
+/** @param {number} value */
+proto.agones.dev.sdk.GameServer.ObjectMeta.prototype.setDeletionTimestamp = function(value) {
+  jspb.Message.setProto3IntField(this, 7, value);
+};
+
+
+/**
+ * map<string, string> annotations = 8;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
+ */
+proto.agones.dev.sdk.GameServer.ObjectMeta.prototype.getAnnotationsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 8, opt_noLazyCreate,
+      null));
+};
+
+
+proto.agones.dev.sdk.GameServer.ObjectMeta.prototype.clearAnnotationsMap = function() {
+  this.getAnnotationsMap().clear();
+};
+
+
+/**
+ * map<string, string> labels = 9;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
+ */
+proto.agones.dev.sdk.GameServer.ObjectMeta.prototype.getLabelsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 9, opt_noLazyCreate,
+      null));
+};
+
+
+proto.agones.dev.sdk.GameServer.ObjectMeta.prototype.clearLabelsMap = function() {
+  this.getLabelsMap().clear();
+};
+
+
+
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.agones.dev.sdk.GameServer.Spec = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.agones.dev.sdk.GameServer.Spec, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  proto.agones.dev.sdk.GameServer.Spec.displayName = 'proto.agones.dev.sdk.GameServer.Spec';
+}
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto suitable for use in Soy templates.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     com.google.apps.jspb.JsClassTemplate.JS_RESERVED_WORDS.
+ * @param {boolean=} opt_includeInstance Whether to include the JSPB instance
+ *     for transitional soy proto support: http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.agones.dev.sdk.GameServer.Spec.prototype.toObject = function(opt_includeInstance) {
+  return proto.agones.dev.sdk.GameServer.Spec.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Whether to include the JSPB
+ *     instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.agones.dev.sdk.GameServer.Spec} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.agones.dev.sdk.GameServer.Spec.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    health: (f = msg.getHealth()) && proto.agones.dev.sdk.GameServer.Spec.Health.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.agones.dev.sdk.GameServer.Spec}
+ */
+proto.agones.dev.sdk.GameServer.Spec.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.agones.dev.sdk.GameServer.Spec;
+  return proto.agones.dev.sdk.GameServer.Spec.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.agones.dev.sdk.GameServer.Spec} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.agones.dev.sdk.GameServer.Spec}
+ */
+proto.agones.dev.sdk.GameServer.Spec.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.agones.dev.sdk.GameServer.Spec.Health;
+      reader.readMessage(value,proto.agones.dev.sdk.GameServer.Spec.Health.deserializeBinaryFromReader);
+      msg.setHealth(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.agones.dev.sdk.GameServer.Spec.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.agones.dev.sdk.GameServer.Spec.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.agones.dev.sdk.GameServer.Spec} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.agones.dev.sdk.GameServer.Spec.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getHealth();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.agones.dev.sdk.GameServer.Spec.Health.serializeBinaryToWriter
+    );
+  }
+};
+
+
+
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.agones.dev.sdk.GameServer.Spec.Health = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.agones.dev.sdk.GameServer.Spec.Health, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  proto.agones.dev.sdk.GameServer.Spec.Health.displayName = 'proto.agones.dev.sdk.GameServer.Spec.Health';
+}
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto suitable for use in Soy templates.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     com.google.apps.jspb.JsClassTemplate.JS_RESERVED_WORDS.
+ * @param {boolean=} opt_includeInstance Whether to include the JSPB instance
+ *     for transitional soy proto support: http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.agones.dev.sdk.GameServer.Spec.Health.prototype.toObject = function(opt_includeInstance) {
+  return proto.agones.dev.sdk.GameServer.Spec.Health.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Whether to include the JSPB
+ *     instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.agones.dev.sdk.GameServer.Spec.Health} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.agones.dev.sdk.GameServer.Spec.Health.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    disabled: jspb.Message.getFieldWithDefault(msg, 1, false),
+    periodSeconds: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    failureThreshold: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    initialDelaySeconds: jspb.Message.getFieldWithDefault(msg, 4, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.agones.dev.sdk.GameServer.Spec.Health}
+ */
+proto.agones.dev.sdk.GameServer.Spec.Health.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.agones.dev.sdk.GameServer.Spec.Health;
+  return proto.agones.dev.sdk.GameServer.Spec.Health.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.agones.dev.sdk.GameServer.Spec.Health} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.agones.dev.sdk.GameServer.Spec.Health}
+ */
+proto.agones.dev.sdk.GameServer.Spec.Health.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setDisabled(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setPeriodSeconds(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setFailureThreshold(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setInitialDelaySeconds(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.agones.dev.sdk.GameServer.Spec.Health.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.agones.dev.sdk.GameServer.Spec.Health.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.agones.dev.sdk.GameServer.Spec.Health} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.agones.dev.sdk.GameServer.Spec.Health.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getDisabled();
+  if (f) {
+    writer.writeBool(
+      1,
+      f
+    );
+  }
+  f = message.getPeriodSeconds();
+  if (f !== 0) {
+    writer.writeInt32(
+      2,
+      f
+    );
+  }
+  f = message.getFailureThreshold();
+  if (f !== 0) {
+    writer.writeInt32(
+      3,
+      f
+    );
+  }
+  f = message.getInitialDelaySeconds();
+  if (f !== 0) {
+    writer.writeInt32(
+      4,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional bool disabled = 1;
+ * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
+ * You should avoid comparisons like {@code val === true/false} in those cases.
+ * @return {boolean}
+ */
+proto.agones.dev.sdk.GameServer.Spec.Health.prototype.getDisabled = function() {
+  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 1, false));
+};
+
+
+/** @param {boolean} value */
+proto.agones.dev.sdk.GameServer.Spec.Health.prototype.setDisabled = function(value) {
+  jspb.Message.setProto3BooleanField(this, 1, value);
+};
+
+
+/**
+ * optional int32 period_seconds = 2;
  * @return {number}
  */
-proto.agones.dev.sdk.GameServer.ObjectMeta.prototype.getGeneration = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+proto.agones.dev.sdk.GameServer.Spec.Health.prototype.getPeriodSeconds = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /** @param {number} value */
-proto.agones.dev.sdk.GameServer.ObjectMeta.prototype.setGeneration = function(value) {
-  jspb.Message.setProto3IntField(this, 5, value);
+proto.agones.dev.sdk.GameServer.Spec.Health.prototype.setPeriodSeconds = function(value) {
+  jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * optional int64 creation_timestamp = 6;
+ * optional int32 failure_threshold = 3;
  * @return {number}
  */
-proto.agones.dev.sdk.GameServer.ObjectMeta.prototype.getCreationTimestamp = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+proto.agones.dev.sdk.GameServer.Spec.Health.prototype.getFailureThreshold = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /** @param {number} value */
-proto.agones.dev.sdk.GameServer.ObjectMeta.prototype.setCreationTimestamp = function(value) {
-  jspb.Message.setProto3IntField(this, 6, value);
+proto.agones.dev.sdk.GameServer.Spec.Health.prototype.setFailureThreshold = function(value) {
+  jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
 /**
- * optional int64 deletion_timestamp = 7;
+ * optional int32 initial_delay_seconds = 4;
  * @return {number}
  */
-proto.agones.dev.sdk.GameServer.ObjectMeta.prototype.getDeletionTimestamp = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+proto.agones.dev.sdk.GameServer.Spec.Health.prototype.getInitialDelaySeconds = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
 
 
 /** @param {number} value */
-proto.agones.dev.sdk.GameServer.ObjectMeta.prototype.setDeletionTimestamp = function(value) {
-  jspb.Message.setProto3IntField(this, 7, value);
+proto.agones.dev.sdk.GameServer.Spec.Health.prototype.setInitialDelaySeconds = function(value) {
+  jspb.Message.setProto3IntField(this, 4, value);
 };
 
 
 /**
- * map<string, string> annotations = 8;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,string>}
+ * optional Health health = 1;
+ * @return {?proto.agones.dev.sdk.GameServer.Spec.Health}
  */
-proto.agones.dev.sdk.GameServer.ObjectMeta.prototype.getAnnotationsMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,string>} */ (
-      jspb.Message.getMapField(this, 8, opt_noLazyCreate,
-      null));
+proto.agones.dev.sdk.GameServer.Spec.prototype.getHealth = function() {
+  return /** @type{?proto.agones.dev.sdk.GameServer.Spec.Health} */ (
+    jspb.Message.getWrapperField(this, proto.agones.dev.sdk.GameServer.Spec.Health, 1));
 };
 
 
-proto.agones.dev.sdk.GameServer.ObjectMeta.prototype.clearAnnotationsMap = function() {
-  this.getAnnotationsMap().clear();
+/** @param {?proto.agones.dev.sdk.GameServer.Spec.Health|undefined} value */
+proto.agones.dev.sdk.GameServer.Spec.prototype.setHealth = function(value) {
+  jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+proto.agones.dev.sdk.GameServer.Spec.prototype.clearHealth = function() {
+  this.setHealth(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {!boolean}
+ */
+proto.agones.dev.sdk.GameServer.Spec.prototype.hasHealth = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.agones.dev.sdk.GameServer.Status = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.agones.dev.sdk.GameServer.Status.repeatedFields_, null);
+};
+goog.inherits(proto.agones.dev.sdk.GameServer.Status, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  proto.agones.dev.sdk.GameServer.Status.displayName = 'proto.agones.dev.sdk.GameServer.Status';
+}
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.agones.dev.sdk.GameServer.Status.repeatedFields_ = [3];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto suitable for use in Soy templates.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     com.google.apps.jspb.JsClassTemplate.JS_RESERVED_WORDS.
+ * @param {boolean=} opt_includeInstance Whether to include the JSPB instance
+ *     for transitional soy proto support: http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.agones.dev.sdk.GameServer.Status.prototype.toObject = function(opt_includeInstance) {
+  return proto.agones.dev.sdk.GameServer.Status.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Whether to include the JSPB
+ *     instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.agones.dev.sdk.GameServer.Status} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.agones.dev.sdk.GameServer.Status.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    state: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    address: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    portsList: jspb.Message.toObjectList(msg.getPortsList(),
+    proto.agones.dev.sdk.GameServer.Status.Port.toObject, includeInstance),
+    countersMap: (f = msg.getCountersMap()) ? f.toObject(includeInstance, proto.agones.dev.sdk.GameServer.Status.Counter.toObject) : [],
+    listsMap: (f = msg.getListsMap()) ? f.toObject(includeInstance, proto.agones.dev.sdk.GameServer.Status.List.toObject) : [],
+    disruption: (f = msg.getDisruption()) && proto.agones.dev.sdk.GameServer.Status.Disruption.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.agones.dev.sdk.GameServer.Status}
+ */
+proto.agones.dev.sdk.GameServer.Status.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.agones.dev.sdk.GameServer.Status;
+  return proto.agones.dev.sdk.GameServer.Status.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.agones.dev.sdk.GameServer.Status} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.agones.dev.sdk.GameServer.Status}
+ */
+proto.agones.dev.sdk.GameServer.Status.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setState(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setAddress(value);
+      break;
+    case 3:
+      var value = new proto.agones.dev.sdk.GameServer.Status.Port;
+      reader.readMessage(value,proto.agones.dev.sdk.GameServer.Status.Port.deserializeBinaryFromReader);
+      msg.addPorts(value);
+      break;
+    case 4:
+      var value = msg.getCountersMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.agones.dev.sdk.GameServer.Status.Counter.deserializeBinaryFromReader, "");
+         });
+      break;
+    case 5:
+      var value = msg.getListsMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.agones.dev.sdk.GameServer.Status.List.deserializeBinaryFromReader, "");
+         });
+      break;
+    case 6:
+      var value = new proto.agones.dev.sdk.GameServer.Status.Disruption;
+      reader.readMessage(value,proto.agones.dev.sdk.GameServer.Status.Disruption.deserializeBinaryFromReader);
+      msg.setDisruption(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.agones.dev.sdk.GameServer.Status.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.agones.dev.sdk.GameServer.Status.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * map<string, string> labels = 9;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,string>}
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.agones.dev.sdk.GameServer.Status} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.agones.dev.sdk.GameServer.ObjectMeta.prototype.getLabelsMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,string>} */ (
-      jspb.Message.getMapField(this, 9, opt_noLazyCreate,
-      null));
-};
-
-
-proto.agones.dev.sdk.GameServer.ObjectMeta.prototype.clearLabelsMap = function() {
-  this.getLabelsMap().clear();
+proto.agones.dev.sdk.GameServer.Status.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getState();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getAddress();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getPortsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      3,
+      f,
+      proto.agones.dev.sdk.GameServer.Status.Port.serializeBinaryToWriter
+    );
+  }
+  f = message.getCountersMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(4, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.agones.dev.sdk.GameServer.Status.Counter.serializeBinaryToWriter);
+  }
+  f = message.getListsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(5, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.agones.dev.sdk.GameServer.Status.List.serializeBinaryToWriter);
+  }
+  f = message.getDisruption();
+  if (f != null) {
+    writer.writeMessage(
+      6,
+      f,
+      proto.agones.dev.sdk.GameServer.Status.Disruption.serializeBinaryToWriter
+    );
+  }
 };
 
 
@@ -993,12 +1917,12 @@ proto.agones.dev.sdk.GameServer.ObjectMeta.prototype.clearLabelsMap = function()
  * @extends {jspb.Message}
  * @constructor
  */
-proto.agones.dev.sdk.GameServer.Spec = function(opt_data) {
+proto.agones.dev.sdk.GameServer.Status.Port = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.agones.dev.sdk.GameServer.Spec, jspb.Message);
+goog.inherits(proto.agones.dev.sdk.GameServer.Status.Port, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
-  proto.agones.dev.sdk.GameServer.Spec.displayName = 'proto.agones.dev.sdk.GameServer.Spec';
+  proto.agones.dev.sdk.GameServer.Status.Port.displayName = 'proto.agones.dev.sdk.GameServer.Status.Port';
 }
 
 
@@ -1013,8 +1937,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     for transitional soy proto support: http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.agones.dev.sdk.GameServer.Spec.prototype.toObject = function(opt_includeInstance) {
-  return proto.agones.dev.sdk.GameServer.Spec.toObject(opt_includeInstance, this);
+proto.agones.dev.sdk.GameServer.Status.Port.prototype.toObject = function(opt_includeInstance) {
+  return proto.agones.dev.sdk.GameServer.Status.Port.toObject(opt_includeInstance, this);
 };
 
 
@@ -1023,13 +1947,14 @@ proto.agones.dev.sdk.GameServer.Spec.prototype.toObject = function(opt_includeIn
  * @param {boolean|undefined} includeInstance Whether to include the JSPB
  *     instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.agones.dev.sdk.GameServer.Spec} msg The msg instance to transform.
+ * @param {!proto.agones.dev.sdk.GameServer.Status.Port} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.agones.dev.sdk.GameServer.Spec.toObject = function(includeInstance, msg) {
+proto.agones.dev.sdk.GameServer.Status.Port.toObject = function(includeInstance, msg) {
   var f, obj = {
-    health: (f = msg.getHealth()) && proto.agones.dev.sdk.GameServer.Spec.Health.toObject(includeInstance, f)
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    port: jspb.Message.getFieldWithDefault(msg, 2, 0)
   };
 
   if (includeInstance) {
@@ -1043,23 +1968,23 @@ proto.agones.dev.sdk.GameServer.Spec.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.agones.dev.sdk.GameServer.Spec}
+ * @return {!proto.agones.dev.sdk.GameServer.Status.Port}
  */
-proto.agones.dev.sdk.GameServer.Spec.deserializeBinary = function(bytes) {
+proto.agones.dev.sdk.GameServer.Status.Port.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.agones.dev.sdk.GameServer.Spec;
-  return proto.agones.dev.sdk.GameServer.Spec.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.agones.dev.sdk.GameServer.Status.Port;
+  return proto.agones.dev.sdk.GameServer.Status.Port.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.agones.dev.sdk.GameServer.Spec} msg The message object to deserialize into.
+ * @param {!proto.agones.dev.sdk.GameServer.Status.Port} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.agones.dev.sdk.GameServer.Spec}
+ * @return {!proto.agones.dev.sdk.GameServer.Status.Port}
  */
-proto.agones.dev.sdk.GameServer.Spec.deserializeBinaryFromReader = function(msg, reader) {
+proto.agones.dev.sdk.GameServer.Status.Port.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1067,9 +1992,12 @@ proto.agones.dev.sdk.GameServer.Spec.deserializeBinaryFromReader = function(msg,
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.agones.dev.sdk.GameServer.Spec.Health;
-      reader.readMessage(value,proto.agones.dev.sdk.GameServer.Spec.Health.deserializeBinaryFromReader);
-      msg.setHealth(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setPort(value);
       break;
     default:
       reader.skipField();
@@ -1084,9 +2012,9 @@ proto.agones.dev.sdk.GameServer.Spec.deserializeBinaryFromReader = function(msg,
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.agones.dev.sdk.GameServer.Spec.prototype.serializeBinary = function() {
+proto.agones.dev.sdk.GameServer.Status.Port.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.agones.dev.sdk.GameServer.Spec.serializeBinaryToWriter(this, writer);
+  proto.agones.dev.sdk.GameServer.Status.Port.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1094,23 +2022,59 @@ proto.agones.dev.sdk.GameServer.Spec.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.agones.dev.sdk.GameServer.Spec} message
+ * @param {!proto.agones.dev.sdk.GameServer.Status.Port} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.agones.dev.sdk.GameServer.Spec.serializeBinaryToWriter = function(message, writer) {
+proto.agones.dev.sdk.GameServer.Status.Port.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getHealth();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
       1,
-      f,
-      proto.agones.dev.sdk.GameServer.Spec.Health.serializeBinaryToWriter
+      f
+    );
+  }
+  f = message.getPort();
+  if (f !== 0) {
+    writer.writeInt32(
+      2,
+      f
     );
   }
 };
 
 
+/**
+ * optional string name = 1;
+ * @return {string}
+ */
+proto.agones.dev.sdk.GameServer.Status.Port.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/** @param {string} value */
+proto.agones.dev.sdk.GameServer.Status.Port.prototype.setName = function(value) {
+  jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional int32 port = 2;
+ * @return {number}
+ */
+proto.agones.dev.sdk.GameServer.Status.Port.prototype.getPort = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/** @param {number} value */
+proto.agones.dev.sdk.GameServer.Status.Port.prototype.setPort = function(value) {
+  jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
 
 /**
  * Generated by JsPbCodeGenerator.
@@ -1122,12 +2086,12 @@ proto.agones.dev.sdk.GameServer.Spec.serializeBinaryToWriter = function(message,
  * @extends {jspb.Message}
  * @constructor
  */
-proto.agones.dev.sdk.GameServer.Spec.Health = function(opt_data) {
+proto.agones.dev.sdk.GameServer.Status.Counter = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.agones.dev.sdk.GameServer.Spec.Health, jspb.Message);
+goog.inherits(proto.agones.dev.sdk.GameServer.Status.Counter, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
-  proto.agones.dev.sdk.GameServer.Spec.Health.displayName = 'proto.agones.dev.sdk.GameServer.Spec.Health';
+  proto.agones.dev.sdk.GameServer.Status.Counter.displayName = 'proto.agones.dev.sdk.GameServer.Status.Counter';
 }
 
 
@@ -1142,8 +2106,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     for transitional soy proto support: http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.agones.dev.sdk.GameServer.Spec.Health.prototype.toObject = function(opt_includeInstance) {
-  return proto.agones.dev.sdk.GameServer.Spec.Health.toObject(opt_includeInstance, this);
+proto.agones.dev.sdk.GameServer.Status.Counter.prototype.toObject = function(opt_includeInstance) {
+  return proto.agones.dev.sdk.GameServer.Status.Counter.toObject(opt_includeInstance, this);
 };
 
 
@@ -1152,16 +2116,14 @@ proto.agones.dev.sdk.GameServer.Spec.Health.prototype.toObject = function(opt_in
  * @param {boolean|undefined} includeInstance Whether to include the JSPB
  *     instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.agones.dev.sdk.GameServer.Spec.Health} msg The msg instance to transform.
+ * @param {!proto.agones.dev.sdk.GameServer.Status.Counter} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.agones.dev.sdk.GameServer.Spec.Health.toObject = function(includeInstance, msg) {
+proto.agones.dev.sdk.GameServer.Status.Counter.toObject = function(includeInstance, msg) {
   var f, obj = {
-    disabled: jspb.Message.getFieldWithDefault(msg, 1, false),
-    periodSeconds: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    failureThreshold: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    initialDelaySeconds: jspb.Message.getFieldWithDefault(msg, 4, 0)
+    count: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    capacity: jspb.Message.getFieldWithDefault(msg, 2, 0)
   };
 
   if (includeInstance) {
@@ -1175,23 +2137,23 @@ proto.agones.dev.sdk.GameServer.Spec.Health.toObject = function(includeInstance,
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.agones.dev.sdk.GameServer.Spec.Health}
+ * @return {!proto.agones.dev.sdk.GameServer.Status.Counter}
  */
-proto.agones.dev.sdk.GameServer.Spec.Health.deserializeBinary = function(bytes) {
+proto.agones.dev.sdk.GameServer.Status.Counter.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.agones.dev.sdk.GameServer.Spec.Health;
-  return proto.agones.dev.sdk.GameServer.Spec.Health.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.agones.dev.sdk.GameServer.Status.Counter;
+  return proto.agones.dev.sdk.GameServer.Status.Counter.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.agones.dev.sdk.GameServer.Spec.Health} msg The message object to deserialize into.
+ * @param {!proto.agones.dev.sdk.GameServer.Status.Counter} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.agones.dev.sdk.GameServer.Spec.Health}
+ * @return {!proto.agones.dev.sdk.GameServer.Status.Counter}
  */
-proto.agones.dev.sdk.GameServer.Spec.Health.deserializeBinaryFromReader = function(msg, reader) {
+proto.agones.dev.sdk.GameServer.Status.Counter.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1199,170 +2161,86 @@ proto.agones.dev.sdk.GameServer.Spec.Health.deserializeBinaryFromReader = functi
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setDisabled(value);
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setCount(value);
       break;
     case 2:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setPeriodSeconds(value);
-      break;
-    case 3:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setFailureThreshold(value);
-      break;
-    case 4:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setInitialDelaySeconds(value);
-      break;
-    default:
-      reader.skipField();
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setCapacity(value);
       break;
-    }
-  }
-  return msg;
-};
-
-
-/**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
- */
-proto.agones.dev.sdk.GameServer.Spec.Health.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.agones.dev.sdk.GameServer.Spec.Health.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
-};
-
-
-/**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.agones.dev.sdk.GameServer.Spec.Health} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
- */
-proto.agones.dev.sdk.GameServer.Spec.Health.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getDisabled();
-  if (f) {
-    writer.writeBool(
-      1,
-      f
-    );
-  }
-  f = message.getPeriodSeconds();
-  if (f !== 0) {
-    writer.writeInt32(
-      2,
-      f
-    );
-  }
-  f = message.getFailureThreshold();
-  if (f !== 0) {
-    writer.writeInt32(
-      3,
-      f
-    );
-  }
-  f = message.getInitialDelaySeconds();
-  if (f !== 0) {
-    writer.writeInt32(
-      4,
-      f
-    );
-  }
-};
-
-
-/**
- * optional bool disabled = 1;
- * Note that Boolean fields may be set to 0/1 when serialized from a Java server.
- * You should avoid comparisons like {@code val === true/false} in those cases.
- * @return {boolean}
- */
-proto.agones.dev.sdk.GameServer.Spec.Health.prototype.getDisabled = function() {
-  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 1, false));
-};
-
-
-/** @param {boolean} value */
-proto.agones.dev.sdk.GameServer.Spec.Health.prototype.setDisabled = function(value) {
-  jspb.Message.setProto3BooleanField(this, 1, value);
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * optional int32 period_seconds = 2;
- * @return {number}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.agones.dev.sdk.GameServer.Spec.Health.prototype.getPeriodSeconds = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
-};
-
-
-/** @param {number} value */
-proto.agones.dev.sdk.GameServer.Spec.Health.prototype.setPeriodSeconds = function(value) {
-  jspb.Message.setProto3IntField(this, 2, value);
+proto.agones.dev.sdk.GameServer.Status.Counter.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.agones.dev.sdk.GameServer.Status.Counter.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * optional int32 failure_threshold = 3;
- * @return {number}
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.agones.dev.sdk.GameServer.Status.Counter} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.agones.dev.sdk.GameServer.Spec.Health.prototype.getFailureThreshold = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
-};
-
-
-/** @param {number} value */
-proto.agones.dev.sdk.GameServer.Spec.Health.prototype.setFailureThreshold = function(value) {
-  jspb.Message.setProto3IntField(this, 3, value);
+proto.agones.dev.sdk.GameServer.Status.Counter.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getCount();
+  if (f !== 0) {
+    writer.writeInt64(
+      1,
+      f
+    );
+  }
+  f = message.getCapacity();
+  if (f !== 0) {
+    writer.writeInt64(
+      2,
+      f
+    );
+  }
 };
 
 
 /**
- * optional int32 initial_delay_seconds = 4;
+ * optional int64 count = 1;
  * @return {number}
  */
-proto.agones.dev.sdk.GameServer.Spec.Health.prototype.getInitialDelaySeconds = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+proto.agones.dev.sdk.GameServer.Status.Counter.prototype.getCount = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /** @param {number} value */
-proto.agones.dev.sdk.GameServer.Spec.Health.prototype.setInitialDelaySeconds = function(value) {
-  jspb.Message.setProto3IntField(this, 4, value);
+proto.agones.dev.sdk.GameServer.Status.Counter.prototype.setCount = function(value) {
+  jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional Health health = 1;
- * @return {?proto.agones.dev.sdk.GameServer.Spec.Health}
+ * optional int64 capacity = 2;
+ * @return {number}
  */
-proto.agones.dev.sdk.GameServer.Spec.prototype.getHealth = function() {
-  return /** @type{?proto.agones.dev.sdk.GameServer.Spec.Health} */ (
-    jspb.Message.getWrapperField(this, proto.agones.dev.sdk.GameServer.Spec.Health, 1));
-};
-
-
-/** @param {?proto.agones.dev.sdk.GameServer.Spec.Health|undefined} value */
-proto.agones.dev.sdk.GameServer.Spec.prototype.setHealth = function(value) {
-  jspb.Message.setWrapperField(this, 1, value);
-};
-
-
-proto.agones.dev.sdk.GameServer.Spec.prototype.clearHealth = function() {
-  this.setHealth(undefined);
+proto.agones.dev.sdk.GameServer.Status.Counter.prototype.getCapacity = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
-/**
- * Returns whether this field is set.
- * @return {!boolean}
- */
-proto.agones.dev.sdk.GameServer.Spec.prototype.hasHealth = function() {
-  return jspb.Message.getField(this, 1) != null;
+/** @param {number} value */
+proto.agones.dev.sdk.GameServer.Status.Counter.prototype.setCapacity = function(value) {
+  jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
@@ -1377,19 +2255,19 @@ proto.agones.dev.sdk.GameServer.Spec.prototype.hasHealth = function() {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.agones.dev.sdk.GameServer.Status = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.agones.dev.sdk.GameServer.Status.repeatedFields_, null);
+proto.agones.dev.sdk.GameServer.Status.List = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.agones.dev.sdk.GameServer.Status.List.repeatedFields_, null);
 };
-goog.inherits(proto.agones.dev.sdk.GameServer.Status, jspb.Message);
+goog.inherits(proto.agones.dev.sdk.GameServer.Status.List, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
-  proto.agones.dev.sdk.GameServer.Status.displayName = 'proto.agones.dev.sdk.GameServer.Status';
+  proto.agones.dev.sdk.GameServer.Status.List.displayName = 'proto.agones.dev.sdk.GameServer.Status.List';
 }
 /**
  * List of repeated fields within this message type.
  * @private {!Array<number>}
  * @const
  */
-proto.agones.dev.sdk.GameServer.Status.repeatedFields_ = [3];
+proto.agones.dev.sdk.GameServer.Status.List.repeatedFields_ = [2];
 
 
 
@@ -1404,8 +2282,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     for transitional soy proto support: http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.agones.dev.sdk.GameServer.Status.prototype.toObject = function(opt_includeInstance) {
-  return proto.agones.dev.sdk.GameServer.Status.toObject(opt_includeInstance, this);
+proto.agones.dev.sdk.GameServer.Status.List.prototype.toObject = function(opt_includeInstance) {
+  return proto.agones.dev.sdk.GameServer.Status.List.toObject(opt_includeInstance, this);
 };
 
 
@@ -1414,16 +2292,14 @@ proto.agones.dev.sdk.GameServer.Status.prototype.toObject = function(opt_include
  * @param {boolean|undefined} includeInstance Whether to include the JSPB
  *     instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.agones.dev.sdk.GameServer.Status} msg The msg instance to transform.
+ * @param {!proto.agones.dev.sdk.GameServer.Status.List} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.agones.dev.sdk.GameServer.Status.toObject = function(includeInstance, msg) {
+proto.agones.dev.sdk.GameServer.Status.List.toObject = function(includeInstance, msg) {
   var f, obj = {
-    state: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    address: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    portsList: jspb.Message.toObjectList(msg.getPortsList(),
-    proto.agones.dev.sdk.GameServer.Status.Port.toObject, includeInstance)
+    capacity: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    valuesList: jspb.Message.getRepeatedField(msg, 2)
   };
 
   if (includeInstance) {
@@ -1437,23 +2313,23 @@ proto.agones.dev.sdk.GameServer.Status.toObject = function(includeInstance, msg)
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.agones.dev.sdk.GameServer.Status}
+ * @return {!proto.agones.dev.sdk.GameServer.Status.List}
  */
-proto.agones.dev.sdk.GameServer.Status.deserializeBinary = function(bytes) {
+proto.agones.dev.sdk.GameServer.Status.List.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.agones.dev.sdk.GameServer.Status;
-  return proto.agones.dev.sdk.GameServer.Status.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.agones.dev.sdk.GameServer.Status.List;
+  return proto.agones.dev.sdk.GameServer.Status.List.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.agones.dev.sdk.GameServer.Status} msg The message object to deserialize into.
+ * @param {!proto.agones.dev.sdk.GameServer.Status.List} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.agones.dev.sdk.GameServer.Status}
+ * @return {!proto.agones.dev.sdk.GameServer.Status.List}
  */
-proto.agones.dev.sdk.GameServer.Status.deserializeBinaryFromReader = function(msg, reader) {
+proto.agones.dev.sdk.GameServer.Status.List.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1461,17 +2337,12 @@ proto.agones.dev.sdk.GameServer.Status.deserializeBinaryFromReader = function(ms
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setState(value);
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setCapacity(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setAddress(value);
-      break;
-    case 3:
-      var value = new proto.agones.dev.sdk.GameServer.Status.Port;
-      reader.readMessage(value,proto.agones.dev.sdk.GameServer.Status.Port.deserializeBinaryFromReader);
-      msg.addPorts(value);
+      msg.addValues(value);
       break;
     default:
       reader.skipField();
@@ -1486,9 +2357,9 @@ proto.agones.dev.sdk.GameServer.Status.deserializeBinaryFromReader = function(ms
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.agones.dev.sdk.GameServer.Status.prototype.serializeBinary = function() {
+proto.agones.dev.sdk.GameServer.Status.List.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.agones.dev.sdk.GameServer.Status.serializeBinaryToWriter(this, writer);
+  proto.agones.dev.sdk.GameServer.Status.List.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1496,34 +2367,70 @@ proto.agones.dev.sdk.GameServer.Status.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.agones.dev.sdk.GameServer.Status} message
+ * @param {!proto.agones.dev.sdk.GameServer.Status.List} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.agones.dev.sdk.GameServer.Status.serializeBinaryToWriter = function(message, writer) {
+proto.agones.dev.sdk.GameServer.Status.List.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getState();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getCapacity();
+  if (f !== 0) {
+    writer.writeInt64(
       1,
       f
     );
   }
-  f = message.getAddress();
+  f = message.getValuesList();
   if (f.length > 0) {
-    writer.writeString(
+    writer.writeRepeatedString(
       2,
       f
     );
   }
-  f = message.getPortsList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      3,
-      f,
-      proto.agones.dev.sdk.GameServer.Status.Port.serializeBinaryToWriter
-    );
-  }
+};
+
+
+/**
+ * optional int64 capacity = 1;
+ * @return {number}
+ */
+proto.agones.dev.sdk.GameServer.Status.List.prototype.getCapacity = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/** @param {number} value */
+proto.agones.dev.sdk.GameServer.Status.List.prototype.setCapacity = function(value) {
+  jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * repeated string values = 2;
+ * @return {!Array<string>}
+ */
+proto.agones.dev.sdk.GameServer.Status.List.prototype.getValuesList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 2));
+};
+
+
+/** @param {!Array<string>} value */
+proto.agones.dev.sdk.GameServer.Status.List.prototype.setValuesList = function(value) {
+  jspb.Message.setField(this, 2, value || []);
+};
+
+
+/**
+ * @param {!string} value
+ * @param {number=} opt_index
+ */
+proto.agones.dev.sdk.GameServer.Status.List.prototype.addValues = function(value, opt_index) {
+  jspb.Message.addToRepeatedField(this, 2, value, opt_index);
+};
+
+
+proto.agones.dev.sdk.GameServer.Status.List.prototype.clearValuesList = function() {
+  this.setValuesList([]);
 };
 
 
@@ -1538,12 +2445,12 @@ proto.agones.dev.sdk.GameServer.Status.serializeBinaryToWriter = function(messag
  * @extends {jspb.Message}
  * @constructor
  */
-proto.agones.dev.sdk.GameServer.Status.Port = function(opt_data) {
+proto.agones.dev.sdk.GameServer.Status.Disruption = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.agones.dev.sdk.GameServer.Status.Port, jspb.Message);
+goog.inherits(proto.agones.dev.sdk.GameServer.Status.Disruption, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
-  proto.agones.dev.sdk.GameServer.Status.Port.displayName = 'proto.agones.dev.sdk.GameServer.Status.Port';
+  proto.agones.dev.sdk.GameServer.Status.Disruption.displayName = 'proto.agones.dev.sdk.GameServer.Status.Disruption';
 }
 
 
@@ -1558,8 +2465,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     for transitional soy proto support: http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.agones.dev.sdk.GameServer.Status.Port.prototype.toObject = function(opt_includeInstance) {
-  return proto.agones.dev.sdk.GameServer.Status.Port.toObject(opt_includeInstance, this);
+proto.agones.dev.sdk.GameServer.Status.Disruption.prototype.toObject = function(opt_includeInstance) {
+  return proto.agones.dev.sdk.GameServer.Status.Disruption.toObject(opt_includeInstance, this);
 };
 
 
@@ -1568,14 +2475,15 @@ proto.agones.dev.sdk.GameServer.Status.Port.prototype.toObject = function(opt_in
  * @param {boolean|undefined} includeInstance Whether to include the JSPB
  *     instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.agones.dev.sdk.GameServer.Status.Port} msg The msg instance to transform.
+ * @param {!proto.agones.dev.sdk.GameServer.Status.Disruption} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.agones.dev.sdk.GameServer.Status.Port.toObject = function(includeInstance, msg) {
+proto.agones.dev.sdk.GameServer.Status.Disruption.toObject = function(includeInstance, msg) {
   var f, obj = {
-    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    port: jspb.Message.getFieldWithDefault(msg, 2, 0)
+    reason: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    message: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    since: jspb.Message.getFieldWithDefault(msg, 3, 0)
   };
 
   if (includeInstance) {
@@ -1589,23 +2497,23 @@ proto.agones.dev.sdk.GameServer.Status.Port.toObject = function(includeInstance,
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.agones.dev.sdk.GameServer.Status.Port}
+ * @return {!proto.agones.dev.sdk.GameServer.Status.Disruption}
  */
-proto.agones.dev.sdk.GameServer.Status.Port.deserializeBinary = function(bytes) {
+proto.agones.dev.sdk.GameServer.Status.Disruption.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.agones.dev.sdk.GameServer.Status.Port;
-  return proto.agones.dev.sdk.GameServer.Status.Port.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.agones.dev.sdk.GameServer.Status.Disruption;
+  return proto.agones.dev.sdk.GameServer.Status.Disruption.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.agones.dev.sdk.GameServer.Status.Port} msg The message object to deserialize into.
+ * @param {!proto.agones.dev.sdk.GameServer.Status.Disruption} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.agones.dev.sdk.GameServer.Status.Port}
+ * @return {!proto.agones.dev.sdk.GameServer.Status.Disruption}
  */
-proto.agones.dev.sdk.GameServer.Status.Port.deserializeBinaryFromReader = function(msg, reader) {
+proto.agones.dev.sdk.GameServer.Status.Disruption.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1614,11 +2522,15 @@ proto.agones.dev.sdk.GameServer.Status.Port.deserializeBinaryFromReader = functi
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
+      msg.setReason(value);
       break;
     case 2:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setPort(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMessage(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setSince(value);
       break;
     default:
       reader.skipField();
@@ -1633,9 +2545,9 @@ proto.agones.dev.sdk.GameServer.Status.Port.deserializeBinaryFromReader = functi
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.agones.dev.sdk.GameServer.Status.Port.prototype.serializeBinary = function() {
+proto.agones.dev.sdk.GameServer.Status.Disruption.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.agones.dev.sdk.GameServer.Status.Port.serializeBinaryToWriter(this, writer);
+  proto.agones.dev.sdk.GameServer.Status.Disruption.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1643,59 +2555,80 @@ proto.agones.dev.sdk.GameServer.Status.Port.prototype.serializeBinary = function
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.agones.dev.sdk.GameServer.Status.Port} message
+ * @param {!proto.agones.dev.sdk.GameServer.Status.Disruption} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.agones.dev.sdk.GameServer.Status.Port.serializeBinaryToWriter = function(message, writer) {
+proto.agones.dev.sdk.GameServer.Status.Disruption.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getName();
+  f = message.getReason();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getPort();
-  if (f !== 0) {
-    writer.writeInt32(
+  f = message.getMessage();
+  if (f.length > 0) {
+    writer.writeString(
       2,
       f
     );
   }
+  f = message.getSince();
+  if (f !== 0) {
+    writer.writeInt64(
+      3,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string name = 1;
+ * optional string reason = 1;
  * @return {string}
  */
-proto.agones.dev.sdk.GameServer.Status.Port.prototype.getName = function() {
+proto.agones.dev.sdk.GameServer.Status.Disruption.prototype.getReason = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /** @param {string} value */
-proto.agones.dev.sdk.GameServer.Status.Port.prototype.setName = function(value) {
+proto.agones.dev.sdk.GameServer.Status.Disruption.prototype.setReason = function(value) {
   jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional int32 port = 2;
+ * optional string message = 2;
+ * @return {string}
+ */
+proto.agones.dev.sdk.GameServer.Status.Disruption.prototype.getMessage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/** @param {string} value */
+proto.agones.dev.sdk.GameServer.Status.Disruption.prototype.setMessage = function(value) {
+  jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional int64 since = 3;
  * @return {number}
  */
-proto.agones.dev.sdk.GameServer.Status.Port.prototype.getPort = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+proto.agones.dev.sdk.GameServer.Status.Disruption.prototype.getSince = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /** @param {number} value */
-proto.agones.dev.sdk.GameServer.Status.Port.prototype.setPort = function(value) {
-  jspb.Message.setProto3IntField(this, 2, value);
+proto.agones.dev.sdk.GameServer.Status.Disruption.prototype.setSince = function(value) {
+  jspb.Message.setProto3IntField(this, 3, value);
 };
 
-
 /**
  * optional string state = 1;
  * @return {string}
@@ -1757,6 +2690,72 @@ proto.agones.dev.sdk.GameServer.Status.prototype.clearPortsList = function() {
 };
 
 
+/**
+ * map<string, Counter> counters = 4;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,!proto.agones.dev.sdk.GameServer.Status.Counter>}
+ */
+proto.agones.dev.sdk.GameServer.Status.prototype.getCountersMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,!proto.agones.dev.sdk.GameServer.Status.Counter>} */ (
+      jspb.Message.getMapField(this, 4, opt_noLazyCreate,
+      proto.agones.dev.sdk.GameServer.Status.Counter));
+};
+
+
+proto.agones.dev.sdk.GameServer.Status.prototype.clearCountersMap = function() {
+  this.getCountersMap().clear();
+};
+
+
+/**
+ * map<string, List> lists = 5;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,!proto.agones.dev.sdk.GameServer.Status.List>}
+ */
+proto.agones.dev.sdk.GameServer.Status.prototype.getListsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,!proto.agones.dev.sdk.GameServer.Status.List>} */ (
+      jspb.Message.getMapField(this, 5, opt_noLazyCreate,
+      proto.agones.dev.sdk.GameServer.Status.List));
+};
+
+
+proto.agones.dev.sdk.GameServer.Status.prototype.clearListsMap = function() {
+  this.getListsMap().clear();
+};
+
+
+/**
+ * optional Disruption disruption = 6;
+ * @return {?proto.agones.dev.sdk.GameServer.Status.Disruption}
+ */
+proto.agones.dev.sdk.GameServer.Status.prototype.getDisruption = function() {
+  return /** @type{?proto.agones.dev.sdk.GameServer.Status.Disruption} */ (
+    jspb.Message.getWrapperField(this, proto.agones.dev.sdk.GameServer.Status.Disruption, 6));
+};
+
+
+/** @param {?proto.agones.dev.sdk.GameServer.Status.Disruption|undefined} value */
+proto.agones.dev.sdk.GameServer.Status.prototype.setDisruption = function(value) {
+  jspb.Message.setWrapperField(this, 6, value);
+};
+
+
+proto.agones.dev.sdk.GameServer.Status.prototype.clearDisruption = function() {
+  this.setDisruption(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {!boolean}
+ */
+proto.agones.dev.sdk.GameServer.Status.prototype.hasDisruption = function() {
+  return jspb.Message.getField(this, 6) != null;
+};
+
+
 /**
  * optional ObjectMeta object_meta = 1;
  * @return {?proto.agones.dev.sdk.GameServer.ObjectMeta}
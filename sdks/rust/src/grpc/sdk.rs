@@ -518,138 +518,64 @@ impl ::protobuf::reflect::ProtobufValue for Duration {
 }
 
 #[derive(PartialEq,Clone,Default)]
-pub struct GameServer {
+pub struct CounterUpdate {
     // message fields
-    pub object_meta: ::protobuf::SingularPtrField<GameServer_ObjectMeta>,
-    pub spec: ::protobuf::SingularPtrField<GameServer_Spec>,
-    pub status: ::protobuf::SingularPtrField<GameServer_Status>,
+    pub name: ::std::string::String,
+    pub amount: i64,
     // special fields
     unknown_fields: ::protobuf::UnknownFields,
     cached_size: ::protobuf::CachedSize,
 }
 
-impl GameServer {
-    pub fn new() -> GameServer {
+impl CounterUpdate {
+    pub fn new() -> CounterUpdate {
         ::std::default::Default::default()
     }
 
-    // .agones.dev.sdk.GameServer.ObjectMeta object_meta = 1;
-
-    pub fn clear_object_meta(&mut self) {
-        self.object_meta.clear();
-    }
-
-    pub fn has_object_meta(&self) -> bool {
-        self.object_meta.is_some()
-    }
-
-    // Param is passed by value, moved
-    pub fn set_object_meta(&mut self, v: GameServer_ObjectMeta) {
-        self.object_meta = ::protobuf::SingularPtrField::some(v);
-    }
-
-    // Mutable pointer to the field.
-    // If field is not initialized, it is initialized with default value first.
-    pub fn mut_object_meta(&mut self) -> &mut GameServer_ObjectMeta {
-        if self.object_meta.is_none() {
-            self.object_meta.set_default();
-        }
-        self.object_meta.as_mut().unwrap()
-    }
-
-    // Take field
-    pub fn take_object_meta(&mut self) -> GameServer_ObjectMeta {
-        self.object_meta.take().unwrap_or_else(|| GameServer_ObjectMeta::new())
-    }
-
-    pub fn get_object_meta(&self) -> &GameServer_ObjectMeta {
-        self.object_meta.as_ref().unwrap_or_else(|| GameServer_ObjectMeta::default_instance())
-    }
-
-    // .agones.dev.sdk.GameServer.Spec spec = 2;
-
-    pub fn clear_spec(&mut self) {
-        self.spec.clear();
-    }
+    // string name = 1;
 
-    pub fn has_spec(&self) -> bool {
-        self.spec.is_some()
+    pub fn clear_name(&mut self) {
+        self.name.clear();
     }
 
     // Param is passed by value, moved
-    pub fn set_spec(&mut self, v: GameServer_Spec) {
-        self.spec = ::protobuf::SingularPtrField::some(v);
+    pub fn set_name(&mut self, v: ::std::string::String) {
+        self.name = v;
     }
 
     // Mutable pointer to the field.
     // If field is not initialized, it is initialized with default value first.
-    pub fn mut_spec(&mut self) -> &mut GameServer_Spec {
-        if self.spec.is_none() {
-            self.spec.set_default();
-        }
-        self.spec.as_mut().unwrap()
+    pub fn mut_name(&mut self) -> &mut ::std::string::String {
+        &mut self.name
     }
 
     // Take field
-    pub fn take_spec(&mut self) -> GameServer_Spec {
-        self.spec.take().unwrap_or_else(|| GameServer_Spec::new())
+    pub fn take_name(&mut self) -> ::std::string::String {
+        ::std::mem::replace(&mut self.name, ::std::string::String::new())
     }
 
-    pub fn get_spec(&self) -> &GameServer_Spec {
-        self.spec.as_ref().unwrap_or_else(|| GameServer_Spec::default_instance())
+    pub fn get_name(&self) -> &str {
+        &self.name
     }
 
-    // .agones.dev.sdk.GameServer.Status status = 3;
-
-    pub fn clear_status(&mut self) {
-        self.status.clear();
-    }
+    // int64 amount = 2;
 
-    pub fn has_status(&self) -> bool {
-        self.status.is_some()
+    pub fn clear_amount(&mut self) {
+        self.amount = 0;
     }
 
     // Param is passed by value, moved
-    pub fn set_status(&mut self, v: GameServer_Status) {
-        self.status = ::protobuf::SingularPtrField::some(v);
-    }
-
-    // Mutable pointer to the field.
-    // If field is not initialized, it is initialized with default value first.
-    pub fn mut_status(&mut self) -> &mut GameServer_Status {
-        if self.status.is_none() {
-            self.status.set_default();
-        }
-        self.status.as_mut().unwrap()
-    }
-
-    // Take field
-    pub fn take_status(&mut self) -> GameServer_Status {
-        self.status.take().unwrap_or_else(|| GameServer_Status::new())
+    pub fn set_amount(&mut self, v: i64) {
+        self.amount = v;
     }
 
-    pub fn get_status(&self) -> &GameServer_Status {
-        self.status.as_ref().unwrap_or_else(|| GameServer_Status::default_instance())
+    pub fn get_amount(&self) -> i64 {
+        self.amount
     }
 }
 
-impl ::protobuf::Message for GameServer {
+impl ::protobuf::Message for CounterUpdate {
     fn is_initialized(&self) -> bool {
-        for v in &self.object_meta {
-            if !v.is_initialized() {
-                return false;
-            }
-        };
-        for v in &self.spec {
-            if !v.is_initialized() {
-                return false;
-            }
-        };
-        for v in &self.status {
-            if !v.is_initialized() {
-                return false;
-            }
-        };
         true
     }
 
@@ -658,13 +584,14 @@ impl ::protobuf::Message for GameServer {
             let (field_number, wire_type) = is.read_tag_unpack()?;
             match field_number {
                 1 => {
-                    ::protobuf::rt::read_singular_message_into(wire_type, is, &mut self.object_meta)?;
+                    ::protobuf::rt::read_singular_proto3_string_into(wire_type, is, &mut self.name)?;
                 },
                 2 => {
-                    ::protobuf::rt::read_singular_message_into(wire_type, is, &mut self.spec)?;
-                },
-                3 => {
-                    ::protobuf::rt::read_singular_message_into(wire_type, is, &mut self.status)?;
+                    if wire_type != ::protobuf::wire_format::WireTypeVarint {
+                        return ::std::result::Result::Err(::protobuf::rt::unexpected_wire_type(wire_type));
+                    }
+                    let tmp = is.read_int64()?;
+                    self.amount = tmp;
                 },
                 _ => {
                     ::protobuf::rt::read_unknown_or_skip_group(field_number, wire_type, is, self.mut_unknown_fields())?;
@@ -678,17 +605,11 @@ impl ::protobuf::Message for GameServer {
     #[allow(unused_variables)]
     fn compute_size(&self) -> u32 {
         let mut my_size = 0;
-        if let Some(ref v) = self.object_meta.as_ref() {
-            let len = v.compute_size();
-            my_size += 1 + ::protobuf::rt::compute_raw_varint32_size(len) + len;
-        }
-        if let Some(ref v) = self.spec.as_ref() {
-            let len = v.compute_size();
-            my_size += 1 + ::protobuf::rt::compute_raw_varint32_size(len) + len;
+        if !self.name.is_empty() {
+            my_size += ::protobuf::rt::string_size(1, &self.name);
         }
-        if let Some(ref v) = self.status.as_ref() {
-            let len = v.compute_size();
-            my_size += 1 + ::protobuf::rt::compute_raw_varint32_size(len) + len;
+        if self.amount != 0 {
+            my_size += ::protobuf::rt::value_size(2, self.amount, ::protobuf::wire_format::WireTypeVarint);
         }
         my_size += ::protobuf::rt::unknown_fields_size(self.get_unknown_fields());
         self.cached_size.set(my_size);
@@ -696,20 +617,11 @@ impl ::protobuf::Message for GameServer {
     }
 
     fn write_to_with_cached_sizes(&self, os: &mut ::protobuf::CodedOutputStream) -> ::protobuf::ProtobufResult<()> {
-        if let Some(ref v) = self.object_meta.as_ref() {
-            os.write_tag(1, ::protobuf::wire_format::WireTypeLengthDelimited)?;
-            os.write_raw_varint32(v.get_cached_size())?;
-            v.write_to_with_cached_sizes(os)?;
-        }
-        if let Some(ref v) = self.spec.as_ref() {
-            os.write_tag(2, ::protobuf::wire_format::WireTypeLengthDelimited)?;
-            os.write_raw_varint32(v.get_cached_size())?;
-            v.write_to_with_cached_sizes(os)?;
+        if !self.name.is_empty() {
+            os.write_string(1, &self.name)?;
         }
-        if let Some(ref v) = self.status.as_ref() {
-            os.write_tag(3, ::protobuf::wire_format::WireTypeLengthDelimited)?;
-            os.write_raw_varint32(v.get_cached_size())?;
-            v.write_to_with_cached_sizes(os)?;
+        if self.amount != 0 {
+            os.write_int64(2, self.amount)?;
         }
         os.write_unknown_fields(self.get_unknown_fields())?;
         ::std::result::Result::Ok(())
@@ -741,8 +653,8 @@ impl ::protobuf::Message for GameServer {
         Self::descriptor_static()
     }
 
-    fn new() -> GameServer {
-        GameServer::new()
+    fn new() -> CounterUpdate {
+        CounterUpdate::new()
     }
 
     fn descriptor_static() -> &'static ::protobuf::reflect::MessageDescriptor {
@@ -753,23 +665,18 @@ impl ::protobuf::Message for GameServer {
         unsafe {
             descriptor.get(|| {
                 let mut fields = ::std::vec::Vec::new();
-                fields.push(::protobuf::reflect::accessor::make_singular_ptr_field_accessor::<_, ::protobuf::types::ProtobufTypeMessage<GameServer_ObjectMeta>>(
-                    "object_meta",
-                    |m: &GameServer| { &m.object_meta },
-                    |m: &mut GameServer| { &mut m.object_meta },
-                ));
-                fields.push(::protobuf::reflect::accessor::make_singular_ptr_field_accessor::<_, ::protobuf::types::ProtobufTypeMessage<GameServer_Spec>>(
-                    "spec",
-                    |m: &GameServer| { &m.spec },
-                    |m: &mut GameServer| { &mut m.spec },
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeString>(
+                    "name",
+                    |m: &CounterUpdate| { &m.name },
+                    |m: &mut CounterUpdate| { &mut m.name },
                 ));
-                fields.push(::protobuf::reflect::accessor::make_singular_ptr_field_accessor::<_, ::protobuf::types::ProtobufTypeMessage<GameServer_Status>>(
-                    "status",
-                    |m: &GameServer| { &m.status },
-                    |m: &mut GameServer| { &mut m.status },
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeInt64>(
+                    "amount",
+                    |m: &CounterUpdate| { &m.amount },
+                    |m: &mut CounterUpdate| { &mut m.amount },
                 ));
-                ::protobuf::reflect::MessageDescriptor::new::<GameServer>(
-                    "GameServer",
+                ::protobuf::reflect::MessageDescriptor::new::<CounterUpdate>(
+                    "CounterUpdate",
                     fields,
                     file_descriptor_proto()
                 )
@@ -777,57 +684,49 @@ impl ::protobuf::Message for GameServer {
         }
     }
 
-    fn default_instance() -> &'static GameServer {
-        static mut instance: ::protobuf::lazy::Lazy<GameServer> = ::protobuf::lazy::Lazy {
+    fn default_instance() -> &'static CounterUpdate {
+        static mut instance: ::protobuf::lazy::Lazy<CounterUpdate> = ::protobuf::lazy::Lazy {
             lock: ::protobuf::lazy::ONCE_INIT,
-            ptr: 0 as *const GameServer,
+            ptr: 0 as *const CounterUpdate,
         };
         unsafe {
-            instance.get(GameServer::new)
+            instance.get(CounterUpdate::new)
         }
     }
 }
 
-impl ::protobuf::Clear for GameServer {
+impl ::protobuf::Clear for CounterUpdate {
     fn clear(&mut self) {
-        self.clear_object_meta();
-        self.clear_spec();
-        self.clear_status();
+        self.clear_name();
+        self.clear_amount();
         self.unknown_fields.clear();
     }
 }
 
-impl ::std::fmt::Debug for GameServer {
+impl ::std::fmt::Debug for CounterUpdate {
     fn fmt(&self, f: &mut ::std::fmt::Formatter) -> ::std::fmt::Result {
         ::protobuf::text_format::fmt(self, f)
     }
 }
 
-impl ::protobuf::reflect::ProtobufValue for GameServer {
+impl ::protobuf::reflect::ProtobufValue for CounterUpdate {
     fn as_ref(&self) -> ::protobuf::reflect::ProtobufValueRef {
         ::protobuf::reflect::ProtobufValueRef::Message(self)
     }
 }
 
 #[derive(PartialEq,Clone,Default)]
-pub struct GameServer_ObjectMeta {
+pub struct ListValue {
     // message fields
     pub name: ::std::string::String,
-    pub namespace: ::std::string::String,
-    pub uid: ::std::string::String,
-    pub resource_version: ::std::string::String,
-    pub generation: i64,
-    pub creation_timestamp: i64,
-    pub deletion_timestamp: i64,
-    pub annotations: ::std::collections::HashMap<::std::string::String, ::std::string::String>,
-    pub labels: ::std::collections::HashMap<::std::string::String, ::std::string::String>,
+    pub value: ::std::string::String,
     // special fields
     unknown_fields: ::protobuf::UnknownFields,
     cached_size: ::protobuf::CachedSize,
 }
 
-impl GameServer_ObjectMeta {
-    pub fn new() -> GameServer_ObjectMeta {
+impl ListValue {
+    pub fn new() -> ListValue {
         ::std::default::Default::default()
     }
 
@@ -857,182 +756,1561 @@ impl GameServer_ObjectMeta {
         &self.name
     }
 
-    // string namespace = 2;
+    // string value = 2;
 
-    pub fn clear_namespace(&mut self) {
-        self.namespace.clear();
+    pub fn clear_value(&mut self) {
+        self.value.clear();
     }
 
     // Param is passed by value, moved
-    pub fn set_namespace(&mut self, v: ::std::string::String) {
-        self.namespace = v;
+    pub fn set_value(&mut self, v: ::std::string::String) {
+        self.value = v;
     }
 
     // Mutable pointer to the field.
     // If field is not initialized, it is initialized with default value first.
-    pub fn mut_namespace(&mut self) -> &mut ::std::string::String {
-        &mut self.namespace
+    pub fn mut_value(&mut self) -> &mut ::std::string::String {
+        &mut self.value
     }
 
     // Take field
-    pub fn take_namespace(&mut self) -> ::std::string::String {
-        ::std::mem::replace(&mut self.namespace, ::std::string::String::new())
+    pub fn take_value(&mut self) -> ::std::string::String {
+        ::std::mem::replace(&mut self.value, ::std::string::String::new())
     }
 
-    pub fn get_namespace(&self) -> &str {
-        &self.namespace
+    pub fn get_value(&self) -> &str {
+        &self.value
     }
+}
 
-    // string uid = 3;
-
-    pub fn clear_uid(&mut self) {
-        self.uid.clear();
+impl ::protobuf::Message for ListValue {
+    fn is_initialized(&self) -> bool {
+        true
     }
 
-    // Param is passed by value, moved
-    pub fn set_uid(&mut self, v: ::std::string::String) {
-        self.uid = v;
+    fn merge_from(&mut self, is: &mut ::protobuf::CodedInputStream) -> ::protobuf::ProtobufResult<()> {
+        while !is.eof()? {
+            let (field_number, wire_type) = is.read_tag_unpack()?;
+            match field_number {
+                1 => {
+                    ::protobuf::rt::read_singular_proto3_string_into(wire_type, is, &mut self.name)?;
+                },
+                2 => {
+                    ::protobuf::rt::read_singular_proto3_string_into(wire_type, is, &mut self.value)?;
+                },
+                _ => {
+                    ::protobuf::rt::read_unknown_or_skip_group(field_number, wire_type, is, self.mut_unknown_fields())?;
+                },
+            };
+        }
+        ::std::result::Result::Ok(())
     }
 
-    // Mutable pointer to the field.
-    // If field is not initialized, it is initialized with default value first.
-    pub fn mut_uid(&mut self) -> &mut ::std::string::String {
-        &mut self.uid
+    // Compute sizes of nested messages
+    #[allow(unused_variables)]
+    fn compute_size(&self) -> u32 {
+        let mut my_size = 0;
+        if !self.name.is_empty() {
+            my_size += ::protobuf::rt::string_size(1, &self.name);
+        }
+        if !self.value.is_empty() {
+            my_size += ::protobuf::rt::string_size(2, &self.value);
+        }
+        my_size += ::protobuf::rt::unknown_fields_size(self.get_unknown_fields());
+        self.cached_size.set(my_size);
+        my_size
     }
 
-    // Take field
+    fn write_to_with_cached_sizes(&self, os: &mut ::protobuf::CodedOutputStream) -> ::protobuf::ProtobufResult<()> {
+        if !self.name.is_empty() {
+            os.write_string(1, &self.name)?;
+        }
+        if !self.value.is_empty() {
+            os.write_string(2, &self.value)?;
+        }
+        os.write_unknown_fields(self.get_unknown_fields())?;
+        ::std::result::Result::Ok(())
+    }
+
+    fn get_cached_size(&self) -> u32 {
+        self.cached_size.get()
+    }
+
+    fn get_unknown_fields(&self) -> &::protobuf::UnknownFields {
+        &self.unknown_fields
+    }
+
+    fn mut_unknown_fields(&mut self) -> &mut ::protobuf::UnknownFields {
+        &mut self.unknown_fields
+    }
+
+    fn as_any(&self) -> &::std::any::Any {
+        self as &::std::any::Any
+    }
+    fn as_any_mut(&mut self) -> &mut ::std::any::Any {
+        self as &mut ::std::any::Any
+    }
+    fn into_any(self: Box<Self>) -> ::std::boxed::Box<::std::any::Any> {
+        self
+    }
+
+    fn descriptor(&self) -> &'static ::protobuf::reflect::MessageDescriptor {
+        Self::descriptor_static()
+    }
+
+    fn new() -> ListValue {
+        ListValue::new()
+    }
+
+    fn descriptor_static() -> &'static ::protobuf::reflect::MessageDescriptor {
+        static mut descriptor: ::protobuf::lazy::Lazy<::protobuf::reflect::MessageDescriptor> = ::protobuf::lazy::Lazy {
+            lock: ::protobuf::lazy::ONCE_INIT,
+            ptr: 0 as *const ::protobuf::reflect::MessageDescriptor,
+        };
+        unsafe {
+            descriptor.get(|| {
+                let mut fields = ::std::vec::Vec::new();
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeString>(
+                    "name",
+                    |m: &ListValue| { &m.name },
+                    |m: &mut ListValue| { &mut m.name },
+                ));
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeString>(
+                    "value",
+                    |m: &ListValue| { &m.value },
+                    |m: &mut ListValue| { &mut m.value },
+                ));
+                ::protobuf::reflect::MessageDescriptor::new::<ListValue>(
+                    "ListValue",
+                    fields,
+                    file_descriptor_proto()
+                )
+            })
+        }
+    }
+
+    fn default_instance() -> &'static ListValue {
+        static mut instance: ::protobuf::lazy::Lazy<ListValue> = ::protobuf::lazy::Lazy {
+            lock: ::protobuf::lazy::ONCE_INIT,
+            ptr: 0 as *const ListValue,
+        };
+        unsafe {
+            instance.get(ListValue::new)
+        }
+    }
+}
+
+impl ::protobuf::Clear for ListValue {
+    fn clear(&mut self) {
+        self.clear_name();
+        self.clear_value();
+        self.unknown_fields.clear();
+    }
+}
+
+impl ::std::fmt::Debug for ListValue {
+    fn fmt(&self, f: &mut ::std::fmt::Formatter) -> ::std::fmt::Result {
+        ::protobuf::text_format::fmt(self, f)
+    }
+}
+
+impl ::protobuf::reflect::ProtobufValue for ListValue {
+    fn as_ref(&self) -> ::protobuf::reflect::ProtobufValueRef {
+        ::protobuf::reflect::ProtobufValueRef::Message(self)
+    }
+}
+
+#[derive(PartialEq,Clone,Default)]
+pub struct GameServer {
+    // message fields
+    pub object_meta: ::protobuf::SingularPtrField<GameServer_ObjectMeta>,
+    pub spec: ::protobuf::SingularPtrField<GameServer_Spec>,
+    pub status: ::protobuf::SingularPtrField<GameServer_Status>,
+    // special fields
+    unknown_fields: ::protobuf::UnknownFields,
+    cached_size: ::protobuf::CachedSize,
+}
+
+impl GameServer {
+    pub fn new() -> GameServer {
+        ::std::default::Default::default()
+    }
+
+    // .agones.dev.sdk.GameServer.ObjectMeta object_meta = 1;
+
+    pub fn clear_object_meta(&mut self) {
+        self.object_meta.clear();
+    }
+
+    pub fn has_object_meta(&self) -> bool {
+        self.object_meta.is_some()
+    }
+
+    // Param is passed by value, moved
+    pub fn set_object_meta(&mut self, v: GameServer_ObjectMeta) {
+        self.object_meta = ::protobuf::SingularPtrField::some(v);
+    }
+
+    // Mutable pointer to the field.
+    // If field is not initialized, it is initialized with default value first.
+    pub fn mut_object_meta(&mut self) -> &mut GameServer_ObjectMeta {
+        if self.object_meta.is_none() {
+            self.object_meta.set_default();
+        }
+        self.object_meta.as_mut().unwrap()
+    }
+
+    // Take field
+    pub fn take_object_meta(&mut self) -> GameServer_ObjectMeta {
+        self.object_meta.take().unwrap_or_else(|| GameServer_ObjectMeta::new())
+    }
+
+    pub fn get_object_meta(&self) -> &GameServer_ObjectMeta {
+        self.object_meta.as_ref().unwrap_or_else(|| GameServer_ObjectMeta::default_instance())
+    }
+
+    // .agones.dev.sdk.GameServer.Spec spec = 2;
+
+    pub fn clear_spec(&mut self) {
+        self.spec.clear();
+    }
+
+    pub fn has_spec(&self) -> bool {
+        self.spec.is_some()
+    }
+
+    // Param is passed by value, moved
+    pub fn set_spec(&mut self, v: GameServer_Spec) {
+        self.spec = ::protobuf::SingularPtrField::some(v);
+    }
+
+    // Mutable pointer to the field.
+    // If field is not initialized, it is initialized with default value first.
+    pub fn mut_spec(&mut self) -> &mut GameServer_Spec {
+        if self.spec.is_none() {
+            self.spec.set_default();
+        }
+        self.spec.as_mut().unwrap()
+    }
+
+    // Take field
+    pub fn take_spec(&mut self) -> GameServer_Spec {
+        self.spec.take().unwrap_or_else(|| GameServer_Spec::new())
+    }
+
+    pub fn get_spec(&self) -> &GameServer_Spec {
+        self.spec.as_ref().unwrap_or_else(|| GameServer_Spec::default_instance())
+    }
+
+    // .agones.dev.sdk.GameServer.Status status = 3;
+
+    pub fn clear_status(&mut self) {
+        self.status.clear();
+    }
+
+    pub fn has_status(&self) -> bool {
+        self.status.is_some()
+    }
+
+    // Param is passed by value, moved
+    pub fn set_status(&mut self, v: GameServer_Status) {
+        self.status = ::protobuf::SingularPtrField::some(v);
+    }
+
+    // Mutable pointer to the field.
+    // If field is not initialized, it is initialized with default value first.
+    pub fn mut_status(&mut self) -> &mut GameServer_Status {
+        if self.status.is_none() {
+            self.status.set_default();
+        }
+        self.status.as_mut().unwrap()
+    }
+
+    // Take field
+    pub fn take_status(&mut self) -> GameServer_Status {
+        self.status.take().unwrap_or_else(|| GameServer_Status::new())
+    }
+
+    pub fn get_status(&self) -> &GameServer_Status {
+        self.status.as_ref().unwrap_or_else(|| GameServer_Status::default_instance())
+    }
+}
+
+impl ::protobuf::Message for GameServer {
+    fn is_initialized(&self) -> bool {
+        for v in &self.object_meta {
+            if !v.is_initialized() {
+                return false;
+            }
+        };
+        for v in &self.spec {
+            if !v.is_initialized() {
+                return false;
+            }
+        };
+        for v in &self.status {
+            if !v.is_initialized() {
+                return false;
+            }
+        };
+        true
+    }
+
+    fn merge_from(&mut self, is: &mut ::protobuf::CodedInputStream) -> ::protobuf::ProtobufResult<()> {
+        while !is.eof()? {
+            let (field_number, wire_type) = is.read_tag_unpack()?;
+            match field_number {
+                1 => {
+                    ::protobuf::rt::read_singular_message_into(wire_type, is, &mut self.object_meta)?;
+                },
+                2 => {
+                    ::protobuf::rt::read_singular_message_into(wire_type, is, &mut self.spec)?;
+                },
+                3 => {
+                    ::protobuf::rt::read_singular_message_into(wire_type, is, &mut self.status)?;
+                },
+                _ => {
+                    ::protobuf::rt::read_unknown_or_skip_group(field_number, wire_type, is, self.mut_unknown_fields())?;
+                },
+            };
+        }
+        ::std::result::Result::Ok(())
+    }
+
+    // Compute sizes of nested messages
+    #[allow(unused_variables)]
+    fn compute_size(&self) -> u32 {
+        let mut my_size = 0;
+        if let Some(ref v) = self.object_meta.as_ref() {
+            let len = v.compute_size();
+            my_size += 1 + ::protobuf::rt::compute_raw_varint32_size(len) + len;
+        }
+        if let Some(ref v) = self.spec.as_ref() {
+            let len = v.compute_size();
+            my_size += 1 + ::protobuf::rt::compute_raw_varint32_size(len) + len;
+        }
+        if let Some(ref v) = self.status.as_ref() {
+            let len = v.compute_size();
+            my_size += 1 + ::protobuf::rt::compute_raw_varint32_size(len) + len;
+        }
+        my_size += ::protobuf::rt::unknown_fields_size(self.get_unknown_fields());
+        self.cached_size.set(my_size);
+        my_size
+    }
+
+    fn write_to_with_cached_sizes(&self, os: &mut ::protobuf::CodedOutputStream) -> ::protobuf::ProtobufResult<()> {
+        if let Some(ref v) = self.object_meta.as_ref() {
+            os.write_tag(1, ::protobuf::wire_format::WireTypeLengthDelimited)?;
+            os.write_raw_varint32(v.get_cached_size())?;
+            v.write_to_with_cached_sizes(os)?;
+        }
+        if let Some(ref v) = self.spec.as_ref() {
+            os.write_tag(2, ::protobuf::wire_format::WireTypeLengthDelimited)?;
+            os.write_raw_varint32(v.get_cached_size())?;
+            v.write_to_with_cached_sizes(os)?;
+        }
+        if let Some(ref v) = self.status.as_ref() {
+            os.write_tag(3, ::protobuf::wire_format::WireTypeLengthDelimited)?;
+            os.write_raw_varint32(v.get_cached_size())?;
+            v.write_to_with_cached_sizes(os)?;
+        }
+        os.write_unknown_fields(self.get_unknown_fields())?;
+        ::std::result::Result::Ok(())
+    }
+
+    fn get_cached_size(&self) -> u32 {
+        self.cached_size.get()
+    }
+
+    fn get_unknown_fields(&self) -> &::protobuf::UnknownFields {
+        &self.unknown_fields
+    }
+
+    fn mut_unknown_fields(&mut self) -> &mut ::protobuf::UnknownFields {
+        &mut self.unknown_fields
+    }
+
+    fn as_any(&self) -> &::std::any::Any {
+        self as &::std::any::Any
+    }
+    fn as_any_mut(&mut self) -> &mut ::std::any::Any {
+        self as &mut ::std::any::Any
+    }
+    fn into_any(self: Box<Self>) -> ::std::boxed::Box<::std::any::Any> {
+        self
+    }
+
+    fn descriptor(&self) -> &'static ::protobuf::reflect::MessageDescriptor {
+        Self::descriptor_static()
+    }
+
+    fn new() -> GameServer {
+        GameServer::new()
+    }
+
+    fn descriptor_static() -> &'static ::protobuf::reflect::MessageDescriptor {
+        static mut descriptor: ::protobuf::lazy::Lazy<::protobuf::reflect::MessageDescriptor> = ::protobuf::lazy::Lazy {
+            lock: ::protobuf::lazy::ONCE_INIT,
+            ptr: 0 as *const ::protobuf::reflect::MessageDescriptor,
+        };
+        unsafe {
+            descriptor.get(|| {
+                let mut fields = ::std::vec::Vec::new();
+                fields.push(::protobuf::reflect::accessor::make_singular_ptr_field_accessor::<_, ::protobuf::types::ProtobufTypeMessage<GameServer_ObjectMeta>>(
+                    "object_meta",
+                    |m: &GameServer| { &m.object_meta },
+                    |m: &mut GameServer| { &mut m.object_meta },
+                ));
+                fields.push(::protobuf::reflect::accessor::make_singular_ptr_field_accessor::<_, ::protobuf::types::ProtobufTypeMessage<GameServer_Spec>>(
+                    "spec",
+                    |m: &GameServer| { &m.spec },
+                    |m: &mut GameServer| { &mut m.spec },
+                ));
+                fields.push(::protobuf::reflect::accessor::make_singular_ptr_field_accessor::<_, ::protobuf::types::ProtobufTypeMessage<GameServer_Status>>(
+                    "status",
+                    |m: &GameServer| { &m.status },
+                    |m: &mut GameServer| { &mut m.status },
+                ));
+                ::protobuf::reflect::MessageDescriptor::new::<GameServer>(
+                    "GameServer",
+                    fields,
+                    file_descriptor_proto()
+                )
+            })
+        }
+    }
+
+    fn default_instance() -> &'static GameServer {
+        static mut instance: ::protobuf::lazy::Lazy<GameServer> = ::protobuf::lazy::Lazy {
+            lock: ::protobuf::lazy::ONCE_INIT,
+            ptr: 0 as *const GameServer,
+        };
+        unsafe {
+            instance.get(GameServer::new)
+        }
+    }
+}
+
+impl ::protobuf::Clear for GameServer {
+    fn clear(&mut self) {
+        self.clear_object_meta();
+        self.clear_spec();
+        self.clear_status();
+        self.unknown_fields.clear();
+    }
+}
+
+impl ::std::fmt::Debug for GameServer {
+    fn fmt(&self, f: &mut ::std::fmt::Formatter) -> ::std::fmt::Result {
+        ::protobuf::text_format::fmt(self, f)
+    }
+}
+
+impl ::protobuf::reflect::ProtobufValue for GameServer {
+    fn as_ref(&self) -> ::protobuf::reflect::ProtobufValueRef {
+        ::protobuf::reflect::ProtobufValueRef::Message(self)
+    }
+}
+
+#[derive(PartialEq,Clone,Default)]
+pub struct GameServer_ObjectMeta {
+    // message fields
+    pub name: ::std::string::String,
+    pub namespace: ::std::string::String,
+    pub uid: ::std::string::String,
+    pub resource_version: ::std::string::String,
+    pub generation: i64,
+    pub creation_timestamp: i64,
+    pub deletion_timestamp: i64,
+    pub annotations: ::std::collections::HashMap<::std::string::String, ::std::string::String>,
+    pub labels: ::std::collections::HashMap<::std::string::String, ::std::string::String>,
+    // special fields
+    unknown_fields: ::protobuf::UnknownFields,
+    cached_size: ::protobuf::CachedSize,
+}
+
+impl GameServer_ObjectMeta {
+    pub fn new() -> GameServer_ObjectMeta {
+        ::std::default::Default::default()
+    }
+
+    // string name = 1;
+
+    pub fn clear_name(&mut self) {
+        self.name.clear();
+    }
+
+    // Param is passed by value, moved
+    pub fn set_name(&mut self, v: ::std::string::String) {
+        self.name = v;
+    }
+
+    // Mutable pointer to the field.
+    // If field is not initialized, it is initialized with default value first.
+    pub fn mut_name(&mut self) -> &mut ::std::string::String {
+        &mut self.name
+    }
+
+    // Take field
+    pub fn take_name(&mut self) -> ::std::string::String {
+        ::std::mem::replace(&mut self.name, ::std::string::String::new())
+    }
+
+    pub fn get_name(&self) -> &str {
+        &self.name
+    }
+
+    // string namespace = 2;
+
+    pub fn clear_namespace(&mut self) {
+        self.namespace.clear();
+    }
+
+    // Param is passed by value, moved
+    pub fn set_namespace(&mut self, v: ::std::string::String) {
+        self.namespace = v;
+    }
+
+    // Mutable pointer to the field.
+    // If field is not initialized, it is initialized with default value first.
+    pub fn mut_namespace(&mut self) -> &mut ::std::string::String {
+        &mut self.namespace
+    }
+
+    // Take field
+    pub fn take_namespace(&mut self) -> ::std::string::String {
+        ::std::mem::replace(&mut self.namespace, ::std::string::String::new())
+    }
+
+    pub fn get_namespace(&self) -> &str {
+        &self.namespace
+    }
+
+    // string uid = 3;
+
+    pub fn clear_uid(&mut self) {
+        self.uid.clear();
+    }
+
+    // Param is passed by value, moved
+    pub fn set_uid(&mut self, v: ::std::string::String) {
+        self.uid = v;
+    }
+
+    // Mutable pointer to the field.
+    // If field is not initialized, it is initialized with default value first.
+    pub fn mut_uid(&mut self) -> &mut ::std::string::String {
+        &mut self.uid
+    }
+
+    // Take field
     pub fn take_uid(&mut self) -> ::std::string::String {
         ::std::mem::replace(&mut self.uid, ::std::string::String::new())
     }
 
-    pub fn get_uid(&self) -> &str {
-        &self.uid
+    pub fn get_uid(&self) -> &str {
+        &self.uid
+    }
+
+    // string resource_version = 4;
+
+    pub fn clear_resource_version(&mut self) {
+        self.resource_version.clear();
+    }
+
+    // Param is passed by value, moved
+    pub fn set_resource_version(&mut self, v: ::std::string::String) {
+        self.resource_version = v;
+    }
+
+    // Mutable pointer to the field.
+    // If field is not initialized, it is initialized with default value first.
+    pub fn mut_resource_version(&mut self) -> &mut ::std::string::String {
+        &mut self.resource_version
+    }
+
+    // Take field
+    pub fn take_resource_version(&mut self) -> ::std::string::String {
+        ::std::mem::replace(&mut self.resource_version, ::std::string::String::new())
+    }
+
+    pub fn get_resource_version(&self) -> &str {
+        &self.resource_version
+    }
+
+    // int64 generation = 5;
+
+    pub fn clear_generation(&mut self) {
+        self.generation = 0;
+    }
+
+    // Param is passed by value, moved
+    pub fn set_generation(&mut self, v: i64) {
+        self.generation = v;
+    }
+
+    pub fn get_generation(&self) -> i64 {
+        self.generation
+    }
+
+    // int64 creation_timestamp = 6;
+
+    pub fn clear_creation_timestamp(&mut self) {
+        self.creation_timestamp = 0;
+    }
+
+    // Param is passed by value, moved
+    pub fn set_creation_timestamp(&mut self, v: i64) {
+        self.creation_timestamp = v;
+    }
+
+    pub fn get_creation_timestamp(&self) -> i64 {
+        self.creation_timestamp
+    }
+
+    // int64 deletion_timestamp = 7;
+
+    pub fn clear_deletion_timestamp(&mut self) {
+        self.deletion_timestamp = 0;
+    }
+
+    // Param is passed by value, moved
+    pub fn set_deletion_timestamp(&mut self, v: i64) {
+        self.deletion_timestamp = v;
+    }
+
+    pub fn get_deletion_timestamp(&self) -> i64 {
+        self.deletion_timestamp
+    }
+
+    // repeated .agones.dev.sdk.GameServer.ObjectMeta.AnnotationsEntry annotations = 8;
+
+    pub fn clear_annotations(&mut self) {
+        self.annotations.clear();
+    }
+
+    // Param is passed by value, moved
+    pub fn set_annotations(&mut self, v: ::std::collections::HashMap<::std::string::String, ::std::string::String>) {
+        self.annotations = v;
+    }
+
+    // Mutable pointer to the field.
+    pub fn mut_annotations(&mut self) -> &mut ::std::collections::HashMap<::std::string::String, ::std::string::String> {
+        &mut self.annotations
+    }
+
+    // Take field
+    pub fn take_annotations(&mut self) -> ::std::collections::HashMap<::std::string::String, ::std::string::String> {
+        ::std::mem::replace(&mut self.annotations, ::std::collections::HashMap::new())
+    }
+
+    pub fn get_annotations(&self) -> &::std::collections::HashMap<::std::string::String, ::std::string::String> {
+        &self.annotations
+    }
+
+    // repeated .agones.dev.sdk.GameServer.ObjectMeta.LabelsEntry labels = 9;
+
+    pub fn clear_labels(&mut self) {
+        self.labels.clear();
+    }
+
+    // Param is passed by value, moved
+    pub fn set_labels(&mut self, v: ::std::collections::HashMap<::std::string::String, ::std::string::String>) {
+        self.labels = v;
+    }
+
+    // Mutable pointer to the field.
+    pub fn mut_labels(&mut self) -> &mut ::std::collections::HashMap<::std::string::String, ::std::string::String> {
+        &mut self.labels
+    }
+
+    // Take field
+    pub fn take_labels(&mut self) -> ::std::collections::HashMap<::std::string::String, ::std::string::String> {
+        ::std::mem::replace(&mut self.labels, ::std::collections::HashMap::new())
+    }
+
+    pub fn get_labels(&self) -> &::std::collections::HashMap<::std::string::String, ::std::string::String> {
+        &self.labels
+    }
+}
+
+impl ::protobuf::Message for GameServer_ObjectMeta {
+    fn is_initialized(&self) -> bool {
+        true
+    }
+
+    fn merge_from(&mut self, is: &mut ::protobuf::CodedInputStream) -> ::protobuf::ProtobufResult<()> {
+        while !is.eof()? {
+            let (field_number, wire_type) = is.read_tag_unpack()?;
+            match field_number {
+                1 => {
+                    ::protobuf::rt::read_singular_proto3_string_into(wire_type, is, &mut self.name)?;
+                },
+                2 => {
+                    ::protobuf::rt::read_singular_proto3_string_into(wire_type, is, &mut self.namespace)?;
+                },
+                3 => {
+                    ::protobuf::rt::read_singular_proto3_string_into(wire_type, is, &mut self.uid)?;
+                },
+                4 => {
+                    ::protobuf::rt::read_singular_proto3_string_into(wire_type, is, &mut self.resource_version)?;
+                },
+                5 => {
+                    if wire_type != ::protobuf::wire_format::WireTypeVarint {
+                        return ::std::result::Result::Err(::protobuf::rt::unexpected_wire_type(wire_type));
+                    }
+                    let tmp = is.read_int64()?;
+                    self.generation = tmp;
+                },
+                6 => {
+                    if wire_type != ::protobuf::wire_format::WireTypeVarint {
+                        return ::std::result::Result::Err(::protobuf::rt::unexpected_wire_type(wire_type));
+                    }
+                    let tmp = is.read_int64()?;
+                    self.creation_timestamp = tmp;
+                },
+                7 => {
+                    if wire_type != ::protobuf::wire_format::WireTypeVarint {
+                        return ::std::result::Result::Err(::protobuf::rt::unexpected_wire_type(wire_type));
+                    }
+                    let tmp = is.read_int64()?;
+                    self.deletion_timestamp = tmp;
+                },
+                8 => {
+                    ::protobuf::rt::read_map_into::<::protobuf::types::ProtobufTypeString, ::protobuf::types::ProtobufTypeString>(wire_type, is, &mut self.annotations)?;
+                },
+                9 => {
+                    ::protobuf::rt::read_map_into::<::protobuf::types::ProtobufTypeString, ::protobuf::types::ProtobufTypeString>(wire_type, is, &mut self.labels)?;
+                },
+                _ => {
+                    ::protobuf::rt::read_unknown_or_skip_group(field_number, wire_type, is, self.mut_unknown_fields())?;
+                },
+            };
+        }
+        ::std::result::Result::Ok(())
+    }
+
+    // Compute sizes of nested messages
+    #[allow(unused_variables)]
+    fn compute_size(&self) -> u32 {
+        let mut my_size = 0;
+        if !self.name.is_empty() {
+            my_size += ::protobuf::rt::string_size(1, &self.name);
+        }
+        if !self.namespace.is_empty() {
+            my_size += ::protobuf::rt::string_size(2, &self.namespace);
+        }
+        if !self.uid.is_empty() {
+            my_size += ::protobuf::rt::string_size(3, &self.uid);
+        }
+        if !self.resource_version.is_empty() {
+            my_size += ::protobuf::rt::string_size(4, &self.resource_version);
+        }
+        if self.generation != 0 {
+            my_size += ::protobuf::rt::value_size(5, self.generation, ::protobuf::wire_format::WireTypeVarint);
+        }
+        if self.creation_timestamp != 0 {
+            my_size += ::protobuf::rt::value_size(6, self.creation_timestamp, ::protobuf::wire_format::WireTypeVarint);
+        }
+        if self.deletion_timestamp != 0 {
+            my_size += ::protobuf::rt::value_size(7, self.deletion_timestamp, ::protobuf::wire_format::WireTypeVarint);
+        }
+        my_size += ::protobuf::rt::compute_map_size::<::protobuf::types::ProtobufTypeString, ::protobuf::types::ProtobufTypeString>(8, &self.annotations);
+        my_size += ::protobuf::rt::compute_map_size::<::protobuf::types::ProtobufTypeString, ::protobuf::types::ProtobufTypeString>(9, &self.labels);
+        my_size += ::protobuf::rt::unknown_fields_size(self.get_unknown_fields());
+        self.cached_size.set(my_size);
+        my_size
+    }
+
+    fn write_to_with_cached_sizes(&self, os: &mut ::protobuf::CodedOutputStream) -> ::protobuf::ProtobufResult<()> {
+        if !self.name.is_empty() {
+            os.write_string(1, &self.name)?;
+        }
+        if !self.namespace.is_empty() {
+            os.write_string(2, &self.namespace)?;
+        }
+        if !self.uid.is_empty() {
+            os.write_string(3, &self.uid)?;
+        }
+        if !self.resource_version.is_empty() {
+            os.write_string(4, &self.resource_version)?;
+        }
+        if self.generation != 0 {
+            os.write_int64(5, self.generation)?;
+        }
+        if self.creation_timestamp != 0 {
+            os.write_int64(6, self.creation_timestamp)?;
+        }
+        if self.deletion_timestamp != 0 {
+            os.write_int64(7, self.deletion_timestamp)?;
+        }
+        ::protobuf::rt::write_map_with_cached_sizes::<::protobuf::types::ProtobufTypeString, ::protobuf::types::ProtobufTypeString>(8, &self.annotations, os)?;
+        ::protobuf::rt::write_map_with_cached_sizes::<::protobuf::types::ProtobufTypeString, ::protobuf::types::ProtobufTypeString>(9, &self.labels, os)?;
+        os.write_unknown_fields(self.get_unknown_fields())?;
+        ::std::result::Result::Ok(())
+    }
+
+    fn get_cached_size(&self) -> u32 {
+        self.cached_size.get()
+    }
+
+    fn get_unknown_fields(&self) -> &::protobuf::UnknownFields {
+        &self.unknown_fields
+    }
+
+    fn mut_unknown_fields(&mut self) -> &mut ::protobuf::UnknownFields {
+        &mut self.unknown_fields
+    }
+
+    fn as_any(&self) -> &::std::any::Any {
+        self as &::std::any::Any
+    }
+    fn as_any_mut(&mut self) -> &mut ::std::any::Any {
+        self as &mut ::std::any::Any
+    }
+    fn into_any(self: Box<Self>) -> ::std::boxed::Box<::std::any::Any> {
+        self
+    }
+
+    fn descriptor(&self) -> &'static ::protobuf::reflect::MessageDescriptor {
+        Self::descriptor_static()
+    }
+
+    fn new() -> GameServer_ObjectMeta {
+        GameServer_ObjectMeta::new()
+    }
+
+    fn descriptor_static() -> &'static ::protobuf::reflect::MessageDescriptor {
+        static mut descriptor: ::protobuf::lazy::Lazy<::protobuf::reflect::MessageDescriptor> = ::protobuf::lazy::Lazy {
+            lock: ::protobuf::lazy::ONCE_INIT,
+            ptr: 0 as *const ::protobuf::reflect::MessageDescriptor,
+        };
+        unsafe {
+            descriptor.get(|| {
+                let mut fields = ::std::vec::Vec::new();
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeString>(
+                    "name",
+                    |m: &GameServer_ObjectMeta| { &m.name },
+                    |m: &mut GameServer_ObjectMeta| { &mut m.name },
+                ));
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeString>(
+                    "namespace",
+                    |m: &GameServer_ObjectMeta| { &m.namespace },
+                    |m: &mut GameServer_ObjectMeta| { &mut m.namespace },
+                ));
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeString>(
+                    "uid",
+                    |m: &GameServer_ObjectMeta| { &m.uid },
+                    |m: &mut GameServer_ObjectMeta| { &mut m.uid },
+                ));
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeString>(
+                    "resource_version",
+                    |m: &GameServer_ObjectMeta| { &m.resource_version },
+                    |m: &mut GameServer_ObjectMeta| { &mut m.resource_version },
+                ));
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeInt64>(
+                    "generation",
+                    |m: &GameServer_ObjectMeta| { &m.generation },
+                    |m: &mut GameServer_ObjectMeta| { &mut m.generation },
+                ));
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeInt64>(
+                    "creation_timestamp",
+                    |m: &GameServer_ObjectMeta| { &m.creation_timestamp },
+                    |m: &mut GameServer_ObjectMeta| { &mut m.creation_timestamp },
+                ));
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeInt64>(
+                    "deletion_timestamp",
+                    |m: &GameServer_ObjectMeta| { &m.deletion_timestamp },
+                    |m: &mut GameServer_ObjectMeta| { &mut m.deletion_timestamp },
+                ));
+                fields.push(::protobuf::reflect::accessor::make_map_accessor::<_, ::protobuf::types::ProtobufTypeString, ::protobuf::types::ProtobufTypeString>(
+                    "annotations",
+                    |m: &GameServer_ObjectMeta| { &m.annotations },
+                    |m: &mut GameServer_ObjectMeta| { &mut m.annotations },
+                ));
+                fields.push(::protobuf::reflect::accessor::make_map_accessor::<_, ::protobuf::types::ProtobufTypeString, ::protobuf::types::ProtobufTypeString>(
+                    "labels",
+                    |m: &GameServer_ObjectMeta| { &m.labels },
+                    |m: &mut GameServer_ObjectMeta| { &mut m.labels },
+                ));
+                ::protobuf::reflect::MessageDescriptor::new::<GameServer_ObjectMeta>(
+                    "GameServer_ObjectMeta",
+                    fields,
+                    file_descriptor_proto()
+                )
+            })
+        }
+    }
+
+    fn default_instance() -> &'static GameServer_ObjectMeta {
+        static mut instance: ::protobuf::lazy::Lazy<GameServer_ObjectMeta> = ::protobuf::lazy::Lazy {
+            lock: ::protobuf::lazy::ONCE_INIT,
+            ptr: 0 as *const GameServer_ObjectMeta,
+        };
+        unsafe {
+            instance.get(GameServer_ObjectMeta::new)
+        }
+    }
+}
+
+impl ::protobuf::Clear for GameServer_ObjectMeta {
+    fn clear(&mut self) {
+        self.clear_name();
+        self.clear_namespace();
+        self.clear_uid();
+        self.clear_resource_version();
+        self.clear_generation();
+        self.clear_creation_timestamp();
+        self.clear_deletion_timestamp();
+        self.clear_annotations();
+        self.clear_labels();
+        self.unknown_fields.clear();
+    }
+}
+
+impl ::std::fmt::Debug for GameServer_ObjectMeta {
+    fn fmt(&self, f: &mut ::std::fmt::Formatter) -> ::std::fmt::Result {
+        ::protobuf::text_format::fmt(self, f)
+    }
+}
+
+impl ::protobuf::reflect::ProtobufValue for GameServer_ObjectMeta {
+    fn as_ref(&self) -> ::protobuf::reflect::ProtobufValueRef {
+        ::protobuf::reflect::ProtobufValueRef::Message(self)
+    }
+}
+
+#[derive(PartialEq,Clone,Default)]
+pub struct GameServer_Spec {
+    // message fields
+    pub health: ::protobuf::SingularPtrField<GameServer_Spec_Health>,
+    // special fields
+    unknown_fields: ::protobuf::UnknownFields,
+    cached_size: ::protobuf::CachedSize,
+}
+
+impl GameServer_Spec {
+    pub fn new() -> GameServer_Spec {
+        ::std::default::Default::default()
+    }
+
+    // .agones.dev.sdk.GameServer.Spec.Health health = 1;
+
+    pub fn clear_health(&mut self) {
+        self.health.clear();
+    }
+
+    pub fn has_health(&self) -> bool {
+        self.health.is_some()
+    }
+
+    // Param is passed by value, moved
+    pub fn set_health(&mut self, v: GameServer_Spec_Health) {
+        self.health = ::protobuf::SingularPtrField::some(v);
+    }
+
+    // Mutable pointer to the field.
+    // If field is not initialized, it is initialized with default value first.
+    pub fn mut_health(&mut self) -> &mut GameServer_Spec_Health {
+        if self.health.is_none() {
+            self.health.set_default();
+        }
+        self.health.as_mut().unwrap()
+    }
+
+    // Take field
+    pub fn take_health(&mut self) -> GameServer_Spec_Health {
+        self.health.take().unwrap_or_else(|| GameServer_Spec_Health::new())
+    }
+
+    pub fn get_health(&self) -> &GameServer_Spec_Health {
+        self.health.as_ref().unwrap_or_else(|| GameServer_Spec_Health::default_instance())
+    }
+}
+
+impl ::protobuf::Message for GameServer_Spec {
+    fn is_initialized(&self) -> bool {
+        for v in &self.health {
+            if !v.is_initialized() {
+                return false;
+            }
+        };
+        true
+    }
+
+    fn merge_from(&mut self, is: &mut ::protobuf::CodedInputStream) -> ::protobuf::ProtobufResult<()> {
+        while !is.eof()? {
+            let (field_number, wire_type) = is.read_tag_unpack()?;
+            match field_number {
+                1 => {
+                    ::protobuf::rt::read_singular_message_into(wire_type, is, &mut self.health)?;
+                },
+                _ => {
+                    ::protobuf::rt::read_unknown_or_skip_group(field_number, wire_type, is, self.mut_unknown_fields())?;
+                },
+            };
+        }
+        ::std::result::Result::Ok(())
+    }
+
+    // Compute sizes of nested messages
+    #[allow(unused_variables)]
+    fn compute_size(&self) -> u32 {
+        let mut my_size = 0;
+        if let Some(ref v) = self.health.as_ref() {
+            let len = v.compute_size();
+            my_size += 1 + ::protobuf::rt::compute_raw_varint32_size(len) + len;
+        }
+        my_size += ::protobuf::rt::unknown_fields_size(self.get_unknown_fields());
+        self.cached_size.set(my_size);
+        my_size
+    }
+
+    fn write_to_with_cached_sizes(&self, os: &mut ::protobuf::CodedOutputStream) -> ::protobuf::ProtobufResult<()> {
+        if let Some(ref v) = self.health.as_ref() {
+            os.write_tag(1, ::protobuf::wire_format::WireTypeLengthDelimited)?;
+            os.write_raw_varint32(v.get_cached_size())?;
+            v.write_to_with_cached_sizes(os)?;
+        }
+        os.write_unknown_fields(self.get_unknown_fields())?;
+        ::std::result::Result::Ok(())
+    }
+
+    fn get_cached_size(&self) -> u32 {
+        self.cached_size.get()
+    }
+
+    fn get_unknown_fields(&self) -> &::protobuf::UnknownFields {
+        &self.unknown_fields
+    }
+
+    fn mut_unknown_fields(&mut self) -> &mut ::protobuf::UnknownFields {
+        &mut self.unknown_fields
+    }
+
+    fn as_any(&self) -> &::std::any::Any {
+        self as &::std::any::Any
+    }
+    fn as_any_mut(&mut self) -> &mut ::std::any::Any {
+        self as &mut ::std::any::Any
+    }
+    fn into_any(self: Box<Self>) -> ::std::boxed::Box<::std::any::Any> {
+        self
+    }
+
+    fn descriptor(&self) -> &'static ::protobuf::reflect::MessageDescriptor {
+        Self::descriptor_static()
+    }
+
+    fn new() -> GameServer_Spec {
+        GameServer_Spec::new()
+    }
+
+    fn descriptor_static() -> &'static ::protobuf::reflect::MessageDescriptor {
+        static mut descriptor: ::protobuf::lazy::Lazy<::protobuf::reflect::MessageDescriptor> = ::protobuf::lazy::Lazy {
+            lock: ::protobuf::lazy::ONCE_INIT,
+            ptr: 0 as *const ::protobuf::reflect::MessageDescriptor,
+        };
+        unsafe {
+            descriptor.get(|| {
+                let mut fields = ::std::vec::Vec::new();
+                fields.push(::protobuf::reflect::accessor::make_singular_ptr_field_accessor::<_, ::protobuf::types::ProtobufTypeMessage<GameServer_Spec_Health>>(
+                    "health",
+                    |m: &GameServer_Spec| { &m.health },
+                    |m: &mut GameServer_Spec| { &mut m.health },
+                ));
+                ::protobuf::reflect::MessageDescriptor::new::<GameServer_Spec>(
+                    "GameServer_Spec",
+                    fields,
+                    file_descriptor_proto()
+                )
+            })
+        }
+    }
+
+    fn default_instance() -> &'static GameServer_Spec {
+        static mut instance: ::protobuf::lazy::Lazy<GameServer_Spec> = ::protobuf::lazy::Lazy {
+            lock: ::protobuf::lazy::ONCE_INIT,
+            ptr: 0 as *const GameServer_Spec,
+        };
+        unsafe {
+            instance.get(GameServer_Spec::new)
+        }
+    }
+}
+
+impl ::protobuf::Clear for GameServer_Spec {
+    fn clear(&mut self) {
+        self.clear_health();
+        self.unknown_fields.clear();
+    }
+}
+
+impl ::std::fmt::Debug for GameServer_Spec {
+    fn fmt(&self, f: &mut ::std::fmt::Formatter) -> ::std::fmt::Result {
+        ::protobuf::text_format::fmt(self, f)
+    }
+}
+
+impl ::protobuf::reflect::ProtobufValue for GameServer_Spec {
+    fn as_ref(&self) -> ::protobuf::reflect::ProtobufValueRef {
+        ::protobuf::reflect::ProtobufValueRef::Message(self)
+    }
+}
+
+#[derive(PartialEq,Clone,Default)]
+pub struct GameServer_Spec_Health {
+    // message fields
+    pub disabled: bool,
+    pub period_seconds: i32,
+    pub failure_threshold: i32,
+    pub initial_delay_seconds: i32,
+    // special fields
+    unknown_fields: ::protobuf::UnknownFields,
+    cached_size: ::protobuf::CachedSize,
+}
+
+impl GameServer_Spec_Health {
+    pub fn new() -> GameServer_Spec_Health {
+        ::std::default::Default::default()
+    }
+
+    // bool disabled = 1;
+
+    pub fn clear_disabled(&mut self) {
+        self.disabled = false;
+    }
+
+    // Param is passed by value, moved
+    pub fn set_disabled(&mut self, v: bool) {
+        self.disabled = v;
+    }
+
+    pub fn get_disabled(&self) -> bool {
+        self.disabled
+    }
+
+    // int32 period_seconds = 2;
+
+    pub fn clear_period_seconds(&mut self) {
+        self.period_seconds = 0;
+    }
+
+    // Param is passed by value, moved
+    pub fn set_period_seconds(&mut self, v: i32) {
+        self.period_seconds = v;
+    }
+
+    pub fn get_period_seconds(&self) -> i32 {
+        self.period_seconds
+    }
+
+    // int32 failure_threshold = 3;
+
+    pub fn clear_failure_threshold(&mut self) {
+        self.failure_threshold = 0;
+    }
+
+    // Param is passed by value, moved
+    pub fn set_failure_threshold(&mut self, v: i32) {
+        self.failure_threshold = v;
+    }
+
+    pub fn get_failure_threshold(&self) -> i32 {
+        self.failure_threshold
+    }
+
+    // int32 initial_delay_seconds = 4;
+
+    pub fn clear_initial_delay_seconds(&mut self) {
+        self.initial_delay_seconds = 0;
+    }
+
+    // Param is passed by value, moved
+    pub fn set_initial_delay_seconds(&mut self, v: i32) {
+        self.initial_delay_seconds = v;
+    }
+
+    pub fn get_initial_delay_seconds(&self) -> i32 {
+        self.initial_delay_seconds
+    }
+}
+
+impl ::protobuf::Message for GameServer_Spec_Health {
+    fn is_initialized(&self) -> bool {
+        true
+    }
+
+    fn merge_from(&mut self, is: &mut ::protobuf::CodedInputStream) -> ::protobuf::ProtobufResult<()> {
+        while !is.eof()? {
+            let (field_number, wire_type) = is.read_tag_unpack()?;
+            match field_number {
+                1 => {
+                    if wire_type != ::protobuf::wire_format::WireTypeVarint {
+                        return ::std::result::Result::Err(::protobuf::rt::unexpected_wire_type(wire_type));
+                    }
+                    let tmp = is.read_bool()?;
+                    self.disabled = tmp;
+                },
+                2 => {
+                    if wire_type != ::protobuf::wire_format::WireTypeVarint {
+                        return ::std::result::Result::Err(::protobuf::rt::unexpected_wire_type(wire_type));
+                    }
+                    let tmp = is.read_int32()?;
+                    self.period_seconds = tmp;
+                },
+                3 => {
+                    if wire_type != ::protobuf::wire_format::WireTypeVarint {
+                        return ::std::result::Result::Err(::protobuf::rt::unexpected_wire_type(wire_type));
+                    }
+                    let tmp = is.read_int32()?;
+                    self.failure_threshold = tmp;
+                },
+                4 => {
+                    if wire_type != ::protobuf::wire_format::WireTypeVarint {
+                        return ::std::result::Result::Err(::protobuf::rt::unexpected_wire_type(wire_type));
+                    }
+                    let tmp = is.read_int32()?;
+                    self.initial_delay_seconds = tmp;
+                },
+                _ => {
+                    ::protobuf::rt::read_unknown_or_skip_group(field_number, wire_type, is, self.mut_unknown_fields())?;
+                },
+            };
+        }
+        ::std::result::Result::Ok(())
+    }
+
+    // Compute sizes of nested messages
+    #[allow(unused_variables)]
+    fn compute_size(&self) -> u32 {
+        let mut my_size = 0;
+        if self.disabled != false {
+            my_size += 2;
+        }
+        if self.period_seconds != 0 {
+            my_size += ::protobuf::rt::value_size(2, self.period_seconds, ::protobuf::wire_format::WireTypeVarint);
+        }
+        if self.failure_threshold != 0 {
+            my_size += ::protobuf::rt::value_size(3, self.failure_threshold, ::protobuf::wire_format::WireTypeVarint);
+        }
+        if self.initial_delay_seconds != 0 {
+            my_size += ::protobuf::rt::value_size(4, self.initial_delay_seconds, ::protobuf::wire_format::WireTypeVarint);
+        }
+        my_size += ::protobuf::rt::unknown_fields_size(self.get_unknown_fields());
+        self.cached_size.set(my_size);
+        my_size
+    }
+
+    fn write_to_with_cached_sizes(&self, os: &mut ::protobuf::CodedOutputStream) -> ::protobuf::ProtobufResult<()> {
+        if self.disabled != false {
+            os.write_bool(1, self.disabled)?;
+        }
+        if self.period_seconds != 0 {
+            os.write_int32(2, self.period_seconds)?;
+        }
+        if self.failure_threshold != 0 {
+            os.write_int32(3, self.failure_threshold)?;
+        }
+        if self.initial_delay_seconds != 0 {
+            os.write_int32(4, self.initial_delay_seconds)?;
+        }
+        os.write_unknown_fields(self.get_unknown_fields())?;
+        ::std::result::Result::Ok(())
+    }
+
+    fn get_cached_size(&self) -> u32 {
+        self.cached_size.get()
+    }
+
+    fn get_unknown_fields(&self) -> &::protobuf::UnknownFields {
+        &self.unknown_fields
+    }
+
+    fn mut_unknown_fields(&mut self) -> &mut ::protobuf::UnknownFields {
+        &mut self.unknown_fields
+    }
+
+    fn as_any(&self) -> &::std::any::Any {
+        self as &::std::any::Any
+    }
+    fn as_any_mut(&mut self) -> &mut ::std::any::Any {
+        self as &mut ::std::any::Any
+    }
+    fn into_any(self: Box<Self>) -> ::std::boxed::Box<::std::any::Any> {
+        self
+    }
+
+    fn descriptor(&self) -> &'static ::protobuf::reflect::MessageDescriptor {
+        Self::descriptor_static()
+    }
+
+    fn new() -> GameServer_Spec_Health {
+        GameServer_Spec_Health::new()
+    }
+
+    fn descriptor_static() -> &'static ::protobuf::reflect::MessageDescriptor {
+        static mut descriptor: ::protobuf::lazy::Lazy<::protobuf::reflect::MessageDescriptor> = ::protobuf::lazy::Lazy {
+            lock: ::protobuf::lazy::ONCE_INIT,
+            ptr: 0 as *const ::protobuf::reflect::MessageDescriptor,
+        };
+        unsafe {
+            descriptor.get(|| {
+                let mut fields = ::std::vec::Vec::new();
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeBool>(
+                    "disabled",
+                    |m: &GameServer_Spec_Health| { &m.disabled },
+                    |m: &mut GameServer_Spec_Health| { &mut m.disabled },
+                ));
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeInt32>(
+                    "period_seconds",
+                    |m: &GameServer_Spec_Health| { &m.period_seconds },
+                    |m: &mut GameServer_Spec_Health| { &mut m.period_seconds },
+                ));
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeInt32>(
+                    "failure_threshold",
+                    |m: &GameServer_Spec_Health| { &m.failure_threshold },
+                    |m: &mut GameServer_Spec_Health| { &mut m.failure_threshold },
+                ));
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeInt32>(
+                    "initial_delay_seconds",
+                    |m: &GameServer_Spec_Health| { &m.initial_delay_seconds },
+                    |m: &mut GameServer_Spec_Health| { &mut m.initial_delay_seconds },
+                ));
+                ::protobuf::reflect::MessageDescriptor::new::<GameServer_Spec_Health>(
+                    "GameServer_Spec_Health",
+                    fields,
+                    file_descriptor_proto()
+                )
+            })
+        }
+    }
+
+    fn default_instance() -> &'static GameServer_Spec_Health {
+        static mut instance: ::protobuf::lazy::Lazy<GameServer_Spec_Health> = ::protobuf::lazy::Lazy {
+            lock: ::protobuf::lazy::ONCE_INIT,
+            ptr: 0 as *const GameServer_Spec_Health,
+        };
+        unsafe {
+            instance.get(GameServer_Spec_Health::new)
+        }
+    }
+}
+
+impl ::protobuf::Clear for GameServer_Spec_Health {
+    fn clear(&mut self) {
+        self.clear_disabled();
+        self.clear_period_seconds();
+        self.clear_failure_threshold();
+        self.clear_initial_delay_seconds();
+        self.unknown_fields.clear();
+    }
+}
+
+impl ::std::fmt::Debug for GameServer_Spec_Health {
+    fn fmt(&self, f: &mut ::std::fmt::Formatter) -> ::std::fmt::Result {
+        ::protobuf::text_format::fmt(self, f)
+    }
+}
+
+impl ::protobuf::reflect::ProtobufValue for GameServer_Spec_Health {
+    fn as_ref(&self) -> ::protobuf::reflect::ProtobufValueRef {
+        ::protobuf::reflect::ProtobufValueRef::Message(self)
+    }
+}
+
+#[derive(PartialEq,Clone,Default)]
+pub struct GameServer_Status {
+    // message fields
+    pub state: ::std::string::String,
+    pub address: ::std::string::String,
+    pub ports: ::protobuf::RepeatedField<GameServer_Status_Port>,
+    pub counters: ::std::collections::HashMap<::std::string::String, GameServer_Status_Counter>,
+    pub lists: ::std::collections::HashMap<::std::string::String, GameServer_Status_List>,
+    pub disruption: ::protobuf::SingularPtrField<GameServer_Status_Disruption>,
+    // special fields
+    unknown_fields: ::protobuf::UnknownFields,
+    cached_size: ::protobuf::CachedSize,
+}
+
+impl GameServer_Status {
+    pub fn new() -> GameServer_Status {
+        ::std::default::Default::default()
     }
 
-    // string resource_version = 4;
+    // string state = 1;
 
-    pub fn clear_resource_version(&mut self) {
-        self.resource_version.clear();
+    pub fn clear_state(&mut self) {
+        self.state.clear();
     }
 
     // Param is passed by value, moved
-    pub fn set_resource_version(&mut self, v: ::std::string::String) {
-        self.resource_version = v;
+    pub fn set_state(&mut self, v: ::std::string::String) {
+        self.state = v;
     }
 
     // Mutable pointer to the field.
     // If field is not initialized, it is initialized with default value first.
-    pub fn mut_resource_version(&mut self) -> &mut ::std::string::String {
-        &mut self.resource_version
+    pub fn mut_state(&mut self) -> &mut ::std::string::String {
+        &mut self.state
     }
 
     // Take field
-    pub fn take_resource_version(&mut self) -> ::std::string::String {
-        ::std::mem::replace(&mut self.resource_version, ::std::string::String::new())
+    pub fn take_state(&mut self) -> ::std::string::String {
+        ::std::mem::replace(&mut self.state, ::std::string::String::new())
     }
 
-    pub fn get_resource_version(&self) -> &str {
-        &self.resource_version
+    pub fn get_state(&self) -> &str {
+        &self.state
     }
 
-    // int64 generation = 5;
+    // string address = 2;
 
-    pub fn clear_generation(&mut self) {
-        self.generation = 0;
+    pub fn clear_address(&mut self) {
+        self.address.clear();
     }
 
     // Param is passed by value, moved
-    pub fn set_generation(&mut self, v: i64) {
-        self.generation = v;
+    pub fn set_address(&mut self, v: ::std::string::String) {
+        self.address = v;
     }
 
-    pub fn get_generation(&self) -> i64 {
-        self.generation
+    // Mutable pointer to the field.
+    // If field is not initialized, it is initialized with default value first.
+    pub fn mut_address(&mut self) -> &mut ::std::string::String {
+        &mut self.address
     }
 
-    // int64 creation_timestamp = 6;
+    // Take field
+    pub fn take_address(&mut self) -> ::std::string::String {
+        ::std::mem::replace(&mut self.address, ::std::string::String::new())
+    }
 
-    pub fn clear_creation_timestamp(&mut self) {
-        self.creation_timestamp = 0;
+    pub fn get_address(&self) -> &str {
+        &self.address
+    }
+
+    // repeated .agones.dev.sdk.GameServer.Status.Port ports = 3;
+
+    pub fn clear_ports(&mut self) {
+        self.ports.clear();
     }
 
     // Param is passed by value, moved
-    pub fn set_creation_timestamp(&mut self, v: i64) {
-        self.creation_timestamp = v;
+    pub fn set_ports(&mut self, v: ::protobuf::RepeatedField<GameServer_Status_Port>) {
+        self.ports = v;
     }
 
-    pub fn get_creation_timestamp(&self) -> i64 {
-        self.creation_timestamp
+    // Mutable pointer to the field.
+    pub fn mut_ports(&mut self) -> &mut ::protobuf::RepeatedField<GameServer_Status_Port> {
+        &mut self.ports
     }
 
-    // int64 deletion_timestamp = 7;
+    // Take field
+    pub fn take_ports(&mut self) -> ::protobuf::RepeatedField<GameServer_Status_Port> {
+        ::std::mem::replace(&mut self.ports, ::protobuf::RepeatedField::new())
+    }
 
-    pub fn clear_deletion_timestamp(&mut self) {
-        self.deletion_timestamp = 0;
+    pub fn get_ports(&self) -> &[GameServer_Status_Port] {
+        &self.ports
+    }
+
+    // repeated .agones.dev.sdk.GameServer.Status.CountersEntry counters = 4;
+
+    pub fn clear_counters(&mut self) {
+        self.counters.clear();
     }
 
     // Param is passed by value, moved
-    pub fn set_deletion_timestamp(&mut self, v: i64) {
-        self.deletion_timestamp = v;
+    pub fn set_counters(&mut self, v: ::std::collections::HashMap<::std::string::String, GameServer_Status_Counter>) {
+        self.counters = v;
     }
 
-    pub fn get_deletion_timestamp(&self) -> i64 {
-        self.deletion_timestamp
+    // Mutable pointer to the field.
+    pub fn mut_counters(&mut self) -> &mut ::std::collections::HashMap<::std::string::String, GameServer_Status_Counter> {
+        &mut self.counters
     }
 
-    // repeated .agones.dev.sdk.GameServer.ObjectMeta.AnnotationsEntry annotations = 8;
+    // Take field
+    pub fn take_counters(&mut self) -> ::std::collections::HashMap<::std::string::String, GameServer_Status_Counter> {
+        ::std::mem::replace(&mut self.counters, ::std::collections::HashMap::new())
+    }
 
-    pub fn clear_annotations(&mut self) {
-        self.annotations.clear();
+    pub fn get_counters(&self) -> &::std::collections::HashMap<::std::string::String, GameServer_Status_Counter> {
+        &self.counters
+    }
+
+    // repeated .agones.dev.sdk.GameServer.Status.ListsEntry lists = 5;
+
+    pub fn clear_lists(&mut self) {
+        self.lists.clear();
     }
 
     // Param is passed by value, moved
-    pub fn set_annotations(&mut self, v: ::std::collections::HashMap<::std::string::String, ::std::string::String>) {
-        self.annotations = v;
+    pub fn set_lists(&mut self, v: ::std::collections::HashMap<::std::string::String, GameServer_Status_List>) {
+        self.lists = v;
     }
 
     // Mutable pointer to the field.
-    pub fn mut_annotations(&mut self) -> &mut ::std::collections::HashMap<::std::string::String, ::std::string::String> {
-        &mut self.annotations
+    pub fn mut_lists(&mut self) -> &mut ::std::collections::HashMap<::std::string::String, GameServer_Status_List> {
+        &mut self.lists
     }
 
     // Take field
-    pub fn take_annotations(&mut self) -> ::std::collections::HashMap<::std::string::String, ::std::string::String> {
-        ::std::mem::replace(&mut self.annotations, ::std::collections::HashMap::new())
+    pub fn take_lists(&mut self) -> ::std::collections::HashMap<::std::string::String, GameServer_Status_List> {
+        ::std::mem::replace(&mut self.lists, ::std::collections::HashMap::new())
     }
 
-    pub fn get_annotations(&self) -> &::std::collections::HashMap<::std::string::String, ::std::string::String> {
-        &self.annotations
+    pub fn get_lists(&self) -> &::std::collections::HashMap<::std::string::String, GameServer_Status_List> {
+        &self.lists
     }
 
-    // repeated .agones.dev.sdk.GameServer.ObjectMeta.LabelsEntry labels = 9;
+    // .agones.dev.sdk.GameServer.Status.Disruption disruption = 6;
 
-    pub fn clear_labels(&mut self) {
-        self.labels.clear();
+    pub fn clear_disruption(&mut self) {
+        self.disruption.clear();
+    }
+
+    pub fn has_disruption(&self) -> bool {
+        self.disruption.is_some()
     }
 
     // Param is passed by value, moved
-    pub fn set_labels(&mut self, v: ::std::collections::HashMap<::std::string::String, ::std::string::String>) {
-        self.labels = v;
+    pub fn set_disruption(&mut self, v: GameServer_Status_Disruption) {
+        self.disruption = ::protobuf::SingularPtrField::some(v);
     }
 
     // Mutable pointer to the field.
-    pub fn mut_labels(&mut self) -> &mut ::std::collections::HashMap<::std::string::String, ::std::string::String> {
-        &mut self.labels
+    // If field is not initialized, it is initialized with default value first.
+    pub fn mut_disruption(&mut self) -> &mut GameServer_Status_Disruption {
+        if self.disruption.is_none() {
+            self.disruption.set_default();
+        }
+        self.disruption.as_mut().unwrap()
     }
 
     // Take field
-    pub fn take_labels(&mut self) -> ::std::collections::HashMap<::std::string::String, ::std::string::String> {
-        ::std::mem::replace(&mut self.labels, ::std::collections::HashMap::new())
+    pub fn take_disruption(&mut self) -> GameServer_Status_Disruption {
+        self.disruption.take().unwrap_or_else(|| GameServer_Status_Disruption::new())
     }
 
-    pub fn get_labels(&self) -> &::std::collections::HashMap<::std::string::String, ::std::string::String> {
-        &self.labels
+    pub fn get_disruption(&self) -> &GameServer_Status_Disruption {
+        self.disruption.as_ref().unwrap_or_else(|| GameServer_Status_Disruption::default_instance())
     }
 }
 
-impl ::protobuf::Message for GameServer_ObjectMeta {
+impl ::protobuf::Message for GameServer_Status {
     fn is_initialized(&self) -> bool {
+        for v in &self.ports {
+            if !v.is_initialized() {
+                return false;
+            }
+        };
+        for v in &self.disruption {
+            if !v.is_initialized() {
+                return false;
+            }
+        };
         true
     }
 
@@ -1041,43 +2319,22 @@ impl ::protobuf::Message for GameServer_ObjectMeta {
             let (field_number, wire_type) = is.read_tag_unpack()?;
             match field_number {
                 1 => {
-                    ::protobuf::rt::read_singular_proto3_string_into(wire_type, is, &mut self.name)?;
+                    ::protobuf::rt::read_singular_proto3_string_into(wire_type, is, &mut self.state)?;
                 },
                 2 => {
-                    ::protobuf::rt::read_singular_proto3_string_into(wire_type, is, &mut self.namespace)?;
+                    ::protobuf::rt::read_singular_proto3_string_into(wire_type, is, &mut self.address)?;
                 },
                 3 => {
-                    ::protobuf::rt::read_singular_proto3_string_into(wire_type, is, &mut self.uid)?;
+                    ::protobuf::rt::read_repeated_message_into(wire_type, is, &mut self.ports)?;
                 },
                 4 => {
-                    ::protobuf::rt::read_singular_proto3_string_into(wire_type, is, &mut self.resource_version)?;
+                    ::protobuf::rt::read_map_into::<::protobuf::types::ProtobufTypeString, ::protobuf::types::ProtobufTypeMessage<GameServer_Status_Counter>>(wire_type, is, &mut self.counters)?;
                 },
                 5 => {
-                    if wire_type != ::protobuf::wire_format::WireTypeVarint {
-                        return ::std::result::Result::Err(::protobuf::rt::unexpected_wire_type(wire_type));
-                    }
-                    let tmp = is.read_int64()?;
-                    self.generation = tmp;
+                    ::protobuf::rt::read_map_into::<::protobuf::types::ProtobufTypeString, ::protobuf::types::ProtobufTypeMessage<GameServer_Status_List>>(wire_type, is, &mut self.lists)?;
                 },
                 6 => {
-                    if wire_type != ::protobuf::wire_format::WireTypeVarint {
-                        return ::std::result::Result::Err(::protobuf::rt::unexpected_wire_type(wire_type));
-                    }
-                    let tmp = is.read_int64()?;
-                    self.creation_timestamp = tmp;
-                },
-                7 => {
-                    if wire_type != ::protobuf::wire_format::WireTypeVarint {
-                        return ::std::result::Result::Err(::protobuf::rt::unexpected_wire_type(wire_type));
-                    }
-                    let tmp = is.read_int64()?;
-                    self.deletion_timestamp = tmp;
-                },
-                8 => {
-                    ::protobuf::rt::read_map_into::<::protobuf::types::ProtobufTypeString, ::protobuf::types::ProtobufTypeString>(wire_type, is, &mut self.annotations)?;
-                },
-                9 => {
-                    ::protobuf::rt::read_map_into::<::protobuf::types::ProtobufTypeString, ::protobuf::types::ProtobufTypeString>(wire_type, is, &mut self.labels)?;
+                    ::protobuf::rt::read_singular_message_into(wire_type, is, &mut self.disruption)?;
                 },
                 _ => {
                     ::protobuf::rt::read_unknown_or_skip_group(field_number, wire_type, is, self.mut_unknown_fields())?;
@@ -1091,58 +2348,46 @@ impl ::protobuf::Message for GameServer_ObjectMeta {
     #[allow(unused_variables)]
     fn compute_size(&self) -> u32 {
         let mut my_size = 0;
-        if !self.name.is_empty() {
-            my_size += ::protobuf::rt::string_size(1, &self.name);
-        }
-        if !self.namespace.is_empty() {
-            my_size += ::protobuf::rt::string_size(2, &self.namespace);
-        }
-        if !self.uid.is_empty() {
-            my_size += ::protobuf::rt::string_size(3, &self.uid);
-        }
-        if !self.resource_version.is_empty() {
-            my_size += ::protobuf::rt::string_size(4, &self.resource_version);
-        }
-        if self.generation != 0 {
-            my_size += ::protobuf::rt::value_size(5, self.generation, ::protobuf::wire_format::WireTypeVarint);
+        if !self.state.is_empty() {
+            my_size += ::protobuf::rt::string_size(1, &self.state);
         }
-        if self.creation_timestamp != 0 {
-            my_size += ::protobuf::rt::value_size(6, self.creation_timestamp, ::protobuf::wire_format::WireTypeVarint);
+        if !self.address.is_empty() {
+            my_size += ::protobuf::rt::string_size(2, &self.address);
         }
-        if self.deletion_timestamp != 0 {
-            my_size += ::protobuf::rt::value_size(7, self.deletion_timestamp, ::protobuf::wire_format::WireTypeVarint);
+        for value in &self.ports {
+            let len = value.compute_size();
+            my_size += 1 + ::protobuf::rt::compute_raw_varint32_size(len) + len;
+        };
+        my_size += ::protobuf::rt::compute_map_size::<::protobuf::types::ProtobufTypeString, ::protobuf::types::ProtobufTypeMessage<GameServer_Status_Counter>>(4, &self.counters);
+        my_size += ::protobuf::rt::compute_map_size::<::protobuf::types::ProtobufTypeString, ::protobuf::types::ProtobufTypeMessage<GameServer_Status_List>>(5, &self.lists);
+        if let Some(ref v) = self.disruption.as_ref() {
+            let len = v.compute_size();
+            my_size += 1 + ::protobuf::rt::compute_raw_varint32_size(len) + len;
         }
-        my_size += ::protobuf::rt::compute_map_size::<::protobuf::types::ProtobufTypeString, ::protobuf::types::ProtobufTypeString>(8, &self.annotations);
-        my_size += ::protobuf::rt::compute_map_size::<::protobuf::types::ProtobufTypeString, ::protobuf::types::ProtobufTypeString>(9, &self.labels);
         my_size += ::protobuf::rt::unknown_fields_size(self.get_unknown_fields());
         self.cached_size.set(my_size);
         my_size
     }
 
     fn write_to_with_cached_sizes(&self, os: &mut ::protobuf::CodedOutputStream) -> ::protobuf::ProtobufResult<()> {
-        if !self.name.is_empty() {
-            os.write_string(1, &self.name)?;
-        }
-        if !self.namespace.is_empty() {
-            os.write_string(2, &self.namespace)?;
-        }
-        if !self.uid.is_empty() {
-            os.write_string(3, &self.uid)?;
-        }
-        if !self.resource_version.is_empty() {
-            os.write_string(4, &self.resource_version)?;
-        }
-        if self.generation != 0 {
-            os.write_int64(5, self.generation)?;
+        if !self.state.is_empty() {
+            os.write_string(1, &self.state)?;
         }
-        if self.creation_timestamp != 0 {
-            os.write_int64(6, self.creation_timestamp)?;
+        if !self.address.is_empty() {
+            os.write_string(2, &self.address)?;
         }
-        if self.deletion_timestamp != 0 {
-            os.write_int64(7, self.deletion_timestamp)?;
+        for v in &self.ports {
+            os.write_tag(3, ::protobuf::wire_format::WireTypeLengthDelimited)?;
+            os.write_raw_varint32(v.get_cached_size())?;
+            v.write_to_with_cached_sizes(os)?;
+        };
+        ::protobuf::rt::write_map_with_cached_sizes::<::protobuf::types::ProtobufTypeString, ::protobuf::types::ProtobufTypeMessage<GameServer_Status_Counter>>(4, &self.counters, os)?;
+        ::protobuf::rt::write_map_with_cached_sizes::<::protobuf::types::ProtobufTypeString, ::protobuf::types::ProtobufTypeMessage<GameServer_Status_List>>(5, &self.lists, os)?;
+        if let Some(ref v) = self.disruption.as_ref() {
+            os.write_tag(6, ::protobuf::wire_format::WireTypeLengthDelimited)?;
+            os.write_raw_varint32(v.get_cached_size())?;
+            v.write_to_with_cached_sizes(os)?;
         }
-        ::protobuf::rt::write_map_with_cached_sizes::<::protobuf::types::ProtobufTypeString, ::protobuf::types::ProtobufTypeString>(8, &self.annotations, os)?;
-        ::protobuf::rt::write_map_with_cached_sizes::<::protobuf::types::ProtobufTypeString, ::protobuf::types::ProtobufTypeString>(9, &self.labels, os)?;
         os.write_unknown_fields(self.get_unknown_fields())?;
         ::std::result::Result::Ok(())
     }
@@ -1173,8 +2418,8 @@ impl ::protobuf::Message for GameServer_ObjectMeta {
         Self::descriptor_static()
     }
 
-    fn new() -> GameServer_ObjectMeta {
-        GameServer_ObjectMeta::new()
+    fn new() -> GameServer_Status {
+        GameServer_Status::new()
     }
 
     fn descriptor_static() -> &'static ::protobuf::reflect::MessageDescriptor {
@@ -1186,52 +2431,37 @@ impl ::protobuf::Message for GameServer_ObjectMeta {
             descriptor.get(|| {
                 let mut fields = ::std::vec::Vec::new();
                 fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeString>(
-                    "name",
-                    |m: &GameServer_ObjectMeta| { &m.name },
-                    |m: &mut GameServer_ObjectMeta| { &mut m.name },
-                ));
-                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeString>(
-                    "namespace",
-                    |m: &GameServer_ObjectMeta| { &m.namespace },
-                    |m: &mut GameServer_ObjectMeta| { &mut m.namespace },
-                ));
-                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeString>(
-                    "uid",
-                    |m: &GameServer_ObjectMeta| { &m.uid },
-                    |m: &mut GameServer_ObjectMeta| { &mut m.uid },
-                ));
-                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeString>(
-                    "resource_version",
-                    |m: &GameServer_ObjectMeta| { &m.resource_version },
-                    |m: &mut GameServer_ObjectMeta| { &mut m.resource_version },
+                    "state",
+                    |m: &GameServer_Status| { &m.state },
+                    |m: &mut GameServer_Status| { &mut m.state },
                 ));
-                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeInt64>(
-                    "generation",
-                    |m: &GameServer_ObjectMeta| { &m.generation },
-                    |m: &mut GameServer_ObjectMeta| { &mut m.generation },
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeString>(
+                    "address",
+                    |m: &GameServer_Status| { &m.address },
+                    |m: &mut GameServer_Status| { &mut m.address },
                 ));
-                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeInt64>(
-                    "creation_timestamp",
-                    |m: &GameServer_ObjectMeta| { &m.creation_timestamp },
-                    |m: &mut GameServer_ObjectMeta| { &mut m.creation_timestamp },
+                fields.push(::protobuf::reflect::accessor::make_repeated_field_accessor::<_, ::protobuf::types::ProtobufTypeMessage<GameServer_Status_Port>>(
+                    "ports",
+                    |m: &GameServer_Status| { &m.ports },
+                    |m: &mut GameServer_Status| { &mut m.ports },
                 ));
-                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeInt64>(
-                    "deletion_timestamp",
-                    |m: &GameServer_ObjectMeta| { &m.deletion_timestamp },
-                    |m: &mut GameServer_ObjectMeta| { &mut m.deletion_timestamp },
+                fields.push(::protobuf::reflect::accessor::make_map_accessor::<_, ::protobuf::types::ProtobufTypeString, ::protobuf::types::ProtobufTypeMessage<GameServer_Status_Counter>>(
+                    "counters",
+                    |m: &GameServer_Status| { &m.counters },
+                    |m: &mut GameServer_Status| { &mut m.counters },
                 ));
-                fields.push(::protobuf::reflect::accessor::make_map_accessor::<_, ::protobuf::types::ProtobufTypeString, ::protobuf::types::ProtobufTypeString>(
-                    "annotations",
-                    |m: &GameServer_ObjectMeta| { &m.annotations },
-                    |m: &mut GameServer_ObjectMeta| { &mut m.annotations },
+                fields.push(::protobuf::reflect::accessor::make_map_accessor::<_, ::protobuf::types::ProtobufTypeString, ::protobuf::types::ProtobufTypeMessage<GameServer_Status_List>>(
+                    "lists",
+                    |m: &GameServer_Status| { &m.lists },
+                    |m: &mut GameServer_Status| { &mut m.lists },
                 ));
-                fields.push(::protobuf::reflect::accessor::make_map_accessor::<_, ::protobuf::types::ProtobufTypeString, ::protobuf::types::ProtobufTypeString>(
-                    "labels",
-                    |m: &GameServer_ObjectMeta| { &m.labels },
-                    |m: &mut GameServer_ObjectMeta| { &mut m.labels },
+                fields.push(::protobuf::reflect::accessor::make_singular_ptr_field_accessor::<_, ::protobuf::types::ProtobufTypeMessage<GameServer_Status_Disruption>>(
+                    "disruption",
+                    |m: &GameServer_Status| { &m.disruption },
+                    |m: &mut GameServer_Status| { &mut m.disruption },
                 ));
-                ::protobuf::reflect::MessageDescriptor::new::<GameServer_ObjectMeta>(
-                    "GameServer_ObjectMeta",
+                ::protobuf::reflect::MessageDescriptor::new::<GameServer_Status>(
+                    "GameServer_Status",
                     fields,
                     file_descriptor_proto()
                 )
@@ -1239,99 +2469,100 @@ impl ::protobuf::Message for GameServer_ObjectMeta {
         }
     }
 
-    fn default_instance() -> &'static GameServer_ObjectMeta {
-        static mut instance: ::protobuf::lazy::Lazy<GameServer_ObjectMeta> = ::protobuf::lazy::Lazy {
+    fn default_instance() -> &'static GameServer_Status {
+        static mut instance: ::protobuf::lazy::Lazy<GameServer_Status> = ::protobuf::lazy::Lazy {
             lock: ::protobuf::lazy::ONCE_INIT,
-            ptr: 0 as *const GameServer_ObjectMeta,
+            ptr: 0 as *const GameServer_Status,
         };
         unsafe {
-            instance.get(GameServer_ObjectMeta::new)
+            instance.get(GameServer_Status::new)
         }
     }
 }
 
-impl ::protobuf::Clear for GameServer_ObjectMeta {
+impl ::protobuf::Clear for GameServer_Status {
     fn clear(&mut self) {
-        self.clear_name();
-        self.clear_namespace();
-        self.clear_uid();
-        self.clear_resource_version();
-        self.clear_generation();
-        self.clear_creation_timestamp();
-        self.clear_deletion_timestamp();
-        self.clear_annotations();
-        self.clear_labels();
+        self.clear_state();
+        self.clear_address();
+        self.clear_ports();
+        self.clear_counters();
+        self.clear_lists();
+        self.clear_disruption();
         self.unknown_fields.clear();
     }
 }
 
-impl ::std::fmt::Debug for GameServer_ObjectMeta {
+impl ::std::fmt::Debug for GameServer_Status {
     fn fmt(&self, f: &mut ::std::fmt::Formatter) -> ::std::fmt::Result {
         ::protobuf::text_format::fmt(self, f)
     }
 }
 
-impl ::protobuf::reflect::ProtobufValue for GameServer_ObjectMeta {
+impl ::protobuf::reflect::ProtobufValue for GameServer_Status {
     fn as_ref(&self) -> ::protobuf::reflect::ProtobufValueRef {
         ::protobuf::reflect::ProtobufValueRef::Message(self)
     }
 }
 
 #[derive(PartialEq,Clone,Default)]
-pub struct GameServer_Spec {
+pub struct GameServer_Status_Port {
     // message fields
-    pub health: ::protobuf::SingularPtrField<GameServer_Spec_Health>,
+    pub name: ::std::string::String,
+    pub port: i32,
     // special fields
     unknown_fields: ::protobuf::UnknownFields,
     cached_size: ::protobuf::CachedSize,
 }
 
-impl GameServer_Spec {
-    pub fn new() -> GameServer_Spec {
+impl GameServer_Status_Port {
+    pub fn new() -> GameServer_Status_Port {
         ::std::default::Default::default()
     }
 
-    // .agones.dev.sdk.GameServer.Spec.Health health = 1;
-
-    pub fn clear_health(&mut self) {
-        self.health.clear();
-    }
+    // string name = 1;
 
-    pub fn has_health(&self) -> bool {
-        self.health.is_some()
+    pub fn clear_name(&mut self) {
+        self.name.clear();
     }
 
     // Param is passed by value, moved
-    pub fn set_health(&mut self, v: GameServer_Spec_Health) {
-        self.health = ::protobuf::SingularPtrField::some(v);
+    pub fn set_name(&mut self, v: ::std::string::String) {
+        self.name = v;
     }
 
     // Mutable pointer to the field.
     // If field is not initialized, it is initialized with default value first.
-    pub fn mut_health(&mut self) -> &mut GameServer_Spec_Health {
-        if self.health.is_none() {
-            self.health.set_default();
-        }
-        self.health.as_mut().unwrap()
+    pub fn mut_name(&mut self) -> &mut ::std::string::String {
+        &mut self.name
     }
 
     // Take field
-    pub fn take_health(&mut self) -> GameServer_Spec_Health {
-        self.health.take().unwrap_or_else(|| GameServer_Spec_Health::new())
+    pub fn take_name(&mut self) -> ::std::string::String {
+        ::std::mem::replace(&mut self.name, ::std::string::String::new())
     }
 
-    pub fn get_health(&self) -> &GameServer_Spec_Health {
-        self.health.as_ref().unwrap_or_else(|| GameServer_Spec_Health::default_instance())
+    pub fn get_name(&self) -> &str {
+        &self.name
+    }
+
+    // int32 port = 2;
+
+    pub fn clear_port(&mut self) {
+        self.port = 0;
+    }
+
+    // Param is passed by value, moved
+    pub fn set_port(&mut self, v: i32) {
+        self.port = v;
+    }
+
+    pub fn get_port(&self) -> i32 {
+        self.port
     }
 }
 
-impl ::protobuf::Message for GameServer_Spec {
+impl ::protobuf::Message for GameServer_Status_Port {
     fn is_initialized(&self) -> bool {
-        for v in &self.health {
-            if !v.is_initialized() {
-                return false;
-            }
-        };
         true
     }
 
@@ -1340,7 +2571,14 @@ impl ::protobuf::Message for GameServer_Spec {
             let (field_number, wire_type) = is.read_tag_unpack()?;
             match field_number {
                 1 => {
-                    ::protobuf::rt::read_singular_message_into(wire_type, is, &mut self.health)?;
+                    ::protobuf::rt::read_singular_proto3_string_into(wire_type, is, &mut self.name)?;
+                },
+                2 => {
+                    if wire_type != ::protobuf::wire_format::WireTypeVarint {
+                        return ::std::result::Result::Err(::protobuf::rt::unexpected_wire_type(wire_type));
+                    }
+                    let tmp = is.read_int32()?;
+                    self.port = tmp;
                 },
                 _ => {
                     ::protobuf::rt::read_unknown_or_skip_group(field_number, wire_type, is, self.mut_unknown_fields())?;
@@ -1354,9 +2592,11 @@ impl ::protobuf::Message for GameServer_Spec {
     #[allow(unused_variables)]
     fn compute_size(&self) -> u32 {
         let mut my_size = 0;
-        if let Some(ref v) = self.health.as_ref() {
-            let len = v.compute_size();
-            my_size += 1 + ::protobuf::rt::compute_raw_varint32_size(len) + len;
+        if !self.name.is_empty() {
+            my_size += ::protobuf::rt::string_size(1, &self.name);
+        }
+        if self.port != 0 {
+            my_size += ::protobuf::rt::value_size(2, self.port, ::protobuf::wire_format::WireTypeVarint);
         }
         my_size += ::protobuf::rt::unknown_fields_size(self.get_unknown_fields());
         self.cached_size.set(my_size);
@@ -1364,10 +2604,11 @@ impl ::protobuf::Message for GameServer_Spec {
     }
 
     fn write_to_with_cached_sizes(&self, os: &mut ::protobuf::CodedOutputStream) -> ::protobuf::ProtobufResult<()> {
-        if let Some(ref v) = self.health.as_ref() {
-            os.write_tag(1, ::protobuf::wire_format::WireTypeLengthDelimited)?;
-            os.write_raw_varint32(v.get_cached_size())?;
-            v.write_to_with_cached_sizes(os)?;
+        if !self.name.is_empty() {
+            os.write_string(1, &self.name)?;
+        }
+        if self.port != 0 {
+            os.write_int32(2, self.port)?;
         }
         os.write_unknown_fields(self.get_unknown_fields())?;
         ::std::result::Result::Ok(())
@@ -1399,8 +2640,8 @@ impl ::protobuf::Message for GameServer_Spec {
         Self::descriptor_static()
     }
 
-    fn new() -> GameServer_Spec {
-        GameServer_Spec::new()
+    fn new() -> GameServer_Status_Port {
+        GameServer_Status_Port::new()
     }
 
     fn descriptor_static() -> &'static ::protobuf::reflect::MessageDescriptor {
@@ -1411,13 +2652,18 @@ impl ::protobuf::Message for GameServer_Spec {
         unsafe {
             descriptor.get(|| {
                 let mut fields = ::std::vec::Vec::new();
-                fields.push(::protobuf::reflect::accessor::make_singular_ptr_field_accessor::<_, ::protobuf::types::ProtobufTypeMessage<GameServer_Spec_Health>>(
-                    "health",
-                    |m: &GameServer_Spec| { &m.health },
-                    |m: &mut GameServer_Spec| { &mut m.health },
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeString>(
+                    "name",
+                    |m: &GameServer_Status_Port| { &m.name },
+                    |m: &mut GameServer_Status_Port| { &mut m.name },
                 ));
-                ::protobuf::reflect::MessageDescriptor::new::<GameServer_Spec>(
-                    "GameServer_Spec",
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeInt32>(
+                    "port",
+                    |m: &GameServer_Status_Port| { &m.port },
+                    |m: &mut GameServer_Status_Port| { &mut m.port },
+                ));
+                ::protobuf::reflect::MessageDescriptor::new::<GameServer_Status_Port>(
+                    "GameServer_Status_Port",
                     fields,
                     file_descriptor_proto()
                 )
@@ -1425,115 +2671,84 @@ impl ::protobuf::Message for GameServer_Spec {
         }
     }
 
-    fn default_instance() -> &'static GameServer_Spec {
-        static mut instance: ::protobuf::lazy::Lazy<GameServer_Spec> = ::protobuf::lazy::Lazy {
+    fn default_instance() -> &'static GameServer_Status_Port {
+        static mut instance: ::protobuf::lazy::Lazy<GameServer_Status_Port> = ::protobuf::lazy::Lazy {
             lock: ::protobuf::lazy::ONCE_INIT,
-            ptr: 0 as *const GameServer_Spec,
+            ptr: 0 as *const GameServer_Status_Port,
         };
         unsafe {
-            instance.get(GameServer_Spec::new)
+            instance.get(GameServer_Status_Port::new)
         }
     }
 }
 
-impl ::protobuf::Clear for GameServer_Spec {
+impl ::protobuf::Clear for GameServer_Status_Port {
     fn clear(&mut self) {
-        self.clear_health();
+        self.clear_name();
+        self.clear_port();
         self.unknown_fields.clear();
     }
 }
 
-impl ::std::fmt::Debug for GameServer_Spec {
+impl ::std::fmt::Debug for GameServer_Status_Port {
     fn fmt(&self, f: &mut ::std::fmt::Formatter) -> ::std::fmt::Result {
         ::protobuf::text_format::fmt(self, f)
     }
 }
 
-impl ::protobuf::reflect::ProtobufValue for GameServer_Spec {
+impl ::protobuf::reflect::ProtobufValue for GameServer_Status_Port {
     fn as_ref(&self) -> ::protobuf::reflect::ProtobufValueRef {
         ::protobuf::reflect::ProtobufValueRef::Message(self)
     }
 }
 
 #[derive(PartialEq,Clone,Default)]
-pub struct GameServer_Spec_Health {
+pub struct GameServer_Status_Counter {
     // message fields
-    pub disabled: bool,
-    pub period_seconds: i32,
-    pub failure_threshold: i32,
-    pub initial_delay_seconds: i32,
+    pub count: i64,
+    pub capacity: i64,
     // special fields
     unknown_fields: ::protobuf::UnknownFields,
     cached_size: ::protobuf::CachedSize,
 }
 
-impl GameServer_Spec_Health {
-    pub fn new() -> GameServer_Spec_Health {
+impl GameServer_Status_Counter {
+    pub fn new() -> GameServer_Status_Counter {
         ::std::default::Default::default()
     }
 
-    // bool disabled = 1;
-
-    pub fn clear_disabled(&mut self) {
-        self.disabled = false;
-    }
-
-    // Param is passed by value, moved
-    pub fn set_disabled(&mut self, v: bool) {
-        self.disabled = v;
-    }
-
-    pub fn get_disabled(&self) -> bool {
-        self.disabled
-    }
-
-    // int32 period_seconds = 2;
-
-    pub fn clear_period_seconds(&mut self) {
-        self.period_seconds = 0;
-    }
-
-    // Param is passed by value, moved
-    pub fn set_period_seconds(&mut self, v: i32) {
-        self.period_seconds = v;
-    }
-
-    pub fn get_period_seconds(&self) -> i32 {
-        self.period_seconds
-    }
-
-    // int32 failure_threshold = 3;
+    // int64 count = 1;
 
-    pub fn clear_failure_threshold(&mut self) {
-        self.failure_threshold = 0;
+    pub fn clear_count(&mut self) {
+        self.count = 0;
     }
 
     // Param is passed by value, moved
-    pub fn set_failure_threshold(&mut self, v: i32) {
-        self.failure_threshold = v;
+    pub fn set_count(&mut self, v: i64) {
+        self.count = v;
     }
 
-    pub fn get_failure_threshold(&self) -> i32 {
-        self.failure_threshold
+    pub fn get_count(&self) -> i64 {
+        self.count
     }
 
-    // int32 initial_delay_seconds = 4;
+    // int64 capacity = 2;
 
-    pub fn clear_initial_delay_seconds(&mut self) {
-        self.initial_delay_seconds = 0;
+    pub fn clear_capacity(&mut self) {
+        self.capacity = 0;
     }
 
     // Param is passed by value, moved
-    pub fn set_initial_delay_seconds(&mut self, v: i32) {
-        self.initial_delay_seconds = v;
+    pub fn set_capacity(&mut self, v: i64) {
+        self.capacity = v;
     }
 
-    pub fn get_initial_delay_seconds(&self) -> i32 {
-        self.initial_delay_seconds
+    pub fn get_capacity(&self) -> i64 {
+        self.capacity
     }
 }
 
-impl ::protobuf::Message for GameServer_Spec_Health {
+impl ::protobuf::Message for GameServer_Status_Counter {
     fn is_initialized(&self) -> bool {
         true
     }
@@ -1546,29 +2761,15 @@ impl ::protobuf::Message for GameServer_Spec_Health {
                     if wire_type != ::protobuf::wire_format::WireTypeVarint {
                         return ::std::result::Result::Err(::protobuf::rt::unexpected_wire_type(wire_type));
                     }
-                    let tmp = is.read_bool()?;
-                    self.disabled = tmp;
+                    let tmp = is.read_int64()?;
+                    self.count = tmp;
                 },
                 2 => {
                     if wire_type != ::protobuf::wire_format::WireTypeVarint {
                         return ::std::result::Result::Err(::protobuf::rt::unexpected_wire_type(wire_type));
-                    }
-                    let tmp = is.read_int32()?;
-                    self.period_seconds = tmp;
-                },
-                3 => {
-                    if wire_type != ::protobuf::wire_format::WireTypeVarint {
-                        return ::std::result::Result::Err(::protobuf::rt::unexpected_wire_type(wire_type));
-                    }
-                    let tmp = is.read_int32()?;
-                    self.failure_threshold = tmp;
-                },
-                4 => {
-                    if wire_type != ::protobuf::wire_format::WireTypeVarint {
-                        return ::std::result::Result::Err(::protobuf::rt::unexpected_wire_type(wire_type));
-                    }
-                    let tmp = is.read_int32()?;
-                    self.initial_delay_seconds = tmp;
+                    }
+                    let tmp = is.read_int64()?;
+                    self.capacity = tmp;
                 },
                 _ => {
                     ::protobuf::rt::read_unknown_or_skip_group(field_number, wire_type, is, self.mut_unknown_fields())?;
@@ -1582,17 +2783,11 @@ impl ::protobuf::Message for GameServer_Spec_Health {
     #[allow(unused_variables)]
     fn compute_size(&self) -> u32 {
         let mut my_size = 0;
-        if self.disabled != false {
-            my_size += 2;
-        }
-        if self.period_seconds != 0 {
-            my_size += ::protobuf::rt::value_size(2, self.period_seconds, ::protobuf::wire_format::WireTypeVarint);
-        }
-        if self.failure_threshold != 0 {
-            my_size += ::protobuf::rt::value_size(3, self.failure_threshold, ::protobuf::wire_format::WireTypeVarint);
+        if self.count != 0 {
+            my_size += ::protobuf::rt::value_size(1, self.count, ::protobuf::wire_format::WireTypeVarint);
         }
-        if self.initial_delay_seconds != 0 {
-            my_size += ::protobuf::rt::value_size(4, self.initial_delay_seconds, ::protobuf::wire_format::WireTypeVarint);
+        if self.capacity != 0 {
+            my_size += ::protobuf::rt::value_size(2, self.capacity, ::protobuf::wire_format::WireTypeVarint);
         }
         my_size += ::protobuf::rt::unknown_fields_size(self.get_unknown_fields());
         self.cached_size.set(my_size);
@@ -1600,17 +2795,11 @@ impl ::protobuf::Message for GameServer_Spec_Health {
     }
 
     fn write_to_with_cached_sizes(&self, os: &mut ::protobuf::CodedOutputStream) -> ::protobuf::ProtobufResult<()> {
-        if self.disabled != false {
-            os.write_bool(1, self.disabled)?;
-        }
-        if self.period_seconds != 0 {
-            os.write_int32(2, self.period_seconds)?;
-        }
-        if self.failure_threshold != 0 {
-            os.write_int32(3, self.failure_threshold)?;
+        if self.count != 0 {
+            os.write_int64(1, self.count)?;
         }
-        if self.initial_delay_seconds != 0 {
-            os.write_int32(4, self.initial_delay_seconds)?;
+        if self.capacity != 0 {
+            os.write_int64(2, self.capacity)?;
         }
         os.write_unknown_fields(self.get_unknown_fields())?;
         ::std::result::Result::Ok(())
@@ -1642,8 +2831,8 @@ impl ::protobuf::Message for GameServer_Spec_Health {
         Self::descriptor_static()
     }
 
-    fn new() -> GameServer_Spec_Health {
-        GameServer_Spec_Health::new()
+    fn new() -> GameServer_Status_Counter {
+        GameServer_Status_Counter::new()
     }
 
     fn descriptor_static() -> &'static ::protobuf::reflect::MessageDescriptor {
@@ -1654,28 +2843,18 @@ impl ::protobuf::Message for GameServer_Spec_Health {
         unsafe {
             descriptor.get(|| {
                 let mut fields = ::std::vec::Vec::new();
-                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeBool>(
-                    "disabled",
-                    |m: &GameServer_Spec_Health| { &m.disabled },
-                    |m: &mut GameServer_Spec_Health| { &mut m.disabled },
-                ));
-                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeInt32>(
-                    "period_seconds",
-                    |m: &GameServer_Spec_Health| { &m.period_seconds },
-                    |m: &mut GameServer_Spec_Health| { &mut m.period_seconds },
-                ));
-                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeInt32>(
-                    "failure_threshold",
-                    |m: &GameServer_Spec_Health| { &m.failure_threshold },
-                    |m: &mut GameServer_Spec_Health| { &mut m.failure_threshold },
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeInt64>(
+                    "count",
+                    |m: &GameServer_Status_Counter| { &m.count },
+                    |m: &mut GameServer_Status_Counter| { &mut m.count },
                 ));
-                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeInt32>(
-                    "initial_delay_seconds",
-                    |m: &GameServer_Spec_Health| { &m.initial_delay_seconds },
-                    |m: &mut GameServer_Spec_Health| { &mut m.initial_delay_seconds },
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeInt64>(
+                    "capacity",
+                    |m: &GameServer_Status_Counter| { &m.capacity },
+                    |m: &mut GameServer_Status_Counter| { &mut m.capacity },
                 ));
-                ::protobuf::reflect::MessageDescriptor::new::<GameServer_Spec_Health>(
-                    "GameServer_Spec_Health",
+                ::protobuf::reflect::MessageDescriptor::new::<GameServer_Status_Counter>(
+                    "GameServer_Status_Counter",
                     fields,
                     file_descriptor_proto()
                 )
@@ -1683,140 +2862,95 @@ impl ::protobuf::Message for GameServer_Spec_Health {
         }
     }
 
-    fn default_instance() -> &'static GameServer_Spec_Health {
-        static mut instance: ::protobuf::lazy::Lazy<GameServer_Spec_Health> = ::protobuf::lazy::Lazy {
+    fn default_instance() -> &'static GameServer_Status_Counter {
+        static mut instance: ::protobuf::lazy::Lazy<GameServer_Status_Counter> = ::protobuf::lazy::Lazy {
             lock: ::protobuf::lazy::ONCE_INIT,
-            ptr: 0 as *const GameServer_Spec_Health,
+            ptr: 0 as *const GameServer_Status_Counter,
         };
         unsafe {
-            instance.get(GameServer_Spec_Health::new)
+            instance.get(GameServer_Status_Counter::new)
         }
     }
 }
 
-impl ::protobuf::Clear for GameServer_Spec_Health {
+impl ::protobuf::Clear for GameServer_Status_Counter {
     fn clear(&mut self) {
-        self.clear_disabled();
-        self.clear_period_seconds();
-        self.clear_failure_threshold();
-        self.clear_initial_delay_seconds();
+        self.clear_count();
+        self.clear_capacity();
         self.unknown_fields.clear();
     }
 }
 
-impl ::std::fmt::Debug for GameServer_Spec_Health {
+impl ::std::fmt::Debug for GameServer_Status_Counter {
     fn fmt(&self, f: &mut ::std::fmt::Formatter) -> ::std::fmt::Result {
         ::protobuf::text_format::fmt(self, f)
     }
 }
 
-impl ::protobuf::reflect::ProtobufValue for GameServer_Spec_Health {
+impl ::protobuf::reflect::ProtobufValue for GameServer_Status_Counter {
     fn as_ref(&self) -> ::protobuf::reflect::ProtobufValueRef {
         ::protobuf::reflect::ProtobufValueRef::Message(self)
     }
 }
 
 #[derive(PartialEq,Clone,Default)]
-pub struct GameServer_Status {
+pub struct GameServer_Status_List {
     // message fields
-    pub state: ::std::string::String,
-    pub address: ::std::string::String,
-    pub ports: ::protobuf::RepeatedField<GameServer_Status_Port>,
+    pub capacity: i64,
+    pub values: ::protobuf::RepeatedField<::std::string::String>,
     // special fields
     unknown_fields: ::protobuf::UnknownFields,
     cached_size: ::protobuf::CachedSize,
 }
 
-impl GameServer_Status {
-    pub fn new() -> GameServer_Status {
+impl GameServer_Status_List {
+    pub fn new() -> GameServer_Status_List {
         ::std::default::Default::default()
     }
 
-    // string state = 1;
-
-    pub fn clear_state(&mut self) {
-        self.state.clear();
-    }
-
-    // Param is passed by value, moved
-    pub fn set_state(&mut self, v: ::std::string::String) {
-        self.state = v;
-    }
-
-    // Mutable pointer to the field.
-    // If field is not initialized, it is initialized with default value first.
-    pub fn mut_state(&mut self) -> &mut ::std::string::String {
-        &mut self.state
-    }
-
-    // Take field
-    pub fn take_state(&mut self) -> ::std::string::String {
-        ::std::mem::replace(&mut self.state, ::std::string::String::new())
-    }
-
-    pub fn get_state(&self) -> &str {
-        &self.state
-    }
+    // int64 capacity = 1;
 
-    // string address = 2;
-
-    pub fn clear_address(&mut self) {
-        self.address.clear();
+    pub fn clear_capacity(&mut self) {
+        self.capacity = 0;
     }
 
     // Param is passed by value, moved
-    pub fn set_address(&mut self, v: ::std::string::String) {
-        self.address = v;
-    }
-
-    // Mutable pointer to the field.
-    // If field is not initialized, it is initialized with default value first.
-    pub fn mut_address(&mut self) -> &mut ::std::string::String {
-        &mut self.address
-    }
-
-    // Take field
-    pub fn take_address(&mut self) -> ::std::string::String {
-        ::std::mem::replace(&mut self.address, ::std::string::String::new())
+    pub fn set_capacity(&mut self, v: i64) {
+        self.capacity = v;
     }
 
-    pub fn get_address(&self) -> &str {
-        &self.address
+    pub fn get_capacity(&self) -> i64 {
+        self.capacity
     }
 
-    // repeated .agones.dev.sdk.GameServer.Status.Port ports = 3;
+    // repeated string values = 2;
 
-    pub fn clear_ports(&mut self) {
-        self.ports.clear();
+    pub fn clear_values(&mut self) {
+        self.values.clear();
     }
 
     // Param is passed by value, moved
-    pub fn set_ports(&mut self, v: ::protobuf::RepeatedField<GameServer_Status_Port>) {
-        self.ports = v;
+    pub fn set_values(&mut self, v: ::protobuf::RepeatedField<::std::string::String>) {
+        self.values = v;
     }
 
     // Mutable pointer to the field.
-    pub fn mut_ports(&mut self) -> &mut ::protobuf::RepeatedField<GameServer_Status_Port> {
-        &mut self.ports
+    pub fn mut_values(&mut self) -> &mut ::protobuf::RepeatedField<::std::string::String> {
+        &mut self.values
     }
 
     // Take field
-    pub fn take_ports(&mut self) -> ::protobuf::RepeatedField<GameServer_Status_Port> {
-        ::std::mem::replace(&mut self.ports, ::protobuf::RepeatedField::new())
+    pub fn take_values(&mut self) -> ::protobuf::RepeatedField<::std::string::String> {
+        ::std::mem::replace(&mut self.values, ::protobuf::RepeatedField::new())
     }
 
-    pub fn get_ports(&self) -> &[GameServer_Status_Port] {
-        &self.ports
+    pub fn get_values(&self) -> &[::std::string::String] {
+        &self.values
     }
 }
 
-impl ::protobuf::Message for GameServer_Status {
+impl ::protobuf::Message for GameServer_Status_List {
     fn is_initialized(&self) -> bool {
-        for v in &self.ports {
-            if !v.is_initialized() {
-                return false;
-            }
-        };
         true
     }
 
@@ -1825,13 +2959,14 @@ impl ::protobuf::Message for GameServer_Status {
             let (field_number, wire_type) = is.read_tag_unpack()?;
             match field_number {
                 1 => {
-                    ::protobuf::rt::read_singular_proto3_string_into(wire_type, is, &mut self.state)?;
+                    if wire_type != ::protobuf::wire_format::WireTypeVarint {
+                        return ::std::result::Result::Err(::protobuf::rt::unexpected_wire_type(wire_type));
+                    }
+                    let tmp = is.read_int64()?;
+                    self.capacity = tmp;
                 },
                 2 => {
-                    ::protobuf::rt::read_singular_proto3_string_into(wire_type, is, &mut self.address)?;
-                },
-                3 => {
-                    ::protobuf::rt::read_repeated_message_into(wire_type, is, &mut self.ports)?;
+                    ::protobuf::rt::read_repeated_string_into(wire_type, is, &mut self.values)?;
                 },
                 _ => {
                     ::protobuf::rt::read_unknown_or_skip_group(field_number, wire_type, is, self.mut_unknown_fields())?;
@@ -1845,15 +2980,11 @@ impl ::protobuf::Message for GameServer_Status {
     #[allow(unused_variables)]
     fn compute_size(&self) -> u32 {
         let mut my_size = 0;
-        if !self.state.is_empty() {
-            my_size += ::protobuf::rt::string_size(1, &self.state);
-        }
-        if !self.address.is_empty() {
-            my_size += ::protobuf::rt::string_size(2, &self.address);
+        if self.capacity != 0 {
+            my_size += ::protobuf::rt::value_size(1, self.capacity, ::protobuf::wire_format::WireTypeVarint);
         }
-        for value in &self.ports {
-            let len = value.compute_size();
-            my_size += 1 + ::protobuf::rt::compute_raw_varint32_size(len) + len;
+        for value in &self.values {
+            my_size += ::protobuf::rt::string_size(2, &value);
         };
         my_size += ::protobuf::rt::unknown_fields_size(self.get_unknown_fields());
         self.cached_size.set(my_size);
@@ -1861,16 +2992,11 @@ impl ::protobuf::Message for GameServer_Status {
     }
 
     fn write_to_with_cached_sizes(&self, os: &mut ::protobuf::CodedOutputStream) -> ::protobuf::ProtobufResult<()> {
-        if !self.state.is_empty() {
-            os.write_string(1, &self.state)?;
-        }
-        if !self.address.is_empty() {
-            os.write_string(2, &self.address)?;
+        if self.capacity != 0 {
+            os.write_int64(1, self.capacity)?;
         }
-        for v in &self.ports {
-            os.write_tag(3, ::protobuf::wire_format::WireTypeLengthDelimited)?;
-            os.write_raw_varint32(v.get_cached_size())?;
-            v.write_to_with_cached_sizes(os)?;
+        for v in &self.values {
+            os.write_string(2, &v)?;
         };
         os.write_unknown_fields(self.get_unknown_fields())?;
         ::std::result::Result::Ok(())
@@ -1902,8 +3028,8 @@ impl ::protobuf::Message for GameServer_Status {
         Self::descriptor_static()
     }
 
-    fn new() -> GameServer_Status {
-        GameServer_Status::new()
+    fn new() -> GameServer_Status_List {
+        GameServer_Status_List::new()
     }
 
     fn descriptor_static() -> &'static ::protobuf::reflect::MessageDescriptor {
@@ -1914,23 +3040,18 @@ impl ::protobuf::Message for GameServer_Status {
         unsafe {
             descriptor.get(|| {
                 let mut fields = ::std::vec::Vec::new();
-                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeString>(
-                    "state",
-                    |m: &GameServer_Status| { &m.state },
-                    |m: &mut GameServer_Status| { &mut m.state },
-                ));
-                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeString>(
-                    "address",
-                    |m: &GameServer_Status| { &m.address },
-                    |m: &mut GameServer_Status| { &mut m.address },
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeInt64>(
+                    "capacity",
+                    |m: &GameServer_Status_List| { &m.capacity },
+                    |m: &mut GameServer_Status_List| { &mut m.capacity },
                 ));
-                fields.push(::protobuf::reflect::accessor::make_repeated_field_accessor::<_, ::protobuf::types::ProtobufTypeMessage<GameServer_Status_Port>>(
-                    "ports",
-                    |m: &GameServer_Status| { &m.ports },
-                    |m: &mut GameServer_Status| { &mut m.ports },
+                fields.push(::protobuf::reflect::accessor::make_repeated_field_accessor::<_, ::protobuf::types::ProtobufTypeString>(
+                    "values",
+                    |m: &GameServer_Status_List| { &m.values },
+                    |m: &mut GameServer_Status_List| { &mut m.values },
                 ));
-                ::protobuf::reflect::MessageDescriptor::new::<GameServer_Status>(
-                    "GameServer_Status",
+                ::protobuf::reflect::MessageDescriptor::new::<GameServer_Status_List>(
+                    "GameServer_Status_List",
                     fields,
                     file_descriptor_proto()
                 )
@@ -1938,96 +3059,122 @@ impl ::protobuf::Message for GameServer_Status {
         }
     }
 
-    fn default_instance() -> &'static GameServer_Status {
-        static mut instance: ::protobuf::lazy::Lazy<GameServer_Status> = ::protobuf::lazy::Lazy {
+    fn default_instance() -> &'static GameServer_Status_List {
+        static mut instance: ::protobuf::lazy::Lazy<GameServer_Status_List> = ::protobuf::lazy::Lazy {
             lock: ::protobuf::lazy::ONCE_INIT,
-            ptr: 0 as *const GameServer_Status,
+            ptr: 0 as *const GameServer_Status_List,
         };
         unsafe {
-            instance.get(GameServer_Status::new)
+            instance.get(GameServer_Status_List::new)
         }
     }
 }
 
-impl ::protobuf::Clear for GameServer_Status {
+impl ::protobuf::Clear for GameServer_Status_List {
     fn clear(&mut self) {
-        self.clear_state();
-        self.clear_address();
-        self.clear_ports();
+        self.clear_capacity();
+        self.clear_values();
         self.unknown_fields.clear();
     }
 }
 
-impl ::std::fmt::Debug for GameServer_Status {
+impl ::std::fmt::Debug for GameServer_Status_List {
     fn fmt(&self, f: &mut ::std::fmt::Formatter) -> ::std::fmt::Result {
         ::protobuf::text_format::fmt(self, f)
     }
 }
 
-impl ::protobuf::reflect::ProtobufValue for GameServer_Status {
+impl ::protobuf::reflect::ProtobufValue for GameServer_Status_List {
     fn as_ref(&self) -> ::protobuf::reflect::ProtobufValueRef {
         ::protobuf::reflect::ProtobufValueRef::Message(self)
     }
 }
 
 #[derive(PartialEq,Clone,Default)]
-pub struct GameServer_Status_Port {
+pub struct GameServer_Status_Disruption {
     // message fields
-    pub name: ::std::string::String,
-    pub port: i32,
+    pub reason: ::std::string::String,
+    pub message: ::std::string::String,
+    pub since: i64,
     // special fields
     unknown_fields: ::protobuf::UnknownFields,
     cached_size: ::protobuf::CachedSize,
 }
 
-impl GameServer_Status_Port {
-    pub fn new() -> GameServer_Status_Port {
+impl GameServer_Status_Disruption {
+    pub fn new() -> GameServer_Status_Disruption {
         ::std::default::Default::default()
     }
 
-    // string name = 1;
+    // string reason = 1;
 
-    pub fn clear_name(&mut self) {
-        self.name.clear();
+    pub fn clear_reason(&mut self) {
+        self.reason.clear();
     }
 
     // Param is passed by value, moved
-    pub fn set_name(&mut self, v: ::std::string::String) {
-        self.name = v;
+    pub fn set_reason(&mut self, v: ::std::string::String) {
+        self.reason = v;
     }
 
     // Mutable pointer to the field.
     // If field is not initialized, it is initialized with default value first.
-    pub fn mut_name(&mut self) -> &mut ::std::string::String {
-        &mut self.name
+    pub fn mut_reason(&mut self) -> &mut ::std::string::String {
+        &mut self.reason
     }
 
     // Take field
-    pub fn take_name(&mut self) -> ::std::string::String {
-        ::std::mem::replace(&mut self.name, ::std::string::String::new())
+    pub fn take_reason(&mut self) -> ::std::string::String {
+        ::std::mem::replace(&mut self.reason, ::std::string::String::new())
     }
 
-    pub fn get_name(&self) -> &str {
-        &self.name
+    pub fn get_reason(&self) -> &str {
+        &self.reason
     }
 
-    // int32 port = 2;
+    // string message = 2;
 
-    pub fn clear_port(&mut self) {
-        self.port = 0;
+    pub fn clear_message(&mut self) {
+        self.message.clear();
     }
 
     // Param is passed by value, moved
-    pub fn set_port(&mut self, v: i32) {
-        self.port = v;
+    pub fn set_message(&mut self, v: ::std::string::String) {
+        self.message = v;
     }
 
-    pub fn get_port(&self) -> i32 {
-        self.port
+    // Mutable pointer to the field.
+    // If field is not initialized, it is initialized with default value first.
+    pub fn mut_message(&mut self) -> &mut ::std::string::String {
+        &mut self.message
+    }
+
+    // Take field
+    pub fn take_message(&mut self) -> ::std::string::String {
+        ::std::mem::replace(&mut self.message, ::std::string::String::new())
+    }
+
+    pub fn get_message(&self) -> &str {
+        &self.message
+    }
+
+    // int64 since = 3;
+
+    pub fn clear_since(&mut self) {
+        self.since = 0;
+    }
+
+    // Param is passed by value, moved
+    pub fn set_since(&mut self, v: i64) {
+        self.since = v;
+    }
+
+    pub fn get_since(&self) -> i64 {
+        self.since
     }
 }
 
-impl ::protobuf::Message for GameServer_Status_Port {
+impl ::protobuf::Message for GameServer_Status_Disruption {
     fn is_initialized(&self) -> bool {
         true
     }
@@ -2037,14 +3184,17 @@ impl ::protobuf::Message for GameServer_Status_Port {
             let (field_number, wire_type) = is.read_tag_unpack()?;
             match field_number {
                 1 => {
-                    ::protobuf::rt::read_singular_proto3_string_into(wire_type, is, &mut self.name)?;
+                    ::protobuf::rt::read_singular_proto3_string_into(wire_type, is, &mut self.reason)?;
                 },
                 2 => {
+                    ::protobuf::rt::read_singular_proto3_string_into(wire_type, is, &mut self.message)?;
+                },
+                3 => {
                     if wire_type != ::protobuf::wire_format::WireTypeVarint {
                         return ::std::result::Result::Err(::protobuf::rt::unexpected_wire_type(wire_type));
                     }
-                    let tmp = is.read_int32()?;
-                    self.port = tmp;
+                    let tmp = is.read_int64()?;
+                    self.since = tmp;
                 },
                 _ => {
                     ::protobuf::rt::read_unknown_or_skip_group(field_number, wire_type, is, self.mut_unknown_fields())?;
@@ -2058,11 +3208,14 @@ impl ::protobuf::Message for GameServer_Status_Port {
     #[allow(unused_variables)]
     fn compute_size(&self) -> u32 {
         let mut my_size = 0;
-        if !self.name.is_empty() {
-            my_size += ::protobuf::rt::string_size(1, &self.name);
+        if !self.reason.is_empty() {
+            my_size += ::protobuf::rt::string_size(1, &self.reason);
         }
-        if self.port != 0 {
-            my_size += ::protobuf::rt::value_size(2, self.port, ::protobuf::wire_format::WireTypeVarint);
+        if !self.message.is_empty() {
+            my_size += ::protobuf::rt::string_size(2, &self.message);
+        }
+        if self.since != 0 {
+            my_size += ::protobuf::rt::value_size(3, self.since, ::protobuf::wire_format::WireTypeVarint);
         }
         my_size += ::protobuf::rt::unknown_fields_size(self.get_unknown_fields());
         self.cached_size.set(my_size);
@@ -2070,11 +3223,14 @@ impl ::protobuf::Message for GameServer_Status_Port {
     }
 
     fn write_to_with_cached_sizes(&self, os: &mut ::protobuf::CodedOutputStream) -> ::protobuf::ProtobufResult<()> {
-        if !self.name.is_empty() {
-            os.write_string(1, &self.name)?;
+        if !self.reason.is_empty() {
+            os.write_string(1, &self.reason)?;
         }
-        if self.port != 0 {
-            os.write_int32(2, self.port)?;
+        if !self.message.is_empty() {
+            os.write_string(2, &self.message)?;
+        }
+        if self.since != 0 {
+            os.write_int64(3, self.since)?;
         }
         os.write_unknown_fields(self.get_unknown_fields())?;
         ::std::result::Result::Ok(())
@@ -2106,8 +3262,8 @@ impl ::protobuf::Message for GameServer_Status_Port {
         Self::descriptor_static()
     }
 
-    fn new() -> GameServer_Status_Port {
-        GameServer_Status_Port::new()
+    fn new() -> GameServer_Status_Disruption {
+        GameServer_Status_Disruption::new()
     }
 
     fn descriptor_static() -> &'static ::protobuf::reflect::MessageDescriptor {
@@ -2119,17 +3275,22 @@ impl ::protobuf::Message for GameServer_Status_Port {
             descriptor.get(|| {
                 let mut fields = ::std::vec::Vec::new();
                 fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeString>(
-                    "name",
-                    |m: &GameServer_Status_Port| { &m.name },
-                    |m: &mut GameServer_Status_Port| { &mut m.name },
+                    "reason",
+                    |m: &GameServer_Status_Disruption| { &m.reason },
+                    |m: &mut GameServer_Status_Disruption| { &mut m.reason },
                 ));
-                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeInt32>(
-                    "port",
-                    |m: &GameServer_Status_Port| { &m.port },
-                    |m: &mut GameServer_Status_Port| { &mut m.port },
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeString>(
+                    "message",
+                    |m: &GameServer_Status_Disruption| { &m.message },
+                    |m: &mut GameServer_Status_Disruption| { &mut m.message },
                 ));
-                ::protobuf::reflect::MessageDescriptor::new::<GameServer_Status_Port>(
-                    "GameServer_Status_Port",
+                fields.push(::protobuf::reflect::accessor::make_simple_field_accessor::<_, ::protobuf::types::ProtobufTypeInt64>(
+                    "since",
+                    |m: &GameServer_Status_Disruption| { &m.since },
+                    |m: &mut GameServer_Status_Disruption| { &mut m.since },
+                ));
+                ::protobuf::reflect::MessageDescriptor::new::<GameServer_Status_Disruption>(
+                    "GameServer_Status_Disruption",
                     fields,
                     file_descriptor_proto()
                 )
@@ -2137,32 +3298,33 @@ impl ::protobuf::Message for GameServer_Status_Port {
         }
     }
 
-    fn default_instance() -> &'static GameServer_Status_Port {
-        static mut instance: ::protobuf::lazy::Lazy<GameServer_Status_Port> = ::protobuf::lazy::Lazy {
+    fn default_instance() -> &'static GameServer_Status_Disruption {
+        static mut instance: ::protobuf::lazy::Lazy<GameServer_Status_Disruption> = ::protobuf::lazy::Lazy {
             lock: ::protobuf::lazy::ONCE_INIT,
-            ptr: 0 as *const GameServer_Status_Port,
+            ptr: 0 as *const GameServer_Status_Disruption,
         };
         unsafe {
-            instance.get(GameServer_Status_Port::new)
+            instance.get(GameServer_Status_Disruption::new)
         }
     }
 }
 
-impl ::protobuf::Clear for GameServer_Status_Port {
+impl ::protobuf::Clear for GameServer_Status_Disruption {
     fn clear(&mut self) {
-        self.clear_name();
-        self.clear_port();
+        self.clear_reason();
+        self.clear_message();
+        self.clear_since();
         self.unknown_fields.clear();
     }
 }
 
-impl ::std::fmt::Debug for GameServer_Status_Port {
+impl ::std::fmt::Debug for GameServer_Status_Disruption {
     fn fmt(&self, f: &mut ::std::fmt::Formatter) -> ::std::fmt::Result {
         ::protobuf::text_format::fmt(self, f)
     }
 }
 
-impl ::protobuf::reflect::ProtobufValue for GameServer_Status_Port {
+impl ::protobuf::reflect::ProtobufValue for GameServer_Status_Disruption {
     fn as_ref(&self) -> ::protobuf::reflect::ProtobufValueRef {
         ::protobuf::reflect::ProtobufValueRef::Message(self)
     }
@@ -2172,69 +3334,95 @@ static file_descriptor_proto_data: &'static [u8] = b"\
     \n\tsdk.proto\x12\x0eagones.dev.sdk\x1a\x1cgoogle/api/annotations.proto\
     \"\x07\n\x05Empty\"2\n\x08KeyValue\x12\x10\n\x03key\x18\x01\x20\x01(\tR\
     \x03key\x12\x14\n\x05value\x18\x02\x20\x01(\tR\x05value\"$\n\x08Duration\
-    \x12\x18\n\x07seconds\x18\x01\x20\x01(\x03R\x07seconds\"\x81\t\n\nGameSe\
-    rver\x12F\n\x0bobject_meta\x18\x01\x20\x01(\x0b2%.agones.dev.sdk.GameSer\
-    ver.ObjectMetaR\nobjectMeta\x123\n\x04spec\x18\x02\x20\x01(\x0b2\x1f.ago\
-    nes.dev.sdk.GameServer.SpecR\x04spec\x129\n\x06status\x18\x03\x20\x01(\
-    \x0b2!.agones.dev.sdk.GameServer.StatusR\x06status\x1a\x99\x04\n\nObject\
-    Meta\x12\x12\n\x04name\x18\x01\x20\x01(\tR\x04name\x12\x1c\n\tnamespace\
-    \x18\x02\x20\x01(\tR\tnamespace\x12\x10\n\x03uid\x18\x03\x20\x01(\tR\x03\
-    uid\x12)\n\x10resource_version\x18\x04\x20\x01(\tR\x0fresourceVersion\
-    \x12\x1e\n\ngeneration\x18\x05\x20\x01(\x03R\ngeneration\x12-\n\x12creat\
-    ion_timestamp\x18\x06\x20\x01(\x03R\x11creationTimestamp\x12-\n\x12delet\
-    ion_timestamp\x18\x07\x20\x01(\x03R\x11deletionTimestamp\x12X\n\x0bannot\
-    ations\x18\x08\x20\x03(\x0b26.agones.dev.sdk.GameServer.ObjectMeta.Annot\
-    ationsEntryR\x0bannotations\x12I\n\x06labels\x18\t\x20\x03(\x0b21.agones\
-    .dev.sdk.GameServer.ObjectMeta.LabelsEntryR\x06labels\x1a>\n\x10Annotati\
-    onsEntry\x12\x10\n\x03key\x18\x01\x20\x01(\tR\x03key\x12\x14\n\x05value\
-    \x18\x02\x20\x01(\tR\x05value:\x028\x01\x1a9\n\x0bLabelsEntry\x12\x10\n\
-    \x03key\x18\x01\x20\x01(\tR\x03key\x12\x14\n\x05value\x18\x02\x20\x01(\t\
-    R\x05value:\x028\x01\x1a\xf5\x01\n\x04Spec\x12>\n\x06health\x18\x01\x20\
-    \x01(\x0b2&.agones.dev.sdk.GameServer.Spec.HealthR\x06health\x1a\xac\x01\
-    \n\x06Health\x12\x1a\n\x08disabled\x18\x01\x20\x01(\x08R\x08disabled\x12\
-    %\n\x0eperiod_seconds\x18\x02\x20\x01(\x05R\rperiodSeconds\x12+\n\x11fai\
-    lure_threshold\x18\x03\x20\x01(\x05R\x10failureThreshold\x122\n\x15initi\
-    al_delay_seconds\x18\x04\x20\x01(\x05R\x13initialDelaySeconds\x1a\xa6\
-    \x01\n\x06Status\x12\x14\n\x05state\x18\x01\x20\x01(\tR\x05state\x12\x18\
-    \n\x07address\x18\x02\x20\x01(\tR\x07address\x12<\n\x05ports\x18\x03\x20\
-    \x03(\x0b2&.agones.dev.sdk.GameServer.Status.PortR\x05ports\x1a.\n\x04Po\
-    rt\x12\x12\n\x04name\x18\x01\x20\x01(\tR\x04name\x12\x12\n\x04port\x18\
-    \x02\x20\x01(\x05R\x04port2\x86\x06\n\x03SDK\x12H\n\x05Ready\x12\x15.ago\
-    nes.dev.sdk.Empty\x1a\x15.agones.dev.sdk.Empty\"\x11\x82\xd3\xe4\x93\x02\
-    \x0b\"\x06/ready:\x01*\x12N\n\x08Allocate\x12\x15.agones.dev.sdk.Empty\
-    \x1a\x15.agones.dev.sdk.Empty\"\x14\x82\xd3\xe4\x93\x02\x0e\"\t/allocate\
-    :\x01*\x12N\n\x08Shutdown\x12\x15.agones.dev.sdk.Empty\x1a\x15.agones.de\
-    v.sdk.Empty\"\x14\x82\xd3\xe4\x93\x02\x0e\"\t/shutdown:\x01*\x12L\n\x06H\
-    ealth\x12\x15.agones.dev.sdk.Empty\x1a\x15.agones.dev.sdk.Empty\"\x12\
-    \x82\xd3\xe4\x93\x02\x0c\"\x07/health:\x01*(\x01\x12W\n\rGetGameServer\
-    \x12\x15.agones.dev.sdk.Empty\x1a\x1a.agones.dev.sdk.GameServer\"\x13\
-    \x82\xd3\xe4\x93\x02\r\x12\x0b/gameserver\x12a\n\x0fWatchGameServer\x12\
-    \x15.agones.dev.sdk.Empty\x1a\x1a.agones.dev.sdk.GameServer\"\x19\x82\
-    \xd3\xe4\x93\x02\x13\x12\x11/watch/gameserver0\x01\x12W\n\x08SetLabel\
-    \x12\x18.agones.dev.sdk.KeyValue\x1a\x15.agones.dev.sdk.Empty\"\x1a\x82\
-    \xd3\xe4\x93\x02\x14\x1a\x0f/metadata/label:\x01*\x12a\n\rSetAnnotation\
-    \x12\x18.agones.dev.sdk.KeyValue\x1a\x15.agones.dev.sdk.Empty\"\x1f\x82\
-    \xd3\xe4\x93\x02\x19\x1a\x14/metadata/annotation:\x01*\x12O\n\x07Reserve\
-    \x12\x18.agones.dev.sdk.Duration\x1a\x15.agones.dev.sdk.Empty\"\x13\x82\
-    \xd3\xe4\x93\x02\r\"\x08/reserve:\x01*B\x05Z\x03sdkJ\xfe$\n\x07\x12\x05\
-    \x0e\0\x96\x01\x01\n\xd1\x04\n\x01\x0c\x12\x03\x0e\0\x122\xc6\x04\x20Cop\
-    yright\x202017\x20Google\x20LLC\x20All\x20Rights\x20Reserved.\n\n\x20Lic\
-    ensed\x20under\x20the\x20Apache\x20License,\x20Version\x202.0\x20(the\
-    \x20\"License\");\n\x20you\x20may\x20not\x20use\x20this\x20file\x20excep\
-    t\x20in\x20compliance\x20with\x20the\x20License.\n\x20You\x20may\x20obta\
-    in\x20a\x20copy\x20of\x20the\x20License\x20at\n\n\x20\x20\x20\x20\x20htt\
-    p://www.apache.org/licenses/LICENSE-2.0\n\n\x20Unless\x20required\x20by\
-    \x20applicable\x20law\x20or\x20agreed\x20to\x20in\x20writing,\x20softwar\
-    e\n\x20distributed\x20under\x20the\x20License\x20is\x20distributed\x20on\
-    \x20an\x20\"AS\x20IS\"\x20BASIS,\n\x20WITHOUT\x20WARRANTIES\x20OR\x20CON\
-    DITIONS\x20OF\x20ANY\x20KIND,\x20either\x20express\x20or\x20implied.\n\
-    \x20See\x20the\x20License\x20for\x20the\x20specific\x20language\x20gover\
-    ning\x20permissions\x20and\n\x20limitations\x20under\x20the\x20License.\
-    \n\n\x08\n\x01\x02\x12\x03\x10\x08\x16\n\x08\n\x01\x08\x12\x03\x11\0\x1a\
-    \n\t\n\x02\x08\x0b\x12\x03\x11\0\x1a\n\t\n\x02\x03\0\x12\x03\x13\x07%\nM\
-    \n\x02\x06\0\x12\x04\x16\0Y\x01\x1aA\x20SDK\x20service\x20to\x20be\x20us\
-    ed\x20in\x20the\x20GameServer\x20SDK\x20to\x20the\x20Pod\x20Sidecar\n\n\
-    \n\n\x03\x06\0\x01\x12\x03\x16\x08\x0b\n1\n\x04\x06\0\x02\0\x12\x04\x18\
+    \x12\x18\n\x07seconds\x18\x01\x20\x01(\x03R\x07seconds\";\n\rCounterUpda\
+    te\x12\x12\n\x04name\x18\x01\x20\x01(\tR\x04name\x12\x16\n\x06amount\x18\
+    \x02\x20\x01(\x03R\x06amount\"5\n\tListValue\x12\x12\n\x04name\x18\x01\
+    \x20\x01(\tR\x04name\x12\x14\n\x05value\x18\x02\x20\x01(\tR\x05value\"\
+    \xf9\r\n\nGameServer\x12F\n\x0bobject_meta\x18\x01\x20\x01(\x0b2%.agones\
+    .dev.sdk.GameServer.ObjectMetaR\nobjectMeta\x123\n\x04spec\x18\x02\x20\
+    \x01(\x0b2\x1f.agones.dev.sdk.GameServer.SpecR\x04spec\x129\n\x06status\
+    \x18\x03\x20\x01(\x0b2!.agones.dev.sdk.GameServer.StatusR\x06status\x1a\
+    \x99\x04\n\nObjectMeta\x12\x12\n\x04name\x18\x01\x20\x01(\tR\x04name\x12\
+    \x1c\n\tnamespace\x18\x02\x20\x01(\tR\tnamespace\x12\x10\n\x03uid\x18\
+    \x03\x20\x01(\tR\x03uid\x12)\n\x10resource_version\x18\x04\x20\x01(\tR\
+    \x0fresourceVersion\x12\x1e\n\ngeneration\x18\x05\x20\x01(\x03R\ngenerat\
+    ion\x12-\n\x12creation_timestamp\x18\x06\x20\x01(\x03R\x11creationTimest\
+    amp\x12-\n\x12deletion_timestamp\x18\x07\x20\x01(\x03R\x11deletionTimest\
+    amp\x12X\n\x0bannotations\x18\x08\x20\x03(\x0b26.agones.dev.sdk.GameServ\
+    er.ObjectMeta.AnnotationsEntryR\x0bannotations\x12I\n\x06labels\x18\t\
+    \x20\x03(\x0b21.agones.dev.sdk.GameServer.ObjectMeta.LabelsEntryR\x06lab\
+    els\x1a>\n\x10AnnotationsEntry\x12\x10\n\x03key\x18\x01\x20\x01(\tR\x03k\
+    ey\x12\x14\n\x05value\x18\x02\x20\x01(\tR\x05value:\x028\x01\x1a9\n\x0bL\
+    abelsEntry\x12\x10\n\x03key\x18\x01\x20\x01(\tR\x03key\x12\x14\n\x05valu\
+    e\x18\x02\x20\x01(\tR\x05value:\x028\x01\x1a\xf5\x01\n\x04Spec\x12>\n\
+    \x06health\x18\x01\x20\x01(\x0b2&.agones.dev.sdk.GameServer.Spec.HealthR\
+    \x06health\x1a\xac\x01\n\x06Health\x12\x1a\n\x08disabled\x18\x01\x20\x01\
+    (\x08R\x08disabled\x12%\n\x0eperiod_seconds\x18\x02\x20\x01(\x05R\rperio\
+    dSeconds\x12+\n\x11failure_threshold\x18\x03\x20\x01(\x05R\x10failureThr\
+    eshold\x122\n\x15initial_delay_seconds\x18\x04\x20\x01(\x05R\x13initialD\
+    elaySeconds\x1a\x9e\x06\n\x06Status\x12\x14\n\x05state\x18\x01\x20\x01(\
+    \tR\x05state\x12\x18\n\x07address\x18\x02\x20\x01(\tR\x07address\x12<\n\
+    \x05ports\x18\x03\x20\x03(\x0b2&.agones.dev.sdk.GameServer.Status.PortR\
+    \x05ports\x12K\n\x08counters\x18\x04\x20\x03(\x0b2/.agones.dev.sdk.GameS\
+    erver.Status.CountersEntryR\x08counters\x12B\n\x05lists\x18\x05\x20\x03(\
+    \x0b2,.agones.dev.sdk.GameServer.Status.ListsEntryR\x05lists\x12L\n\ndis\
+    ruption\x18\x06\x20\x01(\x0b2,.agones.dev.sdk.GameServer.Status.Disrupti\
+    onR\ndisruption\x1a.\n\x04Port\x12\x12\n\x04name\x18\x01\x20\x01(\tR\x04\
+    name\x12\x12\n\x04port\x18\x02\x20\x01(\x05R\x04port\x1a;\n\x07Counter\
+    \x12\x14\n\x05count\x18\x01\x20\x01(\x03R\x05count\x12\x1a\n\x08capacity\
+    \x18\x02\x20\x01(\x03R\x08capacity\x1a:\n\x04List\x12\x1a\n\x08capacity\
+    \x18\x01\x20\x01(\x03R\x08capacity\x12\x16\n\x06values\x18\x02\x20\x03(\
+    \tR\x06values\x1aT\n\nDisruption\x12\x16\n\x06reason\x18\x01\x20\x01(\tR\
+    \x06reason\x12\x18\n\x07message\x18\x02\x20\x01(\tR\x07message\x12\x14\n\
+    \x05since\x18\x03\x20\x01(\x03R\x05since\x1af\n\rCountersEntry\x12\x10\n\
+    \x03key\x18\x01\x20\x01(\tR\x03key\x12?\n\x05value\x18\x02\x20\x01(\x0b2\
+    ).agones.dev.sdk.GameServer.Status.CounterR\x05value:\x028\x01\x1a`\n\nL\
+    istsEntry\x12\x10\n\x03key\x18\x01\x20\x01(\tR\x03key\x12<\n\x05value\
+    \x18\x02\x20\x01(\x0b2&.agones.dev.sdk.GameServer.Status.ListR\x05value:\
+    \x028\x012\x95\t\n\x03SDK\x12H\n\x05Ready\x12\x15.agones.dev.sdk.Empty\
+    \x1a\x15.agones.dev.sdk.Empty\"\x11\x82\xd3\xe4\x93\x02\x0b\"\x06/ready:\
+    \x01*\x12N\n\x08Allocate\x12\x15.agones.dev.sdk.Empty\x1a\x15.agones.dev\
+    .sdk.Empty\"\x14\x82\xd3\xe4\x93\x02\x0e\"\t/allocate:\x01*\x12N\n\x08Sh\
+    utdown\x12\x15.agones.dev.sdk.Empty\x1a\x15.agones.dev.sdk.Empty\"\x14\
+    \x82\xd3\xe4\x93\x02\x0e\"\t/shutdown:\x01*\x12L\n\x06Health\x12\x15.ago\
+    nes.dev.sdk.Empty\x1a\x15.agones.dev.sdk.Empty\"\x12\x82\xd3\xe4\x93\x02\
+    \x0c\"\x07/health:\x01*(\x01\x12W\n\rGetGameServer\x12\x15.agones.dev.sd\
+    k.Empty\x1a\x1a.agones.dev.sdk.GameServer\"\x13\x82\xd3\xe4\x93\x02\r\
+    \x12\x0b/gameserver\x12a\n\x0fWatchGameServer\x12\x15.agones.dev.sdk.Emp\
+    ty\x1a\x1a.agones.dev.sdk.GameServer\"\x19\x82\xd3\xe4\x93\x02\x13\x12\
+    \x11/watch/gameserver0\x01\x12W\n\x08SetLabel\x12\x18.agones.dev.sdk.Key\
+    Value\x1a\x15.agones.dev.sdk.Empty\"\x1a\x82\xd3\xe4\x93\x02\x14\x1a\x0f\
+    /metadata/label:\x01*\x12a\n\rSetAnnotation\x12\x18.agones.dev.sdk.KeyVa\
+    lue\x1a\x15.agones.dev.sdk.Empty\"\x1f\x82\xd3\xe4\x93\x02\x19\x1a\x14/m\
+    etadata/annotation:\x01*\x12O\n\x07Reserve\x12\x18.agones.dev.sdk.Durati\
+    on\x1a\x15.agones.dev.sdk.Empty\"\x13\x82\xd3\xe4\x93\x02\r\"\x08/reserv\
+    e:\x01*\x12g\n\x10IncrementCounter\x12\x1d.agones.dev.sdk.CounterUpdate\
+    \x1a\x15.agones.dev.sdk.Empty\"\x1d\x82\xd3\xe4\x93\x02\x17\"\x12/counte\
+    r/increment:\x01*\x12h\n\x12SetCounterCapacity\x12\x1d.agones.dev.sdk.Co\
+    unterUpdate\x1a\x15.agones.dev.sdk.Empty\"\x1c\x82\xd3\xe4\x93\x02\x16\
+    \x1a\x11/counter/capacity:\x01*\x12\\\n\x0fAppendListValue\x12\x19.agone\
+    s.dev.sdk.ListValue\x1a\x15.agones.dev.sdk.Empty\"\x17\x82\xd3\xe4\x93\
+    \x02\x11\"\x0c/list/append:\x01*\x12\\\n\x0fDeleteListValue\x12\x19.agon\
+    es.dev.sdk.ListValue\x1a\x15.agones.dev.sdk.Empty\"\x17\x82\xd3\xe4\x93\
+    \x02\x11\"\x0c/list/delete:\x01*B\x05Z\x03sdkJ\xf06\n\x07\x12\x05\x0e\0\
+    \xd7\x01\x01\n\xd1\x04\n\x01\x0c\x12\x03\x0e\0\x122\xc6\x04\x20Copyright\
+    \x202017\x20Google\x20LLC\x20All\x20Rights\x20Reserved.\n\n\x20Licensed\
+    \x20under\x20the\x20Apache\x20License,\x20Version\x202.0\x20(the\x20\"Li\
+    cense\");\n\x20you\x20may\x20not\x20use\x20this\x20file\x20except\x20in\
+    \x20compliance\x20with\x20the\x20License.\n\x20You\x20may\x20obtain\x20a\
+    \x20copy\x20of\x20the\x20License\x20at\n\n\x20\x20\x20\x20\x20http://www\
+    .apache.org/licenses/LICENSE-2.0\n\n\x20Unless\x20required\x20by\x20appl\
+    icable\x20law\x20or\x20agreed\x20to\x20in\x20writing,\x20software\n\x20d\
+    istributed\x20under\x20the\x20License\x20is\x20distributed\x20on\x20an\
+    \x20\"AS\x20IS\"\x20BASIS,\n\x20WITHOUT\x20WARRANTIES\x20OR\x20CONDITION\
+    S\x20OF\x20ANY\x20KIND,\x20either\x20express\x20or\x20implied.\n\x20See\
+    \x20the\x20License\x20for\x20the\x20specific\x20language\x20governing\
+    \x20permissions\x20and\n\x20limitations\x20under\x20the\x20License.\n\n\
+    \x08\n\x01\x02\x12\x03\x10\x08\x16\n\x08\n\x01\x08\x12\x03\x11\0\x1a\n\t\
+    \n\x02\x08\x0b\x12\x03\x11\0\x1a\n\t\n\x02\x03\0\x12\x03\x13\x07%\nM\n\
+    \x02\x06\0\x12\x04\x16\0y\x01\x1aA\x20SDK\x20service\x20to\x20be\x20used\
+    \x20in\x20the\x20GameServer\x20SDK\x20to\x20the\x20Pod\x20Sidecar\n\n\n\
+    \n\x03\x06\0\x01\x12\x03\x16\x08\x0b\n1\n\x04\x06\0\x02\0\x12\x04\x18\
     \x04\x1d\x05\x1a#\x20Call\x20when\x20the\x20GameServer\x20is\x20ready\n\
     \n\x0c\n\x05\x06\0\x02\0\x01\x12\x03\x18\x08\r\n\x0c\n\x05\x06\0\x02\0\
     \x02\x12\x03\x18\x0f\x14\n\x0c\n\x05\x06\0\x02\0\x03\x12\x03\x18\x1f$\n\
@@ -2281,128 +3469,233 @@ static file_descriptor_proto_data: &'static [u8] = b"\
     served\x20state\x20for\x20Duration\n\n\x0c\n\x05\x06\0\x02\x08\x01\x12\
     \x03S\x08\x0f\n\x0c\n\x05\x06\0\x02\x08\x02\x12\x03S\x10\x18\n\x0c\n\x05\
     \x06\0\x02\x08\x03\x12\x03S#(\n\r\n\x05\x06\0\x02\x08\x04\x12\x04T\x08W\
-    \n\n\x11\n\t\x06\0\x02\x08\x04\xb0\xca\xbc\"\x12\x04T\x08W\n\n\x18\n\x02\
-    \x04\0\x12\x04\\\0]\x01\x1a\x0c\x20I\x20am\x20Empty\n\n\n\n\x03\x04\0\
-    \x01\x12\x03\\\x08\r\n\x1e\n\x02\x04\x01\x12\x04`\0c\x01\x1a\x12\x20Key,\
-    \x20Value\x20entry\n\n\n\n\x03\x04\x01\x01\x12\x03`\x08\x10\n\x0b\n\x04\
-    \x04\x01\x02\0\x12\x03a\x04\x13\n\r\n\x05\x04\x01\x02\0\x04\x12\x04a\x04\
-    `\x12\n\x0c\n\x05\x04\x01\x02\0\x05\x12\x03a\x04\n\n\x0c\n\x05\x04\x01\
-    \x02\0\x01\x12\x03a\x0b\x0e\n\x0c\n\x05\x04\x01\x02\0\x03\x12\x03a\x11\
-    \x12\n\x0b\n\x04\x04\x01\x02\x01\x12\x03b\x04\x15\n\r\n\x05\x04\x01\x02\
-    \x01\x04\x12\x04b\x04a\x13\n\x0c\n\x05\x04\x01\x02\x01\x05\x12\x03b\x04\
-    \n\n\x0c\n\x05\x04\x01\x02\x01\x01\x12\x03b\x0b\x10\n\x0c\n\x05\x04\x01\
-    \x02\x01\x03\x12\x03b\x13\x14\n'\n\x02\x04\x02\x12\x04f\0h\x01\x1a\x1b\
-    \x20time\x20duration,\x20in\x20seconds\n\n\n\n\x03\x04\x02\x01\x12\x03f\
-    \x08\x10\n\x0b\n\x04\x04\x02\x02\0\x12\x03g\x04\x16\n\r\n\x05\x04\x02\
-    \x02\0\x04\x12\x04g\x04f\x12\n\x0c\n\x05\x04\x02\x02\0\x05\x12\x03g\x04\
-    \t\n\x0c\n\x05\x04\x02\x02\0\x01\x12\x03g\n\x11\n\x0c\n\x05\x04\x02\x02\
-    \0\x03\x12\x03g\x14\x15\n\xa3\x01\n\x02\x04\x03\x12\x05m\0\x96\x01\x01\
-    \x1a\x95\x01\x20A\x20GameServer\x20Custom\x20Resource\x20Definition\x20o\
-    bject\n\x20We\x20will\x20only\x20export\x20those\x20resources\x20that\
-    \x20make\x20the\x20most\n\x20sense.\x20Can\x20always\x20expand\x20to\x20\
-    more\x20as\x20needed.\n\n\n\n\x03\x04\x03\x01\x12\x03m\x08\x12\n\x0b\n\
-    \x04\x04\x03\x02\0\x12\x03n\x04\x1f\n\r\n\x05\x04\x03\x02\0\x04\x12\x04n\
-    \x04m\x14\n\x0c\n\x05\x04\x03\x02\0\x06\x12\x03n\x04\x0e\n\x0c\n\x05\x04\
-    \x03\x02\0\x01\x12\x03n\x0f\x1a\n\x0c\n\x05\x04\x03\x02\0\x03\x12\x03n\
-    \x1d\x1e\n\x0b\n\x04\x04\x03\x02\x01\x12\x03o\x04\x12\n\r\n\x05\x04\x03\
-    \x02\x01\x04\x12\x04o\x04n\x1f\n\x0c\n\x05\x04\x03\x02\x01\x06\x12\x03o\
-    \x04\x08\n\x0c\n\x05\x04\x03\x02\x01\x01\x12\x03o\t\r\n\x0c\n\x05\x04\
-    \x03\x02\x01\x03\x12\x03o\x10\x11\n\x0b\n\x04\x04\x03\x02\x02\x12\x03p\
-    \x04\x16\n\r\n\x05\x04\x03\x02\x02\x04\x12\x04p\x04o\x12\n\x0c\n\x05\x04\
-    \x03\x02\x02\x06\x12\x03p\x04\n\n\x0c\n\x05\x04\x03\x02\x02\x01\x12\x03p\
-    \x0b\x11\n\x0c\n\x05\x04\x03\x02\x02\x03\x12\x03p\x14\x15\n=\n\x04\x04\
-    \x03\x03\0\x12\x04s\x04\x7f\x05\x1a/\x20representation\x20of\x20the\x20K\
-    8s\x20ObjectMeta\x20resource\n\n\x0c\n\x05\x04\x03\x03\0\x01\x12\x03s\
-    \x0c\x16\n\r\n\x06\x04\x03\x03\0\x02\0\x12\x03t\x08\x18\n\x0f\n\x07\x04\
-    \x03\x03\0\x02\0\x04\x12\x04t\x08s\x18\n\x0e\n\x07\x04\x03\x03\0\x02\0\
-    \x05\x12\x03t\x08\x0e\n\x0e\n\x07\x04\x03\x03\0\x02\0\x01\x12\x03t\x0f\
-    \x13\n\x0e\n\x07\x04\x03\x03\0\x02\0\x03\x12\x03t\x16\x17\n\r\n\x06\x04\
-    \x03\x03\0\x02\x01\x12\x03u\x08\x1d\n\x0f\n\x07\x04\x03\x03\0\x02\x01\
-    \x04\x12\x04u\x08t\x18\n\x0e\n\x07\x04\x03\x03\0\x02\x01\x05\x12\x03u\
-    \x08\x0e\n\x0e\n\x07\x04\x03\x03\0\x02\x01\x01\x12\x03u\x0f\x18\n\x0e\n\
-    \x07\x04\x03\x03\0\x02\x01\x03\x12\x03u\x1b\x1c\n\r\n\x06\x04\x03\x03\0\
-    \x02\x02\x12\x03v\x08\x17\n\x0f\n\x07\x04\x03\x03\0\x02\x02\x04\x12\x04v\
-    \x08u\x1d\n\x0e\n\x07\x04\x03\x03\0\x02\x02\x05\x12\x03v\x08\x0e\n\x0e\n\
-    \x07\x04\x03\x03\0\x02\x02\x01\x12\x03v\x0f\x12\n\x0e\n\x07\x04\x03\x03\
-    \0\x02\x02\x03\x12\x03v\x15\x16\n\r\n\x06\x04\x03\x03\0\x02\x03\x12\x03w\
-    \x08$\n\x0f\n\x07\x04\x03\x03\0\x02\x03\x04\x12\x04w\x08v\x17\n\x0e\n\
-    \x07\x04\x03\x03\0\x02\x03\x05\x12\x03w\x08\x0e\n\x0e\n\x07\x04\x03\x03\
-    \0\x02\x03\x01\x12\x03w\x0f\x1f\n\x0e\n\x07\x04\x03\x03\0\x02\x03\x03\
-    \x12\x03w\"#\n\r\n\x06\x04\x03\x03\0\x02\x04\x12\x03x\x08\x1d\n\x0f\n\
-    \x07\x04\x03\x03\0\x02\x04\x04\x12\x04x\x08w$\n\x0e\n\x07\x04\x03\x03\0\
-    \x02\x04\x05\x12\x03x\x08\r\n\x0e\n\x07\x04\x03\x03\0\x02\x04\x01\x12\
-    \x03x\x0e\x18\n\x0e\n\x07\x04\x03\x03\0\x02\x04\x03\x12\x03x\x1b\x1c\n<\
-    \n\x06\x04\x03\x03\0\x02\x05\x12\x03z\x08%\x1a-\x20timestamp\x20is\x20in\
-    \x20Epoch\x20format,\x20unit:\x20seconds\n\n\x0f\n\x07\x04\x03\x03\0\x02\
-    \x05\x04\x12\x04z\x08x\x1d\n\x0e\n\x07\x04\x03\x03\0\x02\x05\x05\x12\x03\
-    z\x08\r\n\x0e\n\x07\x04\x03\x03\0\x02\x05\x01\x12\x03z\x0e\x20\n\x0e\n\
-    \x07\x04\x03\x03\0\x02\x05\x03\x12\x03z#$\nK\n\x06\x04\x03\x03\0\x02\x06\
-    \x12\x03|\x08%\x1a<\x20optional\x20deletion\x20timestamp\x20in\x20Epoch\
-    \x20format,\x20unit:\x20seconds\n\n\x0f\n\x07\x04\x03\x03\0\x02\x06\x04\
-    \x12\x04|\x08z%\n\x0e\n\x07\x04\x03\x03\0\x02\x06\x05\x12\x03|\x08\r\n\
-    \x0e\n\x07\x04\x03\x03\0\x02\x06\x01\x12\x03|\x0e\x20\n\x0e\n\x07\x04\
-    \x03\x03\0\x02\x06\x03\x12\x03|#$\n\r\n\x06\x04\x03\x03\0\x02\x07\x12\
-    \x03}\x08,\n\x0f\n\x07\x04\x03\x03\0\x02\x07\x04\x12\x04}\x08|%\n\x0e\n\
-    \x07\x04\x03\x03\0\x02\x07\x06\x12\x03}\x08\x1b\n\x0e\n\x07\x04\x03\x03\
-    \0\x02\x07\x01\x12\x03}\x1c'\n\x0e\n\x07\x04\x03\x03\0\x02\x07\x03\x12\
-    \x03}*+\n\r\n\x06\x04\x03\x03\0\x02\x08\x12\x03~\x08'\n\x0f\n\x07\x04\
-    \x03\x03\0\x02\x08\x04\x12\x04~\x08},\n\x0e\n\x07\x04\x03\x03\0\x02\x08\
-    \x06\x12\x03~\x08\x1b\n\x0e\n\x07\x04\x03\x03\0\x02\x08\x01\x12\x03~\x1c\
-    \"\n\x0e\n\x07\x04\x03\x03\0\x02\x08\x03\x12\x03~%&\n\x0e\n\x04\x04\x03\
-    \x03\x01\x12\x06\x81\x01\x04\x8a\x01\x05\n\r\n\x05\x04\x03\x03\x01\x01\
-    \x12\x04\x81\x01\x0c\x10\n\x0e\n\x06\x04\x03\x03\x01\x02\0\x12\x04\x82\
-    \x01\x08\x1a\n\x11\n\x07\x04\x03\x03\x01\x02\0\x04\x12\x06\x82\x01\x08\
-    \x81\x01\x12\n\x0f\n\x07\x04\x03\x03\x01\x02\0\x06\x12\x04\x82\x01\x08\
-    \x0e\n\x0f\n\x07\x04\x03\x03\x01\x02\0\x01\x12\x04\x82\x01\x0f\x15\n\x0f\
-    \n\x07\x04\x03\x03\x01\x02\0\x03\x12\x04\x82\x01\x18\x19\n\x10\n\x06\x04\
-    \x03\x03\x01\x03\0\x12\x06\x84\x01\x08\x89\x01\t\n\x0f\n\x07\x04\x03\x03\
-    \x01\x03\0\x01\x12\x04\x84\x01\x10\x16\n\x10\n\x08\x04\x03\x03\x01\x03\0\
-    \x02\0\x12\x04\x85\x01\x0c\x1e\n\x13\n\t\x04\x03\x03\x01\x03\0\x02\0\x04\
-    \x12\x06\x85\x01\x0c\x84\x01\x18\n\x11\n\t\x04\x03\x03\x01\x03\0\x02\0\
-    \x05\x12\x04\x85\x01\x0c\x10\n\x11\n\t\x04\x03\x03\x01\x03\0\x02\0\x01\
-    \x12\x04\x85\x01\x11\x19\n\x11\n\t\x04\x03\x03\x01\x03\0\x02\0\x03\x12\
-    \x04\x85\x01\x1c\x1d\n\x10\n\x08\x04\x03\x03\x01\x03\0\x02\x01\x12\x04\
-    \x86\x01\x0c%\n\x13\n\t\x04\x03\x03\x01\x03\0\x02\x01\x04\x12\x06\x86\
-    \x01\x0c\x85\x01\x1e\n\x11\n\t\x04\x03\x03\x01\x03\0\x02\x01\x05\x12\x04\
-    \x86\x01\x0c\x11\n\x11\n\t\x04\x03\x03\x01\x03\0\x02\x01\x01\x12\x04\x86\
-    \x01\x12\x20\n\x11\n\t\x04\x03\x03\x01\x03\0\x02\x01\x03\x12\x04\x86\x01\
-    #$\n\x10\n\x08\x04\x03\x03\x01\x03\0\x02\x02\x12\x04\x87\x01\x0c(\n\x13\
-    \n\t\x04\x03\x03\x01\x03\0\x02\x02\x04\x12\x06\x87\x01\x0c\x86\x01%\n\
-    \x11\n\t\x04\x03\x03\x01\x03\0\x02\x02\x05\x12\x04\x87\x01\x0c\x11\n\x11\
-    \n\t\x04\x03\x03\x01\x03\0\x02\x02\x01\x12\x04\x87\x01\x12#\n\x11\n\t\
-    \x04\x03\x03\x01\x03\0\x02\x02\x03\x12\x04\x87\x01&'\n\x10\n\x08\x04\x03\
-    \x03\x01\x03\0\x02\x03\x12\x04\x88\x01\x0c,\n\x13\n\t\x04\x03\x03\x01\
-    \x03\0\x02\x03\x04\x12\x06\x88\x01\x0c\x87\x01(\n\x11\n\t\x04\x03\x03\
-    \x01\x03\0\x02\x03\x05\x12\x04\x88\x01\x0c\x11\n\x11\n\t\x04\x03\x03\x01\
-    \x03\0\x02\x03\x01\x12\x04\x88\x01\x12'\n\x11\n\t\x04\x03\x03\x01\x03\0\
-    \x02\x03\x03\x12\x04\x88\x01*+\n\x0e\n\x04\x04\x03\x03\x02\x12\x06\x8c\
-    \x01\x04\x95\x01\x05\n\r\n\x05\x04\x03\x03\x02\x01\x12\x04\x8c\x01\x0c\
-    \x12\n\x10\n\x06\x04\x03\x03\x02\x03\0\x12\x06\x8d\x01\x08\x90\x01\t\n\
-    \x0f\n\x07\x04\x03\x03\x02\x03\0\x01\x12\x04\x8d\x01\x10\x14\n\x10\n\x08\
-    \x04\x03\x03\x02\x03\0\x02\0\x12\x04\x8e\x01\x0c\x1c\n\x13\n\t\x04\x03\
-    \x03\x02\x03\0\x02\0\x04\x12\x06\x8e\x01\x0c\x8d\x01\x16\n\x11\n\t\x04\
-    \x03\x03\x02\x03\0\x02\0\x05\x12\x04\x8e\x01\x0c\x12\n\x11\n\t\x04\x03\
-    \x03\x02\x03\0\x02\0\x01\x12\x04\x8e\x01\x13\x17\n\x11\n\t\x04\x03\x03\
-    \x02\x03\0\x02\0\x03\x12\x04\x8e\x01\x1a\x1b\n\x10\n\x08\x04\x03\x03\x02\
-    \x03\0\x02\x01\x12\x04\x8f\x01\x0c\x1b\n\x13\n\t\x04\x03\x03\x02\x03\0\
-    \x02\x01\x04\x12\x06\x8f\x01\x0c\x8e\x01\x1c\n\x11\n\t\x04\x03\x03\x02\
-    \x03\0\x02\x01\x05\x12\x04\x8f\x01\x0c\x11\n\x11\n\t\x04\x03\x03\x02\x03\
-    \0\x02\x01\x01\x12\x04\x8f\x01\x12\x16\n\x11\n\t\x04\x03\x03\x02\x03\0\
-    \x02\x01\x03\x12\x04\x8f\x01\x19\x1a\n\x0e\n\x06\x04\x03\x03\x02\x02\0\
-    \x12\x04\x92\x01\x08\x19\n\x11\n\x07\x04\x03\x03\x02\x02\0\x04\x12\x06\
-    \x92\x01\x08\x90\x01\t\n\x0f\n\x07\x04\x03\x03\x02\x02\0\x05\x12\x04\x92\
-    \x01\x08\x0e\n\x0f\n\x07\x04\x03\x03\x02\x02\0\x01\x12\x04\x92\x01\x0f\
-    \x14\n\x0f\n\x07\x04\x03\x03\x02\x02\0\x03\x12\x04\x92\x01\x17\x18\n\x0e\
-    \n\x06\x04\x03\x03\x02\x02\x01\x12\x04\x93\x01\x08\x1b\n\x11\n\x07\x04\
-    \x03\x03\x02\x02\x01\x04\x12\x06\x93\x01\x08\x92\x01\x19\n\x0f\n\x07\x04\
-    \x03\x03\x02\x02\x01\x05\x12\x04\x93\x01\x08\x0e\n\x0f\n\x07\x04\x03\x03\
-    \x02\x02\x01\x01\x12\x04\x93\x01\x0f\x16\n\x0f\n\x07\x04\x03\x03\x02\x02\
-    \x01\x03\x12\x04\x93\x01\x19\x1a\n\x0e\n\x06\x04\x03\x03\x02\x02\x02\x12\
-    \x04\x94\x01\x08\x20\n\x0f\n\x07\x04\x03\x03\x02\x02\x02\x04\x12\x04\x94\
-    \x01\x08\x10\n\x0f\n\x07\x04\x03\x03\x02\x02\x02\x06\x12\x04\x94\x01\x11\
-    \x15\n\x0f\n\x07\x04\x03\x03\x02\x02\x02\x01\x12\x04\x94\x01\x16\x1b\n\
-    \x0f\n\x07\x04\x03\x03\x02\x02\x02\x03\x12\x04\x94\x01\x1e\x1fb\x06proto\
-    3\
+    \n\n\x11\n\t\x06\0\x02\x08\x04\xb0\xca\xbc\"\x12\x04T\x08W\n\nm\n\x04\
+    \x06\0\x02\t\x12\x04[\x04`\x05\x1a_\x20Increments\x20the\x20count\x20of\
+    \x20a\x20Counter\x20on\x20the\x20backing\x20GameServer.\x20A\x20negative\
+    \x20amount\x20decrements\x20it.\n\n\x0c\n\x05\x06\0\x02\t\x01\x12\x03[\
+    \x08\x18\n\x0c\n\x05\x06\0\x02\t\x02\x12\x03[\x19&\n\x0c\n\x05\x06\0\x02\
+    \t\x03\x12\x03[16\n\r\n\x05\x06\0\x02\t\x04\x12\x04\\\x08_\n\n\x11\n\t\
+    \x06\0\x02\t\x04\xb0\xca\xbc\"\x12\x04\\\x08_\n\nH\n\x04\x06\0\x02\n\x12\
+    \x04c\x04h\x05\x1a:\x20Sets\x20the\x20capacity\x20of\x20a\x20Counter\x20\
+    on\x20the\x20backing\x20GameServer\n\n\x0c\n\x05\x06\0\x02\n\x01\x12\x03\
+    c\x08\x1a\n\x0c\n\x05\x06\0\x02\n\x02\x12\x03c\x1b(\n\x0c\n\x05\x06\0\
+    \x02\n\x03\x12\x03c38\n\r\n\x05\x06\0\x02\n\x04\x12\x04d\x08g\n\n\x11\n\
+    \t\x06\0\x02\n\x04\xb0\xca\xbc\"\x12\x04d\x08g\n\nC\n\x04\x06\0\x02\x0b\
+    \x12\x04k\x04p\x05\x1a5\x20Appends\x20a\x20value\x20to\x20a\x20List\x20o\
+    n\x20the\x20backing\x20GameServer\n\n\x0c\n\x05\x06\0\x02\x0b\x01\x12\
+    \x03k\x08\x17\n\x0c\n\x05\x06\0\x02\x0b\x02\x12\x03k\x18!\n\x0c\n\x05\
+    \x06\0\x02\x0b\x03\x12\x03k,1\n\r\n\x05\x06\0\x02\x0b\x04\x12\x04l\x08o\
+    \n\n\x11\n\t\x06\0\x02\x0b\x04\xb0\xca\xbc\"\x12\x04l\x08o\n\nE\n\x04\
+    \x06\0\x02\x0c\x12\x04s\x04x\x05\x1a7\x20Removes\x20a\x20value\x20from\
+    \x20a\x20List\x20on\x20the\x20backing\x20GameServer\n\n\x0c\n\x05\x06\0\
+    \x02\x0c\x01\x12\x03s\x08\x17\n\x0c\n\x05\x06\0\x02\x0c\x02\x12\x03s\x18\
+    !\n\x0c\n\x05\x06\0\x02\x0c\x03\x12\x03s,1\n\r\n\x05\x06\0\x02\x0c\x04\
+    \x12\x04t\x08w\n\n\x11\n\t\x06\0\x02\x0c\x04\xb0\xca\xbc\"\x12\x04t\x08w\
+    \n\n\x18\n\x02\x04\0\x12\x04|\0}\x01\x1a\x0c\x20I\x20am\x20Empty\n\n\n\n\
+    \x03\x04\0\x01\x12\x03|\x08\r\n\x20\n\x02\x04\x01\x12\x06\x80\x01\0\x83\
+    \x01\x01\x1a\x12\x20Key,\x20Value\x20entry\n\n\x0b\n\x03\x04\x01\x01\x12\
+    \x04\x80\x01\x08\x10\n\x0c\n\x04\x04\x01\x02\0\x12\x04\x81\x01\x04\x13\n\
+    \x0f\n\x05\x04\x01\x02\0\x04\x12\x06\x81\x01\x04\x80\x01\x12\n\r\n\x05\
+    \x04\x01\x02\0\x05\x12\x04\x81\x01\x04\n\n\r\n\x05\x04\x01\x02\0\x01\x12\
+    \x04\x81\x01\x0b\x0e\n\r\n\x05\x04\x01\x02\0\x03\x12\x04\x81\x01\x11\x12\
+    \n\x0c\n\x04\x04\x01\x02\x01\x12\x04\x82\x01\x04\x15\n\x0f\n\x05\x04\x01\
+    \x02\x01\x04\x12\x06\x82\x01\x04\x81\x01\x13\n\r\n\x05\x04\x01\x02\x01\
+    \x05\x12\x04\x82\x01\x04\n\n\r\n\x05\x04\x01\x02\x01\x01\x12\x04\x82\x01\
+    \x0b\x10\n\r\n\x05\x04\x01\x02\x01\x03\x12\x04\x82\x01\x13\x14\n)\n\x02\
+    \x04\x02\x12\x06\x86\x01\0\x88\x01\x01\x1a\x1b\x20time\x20duration,\x20i\
+    n\x20seconds\n\n\x0b\n\x03\x04\x02\x01\x12\x04\x86\x01\x08\x10\n\x0c\n\
+    \x04\x04\x02\x02\0\x12\x04\x87\x01\x04\x16\n\x0f\n\x05\x04\x02\x02\0\x04\
+    \x12\x06\x87\x01\x04\x86\x01\x12\n\r\n\x05\x04\x02\x02\0\x05\x12\x04\x87\
+    \x01\x04\t\n\r\n\x05\x04\x02\x02\0\x01\x12\x04\x87\x01\n\x11\n\r\n\x05\
+    \x04\x02\x02\0\x03\x12\x04\x87\x01\x14\x15\n,\n\x02\x04\x03\x12\x06\x8b\
+    \x01\0\x8e\x01\x01\x1a\x1e\x20An\x20update\x20to\x20a\x20named\x20Counte\
+    r\n\n\x0b\n\x03\x04\x03\x01\x12\x04\x8b\x01\x08\x15\n\x0c\n\x04\x04\x03\
+    \x02\0\x12\x04\x8c\x01\x04\x14\n\x0f\n\x05\x04\x03\x02\0\x04\x12\x06\x8c\
+    \x01\x04\x8b\x01\x17\n\r\n\x05\x04\x03\x02\0\x05\x12\x04\x8c\x01\x04\n\n\
+    \r\n\x05\x04\x03\x02\0\x01\x12\x04\x8c\x01\x0b\x0f\n\r\n\x05\x04\x03\x02\
+    \0\x03\x12\x04\x8c\x01\x12\x13\n\x0c\n\x04\x04\x03\x02\x01\x12\x04\x8d\
+    \x01\x04\x15\n\x0f\n\x05\x04\x03\x02\x01\x04\x12\x06\x8d\x01\x04\x8c\x01\
+    \x14\n\r\n\x05\x04\x03\x02\x01\x05\x12\x04\x8d\x01\x04\t\n\r\n\x05\x04\
+    \x03\x02\x01\x01\x12\x04\x8d\x01\n\x10\n\r\n\x05\x04\x03\x02\x01\x03\x12\
+    \x04\x8d\x01\x13\x14\n'\n\x02\x04\x04\x12\x06\x91\x01\0\x94\x01\x01\x1a\
+    \x19\x20A\x20value\x20in\x20a\x20named\x20List\n\n\x0b\n\x03\x04\x04\x01\
+    \x12\x04\x91\x01\x08\x11\n\x0c\n\x04\x04\x04\x02\0\x12\x04\x92\x01\x04\
+    \x14\n\x0f\n\x05\x04\x04\x02\0\x04\x12\x06\x92\x01\x04\x91\x01\x13\n\r\n\
+    \x05\x04\x04\x02\0\x05\x12\x04\x92\x01\x04\n\n\r\n\x05\x04\x04\x02\0\x01\
+    \x12\x04\x92\x01\x0b\x0f\n\r\n\x05\x04\x04\x02\0\x03\x12\x04\x92\x01\x12\
+    \x13\n\x0c\n\x04\x04\x04\x02\x01\x12\x04\x93\x01\x04\x15\n\x0f\n\x05\x04\
+    \x04\x02\x01\x04\x12\x06\x93\x01\x04\x92\x01\x14\n\r\n\x05\x04\x04\x02\
+    \x01\x05\x12\x04\x93\x01\x04\n\n\r\n\x05\x04\x04\x02\x01\x01\x12\x04\x93\
+    \x01\x0b\x10\n\r\n\x05\x04\x04\x02\x01\x03\x12\x04\x93\x01\x13\x14\n\xa4\
+    \x01\n\x02\x04\x05\x12\x06\x99\x01\0\xd7\x01\x01\x1a\x95\x01\x20A\x20Gam\
+    eServer\x20Custom\x20Resource\x20Definition\x20object\n\x20We\x20will\
+    \x20only\x20export\x20those\x20resources\x20that\x20make\x20the\x20most\
+    \n\x20sense.\x20Can\x20always\x20expand\x20to\x20more\x20as\x20needed.\n\
+    \n\x0b\n\x03\x04\x05\x01\x12\x04\x99\x01\x08\x12\n\x0c\n\x04\x04\x05\x02\
+    \0\x12\x04\x9a\x01\x04\x1f\n\x0f\n\x05\x04\x05\x02\0\x04\x12\x06\x9a\x01\
+    \x04\x99\x01\x14\n\r\n\x05\x04\x05\x02\0\x06\x12\x04\x9a\x01\x04\x0e\n\r\
+    \n\x05\x04\x05\x02\0\x01\x12\x04\x9a\x01\x0f\x1a\n\r\n\x05\x04\x05\x02\0\
+    \x03\x12\x04\x9a\x01\x1d\x1e\n\x0c\n\x04\x04\x05\x02\x01\x12\x04\x9b\x01\
+    \x04\x12\n\x0f\n\x05\x04\x05\x02\x01\x04\x12\x06\x9b\x01\x04\x9a\x01\x1f\
+    \n\r\n\x05\x04\x05\x02\x01\x06\x12\x04\x9b\x01\x04\x08\n\r\n\x05\x04\x05\
+    \x02\x01\x01\x12\x04\x9b\x01\t\r\n\r\n\x05\x04\x05\x02\x01\x03\x12\x04\
+    \x9b\x01\x10\x11\n\x0c\n\x04\x04\x05\x02\x02\x12\x04\x9c\x01\x04\x16\n\
+    \x0f\n\x05\x04\x05\x02\x02\x04\x12\x06\x9c\x01\x04\x9b\x01\x12\n\r\n\x05\
+    \x04\x05\x02\x02\x06\x12\x04\x9c\x01\x04\n\n\r\n\x05\x04\x05\x02\x02\x01\
+    \x12\x04\x9c\x01\x0b\x11\n\r\n\x05\x04\x05\x02\x02\x03\x12\x04\x9c\x01\
+    \x14\x15\n?\n\x04\x04\x05\x03\0\x12\x06\x9f\x01\x04\xab\x01\x05\x1a/\x20\
+    representation\x20of\x20the\x20K8s\x20ObjectMeta\x20resource\n\n\r\n\x05\
+    \x04\x05\x03\0\x01\x12\x04\x9f\x01\x0c\x16\n\x0e\n\x06\x04\x05\x03\0\x02\
+    \0\x12\x04\xa0\x01\x08\x18\n\x11\n\x07\x04\x05\x03\0\x02\0\x04\x12\x06\
+    \xa0\x01\x08\x9f\x01\x18\n\x0f\n\x07\x04\x05\x03\0\x02\0\x05\x12\x04\xa0\
+    \x01\x08\x0e\n\x0f\n\x07\x04\x05\x03\0\x02\0\x01\x12\x04\xa0\x01\x0f\x13\
+    \n\x0f\n\x07\x04\x05\x03\0\x02\0\x03\x12\x04\xa0\x01\x16\x17\n\x0e\n\x06\
+    \x04\x05\x03\0\x02\x01\x12\x04\xa1\x01\x08\x1d\n\x11\n\x07\x04\x05\x03\0\
+    \x02\x01\x04\x12\x06\xa1\x01\x08\xa0\x01\x18\n\x0f\n\x07\x04\x05\x03\0\
+    \x02\x01\x05\x12\x04\xa1\x01\x08\x0e\n\x0f\n\x07\x04\x05\x03\0\x02\x01\
+    \x01\x12\x04\xa1\x01\x0f\x18\n\x0f\n\x07\x04\x05\x03\0\x02\x01\x03\x12\
+    \x04\xa1\x01\x1b\x1c\n\x0e\n\x06\x04\x05\x03\0\x02\x02\x12\x04\xa2\x01\
+    \x08\x17\n\x11\n\x07\x04\x05\x03\0\x02\x02\x04\x12\x06\xa2\x01\x08\xa1\
+    \x01\x1d\n\x0f\n\x07\x04\x05\x03\0\x02\x02\x05\x12\x04\xa2\x01\x08\x0e\n\
+    \x0f\n\x07\x04\x05\x03\0\x02\x02\x01\x12\x04\xa2\x01\x0f\x12\n\x0f\n\x07\
+    \x04\x05\x03\0\x02\x02\x03\x12\x04\xa2\x01\x15\x16\n\x0e\n\x06\x04\x05\
+    \x03\0\x02\x03\x12\x04\xa3\x01\x08$\n\x11\n\x07\x04\x05\x03\0\x02\x03\
+    \x04\x12\x06\xa3\x01\x08\xa2\x01\x17\n\x0f\n\x07\x04\x05\x03\0\x02\x03\
+    \x05\x12\x04\xa3\x01\x08\x0e\n\x0f\n\x07\x04\x05\x03\0\x02\x03\x01\x12\
+    \x04\xa3\x01\x0f\x1f\n\x0f\n\x07\x04\x05\x03\0\x02\x03\x03\x12\x04\xa3\
+    \x01\"#\n\x0e\n\x06\x04\x05\x03\0\x02\x04\x12\x04\xa4\x01\x08\x1d\n\x11\
+    \n\x07\x04\x05\x03\0\x02\x04\x04\x12\x06\xa4\x01\x08\xa3\x01$\n\x0f\n\
+    \x07\x04\x05\x03\0\x02\x04\x05\x12\x04\xa4\x01\x08\r\n\x0f\n\x07\x04\x05\
+    \x03\0\x02\x04\x01\x12\x04\xa4\x01\x0e\x18\n\x0f\n\x07\x04\x05\x03\0\x02\
+    \x04\x03\x12\x04\xa4\x01\x1b\x1c\n=\n\x06\x04\x05\x03\0\x02\x05\x12\x04\
+    \xa6\x01\x08%\x1a-\x20timestamp\x20is\x20in\x20Epoch\x20format,\x20unit:\
+    \x20seconds\n\n\x11\n\x07\x04\x05\x03\0\x02\x05\x04\x12\x06\xa6\x01\x08\
+    \xa4\x01\x1d\n\x0f\n\x07\x04\x05\x03\0\x02\x05\x05\x12\x04\xa6\x01\x08\r\
+    \n\x0f\n\x07\x04\x05\x03\0\x02\x05\x01\x12\x04\xa6\x01\x0e\x20\n\x0f\n\
+    \x07\x04\x05\x03\0\x02\x05\x03\x12\x04\xa6\x01#$\nL\n\x06\x04\x05\x03\0\
+    \x02\x06\x12\x04\xa8\x01\x08%\x1a<\x20optional\x20deletion\x20timestamp\
+    \x20in\x20Epoch\x20format,\x20unit:\x20seconds\n\n\x11\n\x07\x04\x05\x03\
+    \0\x02\x06\x04\x12\x06\xa8\x01\x08\xa6\x01%\n\x0f\n\x07\x04\x05\x03\0\
+    \x02\x06\x05\x12\x04\xa8\x01\x08\r\n\x0f\n\x07\x04\x05\x03\0\x02\x06\x01\
+    \x12\x04\xa8\x01\x0e\x20\n\x0f\n\x07\x04\x05\x03\0\x02\x06\x03\x12\x04\
+    \xa8\x01#$\n\x0e\n\x06\x04\x05\x03\0\x02\x07\x12\x04\xa9\x01\x08,\n\x11\
+    \n\x07\x04\x05\x03\0\x02\x07\x04\x12\x06\xa9\x01\x08\xa8\x01%\n\x0f\n\
+    \x07\x04\x05\x03\0\x02\x07\x06\x12\x04\xa9\x01\x08\x1b\n\x0f\n\x07\x04\
+    \x05\x03\0\x02\x07\x01\x12\x04\xa9\x01\x1c'\n\x0f\n\x07\x04\x05\x03\0\
+    \x02\x07\x03\x12\x04\xa9\x01*+\n\x0e\n\x06\x04\x05\x03\0\x02\x08\x12\x04\
+    \xaa\x01\x08'\n\x11\n\x07\x04\x05\x03\0\x02\x08\x04\x12\x06\xaa\x01\x08\
+    \xa9\x01,\n\x0f\n\x07\x04\x05\x03\0\x02\x08\x06\x12\x04\xaa\x01\x08\x1b\
+    \n\x0f\n\x07\x04\x05\x03\0\x02\x08\x01\x12\x04\xaa\x01\x1c\"\n\x0f\n\x07\
+    \x04\x05\x03\0\x02\x08\x03\x12\x04\xaa\x01%&\n\x0e\n\x04\x04\x05\x03\x01\
+    \x12\x06\xad\x01\x04\xb6\x01\x05\n\r\n\x05\x04\x05\x03\x01\x01\x12\x04\
+    \xad\x01\x0c\x10\n\x0e\n\x06\x04\x05\x03\x01\x02\0\x12\x04\xae\x01\x08\
+    \x1a\n\x11\n\x07\x04\x05\x03\x01\x02\0\x04\x12\x06\xae\x01\x08\xad\x01\
+    \x12\n\x0f\n\x07\x04\x05\x03\x01\x02\0\x06\x12\x04\xae\x01\x08\x0e\n\x0f\
+    \n\x07\x04\x05\x03\x01\x02\0\x01\x12\x04\xae\x01\x0f\x15\n\x0f\n\x07\x04\
+    \x05\x03\x01\x02\0\x03\x12\x04\xae\x01\x18\x19\n\x10\n\x06\x04\x05\x03\
+    \x01\x03\0\x12\x06\xb0\x01\x08\xb5\x01\t\n\x0f\n\x07\x04\x05\x03\x01\x03\
+    \0\x01\x12\x04\xb0\x01\x10\x16\n\x10\n\x08\x04\x05\x03\x01\x03\0\x02\0\
+    \x12\x04\xb1\x01\x0c\x1e\n\x13\n\t\x04\x05\x03\x01\x03\0\x02\0\x04\x12\
+    \x06\xb1\x01\x0c\xb0\x01\x18\n\x11\n\t\x04\x05\x03\x01\x03\0\x02\0\x05\
+    \x12\x04\xb1\x01\x0c\x10\n\x11\n\t\x04\x05\x03\x01\x03\0\x02\0\x01\x12\
+    \x04\xb1\x01\x11\x19\n\x11\n\t\x04\x05\x03\x01\x03\0\x02\0\x03\x12\x04\
+    \xb1\x01\x1c\x1d\n\x10\n\x08\x04\x05\x03\x01\x03\0\x02\x01\x12\x04\xb2\
+    \x01\x0c%\n\x13\n\t\x04\x05\x03\x01\x03\0\x02\x01\x04\x12\x06\xb2\x01\
+    \x0c\xb1\x01\x1e\n\x11\n\t\x04\x05\x03\x01\x03\0\x02\x01\x05\x12\x04\xb2\
+    \x01\x0c\x11\n\x11\n\t\x04\x05\x03\x01\x03\0\x02\x01\x01\x12\x04\xb2\x01\
+    \x12\x20\n\x11\n\t\x04\x05\x03\x01\x03\0\x02\x01\x03\x12\x04\xb2\x01#$\n\
+    \x10\n\x08\x04\x05\x03\x01\x03\0\x02\x02\x12\x04\xb3\x01\x0c(\n\x13\n\t\
+    \x04\x05\x03\x01\x03\0\x02\x02\x04\x12\x06\xb3\x01\x0c\xb2\x01%\n\x11\n\
+    \t\x04\x05\x03\x01\x03\0\x02\x02\x05\x12\x04\xb3\x01\x0c\x11\n\x11\n\t\
+    \x04\x05\x03\x01\x03\0\x02\x02\x01\x12\x04\xb3\x01\x12#\n\x11\n\t\x04\
+    \x05\x03\x01\x03\0\x02\x02\x03\x12\x04\xb3\x01&'\n\x10\n\x08\x04\x05\x03\
+    \x01\x03\0\x02\x03\x12\x04\xb4\x01\x0c,\n\x13\n\t\x04\x05\x03\x01\x03\0\
+    \x02\x03\x04\x12\x06\xb4\x01\x0c\xb3\x01(\n\x11\n\t\x04\x05\x03\x01\x03\
+    \0\x02\x03\x05\x12\x04\xb4\x01\x0c\x11\n\x11\n\t\x04\x05\x03\x01\x03\0\
+    \x02\x03\x01\x12\x04\xb4\x01\x12'\n\x11\n\t\x04\x05\x03\x01\x03\0\x02\
+    \x03\x03\x12\x04\xb4\x01*+\n\x0e\n\x04\x04\x05\x03\x02\x12\x06\xb8\x01\
+    \x04\xd6\x01\x05\n\r\n\x05\x04\x05\x03\x02\x01\x12\x04\xb8\x01\x0c\x12\n\
+    \x10\n\x06\x04\x05\x03\x02\x03\0\x12\x06\xb9\x01\x08\xbc\x01\t\n\x0f\n\
+    \x07\x04\x05\x03\x02\x03\0\x01\x12\x04\xb9\x01\x10\x14\n\x10\n\x08\x04\
+    \x05\x03\x02\x03\0\x02\0\x12\x04\xba\x01\x0c\x1c\n\x13\n\t\x04\x05\x03\
+    \x02\x03\0\x02\0\x04\x12\x06\xba\x01\x0c\xb9\x01\x16\n\x11\n\t\x04\x05\
+    \x03\x02\x03\0\x02\0\x05\x12\x04\xba\x01\x0c\x12\n\x11\n\t\x04\x05\x03\
+    \x02\x03\0\x02\0\x01\x12\x04\xba\x01\x13\x17\n\x11\n\t\x04\x05\x03\x02\
+    \x03\0\x02\0\x03\x12\x04\xba\x01\x1a\x1b\n\x10\n\x08\x04\x05\x03\x02\x03\
+    \0\x02\x01\x12\x04\xbb\x01\x0c\x1b\n\x13\n\t\x04\x05\x03\x02\x03\0\x02\
+    \x01\x04\x12\x06\xbb\x01\x0c\xba\x01\x1c\n\x11\n\t\x04\x05\x03\x02\x03\0\
+    \x02\x01\x05\x12\x04\xbb\x01\x0c\x11\n\x11\n\t\x04\x05\x03\x02\x03\0\x02\
+    \x01\x01\x12\x04\xbb\x01\x12\x16\n\x11\n\t\x04\x05\x03\x02\x03\0\x02\x01\
+    \x03\x12\x04\xbb\x01\x19\x1a\n\x10\n\x06\x04\x05\x03\x02\x03\x01\x12\x06\
+    \xbe\x01\x08\xc1\x01\t\n\x0f\n\x07\x04\x05\x03\x02\x03\x01\x01\x12\x04\
+    \xbe\x01\x10\x17\n\x10\n\x08\x04\x05\x03\x02\x03\x01\x02\0\x12\x04\xbf\
+    \x01\x0c\x1c\n\x13\n\t\x04\x05\x03\x02\x03\x01\x02\0\x04\x12\x06\xbf\x01\
+    \x0c\xbe\x01\x19\n\x11\n\t\x04\x05\x03\x02\x03\x01\x02\0\x05\x12\x04\xbf\
+    \x01\x0c\x11\n\x11\n\t\x04\x05\x03\x02\x03\x01\x02\0\x01\x12\x04\xbf\x01\
+    \x12\x17\n\x11\n\t\x04\x05\x03\x02\x03\x01\x02\0\x03\x12\x04\xbf\x01\x1a\
+    \x1b\n\x10\n\x08\x04\x05\x03\x02\x03\x01\x02\x01\x12\x04\xc0\x01\x0c\x1f\
+    \n\x13\n\t\x04\x05\x03\x02\x03\x01\x02\x01\x04\x12\x06\xc0\x01\x0c\xbf\
+    \x01\x1c\n\x11\n\t\x04\x05\x03\x02\x03\x01\x02\x01\x05\x12\x04\xc0\x01\
+    \x0c\x11\n\x11\n\t\x04\x05\x03\x02\x03\x01\x02\x01\x01\x12\x04\xc0\x01\
+    \x12\x1a\n\x11\n\t\x04\x05\x03\x02\x03\x01\x02\x01\x03\x12\x04\xc0\x01\
+    \x1d\x1e\n\x10\n\x06\x04\x05\x03\x02\x03\x02\x12\x06\xc3\x01\x08\xc6\x01\
+    \t\n\x0f\n\x07\x04\x05\x03\x02\x03\x02\x01\x12\x04\xc3\x01\x10\x14\n\x10\
+    \n\x08\x04\x05\x03\x02\x03\x02\x02\0\x12\x04\xc4\x01\x0c\x1f\n\x13\n\t\
+    \x04\x05\x03\x02\x03\x02\x02\0\x04\x12\x06\xc4\x01\x0c\xc3\x01\x16\n\x11\
+    \n\t\x04\x05\x03\x02\x03\x02\x02\0\x05\x12\x04\xc4\x01\x0c\x11\n\x11\n\t\
+    \x04\x05\x03\x02\x03\x02\x02\0\x01\x12\x04\xc4\x01\x12\x1a\n\x11\n\t\x04\
+    \x05\x03\x02\x03\x02\x02\0\x03\x12\x04\xc4\x01\x1d\x1e\n\x10\n\x08\x04\
+    \x05\x03\x02\x03\x02\x02\x01\x12\x04\xc5\x01\x0c'\n\x11\n\t\x04\x05\x03\
+    \x02\x03\x02\x02\x01\x04\x12\x04\xc5\x01\x0c\x14\n\x11\n\t\x04\x05\x03\
+    \x02\x03\x02\x02\x01\x05\x12\x04\xc5\x01\x15\x1b\n\x11\n\t\x04\x05\x03\
+    \x02\x03\x02\x02\x01\x01\x12\x04\xc5\x01\x1c\"\n\x11\n\t\x04\x05\x03\x02\
+    \x03\x02\x02\x01\x03\x12\x04\xc5\x01%&\n~\n\x06\x04\x05\x03\x02\x03\x03\
+    \x12\x06\xca\x01\x08\xce\x01\t\x1al\x20A\x20forecast\x20of\x20an\x20immi\
+    nent\x20disruption\x20of\x20the\x20GameServer,\n\x20e.g.\x20because\x20i\
+    ts\x20node\x20is\x20being\x20drained\x20or\x20removed\n\n\x0f\n\x07\x04\
+    \x05\x03\x02\x03\x03\x01\x12\x04\xca\x01\x10\x1a\n\x10\n\x08\x04\x05\x03\
+    \x02\x03\x03\x02\0\x12\x04\xcb\x01\x0c\x1e\n\x13\n\t\x04\x05\x03\x02\x03\
+    \x03\x02\0\x04\x12\x06\xcb\x01\x0c\xca\x01\x1c\n\x11\n\t\x04\x05\x03\x02\
+    \x03\x03\x02\0\x05\x12\x04\xcb\x01\x0c\x12\n\x11\n\t\x04\x05\x03\x02\x03\
+    \x03\x02\0\x01\x12\x04\xcb\x01\x13\x19\n\x11\n\t\x04\x05\x03\x02\x03\x03\
+    \x02\0\x03\x12\x04\xcb\x01\x1c\x1d\n\x10\n\x08\x04\x05\x03\x02\x03\x03\
+    \x02\x01\x12\x04\xcc\x01\x0c\x1f\n\x13\n\t\x04\x05\x03\x02\x03\x03\x02\
+    \x01\x04\x12\x06\xcc\x01\x0c\xcb\x01\x1e\n\x11\n\t\x04\x05\x03\x02\x03\
+    \x03\x02\x01\x05\x12\x04\xcc\x01\x0c\x12\n\x11\n\t\x04\x05\x03\x02\x03\
+    \x03\x02\x01\x01\x12\x04\xcc\x01\x13\x1a\n\x11\n\t\x04\x05\x03\x02\x03\
+    \x03\x02\x01\x03\x12\x04\xcc\x01\x1d\x1e\n\x10\n\x08\x04\x05\x03\x02\x03\
+    \x03\x02\x02\x12\x04\xcd\x01\x0c\x1c\n\x13\n\t\x04\x05\x03\x02\x03\x03\
+    \x02\x02\x04\x12\x06\xcd\x01\x0c\xcc\x01\x1f\n\x11\n\t\x04\x05\x03\x02\
+    \x03\x03\x02\x02\x05\x12\x04\xcd\x01\x0c\x11\n\x11\n\t\x04\x05\x03\x02\
+    \x03\x03\x02\x02\x01\x12\x04\xcd\x01\x12\x17\n\x11\n\t\x04\x05\x03\x02\
+    \x03\x03\x02\x02\x03\x12\x04\xcd\x01\x1a\x1b\n\x0e\n\x06\x04\x05\x03\x02\
+    \x02\0\x12\x04\xd0\x01\x08\x19\n\x11\n\x07\x04\x05\x03\x02\x02\0\x04\x12\
+    \x06\xd0\x01\x08\xce\x01\t\n\x0f\n\x07\x04\x05\x03\x02\x02\0\x05\x12\x04\
+    \xd0\x01\x08\x0e\n\x0f\n\x07\x04\x05\x03\x02\x02\0\x01\x12\x04\xd0\x01\
+    \x0f\x14\n\x0f\n\x07\x04\x05\x03\x02\x02\0\x03\x12\x04\xd0\x01\x17\x18\n\
+    \x0e\n\x06\x04\x05\x03\x02\x02\x01\x12\x04\xd1\x01\x08\x1b\n\x11\n\x07\
+    \x04\x05\x03\x02\x02\x01\x04\x12\x06\xd1\x01\x08\xd0\x01\x19\n\x0f\n\x07\
+    \x04\x05\x03\x02\x02\x01\x05\x12\x04\xd1\x01\x08\x0e\n\x0f\n\x07\x04\x05\
+    \x03\x02\x02\x01\x01\x12\x04\xd1\x01\x0f\x16\n\x0f\n\x07\x04\x05\x03\x02\
+    \x02\x01\x03\x12\x04\xd1\x01\x19\x1a\n\x0e\n\x06\x04\x05\x03\x02\x02\x02\
+    \x12\x04\xd2\x01\x08\x20\n\x0f\n\x07\x04\x05\x03\x02\x02\x02\x04\x12\x04\
+    \xd2\x01\x08\x10\n\x0f\n\x07\x04\x05\x03\x02\x02\x02\x06\x12\x04\xd2\x01\
+    \x11\x15\n\x0f\n\x07\x04\x05\x03\x02\x02\x02\x01\x12\x04\xd2\x01\x16\x1b\
+    \n\x0f\n\x07\x04\x05\x03\x02\x02\x02\x03\x12\x04\xd2\x01\x1e\x1f\n\x0e\n\
+    \x06\x04\x05\x03\x02\x02\x03\x12\x04\xd3\x01\x08*\n\x11\n\x07\x04\x05\
+    \x03\x02\x02\x03\x04\x12\x06\xd3\x01\x08\xd2\x01\x20\n\x0f\n\x07\x04\x05\
+    \x03\x02\x02\x03\x06\x12\x04\xd3\x01\x08\x1c\n\x0f\n\x07\x04\x05\x03\x02\
+    \x02\x03\x01\x12\x04\xd3\x01\x1d%\n\x0f\n\x07\x04\x05\x03\x02\x02\x03\
+    \x03\x12\x04\xd3\x01()\n\x0e\n\x06\x04\x05\x03\x02\x02\x04\x12\x04\xd4\
+    \x01\x08$\n\x11\n\x07\x04\x05\x03\x02\x02\x04\x04\x12\x06\xd4\x01\x08\
+    \xd3\x01*\n\x0f\n\x07\x04\x05\x03\x02\x02\x04\x06\x12\x04\xd4\x01\x08\
+    \x19\n\x0f\n\x07\x04\x05\x03\x02\x02\x04\x01\x12\x04\xd4\x01\x1a\x1f\n\
+    \x0f\n\x07\x04\x05\x03\x02\x02\x04\x03\x12\x04\xd4\x01\"#\n\x0e\n\x06\
+    \x04\x05\x03\x02\x02\x05\x12\x04\xd5\x01\x08\"\n\x11\n\x07\x04\x05\x03\
+    \x02\x02\x05\x04\x12\x06\xd5\x01\x08\xd4\x01$\n\x0f\n\x07\x04\x05\x03\
+    \x02\x02\x05\x06\x12\x04\xd5\x01\x08\x12\n\x0f\n\x07\x04\x05\x03\x02\x02\
+    \x05\x01\x12\x04\xd5\x01\x13\x1d\n\x0f\n\x07\x04\x05\x03\x02\x02\x05\x03\
+    \x12\x04\xd5\x01\x20!b\x06proto3\
 ";
 
 static mut file_descriptor_proto_lazy: ::protobuf::lazy::Lazy<::protobuf::descriptor::FileDescriptorProto> = ::protobuf::lazy::Lazy {
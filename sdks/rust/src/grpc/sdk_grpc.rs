@@ -96,6 +96,34 @@ const METHOD_SDK_RESERVE: ::grpcio::Method<super::sdk::Duration, super::sdk::Emp
     resp_mar: ::grpcio::Marshaller { ser: ::grpcio::pb_ser, de: ::grpcio::pb_de },
 };
 
+const METHOD_SDK_INCREMENT_COUNTER: ::grpcio::Method<super::sdk::CounterUpdate, super::sdk::Empty> = ::grpcio::Method {
+    ty: ::grpcio::MethodType::Unary,
+    name: "/agones.dev.sdk.SDK/IncrementCounter",
+    req_mar: ::grpcio::Marshaller { ser: ::grpcio::pb_ser, de: ::grpcio::pb_de },
+    resp_mar: ::grpcio::Marshaller { ser: ::grpcio::pb_ser, de: ::grpcio::pb_de },
+};
+
+const METHOD_SDK_SET_COUNTER_CAPACITY: ::grpcio::Method<super::sdk::CounterUpdate, super::sdk::Empty> = ::grpcio::Method {
+    ty: ::grpcio::MethodType::Unary,
+    name: "/agones.dev.sdk.SDK/SetCounterCapacity",
+    req_mar: ::grpcio::Marshaller { ser: ::grpcio::pb_ser, de: ::grpcio::pb_de },
+    resp_mar: ::grpcio::Marshaller { ser: ::grpcio::pb_ser, de: ::grpcio::pb_de },
+};
+
+const METHOD_SDK_APPEND_LIST_VALUE: ::grpcio::Method<super::sdk::ListValue, super::sdk::Empty> = ::grpcio::Method {
+    ty: ::grpcio::MethodType::Unary,
+    name: "/agones.dev.sdk.SDK/AppendListValue",
+    req_mar: ::grpcio::Marshaller { ser: ::grpcio::pb_ser, de: ::grpcio::pb_de },
+    resp_mar: ::grpcio::Marshaller { ser: ::grpcio::pb_ser, de: ::grpcio::pb_de },
+};
+
+const METHOD_SDK_DELETE_LIST_VALUE: ::grpcio::Method<super::sdk::ListValue, super::sdk::Empty> = ::grpcio::Method {
+    ty: ::grpcio::MethodType::Unary,
+    name: "/agones.dev.sdk.SDK/DeleteListValue",
+    req_mar: ::grpcio::Marshaller { ser: ::grpcio::pb_ser, de: ::grpcio::pb_de },
+    resp_mar: ::grpcio::Marshaller { ser: ::grpcio::pb_ser, de: ::grpcio::pb_de },
+};
+
 pub struct SdkClient {
     client: ::grpcio::Client,
 }
@@ -234,6 +262,70 @@ impl SdkClient {
     pub fn reserve_async(&self, req: &super::sdk::Duration) -> ::grpcio::Result<::grpcio::ClientUnaryReceiver<super::sdk::Empty>> {
         self.reserve_async_opt(req, ::grpcio::CallOption::default())
     }
+
+    pub fn increment_counter_opt(&self, req: &super::sdk::CounterUpdate, opt: ::grpcio::CallOption) -> ::grpcio::Result<super::sdk::Empty> {
+        self.client.unary_call(&METHOD_SDK_INCREMENT_COUNTER, req, opt)
+    }
+
+    pub fn increment_counter(&self, req: &super::sdk::CounterUpdate) -> ::grpcio::Result<super::sdk::Empty> {
+        self.increment_counter_opt(req, ::grpcio::CallOption::default())
+    }
+
+    pub fn increment_counter_async_opt(&self, req: &super::sdk::CounterUpdate, opt: ::grpcio::CallOption) -> ::grpcio::Result<::grpcio::ClientUnaryReceiver<super::sdk::Empty>> {
+        self.client.unary_call_async(&METHOD_SDK_INCREMENT_COUNTER, req, opt)
+    }
+
+    pub fn increment_counter_async(&self, req: &super::sdk::CounterUpdate) -> ::grpcio::Result<::grpcio::ClientUnaryReceiver<super::sdk::Empty>> {
+        self.increment_counter_async_opt(req, ::grpcio::CallOption::default())
+    }
+
+    pub fn set_counter_capacity_opt(&self, req: &super::sdk::CounterUpdate, opt: ::grpcio::CallOption) -> ::grpcio::Result<super::sdk::Empty> {
+        self.client.unary_call(&METHOD_SDK_SET_COUNTER_CAPACITY, req, opt)
+    }
+
+    pub fn set_counter_capacity(&self, req: &super::sdk::CounterUpdate) -> ::grpcio::Result<super::sdk::Empty> {
+        self.set_counter_capacity_opt(req, ::grpcio::CallOption::default())
+    }
+
+    pub fn set_counter_capacity_async_opt(&self, req: &super::sdk::CounterUpdate, opt: ::grpcio::CallOption) -> ::grpcio::Result<::grpcio::ClientUnaryReceiver<super::sdk::Empty>> {
+        self.client.unary_call_async(&METHOD_SDK_SET_COUNTER_CAPACITY, req, opt)
+    }
+
+    pub fn set_counter_capacity_async(&self, req: &super::sdk::CounterUpdate) -> ::grpcio::Result<::grpcio::ClientUnaryReceiver<super::sdk::Empty>> {
+        self.set_counter_capacity_async_opt(req, ::grpcio::CallOption::default())
+    }
+
+    pub fn append_list_value_opt(&self, req: &super::sdk::ListValue, opt: ::grpcio::CallOption) -> ::grpcio::Result<super::sdk::Empty> {
+        self.client.unary_call(&METHOD_SDK_APPEND_LIST_VALUE, req, opt)
+    }
+
+    pub fn append_list_value(&self, req: &super::sdk::ListValue) -> ::grpcio::Result<super::sdk::Empty> {
+        self.append_list_value_opt(req, ::grpcio::CallOption::default())
+    }
+
+    pub fn append_list_value_async_opt(&self, req: &super::sdk::ListValue, opt: ::grpcio::CallOption) -> ::grpcio::Result<::grpcio::ClientUnaryReceiver<super::sdk::Empty>> {
+        self.client.unary_call_async(&METHOD_SDK_APPEND_LIST_VALUE, req, opt)
+    }
+
+    pub fn append_list_value_async(&self, req: &super::sdk::ListValue) -> ::grpcio::Result<::grpcio::ClientUnaryReceiver<super::sdk::Empty>> {
+        self.append_list_value_async_opt(req, ::grpcio::CallOption::default())
+    }
+
+    pub fn delete_list_value_opt(&self, req: &super::sdk::ListValue, opt: ::grpcio::CallOption) -> ::grpcio::Result<super::sdk::Empty> {
+        self.client.unary_call(&METHOD_SDK_DELETE_LIST_VALUE, req, opt)
+    }
+
+    pub fn delete_list_value(&self, req: &super::sdk::ListValue) -> ::grpcio::Result<super::sdk::Empty> {
+        self.delete_list_value_opt(req, ::grpcio::CallOption::default())
+    }
+
+    pub fn delete_list_value_async_opt(&self, req: &super::sdk::ListValue, opt: ::grpcio::CallOption) -> ::grpcio::Result<::grpcio::ClientUnaryReceiver<super::sdk::Empty>> {
+        self.client.unary_call_async(&METHOD_SDK_DELETE_LIST_VALUE, req, opt)
+    }
+
+    pub fn delete_list_value_async(&self, req: &super::sdk::ListValue) -> ::grpcio::Result<::grpcio::ClientUnaryReceiver<super::sdk::Empty>> {
+        self.delete_list_value_async_opt(req, ::grpcio::CallOption::default())
+    }
     pub fn spawn<F>(&self, f: F) where F: ::futures::Future<Item = (), Error = ()> + Send + 'static {
         self.client.spawn(f)
     }
@@ -249,6 +341,10 @@ pub trait Sdk {
     fn set_label(&self, ctx: ::grpcio::RpcContext, req: super::sdk::KeyValue, sink: ::grpcio::UnarySink<super::sdk::Empty>);
     fn set_annotation(&self, ctx: ::grpcio::RpcContext, req: super::sdk::KeyValue, sink: ::grpcio::UnarySink<super::sdk::Empty>);
     fn reserve(&self, ctx: ::grpcio::RpcContext, req: super::sdk::Duration, sink: ::grpcio::UnarySink<super::sdk::Empty>);
+    fn increment_counter(&self, ctx: ::grpcio::RpcContext, req: super::sdk::CounterUpdate, sink: ::grpcio::UnarySink<super::sdk::Empty>);
+    fn set_counter_capacity(&self, ctx: ::grpcio::RpcContext, req: super::sdk::CounterUpdate, sink: ::grpcio::UnarySink<super::sdk::Empty>);
+    fn append_list_value(&self, ctx: ::grpcio::RpcContext, req: super::sdk::ListValue, sink: ::grpcio::UnarySink<super::sdk::Empty>);
+    fn delete_list_value(&self, ctx: ::grpcio::RpcContext, req: super::sdk::ListValue, sink: ::grpcio::UnarySink<super::sdk::Empty>);
 }
 
 pub fn create_sdk<S: Sdk + Send + Clone + 'static>(s: S) -> ::grpcio::Service {
@@ -289,5 +385,21 @@ pub fn create_sdk<S: Sdk + Send + Clone + 'static>(s: S) -> ::grpcio::Service {
     builder = builder.add_unary_handler(&METHOD_SDK_RESERVE, move |ctx, req, resp| {
         instance.reserve(ctx, req, resp)
     });
+    let instance = s.clone();
+    builder = builder.add_unary_handler(&METHOD_SDK_INCREMENT_COUNTER, move |ctx, req, resp| {
+        instance.increment_counter(ctx, req, resp)
+    });
+    let instance = s.clone();
+    builder = builder.add_unary_handler(&METHOD_SDK_SET_COUNTER_CAPACITY, move |ctx, req, resp| {
+        instance.set_counter_capacity(ctx, req, resp)
+    });
+    let instance = s.clone();
+    builder = builder.add_unary_handler(&METHOD_SDK_APPEND_LIST_VALUE, move |ctx, req, resp| {
+        instance.append_list_value(ctx, req, resp)
+    });
+    let instance = s.clone();
+    builder = builder.add_unary_handler(&METHOD_SDK_DELETE_LIST_VALUE, move |ctx, req, resp| {
+        instance.delete_list_value(ctx, req, resp)
+    });
     builder.build()
 }
@@ -169,7 +169,8 @@ func TestUnhealthyGameServersWithoutFreePorts(t *testing.T) {
 	assert.True(t, len(nodes.Items) > 0)
 
 	gs := defaultGameServer(defaultNs)
-	gs.Spec.Ports[0].HostPort = 7515
+	// outside of the port range of Dynamic ports
+	gs.Spec.Ports[0].HostPort = 6515
 	gs.Spec.Ports[0].PortPolicy = agonesv1.Static
 
 	gameServers := framework.AgonesClient.AgonesV1().GameServers(defaultNs)